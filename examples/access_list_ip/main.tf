@@ -151,11 +151,23 @@ resource "rtx_access_list_ip" "shared_filters" {
   sequence_start = 5000
   sequence_step  = 100
 
+  # Keep path MTU discovery working (destination unreachable, fragmentation needed)
+  entry {
+    action      = "pass"
+    source      = "*"
+    destination = "*"
+    protocol    = "icmp"
+    icmp_type   = 3
+    icmp_code   = 4
+  }
+
+  # Drop echo requests (ping); other ICMP is passed by the next entry
   entry {
     action      = "reject"
     source      = "*"
     destination = "*"
     protocol    = "icmp"
+    icmp_type   = 8
   }
 
   entry {
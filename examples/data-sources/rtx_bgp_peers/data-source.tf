@@ -0,0 +1,11 @@
+data "rtx_bgp_peers" "upstream" {
+  address = "203.0.113.1"
+}
+
+# Fail the run if the upstream session did not come back after a change
+check "upstream_bgp" {
+  assert {
+    condition     = length(data.rtx_bgp_peers.upstream.peers) > 0 && alltrue([for p in data.rtx_bgp_peers.upstream.peers : p.established && p.prefixes_accepted > 0])
+    error_message = "The upstream BGP session is down or has no accepted prefixes."
+  }
+}
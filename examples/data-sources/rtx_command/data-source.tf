@@ -0,0 +1,15 @@
+data "rtx_command" "dhcp" {
+  command = "show status dhcp"
+}
+
+output "dhcp_status" {
+  value     = data.rtx_command.dhcp.output
+  sensitive = true
+}
+
+check "dhcp_status_command" {
+  assert {
+    condition     = data.rtx_command.dhcp.exit_status == 0
+    error_message = "show status dhcp failed, see the dhcp_status output"
+  }
+}
@@ -0,0 +1,9 @@
+# Audit NAT descriptors that are not yet managed by Terraform
+data "rtx_config" "nat" {
+  grep = "nat descriptor"
+}
+
+output "nat_lines" {
+  value     = data.rtx_config.nat.lines
+  sensitive = true
+}
@@ -0,0 +1,8 @@
+# Verify that the intranet name is resolved by the internal DNS server
+data "rtx_dns_cache" "intranet" {
+  name = "intranet.corp.example"
+}
+
+output "intranet_addresses" {
+  value = [for e in data.rtx_dns_cache.intranet.entries : e.data if e.type == "A"]
+}
@@ -0,0 +1,9 @@
+data "rtx_environment" "current" {}
+
+# Fail the pipeline before a large change if the router is already busy
+check "router_headroom" {
+  assert {
+    condition     = data.rtx_environment.current.cpu_1min < 80 && data.rtx_environment.current.memory_used_percent < 90
+    error_message = "Router CPU or memory usage is too high."
+  }
+}
@@ -0,0 +1,11 @@
+data "rtx_firmware_revisions" "current" {
+  revision_list_url = "https://firmware-mirror.example.com/rtx1210/releases.html"
+}
+
+output "firmware_update_due" {
+  value = data.rtx_firmware_revisions.current.update_available
+}
+
+output "firmware_upgrade_path" {
+  value = "${data.rtx_firmware_revisions.current.installed_revision} -> ${data.rtx_firmware_revisions.current.latest_revision}"
+}
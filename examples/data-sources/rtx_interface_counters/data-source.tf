@@ -0,0 +1,13 @@
+data "rtx_interface_counters" "wan" {
+  names = ["lan2"]
+
+  depends_on = [rtx_nat_masquerade.wan]
+}
+
+# Assert that the uplink is passing traffic after the NAT change
+check "wan_traffic" {
+  assert {
+    condition     = data.rtx_interface_counters.wan.interfaces[0].rx_packets > 0
+    error_message = "No packets received on lan2."
+  }
+}
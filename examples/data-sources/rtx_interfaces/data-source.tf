@@ -0,0 +1,11 @@
+data "rtx_interfaces" "lan" {
+  names = ["lan1", "lan2"]
+}
+
+# Fail the plan if the uplink is down before binding filters to it
+check "uplink_up" {
+  assert {
+    condition     = data.rtx_interfaces.lan.interfaces[1].link_up
+    error_message = "lan2 has no link."
+  }
+}
@@ -0,0 +1,10 @@
+data "rtx_ipsec_sa" "site_b" {
+  tunnel_id = 1
+}
+
+check "site_b_tunnel_up" {
+  assert {
+    condition     = data.rtx_ipsec_sa.site_b.established
+    error_message = "IPsec tunnel 1 has no established SAs."
+  }
+}
@@ -0,0 +1,8 @@
+data "rtx_ipv6_neighbors" "lan" {
+  interface = "lan1"
+}
+
+# Map of IPv6 address to MAC address for hosts on the LAN
+output "lan_ipv6_hosts" {
+  value = { for n in data.rtx_ipv6_neighbors.lan.neighbors : n.address => n.mac_address if n.mac_address != "" }
+}
@@ -0,0 +1,5 @@
+data "rtx_l2ms_switches" "all" {}
+
+output "switch_macs" {
+  value = [for sw in data.rtx_l2ms_switches.all.switches : sw.mac_address]
+}
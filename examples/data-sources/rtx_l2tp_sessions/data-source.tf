@@ -0,0 +1,7 @@
+data "rtx_l2tp_sessions" "all" {}
+
+output "vpn_users" {
+  value = [
+    for s in data.rtx_l2tp_sessions.all.sessions : "${s.username} ${s.assigned_address} (${floor(s.uptime_seconds / 60)} min)"
+  ]
+}
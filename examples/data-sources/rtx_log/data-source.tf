@@ -0,0 +1,9 @@
+# Inspect the latest PPPoE events
+data "rtx_log" "pppoe" {
+  keyword    = "PPPoE"
+  tail_lines = 20
+}
+
+output "pppoe_events" {
+  value = [for e in data.rtx_log.pppoe.entries : "${e.timestamp} ${e.message}"]
+}
@@ -0,0 +1,11 @@
+data "rtx_nat_descriptors" "all" {}
+
+# Next free descriptor ID above 1000, for use when planning a new descriptor
+output "next_nat_descriptor_id" {
+  value = max(1000, data.rtx_nat_descriptors.all.ids...) + 1
+}
+
+# Descriptors bound to the PPPoE uplink
+output "pp1_nat_descriptors" {
+  value = [for d in data.rtx_nat_descriptors.all.descriptors : d.id if contains(d.interfaces, "pp1")]
+}
@@ -0,0 +1,11 @@
+data "rtx_netvolante_status" "wan" {
+  interface = "pp 1"
+}
+
+# Point a record in an external DNS zone at the router's current address
+output "netvolante_record" {
+  value = {
+    name    = data.rtx_netvolante_status.wan.hostname
+    address = data.rtx_netvolante_status.wan.ip_address
+  }
+}
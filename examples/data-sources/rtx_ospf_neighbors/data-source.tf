@@ -0,0 +1,9 @@
+data "rtx_ospf_neighbors" "all" {}
+
+# Warn when an adjacency is stuck below 2way/full (DROTHER pairs stay in 2way)
+check "ospf_adjacencies" {
+  assert {
+    condition     = alltrue([for n in data.rtx_ospf_neighbors.all.neighbors : contains(["full", "2way"], n.state)])
+    error_message = "Some OSPF adjacencies are not established."
+  }
+}
@@ -0,0 +1,14 @@
+data "rtx_ping" "upstream_dns" {
+  host             = "8.8.8.8"
+  count            = 3
+  timeout          = 2
+  source_interface = "lan1"
+}
+
+# Smoke test after a routing or filter change
+check "internet_reachable" {
+  assert {
+    condition     = data.rtx_ping.upstream_dns.reachable && data.rtx_ping.upstream_dns.packet_loss_percent < 50
+    error_message = "8.8.8.8 is not reachable from the LAN address (${data.rtx_ping.upstream_dns.packet_loss_percent}% loss)."
+  }
+}
@@ -0,0 +1,11 @@
+data "rtx_pp_status" "isp" {
+  pp_number = 1
+}
+
+output "wan_address" {
+  value = data.rtx_pp_status.isp.local_address
+}
+
+output "isp_dns_servers" {
+  value = data.rtx_pp_status.isp.dns_servers
+}
@@ -0,0 +1,11 @@
+data "rtx_qos_status" "wan" {
+  interface = "lan2"
+}
+
+# The voice class (class 1) must not drop packets
+check "voice_class_no_drops" {
+  assert {
+    condition     = alltrue([for c in data.rtx_qos_status.wan.classes : c.dropped_packets == 0 if c.class == 1])
+    error_message = "Voice traffic is being dropped on lan2."
+  }
+}
@@ -0,0 +1,9 @@
+locals {
+  lan_network = "192.168.1.0/24"
+}
+
+resource "rtx_nat_masquerade" "lan" {
+  descriptor_id = 1000
+  outer_address = "ipcp"
+  inner_network = provider::rtx::cidr_to_range(local.lan_network) # "192.168.1.0-192.168.1.255"
+}
@@ -0,0 +1,23 @@
+locals {
+  servers = {
+    web  = { address = "192.168.1.10", port = "www" }
+    mail = { address = "192.168.1.11", port = "submission" }
+    dns  = { address = "192.168.1.12", port = "domain" }
+  }
+}
+
+# One filter per published server, numbered from 2000
+resource "rtx_config_block" "published_servers" {
+  name = "published-servers"
+
+  lines = [
+    for i, name in sort(keys(local.servers)) : provider::rtx::ip_filter_rule(2000 + i, {
+      action      = "pass"
+      source      = "*"
+      destination = local.servers[name].address
+      protocol    = "tcp"
+      dest_port   = local.servers[name].port
+    })
+  ]
+  # ["ip filter 2000 pass * 192.168.1.12 tcp * domain", "ip filter 2001 pass * 192.168.1.11 tcp * submission", ...]
+}
@@ -0,0 +1,15 @@
+variable "printer_mac" {
+  type    = string
+  default = "0011.2233.44AA"
+
+  validation {
+    condition     = can(provider::rtx::normalize_mac(var.printer_mac))
+    error_message = "printer_mac must be a MAC address."
+  }
+}
+
+resource "rtx_dhcp_binding" "printer" {
+  scope_id    = 1
+  ip_address  = "192.168.1.20"
+  mac_address = provider::rtx::normalize_mac(var.printer_mac) # "00:11:22:33:44:aa"
+}
@@ -0,0 +1,23 @@
+locals {
+  published_services = ["www", "smtp", "submission"]
+}
+
+resource "rtx_access_list_ip" "wan_services" {
+  name           = "wan-services"
+  sequence_start = 200
+
+  dynamic "entry" {
+    for_each = local.published_services
+    content {
+      action      = "pass"
+      source      = "*"
+      destination = "192.168.1.10"
+      protocol    = "tcp"
+      dest_port   = provider::rtx::service_port(entry.value) # "80", "25", "587"
+    }
+  }
+}
+
+output "submission_keyword" {
+  value = provider::rtx::service_port("587") # "submission"
+}
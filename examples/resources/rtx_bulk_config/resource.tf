@@ -0,0 +1,10 @@
+# Push the initial configuration of a new branch router in one transfer
+resource "rtx_bulk_config" "initial" {
+  content = templatefile("${path.module}/branch.conf.tftpl", {
+    lan_address = "192.168.10.1/24"
+    pp_user     = var.pp_user
+  })
+
+  transport = "sftp"
+  restart   = true
+}
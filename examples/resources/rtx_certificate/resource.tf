@@ -0,0 +1,6 @@
+# Server certificate for IKEv2, uploaded via SFTP
+resource "rtx_certificate" "ikev2" {
+  cert_id         = 1
+  certificate_pem = file("${path.module}/certs/rtx.crt")
+  private_key_pem = var.rtx_private_key_pem
+}
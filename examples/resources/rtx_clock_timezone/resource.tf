@@ -0,0 +1,11 @@
+# JST with a nightly NTP sync, serving time to LAN clients
+resource "rtx_clock_timezone" "main" {
+  timezone    = "+09:00"
+  sntp_server = true
+
+  ntp_sync {
+    schedule_id = 30
+    time        = "03:00"
+    server      = "ntp.nict.jp"
+  }
+}
@@ -0,0 +1,21 @@
+# Intrusion detection on LAN1 is not modeled by a dedicated resource yet.
+# The block owns every "ip lan1 intrusion ..." line: others are removed.
+resource "rtx_config_block" "lan1_intrusion" {
+  name           = "lan1-intrusion"
+  owned_prefixes = ["ip lan1 intrusion detection"]
+
+  lines = [
+    "ip lan1 intrusion detection in on",
+    "ip lan1 intrusion detection in reject on",
+  ]
+}
+
+# Lines inside a tunnel context
+resource "rtx_config_block" "tunnel1_tuning" {
+  name    = "tunnel1-tuning"
+  context = "tunnel select 1"
+
+  lines = [
+    "ip tunnel tcp mss limit auto",
+  ]
+}
@@ -0,0 +1,26 @@
+# Send queries for the corporate domain to the internal resolvers
+resource "rtx_dns_server_select" "corp" {
+  selector_id   = 10
+  query_pattern = "*.corp.example.com"
+
+  server {
+    address = "10.0.0.53"
+    edns    = true
+  }
+
+  server {
+    address = "10.0.1.53"
+  }
+}
+
+# AAAA lookups for the lab domain from the lab network only
+resource "rtx_dns_server_select" "lab_v6" {
+  selector_id     = 20
+  record_type     = "aaaa"
+  query_pattern   = "*.lab.example.com"
+  original_sender = "192.168.50.0/24"
+
+  server {
+    address = "192.168.50.1"
+  }
+}
@@ -0,0 +1,21 @@
+# One resource per host, driven by a map
+locals {
+  lan_hosts = {
+    "nas.home.local"     = "192.168.1.20"
+    "printer.home.local" = "192.168.1.30"
+  }
+}
+
+resource "rtx_dns_static_host" "lan" {
+  for_each = local.lan_hosts
+
+  name      = each.key
+  addresses = [each.value]
+}
+
+# A name resolving to several addresses with a custom TTL
+resource "rtx_dns_static_host" "web" {
+  name      = "web.home.local"
+  addresses = ["192.168.1.40", "192.168.1.41"]
+  ttl       = 300
+}
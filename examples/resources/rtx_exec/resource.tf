@@ -0,0 +1,20 @@
+# Take the old uplink down for a cutover, and back up on rollback.
+# Skipped when the uplink is already down.
+resource "rtx_exec" "disconnect_old_uplink" {
+  commands         = ["disconnect pp 1"]
+  destroy_commands = ["connect pp 1"]
+
+  guard {
+    command      = "show status pp 1"
+    skip_pattern = "(?i)not connected"
+  }
+}
+
+# Flush dynamic NAT sessions whenever the masquerade rules change
+resource "rtx_exec" "nat_flush" {
+  commands = ["clear nat descriptor dynamic 1000"]
+
+  triggers = {
+    masquerade = sha1(jsonencode(rtx_nat_masquerade.main.static_entry))
+  }
+}
@@ -0,0 +1,11 @@
+# Back up the configuration to USB on every save, plus a nightly copy
+resource "rtx_external_memory_backup" "main" {
+  auto_backup     = true
+  config_filename = "usb1:/config.txt"
+
+  schedule {
+    schedule_id = 10
+    time        = "03:30"
+    destination = "usb1:/nightly.txt"
+  }
+}
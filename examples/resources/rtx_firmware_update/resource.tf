@@ -0,0 +1,10 @@
+# Allow HTTP firmware updates and check for new revisions every night
+resource "rtx_firmware_update" "main" {
+  allow_update = true
+  timeout      = 60
+
+  auto_update {
+    schedule_id = 20
+    time        = "04:15"
+  }
+}
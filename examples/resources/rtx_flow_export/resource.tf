@@ -0,0 +1,7 @@
+# Export sampled flow records from the WAN and LAN interfaces
+resource "rtx_flow_export" "main" {
+  collector_address = "192.168.1.50"
+  collector_port    = 2055
+  sampling_rate     = 100
+  interfaces        = ["lan1", "pp1"]
+}
@@ -0,0 +1,7 @@
+# Hide the router from scans on the Internet side and stop sending redirects
+resource "rtx_icmp_stealth" "main" {
+  stealth_interfaces = ["pp1"]
+  echo_reply         = true
+  unreachable        = true
+  redirect           = false
+}
@@ -0,0 +1,31 @@
+# Site-to-site IKEv2 tunnel with pre-shared key authentication
+resource "rtx_ikev2_tunnel" "branch" {
+  tunnel_id      = 10
+  local_address  = "203.0.113.1"
+  remote_address = "198.51.100.1"
+  local_auth     = "psk"
+  remote_auth    = "psk"
+  pre_shared_key = var.branch_psk
+
+  ike_encryption = ["aes-cbc-256"]
+  ike_integrity  = ["sha256"]
+  ike_groups     = ["modp2048"]
+  esp_encryption = "aes-gcm-256"
+
+  keepalive_interval = 10
+  keepalive_retry    = 3
+}
+
+# Certificate authentication using a certificate managed by rtx_certificate
+resource "rtx_ikev2_tunnel" "hq" {
+  tunnel_id      = 11
+  remote_address = "vpn.example.com"
+  local_id       = "rtx.example.com"
+  local_id_type  = "fqdn"
+  remote_id      = "vpn.example.com"
+  remote_id_type = "fqdn"
+  local_auth     = "certificate"
+  remote_auth    = "certificate"
+  certificate_id = rtx_certificate.vpn.cert_id
+  nat_traversal  = true
+}
@@ -0,0 +1,18 @@
+# Monitor reachability of the primary ISP by pinging a public resolver
+resource "rtx_ip_keepalive" "primary_wan" {
+  keepalive_id = 1
+  interval     = 10
+  count        = 3
+  target       = "8.8.8.8"
+}
+
+# Monitor the secondary line with damping and syslog output
+resource "rtx_ip_keepalive" "backup_wan" {
+  keepalive_id = 2
+  interval     = 5
+  count        = 3
+  target       = "192.0.2.1"
+  upwait       = 30
+  downwait     = 10
+  syslog       = true
+}
@@ -0,0 +1,26 @@
+# Allow HTTPS to an internal IPv6 server
+resource "rtx_ipv6_filter" "allow_https" {
+  filter_id   = 1010
+  action      = "pass"
+  source      = "*"
+  destination = "2001:db8:1::10"
+  protocol    = "tcp"
+  dest_port   = "443"
+}
+
+# Allow ICMPv6 (required for neighbor discovery and path MTU discovery)
+resource "rtx_ipv6_filter" "allow_icmp6" {
+  filter_id   = 1011
+  action      = "pass"
+  source      = "*"
+  destination = "*"
+  protocol    = "icmp6"
+}
+
+# Reject and log traffic from a documentation prefix
+resource "rtx_ipv6_filter" "reject_doc_prefix" {
+  filter_id   = 1012
+  action      = "reject-log"
+  source      = "2001:db8:dead::/48"
+  destination = "*"
+}
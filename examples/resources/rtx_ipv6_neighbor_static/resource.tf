@@ -0,0 +1,13 @@
+# Pin the IPv6 address of a NAS to its MAC address
+resource "rtx_ipv6_neighbor_static" "nas" {
+  interface   = "lan1"
+  address     = "2001:db8::10"
+  mac_address = "00:a0:de:01:02:03"
+}
+
+# Link-local neighbor on a bridge interface
+resource "rtx_ipv6_neighbor_static" "printer" {
+  interface   = "bridge1"
+  address     = "fe80::20"
+  mac_address = "00:a0:de:04:05:06"
+}
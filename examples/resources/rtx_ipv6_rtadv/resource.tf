@@ -0,0 +1,17 @@
+# Advertise the delegated prefix on LAN1 and hand out DNS settings via RA
+resource "rtx_ipv6_rtadv" "lan1" {
+  interface  = "lan1"
+  prefix_ids = [1]
+
+  o_flag = true
+
+  max_interval    = 600
+  min_interval    = 200
+  router_lifetime = 1800
+
+  # PPPoE uplink: keep clients below the tunnel MTU
+  mtu = 1454
+
+  rdnss = ["2001:db8::53"]
+  dnssl = ["example.com"]
+}
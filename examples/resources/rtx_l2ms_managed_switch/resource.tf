@@ -0,0 +1,18 @@
+# Manage the switch connected to LAN1 port 1
+resource "rtx_l2ms_managed_switch" "floor1" {
+  interface   = "lan1"
+  switch      = "00:a0:de:01:02:03"
+  system_name = "floor1"
+
+  # Guest access port
+  port {
+    port        = 7
+    access_vlan = 20
+  }
+
+  # Unused port
+  port {
+    port    = 8
+    enabled = false
+  }
+}
@@ -0,0 +1,6 @@
+# Bundle LAN2 and LAN3 into an LACP group towards the core switch
+resource "rtx_link_aggregation" "uplink" {
+  group_id = 1
+  mode     = "lacp"
+  members  = ["lan2", "lan3"]
+}
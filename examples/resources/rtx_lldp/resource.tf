@@ -0,0 +1,14 @@
+# Advertise and learn neighbors on the LAN side only
+resource "rtx_lldp" "main" {
+  enabled = true
+
+  port {
+    interface = "lan1"
+    mode      = "txrx"
+  }
+
+  port {
+    interface = "lan2"
+    mode      = "disable"
+  }
+}
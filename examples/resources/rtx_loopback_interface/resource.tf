@@ -0,0 +1,15 @@
+# Stable address for the OSPF router ID and management access
+resource "rtx_loopback_interface" "router_id" {
+  name    = "loopback1"
+  address = "10.255.0.1/32"
+}
+
+resource "rtx_ospf" "main" {
+  process_id = 1
+  router_id  = rtx_loopback_interface.router_id.ip_address
+
+  area {
+    area_id = "0.0.0.0"
+    type    = "normal"
+  }
+}
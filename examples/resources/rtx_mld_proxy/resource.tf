@@ -0,0 +1,13 @@
+# MLD proxy for FLET'S TV style IPv6 multicast delivery
+# lan2 faces the NGN (upstream), lan1 serves the home network (downstream)
+resource "rtx_mld_proxy" "flets_tv" {
+  upstream_interface    = "lan2"
+  downstream_interfaces = ["lan1"]
+  version               = "2"
+
+  # Keep a group joined even when no listener is present on the LAN
+  static_join {
+    interface = "lan1"
+    group     = "ff3e::1234"
+  }
+}
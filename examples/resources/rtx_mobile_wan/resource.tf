@@ -0,0 +1,42 @@
+# LTE backup uplink through a USB dongle, dialed on demand
+resource "rtx_mobile_wan" "lte" {
+  pp_number = 2
+  interface = "usb1"
+
+  apn         = "internet.example.jp"
+  pdp_type    = "ipv4v6"
+  auth_method = "chap"
+  username    = "user@example.jp"
+  password    = var.lte_password
+  pin         = var.sim_pin
+
+  always_on       = false
+  auto_connect    = true
+  disconnect_time = 300
+}
+
+# Default route: PPPoE primary, LTE used only while the primary is down
+resource "rtx_static_route" "default" {
+  prefix = "0.0.0.0"
+  mask   = "0.0.0.0"
+
+  next_hop {
+    interface    = "pp 1"
+    keepalive_id = 1
+  }
+
+  next_hop {
+    interface = "pp ${rtx_mobile_wan.lte.pp_number}"
+    distance  = 0
+  }
+}
+
+variable "lte_password" {
+  type      = string
+  sensitive = true
+}
+
+variable "sim_pin" {
+  type      = string
+  sensitive = true
+}
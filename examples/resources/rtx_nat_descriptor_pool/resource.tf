@@ -0,0 +1,18 @@
+# Descriptor IDs for the NAT resources below, picked among the IDs the router does not use yet
+resource "rtx_nat_descriptor_pool" "main" {
+  range_start = 1000
+  range_end   = 1999
+  names       = ["office", "guest"]
+}
+
+resource "rtx_nat_masquerade" "office" {
+  descriptor_id = rtx_nat_descriptor_pool.main.ids["office"]
+  outer_address = "ipcp"
+  inner_network = "192.168.1.0/24"
+}
+
+resource "rtx_nat_masquerade" "guest" {
+  descriptor_id = rtx_nat_descriptor_pool.main.ids["guest"]
+  outer_address = "ipcp"
+  inner_network = "192.168.20.0/24"
+}
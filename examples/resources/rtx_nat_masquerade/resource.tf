@@ -29,3 +29,22 @@ resource "rtx_nat_masquerade" "with_port_forwarding" {
     protocol            = "tcp"
   }
 }
+
+# Static entries identified by their content; the provider numbers them
+resource "rtx_nat_masquerade" "numbered_by_provider" {
+  descriptor_id = 3
+  outer_address = "pp1"
+  inner_network = "192.168.3.0-192.168.3.255"
+
+  static_entry {
+    inside_local        = "192.168.3.10"
+    inside_local_port   = 443
+    outside_global_port = 443
+    protocol            = "tcp"
+  }
+
+  static_entry {
+    inside_local = "192.168.3.20"
+    protocol     = "esp"
+  }
+}
@@ -0,0 +1,7 @@
+# Copy traffic of LAN1 switch ports 2 and 3 to port 4 for a packet capture
+resource "rtx_port_mirroring" "capture" {
+  interface        = "lan1"
+  destination_port = 4
+  source_ports     = [2, 3]
+  direction        = "both"
+}
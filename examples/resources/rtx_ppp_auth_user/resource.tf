@@ -0,0 +1,13 @@
+# Remote access VPN user authenticated in the anonymous PP context
+resource "rtx_ppp_auth_user" "alice" {
+  username = "alice"
+  password = var.alice_vpn_password
+}
+
+# User with a fixed address
+resource "rtx_ppp_auth_user" "bob" {
+  pp         = "anonymous"
+  username   = "bob"
+  password   = var.bob_vpn_password
+  ip_address = "192.168.100.10"
+}
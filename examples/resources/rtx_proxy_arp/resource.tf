@@ -0,0 +1,5 @@
+# Hosts on LAN1 and LAN2 share 192.168.1.0/24; the router answers ARP
+# requests for hosts on the other segment
+resource "rtx_proxy_arp" "main" {
+  interfaces = ["lan1", "lan2"]
+}
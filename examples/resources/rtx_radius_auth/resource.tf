@@ -0,0 +1,9 @@
+# Authenticate PPP and login users against a primary and backup RADIUS server
+resource "rtx_radius_auth" "main" {
+  servers = ["192.168.1.10", "192.168.1.11"]
+  secret  = var.radius_secret
+
+  accounting = true
+  retry      = 3
+  timeout    = 5
+}
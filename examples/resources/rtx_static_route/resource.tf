@@ -1,8 +1,10 @@
-# Default route through a gateway
+# Default route through a gateway, kept on the router if the resource is destroyed
 resource "rtx_static_route" "default" {
   prefix = "0.0.0.0"
   mask   = "0.0.0.0"
 
+  prevent_destroy_on_device = true
+
   next_hop {
     gateway  = "192.168.0.1"
     distance = 1
@@ -20,7 +22,7 @@ resource "rtx_static_route" "private" {
   }
 }
 
-# Route through PPPoE interface with failover
+# Route through PPPoE interface with weighted load balancing
 resource "rtx_static_route" "internet" {
   prefix = "0.0.0.0"
   mask   = "0.0.0.0"
@@ -32,13 +34,38 @@ resource "rtx_static_route" "internet" {
     permanent = true
   }
 
-  # Backup: Secondary gateway
+  # Secondary gateway receives ten times the share of new sessions
   next_hop {
     gateway  = "192.168.0.254"
     distance = 10
   }
 }
 
+# Dual-WAN failover: primary PPPoE monitored by a keepalive, LTE as backup
+resource "rtx_ip_keepalive" "primary_wan" {
+  keepalive_id = 1
+  interval     = 10
+  count        = 3
+  target       = "8.8.8.8"
+}
+
+resource "rtx_static_route" "dual_wan" {
+  prefix = "0.0.0.0"
+  mask   = "0.0.0.0"
+
+  # Primary: withdrawn while keepalive 1 reports the target unreachable
+  next_hop {
+    interface    = "pp 1"
+    keepalive_id = rtx_ip_keepalive.primary_wan.keepalive_id
+  }
+
+  # Backup: weight 0 is used only while the primary is down
+  next_hop {
+    interface = "pp 2"
+    distance  = 0
+  }
+}
+
 # VPN tunnel route
 resource "rtx_static_route" "vpn" {
   prefix = "192.168.100.0"
@@ -0,0 +1,8 @@
+# Keep IPv4 forwarding on the fast path but process IPv6 in software
+resource "rtx_system_settings" "main" {
+  ip_routing             = true
+  ipv6_routing           = true
+  ip_routing_process     = "fast"
+  ipv6_routing_process   = "normal"
+  ip_filter_source_route = true
+}
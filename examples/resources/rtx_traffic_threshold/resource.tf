@@ -0,0 +1,13 @@
+# Log a notice once the LTE backup link has moved 10 GB
+resource "rtx_traffic_threshold" "lte_notice" {
+  interface = "lan3"
+  direction = "both"
+  megabytes = 10240
+}
+
+# Disconnect the metered PPP session after 30 GB
+resource "rtx_traffic_threshold" "lte_cap" {
+  interface = "pp2"
+  megabytes = 30720
+  action    = "disconnect"
+}
@@ -0,0 +1,6 @@
+# Keep the LTE dongle usable and block USB storage devices
+resource "rtx_usb_host" "main" {
+  enabled         = true
+  modem_enabled   = true
+  storage_enabled = false
+}
@@ -0,0 +1,6 @@
+# Address pool for L2TP/IPsec remote access clients, managed apart from rtx_l2tp
+resource "rtx_vpn_address_pool" "remote_access" {
+  start          = "192.168.100.10"
+  end            = "192.168.100.50"
+  dns_to_clients = true
+}
@@ -0,0 +1,23 @@
+# Wireless LAN with a WPA2/WPA3 mixed-mode SSID and an open guest network
+resource "rtx_wlan" "main" {
+  enabled = true
+  channel = 0 # automatic selection
+
+  ssid {
+    id       = 1
+    name     = "office"
+    security = "wpa2-wpa3-psk"
+    psk      = var.wlan_psk
+  }
+
+  ssid {
+    id       = 2
+    name     = "guest"
+    security = "none"
+  }
+}
+
+variable "wlan_psk" {
+  type      = string
+  sensitive = true
+}
@@ -115,7 +115,7 @@ func (s *ACLService) ReadACLEntries(ctx context.Context, aclType ACLType, sequen
 		return nil, fmt.Errorf("failed to read ACL entries: %w", err)
 	}
 
-	entries, err := s.parseEntries(aclType, string(output))
+	entries, err := s.parseEntries(ctx, aclType, string(output))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse ACL entries: %w", err)
 	}
@@ -457,10 +457,10 @@ func (s *ACLService) buildDeleteCommand(aclType ACLType, sequence int) string {
 }
 
 // parseEntries parses the CLI output into ACL entries
-func (s *ACLService) parseEntries(aclType ACLType, output string) ([]ACLEntry, error) {
+func (s *ACLService) parseEntries(ctx context.Context, aclType ACLType, output string) ([]ACLEntry, error) {
 	switch aclType {
 	case ACLTypeIP, ACLTypeExtended:
-		return s.parseIPFilters(output)
+		return s.parseIPFilters(ctx, output), nil
 	case ACLTypeIPv6:
 		return s.parseIPv6Filters(output)
 	case ACLTypeMAC:
@@ -471,11 +471,8 @@ func (s *ACLService) parseEntries(aclType ACLType, output string) ([]ACLEntry, e
 }
 
 // parseIPFilters parses IP filter output into ACL entries
-func (s *ACLService) parseIPFilters(output string) ([]ACLEntry, error) {
-	filters, err := parsers.ParseIPFilterConfig(output)
-	if err != nil {
-		return nil, err
-	}
+func (s *ACLService) parseIPFilters(ctx context.Context, output string) []ACLEntry {
+	filters := parseIPFilterList(ctx, output)
 
 	entries := make([]ACLEntry, len(filters))
 	for i, f := range filters {
@@ -495,7 +492,7 @@ func (s *ACLService) parseIPFilters(output string) ([]ACLEntry, error) {
 		}
 	}
 
-	return entries, nil
+	return entries
 }
 
 // parseIPv6Filters parses IPv6 filter output into ACL entries
@@ -0,0 +1,44 @@
+package client
+
+import "strings"
+
+// userLevelCommandPrefixes are commands that RTX routers accept without
+// administrator privileges. "console" is not listed: console character,
+// console columns, console lines and console prompt change the terminal
+// settings and are configuration commands.
+var userLevelCommandPrefixes = []string{
+	"show ",        // show commands (show config, show status, show sshd host key, etc.)
+	"less ",        // pager commands
+	"ping ",        // reachability checks
+	"ping6 ",       // IPv6 reachability checks
+	"traceroute ",  // path checks
+	"traceroute6 ", // IPv6 path checks
+}
+
+// adminRequiredPatterns are fragments of RTX error lines reporting that a
+// command can only be used at administrator level (English and Japanese)
+var adminRequiredPatterns = []string{
+	"administrator level",
+	"administrator only",
+	"管理レベルでのみ",
+}
+
+// IsAdminRequiredOutput reports whether command output contains an RTX error
+// line saying that the command needs administrator level. Executors use it to
+// escalate and rerun commands that were sent at user level.
+func IsAdminRequiredOutput(output []byte) bool {
+	for _, line := range strings.Split(string(output), "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimPrefix(line, "% ")
+		if !strings.HasPrefix(line, "Error:") && !strings.HasPrefix(line, "エラー:") && !strings.Contains(line, "管理レベル") {
+			continue
+		}
+		lower := strings.ToLower(line)
+		for _, pattern := range adminRequiredPatterns {
+			if strings.Contains(lower, pattern) {
+				return true
+			}
+		}
+	}
+	return false
+}
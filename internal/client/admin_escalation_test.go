@@ -0,0 +1,50 @@
+package client
+
+import "testing"
+
+func TestIsAdminRequiredOutput(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   bool
+	}{
+		{
+			name:   "japanese message",
+			output: "ip route default gateway 192.168.0.1\r\nエラー: このコマンドは管理レベルでのみ使用できます\r\n> ",
+			want:   true,
+		},
+		{
+			name:   "japanese message without error prefix",
+			output: "管理レベルでのみ使用できます\r\n> ",
+			want:   true,
+		},
+		{
+			name:   "english message",
+			output: "Error: This command can be used only at administrator level\r\n> ",
+			want:   true,
+		},
+		{
+			name:   "other error",
+			output: "Error: Invalid parameter\r\n# ",
+			want:   false,
+		},
+		{
+			name:   "regular output mentioning administrator level",
+			output: "description \"administrator level access\"\r\n# ",
+			want:   false,
+		},
+		{
+			name:   "no output",
+			output: "# ",
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAdminRequiredOutput([]byte(tt.output)); got != tt.want {
+				t.Errorf("IsAdminRequiredOutput() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
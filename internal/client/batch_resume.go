@@ -0,0 +1,216 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// maxBatchResumes bounds how often one batch is resumed after losing the router
+const maxBatchResumes = 3
+
+// selectCommandPattern matches the commands that enter a PP or tunnel section
+var selectCommandPattern = regexp.MustCompile(`^(pp|tunnel) select (\S+)$`)
+
+// BatchCommandError reports which command of a batch failed. The commands
+// before it completed; the failed one may or may not have taken effect.
+type BatchCommandError struct {
+	Index   int    // Position of the failed command in the batch
+	Command string // The failed command
+	Err     error
+}
+
+func (e *BatchCommandError) Error() string {
+	return fmt.Sprintf("batch command '%s' failed: %v", e.Command, e.Err)
+}
+
+func (e *BatchCommandError) Unwrap() error {
+	return e.Err
+}
+
+// resumingExecutor resumes batches interrupted by a dropped connection, such
+// as when the router reboots in the middle of an apply. It waits for the
+// router to come back, checks in the running configuration which commands
+// are in effect, since a reboot discards unsaved changes, and sends the ones
+// that are not together with the rest of the batch.
+type resumingExecutor struct {
+	inner     Executor
+	timeout   time.Duration // How long the router may take to come back
+	reconnect RetryStrategy // Pauses between checks while the router is away
+}
+
+// newResumingExecutor wraps an executor so that interrupted batches are resumed
+func newResumingExecutor(inner Executor, config *Config) *resumingExecutor {
+	timeouts := DefaultTimeouts()
+	if config != nil {
+		timeouts = config.Timeouts.withDefaults()
+	}
+	return &resumingExecutor{
+		inner:     inner,
+		timeout:   timeouts.LongOperation,
+		reconnect: &ExponentialBackoff{BaseDelay: 2 * time.Second, MaxDelay: 30 * time.Second, MaxRetries: 1000},
+	}
+}
+
+// Run executes a single command; single commands are retried by the executor below
+func (e *resumingExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
+	return e.inner.Run(ctx, cmd)
+}
+
+// RunBatch executes the batch, resuming it after the connection to the router was lost
+func (e *resumingExecutor) RunBatch(ctx context.Context, cmds []string) ([]byte, error) {
+	output, err := e.inner.RunBatch(ctx, cmds)
+	for resumes := 0; err != nil; resumes++ {
+		var batchErr *BatchCommandError
+		if !errors.As(err, &batchErr) || !IsRetryable(batchErr.Err) || ctx.Value(noRetryKey{}) != nil ||
+			restartsRouter(batchErr.Command) || resumes >= maxBatchResumes {
+			return output, err
+		}
+
+		logger := logging.FromContext(ctx)
+		logger.Warn().Err(batchErr.Err).Int("index", batchErr.Index).Int("commands", len(cmds)).
+			Msg("Lost the router during a batch, waiting for it to resume")
+
+		config, waitErr := e.waitForRouter(ctx)
+		if waitErr != nil {
+			return output, fmt.Errorf("%w (router did not come back: %v)", err, waitErr)
+		}
+
+		cmds = resumeCommands(config, cmds, batchErr.Index)
+		logger.Info().Int("commands", len(cmds)).Msg("Router is back, resuming batch")
+
+		var more []byte
+		more, err = e.inner.RunBatch(ctx, cmds)
+		output = append(output, more...)
+	}
+	return output, nil
+}
+
+// SetAdministratorPassword changes the administrator password
+func (e *resumingExecutor) SetAdministratorPassword(ctx context.Context, oldPassword, newPassword string) error {
+	return e.inner.SetAdministratorPassword(ctx, oldPassword, newPassword)
+}
+
+// SetLoginPassword changes the login password
+func (e *resumingExecutor) SetLoginPassword(ctx context.Context, newPassword string) error {
+	return e.inner.SetLoginPassword(ctx, newPassword)
+}
+
+// GenerateSSHDHostKey generates the SSHD host key
+func (e *resumingExecutor) GenerateSSHDHostKey(ctx context.Context) error {
+	return e.inner.GenerateSSHDHostKey(ctx)
+}
+
+// waitForRouter polls the router with backoff until it answers "show config",
+// and returns the configuration it is running
+func (e *resumingExecutor) waitForRouter(ctx context.Context) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+
+	for attempt := 0; ; attempt++ {
+		output, err := e.inner.Run(withoutRetry(ctx), "show config")
+		if err == nil && rejectionLine(string(output)) == "" {
+			return string(output), nil
+		}
+
+		delay, giveUp := e.reconnect.Next(attempt)
+		if giveUp {
+			return "", fmt.Errorf("gave up after %d attempts", attempt+1)
+		}
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("%w: %v", ErrTimeout, ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+}
+
+// resumeCommands returns the commands to send to finish a batch that failed
+// at index failed: completed commands that are no longer in effect, the
+// failed command unless it took effect, and the commands after it. Section
+// select commands are always kept so that the commands run in their section.
+func resumeCommands(config string, cmds []string, failed int) []string {
+	var resumed []string
+	section, number := "", 0
+	resent := false
+	for i, cmd := range cmds {
+		normalized := strings.Join(strings.Fields(cmd), " ")
+		if matches := selectCommandPattern.FindStringSubmatch(normalized); matches != nil {
+			section, number = matches[1], 0
+			if n, err := strconv.Atoi(matches[2]); err == nil {
+				number = n
+			} else if matches[2] == "none" {
+				section = ""
+			}
+			resumed = append(resumed, cmd)
+			continue
+		}
+
+		switch {
+		case i > failed:
+		case strings.ToLower(normalized) == "save":
+			// Saving again is only needed when something was sent again
+			if !resent && i < failed {
+				continue
+			}
+		case commandInEffect(configScope(config, section, number), normalized):
+			continue
+		default:
+			resent = true
+		}
+		resumed = append(resumed, cmd)
+	}
+	return resumed
+}
+
+// configScope returns the configuration lines a command in the given section
+// is compared with: the lines of that section, or the whole configuration
+func configScope(config, section string, number int) []string {
+	if section != "" && number > 0 {
+		config = parsers.ExtractConfigSection(config, section, number)
+	}
+	var lines []string
+	for _, line := range strings.Split(config, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// commandInEffect reports whether the configuration reflects cmd: a setting
+// appears as a line, and a "no" command leaves no line for the setting.
+// Commands that do not change the configuration need no checking.
+func commandInEffect(lines []string, cmd string) bool {
+	if isReadOnlyCommand(cmd) {
+		return true
+	}
+	if target, ok := strings.CutPrefix(cmd, "no "); ok {
+		for _, line := range lines {
+			if line == target || strings.HasPrefix(line, target+" ") {
+				return false
+			}
+		}
+		return true
+	}
+	for _, line := range lines {
+		if line == cmd {
+			return true
+		}
+	}
+	return false
+}
+
+// restartsRouter reports whether cmd restarts the router, so that losing the
+// connection is expected rather than an interruption
+func restartsRouter(cmd string) bool {
+	normalized := strings.ToLower(strings.Join(strings.Fields(cmd), " "))
+	return normalized == "restart" || strings.HasPrefix(normalized, "restart ") || normalized == "cold start"
+}
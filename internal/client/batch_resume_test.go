@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResumeCommands(t *testing.T) {
+	config := `ip lan1 address 192.168.1.1/24
+ip route default gateway pp 1
+pp select 1
+ pp description "WAN"
+ pp always-on on
+pp enable 1
+`
+
+	tests := []struct {
+		name   string
+		cmds   []string
+		failed int
+		want   []string
+	}{
+		{
+			name:   "completed commands in effect are skipped",
+			cmds:   []string{"ip lan1 address 192.168.1.1/24", "ip route default gateway pp 1", "ip lan2 address 10.0.0.1/24", "ip lan3 address 10.0.1.1/24"},
+			failed: 2,
+			want:   []string{"ip lan2 address 10.0.0.1/24", "ip lan3 address 10.0.1.1/24"},
+		},
+		{
+			name:   "failed command that took effect is skipped",
+			cmds:   []string{"ip lan1 address 192.168.1.1/24", "ip route default gateway pp 1", "ip lan2 address 10.0.0.1/24"},
+			failed: 1,
+			want:   []string{"ip lan2 address 10.0.0.1/24"},
+		},
+		{
+			name:   "completed commands lost by a reboot are sent again",
+			cmds:   []string{"ip lan2 address 10.0.0.1/24", "ip lan1 address 192.168.1.1/24", "ip lan3 address 10.0.1.1/24"},
+			failed: 1,
+			want:   []string{"ip lan2 address 10.0.0.1/24", "ip lan3 address 10.0.1.1/24"},
+		},
+		{
+			name:   "section commands are checked in their section",
+			cmds:   []string{"pp select 1", "pp description \"WAN\"", "pp auth accept chap", "pp enable 1"},
+			failed: 2,
+			want:   []string{"pp select 1", "pp auth accept chap", "pp enable 1"},
+		},
+		{
+			name:   "no commands are in effect when the setting is gone",
+			cmds:   []string{"no ip lan2 address", "no ip lan1 address", "save"},
+			failed: 2,
+			want:   []string{"no ip lan1 address", "save"},
+		},
+		{
+			name:   "save is skipped when nothing was sent again",
+			cmds:   []string{"ip lan1 address 192.168.1.1/24", "save", "ip lan2 address 10.0.0.1/24"},
+			failed: 2,
+			want:   []string{"ip lan2 address 10.0.0.1/24"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, resumeCommands(config, tt.cmds, tt.failed))
+		})
+	}
+}
+
+func newTestResumingExecutor(inner Executor) *resumingExecutor {
+	e := newResumingExecutor(inner, nil)
+	e.reconnect = &LinearBackoff{Delay: time.Millisecond, MaxRetries: 5}
+	return e
+}
+
+func TestResumingExecutor_RunBatch(t *testing.T) {
+	t.Run("resumes after the router comes back", func(t *testing.T) {
+		cmds := []string{"ip lan1 address 192.168.1.1/24", "ip lan2 address 10.0.0.1/24", "ip lan3 address 10.0.1.1/24"}
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("RunBatch", mock.Anything, cmds).
+			Return([]byte("out1"), &BatchCommandError{Index: 1, Command: cmds[1], Err: io.EOF}).Once()
+		// The router is still rebooting on the first check
+		mockExecutor.On("Run", mock.Anything, "show config").Return(nil, errors.New("connection refused")).Once()
+		mockExecutor.On("Run", mock.Anything, "show config").Return([]byte("ip lan1 address 192.168.1.1/24\n"), nil).Once()
+		mockExecutor.On("RunBatch", mock.Anything, cmds[1:]).Return([]byte("out2"), nil).Once()
+
+		output, err := newTestResumingExecutor(mockExecutor).RunBatch(context.Background(), cmds)
+		require.NoError(t, err)
+		assert.Equal(t, "out1out2", string(output))
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("configuration text mentioning errors counts as answered", func(t *testing.T) {
+		cmds := []string{"ip lan1 address 192.168.1.1/24", "ip lan2 address 10.0.0.1/24"}
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("RunBatch", mock.Anything, cmds).
+			Return([]byte("out1"), &BatchCommandError{Index: 1, Command: cmds[1], Err: io.EOF}).Once()
+		// A rejected probe is retried, a configuration that merely mentions errors is not
+		mockExecutor.On("Run", mock.Anything, "show config").Return([]byte("Error: Command not available yet\n"), nil).Once()
+		mockExecutor.On("Run", mock.Anything, "show config").
+			Return([]byte("ip lan1 address 192.168.1.1/24\ndescription lan1 \"uplink not found error: see notes\"\n"), nil).Once()
+		mockExecutor.On("RunBatch", mock.Anything, cmds[1:]).Return([]byte("out2"), nil).Once()
+
+		output, err := newTestResumingExecutor(mockExecutor).RunBatch(context.Background(), cmds)
+		require.NoError(t, err)
+		assert.Equal(t, "out1out2", string(output))
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("command errors are not resumed", func(t *testing.T) {
+		cmds := []string{"ip lan1 address 192.168.1.1/24"}
+		batchErr := &BatchCommandError{Index: 0, Command: cmds[0], Err: ErrCommandFailed}
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("RunBatch", mock.Anything, cmds).Return(nil, batchErr).Once()
+
+		_, err := newTestResumingExecutor(mockExecutor).RunBatch(context.Background(), cmds)
+		assert.ErrorIs(t, err, ErrCommandFailed)
+		mockExecutor.AssertNotCalled(t, "Run", mock.Anything, mock.Anything)
+	})
+
+	t.Run("restart is not resumed", func(t *testing.T) {
+		cmds := []string{"save", "restart"}
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("RunBatch", mock.Anything, cmds).
+			Return(nil, &BatchCommandError{Index: 1, Command: "restart", Err: io.EOF}).Once()
+
+		_, err := newTestResumingExecutor(mockExecutor).RunBatch(context.Background(), cmds)
+		assert.ErrorIs(t, err, io.EOF)
+		mockExecutor.AssertNotCalled(t, "Run", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// BGPPeerStatusService handles BGP session queries ("show status bgp neighbor")
+type BGPPeerStatusService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewBGPPeerStatusService creates a new BGP peer status service instance
+func NewBGPPeerStatusService(executor Executor, client *rtxClient) *BGPPeerStatusService {
+	return &BGPPeerStatusService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// List retrieves the BGP peer sessions, optionally limited to one peer address
+func (s *BGPPeerStatusService) List(ctx context.Context, address string) ([]BGPPeerStatus, error) {
+	cmd := parsers.BuildShowBGPPeerStatusCommand()
+	logging.FromContext(ctx).Debug().Str("service", "bgp_peer_status").Msgf("Listing BGP peers with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get BGP peers: %w", err)
+	}
+	if line := rejectionLine(string(output)); line != "" {
+		return nil, fmt.Errorf("failed to get BGP peers: %s", line)
+	}
+
+	peers := []BGPPeerStatus{}
+	for _, p := range parsers.ParseBGPPeerStatus(string(output)) {
+		if address != "" && p.Address != address {
+			continue
+		}
+		peers = append(peers, BGPPeerStatus(p))
+	}
+	return peers, nil
+}
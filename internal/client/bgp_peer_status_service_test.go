@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBGPPeerStatusService_List(t *testing.T) {
+	output := `BGP neighbor is 203.0.113.1, remote AS 65001, local AS 65000, external link
+  BGP version 4, remote router ID 203.0.113.1
+  BGP state = Established, up for 2d03h15m
+  Prefixes received 120, accepted 118, advertised 4
+
+BGP neighbor is 198.51.100.1, remote AS 65002, local AS 65000, external link
+  BGP state = Idle
+`
+
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show status bgp neighbor").Return([]byte(output), nil)
+
+	service := NewBGPPeerStatusService(mockExecutor, nil)
+
+	all, err := service.List(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	peers, err := service.List(context.Background(), "203.0.113.1")
+	assert.NoError(t, err)
+	assert.Equal(t, []BGPPeerStatus{
+		{Address: "203.0.113.1", RemoteAS: "65001", RouterID: "203.0.113.1", State: "established", Uptime: "2d03h15m", PrefixesReceived: 120, PrefixesAccepted: 118, PrefixesSent: 4},
+	}, peers)
+}
+
+func TestBGPPeerStatusService_List_Rejections(t *testing.T) {
+	t.Run("rejected command", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status bgp neighbor").Return([]byte("Error: BGP is not running\n"), nil)
+
+		service := NewBGPPeerStatusService(mockExecutor, nil)
+
+		_, err := service.List(context.Background(), "")
+		assert.ErrorContains(t, err, "Error: BGP is not running")
+	})
+
+	t.Run("session notes are not errors", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status bgp neighbor").Return([]byte(`BGP neighbor is 198.51.100.1, remote AS 65002, local AS 65000, external link
+  BGP state = Idle
+  Last error: Hold timer expired
+`), nil)
+
+		service := NewBGPPeerStatusService(mockExecutor, nil)
+
+		peers, err := service.List(context.Background(), "")
+		assert.NoError(t, err)
+		assert.Len(t, peers, 1)
+		assert.Equal(t, "idle", peers[0].State)
+	})
+}
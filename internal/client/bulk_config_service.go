@@ -0,0 +1,213 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Bulk config transports
+const (
+	BulkConfigTransportSFTP = "sftp"
+	BulkConfigTransportTFTP = "tftp"
+)
+
+const (
+	// tftpPacketTimeout is how long to wait for the router to acknowledge a TFTP packet
+	tftpPacketTimeout = 3 * time.Second
+	// restartGracePeriod is how long the router is left alone after "restart"
+	// before checking whether it is back
+	restartGracePeriod = 15 * time.Second
+	// restartPollInterval is the pause between checks while the router restarts
+	restartPollInterval = 5 * time.Second
+)
+
+// BulkConfigService writes a complete configuration file to the router in one
+// transfer, instead of sending it as individual commands
+type BulkConfigService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+
+	// newSFTPClient opens a fresh SFTP connection for uploads; nil when unavailable
+	newSFTPClient func(ctx context.Context) (SFTPClient, error)
+
+	tftpAddr       string          // Router TFTP address (host:69)
+	adminPassword  string          // Appended to TFTP file names as the router requires
+	encoding       ConsoleEncoding // Character encoding of the configuration file
+	restartTimeout time.Duration   // How long the router may take to come back after a restart
+	restartGrace   time.Duration
+	pollInterval   time.Duration
+}
+
+// NewBulkConfigService creates a new bulk config service instance
+func NewBulkConfigService(executor Executor, client *rtxClient) *BulkConfigService {
+	s := &BulkConfigService{
+		executor:       executor,
+		client:         client,
+		restartTimeout: DefaultTimeouts().LongOperation,
+		restartGrace:   restartGracePeriod,
+		pollInterval:   restartPollInterval,
+	}
+	if client != nil && client.config != nil {
+		config := client.config
+		s.newSFTPClient = func(ctx context.Context) (SFTPClient, error) {
+			return NewSFTPClient(ctx, config)
+		}
+		s.tftpAddr = net.JoinHostPort(config.Host, strconv.Itoa(tftpPort))
+		s.adminPassword = config.AdminPassword
+		s.encoding = config.ConsoleEncoding
+		s.restartTimeout = config.Timeouts.withDefaults().LongOperation
+	}
+	return s
+}
+
+// Push writes the configuration file, restarts the router to load it when
+// requested, and returns the configuration the router reports afterwards
+func (s *BulkConfigService) Push(ctx context.Context, bulk BulkConfig) (string, error) {
+	if err := validateBulkConfig(bulk); err != nil {
+		return "", fmt.Errorf("invalid bulk config: %w", err)
+	}
+
+	number := bulk.ConfigNumber
+	if number < 0 {
+		number = s.defaultConfigNumber(ctx)
+	}
+
+	content, err := newConsoleTranscoder(s.encoding).Encode(bulkConfigFile(bulk.Content))
+	if err != nil {
+		return "", err
+	}
+
+	logger := logging.FromContext(ctx).Info().Str("service", "bulk_config").Str("transport", bulk.Transport).Int("config", number)
+	if preview := commandPreviewFromContext(ctx); preview != nil {
+		preview.record(fmt.Sprintf("# write %d lines to config%d via %s", strings.Count(content, "\n"), number, strings.ToUpper(bulk.Transport)))
+		if bulk.Restart {
+			preview.record("restart")
+		}
+		return "", nil
+	}
+
+	// A pending batched save would overwrite the file with the old running configuration
+	if s.client != nil && s.client.saveScheduler != nil {
+		if err := s.client.saveScheduler.Flush(ctx); err != nil {
+			return "", fmt.Errorf("failed to save pending changes before the push: %w", err)
+		}
+	}
+
+	logger.Msgf("Pushing configuration file (%d bytes)", len(content))
+	if err := s.upload(ctx, bulk.Transport, number, []byte(content)); err != nil {
+		return "", err
+	}
+
+	if bulk.Restart {
+		if err := s.restart(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	if s.client != nil {
+		s.client.InvalidateCache()
+	}
+	output, err := s.executor.Run(ctx, parsers.BuildShowRunningConfigCommand(""))
+	if err != nil {
+		return "", fmt.Errorf("failed to read configuration after the push: %w", err)
+	}
+	return parsers.NormalizeRunningConfig(string(output)), nil
+}
+
+// upload writes content to configuration file number
+func (s *BulkConfigService) upload(ctx context.Context, transport string, number int, content []byte) error {
+	switch transport {
+	case BulkConfigTransportTFTP:
+		// The router only accepts configuration files named with the administrator password
+		filename := fmt.Sprintf("config%d", number)
+		if s.adminPassword != "" {
+			filename += "/" + s.adminPassword
+		}
+		if err := tftpPut(ctx, s.tftpAddr, filename, content, tftpPacketTimeout); err != nil {
+			return fmt.Errorf("failed to upload configuration via TFTP (is 'tftp host' allowing this host?): %w", err)
+		}
+		return nil
+	default:
+		if s.newSFTPClient == nil {
+			return fmt.Errorf("SFTP is required to upload the configuration")
+		}
+		sftpClient, err := s.newSFTPClient(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create SFTP client: %w", err)
+		}
+		defer sftpClient.Close()
+
+		if err := sftpClient.WriteFile(ctx, fmt.Sprintf("/system/config%d", number), content); err != nil {
+			return fmt.Errorf("failed to upload configuration via SFTP: %w", err)
+		}
+		return nil
+	}
+}
+
+// restart restarts the router and waits until it accepts commands again
+func (s *BulkConfigService) restart(ctx context.Context) error {
+	logger := logging.FromContext(ctx)
+
+	// The connection drops as the router restarts, so the command must not be retried
+	if _, err := s.executor.Run(withoutRetry(ctx), "restart"); err != nil {
+		logger.Debug().Str("service", "bulk_config").Err(err).Msg("Connection closed by restart")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.restartTimeout)
+	defer cancel()
+
+	wait := s.restartGrace
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: router did not come back after restart", ErrTimeout)
+		case <-time.After(wait):
+		}
+		if _, err := s.executor.Run(ctx, "show environment"); err == nil {
+			logger.Info().Str("service", "bulk_config").Msg("Router is back after restart")
+			return nil
+		}
+		wait = s.pollInterval
+	}
+}
+
+// defaultConfigNumber returns the configuration file the router loads at
+// startup, or 0 when it cannot be determined
+func (s *BulkConfigService) defaultConfigNumber(ctx context.Context) int {
+	output, err := s.executor.Run(ctx, "show environment")
+	if err != nil {
+		return 0
+	}
+	number, _ := parseConfigNumber(string(output))
+	return number
+}
+
+// validateBulkConfig checks the settings of a push
+func validateBulkConfig(bulk BulkConfig) error {
+	if strings.TrimSpace(bulk.Content) == "" {
+		return fmt.Errorf("content must not be empty")
+	}
+	switch bulk.Transport {
+	case BulkConfigTransportSFTP, BulkConfigTransportTFTP:
+	default:
+		return fmt.Errorf("transport must be %q or %q, got %q", BulkConfigTransportSFTP, BulkConfigTransportTFTP, bulk.Transport)
+	}
+	if bulk.ConfigNumber > 9 {
+		return fmt.Errorf("config_number must be between 0 and 9, got %d", bulk.ConfigNumber)
+	}
+	return nil
+}
+
+// bulkConfigFile formats configuration text as an RTX configuration file:
+// CRLF line endings and a final newline
+func bulkConfigFile(content string) string {
+	content = strings.TrimRight(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	return strings.ReplaceAll(content, "\n", "\r\n") + "\r\n"
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkConfigService_PushTFTP(t *testing.T) {
+	server := startTFTPTestServer(t, "", 0)
+
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show environment").Return([]byte("Default config file: config1\n"), nil).Once()
+	mockExecutor.On("Run", mock.Anything, "restart").Return(nil, errors.New("connection closed")).Once()
+	// The router is still down on the first check
+	mockExecutor.On("Run", mock.Anything, "show environment").Return(nil, errors.New("connection refused")).Once()
+	mockExecutor.On("Run", mock.Anything, "show environment").Return([]byte("RTX1210\n"), nil).Once()
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte("# RTX1210\r\nip route default gateway pp 1\r\n"), nil)
+
+	service := NewBulkConfigService(mockExecutor, nil)
+	service.tftpAddr = server.addr
+	service.adminPassword = "admin-secret"
+	service.restartGrace = time.Millisecond
+	service.pollInterval = time.Millisecond
+
+	config, err := service.Push(context.Background(), BulkConfig{
+		Content:      "ip route default gateway pp 1\n",
+		Transport:    BulkConfigTransportTFTP,
+		ConfigNumber: -1,
+		Restart:      true,
+	})
+	require.NoError(t, err)
+	<-server.done
+
+	assert.Equal(t, "config1/admin-secret", server.filename)
+	assert.Equal(t, "ip route default gateway pp 1\r\n", server.data.String())
+	assert.Equal(t, "# RTX1210\nip route default gateway pp 1", config)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestBulkConfigService_PushPreview(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	service := NewBulkConfigService(mockExecutor, nil)
+
+	ctx, preview := WithCommandPreview(context.Background())
+	_, err := service.Push(ctx, BulkConfig{
+		Content:      "ip route default gateway pp 1\nip lan1 address 192.168.0.1/24\n",
+		Transport:    BulkConfigTransportSFTP,
+		ConfigNumber: 0,
+		Restart:      true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"# write 2 lines to config0 via SFTP", "restart"}, preview.Commands())
+	mockExecutor.AssertNotCalled(t, "Run", mock.Anything, mock.Anything)
+}
+
+func TestValidateBulkConfig(t *testing.T) {
+	valid := BulkConfig{Content: "ip routing on", Transport: BulkConfigTransportSFTP, ConfigNumber: -1}
+	assert.NoError(t, validateBulkConfig(valid))
+
+	empty := valid
+	empty.Content = " \n"
+	assert.Error(t, validateBulkConfig(empty))
+
+	transport := valid
+	transport.Transport = "ftp"
+	assert.Error(t, validateBulkConfig(transport))
+
+	number := valid
+	number.ConfigNumber = 10
+	assert.Error(t, validateBulkConfig(number))
+}
+
+func TestBulkConfigFile(t *testing.T) {
+	assert.Equal(t, "a\r\nb\r\n", bulkConfigFile("a\nb"))
+	assert.Equal(t, "a\r\nb\r\n", bulkConfigFile("a\r\nb\r\n\n"))
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// CertificateService handles "pki certificate file" operations.
+// Certificate content is uploaded via SFTP, so sftpd must be enabled on the router.
+type CertificateService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+
+	// newSFTPClient opens a fresh SFTP connection for uploads; nil when unavailable
+	newSFTPClient func(ctx context.Context) (SFTPClient, error)
+}
+
+// NewCertificateService creates a new certificate service instance
+func NewCertificateService(executor Executor, client *rtxClient) *CertificateService {
+	s := &CertificateService{
+		executor: executor,
+		client:   client,
+	}
+	if client != nil && client.config != nil {
+		s.newSFTPClient = func(ctx context.Context) (SFTPClient, error) {
+			return NewSFTPClient(ctx, client.config)
+		}
+	}
+	return s
+}
+
+// Get retrieves a registered certificate. Only the ID and file are known to
+// the router; the PEM content is not read back.
+func (s *CertificateService) Get(ctx context.Context, id int) (*Certificate, error) {
+	cmd := parsers.BuildShowCertificatesCommand()
+	logging.FromContext(ctx).Debug().Str("service", "certificate").Msgf("Getting certificate with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate: %w", err)
+	}
+
+	for _, parsed := range parsers.ParseCertificates(string(output)) {
+		if parsed.ID == id {
+			return &Certificate{ID: parsed.ID, File: parsed.File}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("certificate %d not found", id)
+}
+
+// Create uploads and registers a certificate
+func (s *CertificateService) Create(ctx context.Context, cert Certificate) error {
+	return s.apply(ctx, cert, "created")
+}
+
+// Update overwrites the certificate file and registers it again
+func (s *CertificateService) Update(ctx context.Context, cert Certificate) error {
+	return s.apply(ctx, cert, "updated")
+}
+
+// apply validates, uploads and registers the certificate
+func (s *CertificateService) apply(ctx context.Context, cert Certificate, action string) error {
+	if err := parsers.ValidateCertificateConfig(parsers.CertificateConfig{ID: cert.ID, File: cert.File}); err != nil {
+		return fmt.Errorf("invalid certificate: %w", err)
+	}
+	if err := parsers.ValidateCertificatePEM(cert.CertificatePEM, cert.PrivateKeyPEM); err != nil {
+		return fmt.Errorf("invalid certificate: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if err := s.upload(ctx, cert.File, certificateFileContent(cert)); err != nil {
+		return err
+	}
+
+	cmd := parsers.BuildCertificateFileCommand(cert.ID, cert.File)
+	logging.FromContext(ctx).Debug().Str("service", "certificate").Msgf("Registering certificate with command: %s", cmd)
+
+	if err := runCommand(ctx, s.executor, cmd); err != nil {
+		return fmt.Errorf("failed to register certificate %d: %w", cert.ID, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("certificate %d %s", cert.ID, action))
+}
+
+// Delete unregisters a certificate and removes its file from the router
+func (s *CertificateService) Delete(ctx context.Context, id int) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	current, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	cmd := parsers.BuildDeleteCertificateFileCommand(id)
+	logging.FromContext(ctx).Debug().Str("service", "certificate").Msgf("Deleting certificate with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return fmt.Errorf("failed to delete certificate %d: %w", id, err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete certificate"); err != nil {
+		return err
+	}
+
+	// The file may hold a private key, so do not leave it behind
+	if err := s.remove(ctx, current.File); err != nil {
+		logging.FromContext(ctx).Warn().Str("service", "certificate").Err(err).Msgf("Failed to remove certificate file %s", current.File)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("certificate %d deleted", id))
+}
+
+// upload writes the certificate file via a fresh SFTP connection
+func (s *CertificateService) upload(ctx context.Context, path string, content []byte) error {
+	if preview := commandPreviewFromContext(ctx); preview != nil {
+		preview.record(fmt.Sprintf("# upload %s via SFTP", path))
+		return nil
+	}
+	if s.newSFTPClient == nil {
+		return fmt.Errorf("SFTP is required to upload certificates")
+	}
+
+	sftpClient, err := s.newSFTPClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create SFTP client: %w", err)
+	}
+	defer sftpClient.Close()
+
+	if err := sftpClient.WriteFile(ctx, path, content); err != nil {
+		return fmt.Errorf("failed to upload certificate file: %w", err)
+	}
+	return nil
+}
+
+// remove deletes the certificate file via a fresh SFTP connection
+func (s *CertificateService) remove(ctx context.Context, path string) error {
+	if preview := commandPreviewFromContext(ctx); preview != nil {
+		preview.record(fmt.Sprintf("# remove %s via SFTP", path))
+		return nil
+	}
+	if s.newSFTPClient == nil {
+		return fmt.Errorf("SFTP is required to remove certificate files")
+	}
+
+	sftpClient, err := s.newSFTPClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create SFTP client: %w", err)
+	}
+	defer sftpClient.Close()
+
+	return sftpClient.Remove(ctx, path)
+}
+
+// certificateFileContent combines the certificate chain and optional private key
+// into a single PEM file
+func certificateFileContent(cert Certificate) []byte {
+	content := strings.TrimSpace(cert.CertificatePEM) + "\n"
+	if cert.PrivateKeyPEM != "" {
+		content += strings.TrimSpace(cert.PrivateKeyPEM) + "\n"
+	}
+	return []byte(content)
+}
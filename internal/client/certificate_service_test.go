@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testCertificatePEM(t *testing.T) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "rtx.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestCertificateService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "pki certificate"`).Return([]byte("pki certificate file 1 /pki/cert1.pem pem\n"), nil)
+
+	service := NewCertificateService(mockExecutor, nil)
+
+	cert, err := service.Get(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, &Certificate{ID: 1, File: "/pki/cert1.pem"}, cert)
+
+	_, err = service.Get(context.Background(), 2)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestCertificateService_Create(t *testing.T) {
+	certPEM := testCertificatePEM(t)
+
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "pki certificate file 1 /pki/cert1.pem pem").Return([]byte(""), nil)
+
+	uploaded := map[string][]byte{}
+	service := NewCertificateService(mockExecutor, nil)
+	service.newSFTPClient = func(ctx context.Context) (SFTPClient, error) {
+		return &MockSFTPClientForTest{
+			WriteFileFunc: func(ctx context.Context, path string, content []byte) error {
+				uploaded[path] = content
+				return nil
+			},
+		}, nil
+	}
+
+	err := service.Create(context.Background(), Certificate{ID: 1, File: "/pki/cert1.pem", CertificatePEM: certPEM})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(certPEM), uploaded["/pki/cert1.pem"])
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestCertificateService_CreateWithoutSFTP(t *testing.T) {
+	service := NewCertificateService(new(MockExecutor), nil)
+
+	err := service.Create(context.Background(), Certificate{ID: 1, File: "/pki/cert1.pem", CertificatePEM: testCertificatePEM(t)})
+	assert.ErrorContains(t, err, "SFTP is required")
+}
+
+func TestCertificateService_Delete(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "pki certificate"`).Return([]byte("pki certificate file 1 /pki/cert1.pem pem\n"), nil)
+	mockExecutor.On("Run", mock.Anything, "no pki certificate file 1").Return([]byte(""), nil)
+
+	var removed string
+	service := NewCertificateService(mockExecutor, nil)
+	service.newSFTPClient = func(ctx context.Context) (SFTPClient, error) {
+		return &MockSFTPClientForTest{
+			RemoveFunc: func(ctx context.Context, path string) error {
+				removed = path
+				return nil
+			},
+		}, nil
+	}
+
+	err := service.Delete(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "/pki/cert1.pem", removed)
+	mockExecutor.AssertExpectations(t)
+}
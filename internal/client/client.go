@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"sync"
 	"time"
 
@@ -23,48 +24,98 @@ type rtxClient struct {
 	dialer         ConnDialer
 	promptDetector PromptDetector
 	parsers        map[string]Parser
-	retryStrategy  RetryStrategy
-	semaphore      chan struct{} // Limits concurrent operations
-
-	mu                    sync.Mutex
-	configDownloadMu      sync.Mutex // Ensures only one config download at a time
-	session               Session
-	executor              Executor
-	active                bool
-	configCache           *ConfigCache // Cache for SFTP-based config reading
-	sftpClient            SFTPClient   // Optional SFTP client for fast config download
-	sshConnectionPool     *SSHConnectionPool
-	sshPoolEnabled        bool
-	dhcpService           *DHCPService
-	dhcpScopeService      *DHCPScopeService
-	ipv6PrefixService     *IPv6PrefixService
-	systemService         *SystemService
-	vlanService           *VLANService
-	interfaceService      *InterfaceService
-	staticRouteService    *StaticRouteService
-	natMasqueradeService  *NATMasqueradeService
-	natStaticService      *NATStaticService
-	ethernetFilterService *EthernetFilterService
-	ipFilterService       *IPFilterService
-	bgpService            *BGPService
-	ospfService           *OSPFService
-	ipsecTunnelService    *IPsecTunnelService
-	ipsecTransportService *IPsecTransportService
-	l2tpService           *L2TPService
-	pptpService           *PPTPService
-	syslogService         *SyslogService
-	snmpService           *SNMPService
-	qosService            *QoSService
-	scheduleService       *ScheduleService
-	dnsService            *DNSService
-	adminService          *AdminService
-	serviceManager        *ServiceManager
-	bridgeService         *BridgeService
-	ipv6InterfaceService  *IPv6InterfaceService
-	ddnsService           *DDNSService
-	pppService            *PPPService
-	aclApplyService       *ACLApplyService
-	tunnelService         *TunnelService
+	retryStrategy  RetryStrategy  // nil: each executor's default
+	semaphore      chan struct{}  // Limits concurrent operations
+	saveScheduler  *saveScheduler // Coalesces saves in SaveModeBatch (nil otherwise)
+
+	modelMu sync.Mutex  // Serializes model detection
+	model   *SystemInfo // Router model and firmware, detected on first use
+
+	mu                        sync.Mutex
+	configDownloadMu          sync.Mutex // Ensures only one config download at a time
+	session                   Session
+	executor                  Executor
+	active                    bool
+	configCache               *ConfigCache            // Cache for SFTP-based config reading
+	configSnapshot            *configSnapshotExecutor // Shared "show config" snapshot for reads
+	deviceLock                *deviceLock             // Serializes changes to the router
+	sftpClient                SFTPClient              // Optional SFTP client for fast config download
+	sshConnectionPool         *SSHConnectionPool
+	sshPoolEnabled            bool
+	dhcpService               *DHCPService
+	dhcpScopeService          *DHCPScopeService
+	ipv6PrefixService         *IPv6PrefixService
+	systemService             *SystemService
+	vlanService               *VLANService
+	interfaceService          *InterfaceService
+	staticRouteService        *StaticRouteService
+	natMasqueradeService      *NATMasqueradeService
+	natStaticService          *NATStaticService
+	ethernetFilterService     *EthernetFilterService
+	ipFilterService           *IPFilterService
+	bgpService                *BGPService
+	ospfService               *OSPFService
+	ipsecTunnelService        *IPsecTunnelService
+	ipsecTransportService     *IPsecTransportService
+	l2tpService               *L2TPService
+	pptpService               *PPTPService
+	syslogService             *SyslogService
+	snmpService               *SNMPService
+	qosService                *QoSService
+	scheduleService           *ScheduleService
+	dnsService                *DNSService
+	adminService              *AdminService
+	serviceManager            *ServiceManager
+	bridgeService             *BridgeService
+	ipv6InterfaceService      *IPv6InterfaceService
+	ddnsService               *DDNSService
+	pppService                *PPPService
+	aclApplyService           *ACLApplyService
+	tunnelService             *TunnelService
+	mldService                *MLDService
+	ipKeepaliveService        *IPKeepaliveService
+	flowExportService         *FlowExportService
+	trafficThresholdService   *TrafficThresholdService
+	externalMemoryService     *ExternalMemoryService
+	firmwareUpdateService     *FirmwareUpdateService
+	clockService              *ClockService
+	radiusService             *RADIUSService
+	pppAuthUserService        *PPPAuthUserService
+	vpnAddressPoolService     *VPNAddressPoolService
+	certificateService        *CertificateService
+	ikev2TunnelService        *IKEv2TunnelService
+	ipv6NeighborStaticService *IPv6NeighborStaticService
+	ipv6RTADVService          *IPv6RTADVService
+	linkAggregationService    *LinkAggregationService
+	loopbackInterfaceService  *LoopbackInterfaceService
+	l2msService               *L2MSService
+	wlanService               *WLANService
+	usbHostService            *USBHostService
+	mobileWANService          *MobileWANService
+	configBlockService        *ConfigBlockService
+	bulkConfigService         *BulkConfigService
+	systemSettingsService     *SystemSettingsService
+	proxyARPService           *ProxyARPService
+	portMirroringService      *PortMirroringService
+	lldpService               *LLDPService
+	icmpStealthService        *ICMPStealthService
+	interfaceStatusService    *InterfaceStatusService
+	ipsecSAService            *IPsecSAService
+	ppStatusService           *PPStatusService
+	runningConfigService      *RunningConfigService
+	logService                *LogService
+	environmentService        *EnvironmentService
+	ipv6NeighborService       *IPv6NeighborService
+	dnsCacheService           *DNSCacheService
+	interfaceCountersService  *InterfaceCountersService
+	natDescriptorService      *NATDescriptorService
+	firmwareRevisionService   *FirmwareRevisionService
+	qosStatusService          *QoSStatusService
+	l2tpSessionService        *L2TPSessionService
+	ospfNeighborStatusService *OSPFNeighborStatusService
+	bgpPeerStatusService      *BGPPeerStatusService
+	commandService            *CommandService
+	pingService               *PingService
 }
 
 // NewClient creates a new RTX client instance
@@ -88,17 +139,25 @@ func NewClient(config *Config, opts ...Option) (Client, error) {
 		dialer:         &sshDialer{},
 		promptDetector: &defaultPromptDetector{},
 		parsers:        make(map[string]Parser),
-		retryStrategy:  &noRetry{},
 		semaphore:      make(chan struct{}, maxParallelism),
 		configCache:    NewConfigCache(),
 		sshPoolEnabled: sshPoolEnabled,
 	}
 
+	if config.SaveMode == SaveModeBatch {
+		c.saveScheduler = newSaveScheduler(c.saveNow)
+	}
+
 	// Apply options
 	for _, opt := range opts {
 		opt(c)
 	}
 
+	// Configured prompt patterns take precedence over the detector passed by the caller
+	if patterns := newPromptPatterns(config.Prompts); patterns != nil && patterns.login != nil {
+		c.promptDetector = &configuredPromptDetector{patterns: patterns}
+	}
+
 	return c, nil
 }
 
@@ -140,15 +199,11 @@ func WithSSHSessionPool(enabled bool) Option {
 	}
 }
 
-// getHostKeyCallback returns the appropriate host key callback based on configuration
+// getHostKeyCallback returns the appropriate host key callback based on configuration.
+// Executor connections are verified the same way as the initial connection.
 func (c *rtxClient) getHostKeyCallback() ssh.HostKeyCallback {
-	if c.config.SkipHostKeyCheck {
-		return ssh.InsecureIgnoreHostKey()
-	}
-
-	// Implement proper host key checking if needed
-	// For now, we'll use InsecureIgnoreHostKey
-	return ssh.InsecureIgnoreHostKey()
+	d := &sshDialer{}
+	return d.getHostKeyCallback(c.config)
 }
 
 // Dial establishes a connection to the RTX router
@@ -161,6 +216,124 @@ func (c *rtxClient) Dial(ctx context.Context) error {
 		return nil // Already connected
 	}
 
+	addr := fmt.Sprintf("%s:%d", c.config.Host, c.config.Port)
+	if c.config.YNO != nil {
+		// Commands are relayed by YNO; the router is never contacted directly
+		addr = "yno:" + c.config.YNO.DeviceID
+		c.executor = newYNOExecutor(c.config, c.retryStrategy)
+		logger.Info().Str("device_id", c.config.YNO.DeviceID).Msg("Using YNO for command execution")
+	} else if err := c.dialSSH(ctx, addr); err != nil {
+		return err
+	}
+
+	// Resume batches interrupted by the router rebooting or dropping the session
+	c.executor = newResumingExecutor(c.executor, c.config)
+	// Serve status reads from the web API where the router provides one
+	c.executor = newHTTPAPIExecutor(c.executor, c.config)
+	// Trace every command sent to the router in the Terraform debug log
+	secrets := []string{c.config.Password, c.config.AdminPassword, c.config.PrivateKeyPassphrase}
+	if c.config.YNO != nil {
+		secrets = append(secrets, c.config.YNO.APIToken)
+	}
+	c.executor = newTracingExecutor(c.executor, addr, secrets...)
+	// Serve configuration reads from one "show config" per refresh
+	c.configSnapshot = newConfigSnapshotExecutor(c.executor, c.configCache)
+	c.executor = c.configSnapshot
+	// Save the configuration to a local file before the first change
+	c.executor = newBackupExecutor(c.executor, c.config.BackupDir, addr)
+	// Serialize configuration commands per router, across all clients in this
+	// process and, with a lock file, across processes
+	c.deviceLock = newDeviceLock(addr, c.config.LockFile)
+	c.executor = newLockedExecutor(c.executor, c.deviceLock)
+	// Record configuration commands instead of sending them during plan-time previews
+	c.executor = newPreviewExecutor(c.executor)
+	c.dhcpService = NewDHCPService(c.executor, c)
+	c.dhcpScopeService = NewDHCPScopeService(c.executor, c)
+	c.ipv6PrefixService = NewIPv6PrefixService(c.executor, c)
+	c.systemService = NewSystemService(c.executor, c)
+	c.vlanService = NewVLANService(c.executor, c)
+	c.interfaceService = NewInterfaceService(c.executor, c)
+	c.staticRouteService = NewStaticRouteService(c.executor, c)
+	c.natMasqueradeService = NewNATMasqueradeService(c.executor, c)
+	c.natStaticService = NewNATStaticService(c.executor, c)
+	c.ethernetFilterService = NewEthernetFilterService(c.executor, c)
+	c.ipFilterService = NewIPFilterService(c.executor, c)
+	c.bgpService = NewBGPService(c.executor, c)
+	c.ospfService = NewOSPFService(c.executor, c)
+	c.ipsecTunnelService = NewIPsecTunnelService(c.executor, c)
+	c.ipsecTransportService = NewIPsecTransportService(c.executor, c)
+	c.l2tpService = NewL2TPService(c.executor, c)
+	c.tunnelService = NewTunnelService(c.executor, c)
+	c.pptpService = NewPPTPService(c.executor, c)
+	c.syslogService = NewSyslogService(c.executor, c)
+	c.snmpService = NewSNMPService(c.executor, c)
+	c.qosService = NewQoSService(c.executor, c)
+	c.scheduleService = NewScheduleService(c.executor, c)
+	c.dnsService = NewDNSService(c.executor, c)
+	c.adminService = NewAdminService(c.executor, c)
+	c.serviceManager = NewServiceManager(c.executor, c)
+	c.bridgeService = NewBridgeService(c.executor, c)
+	c.ipv6InterfaceService = NewIPv6InterfaceService(c.executor, c)
+	c.ddnsService = NewDDNSService(c.executor, c)
+	c.pppService = NewPPPService(c.executor, c)
+	c.aclApplyService = NewACLApplyService(c.executor, c)
+	c.mldService = NewMLDService(c.executor, c)
+	c.ipKeepaliveService = NewIPKeepaliveService(c.executor, c)
+	c.flowExportService = NewFlowExportService(c.executor, c)
+	c.trafficThresholdService = NewTrafficThresholdService(c.executor, c)
+	c.externalMemoryService = NewExternalMemoryService(c.executor, c)
+	c.firmwareUpdateService = NewFirmwareUpdateService(c.executor, c)
+	c.clockService = NewClockService(c.executor, c)
+	c.radiusService = NewRADIUSService(c.executor, c)
+	c.pppAuthUserService = NewPPPAuthUserService(c.executor, c)
+	c.vpnAddressPoolService = NewVPNAddressPoolService(c.executor, c)
+	c.certificateService = NewCertificateService(c.executor, c)
+	c.ikev2TunnelService = NewIKEv2TunnelService(c.executor, c)
+	c.ipv6NeighborStaticService = NewIPv6NeighborStaticService(c.executor, c)
+	c.ipv6RTADVService = NewIPv6RTADVService(c.executor, c)
+	c.linkAggregationService = NewLinkAggregationService(c.executor, c)
+	c.loopbackInterfaceService = NewLoopbackInterfaceService(c.executor, c)
+	c.l2msService = NewL2MSService(c.executor, c)
+	c.wlanService = NewWLANService(c.executor, c)
+	c.usbHostService = NewUSBHostService(c.executor, c)
+	c.mobileWANService = NewMobileWANService(c.executor, c)
+	c.configBlockService = NewConfigBlockService(c.executor, c)
+	c.bulkConfigService = NewBulkConfigService(c.executor, c)
+	c.systemSettingsService = NewSystemSettingsService(c.executor, c)
+	c.proxyARPService = NewProxyARPService(c.executor, c)
+	c.portMirroringService = NewPortMirroringService(c.executor, c)
+	c.lldpService = NewLLDPService(c.executor, c)
+	c.icmpStealthService = NewICMPStealthService(c.executor, c)
+	c.interfaceStatusService = NewInterfaceStatusService(c.executor, c)
+	c.ipsecSAService = NewIPsecSAService(c.executor, c)
+	c.ppStatusService = NewPPStatusService(c.executor, c)
+	c.runningConfigService = NewRunningConfigService(c.executor, c)
+	c.logService = NewLogService(c.executor, c)
+	c.environmentService = NewEnvironmentService(c.executor, c)
+	c.ipv6NeighborService = NewIPv6NeighborService(c.executor, c)
+	c.dnsCacheService = NewDNSCacheService(c.executor, c)
+	c.interfaceCountersService = NewInterfaceCountersService(c.executor, c)
+	c.natDescriptorService = NewNATDescriptorService(c.executor, c)
+	c.firmwareRevisionService = NewFirmwareRevisionService(c.executor, c)
+	c.qosStatusService = NewQoSStatusService(c.executor, c)
+	c.l2tpSessionService = NewL2TPSessionService(c.executor, c)
+	c.ospfNeighborStatusService = NewOSPFNeighborStatusService(c.executor, c)
+	c.bgpPeerStatusService = NewBGPPeerStatusService(c.executor, c)
+	c.commandService = NewCommandService(c.executor, c)
+	c.pingService = NewPingService(c.executor, c)
+
+	// Note: SFTP client is created lazily on first use in downloadConfigViaSFTP()
+	// to avoid idle connection timeout issues with RTX routers
+
+	c.active = true
+	return nil
+}
+
+// dialSSH sets up the SSH executor for the router at addr. Connections are
+// opened on demand by the executor.
+func (c *rtxClient) dialSSH(ctx context.Context, addr string) error {
+	logger := logging.FromContext(ctx)
+
 	// For RTX routers, we'll use a simple executor that creates new connections per command
 	// This is less efficient but more reliable given RTX's SSH implementation
 	sshConfig := &ssh.ClientConfig{
@@ -170,11 +343,9 @@ func (c *rtxClient) Dial(ctx context.Context) error {
 		Timeout:         time.Duration(c.config.Timeout) * time.Second,
 	}
 
-	addr := fmt.Sprintf("%s:%d", c.config.Host, c.config.Port)
-
 	// Use dialer if provided (for testing/dependency injection)
 	if c.dialer != nil {
-		session, err := c.dialer.Dial(ctx, fmt.Sprintf("%s:%d", c.config.Host, c.config.Port), c.config)
+		session, err := c.dialer.Dial(ctx, addr, c.config)
 		if err != nil {
 			return err
 		}
@@ -187,6 +358,13 @@ func (c *rtxClient) Dial(ctx context.Context) error {
 
 		// Build pool config from client config or use defaults
 		poolConfig := DefaultSSHPoolConfig()
+		poolConfig.JumpHost = c.config.JumpHost
+		poolConfig.Timeouts = c.config.Timeouts
+		poolConfig.Pacing = c.config.Pacing
+		poolConfig.ConsoleEncoding = c.config.ConsoleEncoding
+		poolConfig.Keepalive = c.config.SSHKeepalive
+		poolConfig.Prompts = c.config.Prompts
+		poolConfig.SkipSaveOnExit = c.config.SaveMode == SaveModeManual
 		if c.config.SSHPoolMaxSessions > 0 {
 			poolConfig.MaxSessions = c.config.SSHPoolMaxSessions
 		}
@@ -212,53 +390,27 @@ func (c *rtxClient) Dial(ctx context.Context) error {
 
 	// Use PooledExecutor when connection pool is available, otherwise fall back to SimpleExecutor
 	if c.sshPoolEnabled && c.sshConnectionPool != nil {
-		c.executor = NewPooledExecutor(c.sshConnectionPool, c.promptDetector, c.config)
+		c.executor = NewPooledExecutor(c.sshConnectionPool, c.promptDetector, c.config, c.retryStrategy)
 		logger.Info().Msg("Using PooledExecutor for command execution")
 	} else {
-		c.executor = NewSimpleExecutor(sshConfig, addr, c.promptDetector, c.config)
+		c.executor = NewSimpleExecutor(sshConfig, addr, c.promptDetector, c.config, c.retryStrategy)
 		logger.Info().Msg("Using SimpleExecutor for command execution")
 	}
-	c.dhcpService = NewDHCPService(c.executor, c)
-	c.dhcpScopeService = NewDHCPScopeService(c.executor, c)
-	c.ipv6PrefixService = NewIPv6PrefixService(c.executor, c)
-	c.systemService = NewSystemService(c.executor, c)
-	c.vlanService = NewVLANService(c.executor, c)
-	c.interfaceService = NewInterfaceService(c.executor, c)
-	c.staticRouteService = NewStaticRouteService(c.executor, c)
-	c.natMasqueradeService = NewNATMasqueradeService(c.executor, c)
-	c.natStaticService = NewNATStaticService(c.executor, c)
-	c.ethernetFilterService = NewEthernetFilterService(c.executor, c)
-	c.ipFilterService = NewIPFilterService(c.executor, c)
-	c.bgpService = NewBGPService(c.executor, c)
-	c.ospfService = NewOSPFService(c.executor, c)
-	c.ipsecTunnelService = NewIPsecTunnelService(c.executor, c)
-	c.ipsecTransportService = NewIPsecTransportService(c.executor, c)
-	c.l2tpService = NewL2TPService(c.executor, c)
-	c.tunnelService = NewTunnelService(c.executor, c)
-	c.pptpService = NewPPTPService(c.executor, c)
-	c.syslogService = NewSyslogService(c.executor, c)
-	c.snmpService = NewSNMPService(c.executor, c)
-	c.qosService = NewQoSService(c.executor, c)
-	c.scheduleService = NewScheduleService(c.executor, c)
-	c.dnsService = NewDNSService(c.executor, c)
-	c.adminService = NewAdminService(c.executor, c)
-	c.serviceManager = NewServiceManager(c.executor, c)
-	c.bridgeService = NewBridgeService(c.executor, c)
-	c.ipv6InterfaceService = NewIPv6InterfaceService(c.executor, c)
-	c.ddnsService = NewDDNSService(c.executor, c)
-	c.pppService = NewPPPService(c.executor, c)
-	c.aclApplyService = NewACLApplyService(c.executor, c)
-
-	// Note: SFTP client is created lazily on first use in downloadConfigViaSFTP()
-	// to avoid idle connection timeout issues with RTX routers
 
-	c.active = true
 	return nil
 }
 
 // Close terminates the connection
 func (c *rtxClient) Close() error {
 	logger := logging.Global()
+
+	// Write batched changes before the connections go away
+	if c.saveScheduler != nil {
+		if err := c.saveScheduler.Flush(context.Background()); err != nil {
+			logger.Warn().Err(err).Msg("Failed to save batched configuration changes")
+		}
+	}
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
@@ -286,6 +438,8 @@ func (c *rtxClient) Close() error {
 	c.active = false
 	c.session = nil
 	c.executor = nil
+	c.configSnapshot = nil
+	c.deviceLock = nil
 	c.sshPoolEnabled = false
 	c.dhcpService = nil
 	c.dhcpScopeService = nil
@@ -314,6 +468,50 @@ func (c *rtxClient) Close() error {
 	c.bridgeService = nil
 	c.ipv6InterfaceService = nil
 	c.aclApplyService = nil
+	c.mldService = nil
+	c.ipKeepaliveService = nil
+	c.flowExportService = nil
+	c.trafficThresholdService = nil
+	c.externalMemoryService = nil
+	c.firmwareUpdateService = nil
+	c.clockService = nil
+	c.radiusService = nil
+	c.pppAuthUserService = nil
+	c.vpnAddressPoolService = nil
+	c.certificateService = nil
+	c.ikev2TunnelService = nil
+	c.ipv6NeighborStaticService = nil
+	c.ipv6RTADVService = nil
+	c.linkAggregationService = nil
+	c.loopbackInterfaceService = nil
+	c.l2msService = nil
+	c.wlanService = nil
+	c.usbHostService = nil
+	c.mobileWANService = nil
+	c.configBlockService = nil
+	c.bulkConfigService = nil
+	c.systemSettingsService = nil
+	c.proxyARPService = nil
+	c.portMirroringService = nil
+	c.lldpService = nil
+	c.icmpStealthService = nil
+	c.interfaceStatusService = nil
+	c.ipsecSAService = nil
+	c.ppStatusService = nil
+	c.runningConfigService = nil
+	c.logService = nil
+	c.environmentService = nil
+	c.ipv6NeighborService = nil
+	c.dnsCacheService = nil
+	c.interfaceCountersService = nil
+	c.natDescriptorService = nil
+	c.firmwareRevisionService = nil
+	c.qosStatusService = nil
+	c.l2tpSessionService = nil
+	c.ospfNeighborStatusService = nil
+	c.bgpPeerStatusService = nil
+	c.commandService = nil
+	c.pingService = nil
 
 	if err != nil {
 		return fmt.Errorf("failed to close session: %w", err)
@@ -374,7 +572,7 @@ func (c *rtxClient) Run(ctx context.Context, cmd Command) (Result, error) {
 // GetInterfaces retrieves interface information from the router
 func (c *rtxClient) GetInterfaces(ctx context.Context) ([]Interface, error) {
 	// First get system information to determine model
-	systemInfo, err := c.GetSystemInfo(ctx)
+	systemInfo, err := c.DetectModel(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get system info for parser selection: %w", err)
 	}
@@ -438,7 +636,7 @@ func (c *rtxClient) GetInterfaces(ctx context.Context) ([]Interface, error) {
 // GetRoutes retrieves routing table information from the router
 func (c *rtxClient) GetRoutes(ctx context.Context) ([]Route, error) {
 	// First get system information to determine model
-	systemInfo, err := c.GetSystemInfo(ctx)
+	systemInfo, err := c.DetectModel(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get system info for parser selection: %w", err)
 	}
@@ -630,20 +828,31 @@ func (c *rtxClient) ListDHCPScopes(ctx context.Context) ([]DHCPScope, error) {
 	return dhcpScopeService.ListScopes(ctx)
 }
 
-// SaveConfig saves the current configuration to persistent memory
+// SaveConfig saves the current configuration to persistent memory.
+// Depending on the configured SaveMode the save runs now, is batched with
+// other changes, or is left to the operator.
 func (c *rtxClient) SaveConfig(ctx context.Context) error {
 	c.mu.Lock()
 	if !c.active {
 		c.mu.Unlock()
 		return fmt.Errorf("client not connected")
 	}
-	executor := c.executor
 	c.mu.Unlock()
 
-	// Execute save command
-	_, err := executor.Run(ctx, "save")
-	if err != nil {
-		return fmt.Errorf("failed to save configuration: %w", err)
+	switch {
+	case IsCommandPreview(ctx):
+		// Previews only list the configuration commands
+		return nil
+	case c.config != nil && c.config.SaveMode == SaveModeManual:
+		logging.FromContext(ctx).Debug().Msg("Skipping configuration save (manual save mode)")
+	case c.saveScheduler != nil:
+		if err := c.saveScheduler.Schedule(ctx); err != nil {
+			return err
+		}
+	default:
+		if err := c.saveNow(ctx); err != nil {
+			return err
+		}
 	}
 
 	// Mark cache as dirty so it will be refreshed on next read
@@ -652,6 +861,46 @@ func (c *rtxClient) SaveConfig(ctx context.Context) error {
 	return nil
 }
 
+// LockDevice holds the device lock of the router for a whole resource
+// operation. Commands run with the returned context do not wait for the lock.
+func (c *rtxClient) LockDevice(ctx context.Context) (context.Context, func(), error) {
+	c.mu.Lock()
+	lock := c.deviceLock
+	c.mu.Unlock()
+
+	if lock == nil {
+		return ctx, func() {}, nil
+	}
+	return lock.LockOperation(ctx)
+}
+
+// BeginChange marks the start of a resource change. In batch save mode the
+// save requested by changes is deferred until the last running change ends.
+func (c *rtxClient) BeginChange() func(ctx context.Context) error {
+	if c.saveScheduler == nil {
+		return func(ctx context.Context) error { return nil }
+	}
+	c.saveScheduler.begin()
+	return c.saveScheduler.end
+}
+
+// saveNow runs the save command
+func (c *rtxClient) saveNow(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	executor := c.executor
+	c.mu.Unlock()
+
+	// Execute save command
+	if _, err := executor.Run(ctx, "save"); err != nil {
+		return fmt.Errorf("failed to save configuration: %w", err)
+	}
+	return nil
+}
+
 // RunBatch executes multiple raw commands in sequence and returns combined output
 func (c *rtxClient) RunBatch(ctx context.Context, cmds []string) ([]byte, error) {
 	c.mu.Lock()
@@ -761,8 +1010,8 @@ func validateConfig(config *Config) error {
 	if config.Username == "" {
 		return fmt.Errorf("username is required")
 	}
-	// Password is required only if no SSH key is provided
-	if config.Password == "" && config.PrivateKey == "" && config.PrivateKeyFile == "" {
+	// Password is required only if no SSH key is provided; YNO logs in by itself
+	if config.YNO == nil && config.Password == "" && config.PrivateKey == "" && config.PrivateKeyFile == "" {
 		return fmt.Errorf("password or private_key/private_key_file is required")
 	}
 	if config.Port <= 0 || config.Port > 65535 {
@@ -772,7 +1021,48 @@ func validateConfig(config *Config) error {
 		config.Timeout = 30 // Default timeout
 	}
 
-	// Note: When both HostKey and KnownHostsFile are specified, HostKey takes priority
+	if config.HostKeyFingerprint != "" && !validHostKeyFingerprint(config.HostKeyFingerprint) {
+		return fmt.Errorf("invalid host key fingerprint %q: expected SHA256:<base64> or MD5:<hex pairs>", config.HostKeyFingerprint)
+	}
+
+	switch config.SaveMode {
+	case "", SaveModeImmediate, SaveModeBatch, SaveModeManual:
+	default:
+		return fmt.Errorf("invalid save mode %q: expected %q, %q or %q", config.SaveMode, SaveModeImmediate, SaveModeBatch, SaveModeManual)
+	}
+
+	switch config.ConsoleEncoding {
+	case "", ConsoleEncodingAuto, ConsoleEncodingUTF8, ConsoleEncodingShiftJIS, ConsoleEncodingEUCJP:
+	default:
+		return fmt.Errorf("invalid console encoding %q: expected %q, %q, %q or %q", config.ConsoleEncoding,
+			ConsoleEncodingAuto, ConsoleEncodingUTF8, ConsoleEncodingShiftJIS, ConsoleEncodingEUCJP)
+	}
+
+	if err := validatePromptConfig(config.Prompts); err != nil {
+		return err
+	}
+
+	if config.HTTPAPI != nil && config.HTTPAPI.URL != "" {
+		u, err := url.Parse(config.HTTPAPI.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid HTTP API URL %q: expected an http:// or https:// URL", config.HTTPAPI.URL)
+		}
+	}
+
+	if config.YNO != nil {
+		u, err := url.Parse(config.YNO.APIURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid YNO API URL %q: expected an http:// or https:// URL", config.YNO.APIURL)
+		}
+		if config.YNO.APIToken == "" || config.YNO.DeviceID == "" {
+			return fmt.Errorf("YNO requires an API token and a device ID")
+		}
+		if config.SFTPEnabled {
+			return fmt.Errorf("SFTP configuration reading is not available through YNO")
+		}
+	}
+
+	// Note: HostKey takes priority over HostKeyFingerprint, which takes priority over KnownHostsFile
 
 	return nil
 }
@@ -2414,6 +2704,108 @@ func (c *rtxClient) ResetDNS(ctx context.Context) error {
 	return dnsService.Reset(ctx)
 }
 
+// GetDNSServerSelect retrieves a DNS server select entry by ID
+func (c *rtxClient) GetDNSServerSelect(ctx context.Context, id int) (*DNSServerSelect, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	dnsService := c.dnsService
+	c.mu.Unlock()
+
+	if dnsService == nil {
+		return nil, fmt.Errorf("DNS service not initialized")
+	}
+
+	return dnsService.GetServerSelect(ctx, id)
+}
+
+// SetDNSServerSelect creates or replaces a DNS server select entry
+func (c *rtxClient) SetDNSServerSelect(ctx context.Context, sel DNSServerSelect) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	dnsService := c.dnsService
+	c.mu.Unlock()
+
+	if dnsService == nil {
+		return fmt.Errorf("DNS service not initialized")
+	}
+
+	return dnsService.SetServerSelect(ctx, sel)
+}
+
+// DeleteDNSServerSelect removes a DNS server select entry
+func (c *rtxClient) DeleteDNSServerSelect(ctx context.Context, id int) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	dnsService := c.dnsService
+	c.mu.Unlock()
+
+	if dnsService == nil {
+		return fmt.Errorf("DNS service not initialized")
+	}
+
+	return dnsService.DeleteServerSelect(ctx, id)
+}
+
+// GetDNSStaticHost retrieves the dns static entries for a record type and name
+func (c *rtxClient) GetDNSStaticHost(ctx context.Context, recordType, name string) (*DNSStaticHost, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	dnsService := c.dnsService
+	c.mu.Unlock()
+
+	if dnsService == nil {
+		return nil, fmt.Errorf("DNS service not initialized")
+	}
+
+	return dnsService.GetStaticHost(ctx, recordType, name)
+}
+
+// SetDNSStaticHost creates or replaces the dns static entries for a record type and name
+func (c *rtxClient) SetDNSStaticHost(ctx context.Context, host DNSStaticHost) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	dnsService := c.dnsService
+	c.mu.Unlock()
+
+	if dnsService == nil {
+		return fmt.Errorf("DNS service not initialized")
+	}
+
+	return dnsService.SetStaticHost(ctx, host)
+}
+
+// DeleteDNSStaticHost removes the dns static entries for a record type and name
+func (c *rtxClient) DeleteDNSStaticHost(ctx context.Context, recordType, name string) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	dnsService := c.dnsService
+	c.mu.Unlock()
+
+	if dnsService == nil {
+		return fmt.Errorf("DNS service not initialized")
+	}
+
+	return dnsService.DeleteStaticHost(ctx, recordType, name)
+}
+
 // ========== QoS Class Map Methods ==========
 
 // GetClassMap retrieves a class-map configuration
@@ -3628,33 +4020,101 @@ func (c *rtxClient) ListIPv6InterfaceConfigs(ctx context.Context) ([]IPv6Interfa
 	return ipv6InterfaceService.List(ctx)
 }
 
-// Access List Extended (IPv4) stub implementations
-func (c *rtxClient) GetAccessListExtended(ctx context.Context, name string) (*AccessListExtended, error) {
-	return nil, fmt.Errorf("access list extended not implemented")
-}
+// GetMLDProxy retrieves the MLD proxy rooted at the given upstream interface
+func (c *rtxClient) GetMLDProxy(ctx context.Context, upstreamInterface string) (*MLDProxy, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	mldService := c.mldService
+	c.mu.Unlock()
 
-func (c *rtxClient) CreateAccessListExtended(ctx context.Context, acl AccessListExtended) error {
-	return fmt.Errorf("access list extended not implemented")
-}
+	if mldService == nil {
+		return nil, fmt.Errorf("MLD service not initialized")
+	}
 
-func (c *rtxClient) UpdateAccessListExtended(ctx context.Context, acl AccessListExtended) error {
-	return fmt.Errorf("access list extended not implemented")
+	return mldService.Get(ctx, upstreamInterface)
 }
 
-func (c *rtxClient) DeleteAccessListExtended(ctx context.Context, name string) error {
-	return fmt.Errorf("access list extended not implemented")
-}
+// CreateMLDProxy creates a new MLD proxy configuration
+func (c *rtxClient) CreateMLDProxy(ctx context.Context, proxy MLDProxy) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	mldService := c.mldService
+	c.mu.Unlock()
 
-func (c *rtxClient) ListAccessListsExtended(ctx context.Context) ([]AccessListExtended, error) {
-	return nil, fmt.Errorf("access list extended not implemented")
-}
+	if mldService == nil {
+		return fmt.Errorf("MLD service not initialized")
+	}
 
-// Access List Extended (IPv6) stub implementations
-func (c *rtxClient) GetAccessListExtendedIPv6(ctx context.Context, name string) (*AccessListExtendedIPv6, error) {
-	return nil, fmt.Errorf("access list extended IPv6 not implemented")
+	return mldService.Create(ctx, proxy)
 }
 
-func (c *rtxClient) CreateAccessListExtendedIPv6(ctx context.Context, acl AccessListExtendedIPv6) error {
+// UpdateMLDProxy updates an existing MLD proxy configuration
+func (c *rtxClient) UpdateMLDProxy(ctx context.Context, proxy MLDProxy) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	mldService := c.mldService
+	c.mu.Unlock()
+
+	if mldService == nil {
+		return fmt.Errorf("MLD service not initialized")
+	}
+
+	return mldService.Update(ctx, proxy)
+}
+
+// DeleteMLDProxy removes the MLD proxy rooted at the given upstream interface
+func (c *rtxClient) DeleteMLDProxy(ctx context.Context, upstreamInterface string) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	mldService := c.mldService
+	c.mu.Unlock()
+
+	if mldService == nil {
+		return fmt.Errorf("MLD service not initialized")
+	}
+
+	return mldService.Delete(ctx, upstreamInterface)
+}
+
+// Access List Extended (IPv4) stub implementations
+func (c *rtxClient) GetAccessListExtended(ctx context.Context, name string) (*AccessListExtended, error) {
+	return nil, fmt.Errorf("access list extended not implemented")
+}
+
+func (c *rtxClient) CreateAccessListExtended(ctx context.Context, acl AccessListExtended) error {
+	return fmt.Errorf("access list extended not implemented")
+}
+
+func (c *rtxClient) UpdateAccessListExtended(ctx context.Context, acl AccessListExtended) error {
+	return fmt.Errorf("access list extended not implemented")
+}
+
+func (c *rtxClient) DeleteAccessListExtended(ctx context.Context, name string) error {
+	return fmt.Errorf("access list extended not implemented")
+}
+
+func (c *rtxClient) ListAccessListsExtended(ctx context.Context) ([]AccessListExtended, error) {
+	return nil, fmt.Errorf("access list extended not implemented")
+}
+
+// Access List Extended (IPv6) stub implementations
+func (c *rtxClient) GetAccessListExtendedIPv6(ctx context.Context, name string) (*AccessListExtendedIPv6, error) {
+	return nil, fmt.Errorf("access list extended IPv6 not implemented")
+}
+
+func (c *rtxClient) CreateAccessListExtendedIPv6(ctx context.Context, acl AccessListExtendedIPv6) error {
 	return fmt.Errorf("access list extended IPv6 not implemented")
 }
 
@@ -4578,7 +5038,7 @@ func (c *rtxClient) downloadConfigViaSFTP(ctx context.Context, executor Executor
 	}
 
 	logger.Debug().Int("bytes", len(content)).Msg("SFTP download successful")
-	return content, nil
+	return DecodeConsoleOutput(content, c.config.ConsoleEncoding), nil
 }
 
 // downloadConfigViaSSH downloads the router config via SSH "show config" command.
@@ -4600,8 +5060,13 @@ func (c *rtxClient) downloadConfigViaSSH(ctx context.Context, executor Executor)
 func (c *rtxClient) InvalidateCache() {
 	c.mu.Lock()
 	cache := c.configCache
+	snapshot := c.configSnapshot
 	c.mu.Unlock()
 
+	if snapshot != nil {
+		snapshot.Invalidate()
+	}
+
 	if cache != nil {
 		cache.Invalidate()
 	}
@@ -4612,8 +5077,13 @@ func (c *rtxClient) InvalidateCache() {
 func (c *rtxClient) MarkCacheDirty() {
 	c.mu.Lock()
 	cache := c.configCache
+	snapshot := c.configSnapshot
 	c.mu.Unlock()
 
+	if snapshot != nil {
+		snapshot.Invalidate()
+	}
+
 	if cache != nil {
 		cache.MarkDirty()
 	}
@@ -4897,3 +5367,2213 @@ func (c *rtxClient) GetMACInterfaceFilters(ctx context.Context, iface, direction
 
 	return aclApplyService.GetInterfaceFilters(ctx, iface, direction, ACLTypeMAC)
 }
+
+// GetIPKeepalive retrieves an IP keepalive monitor
+func (c *rtxClient) GetIPKeepalive(ctx context.Context, id int) (*IPKeepalive, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	ipKeepaliveService := c.ipKeepaliveService
+	c.mu.Unlock()
+
+	if ipKeepaliveService == nil {
+		return nil, fmt.Errorf("IP keepalive service not initialized")
+	}
+
+	return ipKeepaliveService.Get(ctx, id)
+}
+
+// CreateIPKeepalive creates a new IP keepalive monitor
+func (c *rtxClient) CreateIPKeepalive(ctx context.Context, keepalive IPKeepalive) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	ipKeepaliveService := c.ipKeepaliveService
+	c.mu.Unlock()
+
+	if ipKeepaliveService == nil {
+		return fmt.Errorf("IP keepalive service not initialized")
+	}
+
+	return ipKeepaliveService.Create(ctx, keepalive)
+}
+
+// UpdateIPKeepalive updates an existing IP keepalive monitor
+func (c *rtxClient) UpdateIPKeepalive(ctx context.Context, keepalive IPKeepalive) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	ipKeepaliveService := c.ipKeepaliveService
+	c.mu.Unlock()
+
+	if ipKeepaliveService == nil {
+		return fmt.Errorf("IP keepalive service not initialized")
+	}
+
+	return ipKeepaliveService.Update(ctx, keepalive)
+}
+
+// DeleteIPKeepalive removes an IP keepalive monitor
+func (c *rtxClient) DeleteIPKeepalive(ctx context.Context, id int) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	ipKeepaliveService := c.ipKeepaliveService
+	c.mu.Unlock()
+
+	if ipKeepaliveService == nil {
+		return fmt.Errorf("IP keepalive service not initialized")
+	}
+
+	return ipKeepaliveService.Delete(ctx, id)
+}
+
+// ListIPKeepalives retrieves all IP keepalive monitors
+func (c *rtxClient) ListIPKeepalives(ctx context.Context) ([]IPKeepalive, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	ipKeepaliveService := c.ipKeepaliveService
+	c.mu.Unlock()
+
+	if ipKeepaliveService == nil {
+		return nil, fmt.Errorf("IP keepalive service not initialized")
+	}
+
+	return ipKeepaliveService.List(ctx)
+}
+
+// GetFlowExport retrieves flow export configuration
+func (c *rtxClient) GetFlowExport(ctx context.Context) (*FlowExportConfig, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	flowExportService := c.flowExportService
+	c.mu.Unlock()
+
+	if flowExportService == nil {
+		return nil, fmt.Errorf("Flow export service not initialized")
+	}
+
+	return flowExportService.Get(ctx)
+}
+
+// ConfigureFlowExport creates flow export configuration
+func (c *rtxClient) ConfigureFlowExport(ctx context.Context, config FlowExportConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	flowExportService := c.flowExportService
+	c.mu.Unlock()
+
+	if flowExportService == nil {
+		return fmt.Errorf("Flow export service not initialized")
+	}
+
+	return flowExportService.Configure(ctx, config)
+}
+
+// UpdateFlowExport updates flow export configuration
+func (c *rtxClient) UpdateFlowExport(ctx context.Context, config FlowExportConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	flowExportService := c.flowExportService
+	c.mu.Unlock()
+
+	if flowExportService == nil {
+		return fmt.Errorf("Flow export service not initialized")
+	}
+
+	return flowExportService.Update(ctx, config)
+}
+
+// ResetFlowExport removes flow export configuration
+func (c *rtxClient) ResetFlowExport(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	flowExportService := c.flowExportService
+	c.mu.Unlock()
+
+	if flowExportService == nil {
+		return fmt.Errorf("Flow export service not initialized")
+	}
+
+	return flowExportService.Reset(ctx)
+}
+
+// GetTrafficThreshold retrieves the traffic threshold of an interface
+func (c *rtxClient) GetTrafficThreshold(ctx context.Context, iface string) (*TrafficThreshold, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	trafficThresholdService := c.trafficThresholdService
+	c.mu.Unlock()
+
+	if trafficThresholdService == nil {
+		return nil, fmt.Errorf("Traffic threshold service not initialized")
+	}
+
+	return trafficThresholdService.Get(ctx, iface)
+}
+
+// CreateTrafficThreshold creates a traffic threshold
+func (c *rtxClient) CreateTrafficThreshold(ctx context.Context, threshold TrafficThreshold) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	trafficThresholdService := c.trafficThresholdService
+	c.mu.Unlock()
+
+	if trafficThresholdService == nil {
+		return fmt.Errorf("Traffic threshold service not initialized")
+	}
+
+	return trafficThresholdService.Create(ctx, threshold)
+}
+
+// UpdateTrafficThreshold updates a traffic threshold
+func (c *rtxClient) UpdateTrafficThreshold(ctx context.Context, threshold TrafficThreshold) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	trafficThresholdService := c.trafficThresholdService
+	c.mu.Unlock()
+
+	if trafficThresholdService == nil {
+		return fmt.Errorf("Traffic threshold service not initialized")
+	}
+
+	return trafficThresholdService.Update(ctx, threshold)
+}
+
+// DeleteTrafficThreshold removes the traffic threshold of an interface
+func (c *rtxClient) DeleteTrafficThreshold(ctx context.Context, iface string) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	trafficThresholdService := c.trafficThresholdService
+	c.mu.Unlock()
+
+	if trafficThresholdService == nil {
+		return fmt.Errorf("Traffic threshold service not initialized")
+	}
+
+	return trafficThresholdService.Delete(ctx, iface)
+}
+
+// ListTrafficThresholds retrieves all traffic thresholds
+func (c *rtxClient) ListTrafficThresholds(ctx context.Context) ([]TrafficThreshold, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	trafficThresholdService := c.trafficThresholdService
+	c.mu.Unlock()
+
+	if trafficThresholdService == nil {
+		return nil, fmt.Errorf("Traffic threshold service not initialized")
+	}
+
+	return trafficThresholdService.List(ctx)
+}
+
+// GetExternalMemoryBackup retrieves external memory backup configuration
+func (c *rtxClient) GetExternalMemoryBackup(ctx context.Context) (*ExternalMemoryBackup, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	externalMemoryService := c.externalMemoryService
+	c.mu.Unlock()
+
+	if externalMemoryService == nil {
+		return nil, fmt.Errorf("External memory backup service not initialized")
+	}
+
+	return externalMemoryService.Get(ctx)
+}
+
+// ConfigureExternalMemoryBackup creates external memory backup configuration
+func (c *rtxClient) ConfigureExternalMemoryBackup(ctx context.Context, backup ExternalMemoryBackup) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	externalMemoryService := c.externalMemoryService
+	c.mu.Unlock()
+
+	if externalMemoryService == nil {
+		return fmt.Errorf("External memory backup service not initialized")
+	}
+
+	return externalMemoryService.Configure(ctx, backup)
+}
+
+// UpdateExternalMemoryBackup updates external memory backup configuration
+func (c *rtxClient) UpdateExternalMemoryBackup(ctx context.Context, backup ExternalMemoryBackup) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	externalMemoryService := c.externalMemoryService
+	c.mu.Unlock()
+
+	if externalMemoryService == nil {
+		return fmt.Errorf("External memory backup service not initialized")
+	}
+
+	return externalMemoryService.Update(ctx, backup)
+}
+
+// ResetExternalMemoryBackup removes external memory backup configuration
+func (c *rtxClient) ResetExternalMemoryBackup(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	externalMemoryService := c.externalMemoryService
+	c.mu.Unlock()
+
+	if externalMemoryService == nil {
+		return fmt.Errorf("External memory backup service not initialized")
+	}
+
+	return externalMemoryService.Reset(ctx)
+}
+
+// GetFirmwareUpdate retrieves firmware update settings
+func (c *rtxClient) GetFirmwareUpdate(ctx context.Context) (*FirmwareUpdate, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	firmwareUpdateService := c.firmwareUpdateService
+	c.mu.Unlock()
+
+	if firmwareUpdateService == nil {
+		return nil, fmt.Errorf("Firmware update service not initialized")
+	}
+
+	return firmwareUpdateService.Get(ctx)
+}
+
+// ConfigureFirmwareUpdate creates firmware update settings
+func (c *rtxClient) ConfigureFirmwareUpdate(ctx context.Context, update FirmwareUpdate) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	firmwareUpdateService := c.firmwareUpdateService
+	c.mu.Unlock()
+
+	if firmwareUpdateService == nil {
+		return fmt.Errorf("Firmware update service not initialized")
+	}
+
+	return firmwareUpdateService.Configure(ctx, update)
+}
+
+// UpdateFirmwareUpdate updates firmware update settings
+func (c *rtxClient) UpdateFirmwareUpdate(ctx context.Context, update FirmwareUpdate) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	firmwareUpdateService := c.firmwareUpdateService
+	c.mu.Unlock()
+
+	if firmwareUpdateService == nil {
+		return fmt.Errorf("Firmware update service not initialized")
+	}
+
+	return firmwareUpdateService.Update(ctx, update)
+}
+
+// ResetFirmwareUpdate removes firmware update settings
+func (c *rtxClient) ResetFirmwareUpdate(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	firmwareUpdateService := c.firmwareUpdateService
+	c.mu.Unlock()
+
+	if firmwareUpdateService == nil {
+		return fmt.Errorf("Firmware update service not initialized")
+	}
+
+	return firmwareUpdateService.Reset(ctx)
+}
+
+// GetClock retrieves clock settings
+func (c *rtxClient) GetClock(ctx context.Context) (*ClockConfig, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	clockService := c.clockService
+	c.mu.Unlock()
+
+	if clockService == nil {
+		return nil, fmt.Errorf("Clock service not initialized")
+	}
+
+	return clockService.Get(ctx)
+}
+
+// ConfigureClock creates clock settings
+func (c *rtxClient) ConfigureClock(ctx context.Context, config ClockConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	clockService := c.clockService
+	c.mu.Unlock()
+
+	if clockService == nil {
+		return fmt.Errorf("Clock service not initialized")
+	}
+
+	return clockService.Configure(ctx, config)
+}
+
+// UpdateClock updates clock settings
+func (c *rtxClient) UpdateClock(ctx context.Context, config ClockConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	clockService := c.clockService
+	c.mu.Unlock()
+
+	if clockService == nil {
+		return fmt.Errorf("Clock service not initialized")
+	}
+
+	return clockService.Update(ctx, config)
+}
+
+// ResetClock removes clock settings
+func (c *rtxClient) ResetClock(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	clockService := c.clockService
+	c.mu.Unlock()
+
+	if clockService == nil {
+		return fmt.Errorf("Clock service not initialized")
+	}
+
+	return clockService.Reset(ctx)
+}
+
+// GetRADIUS retrieves RADIUS client configuration
+func (c *rtxClient) GetRADIUS(ctx context.Context) (*RADIUSConfig, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	radiusService := c.radiusService
+	c.mu.Unlock()
+
+	if radiusService == nil {
+		return nil, fmt.Errorf("RADIUS service not initialized")
+	}
+
+	return radiusService.Get(ctx)
+}
+
+// ConfigureRADIUS creates RADIUS client configuration
+func (c *rtxClient) ConfigureRADIUS(ctx context.Context, config RADIUSConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	radiusService := c.radiusService
+	c.mu.Unlock()
+
+	if radiusService == nil {
+		return fmt.Errorf("RADIUS service not initialized")
+	}
+
+	return radiusService.Configure(ctx, config)
+}
+
+// UpdateRADIUS updates RADIUS client configuration
+func (c *rtxClient) UpdateRADIUS(ctx context.Context, config RADIUSConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	radiusService := c.radiusService
+	c.mu.Unlock()
+
+	if radiusService == nil {
+		return fmt.Errorf("RADIUS service not initialized")
+	}
+
+	return radiusService.Update(ctx, config)
+}
+
+// ResetRADIUS removes RADIUS client configuration
+func (c *rtxClient) ResetRADIUS(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	radiusService := c.radiusService
+	c.mu.Unlock()
+
+	if radiusService == nil {
+		return fmt.Errorf("RADIUS service not initialized")
+	}
+
+	return radiusService.Reset(ctx)
+}
+
+// GetPPPAuthUser retrieves a PP auth user
+func (c *rtxClient) GetPPPAuthUser(ctx context.Context, pp, username string) (*PPPAuthUser, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	pppAuthUserService := c.pppAuthUserService
+	c.mu.Unlock()
+
+	if pppAuthUserService == nil {
+		return nil, fmt.Errorf("PPP auth user service not initialized")
+	}
+
+	return pppAuthUserService.Get(ctx, pp, username)
+}
+
+// CreatePPPAuthUser creates a PP auth user
+func (c *rtxClient) CreatePPPAuthUser(ctx context.Context, user PPPAuthUser) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	pppAuthUserService := c.pppAuthUserService
+	c.mu.Unlock()
+
+	if pppAuthUserService == nil {
+		return fmt.Errorf("PPP auth user service not initialized")
+	}
+
+	return pppAuthUserService.Create(ctx, user)
+}
+
+// UpdatePPPAuthUser updates a PP auth user
+func (c *rtxClient) UpdatePPPAuthUser(ctx context.Context, user PPPAuthUser) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	pppAuthUserService := c.pppAuthUserService
+	c.mu.Unlock()
+
+	if pppAuthUserService == nil {
+		return fmt.Errorf("PPP auth user service not initialized")
+	}
+
+	return pppAuthUserService.Update(ctx, user)
+}
+
+// DeletePPPAuthUser removes a PP auth user
+func (c *rtxClient) DeletePPPAuthUser(ctx context.Context, pp, username string) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	pppAuthUserService := c.pppAuthUserService
+	c.mu.Unlock()
+
+	if pppAuthUserService == nil {
+		return fmt.Errorf("PPP auth user service not initialized")
+	}
+
+	return pppAuthUserService.Delete(ctx, pp, username)
+}
+
+// ListPPPAuthUsers retrieves all PP auth users
+func (c *rtxClient) ListPPPAuthUsers(ctx context.Context) ([]PPPAuthUser, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	pppAuthUserService := c.pppAuthUserService
+	c.mu.Unlock()
+
+	if pppAuthUserService == nil {
+		return nil, fmt.Errorf("PPP auth user service not initialized")
+	}
+
+	return pppAuthUserService.List(ctx)
+}
+
+// GetVPNAddressPool retrieves the address pool of a PP context
+func (c *rtxClient) GetVPNAddressPool(ctx context.Context, pp string) (*VPNAddressPool, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	vpnAddressPoolService := c.vpnAddressPoolService
+	c.mu.Unlock()
+
+	if vpnAddressPoolService == nil {
+		return nil, fmt.Errorf("VPN address pool service not initialized")
+	}
+
+	return vpnAddressPoolService.Get(ctx, pp)
+}
+
+// CreateVPNAddressPool creates an address pool
+func (c *rtxClient) CreateVPNAddressPool(ctx context.Context, pool VPNAddressPool) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	vpnAddressPoolService := c.vpnAddressPoolService
+	c.mu.Unlock()
+
+	if vpnAddressPoolService == nil {
+		return fmt.Errorf("VPN address pool service not initialized")
+	}
+
+	return vpnAddressPoolService.Create(ctx, pool)
+}
+
+// UpdateVPNAddressPool updates an address pool
+func (c *rtxClient) UpdateVPNAddressPool(ctx context.Context, pool VPNAddressPool) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	vpnAddressPoolService := c.vpnAddressPoolService
+	c.mu.Unlock()
+
+	if vpnAddressPoolService == nil {
+		return fmt.Errorf("VPN address pool service not initialized")
+	}
+
+	return vpnAddressPoolService.Update(ctx, pool)
+}
+
+// DeleteVPNAddressPool removes the address pool of a PP context
+func (c *rtxClient) DeleteVPNAddressPool(ctx context.Context, pp string) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	vpnAddressPoolService := c.vpnAddressPoolService
+	c.mu.Unlock()
+
+	if vpnAddressPoolService == nil {
+		return fmt.Errorf("VPN address pool service not initialized")
+	}
+
+	return vpnAddressPoolService.Delete(ctx, pp)
+}
+
+// ListVPNAddressPools retrieves all address pools
+func (c *rtxClient) ListVPNAddressPools(ctx context.Context) ([]VPNAddressPool, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	vpnAddressPoolService := c.vpnAddressPoolService
+	c.mu.Unlock()
+
+	if vpnAddressPoolService == nil {
+		return nil, fmt.Errorf("VPN address pool service not initialized")
+	}
+
+	return vpnAddressPoolService.List(ctx)
+}
+
+// GetCertificate retrieves a registered certificate
+func (c *rtxClient) GetCertificate(ctx context.Context, id int) (*Certificate, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	certificateService := c.certificateService
+	c.mu.Unlock()
+
+	if certificateService == nil {
+		return nil, fmt.Errorf("Certificate service not initialized")
+	}
+
+	return certificateService.Get(ctx, id)
+}
+
+// CreateCertificate uploads and registers a certificate
+func (c *rtxClient) CreateCertificate(ctx context.Context, cert Certificate) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	certificateService := c.certificateService
+	c.mu.Unlock()
+
+	if certificateService == nil {
+		return fmt.Errorf("Certificate service not initialized")
+	}
+
+	return certificateService.Create(ctx, cert)
+}
+
+// UpdateCertificate replaces the content of a registered certificate
+func (c *rtxClient) UpdateCertificate(ctx context.Context, cert Certificate) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	certificateService := c.certificateService
+	c.mu.Unlock()
+
+	if certificateService == nil {
+		return fmt.Errorf("Certificate service not initialized")
+	}
+
+	return certificateService.Update(ctx, cert)
+}
+
+// DeleteCertificate unregisters a certificate and removes its file
+func (c *rtxClient) DeleteCertificate(ctx context.Context, id int) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	certificateService := c.certificateService
+	c.mu.Unlock()
+
+	if certificateService == nil {
+		return fmt.Errorf("Certificate service not initialized")
+	}
+
+	return certificateService.Delete(ctx, id)
+}
+
+// GetIKEv2Tunnel retrieves an IKEv2 tunnel
+func (c *rtxClient) GetIKEv2Tunnel(ctx context.Context, tunnelID int) (*IKEv2Tunnel, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	ikev2TunnelService := c.ikev2TunnelService
+	c.mu.Unlock()
+
+	if ikev2TunnelService == nil {
+		return nil, fmt.Errorf("IKEv2 tunnel service not initialized")
+	}
+
+	return ikev2TunnelService.Get(ctx, tunnelID)
+}
+
+// CreateIKEv2Tunnel creates an IKEv2 tunnel
+func (c *rtxClient) CreateIKEv2Tunnel(ctx context.Context, tunnel IKEv2Tunnel) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	ikev2TunnelService := c.ikev2TunnelService
+	c.mu.Unlock()
+
+	if ikev2TunnelService == nil {
+		return fmt.Errorf("IKEv2 tunnel service not initialized")
+	}
+
+	return ikev2TunnelService.Create(ctx, tunnel)
+}
+
+// UpdateIKEv2Tunnel updates an IKEv2 tunnel
+func (c *rtxClient) UpdateIKEv2Tunnel(ctx context.Context, tunnel IKEv2Tunnel) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	ikev2TunnelService := c.ikev2TunnelService
+	c.mu.Unlock()
+
+	if ikev2TunnelService == nil {
+		return fmt.Errorf("IKEv2 tunnel service not initialized")
+	}
+
+	return ikev2TunnelService.Update(ctx, tunnel)
+}
+
+// DeleteIKEv2Tunnel removes an IKEv2 tunnel
+func (c *rtxClient) DeleteIKEv2Tunnel(ctx context.Context, tunnelID int) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	ikev2TunnelService := c.ikev2TunnelService
+	c.mu.Unlock()
+
+	if ikev2TunnelService == nil {
+		return fmt.Errorf("IKEv2 tunnel service not initialized")
+	}
+
+	return ikev2TunnelService.Delete(ctx, tunnelID)
+}
+
+// ListIKEv2Tunnels retrieves all IKEv2 tunnels
+func (c *rtxClient) ListIKEv2Tunnels(ctx context.Context) ([]IKEv2Tunnel, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	ikev2TunnelService := c.ikev2TunnelService
+	c.mu.Unlock()
+
+	if ikev2TunnelService == nil {
+		return nil, fmt.Errorf("IKEv2 tunnel service not initialized")
+	}
+
+	return ikev2TunnelService.List(ctx)
+}
+
+// GetIPv6NeighborStatic retrieves a static IPv6 neighbor entry
+func (c *rtxClient) GetIPv6NeighborStatic(ctx context.Context, iface, address string) (*IPv6NeighborStatic, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	ipv6NeighborStaticService := c.ipv6NeighborStaticService
+	c.mu.Unlock()
+
+	if ipv6NeighborStaticService == nil {
+		return nil, fmt.Errorf("IPv6 neighbor static service not initialized")
+	}
+
+	return ipv6NeighborStaticService.Get(ctx, iface, address)
+}
+
+// CreateIPv6NeighborStatic creates a static IPv6 neighbor entry
+func (c *rtxClient) CreateIPv6NeighborStatic(ctx context.Context, entry IPv6NeighborStatic) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	ipv6NeighborStaticService := c.ipv6NeighborStaticService
+	c.mu.Unlock()
+
+	if ipv6NeighborStaticService == nil {
+		return fmt.Errorf("IPv6 neighbor static service not initialized")
+	}
+
+	return ipv6NeighborStaticService.Create(ctx, entry)
+}
+
+// UpdateIPv6NeighborStatic updates a static IPv6 neighbor entry
+func (c *rtxClient) UpdateIPv6NeighborStatic(ctx context.Context, entry IPv6NeighborStatic) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	ipv6NeighborStaticService := c.ipv6NeighborStaticService
+	c.mu.Unlock()
+
+	if ipv6NeighborStaticService == nil {
+		return fmt.Errorf("IPv6 neighbor static service not initialized")
+	}
+
+	return ipv6NeighborStaticService.Update(ctx, entry)
+}
+
+// DeleteIPv6NeighborStatic removes a static IPv6 neighbor entry
+func (c *rtxClient) DeleteIPv6NeighborStatic(ctx context.Context, iface, address string) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	ipv6NeighborStaticService := c.ipv6NeighborStaticService
+	c.mu.Unlock()
+
+	if ipv6NeighborStaticService == nil {
+		return fmt.Errorf("IPv6 neighbor static service not initialized")
+	}
+
+	return ipv6NeighborStaticService.Delete(ctx, iface, address)
+}
+
+// ListIPv6NeighborStatics retrieves all static IPv6 neighbor entries
+func (c *rtxClient) ListIPv6NeighborStatics(ctx context.Context) ([]IPv6NeighborStatic, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	ipv6NeighborStaticService := c.ipv6NeighborStaticService
+	c.mu.Unlock()
+
+	if ipv6NeighborStaticService == nil {
+		return nil, fmt.Errorf("IPv6 neighbor static service not initialized")
+	}
+
+	return ipv6NeighborStaticService.List(ctx)
+}
+
+// GetIPv6RTADV retrieves the Router Advertisement settings of an interface
+func (c *rtxClient) GetIPv6RTADV(ctx context.Context, iface string) (*IPv6RTADV, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	ipv6RTADVService := c.ipv6RTADVService
+	c.mu.Unlock()
+
+	if ipv6RTADVService == nil {
+		return nil, fmt.Errorf("IPv6 RTADV service not initialized")
+	}
+
+	return ipv6RTADVService.Get(ctx, iface)
+}
+
+// ConfigureIPv6RTADV configures Router Advertisement on an interface
+func (c *rtxClient) ConfigureIPv6RTADV(ctx context.Context, rtadv IPv6RTADV) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	ipv6RTADVService := c.ipv6RTADVService
+	c.mu.Unlock()
+
+	if ipv6RTADVService == nil {
+		return fmt.Errorf("IPv6 RTADV service not initialized")
+	}
+
+	return ipv6RTADVService.Configure(ctx, rtadv)
+}
+
+// DeleteIPv6RTADV stops Router Advertisement on an interface
+func (c *rtxClient) DeleteIPv6RTADV(ctx context.Context, iface string) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	ipv6RTADVService := c.ipv6RTADVService
+	c.mu.Unlock()
+
+	if ipv6RTADVService == nil {
+		return fmt.Errorf("IPv6 RTADV service not initialized")
+	}
+
+	return ipv6RTADVService.Delete(ctx, iface)
+}
+
+// GetLinkAggregation retrieves a link aggregation group
+func (c *rtxClient) GetLinkAggregation(ctx context.Context, id int) (*LinkAggregation, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	linkAggregationService := c.linkAggregationService
+	c.mu.Unlock()
+
+	if linkAggregationService == nil {
+		return nil, fmt.Errorf("Link aggregation service not initialized")
+	}
+
+	return linkAggregationService.Get(ctx, id)
+}
+
+// CreateLinkAggregation creates a link aggregation group
+func (c *rtxClient) CreateLinkAggregation(ctx context.Context, lag LinkAggregation) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	linkAggregationService := c.linkAggregationService
+	c.mu.Unlock()
+
+	if linkAggregationService == nil {
+		return fmt.Errorf("Link aggregation service not initialized")
+	}
+
+	return linkAggregationService.Create(ctx, lag)
+}
+
+// UpdateLinkAggregation updates a link aggregation group
+func (c *rtxClient) UpdateLinkAggregation(ctx context.Context, lag LinkAggregation) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	linkAggregationService := c.linkAggregationService
+	c.mu.Unlock()
+
+	if linkAggregationService == nil {
+		return fmt.Errorf("Link aggregation service not initialized")
+	}
+
+	return linkAggregationService.Update(ctx, lag)
+}
+
+// DeleteLinkAggregation removes a link aggregation group
+func (c *rtxClient) DeleteLinkAggregation(ctx context.Context, id int) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	linkAggregationService := c.linkAggregationService
+	c.mu.Unlock()
+
+	if linkAggregationService == nil {
+		return fmt.Errorf("Link aggregation service not initialized")
+	}
+
+	return linkAggregationService.Delete(ctx, id)
+}
+
+// ListLinkAggregations retrieves all link aggregation groups
+func (c *rtxClient) ListLinkAggregations(ctx context.Context) ([]LinkAggregation, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	linkAggregationService := c.linkAggregationService
+	c.mu.Unlock()
+
+	if linkAggregationService == nil {
+		return nil, fmt.Errorf("Link aggregation service not initialized")
+	}
+
+	return linkAggregationService.List(ctx)
+}
+
+// GetLoopbackInterface retrieves the address of a loopback interface
+func (c *rtxClient) GetLoopbackInterface(ctx context.Context, name string) (*LoopbackInterface, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	loopbackInterfaceService := c.loopbackInterfaceService
+	c.mu.Unlock()
+
+	if loopbackInterfaceService == nil {
+		return nil, fmt.Errorf("Loopback interface service not initialized")
+	}
+
+	return loopbackInterfaceService.Get(ctx, name)
+}
+
+// CreateLoopbackInterface assigns an address to a loopback interface
+func (c *rtxClient) CreateLoopbackInterface(ctx context.Context, loopback LoopbackInterface) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	loopbackInterfaceService := c.loopbackInterfaceService
+	c.mu.Unlock()
+
+	if loopbackInterfaceService == nil {
+		return fmt.Errorf("Loopback interface service not initialized")
+	}
+
+	return loopbackInterfaceService.Create(ctx, loopback)
+}
+
+// UpdateLoopbackInterface updates the address of a loopback interface
+func (c *rtxClient) UpdateLoopbackInterface(ctx context.Context, loopback LoopbackInterface) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	loopbackInterfaceService := c.loopbackInterfaceService
+	c.mu.Unlock()
+
+	if loopbackInterfaceService == nil {
+		return fmt.Errorf("Loopback interface service not initialized")
+	}
+
+	return loopbackInterfaceService.Update(ctx, loopback)
+}
+
+// DeleteLoopbackInterface removes the address of a loopback interface
+func (c *rtxClient) DeleteLoopbackInterface(ctx context.Context, name string) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	loopbackInterfaceService := c.loopbackInterfaceService
+	c.mu.Unlock()
+
+	if loopbackInterfaceService == nil {
+		return fmt.Errorf("Loopback interface service not initialized")
+	}
+
+	return loopbackInterfaceService.Delete(ctx, name)
+}
+
+// ListLoopbackInterfaces retrieves all configured loopback interfaces
+func (c *rtxClient) ListLoopbackInterfaces(ctx context.Context) ([]LoopbackInterface, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	loopbackInterfaceService := c.loopbackInterfaceService
+	c.mu.Unlock()
+
+	if loopbackInterfaceService == nil {
+		return nil, fmt.Errorf("Loopback interface service not initialized")
+	}
+
+	return loopbackInterfaceService.List(ctx)
+}
+
+// GetL2MSManagedSwitch retrieves the settings of a switch managed through an interface
+func (c *rtxClient) GetL2MSManagedSwitch(ctx context.Context, iface, sw string) (*L2MSManagedSwitch, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	l2msService := c.l2msService
+	c.mu.Unlock()
+
+	if l2msService == nil {
+		return nil, fmt.Errorf("L2MS service not initialized")
+	}
+
+	return l2msService.Get(ctx, iface, sw)
+}
+
+// CreateL2MSManagedSwitch enables the L2MS manager and pushes settings to a switch
+func (c *rtxClient) CreateL2MSManagedSwitch(ctx context.Context, sw L2MSManagedSwitch) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	l2msService := c.l2msService
+	c.mu.Unlock()
+
+	if l2msService == nil {
+		return fmt.Errorf("L2MS service not initialized")
+	}
+
+	return l2msService.Create(ctx, sw)
+}
+
+// UpdateL2MSManagedSwitch updates the settings of a managed switch
+func (c *rtxClient) UpdateL2MSManagedSwitch(ctx context.Context, sw L2MSManagedSwitch) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	l2msService := c.l2msService
+	c.mu.Unlock()
+
+	if l2msService == nil {
+		return fmt.Errorf("L2MS service not initialized")
+	}
+
+	return l2msService.Update(ctx, sw)
+}
+
+// DeleteL2MSManagedSwitch resets the settings of a managed switch to their defaults
+func (c *rtxClient) DeleteL2MSManagedSwitch(ctx context.Context, iface, sw string) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	l2msService := c.l2msService
+	c.mu.Unlock()
+
+	if l2msService == nil {
+		return fmt.Errorf("L2MS service not initialized")
+	}
+
+	return l2msService.Delete(ctx, iface, sw)
+}
+
+// ListL2MSSwitches retrieves the switches discovered by the L2MS manager
+func (c *rtxClient) ListL2MSSwitches(ctx context.Context) ([]L2MSSwitchStatus, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	l2msService := c.l2msService
+	c.mu.Unlock()
+
+	if l2msService == nil {
+		return nil, fmt.Errorf("L2MS service not initialized")
+	}
+
+	return l2msService.ListSwitches(ctx)
+}
+
+// GetWLAN retrieves the wireless LAN configuration
+func (c *rtxClient) GetWLAN(ctx context.Context) (*WLANConfig, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	wlanService := c.wlanService
+	c.mu.Unlock()
+
+	if wlanService == nil {
+		return nil, fmt.Errorf("WLAN service not initialized")
+	}
+
+	return wlanService.Get(ctx)
+}
+
+// ConfigureWLAN creates the wireless LAN configuration
+func (c *rtxClient) ConfigureWLAN(ctx context.Context, config WLANConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	wlanService := c.wlanService
+	c.mu.Unlock()
+
+	if wlanService == nil {
+		return fmt.Errorf("WLAN service not initialized")
+	}
+
+	return wlanService.Configure(ctx, config)
+}
+
+// UpdateWLAN updates the wireless LAN configuration
+func (c *rtxClient) UpdateWLAN(ctx context.Context, config WLANConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	wlanService := c.wlanService
+	c.mu.Unlock()
+
+	if wlanService == nil {
+		return fmt.Errorf("WLAN service not initialized")
+	}
+
+	return wlanService.Update(ctx, config)
+}
+
+// ResetWLAN disables the wireless LAN and removes its SSIDs
+func (c *rtxClient) ResetWLAN(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	wlanService := c.wlanService
+	c.mu.Unlock()
+
+	if wlanService == nil {
+		return fmt.Errorf("WLAN service not initialized")
+	}
+
+	return wlanService.Reset(ctx)
+}
+
+// GetUSBHost retrieves the USB host settings
+func (c *rtxClient) GetUSBHost(ctx context.Context) (*USBHostConfig, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	usbHostService := c.usbHostService
+	c.mu.Unlock()
+
+	if usbHostService == nil {
+		return nil, fmt.Errorf("USB host service not initialized")
+	}
+
+	return usbHostService.Get(ctx)
+}
+
+// ConfigureUSBHost creates the USB host settings
+func (c *rtxClient) ConfigureUSBHost(ctx context.Context, config USBHostConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	usbHostService := c.usbHostService
+	c.mu.Unlock()
+
+	if usbHostService == nil {
+		return fmt.Errorf("USB host service not initialized")
+	}
+
+	return usbHostService.Configure(ctx, config)
+}
+
+// UpdateUSBHost updates the USB host settings
+func (c *rtxClient) UpdateUSBHost(ctx context.Context, config USBHostConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	usbHostService := c.usbHostService
+	c.mu.Unlock()
+
+	if usbHostService == nil {
+		return fmt.Errorf("USB host service not initialized")
+	}
+
+	return usbHostService.Update(ctx, config)
+}
+
+// ResetUSBHost restores the default USB host settings
+func (c *rtxClient) ResetUSBHost(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	usbHostService := c.usbHostService
+	c.mu.Unlock()
+
+	if usbHostService == nil {
+		return fmt.Errorf("USB host service not initialized")
+	}
+
+	return usbHostService.Reset(ctx)
+}
+
+// GetMobileWAN retrieves the mobile WAN connection on a PP interface
+func (c *rtxClient) GetMobileWAN(ctx context.Context, ppNum int) (*MobileWAN, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	mobileWANService := c.mobileWANService
+	c.mu.Unlock()
+
+	if mobileWANService == nil {
+		return nil, fmt.Errorf("Mobile WAN service not initialized")
+	}
+
+	return mobileWANService.Get(ctx, ppNum)
+}
+
+// CreateMobileWAN creates a mobile WAN connection
+func (c *rtxClient) CreateMobileWAN(ctx context.Context, wan MobileWAN) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	mobileWANService := c.mobileWANService
+	c.mu.Unlock()
+
+	if mobileWANService == nil {
+		return fmt.Errorf("Mobile WAN service not initialized")
+	}
+
+	return mobileWANService.Create(ctx, wan)
+}
+
+// UpdateMobileWAN updates a mobile WAN connection
+func (c *rtxClient) UpdateMobileWAN(ctx context.Context, wan MobileWAN) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	mobileWANService := c.mobileWANService
+	c.mu.Unlock()
+
+	if mobileWANService == nil {
+		return fmt.Errorf("Mobile WAN service not initialized")
+	}
+
+	return mobileWANService.Update(ctx, wan)
+}
+
+// DeleteMobileWAN removes the mobile WAN connection on a PP interface
+func (c *rtxClient) DeleteMobileWAN(ctx context.Context, ppNum int) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	mobileWANService := c.mobileWANService
+	c.mu.Unlock()
+
+	if mobileWANService == nil {
+		return fmt.Errorf("Mobile WAN service not initialized")
+	}
+
+	return mobileWANService.Delete(ctx, ppNum)
+}
+
+// ListMobileWANs retrieves all mobile WAN connections
+func (c *rtxClient) ListMobileWANs(ctx context.Context) ([]MobileWAN, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	mobileWANService := c.mobileWANService
+	c.mu.Unlock()
+
+	if mobileWANService == nil {
+		return nil, fmt.Errorf("Mobile WAN service not initialized")
+	}
+
+	return mobileWANService.List(ctx)
+}
+
+// GetConfigBlock retrieves the configuration lines owned by a config block
+func (c *rtxClient) GetConfigBlock(ctx context.Context, block ConfigBlock) (*ConfigBlock, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	configBlockService := c.configBlockService
+	c.mu.Unlock()
+
+	if configBlockService == nil {
+		return nil, fmt.Errorf("Config block service not initialized")
+	}
+
+	return configBlockService.Get(ctx, block)
+}
+
+// CreateConfigBlock applies a config block
+func (c *rtxClient) CreateConfigBlock(ctx context.Context, block ConfigBlock) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	configBlockService := c.configBlockService
+	c.mu.Unlock()
+
+	if configBlockService == nil {
+		return fmt.Errorf("Config block service not initialized")
+	}
+
+	return configBlockService.Create(ctx, block)
+}
+
+// UpdateConfigBlock applies a config block and removes lines no longer configured
+func (c *rtxClient) UpdateConfigBlock(ctx context.Context, previous, block ConfigBlock) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	configBlockService := c.configBlockService
+	c.mu.Unlock()
+
+	if configBlockService == nil {
+		return fmt.Errorf("Config block service not initialized")
+	}
+
+	return configBlockService.Update(ctx, previous, block)
+}
+
+// DeleteConfigBlock removes all configuration lines owned by a config block
+func (c *rtxClient) DeleteConfigBlock(ctx context.Context, block ConfigBlock) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	configBlockService := c.configBlockService
+	c.mu.Unlock()
+
+	if configBlockService == nil {
+		return fmt.Errorf("Config block service not initialized")
+	}
+
+	return configBlockService.Delete(ctx, block)
+}
+
+// PushBulkConfig writes a complete configuration file to the router
+func (c *rtxClient) PushBulkConfig(ctx context.Context, bulk BulkConfig) (string, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return "", fmt.Errorf("client not connected")
+	}
+	bulkConfigService := c.bulkConfigService
+	c.mu.Unlock()
+
+	if bulkConfigService == nil {
+		return "", fmt.Errorf("Bulk config service not initialized")
+	}
+
+	return bulkConfigService.Push(ctx, bulk)
+}
+
+// GetSystemSettings retrieves the global forwarding settings
+func (c *rtxClient) GetSystemSettings(ctx context.Context) (*SystemSettings, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	systemSettingsService := c.systemSettingsService
+	c.mu.Unlock()
+
+	if systemSettingsService == nil {
+		return nil, fmt.Errorf("System settings service not initialized")
+	}
+
+	return systemSettingsService.Get(ctx)
+}
+
+// ConfigureSystemSettings creates the global forwarding settings
+func (c *rtxClient) ConfigureSystemSettings(ctx context.Context, settings SystemSettings) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	systemSettingsService := c.systemSettingsService
+	c.mu.Unlock()
+
+	if systemSettingsService == nil {
+		return fmt.Errorf("System settings service not initialized")
+	}
+
+	return systemSettingsService.Configure(ctx, settings)
+}
+
+// UpdateSystemSettings updates the global forwarding settings
+func (c *rtxClient) UpdateSystemSettings(ctx context.Context, settings SystemSettings) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	systemSettingsService := c.systemSettingsService
+	c.mu.Unlock()
+
+	if systemSettingsService == nil {
+		return fmt.Errorf("System settings service not initialized")
+	}
+
+	return systemSettingsService.Update(ctx, settings)
+}
+
+// ResetSystemSettings restores the default global forwarding settings
+func (c *rtxClient) ResetSystemSettings(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	systemSettingsService := c.systemSettingsService
+	c.mu.Unlock()
+
+	if systemSettingsService == nil {
+		return fmt.Errorf("System settings service not initialized")
+	}
+
+	return systemSettingsService.Reset(ctx)
+}
+
+// GetProxyARP retrieves the interfaces with proxy ARP enabled
+func (c *rtxClient) GetProxyARP(ctx context.Context) (*ProxyARPConfig, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	proxyARPService := c.proxyARPService
+	c.mu.Unlock()
+
+	if proxyARPService == nil {
+		return nil, fmt.Errorf("Proxy ARP service not initialized")
+	}
+
+	return proxyARPService.Get(ctx)
+}
+
+// ConfigureProxyARP enables proxy ARP on exactly the given interfaces
+func (c *rtxClient) ConfigureProxyARP(ctx context.Context, config ProxyARPConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	proxyARPService := c.proxyARPService
+	c.mu.Unlock()
+
+	if proxyARPService == nil {
+		return fmt.Errorf("Proxy ARP service not initialized")
+	}
+
+	return proxyARPService.Configure(ctx, config)
+}
+
+// UpdateProxyARP updates the interfaces with proxy ARP enabled
+func (c *rtxClient) UpdateProxyARP(ctx context.Context, config ProxyARPConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	proxyARPService := c.proxyARPService
+	c.mu.Unlock()
+
+	if proxyARPService == nil {
+		return fmt.Errorf("Proxy ARP service not initialized")
+	}
+
+	return proxyARPService.Update(ctx, config)
+}
+
+// ResetProxyARP disables proxy ARP on all interfaces
+func (c *rtxClient) ResetProxyARP(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	proxyARPService := c.proxyARPService
+	c.mu.Unlock()
+
+	if proxyARPService == nil {
+		return fmt.Errorf("Proxy ARP service not initialized")
+	}
+
+	return proxyARPService.Reset(ctx)
+}
+
+// GetPortMirroring retrieves the mirroring session of a LAN interface
+func (c *rtxClient) GetPortMirroring(ctx context.Context, iface string) (*PortMirroring, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	portMirroringService := c.portMirroringService
+	c.mu.Unlock()
+
+	if portMirroringService == nil {
+		return nil, fmt.Errorf("Port mirroring service not initialized")
+	}
+
+	return portMirroringService.Get(ctx, iface)
+}
+
+// CreatePortMirroring starts a mirroring session on a LAN interface
+func (c *rtxClient) CreatePortMirroring(ctx context.Context, session PortMirroring) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	portMirroringService := c.portMirroringService
+	c.mu.Unlock()
+
+	if portMirroringService == nil {
+		return fmt.Errorf("Port mirroring service not initialized")
+	}
+
+	return portMirroringService.Create(ctx, session)
+}
+
+// UpdatePortMirroring updates a mirroring session
+func (c *rtxClient) UpdatePortMirroring(ctx context.Context, session PortMirroring) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	portMirroringService := c.portMirroringService
+	c.mu.Unlock()
+
+	if portMirroringService == nil {
+		return fmt.Errorf("Port mirroring service not initialized")
+	}
+
+	return portMirroringService.Update(ctx, session)
+}
+
+// DeletePortMirroring stops the mirroring session of a LAN interface
+func (c *rtxClient) DeletePortMirroring(ctx context.Context, iface string) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	portMirroringService := c.portMirroringService
+	c.mu.Unlock()
+
+	if portMirroringService == nil {
+		return fmt.Errorf("Port mirroring service not initialized")
+	}
+
+	return portMirroringService.Delete(ctx, iface)
+}
+
+// ListPortMirrorings retrieves all port mirroring sessions
+func (c *rtxClient) ListPortMirrorings(ctx context.Context) ([]PortMirroring, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	portMirroringService := c.portMirroringService
+	c.mu.Unlock()
+
+	if portMirroringService == nil {
+		return nil, fmt.Errorf("Port mirroring service not initialized")
+	}
+
+	return portMirroringService.List(ctx)
+}
+
+// GetLLDP retrieves the LLDP settings
+func (c *rtxClient) GetLLDP(ctx context.Context) (*LLDPConfig, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	lldpService := c.lldpService
+	c.mu.Unlock()
+
+	if lldpService == nil {
+		return nil, fmt.Errorf("LLDP service not initialized")
+	}
+
+	return lldpService.Get(ctx)
+}
+
+// ConfigureLLDP applies the LLDP settings
+func (c *rtxClient) ConfigureLLDP(ctx context.Context, config LLDPConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	lldpService := c.lldpService
+	c.mu.Unlock()
+
+	if lldpService == nil {
+		return fmt.Errorf("LLDP service not initialized")
+	}
+
+	return lldpService.Configure(ctx, config)
+}
+
+// UpdateLLDP updates the LLDP settings
+func (c *rtxClient) UpdateLLDP(ctx context.Context, config LLDPConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	lldpService := c.lldpService
+	c.mu.Unlock()
+
+	if lldpService == nil {
+		return fmt.Errorf("LLDP service not initialized")
+	}
+
+	return lldpService.Update(ctx, config)
+}
+
+// ResetLLDP disables LLDP and restores the default interface modes
+func (c *rtxClient) ResetLLDP(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	lldpService := c.lldpService
+	c.mu.Unlock()
+
+	if lldpService == nil {
+		return fmt.Errorf("LLDP service not initialized")
+	}
+
+	return lldpService.Reset(ctx)
+}
+
+// GetICMPStealth retrieves the ICMP hardening settings
+func (c *rtxClient) GetICMPStealth(ctx context.Context) (*ICMPStealthConfig, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	icmpStealthService := c.icmpStealthService
+	c.mu.Unlock()
+
+	if icmpStealthService == nil {
+		return nil, fmt.Errorf("ICMP stealth service not initialized")
+	}
+
+	return icmpStealthService.Get(ctx)
+}
+
+// ConfigureICMPStealth applies the ICMP hardening settings
+func (c *rtxClient) ConfigureICMPStealth(ctx context.Context, config ICMPStealthConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	icmpStealthService := c.icmpStealthService
+	c.mu.Unlock()
+
+	if icmpStealthService == nil {
+		return fmt.Errorf("ICMP stealth service not initialized")
+	}
+
+	return icmpStealthService.Configure(ctx, config)
+}
+
+// UpdateICMPStealth updates the ICMP hardening settings
+func (c *rtxClient) UpdateICMPStealth(ctx context.Context, config ICMPStealthConfig) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	icmpStealthService := c.icmpStealthService
+	c.mu.Unlock()
+
+	if icmpStealthService == nil {
+		return fmt.Errorf("ICMP stealth service not initialized")
+	}
+
+	return icmpStealthService.Update(ctx, config)
+}
+
+// ResetICMPStealth restores the default ICMP settings
+func (c *rtxClient) ResetICMPStealth(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return fmt.Errorf("client not connected")
+	}
+	icmpStealthService := c.icmpStealthService
+	c.mu.Unlock()
+
+	if icmpStealthService == nil {
+		return fmt.Errorf("ICMP stealth service not initialized")
+	}
+
+	return icmpStealthService.Reset(ctx)
+}
+
+// ListInterfaceStatuses retrieves the status of LAN interfaces, discovering them when no names are given
+func (c *rtxClient) ListInterfaceStatuses(ctx context.Context, names []string) ([]InterfaceStatus, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	interfaceStatusService := c.interfaceStatusService
+	c.mu.Unlock()
+
+	if interfaceStatusService == nil {
+		return nil, fmt.Errorf("Interface status service not initialized")
+	}
+
+	return interfaceStatusService.List(ctx, names)
+}
+
+// ListIPsecSAs retrieves the established IPsec and ISAKMP security associations
+func (c *rtxClient) ListIPsecSAs(ctx context.Context) ([]IPsecSA, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	ipsecSAService := c.ipsecSAService
+	c.mu.Unlock()
+
+	if ipsecSAService == nil {
+		return nil, fmt.Errorf("IPsec SA service not initialized")
+	}
+
+	return ipsecSAService.List(ctx)
+}
+
+// GetPPStatus retrieves the session state of a PP interface
+func (c *rtxClient) GetPPStatus(ctx context.Context, ppNum int) (*PPStatus, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	ppStatusService := c.ppStatusService
+	c.mu.Unlock()
+
+	if ppStatusService == nil {
+		return nil, fmt.Errorf("PP status service not initialized")
+	}
+
+	return ppStatusService.Get(ctx, ppNum)
+}
+
+// GetRunningConfig retrieves the raw router configuration, optionally filtered by a grep pattern
+func (c *rtxClient) GetRunningConfig(ctx context.Context, pattern string) (string, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return "", fmt.Errorf("client not connected")
+	}
+	runningConfigService := c.runningConfigService
+	c.mu.Unlock()
+
+	if runningConfigService == nil {
+		return "", fmt.Errorf("Running config service not initialized")
+	}
+
+	return runningConfigService.Get(ctx, pattern)
+}
+
+// GetConfigSection retrieves the configuration of one PP or tunnel interface
+func (c *rtxClient) GetConfigSection(ctx context.Context, section string, number int) (string, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return "", fmt.Errorf("client not connected")
+	}
+	runningConfigService := c.runningConfigService
+	c.mu.Unlock()
+
+	if runningConfigService == nil {
+		return "", fmt.Errorf("Running config service not initialized")
+	}
+
+	return runningConfigService.GetSection(ctx, section, number)
+}
+
+// ListLogEntries retrieves log entries, optionally filtered by keyword and limited to the last entries
+func (c *rtxClient) ListLogEntries(ctx context.Context, keyword string, tail int) ([]LogEntry, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	logService := c.logService
+	c.mu.Unlock()
+
+	if logService == nil {
+		return nil, fmt.Errorf("Log service not initialized")
+	}
+
+	return logService.List(ctx, keyword, tail)
+}
+
+// GetEnvironment retrieves CPU load, memory usage, temperature and fan status
+func (c *rtxClient) GetEnvironment(ctx context.Context) (*Environment, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	environmentService := c.environmentService
+	c.mu.Unlock()
+
+	if environmentService == nil {
+		return nil, fmt.Errorf("Environment service not initialized")
+	}
+
+	return environmentService.Get(ctx)
+}
+
+// ListIPv6Neighbors retrieves the IPv6 neighbor cache, optionally limited to one interface
+func (c *rtxClient) ListIPv6Neighbors(ctx context.Context, iface string) ([]IPv6Neighbor, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	ipv6NeighborService := c.ipv6NeighborService
+	c.mu.Unlock()
+
+	if ipv6NeighborService == nil {
+		return nil, fmt.Errorf("IPv6 neighbor service not initialized")
+	}
+
+	return ipv6NeighborService.List(ctx, iface)
+}
+
+// GetDNSCache retrieves the DNS cache statistics and the entries for name (all entries if empty)
+func (c *rtxClient) GetDNSCache(ctx context.Context, name string) (*DNSCache, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	dnsCacheService := c.dnsCacheService
+	c.mu.Unlock()
+
+	if dnsCacheService == nil {
+		return nil, fmt.Errorf("DNS cache service not initialized")
+	}
+
+	return dnsCacheService.Get(ctx, name)
+}
+
+// ListInterfaceCounters retrieves the traffic counters of LAN interfaces, discovering them when no names are given
+func (c *rtxClient) ListInterfaceCounters(ctx context.Context, names []string) ([]InterfaceCounters, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	interfaceCountersService := c.interfaceCountersService
+	c.mu.Unlock()
+
+	if interfaceCountersService == nil {
+		return nil, fmt.Errorf("Interface counters service not initialized")
+	}
+
+	return interfaceCountersService.List(ctx, names)
+}
+
+// ListNATDescriptors retrieves all NAT descriptors with their addresses and interface bindings
+func (c *rtxClient) ListNATDescriptors(ctx context.Context) ([]NATDescriptor, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	natDescriptorService := c.natDescriptorService
+	c.mu.Unlock()
+
+	if natDescriptorService == nil {
+		return nil, fmt.Errorf("NAT descriptor service not initialized")
+	}
+
+	return natDescriptorService.List(ctx)
+}
+
+// GetFirmwareRevisions retrieves the installed firmware revision and the revisions published at listURL
+func (c *rtxClient) GetFirmwareRevisions(ctx context.Context, listURL string) (*FirmwareRevisions, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	firmwareRevisionService := c.firmwareRevisionService
+	c.mu.Unlock()
+
+	if firmwareRevisionService == nil {
+		return nil, fmt.Errorf("Firmware revision service not initialized")
+	}
+
+	return firmwareRevisionService.Get(ctx, listURL)
+}
+
+// GetQoSStatus retrieves the queue statistics per class of a LAN interface
+func (c *rtxClient) GetQoSStatus(ctx context.Context, iface string) (*QoSStatus, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	qosStatusService := c.qosStatusService
+	c.mu.Unlock()
+
+	if qosStatusService == nil {
+		return nil, fmt.Errorf("QoS status service not initialized")
+	}
+
+	return qosStatusService.Get(ctx, iface)
+}
+
+// ListL2TPSessions retrieves the connected L2TP sessions, optionally limited to one user
+func (c *rtxClient) ListL2TPSessions(ctx context.Context, username string) ([]L2TPSession, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	l2tpSessionService := c.l2tpSessionService
+	c.mu.Unlock()
+
+	if l2tpSessionService == nil {
+		return nil, fmt.Errorf("L2TP session service not initialized")
+	}
+
+	return l2tpSessionService.List(ctx, username)
+}
+
+// ListOSPFNeighborStatuses retrieves the OSPF adjacencies, optionally limited to one interface
+func (c *rtxClient) ListOSPFNeighborStatuses(ctx context.Context, iface string) ([]OSPFNeighborStatus, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	ospfNeighborStatusService := c.ospfNeighborStatusService
+	c.mu.Unlock()
+
+	if ospfNeighborStatusService == nil {
+		return nil, fmt.Errorf("OSPF neighbor status service not initialized")
+	}
+
+	return ospfNeighborStatusService.List(ctx, iface)
+}
+
+// ListBGPPeerStatuses retrieves the BGP peer sessions, optionally limited to one peer address
+func (c *rtxClient) ListBGPPeerStatuses(ctx context.Context, address string) ([]BGPPeerStatus, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	bgpPeerStatusService := c.bgpPeerStatusService
+	c.mu.Unlock()
+
+	if bgpPeerStatusService == nil {
+		return nil, fmt.Errorf("BGP peer status service not initialized")
+	}
+
+	return bgpPeerStatusService.List(ctx, address)
+}
+
+// RunReadOnlyCommand runs a whitelisted read-only command and returns its raw output
+func (c *rtxClient) RunReadOnlyCommand(ctx context.Context, command string) (*CommandResult, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	commandService := c.commandService
+	c.mu.Unlock()
+
+	if commandService == nil {
+		return nil, fmt.Errorf("command service not initialized")
+	}
+
+	return commandService.Run(ctx, command)
+}
+
+// ExecCommands executes operational commands in order and returns their raw output
+func (c *rtxClient) ExecCommands(ctx context.Context, commands []string) ([]CommandResult, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	commandService := c.commandService
+	c.mu.Unlock()
+
+	if commandService == nil {
+		return nil, fmt.Errorf("command service not initialized")
+	}
+
+	return commandService.Exec(ctx, commands)
+}
+
+// Ping runs ping from the router and returns the loss and latency summary
+func (c *rtxClient) Ping(ctx context.Context, opts PingOptions) (*PingResult, error) {
+	c.mu.Lock()
+	if !c.active {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client not connected")
+	}
+	pingService := c.pingService
+	c.mu.Unlock()
+
+	if pingService == nil {
+		return nil, fmt.Errorf("ping service not initialized")
+	}
+
+	return pingService.Ping(ctx, opts)
+}
@@ -711,6 +711,7 @@ type MockSFTPClientForTest struct {
 	DownloadFunc  func(ctx context.Context, path string) ([]byte, error)
 	ListDirFunc   func(ctx context.Context, path string) ([]string, error)
 	WriteFileFunc func(ctx context.Context, path string, content []byte) error
+	RemoveFunc    func(ctx context.Context, path string) error
 	CloseFunc     func() error
 }
 
@@ -735,6 +736,13 @@ func (m *MockSFTPClientForTest) WriteFile(ctx context.Context, path string, cont
 	return nil
 }
 
+func (m *MockSFTPClientForTest) Remove(ctx context.Context, path string) error {
+	if m.RemoveFunc != nil {
+		return m.RemoveFunc(ctx, path)
+	}
+	return nil
+}
+
 func (m *MockSFTPClientForTest) Close() error {
 	if m.CloseFunc != nil {
 		return m.CloseFunc()
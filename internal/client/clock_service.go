@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// ClockService handles clock settings operations
+type ClockService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewClockService creates a new clock service instance
+func NewClockService(executor Executor, client *rtxClient) *ClockService {
+	return &ClockService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves clock settings
+func (s *ClockService) Get(ctx context.Context) (*ClockConfig, error) {
+	var raw string
+	for _, cmd := range parsers.BuildShowClockConfigCommands() {
+		logging.FromContext(ctx).Debug().Str("service", "clock").Msgf("Getting clock settings with command: %s", cmd)
+
+		output, err := s.executor.Run(ctx, cmd)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get clock settings: %w", err)
+		}
+		raw += string(output) + "\n"
+	}
+
+	parsed := parsers.ParseClockConfig(raw)
+	if parsed == nil {
+		return nil, fmt.Errorf("clock configuration not found")
+	}
+
+	config := s.fromParserConfig(*parsed)
+	return &config, nil
+}
+
+// Configure creates clock settings
+func (s *ClockService) Configure(ctx context.Context, config ClockConfig) error {
+	parserConfig := s.toParserConfig(config)
+	if err := parsers.ValidateClockConfig(parserConfig); err != nil {
+		return fmt.Errorf("invalid clock settings: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := parsers.BuildClockCommands(parserConfig)
+	logging.FromContext(ctx).Debug().Str("service", "clock").Msgf("Configuring clock with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to configure clock: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "clock configured")
+}
+
+// Update updates clock settings
+func (s *ClockService) Update(ctx context.Context, config ClockConfig) error {
+	parserConfig := s.toParserConfig(config)
+	if err := parsers.ValidateClockConfig(parserConfig); err != nil {
+		return fmt.Errorf("invalid clock settings: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	current, err := s.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current clock settings: %w", err)
+	}
+
+	var commands []string
+	// Drop the old scheduled sync when it moved to another ID or was removed
+	if current.SyncScheduleID > 0 && current.SyncScheduleID != config.SyncScheduleID {
+		commands = append(commands, parsers.BuildDeleteScheduleCommand(current.SyncScheduleID))
+	}
+	commands = append(commands, parsers.BuildClockCommands(parserConfig)...)
+
+	logging.FromContext(ctx).Debug().Str("service", "clock").Msgf("Updating clock with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to update clock: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "clock updated")
+}
+
+// Reset removes clock settings
+func (s *ClockService) Reset(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	current, err := s.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	commands := parsers.BuildDeleteClockCommands(s.toParserConfig(*current))
+	logging.FromContext(ctx).Debug().Str("service", "clock").Msgf("Resetting clock with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to reset clock: %w", err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to reset clock"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, "clock reset")
+}
+
+// toParserConfig converts client.ClockConfig to parsers.ClockConfig
+func (s *ClockService) toParserConfig(config ClockConfig) parsers.ClockConfig {
+	return parsers.ClockConfig{
+		Timezone:       config.Timezone,
+		NTPServer:      config.NTPServer,
+		SyncScheduleID: config.SyncScheduleID,
+		SyncTime:       config.SyncTime,
+		SNTPServer:     config.SNTPServer,
+	}
+}
+
+// fromParserConfig converts parsers.ClockConfig to client.ClockConfig
+func (s *ClockService) fromParserConfig(config parsers.ClockConfig) ClockConfig {
+	return ClockConfig{
+		Timezone:       config.Timezone,
+		NTPServer:      config.NTPServer,
+		SyncScheduleID: config.SyncScheduleID,
+		SyncTime:       config.SyncTime,
+		SNTPServer:     config.SNTPServer,
+	}
+}
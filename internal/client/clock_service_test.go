@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClockService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config | grep timezone").Return([]byte("timezone +09:00\n"), nil)
+	mockExecutor.On("Run", mock.Anything, "show config | grep ntpdate").Return([]byte("schedule at 30 03:00 ntpdate ntp.nict.jp syslog\n"), nil)
+	mockExecutor.On("Run", mock.Anything, "show config | grep sntpd").Return([]byte(""), nil)
+
+	service := NewClockService(mockExecutor, nil)
+
+	config, err := service.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &ClockConfig{
+		Timezone:       "+09:00",
+		NTPServer:      "ntp.nict.jp",
+		SyncScheduleID: 30,
+		SyncTime:       "03:00",
+	}, config)
+}
+
+func TestClockService_Update(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config | grep timezone").Return([]byte("timezone +09:00\n"), nil)
+	mockExecutor.On("Run", mock.Anything, "show config | grep ntpdate").Return([]byte("schedule at 30 03:00 ntpdate ntp.nict.jp syslog\n"), nil)
+	mockExecutor.On("Run", mock.Anything, "show config | grep sntpd").Return([]byte(""), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no schedule at 30",
+		"timezone +00:00",
+		"sntpd service on",
+	}).Return([]byte(""), nil)
+
+	service := NewClockService(mockExecutor, nil)
+
+	err := service.Update(context.Background(), ClockConfig{Timezone: "+00:00", SNTPServer: true})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
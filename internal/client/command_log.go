@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"sync"
+)
+
+// CommandLog collects the configuration commands an operation sent to the
+// router. Unlike a command preview, the commands are executed.
+type CommandLog struct {
+	mu       sync.Mutex
+	commands []string
+}
+
+// commandLogKey is the context key for the active command log
+type commandLogKey struct{}
+
+// WithCommandLog returns a context in which executed configuration commands
+// are recorded in the returned log
+func WithCommandLog(ctx context.Context) (context.Context, *CommandLog) {
+	log := &CommandLog{}
+	return context.WithValue(ctx, commandLogKey{}, log), log
+}
+
+// commandLogFromContext returns the active command log, or nil
+func commandLogFromContext(ctx context.Context) *CommandLog {
+	log, _ := ctx.Value(commandLogKey{}).(*CommandLog)
+	return log
+}
+
+// Commands returns the executed commands in execution order. Commands that
+// contain secrets are redacted.
+func (l *CommandLog) Commands() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.commands...)
+}
+
+// record appends a command to the log; callers redact secrets first
+func (l *CommandLog) record(cmd string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.commands = append(l.commands, cmd)
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPreviewExecutor_LogsExecutedCommands(t *testing.T) {
+	inner := new(MockExecutor)
+	inner.On("Run", mock.Anything, "show config").Return([]byte(""), nil).Once()
+	inner.On("Run", mock.Anything, "ip route default gateway 192.168.0.254").Return([]byte(""), nil).Once()
+	inner.On("RunBatch", mock.Anything, []string{"show config", "ipsec ike pre-shared-key 1 text secret", "save"}).Return([]byte(""), nil).Once()
+	inner.On("SetLoginPassword", mock.Anything, "new").Return(nil).Once()
+	executor := newPreviewExecutor(inner)
+
+	ctx, log := WithCommandLog(context.Background())
+	assert.False(t, IsCommandPreview(ctx))
+
+	_, err := executor.Run(ctx, "show config")
+	assert.NoError(t, err)
+	_, err = executor.Run(ctx, "ip route default gateway 192.168.0.254")
+	assert.NoError(t, err)
+	_, err = executor.RunBatch(ctx, []string{"show config", "ipsec ike pre-shared-key 1 text secret", "save"})
+	assert.NoError(t, err)
+	assert.NoError(t, executor.SetLoginPassword(ctx, "new"))
+
+	assert.Equal(t, []string{
+		"ip route default gateway 192.168.0.254",
+		"ipsec ike pre-shared-key 1 text [REDACTED]",
+		"save",
+		"login password",
+	}, log.Commands())
+	inner.AssertExpectations(t)
+}
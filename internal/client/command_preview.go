@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+// CommandPreview collects the configuration commands of an operation instead
+// of sending them to the router. Read-only commands still run, so services
+// compute their changes against the live configuration.
+type CommandPreview struct {
+	mu       sync.Mutex
+	commands []string
+}
+
+// commandPreviewKey is the context key for the active command preview
+type commandPreviewKey struct{}
+
+// WithCommandPreview returns a context in which configuration commands are
+// recorded in the returned preview and never executed
+func WithCommandPreview(ctx context.Context) (context.Context, *CommandPreview) {
+	preview := &CommandPreview{}
+	return context.WithValue(ctx, commandPreviewKey{}, preview), preview
+}
+
+// commandPreviewFromContext returns the active command preview, or nil
+func commandPreviewFromContext(ctx context.Context) *CommandPreview {
+	preview, _ := ctx.Value(commandPreviewKey{}).(*CommandPreview)
+	return preview
+}
+
+// IsCommandPreview reports whether ctx records commands instead of executing them
+func IsCommandPreview(ctx context.Context) bool {
+	return commandPreviewFromContext(ctx) != nil
+}
+
+// Commands returns the recorded commands in execution order. Commands that
+// contain secrets are redacted.
+func (p *CommandPreview) Commands() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.commands...)
+}
+
+// record appends a command to the preview; callers redact secrets first
+func (p *CommandPreview) record(cmd string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.commands = append(p.commands, cmd)
+}
+
+// previewExecutor records configuration commands while a command preview is
+// active and passes everything else to the wrapped executor, recording the
+// configuration commands it sends while a command log is active
+type previewExecutor struct {
+	inner Executor
+}
+
+// newPreviewExecutor wraps an executor with command preview support
+func newPreviewExecutor(inner Executor) Executor {
+	return &previewExecutor{inner: inner}
+}
+
+// Run executes read-only commands and records configuration commands during a preview
+func (e *previewExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
+	if preview := commandPreviewFromContext(ctx); preview != nil && !isReadOnlyCommand(cmd) {
+		preview.record(logging.RedactCommand(cmd))
+		return nil, nil
+	}
+	logCommands(ctx, cmd)
+	return e.inner.Run(ctx, cmd)
+}
+
+// RunBatch executes read-only batches and records configuration batches during a preview
+func (e *previewExecutor) RunBatch(ctx context.Context, cmds []string) ([]byte, error) {
+	preview := commandPreviewFromContext(ctx)
+	if preview == nil {
+		logCommands(ctx, cmds...)
+		return e.inner.RunBatch(ctx, cmds)
+	}
+
+	var output []byte
+	for _, cmd := range cmds {
+		out, err := e.Run(ctx, cmd)
+		if err != nil {
+			return output, err
+		}
+		output = append(output, out...)
+	}
+	return output, nil
+}
+
+// SetAdministratorPassword records the password change during a preview
+func (e *previewExecutor) SetAdministratorPassword(ctx context.Context, oldPassword, newPassword string) error {
+	if preview := commandPreviewFromContext(ctx); preview != nil {
+		preview.record("administrator password")
+		return nil
+	}
+	if log := commandLogFromContext(ctx); log != nil {
+		log.record("administrator password")
+	}
+	return e.inner.SetAdministratorPassword(ctx, oldPassword, newPassword)
+}
+
+// SetLoginPassword records the password change during a preview
+func (e *previewExecutor) SetLoginPassword(ctx context.Context, newPassword string) error {
+	if preview := commandPreviewFromContext(ctx); preview != nil {
+		preview.record("login password")
+		return nil
+	}
+	if log := commandLogFromContext(ctx); log != nil {
+		log.record("login password")
+	}
+	return e.inner.SetLoginPassword(ctx, newPassword)
+}
+
+// GenerateSSHDHostKey records the key generation during a preview
+func (e *previewExecutor) GenerateSSHDHostKey(ctx context.Context) error {
+	if preview := commandPreviewFromContext(ctx); preview != nil {
+		preview.record("sshd host key generate")
+		return nil
+	}
+	if log := commandLogFromContext(ctx); log != nil {
+		log.record("sshd host key generate")
+	}
+	return e.inner.GenerateSSHDHostKey(ctx)
+}
+
+// logCommands records the configuration commands among cmds in the active
+// command log, redacting secrets
+func logCommands(ctx context.Context, cmds ...string) {
+	log := commandLogFromContext(ctx)
+	if log == nil {
+		return
+	}
+	for _, cmd := range cmds {
+		if !isReadOnlyCommand(cmd) {
+			log.record(logging.RedactCommand(cmd))
+		}
+	}
+}
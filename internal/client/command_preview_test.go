@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPreviewExecutor_RecordsConfigurationCommands(t *testing.T) {
+	inner := new(MockExecutor)
+	inner.On("Run", mock.Anything, "show config").Return([]byte("ip route default gateway 192.168.0.1\n"), nil).Twice()
+	executor := newPreviewExecutor(inner)
+
+	ctx, preview := WithCommandPreview(context.Background())
+	assert.True(t, IsCommandPreview(ctx))
+
+	output, err := executor.Run(ctx, "show config")
+	assert.NoError(t, err)
+	assert.Contains(t, string(output), "ip route default")
+
+	_, err = executor.Run(ctx, "ip route default gateway 192.168.0.254")
+	assert.NoError(t, err)
+	_, err = executor.RunBatch(ctx, []string{"show config", "dns server 8.8.8.8", "save"})
+	assert.NoError(t, err)
+	assert.NoError(t, executor.SetAdministratorPassword(ctx, "old", "new"))
+	assert.NoError(t, executor.GenerateSSHDHostKey(ctx))
+
+	assert.Equal(t, []string{
+		"ip route default gateway 192.168.0.254",
+		"dns server 8.8.8.8",
+		"save",
+		"administrator password",
+		"sshd host key generate",
+	}, preview.Commands())
+	inner.AssertExpectations(t)
+}
+
+func TestPreviewExecutor_PassesThroughWithoutPreview(t *testing.T) {
+	inner := new(MockExecutor)
+	inner.On("Run", mock.Anything, "ip route default gateway 192.168.0.254").Return([]byte(""), nil).Once()
+	executor := newPreviewExecutor(inner)
+
+	assert.False(t, IsCommandPreview(context.Background()))
+	_, err := executor.Run(context.Background(), "ip route default gateway 192.168.0.254")
+	assert.NoError(t, err)
+	inner.AssertExpectations(t)
+}
+
+func TestRTXClient_SaveConfig_SkippedInPreview(t *testing.T) {
+	executor := new(MockExecutor)
+	c := &rtxClient{config: &Config{}, executor: executor, active: true}
+
+	ctx, preview := WithCommandPreview(context.Background())
+	assert.NoError(t, c.SaveConfig(ctx))
+	assert.Empty(t, preview.Commands())
+	executor.AssertNotCalled(t, "Run", mock.Anything, "save")
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// CommandService runs whitelisted read-only commands and returns their raw output
+type CommandService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewCommandService creates a new command service instance
+func NewCommandService(executor Executor, client *rtxClient) *CommandService {
+	return &CommandService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Run validates the command against the read-only whitelist and executes it.
+// An error reported by the router is returned as a non-zero exit status
+// rather than as an error, so callers can inspect the output.
+func (s *CommandService) Run(ctx context.Context, command string) (*CommandResult, error) {
+	if err := parsers.ValidateReadOnlyCommand(command); err != nil {
+		return nil, err
+	}
+
+	cmd := parsers.NormalizeCommand(command)
+	logging.FromContext(ctx).Debug().Str("service", "command").Msgf("Running read-only command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run command %q: %w", cmd, err)
+	}
+
+	normalized := parsers.NormalizeRunningConfig(string(output))
+	return &CommandResult{
+		Command:    cmd,
+		Output:     normalized,
+		ExitStatus: parsers.ParseCommandExitStatus(normalized),
+	}, nil
+}
+
+// Exec validates and executes commands in order, in administrator mode. The
+// results of the commands run so far are returned along with an error when
+// the router rejects a command; later commands are not run.
+func (s *CommandService) Exec(ctx context.Context, commands []string) ([]CommandResult, error) {
+	for _, command := range commands {
+		if err := parsers.ValidateExecCommand(command); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]CommandResult, 0, len(commands))
+	for _, command := range commands {
+		cmd := parsers.NormalizeCommand(command)
+		logging.FromContext(ctx).Debug().Str("service", "command").Msgf("Executing command: %s", logging.RedactCommand(cmd))
+
+		output, err := s.executor.Run(ctx, cmd)
+		if err != nil {
+			return results, fmt.Errorf("failed to run command %q: %w", logging.RedactCommand(cmd), err)
+		}
+
+		normalized := parsers.NormalizeRunningConfig(string(output))
+		result := CommandResult{
+			Command:    cmd,
+			Output:     normalized,
+			ExitStatus: parsers.ParseCommandExitStatus(normalized),
+		}
+		results = append(results, result)
+		if result.ExitStatus != 0 {
+			return results, fmt.Errorf("command %q failed: %s", logging.RedactCommand(cmd), normalized)
+		}
+	}
+	return results, nil
+}
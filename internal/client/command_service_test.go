@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCommandService_Run(t *testing.T) {
+	tests := []struct {
+		name      string
+		command   string
+		mockSetup func(*MockExecutor)
+		expected  *CommandResult
+		expectErr bool
+	}{
+		{
+			name:    "successful command",
+			command: "show  environment",
+			mockSetup: func(m *MockExecutor) {
+				m.On("Run", mock.Anything, "show environment").
+					Return([]byte("RTX1210 Rev.14.01.42\r\nCPU:   3%(5sec)\r\n\r\n"), nil)
+			},
+			expected: &CommandResult{
+				Command:    "show environment",
+				Output:     "RTX1210 Rev.14.01.42\nCPU:   3%(5sec)",
+				ExitStatus: 0,
+			},
+		},
+		{
+			name:    "router error",
+			command: "show status lan9",
+			mockSetup: func(m *MockExecutor) {
+				m.On("Run", mock.Anything, "show status lan9").
+					Return([]byte("Error: Invalid interface\n"), nil)
+			},
+			expected: &CommandResult{
+				Command:    "show status lan9",
+				Output:     "Error: Invalid interface",
+				ExitStatus: 1,
+			},
+		},
+		{
+			name:      "non read-only command",
+			command:   "save",
+			mockSetup: func(m *MockExecutor) {},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExecutor := new(MockExecutor)
+			tt.mockSetup(mockExecutor)
+
+			service := NewCommandService(mockExecutor, nil)
+			result, err := service.Run(context.Background(), tt.command)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				mockExecutor.AssertNotCalled(t, "Run", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			mockExecutor.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommandService_Exec(t *testing.T) {
+	t.Run("runs commands in order", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "disconnect pp 1").Return([]byte(""), nil).Once()
+		mockExecutor.On("Run", mock.Anything, "clear nat descriptor dynamic 1000").Return([]byte("\r\n"), nil).Once()
+
+		service := NewCommandService(mockExecutor, nil)
+		results, err := service.Exec(context.Background(), []string{"disconnect  pp 1", "clear nat descriptor dynamic 1000"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []CommandResult{
+			{Command: "disconnect pp 1", Output: "", ExitStatus: 0},
+			{Command: "clear nat descriptor dynamic 1000", Output: "", ExitStatus: 0},
+		}, results)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("stops at the first router error", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "disconnect pp 9").Return([]byte("Error: Invalid PP number\r\n"), nil).Once()
+
+		service := NewCommandService(mockExecutor, nil)
+		results, err := service.Exec(context.Background(), []string{"disconnect pp 9", "disconnect pp 1"})
+
+		assert.ErrorContains(t, err, "Invalid PP number")
+		assert.Len(t, results, 1)
+		assert.Equal(t, 1, results[0].ExitStatus)
+		mockExecutor.AssertNotCalled(t, "Run", mock.Anything, "disconnect pp 1")
+	})
+
+	t.Run("validates all commands first", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+
+		service := NewCommandService(mockExecutor, nil)
+		_, err := service.Exec(context.Background(), []string{"disconnect pp 1", "exit"})
+
+		assert.Error(t, err)
+		mockExecutor.AssertNotCalled(t, "Run", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+// tracingExecutor logs every command sent to the router through tflog, so
+// that TF_LOG=DEBUG shows what ran, for which resource and how long it took.
+// Secret arguments are redacted and the configured credentials are masked in
+// all fields, so debug logs can be shared.
+type tracingExecutor struct {
+	inner   Executor
+	device  string
+	secrets []string
+}
+
+// newTracingExecutor wraps an executor with command tracing for device
+func newTracingExecutor(inner Executor, device string, secrets ...string) Executor {
+	return &tracingExecutor{
+		inner:   inner,
+		device:  device,
+		secrets: nonEmptyStrings(secrets...),
+	}
+}
+
+// Run executes a command and traces it
+func (e *tracingExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
+	start := time.Now()
+	output, err := e.inner.Run(ctx, cmd)
+	e.trace(ctx, logging.RedactCommand(cmd), start, len(output), err)
+	return output, err
+}
+
+// RunBatch executes a batch of commands and traces it as one entry
+func (e *tracingExecutor) RunBatch(ctx context.Context, cmds []string) ([]byte, error) {
+	redacted := make([]string, len(cmds))
+	for i, cmd := range cmds {
+		redacted[i] = logging.RedactCommand(cmd)
+	}
+
+	start := time.Now()
+	output, err := e.inner.RunBatch(ctx, cmds)
+	e.trace(ctx, redacted, start, len(output), err)
+	return output, err
+}
+
+// SetAdministratorPassword changes the administrator password and traces it
+func (e *tracingExecutor) SetAdministratorPassword(ctx context.Context, oldPassword, newPassword string) error {
+	start := time.Now()
+	err := e.inner.SetAdministratorPassword(ctx, oldPassword, newPassword)
+	e.trace(tflog.MaskAllFieldValuesStrings(ctx, nonEmptyStrings(oldPassword, newPassword)...), "administrator password", start, 0, err)
+	return err
+}
+
+// SetLoginPassword changes the login password and traces it
+func (e *tracingExecutor) SetLoginPassword(ctx context.Context, newPassword string) error {
+	start := time.Now()
+	err := e.inner.SetLoginPassword(ctx, newPassword)
+	e.trace(tflog.MaskAllFieldValuesStrings(ctx, nonEmptyStrings(newPassword)...), "login password", start, 0, err)
+	return err
+}
+
+// GenerateSSHDHostKey generates the SSHD host key and traces it
+func (e *tracingExecutor) GenerateSSHDHostKey(ctx context.Context) error {
+	start := time.Now()
+	err := e.inner.GenerateSSHDHostKey(ctx)
+	e.trace(ctx, "sshd host key generate", start, 0, err)
+	return err
+}
+
+// trace emits the debug log entry of one executor call. command is either a
+// redacted command or a list of redacted commands.
+func (e *tracingExecutor) trace(ctx context.Context, command interface{}, start time.Time, bytes int, err error) {
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, e.secrets...)
+	ctx = tflog.MaskMessageStrings(ctx, e.secrets...)
+
+	fields := map[string]interface{}{
+		"device":      e.device,
+		"command":     command,
+		"duration_ms": time.Since(start).Milliseconds(),
+		"bytes":       bytes,
+	}
+	if res := logging.ResourceFromContext(ctx); res != nil {
+		fields["resource"] = res.Type
+		if res.ID != "" {
+			fields["resource_id"] = res.ID
+		}
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "RTX command failed", fields)
+		return
+	}
+	tflog.Debug(ctx, "RTX command", fields)
+}
+
+// nonEmptyStrings returns the non-empty values
+func nonEmptyStrings(values ...string) []string {
+	var result []string
+	for _, v := range values {
+		if v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
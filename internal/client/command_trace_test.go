@@ -0,0 +1,70 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+func TestTracingExecutor_Run(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+	ctx = logging.WithResource(ctx, "rtx_ipsec_tunnel", "1")
+
+	inner := new(MockExecutor)
+	inner.On("Run", mock.Anything, "ipsec ike pre-shared-key 1 text psk-secret").Return([]byte("ok\n"), nil)
+	inner.On("Run", mock.Anything, "show config").Return(nil, errors.New("login failed for s3cret-login"))
+	executor := newTracingExecutor(inner, "192.168.1.1:22", "s3cret-login", "")
+
+	_, err := executor.Run(ctx, "ipsec ike pre-shared-key 1 text psk-secret")
+	require.NoError(t, err)
+	_, err = executor.Run(ctx, "show config")
+	require.Error(t, err)
+
+	entries, err := tflogtest.MultilineJSONDecode(&buf)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "RTX command", entries[0]["@message"])
+	assert.Equal(t, "192.168.1.1:22", entries[0]["device"])
+	assert.Equal(t, "ipsec ike pre-shared-key 1 text [REDACTED]", entries[0]["command"])
+	assert.Equal(t, "rtx_ipsec_tunnel", entries[0]["resource"])
+	assert.Equal(t, "1", entries[0]["resource_id"])
+	assert.Equal(t, float64(3), entries[0]["bytes"])
+	assert.Contains(t, entries[0], "duration_ms")
+
+	assert.Equal(t, "RTX command failed", entries[1]["@message"])
+	assert.Equal(t, "login failed for ***", entries[1]["error"])
+	assert.NotContains(t, buf.String(), "psk-secret")
+	assert.NotContains(t, buf.String(), "s3cret-login")
+	inner.AssertExpectations(t)
+}
+
+func TestTracingExecutor_RunBatchAndPasswords(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+
+	inner := new(MockExecutor)
+	inner.On("RunBatch", mock.Anything, []string{"snmp community read-only public", "save"}).Return([]byte(""), nil)
+	inner.On("SetLoginPassword", mock.Anything, "new-login").Return(errors.New("rejected new-login"))
+	executor := newTracingExecutor(inner, "router")
+
+	_, err := executor.RunBatch(ctx, []string{"snmp community read-only public", "save"})
+	require.NoError(t, err)
+	require.Error(t, executor.SetLoginPassword(ctx, "new-login"))
+
+	entries, err := tflogtest.MultilineJSONDecode(&buf)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, []interface{}{"snmp community read-only [REDACTED]", "save"}, entries[0]["command"])
+	assert.Equal(t, "login password", entries[1]["command"])
+	assert.NotContains(t, buf.String(), "new-login")
+}
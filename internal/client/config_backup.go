@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+// backupTimestampFormat names backup files so that they sort chronologically
+const backupTimestampFormat = "20060102-150405"
+
+// backupExecutor saves the full configuration to a local file before the
+// first configuration command of the client, so that every apply leaves a
+// copy of the configuration it started from
+type backupExecutor struct {
+	inner Executor
+	dir   string // Directory the backup files are written to
+	name  string // Router name used in the file name
+
+	mu   sync.Mutex
+	done bool
+	now  func() time.Time
+}
+
+// newBackupExecutor wraps an executor with the pre-change configuration
+// backup. The executor is returned unchanged if dir is empty.
+func newBackupExecutor(inner Executor, dir, name string) Executor {
+	if dir == "" {
+		return inner
+	}
+	return &backupExecutor{
+		inner: inner,
+		dir:   dir,
+		name:  strings.NewReplacer(":", "_", "/", "_", "\\", "_").Replace(name),
+		now:   time.Now,
+	}
+}
+
+// Run executes a command, backing up the configuration first if it is the
+// first configuration command
+func (e *backupExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
+	if !isReadOnlyCommand(cmd) {
+		if err := e.backup(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return e.inner.Run(ctx, cmd)
+}
+
+// RunBatch executes the commands, backing up the configuration first unless
+// all of them are read-only
+func (e *backupExecutor) RunBatch(ctx context.Context, cmds []string) ([]byte, error) {
+	for _, cmd := range cmds {
+		if !isReadOnlyCommand(cmd) {
+			if err := e.backup(ctx); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return e.inner.RunBatch(ctx, cmds)
+}
+
+// SetAdministratorPassword backs up the configuration before changing the password
+func (e *backupExecutor) SetAdministratorPassword(ctx context.Context, oldPassword, newPassword string) error {
+	if err := e.backup(ctx); err != nil {
+		return err
+	}
+	return e.inner.SetAdministratorPassword(ctx, oldPassword, newPassword)
+}
+
+// SetLoginPassword backs up the configuration before changing the password
+func (e *backupExecutor) SetLoginPassword(ctx context.Context, newPassword string) error {
+	if err := e.backup(ctx); err != nil {
+		return err
+	}
+	return e.inner.SetLoginPassword(ctx, newPassword)
+}
+
+// GenerateSSHDHostKey backs up the configuration before regenerating the host key
+func (e *backupExecutor) GenerateSSHDHostKey(ctx context.Context) error {
+	if err := e.backup(ctx); err != nil {
+		return err
+	}
+	return e.inner.GenerateSSHDHostKey(ctx)
+}
+
+// backup writes the configuration to the backup directory once. A failed
+// backup aborts the change and is retried by the next configuration command.
+func (e *backupExecutor) backup(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.done {
+		return nil
+	}
+
+	output, err := e.inner.Run(ctx, "show config")
+	if err != nil {
+		return fmt.Errorf("failed to read configuration for backup: %w", err)
+	}
+
+	if err := os.MkdirAll(e.dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create backup directory: %w", err)
+	}
+	path := filepath.Join(e.dir, fmt.Sprintf("%s-%s.conf", e.name, e.now().Format(backupTimestampFormat)))
+	if err := os.WriteFile(path, output, 0o600); err != nil {
+		return fmt.Errorf("failed to write configuration backup: %w", err)
+	}
+
+	logging.FromContext(ctx).Info().Str("file", path).Msg("Saved configuration backup")
+	e.done = true
+	return nil
+}
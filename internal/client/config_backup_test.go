@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupExecutor_BacksUpBeforeFirstChange(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "backups")
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show status lan1").Return([]byte("status"), nil)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(snapshotTestConfig), nil).Once()
+	mockExecutor.On("RunBatch", mock.Anything, []string{"ip route default gateway pp 2"}).Return([]byte(nil), nil)
+	mockExecutor.On("Run", mock.Anything, "save").Return([]byte(nil), nil)
+
+	e := newBackupExecutor(mockExecutor, dir, "192.168.1.1:22").(*backupExecutor)
+	e.now = func() time.Time { return time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC) }
+	ctx := context.Background()
+
+	_, err := e.Run(ctx, "show status lan1")
+	require.NoError(t, err)
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err), "read-only commands must not write a backup")
+
+	_, err = e.RunBatch(ctx, []string{"ip route default gateway pp 2"})
+	require.NoError(t, err)
+	_, err = e.Run(ctx, "save")
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(dir, "192.168.1.1_22-20240501-093000.conf"))
+	require.NoError(t, err)
+	assert.Equal(t, snapshotTestConfig, string(content))
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestBackupExecutor_FailedBackupAbortsChange(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(nil), errors.New("connection lost")).Once()
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(snapshotTestConfig), nil).Once()
+	mockExecutor.On("Run", mock.Anything, "ip route default gateway pp 2").Return([]byte(nil), nil).Once()
+
+	dir := t.TempDir()
+	e := newBackupExecutor(mockExecutor, dir, "router")
+	ctx := context.Background()
+
+	_, err := e.Run(ctx, "ip route default gateway pp 2")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "backup")
+
+	_, err = e.Run(ctx, "ip route default gateway pp 2")
+	require.NoError(t, err)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestNewBackupExecutor_Disabled(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	assert.Same(t, Executor(mockExecutor), newBackupExecutor(mockExecutor, "", "router"))
+}
@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// ConfigBlockService handles raw configuration lines that are not modeled by
+// a dedicated resource
+type ConfigBlockService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewConfigBlockService creates a new config block service instance
+func NewConfigBlockService(executor Executor, client *rtxClient) *ConfigBlockService {
+	return &ConfigBlockService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the configuration lines currently owned by a block
+func (s *ConfigBlockService) Get(ctx context.Context, block ConfigBlock) (*ConfigBlock, error) {
+	lines, err := s.ownedLines(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("config block lines not found")
+	}
+
+	return &ConfigBlock{
+		Context:  block.Context,
+		Lines:    lines,
+		Prefixes: block.Prefixes,
+	}, nil
+}
+
+// Create applies a block. Existing lines matching the owned prefixes that are
+// not part of the block are removed.
+func (s *ConfigBlockService) Create(ctx context.Context, block ConfigBlock) error {
+	return s.apply(ctx, block, block, "created")
+}
+
+// Update applies a block, removing lines owned by the previous version of the
+// block that are no longer configured
+func (s *ConfigBlockService) Update(ctx context.Context, previous, block ConfigBlock) error {
+	return s.apply(ctx, previous, block, "updated")
+}
+
+// apply validates the block and replaces the lines owned by previous and block
+func (s *ConfigBlockService) apply(ctx context.Context, previous, block ConfigBlock, action string) error {
+	parserBlock := parsers.ConfigBlock(block)
+	if err := parsers.ValidateConfigBlock(parserBlock); err != nil {
+		return fmt.Errorf("invalid config block: %w", err)
+	}
+
+	parsed, err := s.readConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Lines owned by either version of the block are candidates for removal
+	current, err := parsed.ExtractConfigBlockLines(parsers.ConfigBlock(previous))
+	if err != nil {
+		return err
+	}
+	owned, err := parsed.ExtractConfigBlockLines(parserBlock)
+	if err != nil {
+		return err
+	}
+	for _, line := range owned {
+		if !slices.Contains(current, line) {
+			current = append(current, line)
+		}
+	}
+
+	wanted := make(map[string]bool, len(block.Lines))
+	for _, line := range block.Lines {
+		wanted[parsers.NormalizeConfigBlockLine(line)] = true
+	}
+
+	var stale []string
+	for _, line := range current {
+		if !wanted[line] {
+			stale = append(stale, line)
+		}
+	}
+
+	commands := append(parsers.BuildDeleteConfigBlockCommands(block.Context, stale), parsers.BuildConfigBlockCommands(parserBlock)...)
+	logging.FromContext(ctx).Debug().Str("service", "config_block").Msgf("Applying config block with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to apply config block: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("config block %s", action))
+}
+
+// Delete removes all configuration lines owned by a block
+func (s *ConfigBlockService) Delete(ctx context.Context, block ConfigBlock) error {
+	lines, err := s.ownedLines(ctx, block)
+	if err != nil {
+		return err
+	}
+
+	commands := parsers.BuildDeleteConfigBlockCommands(block.Context, lines)
+	if len(commands) == 0 {
+		return nil
+	}
+	logging.FromContext(ctx).Debug().Str("service", "config_block").Msgf("Deleting config block with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to delete config block: %w", err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete config block"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, "config block deleted")
+}
+
+// ownedLines reads the router configuration and returns the lines owned by a block
+func (s *ConfigBlockService) ownedLines(ctx context.Context, block ConfigBlock) ([]string, error) {
+	parsed, err := s.readConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsed.ExtractConfigBlockLines(parsers.ConfigBlock(block))
+}
+
+// readConfig reads and parses the router configuration
+func (s *ConfigBlockService) readConfig(ctx context.Context) (*parsers.ParsedConfig, error) {
+	cmd := parsers.BuildShowConfigBlockCommand()
+	logging.FromContext(ctx).Debug().Str("service", "config_block").Msgf("Reading configuration with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration: %w", err)
+	}
+
+	parsed, err := parsers.NewConfigFileParser().Parse(string(output))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse configuration: %w", err)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testConfigBlockShowConfig = `ip lan1 address 192.168.1.1/24
+ip lan1 intrusion detection in on
+ip lan1 intrusion detection in reject on
+tunnel select 1
+ tunnel encapsulation l2tpv3
+ ip tunnel mtu 1280
+ tunnel enable 1
+`
+
+func TestConfigBlockService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(testConfigBlockShowConfig), nil)
+
+	service := NewConfigBlockService(mockExecutor, nil)
+
+	block, err := service.Get(context.Background(), ConfigBlock{Prefixes: []string{"ip lan1 intrusion"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ip lan1 intrusion detection in on", "ip lan1 intrusion detection in reject on"}, block.Lines)
+
+	_, err = service.Get(context.Background(), ConfigBlock{Lines: []string{"ip lan2 proxyarp on"}})
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestConfigBlockService_Create(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(testConfigBlockShowConfig), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no ip lan1 intrusion detection in reject on",
+		"ip lan1 intrusion detection in on",
+		"ip lan1 intrusion detection out on",
+	}).Return([]byte(""), nil)
+
+	service := NewConfigBlockService(mockExecutor, nil)
+	err := service.Create(context.Background(), ConfigBlock{
+		Lines:    []string{"ip lan1 intrusion detection in on", "ip lan1 intrusion detection out on"},
+		Prefixes: []string{"ip lan1 intrusion"},
+	})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestConfigBlockService_Update(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(testConfigBlockShowConfig), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"tunnel select 1",
+		"no ip tunnel mtu 1280",
+		"tunnel select none",
+		"tunnel select 1",
+		"ip tunnel tcp mss limit auto",
+		"tunnel select none",
+	}).Return([]byte(""), nil)
+
+	service := NewConfigBlockService(mockExecutor, nil)
+	err := service.Update(context.Background(),
+		ConfigBlock{Context: "tunnel select 1", Lines: []string{"ip tunnel mtu 1280"}},
+		ConfigBlock{Context: "tunnel select 1", Lines: []string{"ip tunnel tcp mss limit auto"}},
+	)
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestConfigBlockService_Delete(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(testConfigBlockShowConfig), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no ip lan1 intrusion detection in reject on",
+		"no ip lan1 intrusion detection in on",
+	}).Return([]byte(""), nil)
+
+	service := NewConfigBlockService(mockExecutor, nil)
+	err := service.Delete(context.Background(), ConfigBlock{
+		Lines:    []string{"ip lan1 intrusion detection in on"},
+		Prefixes: []string{"ip lan1 intrusion"},
+	})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
@@ -0,0 +1,197 @@
+package client
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// showConfigGrepPattern matches "show config | grep PATTERN" with the pattern
+// in double quotes, single quotes or as a single word. Commands with grep
+// options are not matched and go to the router.
+var showConfigGrepPattern = regexp.MustCompile(`^show config \| grep (?:"([^"]*)"|'([^']*)'|([^\s"'-]\S*))$`)
+
+// showConfigSectionPattern matches "show config pp N" and "show config tunnel N"
+var showConfigSectionPattern = regexp.MustCompile(`^show config (pp|tunnel) (\d+)$`)
+
+// configSnapshotExecutor answers configuration reads from one shared
+// "show config" snapshot. Resources refreshing in parallel each read their
+// part of the configuration with "show config", "show config | grep ..." or
+// "show config pp N"; instead of one round trip per resource, the full
+// configuration is fetched once and the reads are served from it. Once a
+// command that may change the configuration has run, the snapshot is
+// dropped, so reads after a write see the router again.
+type configSnapshotExecutor struct {
+	inner Executor
+	cache *ConfigCache // Marked dirty on writes so that GetCachedConfig refreshes too
+	ttl   time.Duration
+
+	fetchMu    sync.Mutex // Held while fetching, so concurrent reads share one fetch
+	mu         sync.Mutex
+	content    string
+	validUntil time.Time
+	generation uint64 // Incremented on every invalidation
+}
+
+// newConfigSnapshotExecutor wraps an executor with the shared configuration snapshot
+func newConfigSnapshotExecutor(inner Executor, cache *ConfigCache) *configSnapshotExecutor {
+	return &configSnapshotExecutor{
+		inner: inner,
+		cache: cache,
+		ttl:   DefaultCacheTTL,
+	}
+}
+
+// Run serves configuration reads from the snapshot and passes everything else
+// to the wrapped executor
+func (e *configSnapshotExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
+	normalized := strings.Join(strings.Fields(cmd), " ")
+
+	if normalized == "show config" {
+		content, err := e.snapshot(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(content), nil
+	}
+
+	if matches := showConfigGrepPattern.FindStringSubmatch(normalized); matches != nil {
+		pattern := matches[1] + matches[2] + matches[3]
+		if re, err := regexp.Compile(pattern); err == nil {
+			content, err := e.snapshot(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return []byte(grepLines(content, re)), nil
+		}
+	}
+
+	if matches := showConfigSectionPattern.FindStringSubmatch(normalized); matches != nil {
+		number, _ := strconv.Atoi(matches[2])
+		content, err := e.snapshot(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(parsers.ExtractConfigSection(content, matches[1], number)), nil
+	}
+
+	if changesConfig(cmd) {
+		defer e.Invalidate()
+	}
+	return e.inner.Run(ctx, cmd)
+}
+
+// RunBatch passes the batch to the wrapped executor, dropping the snapshot
+// if any of the commands may change the configuration
+func (e *configSnapshotExecutor) RunBatch(ctx context.Context, cmds []string) ([]byte, error) {
+	for _, cmd := range cmds {
+		if changesConfig(cmd) {
+			defer e.Invalidate()
+			break
+		}
+	}
+	return e.inner.RunBatch(ctx, cmds)
+}
+
+// SetAdministratorPassword changes the administrator password and drops the snapshot
+func (e *configSnapshotExecutor) SetAdministratorPassword(ctx context.Context, oldPassword, newPassword string) error {
+	defer e.Invalidate()
+	return e.inner.SetAdministratorPassword(ctx, oldPassword, newPassword)
+}
+
+// SetLoginPassword changes the login password and drops the snapshot
+func (e *configSnapshotExecutor) SetLoginPassword(ctx context.Context, newPassword string) error {
+	defer e.Invalidate()
+	return e.inner.SetLoginPassword(ctx, newPassword)
+}
+
+// GenerateSSHDHostKey generates the SSHD host key and drops the snapshot
+func (e *configSnapshotExecutor) GenerateSSHDHostKey(ctx context.Context) error {
+	defer e.Invalidate()
+	return e.inner.GenerateSSHDHostKey(ctx)
+}
+
+// Invalidate drops the snapshot, so the next configuration read fetches it
+// again. The result of a fetch that is in progress is not stored, as it may
+// predate the change.
+func (e *configSnapshotExecutor) Invalidate() {
+	e.mu.Lock()
+	e.content = ""
+	e.validUntil = time.Time{}
+	e.generation++
+	e.mu.Unlock()
+
+	if e.cache != nil {
+		e.cache.MarkDirty()
+	}
+}
+
+// snapshot returns the full configuration, fetching it when there is no
+// valid snapshot
+func (e *configSnapshotExecutor) snapshot(ctx context.Context) (string, error) {
+	if content, ok := e.current(); ok {
+		return content, nil
+	}
+
+	// Only one fetch at a time; the others wait and use its result
+	e.fetchMu.Lock()
+	defer e.fetchMu.Unlock()
+	if content, ok := e.current(); ok {
+		return content, nil
+	}
+
+	e.mu.Lock()
+	generation := e.generation
+	e.mu.Unlock()
+
+	logging.FromContext(ctx).Debug().Str("service", "config_snapshot").Msg("Fetching configuration snapshot")
+	output, err := e.inner.Run(ctx, "show config")
+	if err != nil {
+		return "", err
+	}
+
+	content := string(output)
+	e.mu.Lock()
+	if e.generation == generation && strings.TrimSpace(content) != "" && rejectionLine(content) == "" {
+		e.content = content
+		e.validUntil = time.Now().Add(e.ttl)
+	}
+	e.mu.Unlock()
+	return content, nil
+}
+
+// current returns the snapshot if it is still valid
+func (e *configSnapshotExecutor) current() (string, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.content == "" || !time.Now().Before(e.validUntil) {
+		return "", false
+	}
+	return e.content, true
+}
+
+// grepLines returns the lines of content that match re, like grep on the router
+func grepLines(content string, re *regexp.Regexp) string {
+	var b strings.Builder
+	for _, line := range strings.SplitAfter(content, "\n") {
+		if re.MatchString(strings.TrimRight(line, "\r\n")) {
+			b.WriteString(line)
+		}
+	}
+	return b.String()
+}
+
+// changesConfig reports whether a command may change the router configuration.
+// Saving writes the running configuration to flash without changing it.
+func changesConfig(cmd string) bool {
+	if isReadOnlyCommand(cmd) {
+		return false
+	}
+	return strings.ToLower(strings.TrimSpace(cmd)) != "save"
+}
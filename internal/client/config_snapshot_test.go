@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const snapshotTestConfig = "ip route default gateway pp 1\r\n" +
+	"ip lan1 address 192.168.1.1/24\r\n" +
+	"pp select 1\r\n" +
+	" ip pp mtu 1454\r\n" +
+	" pp enable 1\r\n" +
+	"nat descriptor type 1000 masquerade\r\n" +
+	"dhcp service server\r\n"
+
+func TestConfigSnapshotExecutor_ServesReadsFromOneFetch(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(snapshotTestConfig), nil).Once()
+
+	e := newConfigSnapshotExecutor(mockExecutor, nil)
+	ctx := context.Background()
+
+	output, err := e.Run(ctx, `show config | grep "ip route"`)
+	require.NoError(t, err)
+	assert.Equal(t, "ip route default gateway pp 1\r\n", string(output))
+
+	output, err = e.Run(ctx, "show config | grep nat")
+	require.NoError(t, err)
+	assert.Equal(t, "nat descriptor type 1000 masquerade\r\n", string(output))
+
+	output, err = e.Run(ctx, `show config | grep "(dhcp|lan1)"`)
+	require.NoError(t, err)
+	assert.Equal(t, "ip lan1 address 192.168.1.1/24\r\ndhcp service server\r\n", string(output))
+
+	output, err = e.Run(ctx, "show config pp 1")
+	require.NoError(t, err)
+	assert.Equal(t, "pp select 1\n ip pp mtu 1454\n pp enable 1", string(output))
+
+	output, err = e.Run(ctx, "show config")
+	require.NoError(t, err)
+	assert.Equal(t, snapshotTestConfig, string(output))
+
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestConfigSnapshotExecutor_ConcurrentReadsShareFetch(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(snapshotTestConfig), nil).Once()
+
+	e := newConfigSnapshotExecutor(mockExecutor, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := e.Run(context.Background(), `show config | grep "ip"`)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestConfigSnapshotExecutor_WriteInvalidates(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(snapshotTestConfig), nil).Once()
+	mockExecutor.On("Run", mock.Anything, "save").Return([]byte(""), nil).Once()
+	mockExecutor.On("Run", mock.Anything, "ip route 10.0.0.0/8 gateway 192.168.1.254").Return([]byte(""), nil).Once()
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(snapshotTestConfig+"ip route 10.0.0.0/8 gateway 192.168.1.254\r\n"), nil).Once()
+
+	cache := NewConfigCache()
+	e := newConfigSnapshotExecutor(mockExecutor, cache)
+	ctx := context.Background()
+
+	_, err := e.Run(ctx, `show config | grep "ip route"`)
+	require.NoError(t, err)
+
+	// Saving does not change the configuration
+	_, err = e.Run(ctx, "save")
+	require.NoError(t, err)
+	assert.False(t, cache.IsDirty())
+
+	_, err = e.Run(ctx, "ip route 10.0.0.0/8 gateway 192.168.1.254")
+	require.NoError(t, err)
+	assert.True(t, cache.IsDirty(), "writes should mark the parsed config cache dirty")
+
+	output, err := e.Run(ctx, `show config | grep "ip route"`)
+	require.NoError(t, err)
+	assert.Equal(t, "ip route default gateway pp 1\r\nip route 10.0.0.0/8 gateway 192.168.1.254\r\n", string(output))
+
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestConfigSnapshotExecutor_PassesThrough(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config | grep -i route").Return([]byte("ip route default gateway pp 1\r\n"), nil).Once()
+	mockExecutor.On("Run", mock.Anything, "show status pp 1").Return([]byte("PP[01]:\r\n"), nil).Once()
+
+	e := newConfigSnapshotExecutor(mockExecutor, nil)
+	ctx := context.Background()
+
+	_, err := e.Run(ctx, "show config | grep -i route")
+	require.NoError(t, err)
+	_, err = e.Run(ctx, "show status pp 1")
+	require.NoError(t, err)
+
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestConfigSnapshotExecutor_FailedFetchIsNotCached(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return(nil, errors.New("connection reset")).Once()
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(snapshotTestConfig), nil).Once()
+
+	e := newConfigSnapshotExecutor(mockExecutor, nil)
+	ctx := context.Background()
+
+	_, err := e.Run(ctx, "show config | grep nat")
+	require.Error(t, err)
+
+	output, err := e.Run(ctx, "show config | grep nat")
+	require.NoError(t, err)
+	assert.Equal(t, "nat descriptor type 1000 masquerade\r\n", string(output))
+
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestConfigSnapshotExecutor_ConfigMentioningErrorsIsCached(t *testing.T) {
+	config := "description lan1 \"uplink not found error: see notes\"\r\n" + snapshotTestConfig
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(config), nil).Once()
+
+	e := newConfigSnapshotExecutor(mockExecutor, nil)
+	ctx := context.Background()
+
+	_, err := e.Run(ctx, "show config | grep nat")
+	require.NoError(t, err)
+
+	output, err := e.Run(ctx, "show config | grep description")
+	require.NoError(t, err)
+	assert.Equal(t, "description lan1 \"uplink not found error: see notes\"\r\n", string(output))
+
+	mockExecutor.AssertExpectations(t)
+}
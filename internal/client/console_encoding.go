@@ -0,0 +1,129 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// ConsoleEncoding is the character encoding of the router console, set on the
+// router with "console character". Descriptions and Japanese messages are only
+// readable when the provider uses the same encoding.
+type ConsoleEncoding string
+
+const (
+	// ConsoleEncodingAuto decodes output that is not valid UTF-8 as Shift_JIS
+	// or EUC-JP, and encodes input to match once a session has seen such output (default)
+	ConsoleEncodingAuto ConsoleEncoding = "auto"
+	// ConsoleEncodingUTF8 matches "console character ja.utf8" and "ascii"
+	ConsoleEncodingUTF8 ConsoleEncoding = "utf-8"
+	// ConsoleEncodingShiftJIS matches "console character ja.sjis"
+	ConsoleEncodingShiftJIS ConsoleEncoding = "shift_jis"
+	// ConsoleEncodingEUCJP matches "console character euc-jp"
+	ConsoleEncodingEUCJP ConsoleEncoding = "euc-jp"
+)
+
+// textEncoding returns the character set of a fixed console encoding, or nil
+// for UTF-8 and auto
+func (e ConsoleEncoding) textEncoding() encoding.Encoding {
+	switch e {
+	case ConsoleEncodingShiftJIS:
+		return japanese.ShiftJIS
+	case ConsoleEncodingEUCJP:
+		return japanese.EUCJP
+	default:
+		return nil
+	}
+}
+
+// consoleTranscoder converts between UTF-8 and the router console encoding.
+// In auto mode it starts with UTF-8 and switches to the legacy encoding that
+// the router's output turns out to use.
+type consoleTranscoder struct {
+	mode     ConsoleEncoding
+	detected ConsoleEncoding // Encoding in use; differs from mode only in auto mode
+}
+
+// newConsoleTranscoder creates a transcoder for mode; an empty mode means auto
+func newConsoleTranscoder(mode ConsoleEncoding) *consoleTranscoder {
+	if mode == "" {
+		mode = ConsoleEncodingAuto
+	}
+	detected := mode
+	if mode == ConsoleEncodingAuto {
+		detected = ConsoleEncodingUTF8
+	}
+	return &consoleTranscoder{mode: mode, detected: detected}
+}
+
+// Encode converts a UTF-8 command to the console encoding
+func (t *consoleTranscoder) Encode(cmd string) (string, error) {
+	enc := t.detected.textEncoding()
+	if enc == nil || isASCII(cmd) {
+		return cmd, nil
+	}
+	encoded, err := enc.NewEncoder().String(cmd)
+	if err != nil {
+		return "", fmt.Errorf("command contains characters that cannot be sent in %s: %w", t.detected, err)
+	}
+	return encoded, nil
+}
+
+// Decode converts console output to UTF-8. Output that cannot be decoded is
+// returned unchanged.
+func (t *consoleTranscoder) Decode(output []byte) []byte {
+	if t.mode == ConsoleEncodingAuto && t.detected == ConsoleEncodingUTF8 {
+		if utf8.Valid(output) {
+			return output
+		}
+		for _, candidate := range []ConsoleEncoding{ConsoleEncodingShiftJIS, ConsoleEncodingEUCJP} {
+			if decoded, ok := decodeStrict(output, candidate.textEncoding()); ok {
+				t.detected = candidate
+				return decoded
+			}
+		}
+		return output
+	}
+
+	enc := t.detected.textEncoding()
+	if enc == nil || isASCII(string(output)) {
+		return output
+	}
+	if decoded, ok := decodeStrict(output, enc); ok {
+		return decoded
+	}
+	return output
+}
+
+// DecodeConsoleOutput converts output read outside a console session, such as
+// a configuration file downloaded via SFTP, to UTF-8
+func DecodeConsoleOutput(output []byte, mode ConsoleEncoding) []byte {
+	return newConsoleTranscoder(mode).Decode(output)
+}
+
+// decodeStrict decodes data with enc and reports whether every byte sequence
+// was valid in that encoding
+func decodeStrict(data []byte, enc encoding.Encoding) ([]byte, bool) {
+	decoded, err := enc.NewDecoder().Bytes(data)
+	if err != nil {
+		return nil, false
+	}
+	// Invalid sequences are replaced rather than reported by the decoders
+	if bytes.ContainsRune(decoded, utf8.RuneError) {
+		return nil, false
+	}
+	return decoded, true
+}
+
+// isASCII reports whether s contains only ASCII characters
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func shiftJIS(t *testing.T, s string) []byte {
+	t.Helper()
+	encoded, err := japanese.ShiftJIS.NewEncoder().String(s)
+	require.NoError(t, err)
+	return []byte(encoded)
+}
+
+func TestConsoleTranscoder_Auto(t *testing.T) {
+	tr := newConsoleTranscoder("")
+
+	// UTF-8 output passes through and input is not converted
+	assert.Equal(t, "description 1 本社\n", string(tr.Decode([]byte("description 1 本社\n"))))
+	cmd, err := tr.Encode(`description 1 "本社"`)
+	require.NoError(t, err)
+	assert.Equal(t, `description 1 "本社"`, cmd)
+
+	// Shift_JIS output is detected and decoded
+	output := shiftJIS(t, "エラー: 管理レベルでのみ実行できます\n# ")
+	assert.Equal(t, "エラー: 管理レベルでのみ実行できます\n# ", string(tr.Decode(output)))
+	assert.True(t, IsAdminRequiredOutput(tr.Decode(output)))
+
+	// Later input is encoded to match
+	cmd, err = tr.Encode(`description 1 "本社"`)
+	require.NoError(t, err)
+	assert.Equal(t, string(shiftJIS(t, `description 1 "本社"`)), cmd)
+}
+
+func TestConsoleTranscoder_EUCJP(t *testing.T) {
+	encoded, err := japanese.EUCJP.NewEncoder().String("拠点")
+	require.NoError(t, err)
+
+	tr := newConsoleTranscoder(ConsoleEncodingEUCJP)
+	assert.Equal(t, "拠点", string(tr.Decode([]byte(encoded))))
+
+	cmd, err := tr.Encode("拠点")
+	require.NoError(t, err)
+	assert.Equal(t, encoded, cmd)
+}
+
+func TestConsoleTranscoder_FixedUTF8(t *testing.T) {
+	tr := newConsoleTranscoder(ConsoleEncodingUTF8)
+
+	// Invalid UTF-8 is left alone when the encoding is fixed
+	output := shiftJIS(t, "本社")
+	assert.Equal(t, output, tr.Decode(output))
+}
+
+func TestConsoleTranscoder_Unencodable(t *testing.T) {
+	tr := newConsoleTranscoder(ConsoleEncodingShiftJIS)
+
+	_, err := tr.Encode("description 1 🚀")
+	assert.Error(t, err)
+}
+
+func TestDecodeConsoleOutput(t *testing.T) {
+	config := append([]byte("# RTX1210\ndescription 1 "), shiftJIS(t, "本社回線")...)
+	assert.Equal(t, "# RTX1210\ndescription 1 本社回線", string(DecodeConsoleOutput(config, ConsoleEncodingAuto)))
+	assert.Equal(t, "ip route default gateway pp 1", string(DecodeConsoleOutput([]byte("ip route default gateway pp 1"), "")))
+}
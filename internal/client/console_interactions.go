@@ -0,0 +1,97 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+// loginSettleDelay is how long the console must stay quiet after a prompt
+// while logging in. Login banners and password warnings can contain lines
+// that look like a prompt until the rest of the line arrives.
+const loginSettleDelay = 200 * time.Millisecond
+
+// interactionQuietDelay is how long the console must stay quiet after output
+// matching an interaction before it is answered
+const interactionQuietDelay = 100 * time.Millisecond
+
+// interactionWindow is how much of the end of the output interactions are
+// matched against; questions are short, and command output can be large
+const interactionWindow = 512
+
+// consoleInteraction is one entry of an expect-style table: when the output
+// not yet answered ends with pattern and the router waits for input,
+// response is typed into the console and reading continues. Patterns are anchored at the end of the output, so text
+// in the middle of command output is not answered.
+type consoleInteraction struct {
+	name     string
+	pattern  *regexp.Regexp
+	response string
+}
+
+// pagerInteraction continues paged output; paging is disabled after login,
+// but banners and the first command can still be paged
+var pagerInteraction = consoleInteraction{
+	name:     "pager",
+	pattern:  regexp.MustCompile(`-{2,}\s*(?i:more|つづく|続く|つづきます|続きます)[^-\n]*-{2,}\s*$`),
+	response: " ",
+}
+
+// loginInteractions answer questions shown between connecting and the first prompt
+var loginInteractions = []consoleInteraction{
+	pagerInteraction,
+	{
+		name:     "press any key",
+		pattern:  regexp.MustCompile(`(?i)(?:press|hit) (?:any|a|the|enter) ?key[^\n]*$|何かキーを押[^\n]*$`),
+		response: "\r",
+	},
+	{
+		// Routers warn about weak or unset passwords and offer to change
+		// them; the provider does not change passwords it was not asked to
+		name:     "password change",
+		pattern:  regexp.MustCompile(`(?is)(?:password|パスワード).*[(\[]y/n[)\]]\s*[:?]?\s*$`),
+		response: "N\r",
+	},
+}
+
+// commandInteractions answer questions asked while a command runs
+var commandInteractions = []consoleInteraction{
+	pagerInteraction,
+	{
+		// Keep the existing SSHD host key, like GenerateSSHDHostKey does
+		name:     "host key update",
+		pattern:  regexp.MustCompile(`(?is)(?:host key|ホスト鍵|overwrite).*[(\[]y/n[)\]]\s*[:?]?\s*$`),
+		response: "N\r",
+	},
+	{
+		// Commands such as "cold start" ask before acting; the provider
+		// only sends them when the configuration asks for them
+		name:     "confirmation",
+		pattern:  regexp.MustCompile(`(?i)[(\[]y/n[)\]]\s*[:?]?\s*$`),
+		response: "Y\r",
+	},
+}
+
+// matchInteraction returns the first interaction matching the end of pending
+func matchInteraction(pending string, interactions []consoleInteraction) *consoleInteraction {
+	if len(pending) > interactionWindow {
+		pending = pending[len(pending)-interactionWindow:]
+	}
+	for i := range interactions {
+		if interactions[i].pattern.MatchString(pending) {
+			return &interactions[i]
+		}
+	}
+	return nil
+}
+
+// answerInteraction types the response to an interaction into the console
+func (s *workingSession) answerInteraction(interaction consoleInteraction) error {
+	logging.Global().Debug().Str("interaction", interaction.name).Msg("Answering console prompt")
+	if _, err := fmt.Fprint(s.stdin, interaction.response); err != nil {
+		return fmt.Errorf("failed to answer %s prompt: %w", interaction.name, err)
+	}
+	return nil
+}
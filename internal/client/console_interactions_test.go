@@ -0,0 +1,76 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchInteraction(t *testing.T) {
+	tests := []struct {
+		name         string
+		pending      string
+		interactions []consoleInteraction
+		want         string // Expected response, "" for none
+	}{
+		{"pager", "line 40\r\n---つづく---", commandInteractions, " "},
+		{"english pager", "line 40\r\n-- More --", loginInteractions, " "},
+		{"press any key", "Welcome to RTX1210\r\nPress any key to continue", loginInteractions, "\r"},
+		{"password change", "Password strength is weak.\r\nChange the password? (Y/N): ", loginInteractions, "N\r"},
+		{"host key update", "sshd host key generate\r\nUpdate the host key? (Y/N)", commandInteractions, "N\r"},
+		{"confirmation", "cold start\r\nInitialize the configuration? (Y/N) ", commandInteractions, "Y\r"},
+		{"confirmation is not answered while logging in", "Continue? (Y/N) ", loginInteractions, ""},
+		{"question inside output", "description lan1 \"reboot? (y/n)\"\r\nip lan1 address", commandInteractions, ""},
+		{"prompt", "show status lan1\r\nLAN1\r\n[RTX1210] # ", commandInteractions, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := matchInteraction(tt.pending, tt.interactions)
+			if tt.want == "" {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want, got.response)
+		})
+	}
+}
+
+func TestWorkingSession_LoginBannerSettles(t *testing.T) {
+	// The banner line looks like a prompt until its newline arrives
+	output := "Contact <noc@example.com>\r\nPassword strength: weak\r\n\r\n[RTX1210] > "
+	s := newPacingTestSession(&consoleInput{}, Pacing{}, output)
+
+	got, err := s.expectPrompt(time.Second, loginInteractions, 20*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, output, string(got))
+}
+
+func TestWorkingSession_AnswersConfirmation(t *testing.T) {
+	input := &consoleInput{}
+	s := newPacingTestSession(input, Pacing{}, "")
+	s.readCh = make(chan readResult, 256)
+	feed := func(text string) {
+		for i := 0; i < len(text); i++ {
+			s.readCh <- readResult{b: text[i]}
+		}
+	}
+	feed("cold start\r\nInitialize the configuration? (Y/N) ")
+
+	done := make(chan error, 1)
+	var got []byte
+	go func() {
+		var err error
+		got, err = s.readUntilPrompt(2 * time.Second)
+		done <- err
+	}()
+
+	require.Eventually(t, func() bool { return input.String() == "Y\r" }, time.Second, 5*time.Millisecond)
+	feed("Y\r\nRestarting ...\r\n[RTX1210] # ")
+
+	require.NoError(t, <-done)
+	assert.Contains(t, string(got), "Restarting")
+}
@@ -0,0 +1,149 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// DefaultCredentialCommandTimeout is how long an external credential command may run
+const DefaultCredentialCommandTimeout = 30 * time.Second
+
+// Credentials holds the secrets needed to log in to a router
+type Credentials struct {
+	Username             string `json:"username"`
+	Password             string `json:"password"`
+	AdminPassword        string `json:"admin_password"`
+	PrivateKeyPassphrase string `json:"private_key_passphrase"`
+}
+
+// CredentialSources describes where to read credentials that are not configured
+// directly. Sources are consulted in order: environment variables, files, then
+// the external command; the first non-empty value for each credential wins.
+type CredentialSources struct {
+	PasswordEnv              string        // Environment variable holding the login password
+	AdminPasswordEnv         string        // Environment variable holding the administrator password
+	PasswordFile             string        // File containing the login password
+	AdminPasswordFile        string        // File containing the administrator password
+	PrivateKeyPassphraseFile string        // File containing the private key passphrase
+	Command                  []string      // External command printing credentials (program and arguments)
+	CommandTimeout           time.Duration // Time allowed for Command (default: 30s)
+}
+
+// Resolve reads the credentials from the configured sources. host is passed to
+// the external command in the RTX_HOST environment variable so that a single
+// command can serve several routers.
+func (s CredentialSources) Resolve(ctx context.Context, host string) (Credentials, error) {
+	var creds Credentials
+
+	if s.PasswordEnv != "" {
+		creds.Password = os.Getenv(s.PasswordEnv)
+	}
+	if s.AdminPasswordEnv != "" {
+		creds.AdminPassword = os.Getenv(s.AdminPasswordEnv)
+	}
+
+	files := []struct {
+		path   string
+		target *string
+	}{
+		{s.PasswordFile, &creds.Password},
+		{s.AdminPasswordFile, &creds.AdminPassword},
+		{s.PrivateKeyPassphraseFile, &creds.PrivateKeyPassphrase},
+	}
+	for _, f := range files {
+		if f.path == "" || *f.target != "" {
+			continue
+		}
+		value, err := readCredentialFile(f.path)
+		if err != nil {
+			return Credentials{}, err
+		}
+		*f.target = value
+	}
+
+	if len(s.Command) > 0 {
+		fromCommand, err := s.runCommand(ctx, host)
+		if err != nil {
+			return Credentials{}, err
+		}
+		creds = creds.merge(fromCommand)
+	}
+
+	return creds, nil
+}
+
+// merge fills the empty fields of c from other
+func (c Credentials) merge(other Credentials) Credentials {
+	if c.Username == "" {
+		c.Username = other.Username
+	}
+	if c.Password == "" {
+		c.Password = other.Password
+	}
+	if c.AdminPassword == "" {
+		c.AdminPassword = other.AdminPassword
+	}
+	if c.PrivateKeyPassphrase == "" {
+		c.PrivateKeyPassphrase = other.PrivateKeyPassphrase
+	}
+	return c
+}
+
+// readCredentialFile reads a secret from path, dropping the trailing newline
+func readCredentialFile(path string) (string, error) {
+	expanded, err := expandUserPath(path)
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(expanded)
+	if err != nil {
+		return "", fmt.Errorf("failed to read credential file %s: %w", path, err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// runCommand runs the external credential command. A JSON object on stdout sets
+// the matching fields; any other output is used as the login password.
+func (s CredentialSources) runCommand(ctx context.Context, host string) (Credentials, error) {
+	timeout := s.CommandTimeout
+	if timeout <= 0 {
+		timeout = DefaultCredentialCommandTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, s.Command[0], s.Command[1:]...)
+	cmd.Env = append(os.Environ(), "RTX_HOST="+host)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Do not wait for grandchildren that keep the output open after a timeout
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return Credentials{}, fmt.Errorf("credential command %q timed out after %s", s.Command[0], timeout)
+		}
+		// stderr is included to help diagnose the command; stdout may hold secrets
+		return Credentials{}, fmt.Errorf("credential command %q failed: %w: %s", s.Command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	output := strings.TrimSpace(stdout.String())
+	if output == "" {
+		return Credentials{}, fmt.Errorf("credential command %q produced no output", s.Command[0])
+	}
+	if strings.HasPrefix(output, "{") {
+		var creds Credentials
+		if err := json.Unmarshal([]byte(output), &creds); err != nil {
+			return Credentials{}, fmt.Errorf("failed to parse output of credential command %q as JSON: %w", s.Command[0], err)
+		}
+		return creds, nil
+	}
+	return Credentials{Password: output}, nil
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCredentialSources_Resolve_EnvAndFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	passwordFile := filepath.Join(tmpDir, "password")
+	if err := os.WriteFile(passwordFile, []byte("file-password\n"), 0600); err != nil {
+		t.Fatalf("Failed to write password file: %v", err)
+	}
+	passphraseFile := filepath.Join(tmpDir, "passphrase")
+	if err := os.WriteFile(passphraseFile, []byte("file-passphrase\r\n"), 0600); err != nil {
+		t.Fatalf("Failed to write passphrase file: %v", err)
+	}
+	adminFile := filepath.Join(tmpDir, "admin")
+	if err := os.WriteFile(adminFile, []byte("file-admin\n"), 0600); err != nil {
+		t.Fatalf("Failed to write admin password file: %v", err)
+	}
+	t.Setenv("TEST_RTX_ADMIN_SECRET", "env-admin")
+
+	sources := CredentialSources{
+		AdminPasswordEnv:         "TEST_RTX_ADMIN_SECRET",
+		PasswordFile:             passwordFile,
+		AdminPasswordFile:        adminFile,
+		PrivateKeyPassphraseFile: passphraseFile,
+	}
+	got, err := sources.Resolve(context.Background(), "192.168.1.1")
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+
+	want := Credentials{
+		Password:             "file-password",
+		AdminPassword:        "env-admin", // environment takes priority over files
+		PrivateKeyPassphrase: "file-passphrase",
+	}
+	if got != want {
+		t.Errorf("Resolve() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCredentialSources_Resolve_MissingFile(t *testing.T) {
+	sources := CredentialSources{PasswordFile: filepath.Join(t.TempDir(), "missing")}
+	if _, err := sources.Resolve(context.Background(), "192.168.1.1"); err == nil {
+		t.Error("Resolve() expected error for missing file")
+	}
+}
+
+func TestCredentialSources_Resolve_Command(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test commands use /bin/sh")
+	}
+
+	tests := []struct {
+		name    string
+		sources CredentialSources
+		want    Credentials
+		wantErr string
+	}{
+		{
+			name:    "plain output is the password",
+			sources: CredentialSources{Command: []string{"/bin/sh", "-c", "echo plain-secret"}},
+			want:    Credentials{Password: "plain-secret"},
+		},
+		{
+			name: "JSON output",
+			sources: CredentialSources{Command: []string{"/bin/sh", "-c",
+				`echo '{"username":"admin","password":"p","admin_password":"ap"}'`}},
+			want: Credentials{Username: "admin", Password: "p", AdminPassword: "ap"},
+		},
+		{
+			name:    "host is passed to the command",
+			sources: CredentialSources{Command: []string{"/bin/sh", "-c", `echo "secret-for-$RTX_HOST"`}},
+			want:    Credentials{Password: "secret-for-192.168.1.1"},
+		},
+		{
+			name: "command does not override other sources",
+			sources: CredentialSources{
+				PasswordEnv: "TEST_RTX_PASSWORD_SECRET",
+				Command:     []string{"/bin/sh", "-c", `echo '{"password":"from-command","admin_password":"ap"}'`},
+			},
+			want: Credentials{Password: "from-env", AdminPassword: "ap"},
+		},
+		{
+			name:    "failing command",
+			sources: CredentialSources{Command: []string{"/bin/sh", "-c", "echo vault sealed >&2; exit 2"}},
+			wantErr: "vault sealed",
+		},
+		{
+			name:    "empty output",
+			sources: CredentialSources{Command: []string{"/bin/sh", "-c", "true"}},
+			wantErr: "no output",
+		},
+		{
+			name:    "invalid JSON",
+			sources: CredentialSources{Command: []string{"/bin/sh", "-c", "echo '{password'"}},
+			wantErr: "JSON",
+		},
+		{
+			name: "timeout",
+			sources: CredentialSources{
+				Command:        []string{"/bin/sh", "-c", "sleep 5"},
+				CommandTimeout: 100 * time.Millisecond,
+			},
+			wantErr: "timed out",
+		},
+	}
+
+	t.Setenv("TEST_RTX_PASSWORD_SECRET", "from-env")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.sources.Resolve(context.Background(), "192.168.1.1")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Resolve() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Resolve() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
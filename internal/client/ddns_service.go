@@ -90,7 +90,7 @@ func (s *DDNSService) ConfigureNetVolante(ctx context.Context, config NetVolante
 	// Build and execute commands
 	commands := parsers.BuildNetVolanteCommand(parserConfig)
 	for _, cmd := range commands {
-		logging.FromContext(ctx).Debug().Str("service", "ddns").Msgf("Executing NetVolante command: %s", cmd)
+		logging.FromContext(ctx).Debug().Str("service", "ddns").Msgf("Executing NetVolante command: %s", logging.RedactCommand(cmd))
 		if _, err := s.executor.Run(ctx, cmd); err != nil {
 			return fmt.Errorf("failed to execute command %q: %w", cmd, err)
 		}
@@ -226,7 +226,7 @@ func (s *DDNSService) ConfigureDDNS(ctx context.Context, config DDNSServerConfig
 	// Build and execute commands
 	commands := parsers.BuildDDNSCommand(parserConfig)
 	for _, cmd := range commands {
-		logging.FromContext(ctx).Debug().Str("service", "ddns").Msgf("Executing DDNS command: %s", cmd)
+		logging.FromContext(ctx).Debug().Str("service", "ddns").Msgf("Executing DDNS command: %s", logging.RedactCommand(cmd))
 		if _, err := s.executor.Run(ctx, cmd); err != nil {
 			return fmt.Errorf("failed to execute command %q: %w", cmd, err)
 		}
@@ -504,3 +504,26 @@ func TestDDNSService_ConfigureDDNS_WithPassword(t *testing.T) {
 		t.Errorf("ConfigureDDNS() expected 'ddns server user' command with password, got commands: %v", executor.commands)
 	}
 }
+
+func TestDDNSService_GetNetVolanteStatus(t *testing.T) {
+	executor := newMockDDNSExecutor()
+	executor.responses["show status netvolante-dns"] = []byte(`
+Interface: pp 1
+Hostname: myhost.aa0.netvolante.jp
+IP Address: 203.0.113.1
+Status: registered
+Last Update: 2024-01-20 10:30:00
+`)
+	service := NewDDNSService(executor, nil)
+
+	statuses, err := service.GetNetVolanteStatus(context.Background())
+	if err != nil {
+		t.Fatalf("GetNetVolanteStatus() unexpected error: %v", err)
+	}
+	if len(statuses) != 1 {
+		t.Fatalf("GetNetVolanteStatus() returned %d statuses, want 1", len(statuses))
+	}
+	if statuses[0].Hostname != "myhost.aa0.netvolante.jp" || statuses[0].CurrentIP != "203.0.113.1" {
+		t.Errorf("GetNetVolanteStatus() = %+v", statuses[0])
+	}
+}
@@ -0,0 +1,236 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+// fileLockPollInterval is how often a busy cross-process lock file is retried
+const fileLockPollInterval = 100 * time.Millisecond
+
+// deviceLocks holds one in-process lock per router address, shared by all
+// clients in the provider process (e.g., aliased provider configurations)
+var deviceLocks sync.Map // map[string]chan struct{}
+
+// deviceLock serializes configuration commands sent to a single router.
+// Commands for different routers use different locks and still run in parallel.
+type deviceLock struct {
+	key      string        // Router address (host:port)
+	ch       chan struct{} // In-process mutex that can be abandoned on context cancellation
+	lockFile string        // Optional lock file shared with other processes
+}
+
+// newDeviceLock returns the lock of the router at key. If lockFile is set,
+// an exclusive lock on that file is also held while commands run.
+func newDeviceLock(key, lockFile string) *deviceLock {
+	ch, _ := deviceLocks.LoadOrStore(key, make(chan struct{}, 1))
+	return &deviceLock{
+		key:      key,
+		ch:       ch.(chan struct{}),
+		lockFile: lockFile,
+	}
+}
+
+// heldLockKey is the context key marking an operation that holds the device
+// lock of the router at key
+type heldLockKey struct {
+	key string
+}
+
+// heldBy reports whether ctx belongs to an operation holding the lock
+func (l *deviceLock) heldBy(ctx context.Context) bool {
+	return ctx.Value(heldLockKey{key: l.key}) != nil
+}
+
+// LockOperation holds the lock for a whole operation of several commands.
+// Commands run with the returned context do not take the lock again, and
+// other operations wait until the returned unlock function is called.
+func (l *deviceLock) LockOperation(ctx context.Context) (context.Context, func(), error) {
+	if l.heldBy(ctx) {
+		return ctx, func() {}, nil
+	}
+	unlock, err := l.Lock(ctx)
+	if err != nil {
+		return ctx, nil, err
+	}
+	return context.WithValue(ctx, heldLockKey{key: l.key}, true), unlock, nil
+}
+
+// lockCommand takes the lock for a single command, unless the operation the
+// command belongs to already holds it
+func (l *deviceLock) lockCommand(ctx context.Context) (func(), error) {
+	if l.heldBy(ctx) {
+		return func() {}, nil
+	}
+	return l.Lock(ctx)
+}
+
+// Lock waits until the router is free or ctx is done and returns the unlock function
+func (l *deviceLock) Lock(ctx context.Context) (func(), error) {
+	select {
+	case l.ch <- struct{}{}:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%w: waiting for device lock on %s: %v", ErrTimeout, l.key, ctx.Err())
+	}
+
+	if l.lockFile == "" {
+		return func() { <-l.ch }, nil
+	}
+
+	f, err := acquireFileLock(ctx, l.lockFile)
+	if err != nil {
+		<-l.ch
+		return nil, err
+	}
+	return func() {
+		releaseFileLock(f)
+		<-l.ch
+	}, nil
+}
+
+// acquireFileLock opens path and takes an exclusive lock on it, polling until
+// the lock is free or ctx is done
+func acquireFileLock(ctx context.Context, path string) (*os.File, error) {
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, fmt.Errorf("failed to create lock file directory: %w", err)
+		}
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock file %s: %w", path, err)
+	}
+
+	for {
+		locked, err := tryLockFile(f)
+		if err != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("failed to lock %s: %w", path, err)
+		}
+		if locked {
+			return f, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			_ = f.Close()
+			return nil, fmt.Errorf("%w: waiting for lock file %s: %v", ErrTimeout, path, ctx.Err())
+		case <-time.After(fileLockPollInterval):
+		}
+	}
+}
+
+// releaseFileLock releases and closes a lock file taken by acquireFileLock
+func releaseFileLock(f *os.File) {
+	if err := unlockFile(f); err != nil {
+		logging.Global().Warn().Err(err).Str("file", f.Name()).Msg("Failed to unlock lock file")
+	}
+	_ = f.Close()
+}
+
+// isReadOnlyCommand reports whether a command only reads router state.
+// Read-only commands are not serialized, so refreshes stay parallel.
+func isReadOnlyCommand(cmd string) bool {
+	cmdLower := strings.ToLower(strings.TrimSpace(cmd))
+	for _, prefix := range userLevelCommandPrefixes {
+		if strings.HasPrefix(cmdLower, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// lockedExecutor serializes configuration commands per router. Resource
+// operations hold the lock for all of their commands (see
+// deviceLock.LockOperation) so that concurrent changes cannot interleave;
+// commands outside such an operation take it one command at a time.
+type lockedExecutor struct {
+	inner Executor
+	lock  *deviceLock
+}
+
+// newLockedExecutor wraps an executor with the device lock
+func newLockedExecutor(inner Executor, lock *deviceLock) Executor {
+	return &lockedExecutor{
+		inner: inner,
+		lock:  lock,
+	}
+}
+
+// Run executes a command, holding the device lock for configuration commands
+func (e *lockedExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
+	if isReadOnlyCommand(cmd) {
+		return e.inner.Run(ctx, cmd)
+	}
+
+	unlock, err := e.lock.lockCommand(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return e.inner.Run(ctx, cmd)
+}
+
+// RunBatch executes the commands while holding the device lock, unless all of them are read-only
+func (e *lockedExecutor) RunBatch(ctx context.Context, cmds []string) ([]byte, error) {
+	readOnly := true
+	for _, cmd := range cmds {
+		if !isReadOnlyCommand(cmd) {
+			readOnly = false
+			break
+		}
+	}
+	if readOnly {
+		return e.inner.RunBatch(ctx, cmds)
+	}
+
+	unlock, err := e.lock.lockCommand(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return e.inner.RunBatch(ctx, cmds)
+}
+
+// SetAdministratorPassword sets the administrator password while holding the device lock
+func (e *lockedExecutor) SetAdministratorPassword(ctx context.Context, oldPassword, newPassword string) error {
+	unlock, err := e.lock.lockCommand(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return e.inner.SetAdministratorPassword(ctx, oldPassword, newPassword)
+}
+
+// SetLoginPassword sets the login password while holding the device lock
+func (e *lockedExecutor) SetLoginPassword(ctx context.Context, newPassword string) error {
+	unlock, err := e.lock.lockCommand(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return e.inner.SetLoginPassword(ctx, newPassword)
+}
+
+// GenerateSSHDHostKey generates the SSHD host key while holding the device lock
+func (e *lockedExecutor) GenerateSSHDHostKey(ctx context.Context) error {
+	unlock, err := e.lock.lockCommand(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return e.inner.GenerateSSHDHostKey(ctx)
+}
@@ -0,0 +1,260 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// concurrencyExecutor records the maximum number of commands running at the same time
+type concurrencyExecutor struct {
+	running atomic.Int32
+	max     atomic.Int32
+}
+
+func (e *concurrencyExecutor) track() {
+	n := e.running.Add(1)
+	for {
+		m := e.max.Load()
+		if n <= m || e.max.CompareAndSwap(m, n) {
+			break
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+	e.running.Add(-1)
+}
+
+func (e *concurrencyExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
+	e.track()
+	return nil, nil
+}
+
+func (e *concurrencyExecutor) RunBatch(ctx context.Context, cmds []string) ([]byte, error) {
+	e.track()
+	return nil, nil
+}
+
+func (e *concurrencyExecutor) SetAdministratorPassword(ctx context.Context, oldPassword, newPassword string) error {
+	e.track()
+	return nil
+}
+
+func (e *concurrencyExecutor) SetLoginPassword(ctx context.Context, newPassword string) error {
+	e.track()
+	return nil
+}
+
+func (e *concurrencyExecutor) GenerateSSHDHostKey(ctx context.Context) error {
+	e.track()
+	return nil
+}
+
+// runConcurrently runs cmd on every executor from several goroutines
+func runConcurrently(t *testing.T, executors []Executor, cmd string) {
+	t.Helper()
+	var wg sync.WaitGroup
+	for _, executor := range executors {
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func(executor Executor) {
+				defer wg.Done()
+				if _, err := executor.Run(context.Background(), cmd); err != nil {
+					t.Errorf("Run() error = %v", err)
+				}
+			}(executor)
+		}
+	}
+	wg.Wait()
+}
+
+func TestIsReadOnlyCommand(t *testing.T) {
+	tests := []struct {
+		cmd  string
+		want bool
+	}{
+		{cmd: "show config", want: true},
+		{cmd: "  SHOW status lan1", want: true},
+		{cmd: "console character ja.utf8", want: false},
+		{cmd: "console lines infinity", want: false},
+		{cmd: "ping 192.168.1.1", want: true},
+		{cmd: "traceroute6 2001:db8::1", want: true},
+		{cmd: "ip lan1 address 192.168.1.1/24", want: false},
+		{cmd: "save", want: false},
+		{cmd: "showx", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cmd, func(t *testing.T) {
+			if got := isReadOnlyCommand(tt.cmd); got != tt.want {
+				t.Errorf("isReadOnlyCommand(%q) = %v, want %v", tt.cmd, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLockedExecutor_Serialization(t *testing.T) {
+	tests := []struct {
+		name           string
+		keys           []string
+		cmd            string
+		wantSerialized bool
+	}{
+		{
+			name:           "configuration commands to one router are serialized",
+			keys:           []string{"router-a:22", "router-a:22"},
+			cmd:            "ip route default gateway pp 1",
+			wantSerialized: true,
+		},
+		{
+			name:           "read-only commands run in parallel",
+			keys:           []string{"router-a:22", "router-a:22"},
+			cmd:            "show status lan1",
+			wantSerialized: false,
+		},
+		{
+			name:           "different routers run in parallel",
+			keys:           []string{"router-a:22", "router-b:22"},
+			cmd:            "ip route default gateway pp 1",
+			wantSerialized: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &concurrencyExecutor{}
+			var executors []Executor
+			for _, key := range tt.keys {
+				executors = append(executors, newLockedExecutor(inner, newDeviceLock(t.Name()+"/"+key, "")))
+			}
+
+			runConcurrently(t, executors, tt.cmd)
+
+			if serialized := inner.max.Load() == 1; serialized != tt.wantSerialized {
+				t.Errorf("max concurrent commands = %d, want serialized = %v", inner.max.Load(), tt.wantSerialized)
+			}
+		})
+	}
+}
+
+func TestLockedExecutor_LockFile(t *testing.T) {
+	lockFile := filepath.Join(t.TempDir(), "locks", "router.lock")
+	inner := &concurrencyExecutor{}
+
+	// Different in-process keys simulate separate processes sharing only the lock file
+	executors := []Executor{
+		newLockedExecutor(inner, newDeviceLock(t.Name()+"/process-1", lockFile)),
+		newLockedExecutor(inner, newDeviceLock(t.Name()+"/process-2", lockFile)),
+	}
+
+	runConcurrently(t, executors, "save")
+
+	if got := inner.max.Load(); got != 1 {
+		t.Errorf("max concurrent commands = %d, want 1", got)
+	}
+}
+
+func TestDeviceLock_ContextCanceled(t *testing.T) {
+	lock := newDeviceLock(t.Name(), "")
+
+	unlock, err := lock.Lock(context.Background())
+	if err != nil {
+		t.Fatalf("Lock() error = %v", err)
+	}
+	defer unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := lock.Lock(ctx); !errors.Is(err, ErrTimeout) {
+		t.Errorf("Lock() error = %v, want ErrTimeout", err)
+	}
+}
+
+// orderExecutor records the commands it runs in order
+type orderExecutor struct {
+	concurrencyExecutor
+	mu   sync.Mutex
+	cmds []string
+}
+
+func (e *orderExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
+	e.mu.Lock()
+	e.cmds = append(e.cmds, cmd)
+	e.mu.Unlock()
+	time.Sleep(5 * time.Millisecond)
+	return nil, nil
+}
+
+func TestDeviceLock_LockOperation(t *testing.T) {
+	inner := &orderExecutor{}
+	lock := newDeviceLock(t.Name(), "")
+	executor := newLockedExecutor(inner, lock)
+
+	operations := map[string][]string{
+		"a": {"ip filter 1 pass * * * * *", "ip lan1 secure filter in 1", "show config", "save"},
+		"b": {"ip filter 2 reject * * * * *", "ip lan1 secure filter in 2", "show config", "save"},
+	}
+
+	var wg sync.WaitGroup
+	for _, cmds := range operations {
+		wg.Add(1)
+		go func(cmds []string) {
+			defer wg.Done()
+			ctx, unlock, err := lock.LockOperation(context.Background())
+			if err != nil {
+				t.Errorf("LockOperation() error = %v", err)
+				return
+			}
+			defer unlock()
+
+			// Taking the lock again within the operation must not block
+			ctx, unlockAgain, err := lock.LockOperation(ctx)
+			if err != nil {
+				t.Errorf("nested LockOperation() error = %v", err)
+				return
+			}
+			defer unlockAgain()
+
+			for _, cmd := range cmds {
+				if _, err := executor.Run(ctx, cmd); err != nil {
+					t.Errorf("Run(%q) error = %v", cmd, err)
+				}
+			}
+		}(cmds)
+	}
+
+	// A single command outside an operation waits for the running operation
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if _, err := executor.Run(context.Background(), "ip route default gateway pp 1"); err != nil {
+			t.Errorf("Run() error = %v", err)
+		}
+	}()
+	wg.Wait()
+
+	if len(inner.cmds) != 9 {
+		t.Fatalf("ran %d commands, want 9: %v", len(inner.cmds), inner.cmds)
+	}
+	for name, cmds := range operations {
+		start := -1
+		for i, cmd := range inner.cmds {
+			if cmd == cmds[0] {
+				start = i
+			}
+		}
+		if start < 0 || start+len(cmds) > len(inner.cmds) {
+			t.Fatalf("operation %s did not run: %v", name, inner.cmds)
+		}
+		for i, cmd := range cmds {
+			if inner.cmds[start+i] != cmd {
+				t.Errorf("operation %s was interleaved with other commands: %v", name, inner.cmds)
+				break
+			}
+		}
+	}
+}
@@ -133,6 +133,21 @@ func containsError(output string) bool {
 	return false
 }
 
+// rejectionLine returns the first line of output in which the router rejected
+// the command ("Error: ..." or "エラー: ..."), or "" if there is none. Unlike
+// containsError it only inspects error lines, so status and dump output whose
+// labels or entries happen to contain words such as "error" or "not found"
+// are not mistaken for a failure.
+func rejectionLine(output string) string {
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if trimmed := strings.TrimPrefix(line, "% "); strings.HasPrefix(trimmed, "Error:") || strings.HasPrefix(trimmed, "エラー:") {
+			return line
+		}
+	}
+	return ""
+}
+
 // validateDHCPBinding validates DHCP binding parameters
 func validateDHCPBinding(binding DHCPBinding) error {
 	if binding.ScopeID <= 0 {
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// DNSCacheService handles DNS cache queries ("show dns cache")
+type DNSCacheService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewDNSCacheService creates a new DNS cache service instance
+func NewDNSCacheService(executor Executor, client *rtxClient) *DNSCacheService {
+	return &DNSCacheService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the DNS cache statistics and the entries for name (all entries if empty)
+func (s *DNSCacheService) Get(ctx context.Context, name string) (*DNSCache, error) {
+	cmd := parsers.BuildShowDNSCacheCommand()
+	logging.FromContext(ctx).Debug().Str("service", "dns_cache").Msgf("Getting DNS cache with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DNS cache: %w", err)
+	}
+	if line := rejectionLine(string(output)); line != "" {
+		return nil, fmt.Errorf("failed to get DNS cache: %s", line)
+	}
+
+	parsed := parsers.ParseDNSCache(string(output))
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+
+	cache := &DNSCache{
+		MaxEntries: parsed.MaxEntries,
+		EntryCount: parsed.EntryCount,
+		Entries:    []DNSCacheEntry{},
+	}
+	for _, e := range parsed.Entries {
+		if name != "" && e.Name != name {
+			continue
+		}
+		cache.Entries = append(cache.Entries, DNSCacheEntry(e))
+	}
+	return cache, nil
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDNSCacheService_Get(t *testing.T) {
+	output := `Max Entry: 256
+Current Entry: 2
+www.example.com.        A      297    93.184.216.34
+intranet.corp.example   A      58     10.0.0.10
+`
+
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show dns cache").Return([]byte(output), nil)
+
+	service := NewDNSCacheService(mockExecutor, nil)
+
+	cache, err := service.Get(context.Background(), "Intranet.Corp.Example.")
+	assert.NoError(t, err)
+	assert.Equal(t, &DNSCache{
+		MaxEntries: 256,
+		EntryCount: 2,
+		Entries: []DNSCacheEntry{
+			{Name: "intranet.corp.example", Type: "A", TTL: 58, Data: "10.0.0.10"},
+		},
+	}, cache)
+}
+
+func TestDNSCacheService_Get_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		wantErr bool
+	}{
+		{
+			name:   "record data resembling an error",
+			output: "Max Entry: 256\nCurrent Entry: 1\nstatus.example.com.     TXT    300    \"error: page not found\"\n",
+		},
+		{
+			name:    "command rejected",
+			output:  "Error: Invalid command name\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExecutor := new(MockExecutor)
+			mockExecutor.On("Run", mock.Anything, "show dns cache").Return([]byte(tt.output), nil)
+
+			cache, err := NewDNSCacheService(mockExecutor, nil).Get(context.Background(), "")
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "Invalid command name")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, []DNSCacheEntry{
+				{Name: "status.example.com", Type: "TXT", TTL: 300, Data: `"error: page not found"`},
+			}, cache.Entries)
+		})
+	}
+}
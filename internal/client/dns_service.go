@@ -197,11 +197,89 @@ func (s *DNSService) Update(ctx context.Context, config DNSConfig) error {
 		}
 	}
 
-	// Update server select entries
+	// Update server select entries. A nil list means the entries are not
+	// managed by the caller (manage_server_select = false) and must be left
+	// untouched; an empty list removes them all.
+	if config.ServerSelect != nil {
+		if err := s.updateServerSelects(ctx, currentConfig.ServerSelect, config.ServerSelect); err != nil {
+			return err
+		}
+	}
+
+	// Update static hosts. A nil list means the hosts are not managed by the
+	// caller (manage_hosts = false) and must be left untouched; an empty list
+	// removes them all.
+	if config.Hosts != nil {
+		if err := s.updateHosts(ctx, currentConfig.Hosts, config.Hosts); err != nil {
+			return err
+		}
+	}
+
+	// Update DNS service
+	if config.ServiceOn != currentConfig.ServiceOn {
+		cmd := parsers.BuildDNSServiceCommand(config.ServiceOn)
+		logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Updating DNS service with command: %s", cmd)
+		if _, err := s.executor.Run(ctx, cmd); err != nil {
+			return fmt.Errorf("failed to update DNS service: %w", err)
+		}
+	}
+
+	// Update private address spoof
+	if config.PrivateSpoof != currentConfig.PrivateSpoof {
+		cmd := parsers.BuildDNSPrivateSpoofCommand(config.PrivateSpoof)
+		logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Updating DNS private spoof with command: %s", cmd)
+		if _, err := s.executor.Run(ctx, cmd); err != nil {
+			return fmt.Errorf("failed to update DNS private spoof: %w", err)
+		}
+	}
+
+	// Save configuration
+	if s.client != nil {
+		if err := s.client.SaveConfig(ctx); err != nil {
+			return fmt.Errorf("DNS config updated but failed to save configuration: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Reset removes DNS configuration
+func (s *DNSService) Reset(ctx context.Context) error {
+	// Check context
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	// Server select entries and static hosts are removed by their owners via
+	// DeleteServerSelect and DeleteStaticHost
+
+	// Execute delete commands
+	deleteCommands := parsers.BuildDeleteDNSCommand()
+	for _, cmd := range deleteCommands {
+		logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Resetting DNS with command: %s", cmd)
+		if _, err := s.executor.Run(ctx, cmd); err != nil {
+			logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Command %s failed: %v (continuing)", cmd, err)
+		}
+	}
+
+	// Save configuration
+	if s.client != nil {
+		if err := s.client.SaveConfig(ctx); err != nil {
+			return fmt.Errorf("DNS config reset but failed to save configuration: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// updateServerSelects reconciles the router's server select entries with the desired list
+func (s *DNSService) updateServerSelects(ctx context.Context, current, desired []DNSServerSelect) error {
 	// First, remove entries that are no longer needed
-	for _, currentSel := range currentConfig.ServerSelect {
+	for _, currentSel := range current {
 		found := false
-		for _, newSel := range config.ServerSelect {
+		for _, newSel := range desired {
 			if newSel.ID == currentSel.ID {
 				found = true
 				break
@@ -216,7 +294,7 @@ func (s *DNSService) Update(ctx context.Context, config DNSConfig) error {
 		}
 	}
 	// Add/update new entries
-	for _, sel := range config.ServerSelect {
+	for _, sel := range desired {
 		parserSel := convertDNSServerSelectToParser(sel)
 		cmd := parsers.BuildDNSServerSelectCommand(parserSel)
 		if cmd == "" {
@@ -228,10 +306,15 @@ func (s *DNSService) Update(ctx context.Context, config DNSConfig) error {
 		}
 	}
 
-	// Update static hosts using group-based diff
+	return nil
+}
+
+// updateHosts reconciles the router's static hosts with the desired list using a
+// group-based diff
+func (s *DNSService) updateHosts(ctx context.Context, current, desired []DNSHost) error {
 	// Group by (type, name) to handle multiple IPs per hostname correctly
-	currentGroups := groupHostsByKey(currentConfig.Hosts)
-	newGroups := groupHostsByKey(config.Hosts)
+	currentGroups := groupHostsByKey(current)
+	newGroups := groupHostsByKey(desired)
 
 	// Remove groups that are deleted or changed
 	for key := range currentGroups {
@@ -268,81 +351,157 @@ func (s *DNSService) Update(ctx context.Context, config DNSConfig) error {
 		}
 	}
 
-	// Update DNS service
-	if config.ServiceOn != currentConfig.ServiceOn {
-		cmd := parsers.BuildDNSServiceCommand(config.ServiceOn)
-		logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Updating DNS service with command: %s", cmd)
-		if _, err := s.executor.Run(ctx, cmd); err != nil {
-			return fmt.Errorf("failed to update DNS service: %w", err)
-		}
+	return nil
+}
+
+// GetServerSelect retrieves a single DNS server select entry
+func (s *DNSService) GetServerSelect(ctx context.Context, id int) (*DNSServerSelect, error) {
+	config, err := s.Get(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Update private address spoof
-	if config.PrivateSpoof != currentConfig.PrivateSpoof {
-		cmd := parsers.BuildDNSPrivateSpoofCommand(config.PrivateSpoof)
-		logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Updating DNS private spoof with command: %s", cmd)
-		if _, err := s.executor.Run(ctx, cmd); err != nil {
-			return fmt.Errorf("failed to update DNS private spoof: %w", err)
+	for _, sel := range config.ServerSelect {
+		if sel.ID == id {
+			return &sel, nil
 		}
 	}
 
-	// Save configuration
-	if s.client != nil {
-		if err := s.client.SaveConfig(ctx); err != nil {
-			return fmt.Errorf("DNS config updated but failed to save configuration: %w", err)
-		}
+	return nil, fmt.Errorf("DNS server select %d not found", id)
+}
+
+// SetServerSelect creates or replaces a single DNS server select entry
+func (s *DNSService) SetServerSelect(ctx context.Context, sel DNSServerSelect) error {
+	parserSel := convertDNSServerSelectToParser(sel)
+	if err := parsers.ValidateDNSServerSelect(parserSel); err != nil {
+		return fmt.Errorf("invalid DNS server select: %w", err)
 	}
 
-	return nil
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	cmd := parsers.BuildDNSServerSelectCommand(parserSel)
+	logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Setting DNS server select with command: %s", cmd)
+
+	if err := runCommand(ctx, s.executor, cmd); err != nil {
+		return fmt.Errorf("failed to set DNS server select %d: %w", sel.ID, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("DNS server select %d set", sel.ID))
 }
 
-// Reset removes DNS configuration
-func (s *DNSService) Reset(ctx context.Context) error {
-	// Check context
+// DeleteServerSelect removes a single DNS server select entry
+func (s *DNSService) DeleteServerSelect(ctx context.Context, id int) error {
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
 	default:
 	}
 
-	// Get current configuration to clean up server select and static hosts
-	currentConfig, err := s.Get(ctx)
+	cmd := parsers.BuildDeleteDNSServerSelectCommand(id)
+	logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Removing DNS server select %d with command: %s", id, cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
 	if err != nil {
-		logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Could not get current DNS config, proceeding with reset: %v", err)
-		currentConfig = &DNSConfig{}
+		return fmt.Errorf("failed to delete DNS server select %d: %w", id, err)
 	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete DNS server select"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("DNS server select %d deleted", id))
+}
 
-	// Remove server select entries
-	for _, sel := range currentConfig.ServerSelect {
-		cmd := parsers.BuildDeleteDNSServerSelectCommand(sel.ID)
-		logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Removing DNS server select %d with command: %s", sel.ID, cmd)
-		_, _ = s.executor.Run(ctx, cmd)
+// GetStaticHost retrieves the dns static entries for a record type and name
+func (s *DNSService) GetStaticHost(ctx context.Context, recordType, name string) (*DNSStaticHost, error) {
+	config, err := s.Get(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Remove static hosts
-	for _, host := range currentConfig.Hosts {
-		cmd := parsers.BuildDeleteDNSStaticCommand(host.Type, host.Name)
-		logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Removing DNS static host %s with command: %s", host.Name, cmd)
-		_, _ = s.executor.Run(ctx, cmd)
+	var host *DNSStaticHost
+	for _, h := range config.Hosts {
+		if h.Type != recordType || h.Name != name {
+			continue
+		}
+		if host == nil {
+			host = &DNSStaticHost{Type: h.Type, Name: h.Name, TTL: h.TTL}
+		}
+		host.Addresses = append(host.Addresses, h.Address)
 	}
 
-	// Execute delete commands
-	deleteCommands := parsers.BuildDeleteDNSCommand()
-	for _, cmd := range deleteCommands {
-		logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Resetting DNS with command: %s", cmd)
-		if _, err := s.executor.Run(ctx, cmd); err != nil {
-			logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Command %s failed: %v (continuing)", cmd, err)
+	if host == nil {
+		return nil, fmt.Errorf("DNS static host %s %s not found", recordType, name)
+	}
+	return host, nil
+}
+
+// SetStaticHost creates or replaces the dns static entries for a record type and name
+func (s *DNSService) SetStaticHost(ctx context.Context, host DNSStaticHost) error {
+	if len(host.Addresses) == 0 {
+		return fmt.Errorf("invalid DNS static host: at least one address is required")
+	}
+	entries := staticHostEntries(host)
+	for _, entry := range entries {
+		if err := parsers.ValidateDNSHost(parsers.DNSHost{Type: entry.Type, Name: entry.Name, Address: entry.Address, TTL: entry.TTL}); err != nil {
+			return fmt.Errorf("invalid DNS static host: %w", err)
 		}
 	}
 
-	// Save configuration
-	if s.client != nil {
-		if err := s.client.SaveConfig(ctx); err != nil {
-			return fmt.Errorf("DNS config reset but failed to save configuration: %w", err)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	// Remove the previous group first so addresses dropped from the list do not linger
+	cmd := parsers.BuildDeleteDNSStaticCommand(host.Type, host.Name)
+	logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Removing DNS static host group with command: %s", cmd)
+	_, _ = s.executor.Run(ctx, cmd) // Ignore errors for cleanup
+
+	for _, entry := range entries {
+		cmd := parsers.BuildDNSStaticCommand(parsers.DNSHost{Type: entry.Type, Name: entry.Name, Address: entry.Address, TTL: entry.TTL})
+		logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Setting DNS static host with command: %s", cmd)
+		if err := runCommand(ctx, s.executor, cmd); err != nil {
+			return fmt.Errorf("failed to set DNS static host %s: %w", host.Name, err)
 		}
 	}
 
-	return nil
+	return saveConfig(ctx, s.client, fmt.Sprintf("DNS static host %s set", host.Name))
+}
+
+// DeleteStaticHost removes the dns static entries for a record type and name
+func (s *DNSService) DeleteStaticHost(ctx context.Context, recordType, name string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	cmd := parsers.BuildDeleteDNSStaticCommand(recordType, name)
+	logging.FromContext(ctx).Debug().Str("service", "dns").Msgf("Removing DNS static host with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return fmt.Errorf("failed to delete DNS static host %s: %w", name, err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete DNS static host"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("DNS static host %s deleted", name))
+}
+
+// staticHostEntries expands a DNSStaticHost into one DNSHost per address
+func staticHostEntries(host DNSStaticHost) []DNSHost {
+	entries := make([]DNSHost, len(host.Addresses))
+	for i, addr := range host.Addresses {
+		entries[i] = DNSHost{Type: host.Type, Name: host.Name, Address: addr, TTL: host.TTL}
+	}
+	return entries
 }
 
 // toParserConfig converts client.DNSConfig to parsers.DNSConfig
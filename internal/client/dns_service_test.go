@@ -253,33 +253,9 @@ func TestDNSService_Reset(t *testing.T) {
 		expectedErr bool
 	}{
 		{
-			name: "Successful reset - empty config",
+			// Server select entries and static hosts belong to their own resources
+			name: "Successful reset - global settings only",
 			mockSetup: func(m *MockExecutor) {
-				m.On("Run", mock.Anything, "show config | grep dns").
-					Return([]byte(""), nil)
-				m.On("Run", mock.Anything, "no dns server").
-					Return([]byte(""), nil)
-				m.On("Run", mock.Anything, "no dns domain").
-					Return([]byte(""), nil)
-				m.On("Run", mock.Anything, "dns service off").
-					Return([]byte(""), nil)
-				m.On("Run", mock.Anything, "dns private address spoof off").
-					Return([]byte(""), nil)
-			},
-			expectedErr: false,
-		},
-		{
-			name: "Successful reset - with server select and hosts",
-			mockSetup: func(m *MockExecutor) {
-				m.On("Run", mock.Anything, "show config | grep dns").
-					Return([]byte(`dns server 8.8.8.8
-dns server select 1 192.168.1.1 a internal.example.com
-dns static a router 192.168.1.1
-`), nil)
-				m.On("Run", mock.Anything, "no dns server select 1").
-					Return([]byte(""), nil)
-				m.On("Run", mock.Anything, "no dns static a router").
-					Return([]byte(""), nil)
 				m.On("Run", mock.Anything, "no dns server").
 					Return([]byte(""), nil)
 				m.On("Run", mock.Anything, "no dns domain").
@@ -312,6 +288,95 @@ dns static a router 192.168.1.1
 	}
 }
 
+func TestDNSService_Update_ServerSelect(t *testing.T) {
+	current := `dns server 8.8.8.8
+dns server select 1 192.168.1.1 a internal.example.com
+dns server select 2 192.168.1.2 a lab.example.com
+`
+
+	t.Run("nil list leaves entries untouched", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show config | grep dns").Return([]byte(current), nil)
+
+		service := &DNSService{executor: mockExecutor}
+		err := service.Update(context.Background(), DNSConfig{NameServers: []string{"8.8.8.8"}})
+		assert.NoError(t, err)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("empty list removes all entries", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show config | grep dns").Return([]byte(current), nil)
+		mockExecutor.On("Run", mock.Anything, "no dns server select 1").Return([]byte(""), nil)
+		mockExecutor.On("Run", mock.Anything, "no dns server select 2").Return([]byte(""), nil)
+
+		service := &DNSService{executor: mockExecutor}
+		err := service.Update(context.Background(), DNSConfig{NameServers: []string{"8.8.8.8"}, ServerSelect: []DNSServerSelect{}})
+		assert.NoError(t, err)
+		mockExecutor.AssertExpectations(t)
+	})
+}
+
+func TestDNSService_ServerSelect(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config | grep dns").
+		Return([]byte("dns server select 5 10.0.0.53 edns=on a corp.example.com\n"), nil)
+	mockExecutor.On("Run", mock.Anything, "dns server select 6 10.0.0.54 aaaa *.lab.example.com").Return([]byte(""), nil)
+	mockExecutor.On("Run", mock.Anything, "no dns server select 5").Return([]byte("Error: not found\n"), nil)
+
+	service := &DNSService{executor: mockExecutor}
+	ctx := context.Background()
+
+	sel, err := service.GetServerSelect(ctx, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, "corp.example.com", sel.QueryPattern)
+	assert.Equal(t, []DNSServer{{Address: "10.0.0.53", EDNS: true}}, sel.Servers)
+
+	_, err = service.GetServerSelect(ctx, 6)
+	assert.ErrorContains(t, err, "not found")
+
+	err = service.SetServerSelect(ctx, DNSServerSelect{
+		ID:           6,
+		Servers:      []DNSServer{{Address: "10.0.0.54"}},
+		RecordType:   "aaaa",
+		QueryPattern: "*.lab.example.com",
+	})
+	assert.NoError(t, err)
+
+	err = service.SetServerSelect(ctx, DNSServerSelect{ID: 7, QueryPattern: "."})
+	assert.ErrorContains(t, err, "at least one server")
+
+	assert.NoError(t, service.DeleteServerSelect(ctx, 5))
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestDNSService_StaticHost(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config | grep dns").
+		Return([]byte("dns static a nas.home.local 192.168.1.20\ndns static a nas.home.local 192.168.1.21\ndns static a other.home.local 192.168.1.30\n"), nil)
+	mockExecutor.On("Run", mock.Anything, "no dns static a nas.home.local").Return([]byte(""), nil)
+	mockExecutor.On("Run", mock.Anything, "dns static a nas.home.local 192.168.1.22 ttl=300").Return([]byte(""), nil)
+
+	service := &DNSService{executor: mockExecutor}
+	ctx := context.Background()
+
+	host, err := service.GetStaticHost(ctx, "a", "nas.home.local")
+	assert.NoError(t, err)
+	assert.Equal(t, &DNSStaticHost{Type: "a", Name: "nas.home.local", Addresses: []string{"192.168.1.20", "192.168.1.21"}}, host)
+
+	_, err = service.GetStaticHost(ctx, "aaaa", "nas.home.local")
+	assert.ErrorContains(t, err, "not found")
+
+	err = service.SetStaticHost(ctx, DNSStaticHost{Type: "a", Name: "nas.home.local", Addresses: []string{"192.168.1.22"}, TTL: 300})
+	assert.NoError(t, err)
+
+	err = service.SetStaticHost(ctx, DNSStaticHost{Type: "txt", Name: "x", Addresses: []string{"y"}})
+	assert.ErrorContains(t, err, "invalid record type")
+
+	assert.NoError(t, service.DeleteStaticHost(ctx, "a", "nas.home.local"))
+	mockExecutor.AssertExpectations(t)
+}
+
 func TestDNSService_Update_MultiIPHostDeletion(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// EnvironmentService handles hardware state queries ("show environment")
+type EnvironmentService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewEnvironmentService creates a new environment service instance
+func NewEnvironmentService(executor Executor, client *rtxClient) *EnvironmentService {
+	return &EnvironmentService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves CPU load, memory usage, temperature and fan status
+func (s *EnvironmentService) Get(ctx context.Context) (*Environment, error) {
+	logging.FromContext(ctx).Debug().Str("service", "environment").Msg("Getting environment")
+
+	output, err := s.executor.Run(ctx, "show environment")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+	if line := rejectionLine(string(output)); line != "" {
+		return nil, fmt.Errorf("failed to get environment: %s", line)
+	}
+
+	env := Environment(*parsers.ParseEnvironment(string(output)))
+	return &env, nil
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEnvironmentService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show environment").Return([]byte(`RTX1210 Rev.14.01.42 (Fri Jan 15 15:45:26 2021)
+CPU:   2%(5sec)   1%(1min)   1%(5min)    Memory:  24% used
+Inside Temperature(C): 43
+`), nil)
+
+	service := NewEnvironmentService(mockExecutor, nil)
+
+	env, err := service.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "RTX1210", env.Model)
+	assert.Equal(t, 2, env.CPU5Sec)
+	assert.Equal(t, 24, env.MemoryUsedPercent)
+	if assert.NotNil(t, env.TemperatureCelsius) {
+		assert.Equal(t, 43, *env.TemperatureCelsius)
+	}
+	assert.Empty(t, env.FanStatus)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestEnvironmentService_Get_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		wantErr bool
+	}{
+		{
+			name:   "failed fan is reported, not an error",
+			output: "RTX1210 Rev.14.01.42 (Fri Jan 15 15:45:26 2021)\nCPU:   2%(5sec)   1%(1min)   1%(5min)    Memory:  24% used\nFan: Error: stopped\n",
+		},
+		{
+			name:    "command rejected",
+			output:  "Error: Invalid command name\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExecutor := new(MockExecutor)
+			mockExecutor.On("Run", mock.Anything, "show environment").Return([]byte(tt.output), nil)
+
+			env, err := NewEnvironmentService(mockExecutor, nil).Get(context.Background())
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "Invalid command name")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "Error: stopped", env.FanStatus)
+		})
+	}
+}
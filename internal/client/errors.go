@@ -1,6 +1,9 @@
 package client
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // Common errors that can occur when interacting with RTX routers
 var (
@@ -24,4 +27,30 @@ var (
 
 	// ErrHostKeyMismatch indicates SSH host key verification failed
 	ErrHostKeyMismatch = errors.New("host key verification failed")
+
+	// ErrRouterBusy indicates the router temporarily refused the request
+	ErrRouterBusy = errors.New("router busy")
+
+	// ErrAdminRequired indicates a command needs administrator level and escalation failed
+	ErrAdminRequired = errors.New("administrator privileges required")
+
+	// ErrEchoMismatch indicates the router did not echo a command intact, so it was not run
+	ErrEchoMismatch = errors.New("command echo mismatch")
 )
+
+// PartialApplyError reports an apply of several entries that failed after
+// some of them were written to the router. Applied and Pending hold the
+// numbers of the entries that are and are not configured as requested.
+type PartialApplyError struct {
+	Applied []int
+	Pending []int
+	Err     error
+}
+
+func (e *PartialApplyError) Error() string {
+	return fmt.Sprintf("%v (%d of %d entries applied)", e.Err, len(e.Applied), len(e.Applied)+len(e.Pending))
+}
+
+func (e *PartialApplyError) Unwrap() error {
+	return e.Err
+}
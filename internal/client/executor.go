@@ -104,10 +104,10 @@ func (e *sshExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
 func (e *sshExecutor) RunBatch(ctx context.Context, cmds []string) ([]byte, error) {
 	var allOutput []byte
 
-	for _, cmd := range cmds {
+	for i, cmd := range cmds {
 		output, err := e.Run(ctx, cmd)
 		if err != nil {
-			return allOutput, fmt.Errorf("batch command '%s' failed: %w", cmd, err)
+			return allOutput, &BatchCommandError{Index: i, Command: cmd, Err: err}
 		}
 		allOutput = append(allOutput, output...)
 	}
@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// ExternalMemoryService handles external memory backup operations
+type ExternalMemoryService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewExternalMemoryService creates a new external memory service instance
+func NewExternalMemoryService(executor Executor, client *rtxClient) *ExternalMemoryService {
+	return &ExternalMemoryService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves external memory backup configuration
+func (s *ExternalMemoryService) Get(ctx context.Context) (*ExternalMemoryBackup, error) {
+	var raw string
+	for _, cmd := range []string{
+		parsers.BuildShowExternalMemoryConfigCommand(),
+		parsers.BuildShowExternalMemoryScheduleCommand(),
+	} {
+		logging.FromContext(ctx).Debug().Str("service", "external_memory").Msgf("Getting external memory backup with command: %s", cmd)
+
+		output, err := s.executor.Run(ctx, cmd)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get external memory backup config: %w", err)
+		}
+		raw += string(output) + "\n"
+	}
+
+	parsed := parsers.ParseExternalMemoryBackup(raw)
+	if parsed == nil {
+		return nil, fmt.Errorf("external memory backup configuration not found")
+	}
+
+	backup := s.fromParserBackup(*parsed)
+	return &backup, nil
+}
+
+// Configure creates external memory backup configuration
+func (s *ExternalMemoryService) Configure(ctx context.Context, backup ExternalMemoryBackup) error {
+	parserBackup := s.toParserBackup(backup)
+	if err := parsers.ValidateExternalMemoryBackup(parserBackup); err != nil {
+		return fmt.Errorf("invalid external memory backup config: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := parsers.BuildExternalMemoryBackupCommands(parserBackup)
+	logging.FromContext(ctx).Debug().Str("service", "external_memory").Msgf("Configuring external memory backup with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to configure external memory backup: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "external memory backup configured")
+}
+
+// Update updates external memory backup configuration
+func (s *ExternalMemoryService) Update(ctx context.Context, backup ExternalMemoryBackup) error {
+	parserBackup := s.toParserBackup(backup)
+	if err := parsers.ValidateExternalMemoryBackup(parserBackup); err != nil {
+		return fmt.Errorf("invalid external memory backup config: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	current, err := s.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current external memory backup config: %w", err)
+	}
+
+	var commands []string
+	// Drop the old scheduled copy when it moved to another ID or was removed
+	if current.ScheduleID > 0 && current.ScheduleID != backup.ScheduleID {
+		commands = append(commands, parsers.BuildDeleteScheduleCommand(current.ScheduleID))
+	}
+	if current.ConfigFilename != "" && backup.ConfigFilename == "" {
+		commands = append(commands, parsers.BuildDeleteExternalMemoryFilenameCommand())
+	}
+	commands = append(commands, parsers.BuildExternalMemoryBackupCommands(parserBackup)...)
+
+	logging.FromContext(ctx).Debug().Str("service", "external_memory").Msgf("Updating external memory backup with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to update external memory backup: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "external memory backup updated")
+}
+
+// Reset removes external memory backup configuration
+func (s *ExternalMemoryService) Reset(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	current, err := s.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	commands := parsers.BuildDeleteExternalMemoryBackupCommands(s.toParserBackup(*current))
+	logging.FromContext(ctx).Debug().Str("service", "external_memory").Msgf("Resetting external memory backup with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to reset external memory backup: %w", err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to reset external memory backup"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, "external memory backup reset")
+}
+
+// toParserBackup converts client.ExternalMemoryBackup to parsers.ExternalMemoryBackup
+func (s *ExternalMemoryService) toParserBackup(backup ExternalMemoryBackup) parsers.ExternalMemoryBackup {
+	return parsers.ExternalMemoryBackup{
+		AutoBackup:     backup.AutoBackup,
+		ConfigFilename: backup.ConfigFilename,
+		ScheduleID:     backup.ScheduleID,
+		ScheduleTime:   backup.ScheduleTime,
+		Destination:    backup.Destination,
+	}
+}
+
+// fromParserBackup converts parsers.ExternalMemoryBackup to client.ExternalMemoryBackup
+func (s *ExternalMemoryService) fromParserBackup(backup parsers.ExternalMemoryBackup) ExternalMemoryBackup {
+	return ExternalMemoryBackup{
+		AutoBackup:     backup.AutoBackup,
+		ConfigFilename: backup.ConfigFilename,
+		ScheduleID:     backup.ScheduleID,
+		ScheduleTime:   backup.ScheduleTime,
+		Destination:    backup.Destination,
+	}
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExternalMemoryService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "external-memory config"`).Return([]byte(
+		"external-memory config filename usb1:/config.txt\nexternal-memory config auto-backup on\n",
+	), nil)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "copy config"`).Return([]byte(
+		"schedule at 10 03:30 copy config 0 usb1:/backup.txt\n",
+	), nil)
+
+	service := NewExternalMemoryService(mockExecutor, nil)
+
+	backup, err := service.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &ExternalMemoryBackup{
+		AutoBackup:     true,
+		ConfigFilename: "usb1:/config.txt",
+		ScheduleID:     10,
+		ScheduleTime:   "03:30",
+		Destination:    "usb1:/backup.txt",
+	}, backup)
+}
+
+func TestExternalMemoryService_Update(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "external-memory config"`).Return([]byte(
+		"external-memory config filename usb1:/config.txt\nexternal-memory config auto-backup on\n",
+	), nil)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "copy config"`).Return([]byte(
+		"schedule at 10 03:30 copy config 0 usb1:/backup.txt\n",
+	), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no schedule at 10",
+		"no external-memory config filename",
+		"external-memory config auto-backup off",
+		"schedule at 11 04:00 copy config 0 sd1:/backup.txt",
+	}).Return([]byte(""), nil)
+
+	service := NewExternalMemoryService(mockExecutor, nil)
+
+	err := service.Update(context.Background(), ExternalMemoryBackup{
+		ScheduleID:   11,
+		ScheduleTime: "04:00",
+		Destination:  "sd1:/backup.txt",
+	})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// maxRevisionListSize bounds the size of a downloaded revision list
+const maxRevisionListSize = 4 << 20
+
+// FirmwareRevisionService compares the installed firmware revision with the
+// revisions published in a revision list
+type FirmwareRevisionService struct {
+	executor   Executor
+	client     *rtxClient   // Reference to the main client for save functionality
+	httpClient *http.Client // Client used to download the revision list
+}
+
+// NewFirmwareRevisionService creates a new firmware revision service instance
+func NewFirmwareRevisionService(executor Executor, client *rtxClient) *FirmwareRevisionService {
+	return &FirmwareRevisionService{
+		executor:   executor,
+		client:     client,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Get retrieves the installed revision and, when listURL is given, the
+// revisions of the same series published at listURL. The revision list is
+// downloaded by the provider, not by the router, because the router's
+// "http revision-up go" check is interactive and may install firmware.
+func (s *FirmwareRevisionService) Get(ctx context.Context, listURL string) (*FirmwareRevisions, error) {
+	logging.FromContext(ctx).Debug().Str("service", "firmware_revision").Msgf("Getting firmware revisions (list: %q)", listURL)
+
+	output, err := s.executor.Run(ctx, "show environment")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installed firmware revision: %w", err)
+	}
+	env := parsers.ParseEnvironment(string(output))
+	if env.FirmwareVersion == "" {
+		return nil, fmt.Errorf("could not determine installed firmware revision")
+	}
+
+	revisions := &FirmwareRevisions{
+		Model:              env.Model,
+		InstalledRevision:  env.FirmwareVersion,
+		AvailableRevisions: []string{},
+	}
+	if listURL == "" {
+		return revisions, nil
+	}
+
+	page, err := s.fetch(ctx, listURL)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions.AvailableRevisions = parsers.ParseFirmwareRevisions(page, env.FirmwareVersion)
+	if n := len(revisions.AvailableRevisions); n > 0 {
+		revisions.LatestRevision = revisions.AvailableRevisions[n-1]
+		revisions.UpdateAvailable = parsers.CompareFirmwareRevisions(revisions.LatestRevision, revisions.InstalledRevision) > 0
+	}
+	return revisions, nil
+}
+
+// fetch downloads the revision list
+func (s *FirmwareRevisionService) fetch(ctx context.Context, listURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, listURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("invalid revision list URL: %w", err)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to download revision list: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download revision list: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxRevisionListSize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read revision list: %w", err)
+	}
+	return string(body), nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFirmwareRevisionService_Get(t *testing.T) {
+	const environment = "RTX1210 Rev.14.01.38 (Fri Jan 15 15:45:26 2021)\n"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rtx1210.html" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("RTX1210 Rev.14.01.42\nRTX1210 Rev.14.01.41\nRTX830 Rev.15.02.30\n"))
+	}))
+	defer server.Close()
+
+	t.Run("installed only", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show environment").Return([]byte(environment), nil)
+
+		service := NewFirmwareRevisionService(mockExecutor, nil)
+
+		revisions, err := service.Get(context.Background(), "")
+		assert.NoError(t, err)
+		assert.Equal(t, &FirmwareRevisions{
+			Model:              "RTX1210",
+			InstalledRevision:  "14.01.38",
+			AvailableRevisions: []string{},
+		}, revisions)
+	})
+
+	t.Run("with revision list", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show environment").Return([]byte(environment), nil)
+
+		service := NewFirmwareRevisionService(mockExecutor, nil)
+
+		revisions, err := service.Get(context.Background(), server.URL+"/rtx1210.html")
+		assert.NoError(t, err)
+		assert.Equal(t, &FirmwareRevisions{
+			Model:              "RTX1210",
+			InstalledRevision:  "14.01.38",
+			AvailableRevisions: []string{"14.01.41", "14.01.42"},
+			LatestRevision:     "14.01.42",
+			UpdateAvailable:    true,
+		}, revisions)
+	})
+
+	t.Run("revision list not found", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show environment").Return([]byte(environment), nil)
+
+		service := NewFirmwareRevisionService(mockExecutor, nil)
+
+		_, err := service.Get(context.Background(), server.URL+"/missing.html")
+		assert.Error(t, err)
+	})
+}
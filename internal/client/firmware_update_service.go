@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// FirmwareUpdateService handles firmware update settings operations
+type FirmwareUpdateService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewFirmwareUpdateService creates a new firmware update service instance
+func NewFirmwareUpdateService(executor Executor, client *rtxClient) *FirmwareUpdateService {
+	return &FirmwareUpdateService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves firmware update configuration
+func (s *FirmwareUpdateService) Get(ctx context.Context) (*FirmwareUpdate, error) {
+	cmd := parsers.BuildShowFirmwareUpdateConfigCommand()
+	logging.FromContext(ctx).Debug().Str("service", "firmware_update").Msgf("Getting firmware update settings with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get firmware update config: %w", err)
+	}
+
+	parsed := parsers.ParseFirmwareUpdate(string(output))
+	if parsed == nil {
+		return nil, fmt.Errorf("firmware update configuration not found")
+	}
+
+	update := s.fromParserUpdate(*parsed)
+	return &update, nil
+}
+
+// Configure creates firmware update configuration
+func (s *FirmwareUpdateService) Configure(ctx context.Context, update FirmwareUpdate) error {
+	parserUpdate := s.toParserUpdate(update)
+	if err := parsers.ValidateFirmwareUpdate(parserUpdate); err != nil {
+		return fmt.Errorf("invalid firmware update config: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := parsers.BuildFirmwareUpdateCommands(parserUpdate)
+	logging.FromContext(ctx).Debug().Str("service", "firmware_update").Msgf("Configuring firmware update settings with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to configure firmware update settings: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "firmware update configured")
+}
+
+// Update updates firmware update configuration
+func (s *FirmwareUpdateService) Update(ctx context.Context, update FirmwareUpdate) error {
+	parserUpdate := s.toParserUpdate(update)
+	if err := parsers.ValidateFirmwareUpdate(parserUpdate); err != nil {
+		return fmt.Errorf("invalid firmware update config: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	current, err := s.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current firmware update config: %w", err)
+	}
+
+	var commands []string
+	// Drop the old scheduled update when it moved to another ID or was removed
+	if current.ScheduleID > 0 && current.ScheduleID != update.ScheduleID {
+		commands = append(commands, parsers.BuildDeleteScheduleCommand(current.ScheduleID))
+	}
+	if current.URL != "" && update.URL == "" {
+		commands = append(commands, parsers.BuildDeleteFirmwareURLCommand())
+	}
+	if current.Timeout > 0 && update.Timeout == 0 {
+		commands = append(commands, parsers.BuildDeleteFirmwareTimeoutCommand())
+	}
+	commands = append(commands, parsers.BuildFirmwareUpdateCommands(parserUpdate)...)
+
+	logging.FromContext(ctx).Debug().Str("service", "firmware_update").Msgf("Updating firmware update settings with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to update firmware update settings: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "firmware update settings updated")
+}
+
+// Reset removes firmware update configuration
+func (s *FirmwareUpdateService) Reset(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	current, err := s.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	commands := parsers.BuildDeleteFirmwareUpdateCommands(s.toParserUpdate(*current))
+	logging.FromContext(ctx).Debug().Str("service", "firmware_update").Msgf("Resetting firmware update settings with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to reset firmware update settings: %w", err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to reset firmware update settings"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, "firmware update settings reset")
+}
+
+// toParserUpdate converts client.FirmwareUpdate to parsers.FirmwareUpdate
+func (s *FirmwareUpdateService) toParserUpdate(update FirmwareUpdate) parsers.FirmwareUpdate {
+	return parsers.FirmwareUpdate{
+		URL:            update.URL,
+		AllowUpdate:    update.AllowUpdate,
+		AllowDowngrade: update.AllowDowngrade,
+		Timeout:        update.Timeout,
+		ScheduleID:     update.ScheduleID,
+		ScheduleTime:   update.ScheduleTime,
+	}
+}
+
+// fromParserUpdate converts parsers.FirmwareUpdate to client.FirmwareUpdate
+func (s *FirmwareUpdateService) fromParserUpdate(update parsers.FirmwareUpdate) FirmwareUpdate {
+	return FirmwareUpdate{
+		URL:            update.URL,
+		AllowUpdate:    update.AllowUpdate,
+		AllowDowngrade: update.AllowDowngrade,
+		Timeout:        update.Timeout,
+		ScheduleID:     update.ScheduleID,
+		ScheduleTime:   update.ScheduleTime,
+	}
+}
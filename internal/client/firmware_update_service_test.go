@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFirmwareUpdateService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "http revision-"`).Return([]byte(
+		"http revision-up permit on\nschedule at 20 04:15 http revision-up go no-confirm\n",
+	), nil).Once()
+
+	service := NewFirmwareUpdateService(mockExecutor, nil)
+
+	update, err := service.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &FirmwareUpdate{AllowUpdate: true, ScheduleID: 20, ScheduleTime: "04:15"}, update)
+
+	mockExecutor.On("Run", mock.Anything, `show config | grep "http revision-"`).Return([]byte(""), nil).Once()
+	_, err = service.Get(context.Background())
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestFirmwareUpdateService_Update(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "http revision-"`).Return([]byte(
+		"http revision-up url https://example.com/fw.bin\nhttp revision-up permit on\nschedule at 20 04:15 http revision-up go no-confirm\n",
+	), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no schedule at 20",
+		"no http revision-up url",
+		"http revision-up permit off",
+		"http revision-down permit off",
+	}).Return([]byte(""), nil)
+
+	service := NewFirmwareUpdateService(mockExecutor, nil)
+
+	err := service.Update(context.Background(), FirmwareUpdate{})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// FlowExportService handles flow export configuration operations
+type FlowExportService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewFlowExportService creates a new flow export service instance
+func NewFlowExportService(executor Executor, client *rtxClient) *FlowExportService {
+	return &FlowExportService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves flow export configuration
+func (s *FlowExportService) Get(ctx context.Context) (*FlowExportConfig, error) {
+	cmd := parsers.BuildShowFlowExportConfigCommand()
+	logging.FromContext(ctx).Debug().Str("service", "flow_export").Msgf("Getting flow export config with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flow export config: %w", err)
+	}
+
+	parsed := parsers.ParseFlowExportConfig(string(output))
+	if parsed == nil {
+		return nil, fmt.Errorf("flow export configuration not found")
+	}
+
+	config := s.fromParserConfig(*parsed)
+	return &config, nil
+}
+
+// Configure creates flow export configuration
+func (s *FlowExportService) Configure(ctx context.Context, config FlowExportConfig) error {
+	parserConfig := s.toParserConfig(config)
+	if err := parsers.ValidateFlowExportConfig(parserConfig); err != nil {
+		return fmt.Errorf("invalid flow export config: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := parsers.BuildFlowExportCommands(parserConfig)
+	logging.FromContext(ctx).Debug().Str("service", "flow_export").Msgf("Configuring flow export with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to configure flow export: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "flow export configured")
+}
+
+// Update updates flow export configuration
+func (s *FlowExportService) Update(ctx context.Context, config FlowExportConfig) error {
+	parserConfig := s.toParserConfig(config)
+	if err := parsers.ValidateFlowExportConfig(parserConfig); err != nil {
+		return fmt.Errorf("invalid flow export config: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	current, err := s.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current flow export config: %w", err)
+	}
+
+	commands := buildFlowExportUpdateCommands(s.toParserConfig(*current), parserConfig)
+	logging.FromContext(ctx).Debug().Str("service", "flow_export").Msgf("Updating flow export with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to update flow export: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "flow export updated")
+}
+
+// Reset removes flow export configuration
+func (s *FlowExportService) Reset(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	current, err := s.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	commands := parsers.BuildDeleteFlowExportCommands(s.toParserConfig(*current))
+	logging.FromContext(ctx).Debug().Str("service", "flow_export").Msgf("Resetting flow export with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to reset flow export: %w", err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to reset flow export"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, "flow export reset")
+}
+
+// buildFlowExportUpdateCommands builds the commands that move the router from
+// the current flow export configuration to the desired one
+func buildFlowExportUpdateCommands(current, desired parsers.FlowExportConfig) []string {
+	var commands []string
+
+	for _, iface := range current.Interfaces {
+		if !slices.Contains(desired.Interfaces, iface) {
+			commands = append(commands, parsers.BuildDeleteFlowExportInterfaceCommand(iface))
+		}
+	}
+
+	if current.CollectorAddress != desired.CollectorAddress || current.CollectorPort != desired.CollectorPort {
+		commands = append(commands, parsers.BuildFlowExportDestinationCommand(desired.CollectorAddress, desired.CollectorPort))
+	}
+
+	if current.SamplingRate != desired.SamplingRate {
+		if desired.SamplingRate > parsers.DefaultFlowExportSamplingRate {
+			commands = append(commands, parsers.BuildFlowExportSamplingRateCommand(desired.SamplingRate))
+		} else {
+			commands = append(commands, parsers.BuildDeleteFlowExportSamplingRateCommand())
+		}
+	}
+
+	for _, iface := range desired.Interfaces {
+		if !slices.Contains(current.Interfaces, iface) {
+			commands = append(commands, parsers.BuildFlowExportInterfaceCommand(iface, true))
+		}
+	}
+
+	return commands
+}
+
+// toParserConfig converts client.FlowExportConfig to parsers.FlowExportConfig
+func (s *FlowExportService) toParserConfig(config FlowExportConfig) parsers.FlowExportConfig {
+	return parsers.FlowExportConfig{
+		CollectorAddress: config.CollectorAddress,
+		CollectorPort:    config.CollectorPort,
+		SamplingRate:     config.SamplingRate,
+		Interfaces:       config.Interfaces,
+	}
+}
+
+// fromParserConfig converts parsers.FlowExportConfig to client.FlowExportConfig
+func (s *FlowExportService) fromParserConfig(config parsers.FlowExportConfig) FlowExportConfig {
+	return FlowExportConfig{
+		CollectorAddress: config.CollectorAddress,
+		CollectorPort:    config.CollectorPort,
+		SamplingRate:     config.SamplingRate,
+		Interfaces:       config.Interfaces,
+	}
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+func TestFlowExportService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, parsers.BuildShowFlowExportConfigCommand()).Return([]byte(
+		"ip flow export destination 192.0.2.10\nip lan1 flow export on\n",
+	), nil).Once()
+
+	service := NewFlowExportService(mockExecutor, nil)
+
+	config, err := service.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &FlowExportConfig{
+		CollectorAddress: "192.0.2.10",
+		CollectorPort:    2055,
+		SamplingRate:     1,
+		Interfaces:       []string{"lan1"},
+	}, config)
+
+	mockExecutor.On("Run", mock.Anything, parsers.BuildShowFlowExportConfigCommand()).Return([]byte(""), nil).Once()
+	_, err = service.Get(context.Background())
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestBuildFlowExportUpdateCommands(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  parsers.FlowExportConfig
+		desired  parsers.FlowExportConfig
+		expected []string
+	}{
+		{
+			name: "no changes",
+			current: parsers.FlowExportConfig{
+				CollectorAddress: "192.0.2.10", CollectorPort: 2055, SamplingRate: 1, Interfaces: []string{"lan1"},
+			},
+			desired: parsers.FlowExportConfig{
+				CollectorAddress: "192.0.2.10", CollectorPort: 2055, SamplingRate: 1, Interfaces: []string{"lan1"},
+			},
+			expected: nil,
+		},
+		{
+			name: "change collector, sampling and interfaces",
+			current: parsers.FlowExportConfig{
+				CollectorAddress: "192.0.2.10", CollectorPort: 2055, SamplingRate: 100, Interfaces: []string{"lan1"},
+			},
+			desired: parsers.FlowExportConfig{
+				CollectorAddress: "192.0.2.20", CollectorPort: 9995, SamplingRate: 1, Interfaces: []string{"pp1"},
+			},
+			expected: []string{
+				"no ip lan1 flow export",
+				"ip flow export destination 192.0.2.20 9995",
+				"no ip flow export sampling-rate",
+				"ip pp1 flow export on",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, buildFlowExportUpdateCommands(tt.current, tt.desired))
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+// HTTPAPIConfig configures the command endpoint of the router's web API,
+// which newer firmware provides alongside the CLI. Status reads go through
+// it instead of an SSH console session when it is available.
+type HTTPAPIConfig struct {
+	URL                string // Command endpoint (e.g., "https://192.168.1.1/api/command")
+	InsecureSkipVerify bool   // Accept any TLS certificate (for the router's self-signed certificate)
+}
+
+// maxHTTPAPIResponseSize bounds the output read from one API request
+const maxHTTPAPIResponseSize = 16 << 20
+
+// httpAPICommandPrefixes are the status reads sent through the web API. They
+// are read at user level and their output does not depend on the session, so
+// the API returns the same text as the console.
+var httpAPICommandPrefixes = []string{
+	"show status ",
+	"show interface",
+	"show environment",
+	"show arp",
+	"show ip route",
+	"show ipv6 neighbor",
+	"show ipv6 route",
+	"show ipsec sa",
+	"show nat descriptor address",
+	"show log",
+}
+
+// errHTTPAPIUnavailable reports that the router does not provide the web API
+// or does not accept the credentials, so later reads go to the CLI directly
+var errHTTPAPIUnavailable = errors.New("HTTP API unavailable")
+
+// isHTTPAPICommand reports whether cmd is a status read sent through the web API
+func isHTTPAPICommand(cmd string) bool {
+	cmdLower := strings.ToLower(strings.TrimSpace(cmd))
+	for _, prefix := range httpAPICommandPrefixes {
+		if strings.HasPrefix(cmdLower, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// httpAPIExecutor sends status reads to the router's web API and everything
+// else, as well as any read the API cannot serve, to the CLI executor.
+type httpAPIExecutor struct {
+	inner      Executor
+	endpoint   string
+	username   string
+	password   string
+	encoding   ConsoleEncoding
+	httpClient *http.Client
+
+	mu          sync.Mutex
+	unavailable bool // Set once the router has shown that it has no usable API
+}
+
+// newHTTPAPIExecutor wraps the CLI executor with the web API transport of
+// config. Without an API URL, inner is returned unchanged.
+func newHTTPAPIExecutor(inner Executor, config *Config) Executor {
+	api := config.HTTPAPI
+	if api == nil || api.URL == "" {
+		return inner
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if api.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // Opt-in for self-signed router certificates
+	}
+	return &httpAPIExecutor{
+		inner:    inner,
+		endpoint: api.URL,
+		username: config.Username,
+		password: config.Password,
+		encoding: config.ConsoleEncoding,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   config.Timeouts.withDefaults().Command,
+		},
+	}
+}
+
+// Run sends status reads to the web API, falling back to the CLI when the
+// API fails, and runs all other commands on the CLI
+func (e *httpAPIExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
+	if !isHTTPAPICommand(cmd) || !e.available() {
+		return e.inner.Run(ctx, cmd)
+	}
+
+	output, err := e.post(ctx, cmd)
+	if err == nil {
+		return output, nil
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	logger := logging.FromContext(ctx).Warn().Err(err).Str("command", logging.RedactCommand(cmd))
+	if errors.Is(err, errHTTPAPIUnavailable) {
+		e.mu.Lock()
+		e.unavailable = true
+		e.mu.Unlock()
+		logger.Msg("HTTP API is not usable, using the CLI for all commands")
+	} else {
+		logger.Msg("HTTP API request failed, falling back to the CLI")
+	}
+	return e.inner.Run(ctx, cmd)
+}
+
+// RunBatch runs the batch on the CLI, where the commands share one session
+func (e *httpAPIExecutor) RunBatch(ctx context.Context, cmds []string) ([]byte, error) {
+	return e.inner.RunBatch(ctx, cmds)
+}
+
+// SetAdministratorPassword sets the administrator password on the CLI
+func (e *httpAPIExecutor) SetAdministratorPassword(ctx context.Context, oldPassword, newPassword string) error {
+	return e.inner.SetAdministratorPassword(ctx, oldPassword, newPassword)
+}
+
+// SetLoginPassword sets the login password on the CLI
+func (e *httpAPIExecutor) SetLoginPassword(ctx context.Context, newPassword string) error {
+	return e.inner.SetLoginPassword(ctx, newPassword)
+}
+
+// GenerateSSHDHostKey generates the SSHD host key on the CLI
+func (e *httpAPIExecutor) GenerateSSHDHostKey(ctx context.Context) error {
+	return e.inner.GenerateSSHDHostKey(ctx)
+}
+
+// available reports whether the web API is still worth trying
+func (e *httpAPIExecutor) available() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return !e.unavailable
+}
+
+// post runs cmd through the command endpoint. The command is sent as the
+// request body and the console output comes back as the response body.
+func (e *httpAPIExecutor) post(ctx context.Context, cmd string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, strings.NewReader(cmd))
+	if err != nil {
+		return nil, fmt.Errorf("invalid HTTP API URL: %w", err)
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	req.SetBasicAuth(e.username, e.password)
+
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return nil, fmt.Errorf("%w: router returned %s", errHTTPAPIUnavailable, resp.Status)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, fmt.Errorf("%w: credentials rejected (%s)", errHTTPAPIUnavailable, resp.Status)
+	default:
+		return nil, fmt.Errorf("HTTP API returned %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPAPIResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read HTTP API response: %w", err)
+	}
+	output := DecodeConsoleOutput(bytes.ReplaceAll(body, []byte("\r\n"), []byte("\n")), e.encoding)
+	if IsAdminRequiredOutput(output) {
+		return nil, fmt.Errorf("HTTP API session is not allowed to run %q", logging.RedactCommand(cmd))
+	}
+	return output, nil
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newHTTPAPITestExecutor returns an executor whose web API is served by handler
+func newHTTPAPITestExecutor(t *testing.T, inner Executor, handler http.HandlerFunc) Executor {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return newHTTPAPIExecutor(inner, &Config{
+		Username: "admin",
+		Password: "secret",
+		HTTPAPI:  &HTTPAPIConfig{URL: server.URL + "/api/command"},
+	})
+}
+
+func TestHTTPAPIExecutor_StatusReads(t *testing.T) {
+	inner := new(MockExecutor)
+	inner.On("Run", mock.Anything, "ip route default gateway pp 1").Return([]byte(""), nil)
+
+	executor := newHTTPAPITestExecutor(t, inner, func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", user)
+		assert.Equal(t, "secret", password)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "show status dhcp", string(body))
+		_, _ = io.WriteString(w, "DHCP Scope number: 1\r\nLeased address: 192.168.1.2\r\n")
+	})
+
+	output, err := executor.Run(context.Background(), "show status dhcp")
+	require.NoError(t, err)
+	assert.Equal(t, "DHCP Scope number: 1\nLeased address: 192.168.1.2\n", string(output))
+
+	// Configuration commands always go to the CLI
+	_, err = executor.Run(context.Background(), "ip route default gateway pp 1")
+	require.NoError(t, err)
+	inner.AssertExpectations(t)
+}
+
+func TestHTTPAPIExecutor_Fallback(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		body         string
+		wantAPIRetry bool
+	}{
+		{name: "no API", status: http.StatusNotFound, wantAPIRetry: false},
+		{name: "credentials rejected", status: http.StatusUnauthorized, wantAPIRetry: false},
+		{name: "server error", status: http.StatusInternalServerError, wantAPIRetry: true},
+		{name: "administrator level required", status: http.StatusOK, body: "Error: This command can be used at administrator level only\n", wantAPIRetry: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := new(MockExecutor)
+			inner.On("Run", mock.Anything, "show interface lan1").Return([]byte("LAN1 up\n"), nil)
+
+			var requests atomic.Int32
+			executor := newHTTPAPITestExecutor(t, inner, func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.WriteHeader(tt.status)
+				_, _ = io.WriteString(w, tt.body)
+			})
+
+			for i := 0; i < 2; i++ {
+				output, err := executor.Run(context.Background(), "show interface lan1")
+				require.NoError(t, err)
+				assert.Equal(t, "LAN1 up\n", string(output))
+			}
+
+			wantRequests := int32(1)
+			if tt.wantAPIRetry {
+				wantRequests = 2
+			}
+			assert.Equal(t, wantRequests, requests.Load())
+			inner.AssertNumberOfCalls(t, "Run", 2)
+		})
+	}
+}
+
+func TestNewHTTPAPIExecutor_Disabled(t *testing.T) {
+	inner := new(MockExecutor)
+	assert.Same(t, Executor(inner), newHTTPAPIExecutor(inner, &Config{}))
+}
+
+func TestValidateConfig_HTTPAPI(t *testing.T) {
+	config := &Config{Host: "192.168.1.1", Port: 22, Username: "admin", Password: "secret"}
+
+	config.HTTPAPI = &HTTPAPIConfig{URL: "https://192.168.1.1/api/command"}
+	assert.NoError(t, validateConfig(config))
+
+	config.HTTPAPI = &HTTPAPIConfig{URL: "192.168.1.1/api/command"}
+	assert.Error(t, validateConfig(config))
+}
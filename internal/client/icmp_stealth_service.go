@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// ICMPStealthService handles ICMP hardening operations ("ip stealth" and
+// "ip icmp ... send")
+type ICMPStealthService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewICMPStealthService creates a new ICMP settings service instance
+func NewICMPStealthService(executor Executor, client *rtxClient) *ICMPStealthService {
+	return &ICMPStealthService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the ICMP settings
+func (s *ICMPStealthService) Get(ctx context.Context) (*ICMPStealthConfig, error) {
+	cmd := parsers.BuildShowICMPStealthConfigCommand()
+	logging.FromContext(ctx).Debug().Str("service", "icmp_stealth").Msgf("Getting ICMP settings with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ICMP settings: %w", err)
+	}
+
+	config := ICMPStealthConfig(*parsers.ParseICMPStealthConfig(string(output)))
+	return &config, nil
+}
+
+// Configure applies the ICMP settings
+func (s *ICMPStealthService) Configure(ctx context.Context, config ICMPStealthConfig) error {
+	parserConfig := parsers.ICMPStealthConfig(config)
+	if err := parsers.ValidateICMPStealthConfig(parserConfig); err != nil {
+		return fmt.Errorf("invalid ICMP settings: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := parsers.BuildICMPStealthCommands(parserConfig)
+	logging.FromContext(ctx).Debug().Str("service", "icmp_stealth").Msgf("Configuring ICMP settings with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to configure ICMP settings: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "ICMP settings configured")
+}
+
+// Update applies the ICMP settings
+func (s *ICMPStealthService) Update(ctx context.Context, config ICMPStealthConfig) error {
+	return s.Configure(ctx, config)
+}
+
+// Reset restores the default ICMP settings
+func (s *ICMPStealthService) Reset(ctx context.Context) error {
+	commands := parsers.BuildDeleteICMPStealthCommands()
+	logging.FromContext(ctx).Debug().Str("service", "icmp_stealth").Msgf("Resetting ICMP settings with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to reset ICMP settings: %w", err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to reset ICMP settings"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, "ICMP settings reset")
+}
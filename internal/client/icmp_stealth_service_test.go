@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestICMPStealthService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte("ip lan1 address 192.168.1.1/24\nip stealth pp1\nip icmp redirect send off\n"), nil)
+
+	service := NewICMPStealthService(mockExecutor, nil)
+
+	config, err := service.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &ICMPStealthConfig{
+		StealthInterfaces: []string{"pp1"},
+		EchoReply:         true,
+		Unreachable:       true,
+	}, config)
+}
+
+func TestICMPStealthService_Configure(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("RunBatch", mock.Anything, []string{
+			"ip stealth all",
+			"ip icmp echo-reply send on",
+			"ip icmp echo-reply send-only-linkup on",
+			"ip icmp unreachable send off",
+			"ip icmp redirect send off",
+		}).Return([]byte(""), nil)
+
+		service := NewICMPStealthService(mockExecutor, nil)
+		err := service.Configure(context.Background(), ICMPStealthConfig{
+			StealthInterfaces: []string{"all"}, EchoReply: true, EchoReplyOnlyLinkUp: true,
+		})
+		assert.NoError(t, err)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		service := NewICMPStealthService(new(MockExecutor), nil)
+		err := service.Configure(context.Background(), ICMPStealthConfig{StealthInterfaces: []string{"all", "pp1"}})
+		assert.ErrorContains(t, err, "invalid ICMP settings")
+	})
+}
+
+func TestICMPStealthService_Reset(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no ip stealth",
+		"no ip icmp echo-reply send",
+		"no ip icmp echo-reply send-only-linkup",
+		"no ip icmp unreachable send",
+		"no ip icmp redirect send",
+	}).Return([]byte(""), nil)
+
+	service := NewICMPStealthService(mockExecutor, nil)
+
+	err := service.Reset(context.Background())
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
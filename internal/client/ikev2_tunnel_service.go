@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// IKEv2TunnelService handles IPsec tunnels negotiated with IKEv2
+type IKEv2TunnelService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewIKEv2TunnelService creates a new IKEv2 tunnel service instance
+func NewIKEv2TunnelService(executor Executor, client *rtxClient) *IKEv2TunnelService {
+	return &IKEv2TunnelService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves an IKEv2 tunnel
+func (s *IKEv2TunnelService) Get(ctx context.Context, tunnelID int) (*IKEv2Tunnel, error) {
+	tunnels, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tunnel := range tunnels {
+		if tunnel.ID == tunnelID {
+			return &tunnel, nil
+		}
+	}
+
+	return nil, fmt.Errorf("IKEv2 tunnel %d not found", tunnelID)
+}
+
+// List retrieves all IKEv2 tunnels
+func (s *IKEv2TunnelService) List(ctx context.Context) ([]IKEv2Tunnel, error) {
+	cmd := parsers.BuildShowIKEv2TunnelsCommand()
+	logging.FromContext(ctx).Debug().Str("service", "ikev2_tunnel").Msgf("Listing IKEv2 tunnels with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IKEv2 tunnels: %w", err)
+	}
+
+	parsed := parsers.ParseIKEv2Tunnels(string(output))
+	tunnels := make([]IKEv2Tunnel, len(parsed))
+	for i, p := range parsed {
+		tunnels[i] = s.fromParserTunnel(p)
+	}
+	return tunnels, nil
+}
+
+// Create creates a new IKEv2 tunnel
+func (s *IKEv2TunnelService) Create(ctx context.Context, tunnel IKEv2Tunnel) error {
+	parserTunnel := s.toParserTunnel(tunnel)
+	if err := parsers.ValidateIKEv2Tunnel(parserTunnel); err != nil {
+		return fmt.Errorf("invalid IKEv2 tunnel: %w", err)
+	}
+
+	return s.apply(ctx, parsers.BuildIKEv2TunnelCommands(parserTunnel), tunnel.ID, "created")
+}
+
+// Update updates an existing IKEv2 tunnel. Optional gateway settings that are
+// no longer configured are removed before the new configuration is applied.
+func (s *IKEv2TunnelService) Update(ctx context.Context, tunnel IKEv2Tunnel) error {
+	parserTunnel := s.toParserTunnel(tunnel)
+	if err := parsers.ValidateIKEv2Tunnel(parserTunnel); err != nil {
+		return fmt.Errorf("invalid IKEv2 tunnel: %w", err)
+	}
+
+	current, err := s.Get(ctx, tunnel.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get current IKEv2 tunnel: %w", err)
+	}
+
+	commands := parsers.BuildIKEv2TunnelCleanupCommands(s.toParserTunnel(*current), parserTunnel)
+	commands = append(commands, parsers.BuildIKEv2TunnelCommands(parserTunnel)...)
+	return s.apply(ctx, commands, tunnel.ID, "updated")
+}
+
+// apply runs the tunnel commands and saves the configuration
+func (s *IKEv2TunnelService) apply(ctx context.Context, commands []string, tunnelID int, action string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	// The commands carry the pre-shared key and EAP password, so only the target is logged
+	logging.FromContext(ctx).Debug().Str("service", "ikev2_tunnel").Msgf("Applying IKEv2 tunnel %d (%d commands)", tunnelID, len(commands))
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to apply IKEv2 tunnel %d: %w", tunnelID, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("IKEv2 tunnel %d %s", tunnelID, action))
+}
+
+// Delete removes an IKEv2 tunnel and its IKE gateway settings
+func (s *IKEv2TunnelService) Delete(ctx context.Context, tunnelID int) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := parsers.BuildDeleteIKEv2TunnelCommands(tunnelID)
+	logging.FromContext(ctx).Debug().Str("service", "ikev2_tunnel").Msgf("Deleting IKEv2 tunnel with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to delete IKEv2 tunnel %d: %w", tunnelID, err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete IKEv2 tunnel"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("IKEv2 tunnel %d deleted", tunnelID))
+}
+
+// toParserTunnel converts client.IKEv2Tunnel to parsers.IKEv2Tunnel
+func (s *IKEv2TunnelService) toParserTunnel(t IKEv2Tunnel) parsers.IKEv2Tunnel {
+	return parsers.IKEv2Tunnel(t)
+}
+
+// fromParserTunnel converts parsers.IKEv2Tunnel to client.IKEv2Tunnel
+func (s *IKEv2TunnelService) fromParserTunnel(t parsers.IKEv2Tunnel) IKEv2Tunnel {
+	return IKEv2Tunnel(t)
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testIKEv2TunnelConfig = `tunnel select 1
+ ipsec tunnel 1
+  ipsec sa policy 1 1 esp aes-cbc-256 sha256-hmac
+  ipsec ike version 1 2
+  ipsec ike local address 1 192.168.1.1
+  ipsec ike remote address 1 192.168.2.1
+  ipsec ike local auth method 1 psk
+  ipsec ike remote auth method 1 psk
+  ipsec ike pre-shared-key 1 text secret
+  ipsec ike encryption 1 aes-cbc-256
+ tunnel enable 1
+tunnel select 2
+ ipsec tunnel 2
+  ipsec ike remote address 2 192.168.3.1
+tunnel select none
+`
+
+func testIKEv2Tunnel() IKEv2Tunnel {
+	return IKEv2Tunnel{
+		ID:               1,
+		LocalAddress:     "192.168.1.1",
+		RemoteAddress:    "192.168.2.1",
+		LocalAuthMethod:  "psk",
+		RemoteAuthMethod: "psk",
+		PreSharedKey:     "secret",
+		Encryption:       []string{"aes-cbc-256"},
+		ESPEncryption:    "aes-cbc-256",
+		ESPIntegrity:     "sha256-hmac",
+		IKESALifetime:    28800,
+		ChildSALifetime:  28800,
+		Enabled:          true,
+	}
+}
+
+func TestIKEv2TunnelService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(testIKEv2TunnelConfig), nil)
+
+	service := NewIKEv2TunnelService(mockExecutor, nil)
+
+	tunnel, err := service.Get(context.Background(), 1)
+	assert.NoError(t, err)
+	want := testIKEv2Tunnel()
+	assert.Equal(t, &want, tunnel)
+
+	// Tunnel 2 is not an IKEv2 tunnel
+	_, err = service.Get(context.Background(), 2)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestIKEv2TunnelService_Create_Invalid(t *testing.T) {
+	service := NewIKEv2TunnelService(new(MockExecutor), nil)
+
+	tunnel := testIKEv2Tunnel()
+	tunnel.PreSharedKey = ""
+	err := service.Create(context.Background(), tunnel)
+	assert.ErrorContains(t, err, "pre-shared key is required")
+}
+
+func TestIKEv2TunnelService_Update(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(testIKEv2TunnelConfig), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no ipsec ike local address 1",
+		"no ipsec ike encryption 1",
+		"tunnel select 1",
+		"ipsec tunnel 1",
+		"ipsec sa policy 1 1 esp aes-cbc-256 sha256-hmac",
+		"ipsec ike version 1 2",
+		"ipsec ike remote address 1 192.168.2.1",
+		"ipsec ike local auth method 1 psk",
+		"ipsec ike remote auth method 1 psk",
+		"ipsec ike pre-shared-key 1 text secret",
+		"ipsec ike duration ike-sa 1 28800",
+		"ipsec ike duration child-sa 1 28800",
+		"ipsec ike nat-traversal 1 off",
+		"ipsec ike keepalive use 1 off",
+		"tunnel disable 1",
+	}).Return([]byte(""), nil)
+
+	service := NewIKEv2TunnelService(mockExecutor, nil)
+
+	tunnel := testIKEv2Tunnel()
+	tunnel.LocalAddress = ""
+	tunnel.Encryption = nil
+	tunnel.Enabled = false
+	err := service.Update(context.Background(), tunnel)
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestIKEv2TunnelService_Delete(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("RunBatch", mock.Anything, mock.MatchedBy(func(cmds []string) bool {
+		return len(cmds) > 3 &&
+			cmds[0] == "tunnel select 1" &&
+			cmds[1] == "no ipsec tunnel 1" &&
+			cmds[len(cmds)-1] == "no ipsec sa policy 1"
+	})).Return([]byte("Error: not found\n"), nil)
+
+	service := NewIKEv2TunnelService(mockExecutor, nil)
+
+	err := service.Delete(context.Background(), 1)
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// InterfaceCountersService handles LAN interface traffic counter queries ("show status lanN")
+type InterfaceCountersService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewInterfaceCountersService creates a new interface counters service instance
+func NewInterfaceCountersService(executor Executor, client *rtxClient) *InterfaceCountersService {
+	return &InterfaceCountersService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the traffic counters of a LAN interface
+func (s *InterfaceCountersService) Get(ctx context.Context, name string) (*InterfaceCounters, error) {
+	if err := parsers.ValidateInterfaceStatusName(name); err != nil {
+		return nil, err
+	}
+
+	cmd := parsers.BuildShowInterfaceStatusCommand(name)
+	logging.FromContext(ctx).Debug().Str("service", "interface_counters").Msgf("Getting interface counters with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get counters of %s: %w", name, err)
+	}
+
+	// containsError is not used here: counter labels such as "Received buffer
+	// error:" would match it. Unknown interfaces have no "LANn" header instead.
+	parsed, err := parsers.ParseInterfaceCounters(name, string(output))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	counters := InterfaceCounters(*parsed)
+	return &counters, nil
+}
+
+// List retrieves the traffic counters of the given LAN interfaces. When no
+// names are given, lan1, lan2, ... are read until the router rejects an interface.
+func (s *InterfaceCountersService) List(ctx context.Context, names []string) ([]InterfaceCounters, error) {
+	limit := s.client.readConcurrency()
+
+	if len(names) > 0 {
+		results, errs := readConcurrently(ctx, limit, names, s.Get)
+		for _, err := range errs {
+			if err != nil {
+				return nil, err
+			}
+		}
+		return derefAll(results), nil
+	}
+
+	// Read the interfaces in groups of limit, stopping at the first one the
+	// router rejects
+	var counters []InterfaceCounters
+	for first := 1; first <= maxLANInterfaces; first += limit {
+		var group []string
+		for i := first; i < first+limit && i <= maxLANInterfaces; i++ {
+			group = append(group, fmt.Sprintf("lan%d", i))
+		}
+
+		results, errs := readConcurrently(ctx, limit, group, s.Get)
+		for i, err := range errs {
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				logging.FromContext(ctx).Debug().Str("service", "interface_counters").Msgf("Stopping interface discovery at %s: %v", group[i], err)
+				return counters, nil
+			}
+			counters = append(counters, *results[i])
+		}
+	}
+
+	return counters, nil
+}
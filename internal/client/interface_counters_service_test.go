@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInterfaceCountersService_List(t *testing.T) {
+	t.Run("discover", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status lan1").Return([]byte(`LAN1
+Transmitted:                    100 packets (12800 octets)
+Received:                       200 packets (25600 octets)
+Received overrun:               0 packets
+Received buffer error:          1 packets
+`), nil)
+		mockExecutor.On("Run", mock.Anything, "show status lan2").Return([]byte("Error: Invalid interface name\n"), nil)
+
+		service := NewInterfaceCountersService(mockExecutor, nil)
+
+		counters, err := service.List(context.Background(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []InterfaceCounters{
+			{Name: "lan1", TxPackets: 100, TxOctets: 12800, RxPackets: 200, RxOctets: 25600, RxBufferErrors: 1},
+		}, counters)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		service := NewInterfaceCountersService(new(MockExecutor), nil)
+
+		_, err := service.List(context.Background(), []string{"pp1"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// maxLANInterfaces bounds the probing of LAN interfaces when no names are given
+const maxLANInterfaces = 16
+
+// InterfaceStatusService handles LAN interface status queries ("show status lanN")
+type InterfaceStatusService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewInterfaceStatusService creates a new interface status service instance
+func NewInterfaceStatusService(executor Executor, client *rtxClient) *InterfaceStatusService {
+	return &InterfaceStatusService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the status of a LAN interface
+func (s *InterfaceStatusService) Get(ctx context.Context, name string) (*InterfaceStatus, error) {
+	if err := parsers.ValidateInterfaceStatusName(name); err != nil {
+		return nil, err
+	}
+
+	cmd := parsers.BuildShowInterfaceStatusCommand(name)
+	logging.FromContext(ctx).Debug().Str("service", "interface_status").Msgf("Getting interface status with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status of %s: %w", name, err)
+	}
+
+	// containsError is not used here: counter labels such as "Received buffer
+	// error:" would match it. Unknown interfaces have no "LANn" header instead.
+	parsed, err := parsers.ParseInterfaceStatus(name, string(output))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	status := InterfaceStatus(*parsed)
+	return &status, nil
+}
+
+// List retrieves the status of the given LAN interfaces. When no names are
+// given, lan1, lan2, ... are read until the router rejects an interface.
+func (s *InterfaceStatusService) List(ctx context.Context, names []string) ([]InterfaceStatus, error) {
+	limit := s.client.readConcurrency()
+
+	if len(names) > 0 {
+		results, errs := readConcurrently(ctx, limit, names, s.Get)
+		for _, err := range errs {
+			if err != nil {
+				return nil, err
+			}
+		}
+		return derefAll(results), nil
+	}
+
+	// Read the interfaces in groups of limit, stopping at the first one the
+	// router rejects
+	var statuses []InterfaceStatus
+	for first := 1; first <= maxLANInterfaces; first += limit {
+		var group []string
+		for i := first; i < first+limit && i <= maxLANInterfaces; i++ {
+			group = append(group, fmt.Sprintf("lan%d", i))
+		}
+
+		results, errs := readConcurrently(ctx, limit, group, s.Get)
+		for i, err := range errs {
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				logging.FromContext(ctx).Debug().Str("service", "interface_status").Msgf("Stopping interface discovery at %s: %v", group[i], err)
+				return statuses, nil
+			}
+			statuses = append(statuses, *results[i])
+		}
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testLAN1Status = `LAN1
+Description:
+IP Address:                     192.168.100.1/24
+Ethernet address:               00:a0:de:01:02:03
+Operation mode setting:         Auto Negotiation (1000BASE-T Full Duplex)
+Maximum Transmission Unit(MTU): 1500 octets
+Transmitted:                    2305434 packets (1170577446 octets)
+Received:                       3052934 packets (2953183937 octets)
+Received overrun:               0 packets
+Received buffer error:          0 packets
+`
+
+const testLAN2Status = `LAN2
+Description:
+IP Address:
+Ethernet address:               00:a0:de:01:02:04
+Operation mode setting:         Auto Negotiation (Link Down)
+Maximum Transmission Unit(MTU): 1500 octets
+`
+
+func TestInterfaceStatusService_List(t *testing.T) {
+	t.Run("discover", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status lan1").Return([]byte(testLAN1Status), nil)
+		mockExecutor.On("Run", mock.Anything, "show status lan2").Return([]byte(testLAN2Status), nil)
+		mockExecutor.On("Run", mock.Anything, "show status lan3").Return([]byte("Error: Invalid interface name\n"), nil)
+
+		service := NewInterfaceStatusService(mockExecutor, nil)
+
+		statuses, err := service.List(context.Background(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []InterfaceStatus{
+			{
+				Name:        "lan1",
+				MACAddress:  "00:a0:de:01:02:03",
+				LinkUp:      true,
+				Speed:       "1000BASE-T",
+				Duplex:      "full",
+				IPAddresses: []string{"192.168.100.1/24"},
+				MTU:         1500,
+			},
+			{
+				Name:        "lan2",
+				MACAddress:  "00:a0:de:01:02:04",
+				IPAddresses: []string{},
+				MTU:         1500,
+			},
+		}, statuses)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("discover over pooled connections", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status lan1").Return([]byte(testLAN1Status), nil)
+		mockExecutor.On("Run", mock.Anything, "show status lan2").Return([]byte(testLAN2Status), nil)
+		// Both interfaces of the second group are read, discovery stops at lan3
+		mockExecutor.On("Run", mock.Anything, "show status lan3").Return([]byte("Error: Invalid interface name\n"), nil)
+		mockExecutor.On("Run", mock.Anything, "show status lan4").Return([]byte("Error: Invalid interface name\n"), nil)
+
+		c := &rtxClient{sshConnectionPool: &SSHConnectionPool{config: SSHPoolConfig{MaxSessions: 2}}}
+		service := NewInterfaceStatusService(mockExecutor, c)
+
+		statuses, err := service.List(context.Background(), nil)
+		assert.NoError(t, err)
+		assert.Len(t, statuses, 2)
+		assert.Equal(t, "lan1", statuses[0].Name)
+		assert.Equal(t, "lan2", statuses[1].Name)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("named interface not found", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status lan5").Return([]byte("Error: Invalid interface name\n"), nil)
+
+		service := NewInterfaceStatusService(mockExecutor, nil)
+
+		_, err := service.List(context.Background(), []string{"lan5"})
+		assert.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		service := NewInterfaceStatusService(new(MockExecutor), nil)
+
+		_, err := service.List(context.Background(), []string{"pp1"})
+		assert.ErrorContains(t, err, "LAN interface")
+	})
+}
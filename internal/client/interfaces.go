@@ -21,6 +21,9 @@ type Client interface {
 	// GetSystemInfo retrieves system information from the router
 	GetSystemInfo(ctx context.Context) (*SystemInfo, error)
 
+	// DetectModel returns the router model and firmware revision, detected once per client
+	DetectModel(ctx context.Context) (*SystemInfo, error)
+
 	// GetInterfaces retrieves interface information from the router
 	GetInterfaces(ctx context.Context) ([]Interface, error)
 
@@ -126,6 +129,17 @@ type Client interface {
 	// SaveConfig saves the current configuration to persistent memory
 	SaveConfig(ctx context.Context) error
 
+	// BeginChange marks the start of a resource change. The returned function
+	// ends it and, in batch save mode, runs the deferred save when no other
+	// change is running, returning its error.
+	BeginChange() func(ctx context.Context) error
+
+	// LockDevice holds the device lock of the router until the returned
+	// function is called, so that the commands of concurrent resource
+	// operations cannot interleave. Commands must be run with the returned
+	// context, which does not wait for the lock again.
+	LockDevice(ctx context.Context) (context.Context, func(), error)
+
 	// RunBatch executes multiple raw commands in sequence and returns combined output
 	// This is useful for VPN-safe updates where commands must be sent quickly
 	RunBatch(ctx context.Context, cmds []string) ([]byte, error)
@@ -473,6 +487,24 @@ type Client interface {
 	// ResetDNS removes DNS server configuration
 	ResetDNS(ctx context.Context) error
 
+	// GetDNSServerSelect retrieves a DNS server select entry by ID
+	GetDNSServerSelect(ctx context.Context, id int) (*DNSServerSelect, error)
+
+	// SetDNSServerSelect creates or replaces a DNS server select entry
+	SetDNSServerSelect(ctx context.Context, sel DNSServerSelect) error
+
+	// DeleteDNSServerSelect removes a DNS server select entry
+	DeleteDNSServerSelect(ctx context.Context, id int) error
+
+	// GetDNSStaticHost retrieves the dns static entries for a record type and name
+	GetDNSStaticHost(ctx context.Context, recordType, name string) (*DNSStaticHost, error)
+
+	// SetDNSStaticHost creates or replaces the dns static entries for a record type and name
+	SetDNSStaticHost(ctx context.Context, host DNSStaticHost) error
+
+	// DeleteDNSStaticHost removes the dns static entries for a record type and name
+	DeleteDNSStaticHost(ctx context.Context, recordType, name string) error
+
 	// Admin methods (singleton resource)
 	// GetAdminConfig retrieves admin password configuration
 	GetAdminConfig(ctx context.Context) (*AdminConfig, error)
@@ -588,6 +620,19 @@ type Client interface {
 	// ListIPv6InterfaceConfigs retrieves all IPv6 interface configurations
 	ListIPv6InterfaceConfigs(ctx context.Context) ([]IPv6InterfaceConfig, error)
 
+	// MLD Proxy methods
+	// GetMLDProxy retrieves the MLD proxy rooted at the given upstream interface
+	GetMLDProxy(ctx context.Context, upstreamInterface string) (*MLDProxy, error)
+
+	// CreateMLDProxy creates a new MLD proxy configuration
+	CreateMLDProxy(ctx context.Context, proxy MLDProxy) error
+
+	// UpdateMLDProxy updates an existing MLD proxy configuration
+	UpdateMLDProxy(ctx context.Context, proxy MLDProxy) error
+
+	// DeleteMLDProxy removes the MLD proxy rooted at the given upstream interface
+	DeleteMLDProxy(ctx context.Context, upstreamInterface string) error
+
 	// Access List Extended (IPv4) methods
 	// GetAccessListExtended retrieves an IPv4 extended access list
 	GetAccessListExtended(ctx context.Context, name string) (*AccessListExtended, error)
@@ -866,6 +911,441 @@ type Client interface {
 
 	// GetExtendedInterfaceFilters returns all extended ACL filter bindings for all interfaces
 	GetExtendedInterfaceFilters(ctx context.Context) (map[string]map[string][]int, error)
+
+	// IP keepalive methods
+	// GetIPKeepalive retrieves an IP keepalive monitor
+	GetIPKeepalive(ctx context.Context, id int) (*IPKeepalive, error)
+
+	// CreateIPKeepalive creates a new IP keepalive monitor
+	CreateIPKeepalive(ctx context.Context, keepalive IPKeepalive) error
+
+	// UpdateIPKeepalive updates an existing IP keepalive monitor
+	UpdateIPKeepalive(ctx context.Context, keepalive IPKeepalive) error
+
+	// DeleteIPKeepalive removes an IP keepalive monitor
+	DeleteIPKeepalive(ctx context.Context, id int) error
+
+	// ListIPKeepalives retrieves all IP keepalive monitors
+	ListIPKeepalives(ctx context.Context) ([]IPKeepalive, error)
+
+	// Flow export methods (singleton resource)
+	// GetFlowExport retrieves flow export configuration
+	GetFlowExport(ctx context.Context) (*FlowExportConfig, error)
+
+	// ConfigureFlowExport creates flow export configuration
+	ConfigureFlowExport(ctx context.Context, config FlowExportConfig) error
+
+	// UpdateFlowExport updates flow export configuration
+	UpdateFlowExport(ctx context.Context, config FlowExportConfig) error
+
+	// ResetFlowExport removes flow export configuration
+	ResetFlowExport(ctx context.Context) error
+
+	// Traffic threshold methods
+	// GetTrafficThreshold retrieves the traffic threshold of an interface
+	GetTrafficThreshold(ctx context.Context, iface string) (*TrafficThreshold, error)
+
+	// CreateTrafficThreshold creates a traffic threshold
+	CreateTrafficThreshold(ctx context.Context, threshold TrafficThreshold) error
+
+	// UpdateTrafficThreshold updates a traffic threshold
+	UpdateTrafficThreshold(ctx context.Context, threshold TrafficThreshold) error
+
+	// DeleteTrafficThreshold removes the traffic threshold of an interface
+	DeleteTrafficThreshold(ctx context.Context, iface string) error
+
+	// ListTrafficThresholds retrieves all traffic thresholds
+	ListTrafficThresholds(ctx context.Context) ([]TrafficThreshold, error)
+
+	// External memory backup methods (singleton resource)
+	// GetExternalMemoryBackup retrieves external memory backup configuration
+	GetExternalMemoryBackup(ctx context.Context) (*ExternalMemoryBackup, error)
+
+	// ConfigureExternalMemoryBackup creates external memory backup configuration
+	ConfigureExternalMemoryBackup(ctx context.Context, backup ExternalMemoryBackup) error
+
+	// UpdateExternalMemoryBackup updates external memory backup configuration
+	UpdateExternalMemoryBackup(ctx context.Context, backup ExternalMemoryBackup) error
+
+	// ResetExternalMemoryBackup removes external memory backup configuration
+	ResetExternalMemoryBackup(ctx context.Context) error
+
+	// Firmware update methods (singleton resource)
+	// GetFirmwareUpdate retrieves firmware update settings
+	GetFirmwareUpdate(ctx context.Context) (*FirmwareUpdate, error)
+
+	// ConfigureFirmwareUpdate creates firmware update settings
+	ConfigureFirmwareUpdate(ctx context.Context, update FirmwareUpdate) error
+
+	// UpdateFirmwareUpdate updates firmware update settings
+	UpdateFirmwareUpdate(ctx context.Context, update FirmwareUpdate) error
+
+	// ResetFirmwareUpdate removes firmware update settings
+	ResetFirmwareUpdate(ctx context.Context) error
+
+	// Clock methods (singleton resource)
+	// GetClock retrieves clock settings
+	GetClock(ctx context.Context) (*ClockConfig, error)
+
+	// ConfigureClock creates clock settings
+	ConfigureClock(ctx context.Context, config ClockConfig) error
+
+	// UpdateClock updates clock settings
+	UpdateClock(ctx context.Context, config ClockConfig) error
+
+	// ResetClock removes clock settings
+	ResetClock(ctx context.Context) error
+
+	// RADIUS methods (singleton resource)
+	// GetRADIUS retrieves RADIUS client configuration
+	GetRADIUS(ctx context.Context) (*RADIUSConfig, error)
+
+	// ConfigureRADIUS creates RADIUS client configuration
+	ConfigureRADIUS(ctx context.Context, config RADIUSConfig) error
+
+	// UpdateRADIUS updates RADIUS client configuration
+	UpdateRADIUS(ctx context.Context, config RADIUSConfig) error
+
+	// ResetRADIUS removes RADIUS client configuration
+	ResetRADIUS(ctx context.Context) error
+
+	// PPP auth user methods
+	// GetPPPAuthUser retrieves a PP auth user
+	GetPPPAuthUser(ctx context.Context, pp, username string) (*PPPAuthUser, error)
+
+	// CreatePPPAuthUser creates a PP auth user
+	CreatePPPAuthUser(ctx context.Context, user PPPAuthUser) error
+
+	// UpdatePPPAuthUser updates a PP auth user
+	UpdatePPPAuthUser(ctx context.Context, user PPPAuthUser) error
+
+	// DeletePPPAuthUser removes a PP auth user
+	DeletePPPAuthUser(ctx context.Context, pp, username string) error
+
+	// ListPPPAuthUsers retrieves all PP auth users
+	ListPPPAuthUsers(ctx context.Context) ([]PPPAuthUser, error)
+
+	// VPN address pool methods
+	// GetVPNAddressPool retrieves the address pool of a PP context
+	GetVPNAddressPool(ctx context.Context, pp string) (*VPNAddressPool, error)
+
+	// CreateVPNAddressPool creates an address pool
+	CreateVPNAddressPool(ctx context.Context, pool VPNAddressPool) error
+
+	// UpdateVPNAddressPool updates an address pool
+	UpdateVPNAddressPool(ctx context.Context, pool VPNAddressPool) error
+
+	// DeleteVPNAddressPool removes the address pool of a PP context
+	DeleteVPNAddressPool(ctx context.Context, pp string) error
+
+	// ListVPNAddressPools retrieves all address pools
+	ListVPNAddressPools(ctx context.Context) ([]VPNAddressPool, error)
+
+	// Certificate methods
+	// GetCertificate retrieves a registered certificate
+	GetCertificate(ctx context.Context, id int) (*Certificate, error)
+
+	// CreateCertificate uploads and registers a certificate
+	CreateCertificate(ctx context.Context, cert Certificate) error
+
+	// UpdateCertificate replaces the content of a registered certificate
+	UpdateCertificate(ctx context.Context, cert Certificate) error
+
+	// DeleteCertificate unregisters a certificate and removes its file
+	DeleteCertificate(ctx context.Context, id int) error
+
+	// IKEv2 tunnel methods
+	// GetIKEv2Tunnel retrieves an IKEv2 tunnel
+	GetIKEv2Tunnel(ctx context.Context, tunnelID int) (*IKEv2Tunnel, error)
+
+	// CreateIKEv2Tunnel creates an IKEv2 tunnel
+	CreateIKEv2Tunnel(ctx context.Context, tunnel IKEv2Tunnel) error
+
+	// UpdateIKEv2Tunnel updates an IKEv2 tunnel
+	UpdateIKEv2Tunnel(ctx context.Context, tunnel IKEv2Tunnel) error
+
+	// DeleteIKEv2Tunnel removes an IKEv2 tunnel
+	DeleteIKEv2Tunnel(ctx context.Context, tunnelID int) error
+
+	// ListIKEv2Tunnels retrieves all IKEv2 tunnels
+	ListIKEv2Tunnels(ctx context.Context) ([]IKEv2Tunnel, error)
+
+	// IPv6 neighbor static methods
+	// GetIPv6NeighborStatic retrieves a static IPv6 neighbor entry
+	GetIPv6NeighborStatic(ctx context.Context, iface, address string) (*IPv6NeighborStatic, error)
+
+	// CreateIPv6NeighborStatic creates a static IPv6 neighbor entry
+	CreateIPv6NeighborStatic(ctx context.Context, entry IPv6NeighborStatic) error
+
+	// UpdateIPv6NeighborStatic updates a static IPv6 neighbor entry
+	UpdateIPv6NeighborStatic(ctx context.Context, entry IPv6NeighborStatic) error
+
+	// DeleteIPv6NeighborStatic removes a static IPv6 neighbor entry
+	DeleteIPv6NeighborStatic(ctx context.Context, iface, address string) error
+
+	// ListIPv6NeighborStatics retrieves all static IPv6 neighbor entries
+	ListIPv6NeighborStatics(ctx context.Context) ([]IPv6NeighborStatic, error)
+
+	// IPv6 RTADV methods
+	// GetIPv6RTADV retrieves the Router Advertisement settings of an interface
+	GetIPv6RTADV(ctx context.Context, iface string) (*IPv6RTADV, error)
+
+	// ConfigureIPv6RTADV configures Router Advertisement on an interface
+	ConfigureIPv6RTADV(ctx context.Context, rtadv IPv6RTADV) error
+
+	// DeleteIPv6RTADV stops Router Advertisement on an interface
+	DeleteIPv6RTADV(ctx context.Context, iface string) error
+
+	// Link aggregation methods
+	// GetLinkAggregation retrieves a link aggregation group
+	GetLinkAggregation(ctx context.Context, id int) (*LinkAggregation, error)
+
+	// CreateLinkAggregation creates a link aggregation group
+	CreateLinkAggregation(ctx context.Context, lag LinkAggregation) error
+
+	// UpdateLinkAggregation updates a link aggregation group
+	UpdateLinkAggregation(ctx context.Context, lag LinkAggregation) error
+
+	// DeleteLinkAggregation removes a link aggregation group
+	DeleteLinkAggregation(ctx context.Context, id int) error
+
+	// ListLinkAggregations retrieves all link aggregation groups
+	ListLinkAggregations(ctx context.Context) ([]LinkAggregation, error)
+
+	// Loopback interface methods
+	// GetLoopbackInterface retrieves the address of a loopback interface
+	GetLoopbackInterface(ctx context.Context, name string) (*LoopbackInterface, error)
+
+	// CreateLoopbackInterface assigns an address to a loopback interface
+	CreateLoopbackInterface(ctx context.Context, loopback LoopbackInterface) error
+
+	// UpdateLoopbackInterface updates the address of a loopback interface
+	UpdateLoopbackInterface(ctx context.Context, loopback LoopbackInterface) error
+
+	// DeleteLoopbackInterface removes the address of a loopback interface
+	DeleteLoopbackInterface(ctx context.Context, name string) error
+
+	// ListLoopbackInterfaces retrieves all configured loopback interfaces
+	ListLoopbackInterfaces(ctx context.Context) ([]LoopbackInterface, error)
+
+	// L2MS methods
+	// GetL2MSManagedSwitch retrieves the settings of a switch managed through an interface
+	GetL2MSManagedSwitch(ctx context.Context, iface, sw string) (*L2MSManagedSwitch, error)
+
+	// CreateL2MSManagedSwitch enables the L2MS manager and pushes settings to a switch
+	CreateL2MSManagedSwitch(ctx context.Context, sw L2MSManagedSwitch) error
+
+	// UpdateL2MSManagedSwitch updates the settings of a managed switch
+	UpdateL2MSManagedSwitch(ctx context.Context, sw L2MSManagedSwitch) error
+
+	// DeleteL2MSManagedSwitch resets the settings of a managed switch to their defaults
+	DeleteL2MSManagedSwitch(ctx context.Context, iface, sw string) error
+
+	// ListL2MSSwitches retrieves the switches discovered by the L2MS manager
+	ListL2MSSwitches(ctx context.Context) ([]L2MSSwitchStatus, error)
+
+	// Wireless LAN methods (singleton resource)
+	// GetWLAN retrieves the wireless LAN configuration
+	GetWLAN(ctx context.Context) (*WLANConfig, error)
+
+	// ConfigureWLAN creates the wireless LAN configuration
+	ConfigureWLAN(ctx context.Context, config WLANConfig) error
+
+	// UpdateWLAN updates the wireless LAN configuration
+	UpdateWLAN(ctx context.Context, config WLANConfig) error
+
+	// ResetWLAN disables the wireless LAN and removes its SSIDs
+	ResetWLAN(ctx context.Context) error
+
+	// USB host methods (singleton resource)
+	// GetUSBHost retrieves the USB host settings
+	GetUSBHost(ctx context.Context) (*USBHostConfig, error)
+
+	// ConfigureUSBHost creates the USB host settings
+	ConfigureUSBHost(ctx context.Context, config USBHostConfig) error
+
+	// UpdateUSBHost updates the USB host settings
+	UpdateUSBHost(ctx context.Context, config USBHostConfig) error
+
+	// ResetUSBHost restores the default USB host settings
+	ResetUSBHost(ctx context.Context) error
+
+	// Mobile WAN methods
+	// GetMobileWAN retrieves the mobile WAN connection on a PP interface
+	GetMobileWAN(ctx context.Context, ppNum int) (*MobileWAN, error)
+
+	// CreateMobileWAN creates a mobile WAN connection
+	CreateMobileWAN(ctx context.Context, wan MobileWAN) error
+
+	// UpdateMobileWAN updates a mobile WAN connection
+	UpdateMobileWAN(ctx context.Context, wan MobileWAN) error
+
+	// DeleteMobileWAN removes the mobile WAN connection on a PP interface
+	DeleteMobileWAN(ctx context.Context, ppNum int) error
+
+	// ListMobileWANs retrieves all mobile WAN connections
+	ListMobileWANs(ctx context.Context) ([]MobileWAN, error)
+
+	// Config block methods
+	// GetConfigBlock retrieves the configuration lines owned by a config block
+	GetConfigBlock(ctx context.Context, block ConfigBlock) (*ConfigBlock, error)
+
+	// CreateConfigBlock applies a config block
+	CreateConfigBlock(ctx context.Context, block ConfigBlock) error
+
+	// UpdateConfigBlock applies a config block and removes lines no longer configured
+	UpdateConfigBlock(ctx context.Context, previous, block ConfigBlock) error
+
+	// DeleteConfigBlock removes all configuration lines owned by a config block
+	DeleteConfigBlock(ctx context.Context, block ConfigBlock) error
+
+	// Bulk config methods
+	// PushBulkConfig writes a complete configuration file to the router and returns the configuration read back afterwards
+	PushBulkConfig(ctx context.Context, bulk BulkConfig) (string, error)
+
+	// System settings methods (singleton resource)
+	// GetSystemSettings retrieves the global forwarding settings
+	GetSystemSettings(ctx context.Context) (*SystemSettings, error)
+
+	// ConfigureSystemSettings creates the global forwarding settings
+	ConfigureSystemSettings(ctx context.Context, settings SystemSettings) error
+
+	// UpdateSystemSettings updates the global forwarding settings
+	UpdateSystemSettings(ctx context.Context, settings SystemSettings) error
+
+	// ResetSystemSettings restores the default global forwarding settings
+	ResetSystemSettings(ctx context.Context) error
+
+	// Proxy ARP methods (singleton resource)
+	// GetProxyARP retrieves the interfaces with proxy ARP enabled
+	GetProxyARP(ctx context.Context) (*ProxyARPConfig, error)
+
+	// ConfigureProxyARP enables proxy ARP on exactly the given interfaces
+	ConfigureProxyARP(ctx context.Context, config ProxyARPConfig) error
+
+	// UpdateProxyARP updates the interfaces with proxy ARP enabled
+	UpdateProxyARP(ctx context.Context, config ProxyARPConfig) error
+
+	// ResetProxyARP disables proxy ARP on all interfaces
+	ResetProxyARP(ctx context.Context) error
+
+	// Port mirroring methods
+	// GetPortMirroring retrieves the mirroring session of a LAN interface
+	GetPortMirroring(ctx context.Context, iface string) (*PortMirroring, error)
+
+	// CreatePortMirroring starts a mirroring session on a LAN interface
+	CreatePortMirroring(ctx context.Context, session PortMirroring) error
+
+	// UpdatePortMirroring updates a mirroring session
+	UpdatePortMirroring(ctx context.Context, session PortMirroring) error
+
+	// DeletePortMirroring stops the mirroring session of a LAN interface
+	DeletePortMirroring(ctx context.Context, iface string) error
+
+	// ListPortMirrorings retrieves all port mirroring sessions
+	ListPortMirrorings(ctx context.Context) ([]PortMirroring, error)
+
+	// LLDP methods (singleton resource)
+	// GetLLDP retrieves the LLDP settings
+	GetLLDP(ctx context.Context) (*LLDPConfig, error)
+
+	// ConfigureLLDP applies the LLDP settings
+	ConfigureLLDP(ctx context.Context, config LLDPConfig) error
+
+	// UpdateLLDP updates the LLDP settings
+	UpdateLLDP(ctx context.Context, config LLDPConfig) error
+
+	// ResetLLDP disables LLDP and restores the default interface modes
+	ResetLLDP(ctx context.Context) error
+
+	// ICMP stealth methods (singleton resource)
+	// GetICMPStealth retrieves the ICMP hardening settings
+	GetICMPStealth(ctx context.Context) (*ICMPStealthConfig, error)
+
+	// ConfigureICMPStealth applies the ICMP hardening settings
+	ConfigureICMPStealth(ctx context.Context, config ICMPStealthConfig) error
+
+	// UpdateICMPStealth updates the ICMP hardening settings
+	UpdateICMPStealth(ctx context.Context, config ICMPStealthConfig) error
+
+	// ResetICMPStealth restores the default ICMP settings
+	ResetICMPStealth(ctx context.Context) error
+
+	// Interface status methods (data source)
+	// ListInterfaceStatuses retrieves the status of LAN interfaces, discovering them when no names are given
+	ListInterfaceStatuses(ctx context.Context, names []string) ([]InterfaceStatus, error)
+
+	// IPsec SA methods (data source)
+	// ListIPsecSAs retrieves the established IPsec and ISAKMP security associations
+	ListIPsecSAs(ctx context.Context) ([]IPsecSA, error)
+
+	// PP status methods (data source)
+	// GetPPStatus retrieves the session state of a PP interface
+	GetPPStatus(ctx context.Context, ppNum int) (*PPStatus, error)
+
+	// Running config methods (data source)
+	// GetRunningConfig retrieves the raw router configuration, optionally filtered by a grep pattern
+	GetRunningConfig(ctx context.Context, pattern string) (string, error)
+
+	// GetConfigSection retrieves the configuration of one PP or tunnel interface
+	// (section "pp" or "tunnel"), reading only that section where the router supports it
+	GetConfigSection(ctx context.Context, section string, number int) (string, error)
+
+	// Log methods (data source)
+	// ListLogEntries retrieves log entries, optionally filtered by keyword and limited to the last entries
+	ListLogEntries(ctx context.Context, keyword string, tail int) ([]LogEntry, error)
+
+	// Environment methods (data source)
+	// GetEnvironment retrieves CPU load, memory usage, temperature and fan status
+	GetEnvironment(ctx context.Context) (*Environment, error)
+
+	// IPv6 neighbor cache methods (data source)
+	// ListIPv6Neighbors retrieves the IPv6 neighbor cache, optionally limited to one interface
+	ListIPv6Neighbors(ctx context.Context, iface string) ([]IPv6Neighbor, error)
+
+	// DNS cache methods (data source)
+	// GetDNSCache retrieves the DNS cache statistics and the entries for name (all entries if empty)
+	GetDNSCache(ctx context.Context, name string) (*DNSCache, error)
+
+	// Interface counters methods (data source)
+	// ListInterfaceCounters retrieves the traffic counters of LAN interfaces, discovering them when no names are given
+	ListInterfaceCounters(ctx context.Context, names []string) ([]InterfaceCounters, error)
+
+	// NAT descriptor methods (data source)
+	// ListNATDescriptors retrieves all NAT descriptors with their addresses and interface bindings
+	ListNATDescriptors(ctx context.Context) ([]NATDescriptor, error)
+
+	// Firmware revision methods (data source)
+	// GetFirmwareRevisions retrieves the installed firmware revision and the revisions published at listURL
+	GetFirmwareRevisions(ctx context.Context, listURL string) (*FirmwareRevisions, error)
+
+	// QoS status methods (data source)
+	// GetQoSStatus retrieves the queue statistics per class of a LAN interface
+	GetQoSStatus(ctx context.Context, iface string) (*QoSStatus, error)
+
+	// L2TP session methods (data source)
+	// ListL2TPSessions retrieves the connected L2TP sessions, optionally limited to one user
+	ListL2TPSessions(ctx context.Context, username string) ([]L2TPSession, error)
+
+	// OSPF neighbor status methods (data source)
+	// ListOSPFNeighborStatuses retrieves the OSPF adjacencies, optionally limited to one interface
+	ListOSPFNeighborStatuses(ctx context.Context, iface string) ([]OSPFNeighborStatus, error)
+
+	// BGP peer status methods (data source)
+	// ListBGPPeerStatuses retrieves the BGP peer sessions, optionally limited to one peer address
+	ListBGPPeerStatuses(ctx context.Context, address string) ([]BGPPeerStatus, error)
+
+	// Read-only command methods (data source)
+	// RunReadOnlyCommand runs a whitelisted read-only command and returns its raw output
+	RunReadOnlyCommand(ctx context.Context, command string) (*CommandResult, error)
+
+	// Exec command methods
+	// ExecCommands executes operational commands in order and returns their raw output
+	ExecCommands(ctx context.Context, commands []string) ([]CommandResult, error)
+
+	// Ping methods (data source)
+	// Ping runs ping from the router and returns the loss and latency summary
+	Ping(ctx context.Context, opts PingOptions) (*PingResult, error)
 }
 
 // Interface represents a network interface on an RTX router
@@ -1020,11 +1500,17 @@ type Config struct {
 	AdminPassword        string // Administrator password for configuration changes
 	Timeout              int    // seconds
 	HostKey              string // Fixed host key for verification (base64 encoded)
+	HostKeyFingerprint   string // Pinned host key fingerprint (e.g., "SHA256:...")
 	KnownHostsFile       string // Path to known_hosts file
 	SkipHostKeyCheck     bool   // Skip host key verification (insecure)
+	HostKeyWarnOnly      bool   // Log host key verification failures instead of rejecting the connection
 	PrivateKey           string // PEM-encoded private key content for SSH authentication
 	PrivateKeyFile       string // Path to private key file for SSH authentication
 	PrivateKeyPassphrase string // Passphrase for encrypted private key
+	Certificate          string // OpenSSH certificate for the private key (authorized_keys format)
+	CertificateFile      string // Path to the OpenSSH certificate (default: "<private key file>-cert.pub" if present)
+	SSHAgentSocket       string // SSH agent socket path (default: SSH_AUTH_SOCK)
+	DisableSSHAgent      bool   // Do not use the SSH agent when no private key is configured
 	MaxParallelism       int    // Maximum number of concurrent operations (default: 6)
 	SFTPEnabled          bool   // Enable SFTP-based configuration reading for faster bulk operations
 	SFTPConfigPath       string // SFTP path to config file (e.g., "/system/config0"); empty for auto-detect
@@ -1033,6 +1519,63 @@ type Config struct {
 	SSHPoolEnabled     bool   // Enable SSH session pooling (default: true)
 	SSHPoolMaxSessions int    // Maximum concurrent SSH sessions (default: 2)
 	SSHPoolIdleTimeout string // Idle session timeout duration string (default: "5m")
+
+	// JumpHost routes all SSH connections through a bastion host (nil for direct connections)
+	JumpHost *JumpHostConfig
+
+	// LockFile is an optional file locked while configuration commands run, so that
+	// several Terraform processes managing the same router do not interleave commands
+	LockFile string
+
+	// BackupDir is an optional directory the full configuration is saved to before
+	// the first configuration change, as a timestamped file per apply
+	BackupDir string
+
+	// Timeouts overrides how long to wait for router responses (zero values use the defaults)
+	Timeouts Timeouts
+
+	// Pacing slows down console input for routers that drop characters (zero value: full speed)
+	Pacing Pacing
+
+	// ConsoleEncoding is the router's console character encoding (default: ConsoleEncodingAuto)
+	ConsoleEncoding ConsoleEncoding
+
+	// HTTPAPI enables status reads through the router's web API (nil: CLI only)
+	HTTPAPI *HTTPAPIConfig
+
+	// YNO sends all commands through Yamaha Network Organizer instead of SSH (nil for direct connections)
+	YNO *YNOConfig
+
+	// Prompts overrides the console prompt detection for customized prompts (nil: built-in detection)
+	Prompts *PromptConfig
+
+	// SSHKeepalive configures keepalives on pooled SSH connections (zero interval disables them)
+	SSHKeepalive SSHKeepaliveConfig
+
+	// SaveMode controls when the configuration is saved to flash memory (default: SaveModeImmediate)
+	SaveMode SaveMode
+}
+
+// JumpHostConfig holds the connection settings of an SSH jump host (bastion).
+// Authentication follows the same rules as the router connection: an explicit
+// private key, otherwise the SSH agent, with password authentication as fallback.
+type JumpHostConfig struct {
+	Host                 string
+	Port                 int // defaults to 22
+	Username             string
+	Password             string
+	PrivateKey           string // PEM-encoded private key content
+	PrivateKeyFile       string // Path to private key file
+	PrivateKeyPassphrase string // Passphrase for encrypted private key
+	Certificate          string // OpenSSH certificate for the private key
+	CertificateFile      string // Path to the OpenSSH certificate
+	SSHAgentSocket       string // SSH agent socket path (default: SSH_AUTH_SOCK)
+	DisableSSHAgent      bool   // Do not use the SSH agent when no private key is configured
+	HostKey              string // Fixed host key for verification (base64 encoded)
+	HostKeyFingerprint   string // Pinned host key fingerprint (e.g., "SHA256:...")
+	KnownHostsFile       string // Path to known_hosts file
+	SkipHostKeyCheck     bool   // Skip host key verification (insecure)
+	HostKeyWarnOnly      bool   // Log host key verification failures instead of rejecting the connection
 }
 
 // InterfaceConfig represents interface configuration on an RTX router
@@ -1070,12 +1613,13 @@ type StaticRoute struct {
 
 // StaticRouteHop represents a next hop configuration for a static route
 type StaticRouteHop struct {
-	NextHop   string `json:"next_hop,omitempty"`  // Gateway IP address
-	Interface string `json:"interface,omitempty"` // Interface (pp 1, tunnel 1, etc.)
-	Distance  int    `json:"distance"`            // Administrative distance (weight)
-	Name      string `json:"name,omitempty"`      // Route description
-	Permanent bool   `json:"permanent"`           // Keep route when interface down
-	Filter    int    `json:"filter,omitempty"`    // IP filter number (RTX-specific)
+	NextHop     string `json:"next_hop,omitempty"`     // Gateway IP address
+	Interface   string `json:"interface,omitempty"`    // Interface (pp 1, tunnel 1, etc.)
+	Distance    int    `json:"distance"`               // Gateway weight (0 = backup gateway)
+	Name        string `json:"name,omitempty"`         // Route description
+	Permanent   bool   `json:"permanent"`              // Keep route when interface down
+	Filter      int    `json:"filter,omitempty"`       // IP filter number (RTX-specific)
+	KeepaliveID int    `json:"keepalive_id,omitempty"` // ip keepalive ID that withdraws this gateway when the target is down
 }
 
 // NATMasquerade represents a NAT masquerade configuration on an RTX router
@@ -1084,6 +1628,19 @@ type NATMasquerade struct {
 	OuterAddress  string                  `json:"outer_address"`            // "ipcp", interface name, or specific IP
 	InnerNetwork  string                  `json:"inner_network"`            // IP range: "192.168.1.0-192.168.1.255"
 	StaticEntries []MasqueradeStaticEntry `json:"static_entries,omitempty"` // Static port mappings
+
+	// Descriptor options
+	SIP                     string                        `json:"sip,omitempty"`                       // "on", "off", "auto" (empty = router default)
+	FTPPorts                []int                         `json:"ftp_ports,omitempty"`                 // FTP control ports (empty = router default 21)
+	UnconvertiblePorts      []MasqueradeUnconvertiblePort `json:"unconvertible_ports,omitempty"`       // Ports excluded from conversion
+	UnconvertibleIfPossible bool                          `json:"unconvertible_if_possible,omitempty"` // Keep source port when not in use
+	Rlogin                  bool                          `json:"rlogin,omitempty"`                    // Allow rlogin/rcp/ssh through masquerade
+}
+
+// MasqueradeUnconvertiblePort represents a port range that NAT masquerade must not convert
+type MasqueradeUnconvertiblePort struct {
+	Protocol string `json:"protocol"` // "tcp" or "udp"
+	Port     string `json:"port"`     // Single port or range: "1024" or "1024-65535"
 }
 
 // MasqueradeStaticEntry represents a static port mapping entry for NAT masquerade
@@ -1133,6 +1690,12 @@ type IPFilter struct {
 	SourcePort    string `json:"source_port,omitempty"` // Source port(s) or "*"
 	DestPort      string `json:"dest_port,omitempty"`   // Destination port(s) or "*"
 	Established   bool   `json:"established,omitempty"` // Match established TCP connections
+	ICMPType      *int   `json:"icmp_type,omitempty"`   // ICMP type (icmp only)
+	ICMPCode      *int   `json:"icmp_code,omitempty"`   // ICMP code (icmp only)
+	Fragment      bool   `json:"fragment,omitempty"`    // Match IP fragments only
+	Length        string `json:"length,omitempty"`      // Packet length or range (e.g., "100-200")
+	TOS           string `json:"tos,omitempty"`         // TOS byte to match (e.g., "0x10")
+	DSCP          string `json:"dscp,omitempty"`        // DSCP value to match (e.g., "46" or "ef")
 }
 
 // IPFilterDynamic represents a dynamic (stateful) IP filter on an RTX router
@@ -1478,6 +2041,14 @@ type DNSHost struct {
 	TTL     int    `json:"ttl"`     // Optional TTL (0 = not specified)
 }
 
+// DNSStaticHost groups all dns static entries sharing a record type and name
+type DNSStaticHost struct {
+	Type      string   `json:"type"`      // Record type: a, aaaa, ptr, mx, ns, cname
+	Name      string   `json:"name"`      // Hostname/FQDN
+	Addresses []string `json:"addresses"` // IP addresses or values, one dns static line each
+	TTL       int      `json:"ttl"`       // Optional TTL (0 = not specified)
+}
+
 // ClassMap represents a class-map configuration for traffic classification
 type ClassMap struct {
 	Name                 string `json:"name"`                             // Class map name
@@ -1668,6 +2239,22 @@ type RTADVConfig struct {
 	Lifetime int  `json:"lifetime,omitempty"` // Router lifetime in seconds
 }
 
+// MLDProxy represents an MLD proxy on an RTX router
+type MLDProxy struct {
+	UpstreamInterface    string          `json:"upstream_interface"`     // Interface in MLD host mode (e.g., "lan2")
+	DownstreamInterfaces []string        `json:"downstream_interfaces"`  // Interfaces in MLD router mode
+	Version              string          `json:"version,omitempty"`      // MLD version ("1" or "2")
+	Syslog               bool            `json:"syslog,omitempty"`       // Log MLD events
+	StaticJoins          []MLDStaticJoin `json:"static_joins,omitempty"` // Statically joined groups
+}
+
+// MLDStaticJoin represents a statically joined IPv6 multicast group
+type MLDStaticJoin struct {
+	Interface string `json:"interface"`        // Interface the group is joined on
+	Group     string `json:"group"`            // IPv6 multicast group address
+	Source    string `json:"source,omitempty"` // Optional source address (SSM)
+}
+
 // AccessListExtended represents an IPv4 extended access list (Cisco-compatible naming)
 type AccessListExtended struct {
 	Name    string                    `json:"name"`    // ACL name (identifier)
@@ -1939,3 +2526,467 @@ type PPConnectionStatus struct {
 	State     string `json:"state,omitempty"`      // State: "connected", "disconnected", "unknown"
 	RawStatus string `json:"raw_status,omitempty"` // Raw status output from router
 }
+
+// IPKeepalive represents an "ip keepalive" reachability monitor on an RTX router
+type IPKeepalive struct {
+	ID       int    `json:"id"`                 // Keepalive ID referenced by routes (1-6000)
+	Kind     string `json:"kind"`               // Probe kind (icmp-echo)
+	Interval int    `json:"interval"`           // Probe interval in seconds
+	Count    int    `json:"count"`              // Consecutive failures before the target is considered down
+	Target   string `json:"target"`             // Probe destination address
+	Upwait   int    `json:"upwait,omitempty"`   // Seconds of success before declaring the target up
+	Downwait int    `json:"downwait,omitempty"` // Seconds of failure before declaring the target down
+	Syslog   bool   `json:"syslog,omitempty"`   // Log state transitions
+}
+
+// FlowExportConfig represents traffic flow export (external statistics) configuration
+type FlowExportConfig struct {
+	CollectorAddress string   `json:"collector_address"`    // Collector IP address
+	CollectorPort    int      `json:"collector_port"`       // Collector UDP port
+	SamplingRate     int      `json:"sampling_rate"`        // Export 1 out of N packets
+	Interfaces       []string `json:"interfaces,omitempty"` // Interfaces with flow export enabled
+}
+
+// TrafficThreshold represents a traffic volume alarm on an interface
+type TrafficThreshold struct {
+	Interface string `json:"interface"` // Interface whose traffic is counted
+	Direction string `json:"direction"` // in, out or both
+	Megabytes int    `json:"megabytes"` // Volume in megabytes that triggers the action
+	Action    string `json:"action"`    // syslog or disconnect
+}
+
+// ExternalMemoryBackup represents configuration backups to external memory (USB or microSD)
+type ExternalMemoryBackup struct {
+	AutoBackup     bool   `json:"auto_backup"`               // Copy the config to external memory whenever it is saved
+	ConfigFilename string `json:"config_filename,omitempty"` // Backup file path, e.g. usb1:/config.txt
+	ScheduleID     int    `json:"schedule_id,omitempty"`     // "schedule at" ID of the periodic copy
+	ScheduleTime   string `json:"schedule_time,omitempty"`   // Daily time of the periodic copy (HH:MM)
+	Destination    string `json:"destination,omitempty"`     // Destination path of the periodic copy
+}
+
+// FirmwareUpdate represents "http revision-up" firmware update settings
+type FirmwareUpdate struct {
+	URL            string `json:"url,omitempty"`           // Firmware download URL
+	AllowUpdate    bool   `json:"allow_update"`            // Allow firmware updates
+	AllowDowngrade bool   `json:"allow_downgrade"`         // Allow firmware downgrades
+	Timeout        int    `json:"timeout,omitempty"`       // Download timeout in seconds
+	ScheduleID     int    `json:"schedule_id,omitempty"`   // "schedule at" ID of the automatic update
+	ScheduleTime   string `json:"schedule_time,omitempty"` // Daily time of the automatic update (HH:MM)
+}
+
+// ClockConfig represents clock settings: timezone, NTP synchronisation and SNTP server
+type ClockConfig struct {
+	Timezone       string `json:"timezone"`                   // UTC offset (e.g., "+09:00")
+	NTPServer      string `json:"ntp_server,omitempty"`       // Upstream NTP server queried by ntpdate
+	SyncScheduleID int    `json:"sync_schedule_id,omitempty"` // "schedule at" ID of the periodic ntpdate
+	SyncTime       string `json:"sync_time,omitempty"`        // Daily time of the periodic ntpdate (HH:MM)
+	SNTPServer     bool   `json:"sntp_server"`                // Serve time to LAN clients
+}
+
+// RADIUSConfig represents the RADIUS client configuration used for PPP and login authentication
+type RADIUSConfig struct {
+	Auth       bool     `json:"auth"`       // Enable RADIUS authentication
+	Accounting bool     `json:"accounting"` // Enable RADIUS accounting
+	Servers    []string `json:"servers"`    // RADIUS server addresses (primary, secondary)
+	Secret     string   `json:"-"`          // Shared secret (never serialized)
+	AuthPort   int      `json:"auth_port"`  // Authentication UDP port
+	Retry      int      `json:"retry"`      // Retransmissions before giving up on a server
+	Wait       int      `json:"wait"`       // Seconds to wait for a reply
+}
+
+// PPPAuthUser represents a "pp auth username" entry for dial-in, PPTP and L2TP users
+type PPPAuthUser struct {
+	PP        string `json:"pp"`                   // PP selector: "anonymous" or a PP number
+	Username  string `json:"username"`             // Login username
+	Password  string `json:"-"`                    // Login password (never serialized)
+	IPAddress string `json:"ip_address,omitempty"` // Fixed address assigned to the user
+}
+
+// VPNAddressPool represents the address pool handed to remote access VPN clients
+type VPNAddressPool struct {
+	PP           string `json:"pp"`             // PP selector: "anonymous" or a PP number
+	Start        string `json:"start"`          // First address of the pool
+	End          string `json:"end"`            // Last address of the pool
+	DNSToClients bool   `json:"dns_to_clients"` // Hand DNS servers to clients (ppp ipcp msext)
+}
+
+// Certificate represents a certificate (and optional private key) installed on
+// the router for IKEv2 or the HTTPS GUI
+type Certificate struct {
+	ID             int    `json:"id"`   // Certificate ID (pki certificate file <id>)
+	File           string `json:"file"` // Path of the certificate file on the router
+	CertificatePEM string `json:"-"`    // PEM encoded certificate chain (never serialized)
+	PrivateKeyPEM  string `json:"-"`    // PEM encoded private key (never serialized)
+}
+
+// IKEv2Tunnel represents an IPsec tunnel negotiated with IKEv2
+type IKEv2Tunnel struct {
+	ID                int      `json:"id"`                           // Tunnel, SA policy and IKE gateway ID
+	LocalAddress      string   `json:"local_address,omitempty"`      // Local IKE endpoint
+	RemoteAddress     string   `json:"remote_address"`               // Remote IKE endpoint, FQDN or "any"
+	LocalName         string   `json:"local_name,omitempty"`         // Local IKE identity
+	LocalNameType     string   `json:"local_name_type,omitempty"`    // ipv4-addr, ipv6-addr, fqdn, rfc822-addr or key-id
+	RemoteName        string   `json:"remote_name,omitempty"`        // Expected remote IKE identity
+	RemoteNameType    string   `json:"remote_name_type,omitempty"`   // ipv4-addr, ipv6-addr, fqdn, rfc822-addr or key-id
+	LocalAuthMethod   string   `json:"local_auth_method"`            // psk, certificate or eap-md5
+	RemoteAuthMethod  string   `json:"remote_auth_method"`           // psk, certificate or eap-md5
+	PreSharedKey      string   `json:"-"`                            // Pre-shared key (never serialized)
+	CertificateID     int      `json:"certificate_id,omitempty"`     // Certificate slot used for local authentication
+	EAPUsername       string   `json:"eap_username,omitempty"`       // EAP identity sent to the peer
+	EAPPassword       string   `json:"-"`                            // EAP password (never serialized)
+	Encryption        []string `json:"encryption,omitempty"`         // IKE SA encryption proposals
+	Integrity         []string `json:"integrity,omitempty"`          // IKE SA integrity proposals
+	Groups            []string `json:"groups,omitempty"`             // IKE SA DH group proposals
+	ESPEncryption     string   `json:"esp_encryption"`               // Child SA encryption
+	ESPIntegrity      string   `json:"esp_integrity,omitempty"`      // Child SA integrity (unused with GCM)
+	IKESALifetime     int      `json:"ike_sa_lifetime"`              // IKE SA lifetime in seconds
+	ChildSALifetime   int      `json:"child_sa_lifetime"`            // Child SA lifetime in seconds
+	KeepaliveInterval int      `json:"keepalive_interval,omitempty"` // Liveness check interval in seconds (0 = off)
+	KeepaliveRetry    int      `json:"keepalive_retry,omitempty"`    // Failed checks before the SA is torn down
+	NATTraversal      bool     `json:"nat_traversal"`                // Enable NAT traversal
+	Enabled           bool     `json:"enabled"`                      // tunnel enable
+}
+
+// IPv6NeighborStatic represents a static IPv6 neighbor cache entry
+type IPv6NeighborStatic struct {
+	Interface  string `json:"interface"`   // LAN-side interface (lan1, bridge1, etc.)
+	Address    string `json:"address"`     // IPv6 address of the neighbor
+	MACAddress string `json:"mac_address"` // Link-layer address of the neighbor
+}
+
+// IPv6RTADV represents the full Router Advertisement settings of an interface
+type IPv6RTADV struct {
+	Interface      string   `json:"interface"`                 // Interface sending RAs (lan1, bridge1, etc.)
+	PrefixIDs      []int    `json:"prefix_ids"`                // Prefix IDs to advertise
+	OFlag          bool     `json:"o_flag"`                    // Other Configuration Flag (O flag)
+	MFlag          bool     `json:"m_flag"`                    // Managed Address Configuration Flag (M flag)
+	MaxInterval    int      `json:"max_interval,omitempty"`    // Maximum RA interval in seconds (0 = router default)
+	MinInterval    int      `json:"min_interval,omitempty"`    // Minimum RA interval in seconds (0 = router default)
+	RouterLifetime int      `json:"router_lifetime,omitempty"` // Router lifetime in seconds (0 = router default)
+	MTU            int      `json:"mtu,omitempty"`             // Advertised link MTU (0 = not advertised)
+	RDNSS          []string `json:"rdnss,omitempty"`           // Recursive DNS servers (RFC 8106)
+	DNSSL          []string `json:"dnssl,omitempty"`           // DNS search list (RFC 8106)
+}
+
+// LinkAggregation represents a LAN link aggregation group
+type LinkAggregation struct {
+	ID      int      `json:"id"`      // Link aggregation group ID
+	Mode    string   `json:"mode"`    // "static" or "lacp"
+	Members []string `json:"members"` // Member LAN interfaces (lan1, lan2, ...)
+}
+
+// LoopbackInterface represents the address of a loopback interface
+type LoopbackInterface struct {
+	Name    string `json:"name"`    // Loopback interface name (loopback1 - loopback9)
+	Address string `json:"address"` // IPv4 address in CIDR notation
+}
+
+// L2MSManagedSwitch represents the settings pushed to a switch managed through L2MS
+type L2MSManagedSwitch struct {
+	Interface  string           `json:"interface"`             // Router interface acting as L2MS manager
+	Switch     string           `json:"switch"`                // Switch MAC address or route (e.g., lan1:1)
+	SystemName string           `json:"system_name,omitempty"` // Switch system name
+	Ports      []L2MSSwitchPort `json:"ports,omitempty"`       // Per-port settings differing from defaults
+}
+
+// L2MSSwitchPort represents the settings of one port of a managed switch
+type L2MSSwitchPort struct {
+	Port       int  `json:"port"`                  // Port number on the switch
+	Enabled    bool `json:"enabled"`               // port-use on/off
+	AccessVLAN int  `json:"access_vlan,omitempty"` // Access VLAN ID (0 = switch default)
+}
+
+// L2MSSwitchStatus represents a switch discovered by the L2MS manager
+type L2MSSwitchStatus struct {
+	MACAddress string `json:"mac_address"` // Switch MAC address
+	Model      string `json:"model"`       // Switch model (e.g., SWX2200-8G)
+	Route      string `json:"route"`       // Route from the router (e.g., lan1:1)
+	Name       string `json:"name"`        // Switch system name
+}
+
+// WLANConfig represents the built-in wireless LAN of models with Wi-Fi
+type WLANConfig struct {
+	Enabled bool       `json:"enabled"`         // wlan use on/off
+	Channel int        `json:"channel"`         // Radio channel (0 = auto)
+	SSIDs   []WLANSSID `json:"ssids,omitempty"` // Configured SSIDs
+}
+
+// WLANSSID represents one SSID of the wireless LAN
+type WLANSSID struct {
+	ID       int    `json:"id"`            // SSID number (1-4)
+	Name     string `json:"name"`          // Network name
+	Security string `json:"security"`      // Security mode
+	PSK      string `json:"psk,omitempty"` // Pre-shared key (not read back from the router)
+}
+
+// USBHostConfig represents the USB host settings of the router
+type USBHostConfig struct {
+	Enabled        bool `json:"enabled"`         // usbhost use on/off
+	ModemEnabled   bool `json:"modem_enabled"`   // usbhost modem use on/off
+	StorageEnabled bool `json:"storage_enabled"` // usbhost storage use on/off
+}
+
+// MobileWAN represents an LTE (mobile) WAN connection bound to a PP interface
+type MobileWAN struct {
+	PPNumber       int    `json:"pp_number"`                 // PP number (pp select <num>)
+	Interface      string `json:"interface"`                 // Mobile interface (usb1, wan1)
+	Enabled        bool   `json:"enabled"`                   // mobile use <interface> on|off
+	APN            string `json:"apn"`                       // Access point name
+	CID            int    `json:"cid"`                       // PDP context ID
+	PDPType        string `json:"pdp_type"`                  // ip, ipv6 or ipv4v6
+	AuthMethod     string `json:"auth_method,omitempty"`     // pap or chap
+	Username       string `json:"username,omitempty"`        // Authentication username
+	Password       string `json:"password,omitempty"`        // Authentication password
+	PIN            string `json:"pin,omitempty"`             // SIM PIN code
+	AlwaysOn       bool   `json:"always_on"`                 // Keep the connection up permanently
+	AutoConnect    bool   `json:"auto_connect"`              // Connect automatically when traffic arrives
+	DisconnectTime int    `json:"disconnect_time,omitempty"` // Idle disconnect timer in seconds (0 = off)
+}
+
+// ConfigBlock represents a set of raw configuration lines owned as a unit
+type ConfigBlock struct {
+	Context  string   `json:"context,omitempty"`  // Context select command (e.g., "tunnel select 1"), empty for global
+	Lines    []string `json:"lines"`              // Raw command lines
+	Prefixes []string `json:"prefixes,omitempty"` // Command prefixes owned by the block
+}
+
+// BulkConfig represents a complete configuration written to the router as one file
+type BulkConfig struct {
+	Content      string `json:"content"`       // Configuration text, one command per line
+	Transport    string `json:"transport"`     // File transfer used: "sftp" or "tftp"
+	ConfigNumber int    `json:"config_number"` // Configuration file written (configN); -1 for the startup configuration
+	Restart      bool   `json:"restart"`       // Restart the router so that it loads the file
+}
+
+// SystemSettings represents global forwarding toggles of the router
+type SystemSettings struct {
+	IPRouting           bool   `json:"ip_routing"`             // ip routing on|off
+	IPv6Routing         bool   `json:"ipv6_routing"`           // ipv6 routing on|off
+	IPRoutingProcess    string `json:"ip_routing_process"`     // ip routing process fast|normal
+	IPv6RoutingProcess  string `json:"ipv6_routing_process"`   // ipv6 routing process fast|normal
+	IPFilterSourceRoute bool   `json:"ip_filter_source_route"` // ip filter source-route on|off
+}
+
+// ProxyARPConfig represents the interfaces answering ARP requests on behalf of other hosts
+type ProxyARPConfig struct {
+	Interfaces []string `json:"interfaces"` // Interfaces with "ip <interface> proxyarp on"
+}
+
+// PortMirroring represents a switching hub port mirroring session on a LAN interface
+type PortMirroring struct {
+	Interface       string `json:"interface"`        // LAN interface (lan1, lan2, ...)
+	DestinationPort int    `json:"destination_port"` // Observer port that receives the mirrored frames
+	SourcePorts     []int  `json:"source_ports"`     // Ports whose traffic is mirrored
+	Direction       string `json:"direction"`        // in, out, or both
+}
+
+// LLDPConfig represents the LLDP settings of the router
+type LLDPConfig struct {
+	Enabled bool       `json:"enabled"` // lldp use on/off
+	Ports   []LLDPPort `json:"ports"`   // Per-interface transmit/receive modes
+}
+
+// LLDPPort represents the LLDP agent mode of an interface
+type LLDPPort struct {
+	Interface string `json:"interface"` // LAN interface (lan1, lan2, ...)
+	Mode      string `json:"mode"`      // txrx, tx, rx, or disable
+}
+
+// ICMPStealthConfig represents the ICMP hardening settings of the router
+type ICMPStealthConfig struct {
+	StealthInterfaces   []string `json:"stealth_interfaces"`      // ip stealth <interface>... or all
+	EchoReply           bool     `json:"echo_reply"`              // ip icmp echo-reply send on|off
+	EchoReplyOnlyLinkUp bool     `json:"echo_reply_only_link_up"` // ip icmp echo-reply send-only-linkup on|off
+	Unreachable         bool     `json:"unreachable"`             // ip icmp unreachable send on|off
+	Redirect            bool     `json:"redirect"`                // ip icmp redirect send on|off
+}
+
+// InterfaceStatus represents the runtime status of a LAN interface
+type InterfaceStatus struct {
+	Name        string   `json:"name"`                  // Interface name (lan1, lan2, ...)
+	Description string   `json:"description,omitempty"` // Interface description
+	MACAddress  string   `json:"mac_address,omitempty"` // Ethernet address
+	LinkUp      bool     `json:"link_up"`               // True if at least one port has link
+	Speed       string   `json:"speed,omitempty"`       // Negotiated speed (e.g., 1000BASE-T)
+	Duplex      string   `json:"duplex,omitempty"`      // full or half
+	IPAddresses []string `json:"ip_addresses"`          // IPv4 addresses in CIDR notation
+	MTU         int      `json:"mtu,omitempty"`         // Maximum transmission unit
+}
+
+// IPsecSA represents an established security association
+type IPsecSA struct {
+	ID         int    `json:"id"`                    // SA number
+	Gateway    int    `json:"gateway"`               // Security gateway (ipsec tunnel) number
+	ISAKMPSA   int    `json:"isakmp_sa,omitempty"`   // ISAKMP SA the IPsec SA was negotiated on (0 for ISAKMP SAs)
+	Type       string `json:"type"`                  // isakmp, esp, or ah
+	Tunnel     int    `json:"tunnel,omitempty"`      // Tunnel interface number
+	Direction  string `json:"direction,omitempty"`   // send or recv (empty for ISAKMP SAs)
+	Lifetime   int    `json:"lifetime"`              // Remaining lifetime in seconds
+	RemoteID   string `json:"remote_id"`             // Peer identifier
+	SPI        string `json:"spi,omitempty"`         // SPI as hex string
+	LocalHost  string `json:"local_host,omitempty"`  // Local endpoint
+	RemoteHost string `json:"remote_host,omitempty"` // Remote endpoint
+}
+
+// PPStatus represents the session state of a PP interface
+type PPStatus struct {
+	PPNumber           int      `json:"pp_number"`                     // PP interface number
+	Connected          bool     `json:"connected"`                     // Session is established
+	LocalAddress       string   `json:"local_address,omitempty"`       // Address assigned by IPCP
+	RemoteAddress      string   `json:"remote_address,omitempty"`      // Peer address from IPCP
+	DNSServers         []string `json:"dns_servers"`                   // DNS servers notified by IPCP
+	UptimeSeconds      int      `json:"uptime_seconds"`                // Time connected in seconds
+	AccessConcentrator string   `json:"access_concentrator,omitempty"` // PPPoE access concentrator name
+}
+
+// LogEntry represents a line of the router's internal log
+type LogEntry struct {
+	Timestamp string `json:"timestamp"` // Time as printed by the router (YYYY/MM/DD HH:MM:SS)
+	Message   string `json:"message"`   // Log message
+}
+
+// Environment represents the hardware state reported by "show environment"
+type Environment struct {
+	Model              string `json:"model,omitempty"`               // Router model (e.g., RTX1210)
+	FirmwareVersion    string `json:"firmware_version,omitempty"`    // Firmware revision (e.g., 14.01.42)
+	CPU5Sec            int    `json:"cpu_5sec"`                      // CPU load over the last 5 seconds (percent)
+	CPU1Min            int    `json:"cpu_1min"`                      // CPU load over the last minute (percent)
+	CPU5Min            int    `json:"cpu_5min"`                      // CPU load over the last 5 minutes (percent)
+	MemoryUsedPercent  int    `json:"memory_used_percent"`           // Memory usage (percent)
+	TemperatureCelsius *int   `json:"temperature_celsius,omitempty"` // Inside temperature, nil if the model has no sensor
+	FanStatus          string `json:"fan_status,omitempty"`          // Fan status, empty if the model has no fan
+	Uptime             string `json:"uptime,omitempty"`              // Elapsed time from boot as printed by the router
+}
+
+// IPv6Neighbor represents an entry of the IPv6 neighbor cache
+type IPv6Neighbor struct {
+	Address    string `json:"address"`         // IPv6 address of the neighbor
+	Interface  string `json:"interface"`       // Interface the neighbor was learned on (lan1, bridge1, etc.)
+	MACAddress string `json:"mac_address"`     // Link-layer address (empty while resolution is incomplete)
+	State      string `json:"state,omitempty"` // Neighbor Unreachability Detection state (reachable, stale, etc.)
+}
+
+// DNSCache represents the DNS cache statistics and entries
+type DNSCache struct {
+	MaxEntries int             `json:"max_entries"` // Configured cache size
+	EntryCount int             `json:"entry_count"` // Number of cached records
+	Entries    []DNSCacheEntry `json:"entries"`     // Cached records
+}
+
+// DNSCacheEntry represents a cached DNS record
+type DNSCacheEntry struct {
+	Name string `json:"name"` // Query name without the trailing dot
+	Type string `json:"type"` // Record type (A, AAAA, CNAME, etc.)
+	TTL  int    `json:"ttl"`  // Remaining TTL in seconds
+	Data string `json:"data"` // Record data (address, canonical name, etc.)
+}
+
+// InterfaceCounters represents the traffic counters of a LAN interface
+type InterfaceCounters struct {
+	Name           string `json:"name"`             // Interface name (lan1, lan2, ...)
+	TxPackets      int64  `json:"tx_packets"`       // Transmitted packets
+	TxOctets       int64  `json:"tx_octets"`        // Transmitted octets
+	RxPackets      int64  `json:"rx_packets"`       // Received packets
+	RxOctets       int64  `json:"rx_octets"`        // Received octets
+	RxOverruns     int64  `json:"rx_overruns"`      // Packets dropped by receive overrun
+	RxBufferErrors int64  `json:"rx_buffer_errors"` // Packets dropped for lack of receive buffers
+}
+
+// NATDescriptor summarizes a NAT descriptor and the interfaces it is bound to
+type NATDescriptor struct {
+	ID             int      `json:"id"`              // Descriptor number
+	Type           string   `json:"type"`            // masquerade, static, nat, nat-masquerade, or none
+	OuterAddresses []string `json:"outer_addresses"` // Outer address values (ipcp, primary, addresses, ranges)
+	InnerAddresses []string `json:"inner_addresses"` // Inner address values (auto, ranges)
+	Interfaces     []string `json:"interfaces"`      // Interfaces the descriptor is applied to (lan2, pp1, tunnel1, ...)
+}
+
+// FirmwareRevisions compares the installed firmware revision with published revisions
+type FirmwareRevisions struct {
+	Model              string   `json:"model,omitempty"`           // Router model (e.g., RTX1210)
+	InstalledRevision  string   `json:"installed_revision"`        // Running firmware revision (e.g., 14.01.38)
+	AvailableRevisions []string `json:"available_revisions"`       // Published revisions of the same series, ascending
+	LatestRevision     string   `json:"latest_revision,omitempty"` // Newest published revision of the same series
+	UpdateAvailable    bool     `json:"update_available"`          // LatestRevision is newer than InstalledRevision
+}
+
+// QoSStatus represents the queue statistics of an interface
+type QoSStatus struct {
+	Interface string           `json:"interface"`            // Interface name (lan1, lan2, ...)
+	QueueType string           `json:"queue_type,omitempty"` // Queuing type as reported by the router
+	Classes   []QoSClassStatus `json:"classes"`              // Statistics per class, ordered by class number
+}
+
+// QoSClassStatus represents the statistics of a queue class
+type QoSClassStatus struct {
+	Class          int   `json:"class"`                 // Class number
+	SentPackets    int64 `json:"sent_packets"`          // Packets transmitted from the class
+	SentOctets     int64 `json:"sent_octets"`           // Octets transmitted from the class
+	DroppedPackets int64 `json:"dropped_packets"`       // Packets discarded because the queue was full
+	QueueLength    int   `json:"queue_length"`          // Packets currently queued
+	QueueLimit     int   `json:"queue_limit,omitempty"` // Maximum queue length
+}
+
+// L2TPSession represents a connected L2TP session
+type L2TPSession struct {
+	TunnelID        int    `json:"tunnel_id"`                  // TUNNEL[n] index
+	SessionID       int    `json:"session_id"`                 // Local session ID
+	State           string `json:"state"`                      // Session state (established, etc.)
+	Username        string `json:"username,omitempty"`         // Authenticated user name
+	AssignedAddress string `json:"assigned_address,omitempty"` // Address assigned to the client
+	RemoteAddress   string `json:"remote_address,omitempty"`   // Public address of the client (tunnel peer)
+	Version         string `json:"version,omitempty"`          // L2TPv2 or L2TPv3
+	UptimeSeconds   int    `json:"uptime_seconds"`             // Time connected in seconds
+}
+
+// OSPFNeighborStatus represents an OSPF adjacency
+type OSPFNeighborStatus struct {
+	NeighborID string `json:"neighbor_id"`    // Router ID of the neighbor
+	Priority   int    `json:"priority"`       // Router priority of the neighbor
+	State      string `json:"state"`          // Adjacency state (full, 2way, init, ...)
+	Role       string `json:"role,omitempty"` // Role of the neighbor on the segment (dr, bdr, drother)
+	DeadTime   string `json:"dead_time"`      // Time until the neighbor is declared down
+	Address    string `json:"address"`        // Interface address of the neighbor
+	Interface  string `json:"interface"`      // Local interface the neighbor was learned on
+}
+
+// BGPPeerStatus represents the session state of a BGP peer
+type BGPPeerStatus struct {
+	Address          string `json:"address"`             // Peer IP address
+	RemoteAS         string `json:"remote_as"`           // Remote AS number
+	RouterID         string `json:"router_id,omitempty"` // BGP identifier of the peer
+	State            string `json:"state"`               // Session state (idle, connect, active, opensent, openconfirm, established)
+	Uptime           string `json:"uptime,omitempty"`    // Time since the session was established
+	PrefixesReceived int    `json:"prefixes_received"`   // Number of prefixes received from the peer
+	PrefixesAccepted int    `json:"prefixes_accepted"`   // Number of received prefixes accepted into the table
+	PrefixesSent     int    `json:"prefixes_sent"`       // Number of prefixes advertised to the peer
+}
+
+// CommandResult represents the result of a read-only command
+type CommandResult struct {
+	Command    string `json:"command"`     // Command as sent to the router
+	Output     string `json:"output"`      // Raw output with LF line endings
+	ExitStatus int    `json:"exit_status"` // 0 on success, 1 if the router reported an error
+}
+
+// PingOptions holds the parameters of a ping run from the router
+type PingOptions struct {
+	Host           string `json:"host"`                     // Destination host name or address
+	Count          int    `json:"count"`                    // Number of echo requests to send
+	TimeoutSeconds int    `json:"timeout_seconds"`          // Seconds to wait for each reply
+	SourceAddress  string `json:"source_address,omitempty"` // Source address of the echo requests
+	// SourceInterface is an interface whose static IPv4 address is used as the
+	// source address
+	SourceInterface string `json:"source_interface,omitempty"`
+}
+
+// PingResult represents the loss and latency summary of a ping run
+type PingResult struct {
+	Host              string   `json:"host"`                 // Destination host name or address
+	PacketsSent       int      `json:"packets_sent"`         // Number of echo requests sent
+	PacketsReceived   int      `json:"packets_received"`     // Number of echo replies received
+	PacketLossPercent float64  `json:"packet_loss_percent"`  // Packet loss in percent
+	RTTMinMs          *float64 `json:"rtt_min_ms,omitempty"` // Minimum round-trip time (nil if no reply)
+	RTTAvgMs          *float64 `json:"rtt_avg_ms,omitempty"` // Average round-trip time (nil if no reply)
+	RTTMaxMs          *float64 `json:"rtt_max_ms,omitempty"` // Maximum round-trip time (nil if no reply)
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -86,11 +87,9 @@ func (s *IPFilterService) GetFilter(ctx context.Context, number int) (*IPFilter,
 
 	logger.Debug().Str("service", "IPFilterService").Str("operation", "GetFilter").Msgf("IP filter raw output: %q", string(output))
 
-	// Parse the output
-	parserFilters, err := parsers.ParseIPFilterConfig(string(output))
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse IP filter: %w", err)
-	}
+	// Parse the output. grep also matches filters whose number starts with
+	// this one; fields of those that cannot be parsed do not matter here.
+	parserFilters, parseErr := parsers.ParseIPFilterConfig(string(output))
 
 	// Find the specific filter
 	for _, pf := range parserFilters {
@@ -99,10 +98,29 @@ func (s *IPFilterService) GetFilter(ctx context.Context, number int) (*IPFilter,
 			return &filter, nil
 		}
 	}
+	if joined, ok := parseErr.(interface{ Unwrap() []error }); ok {
+		for _, err := range joined.Unwrap() {
+			var filterErr *parsers.IPFilterParseError
+			if errors.As(err, &filterErr) && filterErr.Number == number {
+				return nil, fmt.Errorf("failed to parse IP filter: %w", err)
+			}
+		}
+	}
 
 	return nil, fmt.Errorf("IP filter %d not found", number)
 }
 
+// parseIPFilterList parses "ip filter" lines, keeping the filters that could be
+// parsed. Filters with fields this provider does not support are logged and
+// left out, so that one hand-written filter does not fail every read.
+func parseIPFilterList(ctx context.Context, output string) []parsers.IPFilter {
+	filters, err := parsers.ParseIPFilterConfig(output)
+	if err != nil {
+		logging.FromContext(ctx).Warn().Str("service", "ip_filter").Err(err).Msg("Skipping IP filters that could not be parsed")
+	}
+	return filters
+}
+
 // UpdateFilter updates an existing IP filter
 func (s *IPFilterService) UpdateFilter(ctx context.Context, filter IPFilter) error {
 	parserFilter := s.toParserFilter(filter)
@@ -198,10 +216,7 @@ func (s *IPFilterService) ListFilters(ctx context.Context) ([]IPFilter, error) {
 	logging.FromContext(ctx).Debug().Str("service", "UipUfilterService").Msgf("IP filters raw output: %q", string(output))
 
 	// Parse the output
-	parserFilters, err := parsers.ParseIPFilterConfig(string(output))
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse IP filters: %w", err)
-	}
+	parserFilters := parseIPFilterList(ctx, string(output))
 
 	// Convert parsers.IPFilter to client.IPFilter
 	filters := make([]IPFilter, len(parserFilters))
@@ -374,6 +389,12 @@ func (s *IPFilterService) toParserFilter(filter IPFilter) parsers.IPFilter {
 		SourcePort:    filter.SourcePort,
 		DestPort:      filter.DestPort,
 		Established:   filter.Established,
+		ICMPType:      filter.ICMPType,
+		ICMPCode:      filter.ICMPCode,
+		Fragment:      filter.Fragment,
+		Length:        filter.Length,
+		TOS:           filter.TOS,
+		DSCP:          filter.DSCP,
 	}
 }
 
@@ -390,6 +411,12 @@ func (s *IPFilterService) fromParserFilter(pf parsers.IPFilter) IPFilter {
 		SourcePort:    pf.SourcePort,
 		DestPort:      pf.DestPort,
 		Established:   pf.Established,
+		ICMPType:      pf.ICMPType,
+		ICMPCode:      pf.ICMPCode,
+		Fragment:      pf.Fragment,
+		Length:        pf.Length,
+		TOS:           pf.TOS,
+		DSCP:          pf.DSCP,
 	}
 }
 
@@ -474,10 +501,7 @@ func (s *IPFilterService) GetAccessListExtended(ctx context.Context, name string
 		return nil, fmt.Errorf("failed to get ACL: %w", err)
 	}
 
-	filters, err := parsers.ParseIPFilterConfig(string(output))
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse ACL: %w", err)
-	}
+	filters := parseIPFilterList(ctx, string(output))
 
 	// Convert filters to ACL entries
 	acl := &AccessListExtended{
@@ -1206,9 +1230,17 @@ func (s *IPFilterService) fromParserFilterToExtendedEntry(filter parsers.IPFilte
 		entry.DestinationPrefixMask = filter.DestMask
 	}
 
-	// Map ports
-	entry.SourcePortEqual = filter.SourcePort
-	entry.DestinationPortEqual = filter.DestPort
+	// Map ports; a single range is read back as a range so that it matches the configuration
+	if parsers.IsIPFilterPortRange(filter.SourcePort) {
+		entry.SourcePortRange = filter.SourcePort
+	} else {
+		entry.SourcePortEqual = filter.SourcePort
+	}
+	if parsers.IsIPFilterPortRange(filter.DestPort) {
+		entry.DestinationPortRange = filter.DestPort
+	} else {
+		entry.DestinationPortEqual = filter.DestPort
+	}
 
 	return entry
 }
@@ -1262,9 +1294,17 @@ func (s *IPFilterService) fromParserFilterToExtendedIPv6Entry(filter parsers.IPF
 		entry.DestinationPrefix = filter.DestAddress
 	}
 
-	// Map ports
-	entry.SourcePortEqual = filter.SourcePort
-	entry.DestinationPortEqual = filter.DestPort
+	// Map ports; a single range is read back as a range so that it matches the configuration
+	if parsers.IsIPFilterPortRange(filter.SourcePort) {
+		entry.SourcePortRange = filter.SourcePort
+	} else {
+		entry.SourcePortEqual = filter.SourcePort
+	}
+	if parsers.IsIPFilterPortRange(filter.DestPort) {
+		entry.DestinationPortRange = filter.DestPort
+	} else {
+		entry.DestinationPortEqual = filter.DestPort
+	}
 
 	return entry
 }
@@ -1275,10 +1315,7 @@ func (s *IPFilterService) CreateIPv6Filter(ctx context.Context, filter IPFilter)
 	parserFilter := s.toParserFilter(filter)
 
 	// Validate input
-	if err := parsers.ValidateIPFilterNumber(parserFilter.Number); err != nil {
-		return fmt.Errorf("invalid IPv6 filter: %w", err)
-	}
-	if err := parsers.ValidateIPFilterAction(parserFilter.Action); err != nil {
+	if err := parsers.ValidateIPv6Filter(parserFilter); err != nil {
 		return fmt.Errorf("invalid IPv6 filter: %w", err)
 	}
 
@@ -1353,10 +1390,7 @@ func (s *IPFilterService) UpdateIPv6Filter(ctx context.Context, filter IPFilter)
 	parserFilter := s.toParserFilter(filter)
 
 	// Validate input
-	if err := parsers.ValidateIPFilterNumber(parserFilter.Number); err != nil {
-		return fmt.Errorf("invalid IPv6 filter: %w", err)
-	}
-	if err := parsers.ValidateIPFilterAction(parserFilter.Action); err != nil {
+	if err := parsers.ValidateIPv6Filter(parserFilter); err != nil {
 		return fmt.Errorf("invalid IPv6 filter: %w", err)
 	}
 
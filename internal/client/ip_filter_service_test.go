@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+func TestIPFilterService_ListFilters_SkipsUnparsableFilters(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, parsers.BuildShowIPFilterCommand()).
+		Return([]byte("ip filter 100 pass * * tcp * www\nip filter 101 pass * * tcp * www newfield=1\nip filter 102 reject * * udp * domain\n"), nil)
+
+	service := NewIPFilterService(mockExecutor, nil)
+	filters, err := service.ListFilters(context.Background())
+
+	assert.NoError(t, err)
+	var numbers []int
+	for _, f := range filters {
+		numbers = append(numbers, f.Number)
+	}
+	assert.Equal(t, []int{100, 102}, numbers)
+	mockExecutor.AssertExpectations(t)
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// IPKeepaliveService handles "ip keepalive" monitor operations
+type IPKeepaliveService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewIPKeepaliveService creates a new IP keepalive service instance
+func NewIPKeepaliveService(executor Executor, client *rtxClient) *IPKeepaliveService {
+	return &IPKeepaliveService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves a keepalive monitor by ID
+func (s *IPKeepaliveService) Get(ctx context.Context, id int) (*IPKeepalive, error) {
+	cmd := parsers.BuildShowIPKeepaliveCommand()
+	logging.FromContext(ctx).Debug().Str("service", "ip_keepalive").Msgf("Getting IP keepalive with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IP keepalive: %w", err)
+	}
+
+	parsed, err := parsers.ParseSingleIPKeepalive(string(output), id)
+	if err != nil {
+		return nil, err
+	}
+
+	keepalive := s.fromParserKeepalive(*parsed)
+	return &keepalive, nil
+}
+
+// List retrieves all keepalive monitors
+func (s *IPKeepaliveService) List(ctx context.Context) ([]IPKeepalive, error) {
+	cmd := parsers.BuildShowIPKeepaliveCommand()
+	logging.FromContext(ctx).Debug().Str("service", "ip_keepalive").Msgf("Listing IP keepalives with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IP keepalives: %w", err)
+	}
+
+	parsed, err := parsers.ParseIPKeepaliveConfig(string(output))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse IP keepalives: %w", err)
+	}
+
+	keepalives := make([]IPKeepalive, len(parsed))
+	for i, p := range parsed {
+		keepalives[i] = s.fromParserKeepalive(p)
+	}
+	return keepalives, nil
+}
+
+// Create defines a new keepalive monitor
+func (s *IPKeepaliveService) Create(ctx context.Context, keepalive IPKeepalive) error {
+	return s.apply(ctx, keepalive, "created")
+}
+
+// Update redefines an existing keepalive monitor. The command overwrites the
+// previous definition, so no delete is needed.
+func (s *IPKeepaliveService) Update(ctx context.Context, keepalive IPKeepalive) error {
+	return s.apply(ctx, keepalive, "updated")
+}
+
+// apply validates and writes the keepalive definition
+func (s *IPKeepaliveService) apply(ctx context.Context, keepalive IPKeepalive, action string) error {
+	parserKeepalive := s.toParserKeepalive(keepalive)
+	if err := parsers.ValidateIPKeepalive(parserKeepalive); err != nil {
+		return fmt.Errorf("invalid IP keepalive: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	cmd := parsers.BuildIPKeepaliveCommand(parserKeepalive)
+	logging.FromContext(ctx).Debug().Str("service", "ip_keepalive").Msgf("Applying IP keepalive with command: %s", cmd)
+
+	if err := runCommand(ctx, s.executor, cmd); err != nil {
+		return fmt.Errorf("failed to apply IP keepalive %d: %w", keepalive.ID, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("IP keepalive %d %s", keepalive.ID, action))
+}
+
+// Delete removes a keepalive monitor
+func (s *IPKeepaliveService) Delete(ctx context.Context, id int) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	cmd := parsers.BuildDeleteIPKeepaliveCommand(id)
+	logging.FromContext(ctx).Debug().Str("service", "ip_keepalive").Msgf("Deleting IP keepalive with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return fmt.Errorf("failed to delete IP keepalive %d: %w", id, err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete IP keepalive"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("IP keepalive %d deleted", id))
+}
+
+// toParserKeepalive converts client.IPKeepalive to parsers.IPKeepalive
+func (s *IPKeepaliveService) toParserKeepalive(k IPKeepalive) parsers.IPKeepalive {
+	return parsers.IPKeepalive{
+		ID:       k.ID,
+		Kind:     strings.ToLower(k.Kind),
+		Interval: k.Interval,
+		Count:    k.Count,
+		Target:   k.Target,
+		Upwait:   k.Upwait,
+		Downwait: k.Downwait,
+		Syslog:   k.Syslog,
+	}
+}
+
+// fromParserKeepalive converts parsers.IPKeepalive to client.IPKeepalive
+func (s *IPKeepaliveService) fromParserKeepalive(k parsers.IPKeepalive) IPKeepalive {
+	return IPKeepalive{
+		ID:       k.ID,
+		Kind:     k.Kind,
+		Interval: k.Interval,
+		Count:    k.Count,
+		Target:   k.Target,
+		Upwait:   k.Upwait,
+		Downwait: k.Downwait,
+		Syslog:   k.Syslog,
+	}
+}
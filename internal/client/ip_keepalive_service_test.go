@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIPKeepaliveService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "ip keepalive"`).Return([]byte(
+		"ip keepalive 1 icmp-echo 10 3 8.8.8.8\nip keepalive 2 icmp-echo 5 3 192.0.2.1 downwait=5\n",
+	), nil)
+
+	service := NewIPKeepaliveService(mockExecutor, nil)
+
+	keepalive, err := service.Get(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, &IPKeepalive{ID: 2, Kind: "icmp-echo", Interval: 5, Count: 3, Target: "192.0.2.1", Downwait: 5}, keepalive)
+
+	_, err = service.Get(context.Background(), 3)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestIPKeepaliveService_Create(t *testing.T) {
+	tests := []struct {
+		name        string
+		keepalive   IPKeepalive
+		mockSetup   func(*MockExecutor)
+		expectedErr bool
+	}{
+		{
+			name:      "valid keepalive",
+			keepalive: IPKeepalive{ID: 1, Kind: "icmp-echo", Interval: 10, Count: 3, Target: "8.8.8.8", Syslog: true},
+			mockSetup: func(m *MockExecutor) {
+				m.On("Run", mock.Anything, "ip keepalive 1 icmp-echo 10 3 8.8.8.8 syslog=on").Return([]byte(""), nil)
+			},
+			expectedErr: false,
+		},
+		{
+			name:        "invalid target",
+			keepalive:   IPKeepalive{ID: 1, Kind: "icmp-echo", Interval: 10, Count: 3, Target: "example"},
+			mockSetup:   func(m *MockExecutor) {},
+			expectedErr: true,
+		},
+		{
+			name:      "executor error",
+			keepalive: IPKeepalive{ID: 1, Kind: "icmp-echo", Interval: 10, Count: 3, Target: "8.8.8.8"},
+			mockSetup: func(m *MockExecutor) {
+				m.On("Run", mock.Anything, mock.Anything).Return(nil, errors.New("connection lost"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExecutor := new(MockExecutor)
+			tt.mockSetup(mockExecutor)
+
+			service := NewIPKeepaliveService(mockExecutor, nil)
+			err := service.Create(context.Background(), tt.keepalive)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockExecutor.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// IPsecSAService handles security association status queries ("show ipsec sa")
+type IPsecSAService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewIPsecSAService creates a new IPsec SA service instance
+func NewIPsecSAService(executor Executor, client *rtxClient) *IPsecSAService {
+	return &IPsecSAService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// List retrieves the established security associations. SPIs and endpoints
+// are read from the detail output when the router provides it.
+func (s *IPsecSAService) List(ctx context.Context) ([]IPsecSA, error) {
+	logger := logging.FromContext(ctx)
+
+	cmd := parsers.BuildShowIPsecSACommand()
+	logger.Debug().Str("service", "ipsec_sa").Msgf("Listing IPsec SAs with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IPsec SAs: %w", err)
+	}
+	if line := rejectionLine(string(output)); line != "" {
+		return nil, fmt.Errorf("failed to list IPsec SAs: %s", line)
+	}
+
+	parsed := parsers.ParseIPsecSAs(string(output))
+	if len(parsed) > 0 {
+		detailCmd := parsers.BuildShowIPsecSADetailCommand()
+		detail, err := s.executor.Run(ctx, detailCmd)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get IPsec SA details: %w", err)
+		}
+		if rejectionLine(string(detail)) != "" {
+			logger.Debug().Str("service", "ipsec_sa").Msg("IPsec SA details not available, SPIs are left empty")
+		} else {
+			parsed = parsers.MergeIPsecSADetails(parsed, string(detail))
+		}
+	}
+
+	sas := make([]IPsecSA, len(parsed))
+	for i, p := range parsed {
+		sas[i] = IPsecSA(p)
+	}
+	return sas, nil
+}
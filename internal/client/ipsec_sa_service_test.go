@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIPsecSAService_List(t *testing.T) {
+	t.Run("with details", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show ipsec sa").Return([]byte(`sa   sgw isakmp connection   dir  life[s] remote-id
+-----------------------------------------------------------------------------
+2     1    1     tun[001]esp  send 28788   203.0.113.1
+`), nil)
+		mockExecutor.On("Run", mock.Anything, "show ipsec sa detail").Return([]byte(`SA[2] Duration: 11s
+ Local Host: 192.0.2.1
+ Remote Host: 203.0.113.1
+ SPI: 6e 20 53 a9
+`), nil)
+
+		service := NewIPsecSAService(mockExecutor, nil)
+
+		sas, err := service.List(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []IPsecSA{{
+			ID: 2, Gateway: 1, ISAKMPSA: 1, Type: "esp", Tunnel: 1, Direction: "send", Lifetime: 28788,
+			RemoteID: "203.0.113.1", SPI: "6e2053a9", LocalHost: "192.0.2.1", RemoteHost: "203.0.113.1",
+		}}, sas)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("detail labels are not errors", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show ipsec sa").Return([]byte(`sa   sgw isakmp connection   dir  life[s] remote-id
+-----------------------------------------------------------------------------
+2     1    1     tun[001]esp  send 28788   203.0.113.1
+`), nil)
+		mockExecutor.On("Run", mock.Anything, "show ipsec sa detail").Return([]byte(`SA[2] Duration: 11s
+ Local Host: 192.0.2.1
+ Remote Host: 203.0.113.1
+ Last error: not found
+ SPI: 6e 20 53 a9
+`), nil)
+
+		service := NewIPsecSAService(mockExecutor, nil)
+
+		sas, err := service.List(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, sas, 1)
+		assert.Equal(t, "6e2053a9", sas[0].SPI)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("details rejected", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show ipsec sa").Return([]byte(`sa   sgw isakmp connection   dir  life[s] remote-id
+-----------------------------------------------------------------------------
+2     1    1     tun[001]esp  send 28788   203.0.113.1
+`), nil)
+		mockExecutor.On("Run", mock.Anything, "show ipsec sa detail").Return([]byte("Error: Invalid parameter\n"), nil)
+
+		service := NewIPsecSAService(mockExecutor, nil)
+
+		sas, err := service.List(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, sas, 1)
+		assert.Empty(t, sas[0].SPI)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("no SAs", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show ipsec sa").Return([]byte("Total: isakmp:0 send:0 recv:0\n"), nil)
+
+		service := NewIPsecSAService(mockExecutor, nil)
+
+		sas, err := service.List(context.Background())
+		assert.NoError(t, err)
+		assert.Empty(t, sas)
+		mockExecutor.AssertExpectations(t)
+	})
+}
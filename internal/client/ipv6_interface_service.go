@@ -178,8 +178,8 @@ func (s *IPv6InterfaceService) Update(ctx context.Context, config IPv6InterfaceC
 		}
 	}
 
-	// Update RTADV
-	if !rtadvConfigsEqual(currentConfig.RTADV, config.RTADV) {
+	// Update RTADV. A nil RTADV leaves Router Advertisement to rtx_ipv6_rtadv.
+	if config.RTADV != nil && !rtadvConfigsEqual(currentConfig.RTADV, config.RTADV) {
 		// Remove old RTADV if it was configured
 		if currentConfig.RTADV != nil && currentConfig.RTADV.Enabled {
 			deleteCmd := parsers.BuildDeleteIPv6RTADVCommand(config.Interface)
@@ -434,6 +434,28 @@ func TestIPv6InterfaceService_Update(t *testing.T) {
 	}
 }
 
+func TestIPv6InterfaceService_Update_RTADVUnmanaged(t *testing.T) {
+	mock := &mockIPv6InterfaceExecutor{
+		output: []byte("ipv6 lan1 address 2001:db8::1/64\nipv6 lan1 rtadv send 1 o_flag=on m_flag=off rdnss=2001:db8::53"),
+	}
+
+	service := NewIPv6InterfaceService(mock, nil)
+	err := service.Update(context.Background(), IPv6InterfaceConfig{
+		Interface: "lan1",
+		Addresses: []IPv6Address{{Address: "2001:db8::1/64"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Router Advertisement managed elsewhere must be left untouched
+	for _, cmd := range mock.cmdLog {
+		if strings.Contains(cmd, "rtadv") {
+			t.Errorf("unexpected RTADV command: %s", cmd)
+		}
+	}
+}
+
 func TestIPv6InterfaceService_Reset(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// IPv6NeighborService handles IPv6 neighbor cache queries ("show ipv6 neighbor cache")
+type IPv6NeighborService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewIPv6NeighborService creates a new IPv6 neighbor service instance
+func NewIPv6NeighborService(executor Executor, client *rtxClient) *IPv6NeighborService {
+	return &IPv6NeighborService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// List retrieves the IPv6 neighbor cache, optionally limited to one interface
+func (s *IPv6NeighborService) List(ctx context.Context, iface string) ([]IPv6Neighbor, error) {
+	cmd := parsers.BuildShowIPv6NeighborCacheCommand()
+	logging.FromContext(ctx).Debug().Str("service", "ipv6_neighbor").Msgf("Listing IPv6 neighbors with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IPv6 neighbor cache: %w", err)
+	}
+	if line := rejectionLine(string(output)); line != "" {
+		return nil, fmt.Errorf("failed to get IPv6 neighbor cache: %s", line)
+	}
+
+	neighbors := []IPv6Neighbor{}
+	for _, n := range parsers.ParseIPv6Neighbors(string(output)) {
+		if iface != "" && !strings.EqualFold(n.Interface, iface) {
+			continue
+		}
+		neighbors = append(neighbors, IPv6Neighbor(n))
+	}
+	return neighbors, nil
+}
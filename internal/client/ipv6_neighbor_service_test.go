@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIPv6NeighborService_List(t *testing.T) {
+	output := `fe80::1       LAN1  00:a0:de:01:02:03  REACHABLE  28
+2001:db8::10  LAN2  00:a0:de:01:02:04  STALE      -
+`
+
+	tests := []struct {
+		name  string
+		iface string
+		want  []IPv6Neighbor
+	}{
+		{
+			name: "all interfaces",
+			want: []IPv6Neighbor{
+				{Address: "fe80::1", Interface: "lan1", MACAddress: "00:a0:de:01:02:03", State: "reachable"},
+				{Address: "2001:db8::10", Interface: "lan2", MACAddress: "00:a0:de:01:02:04", State: "stale"},
+			},
+		},
+		{
+			name:  "filtered by interface",
+			iface: "lan2",
+			want: []IPv6Neighbor{
+				{Address: "2001:db8::10", Interface: "lan2", MACAddress: "00:a0:de:01:02:04", State: "stale"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExecutor := new(MockExecutor)
+			mockExecutor.On("Run", mock.Anything, "show ipv6 neighbor cache").Return([]byte(output), nil)
+
+			service := NewIPv6NeighborService(mockExecutor, nil)
+
+			got, err := service.List(context.Background(), tt.iface)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIPv6NeighborService_List_Rejections(t *testing.T) {
+	t.Run("rejected command", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show ipv6 neighbor cache").Return([]byte("Error: Invalid parameter\n"), nil)
+
+		service := NewIPv6NeighborService(mockExecutor, nil)
+
+		_, err := service.List(context.Background(), "")
+		assert.ErrorContains(t, err, "Error: Invalid parameter")
+	})
+
+	t.Run("notes are not errors", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show ipv6 neighbor cache").Return([]byte(`fe80::1       LAN1  00:a0:de:01:02:03  REACHABLE  28
+# entries not found on lan3 have expired
+`), nil)
+
+		service := NewIPv6NeighborService(mockExecutor, nil)
+
+		got, err := service.List(context.Background(), "")
+		assert.NoError(t, err)
+		assert.Equal(t, []IPv6Neighbor{
+			{Address: "fe80::1", Interface: "lan1", MACAddress: "00:a0:de:01:02:03", State: "reachable"},
+		}, got)
+	})
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// IPv6NeighborStaticService handles "ipv6 neighbor static" operations
+type IPv6NeighborStaticService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewIPv6NeighborStaticService creates a new IPv6 neighbor static service instance
+func NewIPv6NeighborStaticService(executor Executor, client *rtxClient) *IPv6NeighborStaticService {
+	return &IPv6NeighborStaticService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves a static IPv6 neighbor entry. Addresses are compared in
+// their parsed form so "2001:db8::0a" matches "2001:db8::a".
+func (s *IPv6NeighborStaticService) Get(ctx context.Context, iface, address string) (*IPv6NeighborStatic, error) {
+	entries, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	want := net.ParseIP(address)
+	for _, entry := range entries {
+		if entry.Interface == iface && want != nil && want.Equal(net.ParseIP(entry.Address)) {
+			return &entry, nil
+		}
+	}
+
+	return nil, fmt.Errorf("IPv6 neighbor %s on %s not found", address, iface)
+}
+
+// List retrieves all static IPv6 neighbor entries
+func (s *IPv6NeighborStaticService) List(ctx context.Context) ([]IPv6NeighborStatic, error) {
+	cmd := parsers.BuildShowIPv6NeighborStaticsCommand()
+	logging.FromContext(ctx).Debug().Str("service", "ipv6_neighbor_static").Msgf("Listing IPv6 neighbor statics with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IPv6 neighbor statics: %w", err)
+	}
+
+	parsed := parsers.ParseIPv6NeighborStatics(string(output))
+	entries := make([]IPv6NeighborStatic, len(parsed))
+	for i, p := range parsed {
+		entries[i] = IPv6NeighborStatic(p)
+	}
+	return entries, nil
+}
+
+// Create adds a new static IPv6 neighbor entry
+func (s *IPv6NeighborStaticService) Create(ctx context.Context, entry IPv6NeighborStatic) error {
+	return s.apply(ctx, entry, "created")
+}
+
+// Update changes the MAC address of an existing entry. The command replaces
+// the previous entry for the same address, so no delete is needed.
+func (s *IPv6NeighborStaticService) Update(ctx context.Context, entry IPv6NeighborStatic) error {
+	return s.apply(ctx, entry, "updated")
+}
+
+// apply validates and writes the static IPv6 neighbor entry
+func (s *IPv6NeighborStaticService) apply(ctx context.Context, entry IPv6NeighborStatic, action string) error {
+	parserEntry := parsers.IPv6NeighborStatic(entry)
+	if err := parsers.ValidateIPv6NeighborStatic(parserEntry); err != nil {
+		return fmt.Errorf("invalid IPv6 neighbor static: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	cmd := parsers.BuildIPv6NeighborStaticCommand(parserEntry)
+	logging.FromContext(ctx).Debug().Str("service", "ipv6_neighbor_static").Msgf("Applying IPv6 neighbor static with command: %s", cmd)
+
+	if err := runCommand(ctx, s.executor, cmd); err != nil {
+		return fmt.Errorf("failed to apply IPv6 neighbor %s: %w", entry.Address, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("IPv6 neighbor %s %s", entry.Address, action))
+}
+
+// Delete removes a static IPv6 neighbor entry
+func (s *IPv6NeighborStaticService) Delete(ctx context.Context, iface, address string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	cmd := parsers.BuildDeleteIPv6NeighborStaticCommand(iface, address)
+	logging.FromContext(ctx).Debug().Str("service", "ipv6_neighbor_static").Msgf("Deleting IPv6 neighbor static with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return fmt.Errorf("failed to delete IPv6 neighbor %s: %w", address, err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete IPv6 neighbor static"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("IPv6 neighbor %s deleted", address))
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testIPv6NeighborStaticConfig = `ipv6 neighbor static lan1 2001:db8::a 00:a0:de:01:02:03
+ipv6 neighbor static bridge1 fe80::20 00:a0:de:04:05:06
+`
+
+func TestIPv6NeighborStaticService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "ipv6 neighbor static"`).Return([]byte(testIPv6NeighborStaticConfig), nil)
+
+	service := NewIPv6NeighborStaticService(mockExecutor, nil)
+
+	// Non-canonical notation still matches the configured entry
+	entry, err := service.Get(context.Background(), "lan1", "2001:db8:0::000a")
+	assert.NoError(t, err)
+	assert.Equal(t, &IPv6NeighborStatic{Interface: "lan1", Address: "2001:db8::a", MACAddress: "00:a0:de:01:02:03"}, entry)
+
+	_, err = service.Get(context.Background(), "lan2", "2001:db8::a")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestIPv6NeighborStaticService_Create(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "ipv6 neighbor static lan1 2001:db8::10 00:a0:de:01:02:03").Return([]byte(""), nil)
+
+	service := NewIPv6NeighborStaticService(mockExecutor, nil)
+
+	err := service.Create(context.Background(), IPv6NeighborStatic{Interface: "lan1", Address: "2001:db8::10", MACAddress: "00:A0:DE:01:02:03"})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+
+	err = service.Create(context.Background(), IPv6NeighborStatic{Interface: "pp1", Address: "2001:db8::10", MACAddress: "00:a0:de:01:02:03"})
+	assert.ErrorContains(t, err, "invalid IPv6 neighbor static")
+}
+
+func TestIPv6NeighborStaticService_Delete(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "no ipv6 neighbor static lan1 2001:db8::10").Return([]byte(""), nil)
+
+	service := NewIPv6NeighborStaticService(mockExecutor, nil)
+
+	err := service.Delete(context.Background(), "lan1", "2001:db8::10")
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
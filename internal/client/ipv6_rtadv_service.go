@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// IPv6RTADVService handles "ipv6 <interface> rtadv send" operations
+type IPv6RTADVService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewIPv6RTADVService creates a new IPv6 RTADV service instance
+func NewIPv6RTADVService(executor Executor, client *rtxClient) *IPv6RTADVService {
+	return &IPv6RTADVService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the Router Advertisement settings of an interface
+func (s *IPv6RTADVService) Get(ctx context.Context, iface string) (*IPv6RTADV, error) {
+	cmd := parsers.BuildShowIPv6RTADVCommand(iface)
+	logging.FromContext(ctx).Debug().Str("service", "ipv6_rtadv").Msgf("Getting IPv6 RTADV with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IPv6 RTADV: %w", err)
+	}
+
+	parsed, err := parsers.ParseIPv6RTADV(string(output), iface)
+	if err != nil {
+		return nil, err
+	}
+
+	rtadv := IPv6RTADV(*parsed)
+	return &rtadv, nil
+}
+
+// Configure validates and writes the Router Advertisement settings. The
+// command replaces the previous settings of the interface as a whole, so
+// options that are no longer set fall back to the router defaults.
+func (s *IPv6RTADVService) Configure(ctx context.Context, rtadv IPv6RTADV) error {
+	parserRTADV := parsers.IPv6RTADV(rtadv)
+	if err := parsers.ValidateIPv6RTADV(parserRTADV); err != nil {
+		return fmt.Errorf("invalid IPv6 RTADV: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	cmd := parsers.BuildIPv6RTADVSendCommand(parserRTADV)
+	logging.FromContext(ctx).Debug().Str("service", "ipv6_rtadv").Msgf("Configuring IPv6 RTADV with command: %s", cmd)
+
+	if err := runCommand(ctx, s.executor, cmd); err != nil {
+		return fmt.Errorf("failed to configure IPv6 RTADV on %s: %w", rtadv.Interface, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("IPv6 RTADV on %s configured", rtadv.Interface))
+}
+
+// Delete stops Router Advertisement on an interface
+func (s *IPv6RTADVService) Delete(ctx context.Context, iface string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	cmd := parsers.BuildDeleteIPv6RTADVCommand(iface)
+	logging.FromContext(ctx).Debug().Str("service", "ipv6_rtadv").Msgf("Deleting IPv6 RTADV with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return fmt.Errorf("failed to delete IPv6 RTADV on %s: %w", iface, err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete IPv6 RTADV"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("IPv6 RTADV on %s deleted", iface))
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIPv6RTADVService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "ipv6 lan1 rtadv"`).
+		Return([]byte("ipv6 lan1 rtadv send 1 o_flag=on m_flag=off rdnss=2001:db8::53\n"), nil)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "ipv6 lan2 rtadv"`).Return([]byte(""), nil)
+
+	service := NewIPv6RTADVService(mockExecutor, nil)
+
+	rtadv, err := service.Get(context.Background(), "lan1")
+	assert.NoError(t, err)
+	assert.Equal(t, &IPv6RTADV{Interface: "lan1", PrefixIDs: []int{1}, OFlag: true, RDNSS: []string{"2001:db8::53"}}, rtadv)
+
+	_, err = service.Get(context.Background(), "lan2")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestIPv6RTADVService_Configure(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "ipv6 lan1 rtadv send 1 o_flag=on m_flag=off max-rtr-adv-interval=300 dnssl=example.com").Return([]byte(""), nil)
+
+	service := NewIPv6RTADVService(mockExecutor, nil)
+
+	err := service.Configure(context.Background(), IPv6RTADV{Interface: "lan1", PrefixIDs: []int{1}, OFlag: true, MaxInterval: 300, DNSSL: []string{"example.com"}})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+
+	err = service.Configure(context.Background(), IPv6RTADV{Interface: "lan1"})
+	assert.ErrorContains(t, err, "invalid IPv6 RTADV")
+}
+
+func TestIPv6RTADVService_Delete(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "no ipv6 lan1 rtadv send").Return([]byte(""), nil)
+
+	service := NewIPv6RTADVService(mockExecutor, nil)
+
+	err := service.Delete(context.Background(), "lan1")
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+// jumpHostClientConfig builds the SSH client configuration for the jump host.
+// Authentication follows the same priority as the router connection (explicit key,
+// SSH agent, password), and the host key is verified with the jump host's
+// own host key settings.
+func jumpHostClientConfig(jump *JumpHostConfig, timeout time.Duration) *ssh.ClientConfig {
+	jumpConfig := &Config{
+		Host:                 jump.Host,
+		Port:                 jump.Port,
+		Username:             jump.Username,
+		Password:             jump.Password,
+		PrivateKey:           jump.PrivateKey,
+		PrivateKeyFile:       jump.PrivateKeyFile,
+		PrivateKeyPassphrase: jump.PrivateKeyPassphrase,
+		Certificate:          jump.Certificate,
+		CertificateFile:      jump.CertificateFile,
+		SSHAgentSocket:       jump.SSHAgentSocket,
+		DisableSSHAgent:      jump.DisableSSHAgent,
+		HostKey:              jump.HostKey,
+		HostKeyFingerprint:   jump.HostKeyFingerprint,
+		KnownHostsFile:       jump.KnownHostsFile,
+		SkipHostKeyCheck:     jump.SkipHostKeyCheck,
+		HostKeyWarnOnly:      jump.HostKeyWarnOnly,
+	}
+
+	d := &sshDialer{}
+	return &ssh.ClientConfig{
+		User:            jump.Username,
+		Auth:            d.buildAuthMethods(jumpConfig),
+		HostKeyCallback: d.getHostKeyCallback(jumpConfig),
+		Timeout:         timeout,
+	}
+}
+
+// jumpHostAddr returns the address of the jump host, defaulting to port 22
+func jumpHostAddr(jump *JumpHostConfig) string {
+	port := jump.Port
+	if port == 0 {
+		port = 22
+	}
+	return net.JoinHostPort(jump.Host, fmt.Sprintf("%d", port))
+}
+
+// dialThroughJumpHost connects to the jump host, opens a TCP tunnel to addr and
+// performs the SSH handshake with the router over that tunnel. The jump host
+// connection is closed when the returned client is closed.
+func dialThroughJumpHost(ctx context.Context, jump *JumpHostConfig, addr string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	logger := logging.FromContext(ctx)
+	jumpAddr := jumpHostAddr(jump)
+
+	logger.Debug().Str("jump_host", jumpAddr).Str("addr", addr).Msg("Dialing SSH through jump host")
+
+	d := &net.Dialer{Timeout: config.Timeout}
+	jumpConn, err := d.DialContext(ctx, "tcp", jumpAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial jump host %s: %w", jumpAddr, err)
+	}
+
+	c, chans, reqs, err := ssh.NewClientConn(jumpConn, jumpAddr, jumpHostClientConfig(jump, config.Timeout))
+	if err != nil {
+		_ = jumpConn.Close()
+		return nil, fmt.Errorf("SSH handshake with jump host %s failed: %w", jumpAddr, err)
+	}
+	jumpClient := ssh.NewClient(c, chans, reqs)
+
+	conn, err := jumpClient.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		_ = jumpClient.Close()
+		return nil, fmt.Errorf("jump host %s failed to connect to %s: %w", jumpAddr, addr, err)
+	}
+
+	tc, tchans, treqs, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		_ = conn.Close()
+		_ = jumpClient.Close()
+		return nil, fmt.Errorf("SSH handshake failed (addr: %s via %s): %w", addr, jumpAddr, err)
+	}
+	client := ssh.NewClient(tc, tchans, treqs)
+
+	// Tear down the jump host connection together with the router connection
+	go func() {
+		_ = client.Wait()
+		_ = jumpClient.Close()
+	}()
+
+	return client, nil
+}
+
+// dialSSH opens an SSH connection to addr, through the jump host when one is
+// configured. Unlike DialContext, the connection is not tied to ctx, so it can
+// outlive the request that created it (e.g., pooled connections).
+func dialSSH(ctx context.Context, jump *JumpHostConfig, addr string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	if jump == nil {
+		return ssh.Dial("tcp", addr, config)
+	}
+	return dialThroughJumpHost(ctx, jump, addr, config)
+}
+
+// dialSSHContext is like dialSSH but closes the connection when ctx is done
+func dialSSHContext(ctx context.Context, jump *JumpHostConfig, addr string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	if jump == nil {
+		return DialContext(ctx, "tcp", addr, config)
+	}
+
+	client, err := dialThroughJumpHost(ctx, jump, addr, config)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		_ = client.Close()
+	}()
+	return client, nil
+}
@@ -0,0 +1,208 @@
+package client
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// startTestSSHServer starts an SSH server that accepts the given password and
+// forwards direct-tcpip channels, so it can act both as jump host and as target
+func startTestSSHServer(t *testing.T, password string) (addr string, port int) {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate host key: %v", err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+
+	serverConfig := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			if string(pass) == password {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("password rejected for %s", c.User())
+		},
+	}
+	serverConfig.AddHostKey(signer)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestSSHConn(conn, serverConfig)
+		}
+	}()
+
+	return ln.Addr().String(), ln.Addr().(*net.TCPAddr).Port
+}
+
+func serveTestSSHConn(conn net.Conn, config *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "direct-tcpip" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "only direct-tcpip is supported")
+			continue
+		}
+
+		var target struct {
+			Host     string
+			Port     uint32
+			OrigHost string
+			OrigPort uint32
+		}
+		if err := ssh.Unmarshal(newChannel.ExtraData(), &target); err != nil {
+			_ = newChannel.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+
+		upstream, err := net.Dial("tcp", net.JoinHostPort(target.Host, strconv.Itoa(int(target.Port))))
+		if err != nil {
+			_ = newChannel.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+
+		channel, channelReqs, err := newChannel.Accept()
+		if err != nil {
+			_ = upstream.Close()
+			continue
+		}
+		go ssh.DiscardRequests(channelReqs)
+		go func() {
+			_, _ = io.Copy(channel, upstream)
+			_ = channel.Close()
+		}()
+		go func() {
+			_, _ = io.Copy(upstream, channel)
+			_ = upstream.Close()
+		}()
+	}
+}
+
+func TestDialSSH_JumpHost(t *testing.T) {
+	t.Setenv("SSH_AUTH_SOCK", "")
+
+	jumpAddr, jumpPort := startTestSSHServer(t, "jump-secret")
+	targetAddr, _ := startTestSSHServer(t, "router-secret")
+
+	targetConfig := &ssh.ClientConfig{
+		User:            "admin",
+		Auth:            []ssh.AuthMethod{ssh.Password("router-secret")},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         5 * time.Second,
+	}
+
+	tests := []struct {
+		name            string
+		jump            *JumpHostConfig
+		wantErrContains string
+	}{
+		{
+			name: "connects through jump host",
+			jump: &JumpHostConfig{
+				Host:             "127.0.0.1",
+				Port:             jumpPort,
+				Username:         "bastion",
+				Password:         "jump-secret",
+				SkipHostKeyCheck: true,
+			},
+		},
+		{
+			name: "jump host authentication failure",
+			jump: &JumpHostConfig{
+				Host:             "127.0.0.1",
+				Port:             jumpPort,
+				Username:         "bastion",
+				Password:         "wrong",
+				SkipHostKeyCheck: true,
+			},
+			wantErrContains: "jump host " + jumpAddr,
+		},
+		{
+			name: "jump host host key mismatch",
+			jump: &JumpHostConfig{
+				Host:     "127.0.0.1",
+				Port:     jumpPort,
+				Username: "bastion",
+				Password: "jump-secret",
+				HostKey:  "AAAAC3NzaC1lZDI1NTE5AAAAIE2pCsJ8fH0I7Zx8H6a0u6dDqk0E0NqvFJ0x3xS5b6mQ",
+			},
+			wantErrContains: "host key mismatch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			client, err := dialSSH(ctx, tt.jump, targetAddr, targetConfig)
+			if tt.wantErrContains != "" {
+				if err == nil {
+					_ = client.Close()
+					t.Fatal("dialSSH() expected error, got nil")
+				}
+				if !strings.Contains(err.Error(), tt.wantErrContains) {
+					t.Errorf("dialSSH() error = %v, want error containing %q", err, tt.wantErrContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("dialSSH() unexpected error: %v", err)
+			}
+			if got := client.User(); got != "admin" {
+				t.Errorf("client.User() = %q, want %q", got, "admin")
+			}
+			if err := client.Close(); err != nil {
+				t.Errorf("client.Close() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestJumpHostAddr(t *testing.T) {
+	tests := []struct {
+		name string
+		jump *JumpHostConfig
+		want string
+	}{
+		{name: "default port", jump: &JumpHostConfig{Host: "bastion.example.com"}, want: "bastion.example.com:22"},
+		{name: "custom port", jump: &JumpHostConfig{Host: "10.0.0.1", Port: 2222}, want: "10.0.0.1:2222"},
+		{name: "ipv6", jump: &JumpHostConfig{Host: "2001:db8::1", Port: 22}, want: "[2001:db8::1]:22"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jumpHostAddr(tt.jump); got != tt.want {
+				t.Errorf("jumpHostAddr() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
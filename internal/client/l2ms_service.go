@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// L2MSService handles L2MS ("switch control") operations for managed Yamaha switches
+type L2MSService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewL2MSService creates a new L2MS service instance
+func NewL2MSService(executor Executor, client *rtxClient) *L2MSService {
+	return &L2MSService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the settings of a switch managed through an interface
+func (s *L2MSService) Get(ctx context.Context, iface, sw string) (*L2MSManagedSwitch, error) {
+	cmd := parsers.BuildShowL2MSConfigCommand()
+	logging.FromContext(ctx).Debug().Str("service", "l2ms").Msgf("Getting L2MS configuration with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L2MS configuration: %w", err)
+	}
+
+	parsed := parsers.FindL2MSManagedSwitch(string(output), iface, sw)
+	if parsed == nil {
+		return nil, fmt.Errorf("L2MS manager on %s not found", iface)
+	}
+
+	result := s.fromParserSwitch(*parsed)
+	return &result, nil
+}
+
+// ListSwitches retrieves the switches discovered by the L2MS manager
+func (s *L2MSService) ListSwitches(ctx context.Context) ([]L2MSSwitchStatus, error) {
+	cmd := parsers.BuildShowL2MSStatusCommand()
+	logging.FromContext(ctx).Debug().Str("service", "l2ms").Msgf("Listing L2MS switches with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list L2MS switches: %w", err)
+	}
+
+	parsed := parsers.ParseL2MSSwitchStatus(string(output))
+	switches := make([]L2MSSwitchStatus, len(parsed))
+	for i, p := range parsed {
+		switches[i] = L2MSSwitchStatus(p)
+	}
+	return switches, nil
+}
+
+// Create enables the L2MS manager on the interface and pushes the switch settings
+func (s *L2MSService) Create(ctx context.Context, sw L2MSManagedSwitch) error {
+	parserSwitch := s.toParserSwitch(sw)
+	if err := parsers.ValidateL2MSManagedSwitch(parserSwitch); err != nil {
+		return fmt.Errorf("invalid L2MS managed switch: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := append([]string{parsers.BuildL2MSControlUseCommand(sw.Interface)}, parsers.BuildL2MSSwitchCommands(parserSwitch)...)
+	logging.FromContext(ctx).Debug().Str("service", "l2ms").Msgf("Creating L2MS managed switch with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to configure switch %s: %w", sw.Switch, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("L2MS managed switch %s created", sw.Switch))
+}
+
+// Update replaces the settings of a managed switch. The current settings are
+// reset to their defaults before the new ones are pushed.
+func (s *L2MSService) Update(ctx context.Context, sw L2MSManagedSwitch) error {
+	parserSwitch := s.toParserSwitch(sw)
+	if err := parsers.ValidateL2MSManagedSwitch(parserSwitch); err != nil {
+		return fmt.Errorf("invalid L2MS managed switch: %w", err)
+	}
+
+	commands := []string{parsers.BuildL2MSControlUseCommand(sw.Interface)}
+	current, err := s.Get(ctx, sw.Interface, sw.Switch)
+	if err != nil && !strings.Contains(err.Error(), "not found") {
+		return fmt.Errorf("failed to get current L2MS configuration: %w", err)
+	}
+	if current != nil {
+		commands = append(commands, parsers.BuildDeleteL2MSSwitchCommands(s.toParserSwitch(*current))...)
+	}
+	commands = append(commands, parsers.BuildL2MSSwitchCommands(parserSwitch)...)
+
+	logging.FromContext(ctx).Debug().Str("service", "l2ms").Msgf("Updating L2MS managed switch with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to update switch %s: %w", sw.Switch, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("L2MS managed switch %s updated", sw.Switch))
+}
+
+// Delete resets the settings of a managed switch to their defaults. The L2MS
+// manager stays enabled because other switches on the interface may rely on it.
+func (s *L2MSService) Delete(ctx context.Context, iface, sw string) error {
+	current, err := s.Get(ctx, iface, sw)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil
+		}
+		return fmt.Errorf("failed to get current L2MS configuration: %w", err)
+	}
+
+	commands := parsers.BuildDeleteL2MSSwitchCommands(s.toParserSwitch(*current))
+	logging.FromContext(ctx).Debug().Str("service", "l2ms").Msgf("Deleting L2MS managed switch with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to reset switch %s: %w", sw, err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to reset L2MS managed switch"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("L2MS managed switch %s deleted", sw))
+}
+
+// toParserSwitch converts a client L2MSManagedSwitch to the parser representation
+func (s *L2MSService) toParserSwitch(sw L2MSManagedSwitch) parsers.L2MSManagedSwitch {
+	ports := make([]parsers.L2MSSwitchPort, len(sw.Ports))
+	for i, p := range sw.Ports {
+		ports[i] = parsers.L2MSSwitchPort(p)
+	}
+	return parsers.L2MSManagedSwitch{
+		Interface:  sw.Interface,
+		Switch:     sw.Switch,
+		SystemName: sw.SystemName,
+		Ports:      ports,
+	}
+}
+
+// fromParserSwitch converts a parser L2MSManagedSwitch to the client representation
+func (s *L2MSService) fromParserSwitch(sw parsers.L2MSManagedSwitch) L2MSManagedSwitch {
+	ports := make([]L2MSSwitchPort, len(sw.Ports))
+	for i, p := range sw.Ports {
+		ports[i] = L2MSSwitchPort(p)
+	}
+	return L2MSManagedSwitch{
+		Interface:  sw.Interface,
+		Switch:     sw.Switch,
+		SystemName: sw.SystemName,
+		Ports:      ports,
+	}
+}
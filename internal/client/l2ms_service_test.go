@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testL2MSConfig = `switch control use lan1 on
+switch select 00:a0:de:01:02:03
+ switch control function set system-name floor1
+ switch control function set port-use 3 off
+switch select none
+`
+
+func TestL2MSService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "switch "`).Return([]byte(testL2MSConfig), nil)
+
+	service := NewL2MSService(mockExecutor, nil)
+
+	sw, err := service.Get(context.Background(), "lan1", "00:a0:de:01:02:03")
+	assert.NoError(t, err)
+	assert.Equal(t, &L2MSManagedSwitch{
+		Interface:  "lan1",
+		Switch:     "00:a0:de:01:02:03",
+		SystemName: "floor1",
+		Ports:      []L2MSSwitchPort{{Port: 3, Enabled: false}},
+	}, sw)
+
+	_, err = service.Get(context.Background(), "lan2", "00:a0:de:01:02:03")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestL2MSService_Create(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"switch control use lan1 on",
+		"switch select lan1:1",
+		"switch control function set port-access-vlan 2 10",
+		"switch select none",
+	}).Return([]byte(""), nil)
+
+	service := NewL2MSService(mockExecutor, nil)
+
+	err := service.Create(context.Background(), L2MSManagedSwitch{
+		Interface: "lan1",
+		Switch:    "lan1:1",
+		Ports:     []L2MSSwitchPort{{Port: 2, Enabled: true, AccessVLAN: 10}},
+	})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+
+	err = service.Create(context.Background(), L2MSManagedSwitch{Interface: "pp1", Switch: "lan1:1"})
+	assert.ErrorContains(t, err, "invalid L2MS managed switch")
+}
+
+func TestL2MSService_Update(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "switch "`).Return([]byte(testL2MSConfig), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"switch control use lan1 on",
+		"switch select 00:a0:de:01:02:03",
+		"no switch control function set system-name",
+		"no switch control function set port-use 3",
+		"switch select none",
+		"switch select 00:a0:de:01:02:03",
+		"switch control function set system-name floor2",
+		"switch select none",
+	}).Return([]byte(""), nil)
+
+	service := NewL2MSService(mockExecutor, nil)
+
+	err := service.Update(context.Background(), L2MSManagedSwitch{Interface: "lan1", Switch: "00:a0:de:01:02:03", SystemName: "floor2"})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestL2MSService_ListSwitches(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show status switch control").Return([]byte("Switch: 00:a0:de:01:02:03\n  Model: SWX2200-8G\n  Route: lan1:1\n"), nil)
+
+	service := NewL2MSService(mockExecutor, nil)
+
+	switches, err := service.ListSwitches(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []L2MSSwitchStatus{{MACAddress: "00:a0:de:01:02:03", Model: "SWX2200-8G", Route: "lan1:1"}}, switches)
+}
@@ -220,11 +220,10 @@ func (s *L2TPService) Update(ctx context.Context, config L2TPConfig) error {
 			commands = append(commands, "no pp auth myname")
 		}
 
+		// Leave the pool untouched when not specified so it can be managed
+		// separately by rtx_vpn_address_pool without being reset on update
 		if config.IPPool != nil && config.IPPool.Start != "" && config.IPPool.End != "" {
 			commands = append(commands, parsers.BuildIPPPRemotePoolCommand(config.IPPool.Start, config.IPPool.End))
-		} else {
-			// Use dhcp for ip pool if not specified
-			commands = append(commands, parsers.BuildIPPPRemotePoolDHCPCommand())
 		}
 	} else if config.Version == "l2tpv3" {
 		commands = append(commands, fmt.Sprintf("tunnel select %d", config.ID))
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// L2TPSessionService handles connected L2TP session queries ("show status l2tp")
+type L2TPSessionService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewL2TPSessionService creates a new L2TP session service instance
+func NewL2TPSessionService(executor Executor, client *rtxClient) *L2TPSessionService {
+	return &L2TPSessionService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// List retrieves the connected L2TP sessions, optionally limited to one user
+func (s *L2TPSessionService) List(ctx context.Context, username string) ([]L2TPSession, error) {
+	cmd := parsers.BuildShowL2TPStatusCommand()
+	logging.FromContext(ctx).Debug().Str("service", "l2tp_session").Msgf("Listing L2TP sessions with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L2TP status: %w", err)
+	}
+	if line := rejectionLine(string(output)); line != "" {
+		return nil, fmt.Errorf("failed to get L2TP status: %s", line)
+	}
+
+	sessions := []L2TPSession{}
+	for _, session := range parsers.ParseL2TPSessions(string(output)) {
+		if username != "" && session.Username != username {
+			continue
+		}
+		sessions = append(sessions, L2TPSession(session))
+	}
+	return sessions, nil
+}
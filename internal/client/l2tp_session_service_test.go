@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestL2TPSessionService_List(t *testing.T) {
+	output := `TUNNEL[1]:
+  Version: L2TPv2
+  Remote IP address: 198.51.100.10
+  SESSION[1]:
+    Session state: established
+    Local session ID: 345
+    Username: alice
+    Assigned IP address: 192.168.100.200
+    Time connected: 0:01:00
+TUNNEL[2]:
+  Version: L2TPv2
+  Remote IP address: 198.51.100.20
+  SESSION[1]:
+    Session state: established
+    Local session ID: 346
+    Username: bob
+    Assigned IP address: 192.168.100.201
+    Time connected: 0:00:30
+`
+
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show status l2tp").Return([]byte(output), nil)
+
+	service := NewL2TPSessionService(mockExecutor, nil)
+
+	all, err := service.List(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	sessions, err := service.List(context.Background(), "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, []L2TPSession{
+		{TunnelID: 2, SessionID: 346, State: "established", Username: "bob", AssignedAddress: "192.168.100.201", RemoteAddress: "198.51.100.20", Version: "L2TPv2", UptimeSeconds: 30},
+	}, sessions)
+}
+
+func TestL2TPSessionService_List_Rejections(t *testing.T) {
+	t.Run("rejected command", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status l2tp").Return([]byte("Error: Invalid parameter\n"), nil)
+
+		service := NewL2TPSessionService(mockExecutor, nil)
+
+		_, err := service.List(context.Background(), "")
+		assert.ErrorContains(t, err, "Error: Invalid parameter")
+	})
+
+	t.Run("status labels are not errors", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status l2tp").Return([]byte(`TUNNEL[1]:
+  Version: L2TPv2
+  Remote IP address: 198.51.100.10
+  Last error: none
+  SESSION[1]:
+    Session state: established
+    Local session ID: 345
+    Username: alice
+`), nil)
+
+		service := NewL2TPSessionService(mockExecutor, nil)
+
+		sessions, err := service.List(context.Background(), "")
+		assert.NoError(t, err)
+		assert.Len(t, sessions, 1)
+	})
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// LinkAggregationService handles "lan link-aggregation" operations
+type LinkAggregationService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewLinkAggregationService creates a new link aggregation service instance
+func NewLinkAggregationService(executor Executor, client *rtxClient) *LinkAggregationService {
+	return &LinkAggregationService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves a link aggregation group
+func (s *LinkAggregationService) Get(ctx context.Context, id int) (*LinkAggregation, error) {
+	groups, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lag := range groups {
+		if lag.ID == id {
+			return &lag, nil
+		}
+	}
+
+	return nil, fmt.Errorf("link aggregation %d not found", id)
+}
+
+// List retrieves all link aggregation groups
+func (s *LinkAggregationService) List(ctx context.Context) ([]LinkAggregation, error) {
+	cmd := parsers.BuildShowLinkAggregationsCommand()
+	logging.FromContext(ctx).Debug().Str("service", "link_aggregation").Msgf("Listing link aggregations with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list link aggregations: %w", err)
+	}
+
+	parsed := parsers.ParseLinkAggregations(string(output))
+	groups := make([]LinkAggregation, len(parsed))
+	for i, p := range parsed {
+		groups[i] = LinkAggregation(p)
+	}
+	return groups, nil
+}
+
+// Create adds a new link aggregation group
+func (s *LinkAggregationService) Create(ctx context.Context, lag LinkAggregation) error {
+	if err := s.validate(ctx, lag); err != nil {
+		return err
+	}
+
+	cmd := parsers.BuildLinkAggregationCommand(parsers.LinkAggregation(lag))
+	logging.FromContext(ctx).Debug().Str("service", "link_aggregation").Msgf("Creating link aggregation with command: %s", cmd)
+
+	if err := runCommand(ctx, s.executor, cmd); err != nil {
+		return fmt.Errorf("failed to create link aggregation %d: %w", lag.ID, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("link aggregation %d created", lag.ID))
+}
+
+// Update changes the mode or members of a link aggregation group. The group
+// is removed with its current mode before it is recreated.
+func (s *LinkAggregationService) Update(ctx context.Context, lag LinkAggregation) error {
+	if err := s.validate(ctx, lag); err != nil {
+		return err
+	}
+
+	commands := []string{}
+	current, err := s.Get(ctx, lag.ID)
+	if err != nil && !strings.Contains(err.Error(), "not found") {
+		return fmt.Errorf("failed to get current link aggregation: %w", err)
+	}
+	if current != nil {
+		commands = append(commands, parsers.BuildDeleteLinkAggregationCommand(current.Mode, current.ID))
+	}
+	commands = append(commands, parsers.BuildLinkAggregationCommand(parsers.LinkAggregation(lag)))
+
+	logging.FromContext(ctx).Debug().Str("service", "link_aggregation").Msgf("Updating link aggregation with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to update link aggregation %d: %w", lag.ID, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("link aggregation %d updated", lag.ID))
+}
+
+// Delete removes a link aggregation group
+func (s *LinkAggregationService) Delete(ctx context.Context, id int) error {
+	current, err := s.Get(ctx, id)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil
+		}
+		return fmt.Errorf("failed to get current link aggregation: %w", err)
+	}
+
+	cmd := parsers.BuildDeleteLinkAggregationCommand(current.Mode, id)
+	logging.FromContext(ctx).Debug().Str("service", "link_aggregation").Msgf("Deleting link aggregation with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return fmt.Errorf("failed to delete link aggregation %d: %w", id, err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete link aggregation"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("link aggregation %d deleted", id))
+}
+
+// validate checks the group itself and, when the router model can be
+// detected, that the model supports link aggregation and has the member ports
+func (s *LinkAggregationService) validate(ctx context.Context, lag LinkAggregation) error {
+	parserLAG := parsers.LinkAggregation(lag)
+	if err := parsers.ValidateLinkAggregation(parserLAG); err != nil {
+		return fmt.Errorf("invalid link aggregation: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	output, err := s.executor.Run(ctx, "show environment")
+	if err != nil {
+		return fmt.Errorf("failed to detect router model: %w", err)
+	}
+
+	model := parseSystemInfo(string(output)).Model
+	if model == "" {
+		logging.FromContext(ctx).Warn().Str("service", "link_aggregation").Msg("Could not detect router model, skipping member port validation")
+		return nil
+	}
+
+	if err := parsers.ValidateLinkAggregationForModel(parserLAG, model); err != nil {
+		return fmt.Errorf("invalid link aggregation: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testLinkAggregationConfig = `lan link-aggregation static 1 lan2 lan3
+`
+
+const testRTX1300Environment = `RTX1300 Rev.23.00.05 (Tue Jan 16 10:00:00 2024)
+main:  RTX1300 ver=00 serial=S5A000001 MAC-Address=00:a0:de:00:00:01
+`
+
+func TestLinkAggregationService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "lan link-aggregation"`).Return([]byte(testLinkAggregationConfig), nil)
+
+	service := NewLinkAggregationService(mockExecutor, nil)
+
+	lag, err := service.Get(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, &LinkAggregation{ID: 1, Mode: "static", Members: []string{"lan2", "lan3"}}, lag)
+
+	_, err = service.Get(context.Background(), 2)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestLinkAggregationService_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     string
+		lag     LinkAggregation
+		wantCmd string
+		wantErr string
+	}{
+		{
+			name:    "member ports exist on model",
+			env:     testRTX1300Environment,
+			lag:     LinkAggregation{ID: 1, Mode: "lacp", Members: []string{"lan2", "lan3"}},
+			wantCmd: "lan link-aggregation lacp 1 lan2 lan3",
+		},
+		{
+			name:    "member port missing on model",
+			env:     testRTX1300Environment,
+			lag:     LinkAggregation{ID: 1, Mode: "static", Members: []string{"lan3", "lan4"}},
+			wantErr: "lan4 does not exist on RTX1300",
+		},
+		{
+			name:    "unsupported model",
+			env:     "RTX830 Rev.15.02.30 (Mon Oct 2 10:00:00 2023)\n",
+			lag:     LinkAggregation{ID: 1, Mode: "static", Members: []string{"lan1", "lan2"}},
+			wantErr: "not supported on RTX830",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExecutor := new(MockExecutor)
+			mockExecutor.On("Run", mock.Anything, "show environment").Return([]byte(tt.env), nil)
+			if tt.wantCmd != "" {
+				mockExecutor.On("Run", mock.Anything, tt.wantCmd).Return([]byte(""), nil)
+			}
+
+			service := NewLinkAggregationService(mockExecutor, nil)
+			err := service.Create(context.Background(), tt.lag)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			mockExecutor.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLinkAggregationService_Update(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show environment").Return([]byte(testRTX1300Environment), nil)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "lan link-aggregation"`).Return([]byte(testLinkAggregationConfig), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no lan link-aggregation static 1",
+		"lan link-aggregation lacp 1 lan1 lan2 lan3",
+	}).Return([]byte(""), nil)
+
+	service := NewLinkAggregationService(mockExecutor, nil)
+
+	err := service.Update(context.Background(), LinkAggregation{ID: 1, Mode: "lacp", Members: []string{"lan1", "lan2", "lan3"}})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestLinkAggregationService_Delete(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "lan link-aggregation"`).Return([]byte(testLinkAggregationConfig), nil)
+	mockExecutor.On("Run", mock.Anything, "no lan link-aggregation static 1").Return([]byte(""), nil)
+
+	service := NewLinkAggregationService(mockExecutor, nil)
+
+	assert.NoError(t, service.Delete(context.Background(), 1))
+	// Deleting a group that does not exist is a no-op
+	assert.NoError(t, service.Delete(context.Background(), 2))
+	mockExecutor.AssertExpectations(t)
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// LLDPService handles LLDP ("lldp") operations
+type LLDPService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewLLDPService creates a new LLDP service instance
+func NewLLDPService(executor Executor, client *rtxClient) *LLDPService {
+	return &LLDPService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the LLDP settings
+func (s *LLDPService) Get(ctx context.Context) (*LLDPConfig, error) {
+	cmd := parsers.BuildShowLLDPConfigCommand()
+	logging.FromContext(ctx).Debug().Str("service", "lldp").Msgf("Getting LLDP settings with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get LLDP settings: %w", err)
+	}
+
+	config := s.fromParserConfig(*parsers.ParseLLDPConfig(string(output)))
+	return &config, nil
+}
+
+// Configure applies the LLDP settings
+func (s *LLDPService) Configure(ctx context.Context, config LLDPConfig) error {
+	return s.apply(ctx, config, nil)
+}
+
+// Update applies the LLDP settings and restores the default mode of
+// interfaces that are no longer configured
+func (s *LLDPService) Update(ctx context.Context, config LLDPConfig) error {
+	current, err := s.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]bool, len(config.Ports))
+	for _, port := range config.Ports {
+		wanted[port.Interface] = true
+	}
+
+	var removals []string
+	for _, port := range current.Ports {
+		if !wanted[port.Interface] {
+			removals = append(removals, parsers.BuildDeleteLLDPPortCommand(port.Interface))
+		}
+	}
+
+	return s.apply(ctx, config, removals)
+}
+
+// apply validates the settings and writes them after the given removal commands
+func (s *LLDPService) apply(ctx context.Context, config LLDPConfig, removals []string) error {
+	parserConfig := s.toParserConfig(config)
+	if err := parsers.ValidateLLDPConfig(parserConfig); err != nil {
+		return fmt.Errorf("invalid LLDP configuration: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := append(removals, parsers.BuildLLDPCommands(parserConfig)...)
+	logging.FromContext(ctx).Debug().Str("service", "lldp").Msgf("Configuring LLDP with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to configure LLDP: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "LLDP configured")
+}
+
+// Reset disables LLDP and restores the default mode of all interfaces
+func (s *LLDPService) Reset(ctx context.Context) error {
+	current, err := s.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	commands := parsers.BuildDeleteLLDPCommands(s.toParserConfig(*current))
+	logging.FromContext(ctx).Debug().Str("service", "lldp").Msgf("Resetting LLDP with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to reset LLDP: %w", err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to reset LLDP"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, "LLDP reset")
+}
+
+// toParserLLDPConfig converts a client LLDPConfig to the parser representation
+func (s *LLDPService) toParserConfig(config LLDPConfig) parsers.LLDPConfig {
+	ports := make([]parsers.LLDPPort, len(config.Ports))
+	for i, port := range config.Ports {
+		ports[i] = parsers.LLDPPort(port)
+	}
+	return parsers.LLDPConfig{
+		Enabled: config.Enabled,
+		Ports:   ports,
+	}
+}
+
+// fromParserConfig converts a parser LLDPConfig to the client representation
+func (s *LLDPService) fromParserConfig(config parsers.LLDPConfig) LLDPConfig {
+	ports := make([]LLDPPort, len(config.Ports))
+	for i, port := range config.Ports {
+		ports[i] = LLDPPort(port)
+	}
+	return LLDPConfig{
+		Enabled: config.Enabled,
+		Ports:   ports,
+	}
+}
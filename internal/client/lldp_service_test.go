@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testLLDPConfig = `lldp use on
+lldp agent lan1 mode txrx
+lldp agent lan2 mode rx
+`
+
+func TestLLDPService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "lldp "`).Return([]byte(testLLDPConfig), nil)
+
+	service := NewLLDPService(mockExecutor, nil)
+
+	config, err := service.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &LLDPConfig{
+		Enabled: true,
+		Ports: []LLDPPort{
+			{Interface: "lan1", Mode: "txrx"},
+			{Interface: "lan2", Mode: "rx"},
+		},
+	}, config)
+}
+
+func TestLLDPService_Update(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "lldp "`).Return([]byte(testLLDPConfig), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no lldp agent lan2 mode",
+		"lldp use on",
+		"lldp agent lan1 mode tx",
+	}).Return([]byte(""), nil)
+
+	service := NewLLDPService(mockExecutor, nil)
+
+	err := service.Update(context.Background(), LLDPConfig{
+		Enabled: true,
+		Ports:   []LLDPPort{{Interface: "lan1", Mode: "tx"}},
+	})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestLLDPService_Reset(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "lldp "`).Return([]byte(testLLDPConfig), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no lldp agent lan1 mode",
+		"no lldp agent lan2 mode",
+		"no lldp use",
+	}).Return([]byte(""), nil)
+
+	service := NewLLDPService(mockExecutor, nil)
+
+	err := service.Reset(context.Background())
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
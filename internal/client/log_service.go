@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// LogService reads the router's internal log ("show log")
+type LogService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewLogService creates a new log service instance
+func NewLogService(executor Executor, client *rtxClient) *LogService {
+	return &LogService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// List retrieves log entries containing the keyword (all entries if empty),
+// limited to the last tail entries when tail is positive
+func (s *LogService) List(ctx context.Context, keyword string, tail int) ([]LogEntry, error) {
+	if err := parsers.ValidateConfigGrepPattern(keyword); err != nil {
+		return nil, err
+	}
+
+	cmd := parsers.BuildShowLogCommand(keyword)
+	logging.FromContext(ctx).Debug().Str("service", "log").Msgf("Reading log with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read log: %w", err)
+	}
+
+	parsed := parsers.TailLogEntries(parsers.ParseLogEntries(string(output)), tail)
+	entries := make([]LogEntry, len(parsed))
+	for i, p := range parsed {
+		entries[i] = LogEntry(p)
+	}
+	return entries, nil
+}
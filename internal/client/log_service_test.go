@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLogService_List(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show log | grep "PP\[01\]"`).Return([]byte(`2026/10/16 07:49:49: PP[01] PPPoE Connect
+2026/10/16 07:49:50: PP[01] IPCP Opened
+`), nil)
+
+	service := NewLogService(mockExecutor, nil)
+
+	entries, err := service.List(context.Background(), `PP\[01\]`, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []LogEntry{{Timestamp: "2026/10/16 07:49:50", Message: "PP[01] IPCP Opened"}}, entries)
+
+	_, err = service.List(context.Background(), "a|b", 0)
+	assert.Error(t, err)
+}
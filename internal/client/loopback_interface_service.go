@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// LoopbackInterfaceService handles "ip loopbackN address" operations
+type LoopbackInterfaceService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewLoopbackInterfaceService creates a new loopback interface service instance
+func NewLoopbackInterfaceService(executor Executor, client *rtxClient) *LoopbackInterfaceService {
+	return &LoopbackInterfaceService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the address of a loopback interface
+func (s *LoopbackInterfaceService) Get(ctx context.Context, name string) (*LoopbackInterface, error) {
+	loopbacks, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, loopback := range loopbacks {
+		if loopback.Name == name {
+			return &loopback, nil
+		}
+	}
+
+	return nil, fmt.Errorf("loopback interface %s not found", name)
+}
+
+// List retrieves all configured loopback interfaces
+func (s *LoopbackInterfaceService) List(ctx context.Context) ([]LoopbackInterface, error) {
+	cmd := parsers.BuildShowLoopbackInterfacesCommand()
+	logging.FromContext(ctx).Debug().Str("service", "loopback_interface").Msgf("Listing loopback interfaces with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list loopback interfaces: %w", err)
+	}
+
+	parsed := parsers.ParseLoopbackInterfaces(string(output))
+	loopbacks := make([]LoopbackInterface, len(parsed))
+	for i, p := range parsed {
+		loopbacks[i] = LoopbackInterface(p)
+	}
+	return loopbacks, nil
+}
+
+// Create assigns an address to a loopback interface
+func (s *LoopbackInterfaceService) Create(ctx context.Context, loopback LoopbackInterface) error {
+	return s.apply(ctx, loopback, "created")
+}
+
+// Update changes the address of a loopback interface. The command replaces
+// the previous address, so no delete is needed.
+func (s *LoopbackInterfaceService) Update(ctx context.Context, loopback LoopbackInterface) error {
+	return s.apply(ctx, loopback, "updated")
+}
+
+// apply validates and writes the loopback interface address
+func (s *LoopbackInterfaceService) apply(ctx context.Context, loopback LoopbackInterface, action string) error {
+	parserLoopback := parsers.LoopbackInterface(loopback)
+	if err := parsers.ValidateLoopbackInterface(parserLoopback); err != nil {
+		return fmt.Errorf("invalid loopback interface: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	cmd := parsers.BuildLoopbackAddressCommand(parserLoopback)
+	logging.FromContext(ctx).Debug().Str("service", "loopback_interface").Msgf("Applying loopback interface with command: %s", cmd)
+
+	if err := runCommand(ctx, s.executor, cmd); err != nil {
+		return fmt.Errorf("failed to configure %s: %w", loopback.Name, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("loopback interface %s %s", loopback.Name, action))
+}
+
+// Delete removes the address of a loopback interface
+func (s *LoopbackInterfaceService) Delete(ctx context.Context, name string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	cmd := parsers.BuildDeleteLoopbackAddressCommand(name)
+	logging.FromContext(ctx).Debug().Str("service", "loopback_interface").Msgf("Deleting loopback interface with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return fmt.Errorf("failed to delete %s address: %w", name, err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete loopback interface"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("loopback interface %s deleted", name))
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testLoopbackInterfaceConfig = `ip loopback1 address 10.255.0.1/32
+`
+
+func TestLoopbackInterfaceService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "ip loopback"`).Return([]byte(testLoopbackInterfaceConfig), nil)
+
+	service := NewLoopbackInterfaceService(mockExecutor, nil)
+
+	loopback, err := service.Get(context.Background(), "loopback1")
+	assert.NoError(t, err)
+	assert.Equal(t, &LoopbackInterface{Name: "loopback1", Address: "10.255.0.1/32"}, loopback)
+
+	_, err = service.Get(context.Background(), "loopback2")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestLoopbackInterfaceService_Create(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "ip loopback1 address 10.255.0.1/32").Return([]byte(""), nil)
+
+	service := NewLoopbackInterfaceService(mockExecutor, nil)
+
+	err := service.Create(context.Background(), LoopbackInterface{Name: "loopback1", Address: "10.255.0.1/32"})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+
+	err = service.Create(context.Background(), LoopbackInterface{Name: "loopback1", Address: "10.255.0.1"})
+	assert.ErrorContains(t, err, "invalid loopback interface")
+}
+
+func TestLoopbackInterfaceService_Delete(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "no ip loopback1 address").Return([]byte(""), nil)
+
+	service := NewLoopbackInterfaceService(mockExecutor, nil)
+
+	err := service.Delete(context.Background(), "loopback1")
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
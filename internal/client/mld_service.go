@@ -0,0 +1,219 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// MLDService handles MLD proxy operations
+type MLDService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewMLDService creates a new MLD service instance
+func NewMLDService(executor Executor, client *rtxClient) *MLDService {
+	return &MLDService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the MLD proxy rooted at the given upstream interface
+func (s *MLDService) Get(ctx context.Context, upstreamInterface string) (*MLDProxy, error) {
+	cmd := parsers.BuildShowMLDConfigCommand()
+	logging.FromContext(ctx).Debug().Str("service", "mld").Msgf("Getting MLD config with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get MLD config: %w", err)
+	}
+
+	config, err := parsers.ParseMLDConfig(string(output))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse MLD config: %w", err)
+	}
+
+	parserProxy, err := parsers.MLDProxyFromConfig(config, upstreamInterface)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := s.fromParserProxy(*parserProxy)
+	return &proxy, nil
+}
+
+// Create configures a new MLD proxy
+func (s *MLDService) Create(ctx context.Context, proxy MLDProxy) error {
+	parserProxy := s.toParserProxy(proxy)
+	if err := parsers.ValidateMLDProxy(parserProxy); err != nil {
+		return fmt.Errorf("invalid MLD proxy: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := parsers.BuildMLDProxyCommands(parserProxy)
+	logging.FromContext(ctx).Debug().Str("service", "mld").Msgf("Creating MLD proxy with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to create MLD proxy: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "MLD proxy created")
+}
+
+// Update reconciles the MLD proxy with the desired configuration
+func (s *MLDService) Update(ctx context.Context, proxy MLDProxy) error {
+	parserProxy := s.toParserProxy(proxy)
+	if err := parsers.ValidateMLDProxy(parserProxy); err != nil {
+		return fmt.Errorf("invalid MLD proxy: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	current, err := s.Get(ctx, proxy.UpstreamInterface)
+	if err != nil {
+		return fmt.Errorf("failed to get current MLD proxy: %w", err)
+	}
+
+	commands := buildMLDProxyUpdateCommands(s.toParserProxy(*current), parserProxy)
+	logging.FromContext(ctx).Debug().Str("service", "mld").Msgf("Updating MLD proxy with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to update MLD proxy: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "MLD proxy updated")
+}
+
+// Delete removes the MLD proxy rooted at the given upstream interface
+func (s *MLDService) Delete(ctx context.Context, upstreamInterface string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	current, err := s.Get(ctx, upstreamInterface)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil
+		}
+		return fmt.Errorf("failed to get current MLD proxy: %w", err)
+	}
+
+	commands := parsers.BuildDeleteMLDProxyCommands(s.toParserProxy(*current))
+	logging.FromContext(ctx).Debug().Str("service", "mld").Msgf("Deleting MLD proxy with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to delete MLD proxy: %w", err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete MLD proxy"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, "MLD proxy deleted")
+}
+
+// buildMLDProxyUpdateCommands builds the commands that move the router from
+// the current MLD proxy configuration to the desired one
+func buildMLDProxyUpdateCommands(current, desired parsers.MLDProxy) []string {
+	var commands []string
+
+	// Remove static joins that are no longer desired
+	for _, join := range current.StaticJoins {
+		if !slices.Contains(desired.StaticJoins, join) {
+			commands = append(commands, parsers.BuildDeleteMLDStaticJoinCommand(join))
+		}
+	}
+
+	// Remove downstream interfaces that are no longer desired
+	for _, iface := range current.DownstreamInterfaces {
+		if !slices.Contains(desired.DownstreamInterfaces, iface) {
+			commands = append(commands, parsers.BuildDeleteMLDInterfaceCommand(iface))
+		}
+	}
+
+	// Re-issue interface settings; the commands overwrite existing values
+	settingsChanged := current.Version != desired.Version || current.Syslog != desired.Syslog
+	upstream := parsers.MLDInterface{
+		Interface: desired.UpstreamInterface,
+		Mode:      parsers.MLDModeHost,
+		Version:   desired.Version,
+		Syslog:    desired.Syslog,
+	}
+	if settingsChanged {
+		commands = append(commands, parsers.BuildMLDInterfaceCommand(upstream))
+	}
+	for _, iface := range desired.DownstreamInterfaces {
+		if settingsChanged || !slices.Contains(current.DownstreamInterfaces, iface) {
+			commands = append(commands, parsers.BuildMLDInterfaceCommand(parsers.MLDInterface{
+				Interface: iface,
+				Mode:      parsers.MLDModeRouter,
+				Version:   desired.Version,
+				Syslog:    desired.Syslog,
+			}))
+		}
+	}
+
+	// Add new static joins
+	for _, join := range desired.StaticJoins {
+		if !slices.Contains(current.StaticJoins, join) {
+			commands = append(commands, parsers.BuildMLDStaticJoinCommand(join))
+		}
+	}
+
+	return commands
+}
+
+// toParserProxy converts client.MLDProxy to parsers.MLDProxy
+func (s *MLDService) toParserProxy(proxy MLDProxy) parsers.MLDProxy {
+	result := parsers.MLDProxy{
+		UpstreamInterface:    proxy.UpstreamInterface,
+		DownstreamInterfaces: proxy.DownstreamInterfaces,
+		Version:              proxy.Version,
+		Syslog:               proxy.Syslog,
+	}
+	for _, join := range proxy.StaticJoins {
+		result.StaticJoins = append(result.StaticJoins, parsers.MLDStaticJoin{
+			Interface: join.Interface,
+			Group:     join.Group,
+			Source:    join.Source,
+		})
+	}
+	return result
+}
+
+// fromParserProxy converts parsers.MLDProxy to client.MLDProxy
+func (s *MLDService) fromParserProxy(proxy parsers.MLDProxy) MLDProxy {
+	result := MLDProxy{
+		UpstreamInterface:    proxy.UpstreamInterface,
+		DownstreamInterfaces: proxy.DownstreamInterfaces,
+		Version:              proxy.Version,
+		Syslog:               proxy.Syslog,
+		StaticJoins:          []MLDStaticJoin{},
+	}
+	for _, join := range proxy.StaticJoins {
+		result.StaticJoins = append(result.StaticJoins, MLDStaticJoin{
+			Interface: join.Interface,
+			Group:     join.Group,
+			Source:    join.Source,
+		})
+	}
+	return result
+}
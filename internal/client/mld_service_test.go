@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+func TestMLDService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, parsers.BuildShowMLDConfigCommand()).Return([]byte(
+		"ipv6 lan1 mld router version=2\nipv6 lan2 mld host version=2\nipv6 lan1 mld static ff3e::1\n",
+	), nil)
+
+	service := NewMLDService(mockExecutor, nil)
+
+	proxy, err := service.Get(context.Background(), "lan2")
+	assert.NoError(t, err)
+	assert.Equal(t, &MLDProxy{
+		UpstreamInterface:    "lan2",
+		DownstreamInterfaces: []string{"lan1"},
+		Version:              "2",
+		StaticJoins:          []MLDStaticJoin{{Interface: "lan1", Group: "ff3e::1"}},
+	}, proxy)
+
+	_, err = service.Get(context.Background(), "lan3")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestBuildMLDProxyUpdateCommands(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  parsers.MLDProxy
+		desired  parsers.MLDProxy
+		expected []string
+	}{
+		{
+			name: "no changes",
+			current: parsers.MLDProxy{
+				UpstreamInterface:    "lan2",
+				DownstreamInterfaces: []string{"lan1"},
+				Version:              "2",
+			},
+			desired: parsers.MLDProxy{
+				UpstreamInterface:    "lan2",
+				DownstreamInterfaces: []string{"lan1"},
+				Version:              "2",
+			},
+			expected: nil,
+		},
+		{
+			name: "swap downstream and static join",
+			current: parsers.MLDProxy{
+				UpstreamInterface:    "lan2",
+				DownstreamInterfaces: []string{"lan1"},
+				Version:              "2",
+				StaticJoins:          []parsers.MLDStaticJoin{{Interface: "lan1", Group: "ff3e::1"}},
+			},
+			desired: parsers.MLDProxy{
+				UpstreamInterface:    "lan2",
+				DownstreamInterfaces: []string{"lan3"},
+				Version:              "2",
+				StaticJoins:          []parsers.MLDStaticJoin{{Interface: "lan3", Group: "ff3e::1"}},
+			},
+			expected: []string{
+				"no ipv6 lan1 mld static ff3e::1",
+				"no ipv6 lan1 mld",
+				"ipv6 lan3 mld router version=2",
+				"ipv6 lan3 mld static ff3e::1",
+			},
+		},
+		{
+			name: "version change reissues all interfaces",
+			current: parsers.MLDProxy{
+				UpstreamInterface:    "lan2",
+				DownstreamInterfaces: []string{"lan1"},
+				Version:              "2",
+			},
+			desired: parsers.MLDProxy{
+				UpstreamInterface:    "lan2",
+				DownstreamInterfaces: []string{"lan1"},
+				Version:              "1",
+			},
+			expected: []string{
+				"ipv6 lan2 mld host version=1",
+				"ipv6 lan1 mld router version=1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, buildMLDProxyUpdateCommands(tt.current, tt.desired))
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// MobileWANService handles mobile (LTE) WAN operations on PP interfaces
+type MobileWANService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewMobileWANService creates a new mobile WAN service instance
+func NewMobileWANService(executor Executor, client *rtxClient) *MobileWANService {
+	return &MobileWANService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the mobile WAN connection on a PP interface
+func (s *MobileWANService) Get(ctx context.Context, ppNum int) (*MobileWAN, error) {
+	wans, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, wan := range wans {
+		if wan.PPNumber == ppNum {
+			return &wan, nil
+		}
+	}
+
+	return nil, fmt.Errorf("mobile WAN on pp %d not found", ppNum)
+}
+
+// List retrieves all configured mobile WAN connections
+func (s *MobileWANService) List(ctx context.Context) ([]MobileWAN, error) {
+	cmd := parsers.BuildShowMobileWANCommand()
+	logging.FromContext(ctx).Debug().Str("service", "mobile_wan").Msgf("Listing mobile WANs with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mobile WANs: %w", err)
+	}
+
+	parsed := parsers.ParseMobileWANs(string(output))
+	wans := make([]MobileWAN, len(parsed))
+	for i, p := range parsed {
+		wans[i] = MobileWAN(p)
+	}
+	return wans, nil
+}
+
+// Create configures a mobile WAN connection
+func (s *MobileWANService) Create(ctx context.Context, wan MobileWAN) error {
+	return s.apply(ctx, wan, nil, "created")
+}
+
+// Update changes a mobile WAN connection. Settings removed from the
+// configuration are cleared before the new settings are written.
+func (s *MobileWANService) Update(ctx context.Context, wan MobileWAN) error {
+	current, err := s.Get(ctx, wan.PPNumber)
+	if err != nil {
+		return err
+	}
+
+	var removals []string
+	switch {
+	case current.Interface != wan.Interface:
+		removals = parsers.BuildDeleteMobileWANCommands(parsers.MobileWAN(*current))
+	default:
+		if current.Username != "" && wan.Username == "" {
+			removals = append(removals, parsers.BuildDeleteMobileWANAuthCommands(wan.PPNumber)...)
+		}
+		if current.PIN != "" && wan.PIN == "" {
+			removals = append(removals, parsers.BuildDeleteMobileWANPINCommand(wan.Interface))
+		}
+	}
+
+	return s.apply(ctx, wan, removals, "updated")
+}
+
+// apply validates and writes the mobile WAN connection after the given removal commands
+func (s *MobileWANService) apply(ctx context.Context, wan MobileWAN, removals []string, action string) error {
+	parserWAN := parsers.MobileWAN(wan)
+	if err := parsers.ValidateMobileWAN(parserWAN); err != nil {
+		return fmt.Errorf("invalid mobile WAN: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := append(removals, parsers.BuildMobileWANCommands(parserWAN)...)
+	logging.FromContext(ctx).Debug().Str("service", "mobile_wan").Msgf("Applying mobile WAN on pp %d with %d commands", wan.PPNumber, len(commands))
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to configure mobile WAN on pp %d: %w", wan.PPNumber, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("mobile WAN on pp %d %s", wan.PPNumber, action))
+}
+
+// Delete removes the mobile WAN connection on a PP interface
+func (s *MobileWANService) Delete(ctx context.Context, ppNum int) error {
+	current, err := s.Get(ctx, ppNum)
+	if err != nil {
+		return err
+	}
+
+	commands := parsers.BuildDeleteMobileWANCommands(parsers.MobileWAN(*current))
+	logging.FromContext(ctx).Debug().Str("service", "mobile_wan").Msgf("Deleting mobile WAN on pp %d with %d commands", ppNum, len(commands))
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to delete mobile WAN on pp %d: %w", ppNum, err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete mobile WAN"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("mobile WAN on pp %d deleted", ppNum))
+}
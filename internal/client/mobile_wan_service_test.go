@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testMobileWANShowConfig = `pp select 2
+ pp always-on on
+ pp bind usb1
+ pp auth accept chap
+ pp auth myname lte lte-pass
+ mobile auto connect on
+ mobile disconnect time off
+ mobile access-point name internet.example cid 1 pdp-type ip
+ pp enable 2
+pp select none
+mobile use usb1 on
+mobile pin code usb1 1234
+`
+
+func TestMobileWANService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(testMobileWANShowConfig), nil)
+
+	service := NewMobileWANService(mockExecutor, nil)
+
+	wan, err := service.Get(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, &MobileWAN{
+		PPNumber:    2,
+		Interface:   "usb1",
+		Enabled:     true,
+		APN:         "internet.example",
+		CID:         1,
+		PDPType:     "ip",
+		AuthMethod:  "chap",
+		Username:    "lte",
+		Password:    "lte-pass",
+		PIN:         "1234",
+		AlwaysOn:    true,
+		AutoConnect: true,
+	}, wan)
+
+	_, err = service.Get(context.Background(), 3)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestMobileWANService_Create(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("RunBatch", mock.Anything, []string{
+			"pp select 2",
+			"pp bind usb1",
+			"mobile access-point name internet.example cid 1 pdp-type ip",
+			"pp always-on on",
+			"mobile auto connect off",
+			"mobile disconnect time off",
+			"pp enable 2",
+			"pp select none",
+			"mobile use usb1 on",
+		}).Return([]byte(""), nil)
+
+		service := NewMobileWANService(mockExecutor, nil)
+		err := service.Create(context.Background(), MobileWAN{
+			PPNumber: 2, Interface: "usb1", Enabled: true, APN: "internet.example", CID: 1, PDPType: "ip", AlwaysOn: true,
+		})
+		assert.NoError(t, err)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("invalid interface", func(t *testing.T) {
+		service := NewMobileWANService(new(MockExecutor), nil)
+		err := service.Create(context.Background(), MobileWAN{PPNumber: 2, Interface: "lan2", APN: "apn", CID: 1, PDPType: "ip"})
+		assert.ErrorContains(t, err, "invalid mobile WAN")
+	})
+}
+
+func TestMobileWANService_Update(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(testMobileWANShowConfig), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"pp select 2",
+		"no pp auth myname",
+		"no pp auth accept",
+		"pp select none",
+		"no mobile pin code usb1",
+		"pp select 2",
+		"pp bind usb1",
+		"mobile access-point name internet.example cid 1 pdp-type ip",
+		"pp always-on off",
+		"mobile auto connect on",
+		"mobile disconnect time 600",
+		"pp enable 2",
+		"pp select none",
+		"mobile use usb1 on",
+	}).Return([]byte(""), nil)
+
+	service := NewMobileWANService(mockExecutor, nil)
+	err := service.Update(context.Background(), MobileWAN{
+		PPNumber: 2, Interface: "usb1", Enabled: true, APN: "internet.example", CID: 1, PDPType: "ip",
+		AutoConnect: true, DisconnectTime: 600,
+	})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestMobileWANService_Delete(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(testMobileWANShowConfig), nil)
+	mockExecutor.On("RunBatch", mock.Anything, mock.MatchedBy(func(cmds []string) bool {
+		return len(cmds) > 0 && cmds[0] == "no mobile use usb1" && cmds[len(cmds)-1] == "no mobile pin code usb1"
+	})).Return([]byte(""), nil)
+
+	service := NewMobileWANService(mockExecutor, nil)
+	assert.NoError(t, service.Delete(context.Background(), 2))
+	mockExecutor.AssertExpectations(t)
+}
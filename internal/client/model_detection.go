@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// DetectModel returns the router model and firmware revision. They are read
+// from "show environment" on first use and cached for the lifetime of the
+// client, so resources can consult them freely to adjust command syntax or
+// reject unsupported features.
+func (c *rtxClient) DetectModel(ctx context.Context) (*SystemInfo, error) {
+	c.modelMu.Lock()
+	defer c.modelMu.Unlock()
+
+	if c.model != nil {
+		return c.model, nil
+	}
+
+	info, err := c.GetSystemInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect router model: %w", err)
+	}
+	if info.Model != "" {
+		// Keep retrying detection while the model cannot be recognized
+		c.model = info
+	}
+	return info, nil
+}
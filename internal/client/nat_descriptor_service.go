@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// NATDescriptorService lists NAT descriptors of all types and their interface bindings
+type NATDescriptorService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewNATDescriptorService creates a new NAT descriptor service instance
+func NewNATDescriptorService(executor Executor, client *rtxClient) *NATDescriptorService {
+	return &NATDescriptorService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// List retrieves all NAT descriptors ordered by ID
+func (s *NATDescriptorService) List(ctx context.Context) ([]NATDescriptor, error) {
+	// The full config is read because interface bindings of PP and tunnel
+	// interfaces are only attributable inside their select contexts
+	logging.FromContext(ctx).Debug().Str("service", "nat_descriptor").Msg("Listing NAT descriptors")
+
+	output, err := s.executor.Run(ctx, "show config")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get NAT descriptors: %w", err)
+	}
+
+	parsed, err := parsers.ParseNATDescriptors(string(output))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse NAT descriptors: %w", err)
+	}
+
+	descriptors := make([]NATDescriptor, len(parsed))
+	for i, p := range parsed {
+		descriptors[i] = NATDescriptor(p)
+	}
+	return descriptors, nil
+}
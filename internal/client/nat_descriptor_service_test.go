@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNATDescriptorService_List(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(`pp select 1
+ ip pp nat descriptor 1000
+ pp enable 1
+nat descriptor type 1000 masquerade
+nat descriptor address outer 1000 ipcp
+nat descriptor address inner 1000 auto
+`), nil)
+
+		service := NewNATDescriptorService(mockExecutor, nil)
+
+		descriptors, err := service.List(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []NATDescriptor{
+			{
+				ID:             1000,
+				Type:           "masquerade",
+				OuterAddresses: []string{"ipcp"},
+				InnerAddresses: []string{"auto"},
+				Interfaces:     []string{"pp1"},
+			},
+		}, descriptors)
+	})
+
+	t.Run("executor error", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(nil), errors.New("connection lost"))
+
+		service := NewNATDescriptorService(mockExecutor, nil)
+
+		_, err := service.List(context.Background())
+		assert.Error(t, err)
+	})
+}
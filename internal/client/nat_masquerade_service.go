@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/sh1/terraform-provider-rtx/internal/logging"
 
@@ -40,6 +41,8 @@ func (s *NATMasqueradeService) Create(ctx context.Context, nat NATMasquerade) er
 	default:
 	}
 
+	nat.StaticEntries = assignStaticEntryNumbers(nil, nat.StaticEntries)
+
 	// Collect all commands
 	commands := []string{}
 
@@ -60,22 +63,20 @@ func (s *NATMasqueradeService) Create(ctx context.Context, nat NATMasquerade) er
 
 	// Step 4: Configure static entries
 	for i, entry := range nat.StaticEntries {
-		parserEntry := parsers.MasqueradeStaticEntry{
-			EntryNumber:       entry.EntryNumber,
-			InsideLocal:       entry.InsideLocal,
-			InsideLocalPort:   entry.InsideLocalPort,
-			OutsideGlobal:     entry.OutsideGlobal,
-			OutsideGlobalPort: entry.OutsideGlobalPort,
-			Protocol:          entry.Protocol,
-		}
-		cmd = parsers.BuildNATMasqueradeStaticCommand(nat.DescriptorID, entry.EntryNumber, parserEntry)
+		cmd = buildMasqueradeStaticCommand(nat.DescriptorID, entry)
 		logging.FromContext(ctx).Debug().Str("service", "nat_masquerade").Msgf("Adding static entry %d with command: %s", i+1, cmd)
 		commands = append(commands, cmd)
 	}
 
+	// Step 5: Configure descriptor options
+	for _, cmd := range buildNATMasqueradeOptionCommands(nat.DescriptorID, parserNAT) {
+		logging.FromContext(ctx).Debug().Str("service", "nat_masquerade").Msgf("Setting descriptor option with command: %s", cmd)
+		commands = append(commands, cmd)
+	}
+
 	// Execute all commands in batch
 	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
-		return fmt.Errorf("failed to create NAT masquerade: %w", err)
+		return s.staticEntriesError(ctx, nat, fmt.Errorf("failed to create NAT masquerade: %w", err))
 	}
 
 	return saveConfig(ctx, s.client, "NAT masquerade created")
@@ -138,6 +139,7 @@ func (s *NATMasqueradeService) Update(ctx context.Context, nat NATMasquerade) er
 	if err != nil {
 		return fmt.Errorf("failed to get current NAT masquerade: %w", err)
 	}
+	nat.StaticEntries = assignStaticEntryNumbers(currentNAT.StaticEntries, nat.StaticEntries)
 
 	// Collect all commands
 	commands := []string{}
@@ -172,24 +174,29 @@ func (s *NATMasqueradeService) Update(ctx context.Context, nat NATMasquerade) er
 		}
 	}
 
-	// Add/update new entries
+	// Add/update new entries, skipping the ones already configured as requested
+	current := make(map[int]string, len(currentNAT.StaticEntries))
+	for _, entry := range currentNAT.StaticEntries {
+		current[entry.EntryNumber] = buildMasqueradeStaticCommand(nat.DescriptorID, entry)
+	}
 	for i, entry := range nat.StaticEntries {
-		parserEntry := parsers.MasqueradeStaticEntry{
-			EntryNumber:       entry.EntryNumber,
-			InsideLocal:       entry.InsideLocal,
-			InsideLocalPort:   entry.InsideLocalPort,
-			OutsideGlobal:     entry.OutsideGlobal,
-			OutsideGlobalPort: entry.OutsideGlobalPort,
-			Protocol:          entry.Protocol,
+		cmd := buildMasqueradeStaticCommand(nat.DescriptorID, entry)
+		if current[entry.EntryNumber] == cmd {
+			continue
 		}
-		cmd := parsers.BuildNATMasqueradeStaticCommand(nat.DescriptorID, entry.EntryNumber, parserEntry)
 		logging.FromContext(ctx).Debug().Str("service", "nat_masquerade").Msgf("Setting static entry %d with command: %s", i+1, cmd)
 		commands = append(commands, cmd)
 	}
 
+	// Update descriptor options
+	for _, cmd := range buildNATMasqueradeOptionUpdateCommands(nat.DescriptorID, s.toParserNAT(*currentNAT), parserNAT) {
+		logging.FromContext(ctx).Debug().Str("service", "nat_masquerade").Msgf("Updating descriptor option with command: %s", cmd)
+		commands = append(commands, cmd)
+	}
+
 	// Execute all commands in batch
 	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
-		return fmt.Errorf("failed to update NAT masquerade: %w", err)
+		return s.staticEntriesError(ctx, nat, fmt.Errorf("failed to update NAT masquerade: %w", err))
 	}
 
 	return saveConfig(ctx, s.client, "NAT masquerade updated")
@@ -253,6 +260,111 @@ func (s *NATMasqueradeService) List(ctx context.Context) ([]NATMasquerade, error
 	return nats, nil
 }
 
+// staticEntriesError returns err of a failed apply of nat, as a
+// *PartialApplyError listing the static entries that reached the router when
+// the apply included any. The router runs the commands of a batch in order,
+// so the configuration read back tells which entries were written.
+func (s *NATMasqueradeService) staticEntriesError(ctx context.Context, nat NATMasquerade, err error) error {
+	if len(nat.StaticEntries) == 0 {
+		return err
+	}
+
+	currentNAT, getErr := s.Get(ctx, nat.DescriptorID)
+	if getErr != nil {
+		logging.FromContext(ctx).Warn().Err(getErr).Str("service", "nat_masquerade").Msg("Could not read back static entries after failed apply")
+		return err
+	}
+
+	current := make(map[int]string, len(currentNAT.StaticEntries))
+	for _, entry := range currentNAT.StaticEntries {
+		current[entry.EntryNumber] = buildMasqueradeStaticCommand(nat.DescriptorID, entry)
+	}
+	partial := &PartialApplyError{Err: err}
+	for _, entry := range nat.StaticEntries {
+		if current[entry.EntryNumber] == buildMasqueradeStaticCommand(nat.DescriptorID, entry) {
+			partial.Applied = append(partial.Applied, entry.EntryNumber)
+		} else {
+			partial.Pending = append(partial.Pending, entry.EntryNumber)
+		}
+	}
+	if len(partial.Applied) == 0 || len(partial.Pending) == 0 {
+		return err
+	}
+
+	// Keep the written entries across reboots, as the state records them
+	if saveErr := saveConfig(ctx, s.client, "NAT masquerade partially applied"); saveErr != nil {
+		logging.FromContext(ctx).Warn().Err(saveErr).Str("service", "nat_masquerade").Msg("Could not save partially applied configuration")
+	}
+	return partial
+}
+
+// MasqueradeStaticEntryKey identifies a static entry by its content,
+// regardless of its entry number
+func MasqueradeStaticEntryKey(entry MasqueradeStaticEntry) string {
+	entry.EntryNumber = 0
+	return buildMasqueradeStaticCommand(0, entry)
+}
+
+// assignStaticEntryNumbers numbers the desired static entries without an
+// entry number. An entry keeps the number of a current entry with the same
+// content, so that unchanged entries are not rewritten; the others take the
+// lowest free numbers.
+func assignStaticEntryNumbers(current, desired []MasqueradeStaticEntry) []MasqueradeStaticEntry {
+	result := make([]MasqueradeStaticEntry, len(desired))
+	copy(result, desired)
+
+	used := make(map[int]bool, len(result))
+	for _, entry := range result {
+		if entry.EntryNumber != 0 {
+			used[entry.EntryNumber] = true
+		}
+	}
+
+	currentByKey := make(map[string][]int)
+	for _, entry := range current {
+		key := MasqueradeStaticEntryKey(entry)
+		currentByKey[key] = append(currentByKey[key], entry.EntryNumber)
+	}
+	for i := range result {
+		if result[i].EntryNumber != 0 {
+			continue
+		}
+		for _, number := range currentByKey[MasqueradeStaticEntryKey(result[i])] {
+			if !used[number] {
+				result[i].EntryNumber = number
+				used[number] = true
+				break
+			}
+		}
+	}
+
+	next := 1
+	for i := range result {
+		if result[i].EntryNumber != 0 {
+			continue
+		}
+		for used[next] {
+			next++
+		}
+		result[i].EntryNumber = next
+		used[next] = true
+	}
+	return result
+}
+
+// buildMasqueradeStaticCommand returns the command configuring a static
+// entry, which also serves to compare entries in their router form
+func buildMasqueradeStaticCommand(id int, entry MasqueradeStaticEntry) string {
+	return parsers.BuildNATMasqueradeStaticCommand(id, entry.EntryNumber, parsers.MasqueradeStaticEntry{
+		EntryNumber:       entry.EntryNumber,
+		InsideLocal:       entry.InsideLocal,
+		InsideLocalPort:   entry.InsideLocalPort,
+		OutsideGlobal:     entry.OutsideGlobal,
+		OutsideGlobalPort: entry.OutsideGlobalPort,
+		Protocol:          entry.Protocol,
+	})
+}
+
 // toParserNAT converts client.NATMasquerade to parsers.NATMasquerade
 func (s *NATMasqueradeService) toParserNAT(nat NATMasquerade) parsers.NATMasquerade {
 	staticEntries := make([]parsers.MasqueradeStaticEntry, len(nat.StaticEntries))
@@ -267,11 +379,24 @@ func (s *NATMasqueradeService) toParserNAT(nat NATMasquerade) parsers.NATMasquer
 		}
 	}
 
+	var unconvertible []parsers.MasqueradeUnconvertiblePort
+	for _, port := range nat.UnconvertiblePorts {
+		unconvertible = append(unconvertible, parsers.MasqueradeUnconvertiblePort{
+			Protocol: port.Protocol,
+			Port:     port.Port,
+		})
+	}
+
 	return parsers.NATMasquerade{
-		DescriptorID:  nat.DescriptorID,
-		OuterAddress:  nat.OuterAddress,
-		InnerNetwork:  nat.InnerNetwork,
-		StaticEntries: staticEntries,
+		DescriptorID:            nat.DescriptorID,
+		OuterAddress:            nat.OuterAddress,
+		InnerNetwork:            nat.InnerNetwork,
+		StaticEntries:           staticEntries,
+		SIP:                     nat.SIP,
+		FTPPorts:                nat.FTPPorts,
+		UnconvertiblePorts:      unconvertible,
+		UnconvertibleIfPossible: nat.UnconvertibleIfPossible,
+		Rlogin:                  nat.Rlogin,
 	}
 }
 
@@ -289,10 +414,80 @@ func (s *NATMasqueradeService) fromParserNAT(parserNAT parsers.NATMasquerade) NA
 		}
 	}
 
+	var unconvertible []MasqueradeUnconvertiblePort
+	for _, port := range parserNAT.UnconvertiblePorts {
+		unconvertible = append(unconvertible, MasqueradeUnconvertiblePort{
+			Protocol: port.Protocol,
+			Port:     port.Port,
+		})
+	}
+
 	return NATMasquerade{
-		DescriptorID:  parserNAT.DescriptorID,
-		OuterAddress:  parserNAT.OuterAddress,
-		InnerNetwork:  parserNAT.InnerNetwork,
-		StaticEntries: staticEntries,
+		DescriptorID:            parserNAT.DescriptorID,
+		OuterAddress:            parserNAT.OuterAddress,
+		InnerNetwork:            parserNAT.InnerNetwork,
+		StaticEntries:           staticEntries,
+		SIP:                     parserNAT.SIP,
+		FTPPorts:                parserNAT.FTPPorts,
+		UnconvertiblePorts:      unconvertible,
+		UnconvertibleIfPossible: parserNAT.UnconvertibleIfPossible,
+		Rlogin:                  parserNAT.Rlogin,
+	}
+}
+
+// buildNATMasqueradeOptionCommands returns the commands that configure
+// descriptor options on a freshly created masquerade descriptor
+func buildNATMasqueradeOptionCommands(id int, nat parsers.NATMasquerade) []string {
+	return buildNATMasqueradeOptionUpdateCommands(id, parsers.NATMasquerade{}, nat)
+}
+
+// buildNATMasqueradeOptionUpdateCommands returns the commands needed to move
+// descriptor options from the current state to the desired state
+func buildNATMasqueradeOptionUpdateCommands(id int, current, desired parsers.NATMasquerade) []string {
+	var commands []string
+
+	if current.SIP != desired.SIP {
+		if desired.SIP == "" {
+			commands = append(commands, parsers.BuildDeleteNATDescriptorSIPCommand(id))
+		} else {
+			commands = append(commands, parsers.BuildNATDescriptorSIPCommand(id, desired.SIP))
+		}
+	}
+
+	if !slices.Equal(current.FTPPorts, desired.FTPPorts) {
+		if len(desired.FTPPorts) == 0 {
+			commands = append(commands, parsers.BuildDeleteNATDescriptorFTPPortCommand(id))
+		} else {
+			commands = append(commands, parsers.BuildNATDescriptorFTPPortCommand(id, desired.FTPPorts))
+		}
+	}
+
+	for _, port := range current.UnconvertiblePorts {
+		if !slices.Contains(desired.UnconvertiblePorts, port) {
+			commands = append(commands, parsers.BuildDeleteNATMasqueradeUnconvertiblePortCommand(id, port))
+		}
+	}
+	for _, port := range desired.UnconvertiblePorts {
+		if !slices.Contains(current.UnconvertiblePorts, port) {
+			commands = append(commands, parsers.BuildNATMasqueradeUnconvertiblePortCommand(id, port))
+		}
+	}
+
+	if current.UnconvertibleIfPossible != desired.UnconvertibleIfPossible {
+		if desired.UnconvertibleIfPossible {
+			commands = append(commands, parsers.BuildNATMasqueradeUnconvertibleIfPossibleCommand(id))
+		} else {
+			commands = append(commands, parsers.BuildDeleteNATMasqueradeUnconvertibleIfPossibleCommand(id))
+		}
 	}
+
+	if current.Rlogin != desired.Rlogin {
+		if desired.Rlogin {
+			commands = append(commands, parsers.BuildNATMasqueradeRloginCommand(id, true))
+		} else {
+			commands = append(commands, parsers.BuildDeleteNATMasqueradeRloginCommand(id))
+		}
+	}
+
+	return commands
 }
@@ -7,6 +7,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
 )
 
 // intPtr is a helper function to create *int pointers for test data
@@ -747,3 +749,192 @@ func TestNATMasqueradeService_TypeConversions(t *testing.T) {
 		assert.Equal(t, "tcp", parserNAT.StaticEntries[0].Protocol)
 	})
 }
+
+func TestBuildNATMasqueradeOptionUpdateCommands(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  parsers.NATMasquerade
+		desired  parsers.NATMasquerade
+		expected []string
+	}{
+		{
+			name:     "no options",
+			expected: nil,
+		},
+		{
+			name: "set all options",
+			desired: parsers.NATMasquerade{
+				SIP:                     "on",
+				FTPPorts:                []int{21, 2121},
+				UnconvertiblePorts:      []parsers.MasqueradeUnconvertiblePort{{Protocol: "tcp", Port: "1024-65535"}},
+				UnconvertibleIfPossible: true,
+				Rlogin:                  true,
+			},
+			expected: []string{
+				"nat descriptor sip 1 on",
+				"nat descriptor ftp port 1 21 2121",
+				"nat descriptor masquerade unconvertible port 1 tcp 1024-65535",
+				"nat descriptor masquerade unconvertible port 1 if-possible",
+				"nat descriptor masquerade rlogin 1 on",
+			},
+		},
+		{
+			name: "reset all options",
+			current: parsers.NATMasquerade{
+				SIP:                     "off",
+				FTPPorts:                []int{2121},
+				UnconvertiblePorts:      []parsers.MasqueradeUnconvertiblePort{{Protocol: "udp", Port: "500"}},
+				UnconvertibleIfPossible: true,
+				Rlogin:                  true,
+			},
+			expected: []string{
+				"no nat descriptor sip 1",
+				"no nat descriptor ftp port 1",
+				"no nat descriptor masquerade unconvertible port 1 udp 500",
+				"no nat descriptor masquerade unconvertible port 1 if-possible",
+				"no nat descriptor masquerade rlogin 1",
+			},
+		},
+		{
+			name: "unchanged options produce no commands",
+			current: parsers.NATMasquerade{
+				SIP:      "on",
+				FTPPorts: []int{21},
+			},
+			desired: parsers.NATMasquerade{
+				SIP:      "on",
+				FTPPorts: []int{21},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, buildNATMasqueradeOptionUpdateCommands(1, tt.current, tt.desired))
+		})
+	}
+}
+
+func TestNATMasqueradeService_PartialApply(t *testing.T) {
+	entries := []MasqueradeStaticEntry{
+		{EntryNumber: 1, InsideLocal: "192.168.1.10", InsideLocalPort: intPtr(80), OutsideGlobal: "ipcp", OutsideGlobalPort: intPtr(80), Protocol: "tcp"},
+		{EntryNumber: 2, InsideLocal: "192.168.1.11", InsideLocalPort: intPtr(443), OutsideGlobal: "ipcp", OutsideGlobalPort: intPtr(443), Protocol: "tcp"},
+		{EntryNumber: 3, InsideLocal: "192.168.1.12", InsideLocalPort: intPtr(25), OutsideGlobal: "ipcp", OutsideGlobalPort: intPtr(25), Protocol: "tcp"},
+	}
+	nat := NATMasquerade{
+		DescriptorID:  1,
+		OuterAddress:  "ipcp",
+		InnerNetwork:  "192.168.1.0-192.168.1.255",
+		StaticEntries: entries,
+	}
+	getCmd := `show config | grep "nat descriptor.*1"`
+
+	t.Run("Create reports applied and pending entries", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("RunBatch", mock.Anything, mock.Anything).
+			Return([]byte("Error: static entry table is full"), nil)
+		mockExecutor.On("Run", mock.Anything, getCmd).Return([]byte(`nat descriptor type 1 masquerade
+nat descriptor address outer 1 ipcp
+nat descriptor address inner 1 192.168.1.0-192.168.1.255
+nat descriptor masquerade static 1 1 192.168.1.10 tcp 80
+nat descriptor masquerade static 1 2 192.168.1.11 tcp 443
+`), nil)
+
+		service := &NATMasqueradeService{executor: mockExecutor}
+		err := service.Create(context.Background(), nat)
+
+		var partial *PartialApplyError
+		if assert.ErrorAs(t, err, &partial) {
+			assert.Equal(t, []int{1, 2}, partial.Applied)
+			assert.Equal(t, []int{3}, partial.Pending)
+			assert.Contains(t, err.Error(), "2 of 3 entries applied")
+		}
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("Create without any entry applied returns the error", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("RunBatch", mock.Anything, mock.Anything).
+			Return([]byte("Error: invalid command"), nil)
+		mockExecutor.On("Run", mock.Anything, getCmd).Return([]byte(""), nil)
+
+		service := &NATMasqueradeService{executor: mockExecutor}
+		err := service.Create(context.Background(), nat)
+
+		var partial *PartialApplyError
+		assert.Error(t, err)
+		assert.False(t, errors.As(err, &partial))
+	})
+
+	t.Run("Update only writes entries that differ", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, getCmd).Return([]byte(`nat descriptor type 1 masquerade
+nat descriptor address outer 1 ipcp
+nat descriptor address inner 1 192.168.1.0-192.168.1.255
+nat descriptor masquerade static 1 1 192.168.1.10 tcp 80
+nat descriptor masquerade static 1 2 192.168.1.11 tcp 8443=443
+`), nil)
+		mockExecutor.On("RunBatch", mock.Anything, []string{
+			"nat descriptor masquerade static 1 2 192.168.1.11 tcp 443",
+			"nat descriptor masquerade static 1 3 192.168.1.12 tcp 25",
+		}).Return([]byte(""), nil)
+
+		service := &NATMasqueradeService{executor: mockExecutor}
+		err := service.Update(context.Background(), nat)
+
+		assert.NoError(t, err)
+		mockExecutor.AssertExpectations(t)
+	})
+}
+
+func TestAssignStaticEntryNumbers(t *testing.T) {
+	web := MasqueradeStaticEntry{InsideLocal: "192.168.1.10", InsideLocalPort: intPtr(80), OutsideGlobal: "ipcp", OutsideGlobalPort: intPtr(80), Protocol: "tcp"}
+	mail := MasqueradeStaticEntry{InsideLocal: "192.168.1.12", InsideLocalPort: intPtr(25), OutsideGlobal: "ipcp", OutsideGlobalPort: intPtr(25), Protocol: "tcp"}
+	vpn := MasqueradeStaticEntry{InsideLocal: "192.168.1.20", Protocol: "esp"}
+	numbered := func(entry MasqueradeStaticEntry, number int) MasqueradeStaticEntry {
+		entry.EntryNumber = number
+		return entry
+	}
+
+	tests := []struct {
+		name    string
+		current []MasqueradeStaticEntry
+		desired []MasqueradeStaticEntry
+		want    []int
+	}{
+		{
+			name:    "create takes lowest free numbers",
+			desired: []MasqueradeStaticEntry{web, numbered(mail, 1), vpn},
+			want:    []int{2, 1, 3},
+		},
+		{
+			name:    "unchanged entries keep their numbers",
+			current: []MasqueradeStaticEntry{numbered(web, 5), numbered(vpn, 7)},
+			desired: []MasqueradeStaticEntry{vpn, mail, web},
+			want:    []int{7, 1, 5},
+		},
+		{
+			name:    "explicit numbers win over current numbers",
+			current: []MasqueradeStaticEntry{numbered(web, 1)},
+			desired: []MasqueradeStaticEntry{web, numbered(mail, 1)},
+			want:    []int{2, 1},
+		},
+		{
+			name:    "changed entries reuse freed numbers",
+			current: []MasqueradeStaticEntry{numbered(web, 1), numbered(mail, 2)},
+			desired: []MasqueradeStaticEntry{mail, vpn},
+			want:    []int{2, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := assignStaticEntryNumbers(tt.current, tt.desired)
+			numbers := make([]int, len(got))
+			for i, entry := range got {
+				numbers[i] = entry.EntryNumber
+			}
+			assert.Equal(t, tt.want, numbers)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// OSPFNeighborStatusService handles OSPF adjacency queries ("show status ospf neighbor")
+type OSPFNeighborStatusService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewOSPFNeighborStatusService creates a new OSPF neighbor status service instance
+func NewOSPFNeighborStatusService(executor Executor, client *rtxClient) *OSPFNeighborStatusService {
+	return &OSPFNeighborStatusService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// List retrieves the OSPF neighbors, optionally limited to one interface
+func (s *OSPFNeighborStatusService) List(ctx context.Context, iface string) ([]OSPFNeighborStatus, error) {
+	cmd := parsers.BuildShowOSPFNeighborStatusCommand()
+	logging.FromContext(ctx).Debug().Str("service", "ospf_neighbor_status").Msgf("Listing OSPF neighbors with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OSPF neighbors: %w", err)
+	}
+	if line := rejectionLine(string(output)); line != "" {
+		return nil, fmt.Errorf("failed to get OSPF neighbors: %s", line)
+	}
+
+	neighbors := []OSPFNeighborStatus{}
+	for _, n := range parsers.ParseOSPFNeighborStatus(string(output)) {
+		if iface != "" && !strings.EqualFold(n.Interface, iface) {
+			continue
+		}
+		neighbors = append(neighbors, OSPFNeighborStatus(n))
+	}
+	return neighbors, nil
+}
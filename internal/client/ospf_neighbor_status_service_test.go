@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOSPFNeighborStatusService_List(t *testing.T) {
+	output := `Neighbor ID      Pri   State           Dead Time   Address          Interface
+192.168.1.2        1   FULL/BDR        00:00:35    192.168.1.2      LAN1
+10.0.0.2           0   FULL/-          00:00:38    10.255.0.2       TUNNEL1
+`
+
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show status ospf neighbor").Return([]byte(output), nil)
+
+	service := NewOSPFNeighborStatusService(mockExecutor, nil)
+
+	all, err := service.List(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	neighbors, err := service.List(context.Background(), "tunnel1")
+	assert.NoError(t, err)
+	assert.Equal(t, []OSPFNeighborStatus{
+		{NeighborID: "10.0.0.2", Priority: 0, State: "full", DeadTime: "00:00:38", Address: "10.255.0.2", Interface: "tunnel1"},
+	}, neighbors)
+}
+
+func TestOSPFNeighborStatusService_List_Rejections(t *testing.T) {
+	t.Run("rejected command", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status ospf neighbor").Return([]byte("Error: OSPF is not running\n"), nil)
+
+		service := NewOSPFNeighborStatusService(mockExecutor, nil)
+
+		_, err := service.List(context.Background(), "")
+		assert.ErrorContains(t, err, "Error: OSPF is not running")
+	})
+
+	t.Run("status notes are not errors", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status ospf neighbor").Return([]byte(`Neighbor ID      Pri   State           Dead Time   Address          Interface
+192.168.1.2        1   FULL/BDR        00:00:35    192.168.1.2      LAN1
+Last error: none
+`), nil)
+
+		service := NewOSPFNeighborStatusService(mockExecutor, nil)
+
+		neighbors, err := service.List(context.Background(), "")
+		assert.NoError(t, err)
+		assert.Len(t, neighbors, 1)
+	})
+}
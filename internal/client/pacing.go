@@ -0,0 +1,96 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+// Pacing slows down how commands are typed into the router console. Some RTX
+// models drop characters when input arrives faster than their console reads
+// it, which silently corrupts the commands. The zero value sends at full speed.
+type Pacing struct {
+	CommandDelay   time.Duration // Minimum pause between consecutive commands
+	CharacterDelay time.Duration // Pause after each character written to the console
+	VerifyEcho     bool          // Wait for the router to echo each command intact before pressing Enter
+}
+
+// clearLine is Ctrl-U, which discards the current input line in the RTX console
+const clearLine = "\x15"
+
+// pacedWriter writes one byte at a time with a delay after each byte
+type pacedWriter struct {
+	w     io.WriteCloser
+	delay time.Duration
+}
+
+// newPacedWriter wraps w so that every byte is followed by delay. A zero delay
+// returns w unchanged.
+func newPacedWriter(w io.WriteCloser, delay time.Duration) io.WriteCloser {
+	if delay <= 0 {
+		return w
+	}
+	return &pacedWriter{w: w, delay: delay}
+}
+
+// Write writes p byte by byte
+func (w *pacedWriter) Write(p []byte) (int, error) {
+	for i := range p {
+		if _, err := w.w.Write(p[i : i+1]); err != nil {
+			return i, err
+		}
+		time.Sleep(w.delay)
+	}
+	return len(p), nil
+}
+
+// Close closes the underlying stream
+func (w *pacedWriter) Close() error {
+	return w.w.Close()
+}
+
+// waitCommandDelay blocks until the configured delay since the previous command has passed
+func (s *workingSession) waitCommandDelay() {
+	if s.pacing.CommandDelay <= 0 || s.lastCommand.IsZero() {
+		return
+	}
+	if wait := s.pacing.CommandDelay - time.Since(s.lastCommand); wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+// sendCommandLine types cmd into the console and presses Enter, applying the
+// configured pacing. With echo verification, Enter is only pressed once the
+// router has echoed cmd intact; otherwise the line is discarded and the
+// command is not run. The echo that was read is returned so that callers see
+// the same output as without verification.
+func (s *workingSession) sendCommandLine(cmd string) ([]byte, error) {
+	s.waitCommandDelay()
+	defer func() { s.lastCommand = time.Now() }()
+
+	if !s.pacing.VerifyEcho {
+		if _, err := fmt.Fprintf(s.stdin, "%s\r", cmd); err != nil {
+			return nil, fmt.Errorf("failed to send command: %w", err)
+		}
+		return nil, nil
+	}
+
+	if _, err := io.WriteString(s.stdin, cmd); err != nil {
+		return nil, fmt.Errorf("failed to send command: %w", err)
+	}
+	echo, err := s.readUntilString(cmd, s.timeouts.Login)
+	if err != nil {
+		// Discard the partial line so that the corrupted command never runs
+		if _, clearErr := io.WriteString(s.stdin, clearLine); clearErr != nil {
+			return nil, fmt.Errorf("failed to clear command line: %w", clearErr)
+		}
+		// The echo is left out of the error as it may hold secrets
+		return nil, fmt.Errorf("%w: %q", ErrEchoMismatch, logging.RedactCommand(cmd))
+	}
+	if _, err := io.WriteString(s.stdin, "\r"); err != nil {
+		return nil, fmt.Errorf("failed to send command: %w", err)
+	}
+	return echo, nil
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// consoleInput records what a session types into the router console
+type consoleInput struct {
+	mu     sync.Mutex
+	buf    bytes.Buffer
+	writes int
+}
+
+func (c *consoleInput) Write(p []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.writes++
+	return c.buf.Write(p)
+}
+
+func (c *consoleInput) Close() error { return nil }
+
+func (c *consoleInput) String() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.buf.String()
+}
+
+// newPacingTestSession returns a session whose console output is echo
+func newPacingTestSession(input *consoleInput, pacing Pacing, echo string) *workingSession {
+	s := &workingSession{
+		stdin:    input,
+		timeouts: Timeouts{Login: 50 * time.Millisecond}.withDefaults(),
+		pacing:   pacing,
+		readCh:   make(chan readResult, len(echo)+1),
+	}
+	for i := 0; i < len(echo); i++ {
+		s.readCh <- readResult{b: echo[i]}
+	}
+	return s
+}
+
+func TestPacedWriter(t *testing.T) {
+	input := &consoleInput{}
+	w := newPacedWriter(input, 5*time.Millisecond)
+
+	start := time.Now()
+	n, err := w.Write([]byte("save"))
+	require.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	assert.Equal(t, "save", input.String())
+	assert.Equal(t, 4, input.writes, "each character should be written separately")
+
+	assert.Same(t, input, newPacedWriter(input, 0), "zero delay should not wrap the writer")
+}
+
+func TestWorkingSession_SendCommandLine(t *testing.T) {
+	t.Run("without echo verification", func(t *testing.T) {
+		input := &consoleInput{}
+		s := newPacingTestSession(input, Pacing{}, "")
+
+		echo, err := s.sendCommandLine("show config")
+		require.NoError(t, err)
+		assert.Empty(t, echo)
+		assert.Equal(t, "show config\r", input.String())
+	})
+
+	t.Run("verified echo", func(t *testing.T) {
+		input := &consoleInput{}
+		s := newPacingTestSession(input, Pacing{VerifyEcho: true}, "ip route default gateway pp 1")
+
+		echo, err := s.sendCommandLine("ip route default gateway pp 1")
+		require.NoError(t, err)
+		assert.Equal(t, "ip route default gateway pp 1", string(echo))
+		assert.Equal(t, "ip route default gateway pp 1\r", input.String())
+	})
+
+	t.Run("dropped characters are not run", func(t *testing.T) {
+		input := &consoleInput{}
+		s := newPacingTestSession(input, Pacing{VerifyEcho: true}, "ip rute default gateway pp 1")
+
+		_, err := s.sendCommandLine("ip route default gateway pp 1")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrEchoMismatch))
+		assert.True(t, IsRetryable(err), "a command that was not run can be retried")
+		assert.Equal(t, "ip route default gateway pp 1"+clearLine, input.String(), "the line should be cleared instead of entered")
+	})
+
+	t.Run("command delay", func(t *testing.T) {
+		input := &consoleInput{}
+		s := newPacingTestSession(input, Pacing{CommandDelay: 30 * time.Millisecond}, "")
+
+		start := time.Now()
+		_, err := s.sendCommandLine("show config")
+		require.NoError(t, err)
+		assert.Less(t, time.Since(start), 30*time.Millisecond, "the first command should not wait")
+
+		_, err = s.sendCommandLine("show environment")
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+	})
+}
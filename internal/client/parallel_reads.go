@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"sync"
+)
+
+// readConcurrency returns how many read commands may run at once: one per
+// pooled SSH connection, or one at a time without a pool
+func (c *rtxClient) readConcurrency() int {
+	if c == nil {
+		return 1
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.sshConnectionPool == nil {
+		return 1
+	}
+	return max(c.sshConnectionPool.config.MaxSessions, 1)
+}
+
+// readConcurrently calls read for every key with at most limit calls in
+// flight, and returns the results and errors in the order of keys. Once the
+// context is canceled, remaining keys fail with its error.
+func readConcurrently[T any](ctx context.Context, limit int, keys []string, read func(ctx context.Context, key string) (T, error)) ([]T, []error) {
+	results := make([]T, len(keys))
+	errs := make([]error, len(keys))
+	if limit < 1 {
+		limit = 1
+	}
+
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			for j := i; j < len(keys); j++ {
+				errs[j] = err
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, key string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i], errs[i] = read(ctx, key)
+		}(i, key)
+	}
+	wg.Wait()
+	return results, errs
+}
+
+// derefAll returns the values behind ptrs
+func derefAll[T any](ptrs []*T) []T {
+	values := make([]T, len(ptrs))
+	for i, p := range ptrs {
+		values[i] = *p
+	}
+	return values
+}
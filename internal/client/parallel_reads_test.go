@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadConcurrently(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	read := func(ctx context.Context, key string) (string, error) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if key == "lan3" {
+			return "", errors.New("not found")
+		}
+		return "status " + key, nil
+	}
+
+	results, errs := readConcurrently(context.Background(), 2, []string{"lan1", "lan2", "lan3", "lan4", "lan5"}, read)
+
+	assert.Equal(t, []string{"status lan1", "status lan2", "", "status lan4", "status lan5"}, results)
+	assert.NoError(t, errs[0])
+	assert.Error(t, errs[2])
+	assert.Equal(t, int32(2), maxInFlight.Load(), "at most limit reads should run at once")
+}
+
+func TestReadConcurrently_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, errs := readConcurrently(ctx, 1, []string{"lan1", "lan2"}, func(ctx context.Context, key string) (string, error) {
+		return key, nil
+	})
+	assert.ErrorIs(t, errs[0], context.Canceled)
+	assert.ErrorIs(t, errs[1], context.Canceled)
+}
+
+func TestReadConcurrency(t *testing.T) {
+	var nilClient *rtxClient
+	assert.Equal(t, 1, nilClient.readConcurrency())
+	assert.Equal(t, 1, (&rtxClient{}).readConcurrency())
+	assert.Equal(t, 3, (&rtxClient{sshConnectionPool: &SSHConnectionPool{config: SSHPoolConfig{MaxSessions: 3}}}).readConcurrency())
+}
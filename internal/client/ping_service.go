@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// PingService runs ping from the router for reachability checks
+type PingService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewPingService creates a new ping service instance
+func NewPingService(executor Executor, client *rtxClient) *PingService {
+	return &PingService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Ping sends echo requests to the host and returns the loss and latency summary.
+// Unreachable hosts are reported through the result, not as an error.
+func (s *PingService) Ping(ctx context.Context, opts PingOptions) (*PingResult, error) {
+	parserOpts := parsers.PingOptions(opts)
+	if err := parsers.ValidatePingOptions(parserOpts); err != nil {
+		return nil, err
+	}
+
+	if parserOpts.SourceInterface != "" {
+		address, err := s.sourceAddress(ctx, parserOpts.SourceInterface)
+		if err != nil {
+			return nil, err
+		}
+		parserOpts.SourceAddress = address
+	}
+
+	cmd := parsers.BuildPingCommand(parserOpts)
+	logging.FromContext(ctx).Debug().Str("service", "ping").Msgf("Running ping with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ping %s: %w", opts.Host, err)
+	}
+
+	parsed, err := parsers.ParsePingResult(opts.Host, string(output))
+	if err != nil {
+		return nil, fmt.Errorf("failed to ping %s: %w: %s", opts.Host, err, strings.TrimSpace(string(output)))
+	}
+
+	result := PingResult(*parsed)
+	return &result, nil
+}
+
+// sourceAddress reads the configuration of an interface and returns the
+// address to send echo requests from
+func (s *PingService) sourceAddress(ctx context.Context, iface string) (string, error) {
+	cmd := parsers.BuildShowInterfaceConfigCommand(iface)
+	logging.FromContext(ctx).Debug().Str("service", "ping").Msgf("Reading source interface with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return "", fmt.Errorf("failed to read source interface %s: %w", iface, err)
+	}
+
+	config, err := parsers.ParseInterfaceConfig(string(output), iface)
+	if err != nil {
+		return "", fmt.Errorf("failed to read source interface %s: %w", iface, err)
+	}
+	return parsers.PingSourceAddress(config)
+}
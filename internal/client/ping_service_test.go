@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// float64Ptr is a helper function to create *float64 pointers for test data
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+func TestPingService_Ping(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      PingOptions
+		mockSetup func(*MockExecutor)
+		expected  *PingResult
+		expectErr bool
+	}{
+		{
+			name: "host reachable",
+			opts: PingOptions{Host: "8.8.8.8", Count: 2, TimeoutSeconds: 1, SourceAddress: "192.168.1.1"},
+			mockSetup: func(m *MockExecutor) {
+				m.On("Run", mock.Anything, "ping -c 2 -w 1 -sa 192.168.1.1 8.8.8.8").
+					Return([]byte("--- 8.8.8.8 ping statistics ---\r\n2 packets transmitted, 2 packets received, 0.0% packet loss\r\nround-trip min/avg/max = 4.000/5.000/6.000 ms\r\n"), nil)
+			},
+			expected: &PingResult{
+				Host: "8.8.8.8", PacketsSent: 2, PacketsReceived: 2, PacketLossPercent: 0,
+				RTTMinMs: float64Ptr(4), RTTAvgMs: float64Ptr(5), RTTMaxMs: float64Ptr(6),
+			},
+		},
+		{
+			name: "source interface",
+			opts: PingOptions{Host: "8.8.8.8", Count: 1, TimeoutSeconds: 1, SourceInterface: "lan1"},
+			mockSetup: func(m *MockExecutor) {
+				m.On("Run", mock.Anything, `show config | grep "lan1"`).
+					Return([]byte("ip lan1 address 192.168.1.1/24\ndescription lan1 LAN\n"), nil)
+				m.On("Run", mock.Anything, "ping -c 1 -w 1 -sa 192.168.1.1 8.8.8.8").
+					Return([]byte("1 packets transmitted, 1 packets received, 0.0% packet loss\nround-trip min/avg/max = 3.000/3.000/3.000 ms\n"), nil)
+			},
+			expected: &PingResult{
+				Host: "8.8.8.8", PacketsSent: 1, PacketsReceived: 1, PacketLossPercent: 0,
+				RTTMinMs: float64Ptr(3), RTTAvgMs: float64Ptr(3), RTTMaxMs: float64Ptr(3),
+			},
+		},
+		{
+			name: "source interface without static address",
+			opts: PingOptions{Host: "8.8.8.8", Count: 1, TimeoutSeconds: 1, SourceInterface: "lan2"},
+			mockSetup: func(m *MockExecutor) {
+				m.On("Run", mock.Anything, `show config | grep "lan2"`).
+					Return([]byte("ip lan2 address dhcp\n"), nil)
+			},
+			expectErr: true,
+		},
+		{
+			name: "host unreachable",
+			opts: PingOptions{Host: "192.0.2.1", Count: 2, TimeoutSeconds: 1},
+			mockSetup: func(m *MockExecutor) {
+				m.On("Run", mock.Anything, "ping -c 2 -w 1 192.0.2.1").
+					Return([]byte("2 packets transmitted, 0 packets received, 100.0% packet loss\n"), nil)
+			},
+			expected: &PingResult{Host: "192.0.2.1", PacketsSent: 2, PacketsReceived: 0, PacketLossPercent: 100},
+		},
+		{
+			name: "unknown host",
+			opts: PingOptions{Host: "www.invalid", Count: 1, TimeoutSeconds: 1},
+			mockSetup: func(m *MockExecutor) {
+				m.On("Run", mock.Anything, "ping -c 1 -w 1 www.invalid").
+					Return([]byte("ping: unknown host www.invalid\n"), nil)
+			},
+			expectErr: true,
+		},
+		{
+			name:      "invalid options",
+			opts:      PingOptions{Host: "8.8.8.8", Count: 0, TimeoutSeconds: 1},
+			mockSetup: func(m *MockExecutor) {},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExecutor := new(MockExecutor)
+			tt.mockSetup(mockExecutor)
+
+			service := NewPingService(mockExecutor, nil)
+			result, err := service.Ping(context.Background(), tt.opts)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			mockExecutor.AssertExpectations(t)
+		})
+	}
+}
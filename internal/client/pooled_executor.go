@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -10,9 +11,9 @@ import (
 )
 
 const (
-	// maxRetries is the maximum number of retry attempts for failed commands
+	// maxRetries is the default maximum number of retry attempts for failed commands
 	maxRetries = 2
-	// retryBaseDelay is the base delay between retries
+	// retryBaseDelay is the default base delay between retries
 	retryBaseDelay = 100 * time.Millisecond
 )
 
@@ -21,14 +22,24 @@ type PooledExecutor struct {
 	pool           *SSHConnectionPool
 	promptDetector PromptDetector
 	config         *Config
+	retryStrategy  RetryStrategy
 }
 
-// NewPooledExecutor creates a new pooled executor
-func NewPooledExecutor(pool *SSHConnectionPool, promptDetector PromptDetector, config *Config) Executor {
+// NewPooledExecutor creates a new pooled executor.
+// If retryStrategy is nil, failed commands are retried maxRetries times.
+func NewPooledExecutor(pool *SSHConnectionPool, promptDetector PromptDetector, config *Config, retryStrategy RetryStrategy) Executor {
+	if retryStrategy == nil {
+		retryStrategy = &ExponentialBackoff{
+			BaseDelay:  retryBaseDelay,
+			MaxDelay:   retryBaseDelay * maxRetries,
+			MaxRetries: maxRetries,
+		}
+	}
 	return &PooledExecutor{
 		pool:           pool,
 		promptDetector: promptDetector,
 		config:         config,
+		retryStrategy:  retryStrategy,
 	}
 }
 
@@ -37,7 +48,7 @@ func (e *PooledExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
 	logger := logging.FromContext(ctx)
 
 	// Log command with resource context if available
-	logEvent := logger.Info().Str("command", logging.SanitizeString(cmd))
+	logEvent := logger.Info().Str("command", logging.RedactCommand(cmd))
 	if res := logging.ResourceFromContext(ctx); res != nil {
 		logEvent = logEvent.Str("resource", res.Type)
 		if res.ID != "" {
@@ -46,26 +57,21 @@ func (e *PooledExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
 	}
 	logEvent.Msg("RTX command (pooled)")
 
-	return e.executeWithRetry(ctx, cmd, maxRetries)
+	return e.executeWithRetry(ctx, cmd)
 }
 
-// executeWithRetry executes a command with retry logic on connection failure
-func (e *PooledExecutor) executeWithRetry(ctx context.Context, cmd string, retries int) ([]byte, error) {
+// executeWithRetry executes a command, retrying transient failures (connection
+// drops, login races, busy responses) according to the retry strategy.
+// Configuration commands are only resent when they did not reach the router.
+func (e *PooledExecutor) executeWithRetry(ctx context.Context, cmd string) ([]byte, error) {
 	logger := logging.FromContext(ctx)
-	var lastErr error
-
-	for attempt := 0; attempt <= retries; attempt++ {
-		// Check context cancellation
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-		}
+	var output []byte
 
+	err := retryWithStrategy(ctx, e.retryStrategy, isReadOnlyCommand(cmd), func(attempt int) error {
 		// Acquire connection from pool
 		conn, err := e.pool.Acquire(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to acquire SSH connection: %w", err)
+			return fmt.Errorf("failed to acquire SSH connection: %w", err)
 		}
 
 		// Prepare connection (admin authentication if needed)
@@ -76,35 +82,43 @@ func (e *PooledExecutor) executeWithRetry(ctx context.Context, cmd string, retri
 				Int("attempt", attempt+1).
 				Msg("PooledExecutor: Failed to prepare connection, discarding")
 			e.pool.Discard(conn)
-			lastErr = fmt.Errorf("failed to prepare connection: %w", err)
-			if attempt < retries {
-				time.Sleep(retryBaseDelay * time.Duration(attempt+1))
+			if errors.Is(err, ErrAuthFailed) {
+				return fmt.Errorf("failed to prepare connection: %w", err)
 			}
-			continue
+			// Concurrent administrator logins can race; a fresh connection usually succeeds
+			return &RetryableError{Err: fmt.Errorf("failed to prepare connection: %w", err)}
 		}
 
 		// Execute command on connection
-		output, err := e.executeOnConnection(ctx, conn, cmd)
+		out, err := e.executeOnConnection(ctx, conn, cmd)
 		if err != nil {
+			if errors.Is(err, ErrRouterBusy) {
+				// The connection itself is healthy
+				e.pool.Release(conn)
+				return err
+			}
 			logger.Warn().
 				Err(err).
 				Int("attempt", attempt+1).
-				Int("max_retries", retries).
 				Msg("PooledExecutor: Command execution failed, discarding connection")
 			e.pool.Discard(conn)
-			lastErr = err
-			if attempt < retries {
-				time.Sleep(retryBaseDelay * time.Duration(attempt+1))
+			err = &commandSentError{Err: err}
+			if conn.isDead() {
+				// The connection was lost; the next attempt reconnects
+				return &RetryableError{Err: err}
 			}
-			continue
+			return err
 		}
 
 		// Success - release connection back to pool
 		e.pool.Release(conn)
-		return output, nil
+		output = out
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	return nil, fmt.Errorf("command failed after %d attempts: %w", retries+1, lastErr)
+	return output, nil
 }
 
 // executeOnConnection executes a command on the given connection
@@ -112,11 +126,25 @@ func (e *PooledExecutor) executeOnConnection(ctx context.Context, conn *PooledCo
 	logger := logging.FromContext(ctx)
 
 	// Execute the command
-	output, err := conn.Send(cmd)
+	output, err := conn.SendContext(ctx, cmd)
 	if err != nil {
 		return nil, fmt.Errorf("command execution failed: %w", err)
 	}
 
+	// The router refused the command at user level: escalate and run it again.
+	// The connection stays escalated until it is closed.
+	if !conn.adminMode && IsAdminRequiredOutput(output) {
+		logger.Debug().Str("pool_id", conn.poolID).Msg("PooledExecutor: Router requires administrator level, escalating")
+		if err := e.prepareConnection(ctx, conn, true); err != nil {
+			return nil, fmt.Errorf("%w for %q: %w", ErrAdminRequired, cmd, err)
+		}
+
+		output, err = conn.SendContext(ctx, cmd)
+		if err != nil {
+			return nil, fmt.Errorf("command execution failed: %w", err)
+		}
+	}
+
 	// Check for prompt
 	matched, prompt := e.promptDetector.DetectPrompt(output)
 	if !matched {
@@ -125,36 +153,22 @@ func (e *PooledExecutor) executeOnConnection(ctx context.Context, conn *PooledCo
 	}
 	logger.Debug().Str("prompt", prompt).Msg("PooledExecutor: Prompt detected")
 
+	if IsBusyOutput(output) {
+		return nil, fmt.Errorf("%w: %s", ErrRouterBusy, strings.TrimSpace(string(output)))
+	}
+
 	return output, nil
 }
 
 // requiresAdminPrivileges checks if a command requires administrator privileges.
 // Read-only commands (show, console) do not require admin privileges.
-// Configuration commands require admin authentication when password is configured.
+// Configuration commands require admin authentication when password is configured;
+// without a password, escalation happens only when the router asks for it.
 func (e *PooledExecutor) requiresAdminPrivileges(cmd string) bool {
-	hasConfig := e.config != nil
-	hasPassword := hasConfig && e.config.AdminPassword != ""
-	if !hasPassword {
+	if e.config == nil || e.config.AdminPassword == "" {
 		return false
 	}
-
-	// Normalize command for checking
-	cmdLower := strings.ToLower(strings.TrimSpace(cmd))
-
-	// Read-only commands do not require admin privileges
-	readOnlyPrefixes := []string{
-		"show ",    // show commands (show config, show status, show sshd host key, etc.)
-		"console ", // console display commands
-		"less ",    // pager commands
-	}
-	for _, prefix := range readOnlyPrefixes {
-		if strings.HasPrefix(cmdLower, prefix) {
-			return false
-		}
-	}
-
-	// All other commands require admin when password is configured
-	return true
+	return !isReadOnlyCommand(cmd)
 }
 
 // prepareConnection prepares a connection for command execution, including admin authentication if needed
@@ -202,7 +216,7 @@ func (e *PooledExecutor) authenticateAsAdmin(ctx context.Context, conn *PooledCo
 	}
 
 	// Read until we get password prompt or admin prompt (already administrator)
-	response, err := ws.readUntilPasswordPromptOrAdminMode(10 * time.Second)
+	response, err := ws.readUntilPasswordPromptOrAdminMode(ws.timeouts.Login)
 	if err != nil {
 		return fmt.Errorf("failed to get response after administrator command: %w", err)
 	}
@@ -242,7 +256,7 @@ func (e *PooledExecutor) authenticateAsAdmin(ctx context.Context, conn *PooledCo
 	logger.Debug().Int("bytes_written", n).Msg("PooledExecutor: Password sent")
 
 	// Read response after password - look for administrator prompt (# instead of >)
-	response, err = ws.readUntilPrompt(10 * time.Second)
+	response, err = ws.readUntilPrompt(ws.timeouts.Login)
 	if err != nil {
 		return fmt.Errorf("failed to read password response: %w", err)
 	}
@@ -253,7 +267,7 @@ func (e *PooledExecutor) authenticateAsAdmin(ctx context.Context, conn *PooledCo
 	// Check for authentication failure (English and Japanese)
 	if strings.Contains(responseStr, "incorrect") || strings.Contains(responseStr, "failed") || strings.Contains(responseStr, "Invalid") ||
 		strings.Contains(responseStr, "エラー") || strings.Contains(responseStr, "パスワードが違います") {
-		return fmt.Errorf("administrator authentication failed: %s", responseStr)
+		return fmt.Errorf("administrator %w: %s", ErrAuthFailed, responseStr)
 	}
 
 	// Verify we actually got the admin prompt (#) not user prompt (>)
@@ -295,14 +309,14 @@ func (e *PooledExecutor) RunBatch(ctx context.Context, cmds []string) ([]byte, e
 	}
 
 	var allOutput []byte
-	for _, cmd := range cmds {
-		logger.Info().Str("command", logging.SanitizeString(cmd)).Msg("RTX batch command (pooled)")
+	for i, cmd := range cmds {
+		logger.Info().Str("command", logging.RedactCommand(cmd)).Msg("RTX batch command (pooled)")
 
 		output, err := e.executeOnConnection(ctx, conn, cmd)
 		if err != nil {
 			// On failure, discard connection and return partial output
 			e.pool.Discard(conn)
-			return allOutput, fmt.Errorf("batch command '%s' failed: %w", cmd, err)
+			return allOutput, &BatchCommandError{Index: i, Command: cmd, Err: err}
 		}
 		allOutput = append(allOutput, output...)
 	}
@@ -344,7 +358,7 @@ func (e *PooledExecutor) SetAdministratorPassword(ctx context.Context, oldPasswo
 	}
 
 	// Wait for Old_Password: prompt
-	_, err = ws.readUntilString("Old_Password:", 10*time.Second)
+	_, err = ws.readUntilString("Old_Password:", ws.timeouts.Login)
 	if err != nil {
 		e.pool.Discard(conn)
 		return fmt.Errorf("failed to get Old_Password prompt: %w", err)
@@ -358,7 +372,7 @@ func (e *PooledExecutor) SetAdministratorPassword(ctx context.Context, oldPasswo
 	}
 
 	// Wait for first New_Password: prompt
-	_, err = ws.readUntilString("New_Password:", 10*time.Second)
+	_, err = ws.readUntilString("New_Password:", ws.timeouts.Login)
 	if err != nil {
 		e.pool.Discard(conn)
 		return fmt.Errorf("failed to get first New_Password prompt: %w", err)
@@ -372,7 +386,7 @@ func (e *PooledExecutor) SetAdministratorPassword(ctx context.Context, oldPasswo
 	}
 
 	// Wait for second New_Password: prompt (confirmation)
-	_, err = ws.readUntilString("New_Password:", 10*time.Second)
+	_, err = ws.readUntilString("New_Password:", ws.timeouts.Login)
 	if err != nil {
 		e.pool.Discard(conn)
 		return fmt.Errorf("failed to get second New_Password prompt: %w", err)
@@ -386,7 +400,7 @@ func (e *PooledExecutor) SetAdministratorPassword(ctx context.Context, oldPasswo
 	}
 
 	// Wait for completion (Password Strength or prompt)
-	response, err := ws.readUntilPrompt(10 * time.Second)
+	response, err := ws.readUntilPrompt(ws.timeouts.Login)
 	if err != nil {
 		e.pool.Discard(conn)
 		return fmt.Errorf("failed to read password change response: %w", err)
@@ -439,7 +453,7 @@ func (e *PooledExecutor) SetLoginPassword(ctx context.Context, newPassword strin
 	}
 
 	// Wait for New_Password: prompt (login password may not have old password prompt if not set)
-	_, err = ws.readUntilString("New_Password:", 10*time.Second)
+	_, err = ws.readUntilString("New_Password:", ws.timeouts.Login)
 	if err != nil {
 		e.pool.Discard(conn)
 		return fmt.Errorf("failed to get first New_Password prompt: %w", err)
@@ -453,7 +467,7 @@ func (e *PooledExecutor) SetLoginPassword(ctx context.Context, newPassword strin
 	}
 
 	// Wait for second New_Password: prompt (confirmation)
-	_, err = ws.readUntilString("New_Password:", 10*time.Second)
+	_, err = ws.readUntilString("New_Password:", ws.timeouts.Login)
 	if err != nil {
 		e.pool.Discard(conn)
 		return fmt.Errorf("failed to get second New_Password prompt: %w", err)
@@ -467,7 +481,7 @@ func (e *PooledExecutor) SetLoginPassword(ctx context.Context, newPassword strin
 	}
 
 	// Wait for completion (Password Strength or prompt)
-	response, err := ws.readUntilPrompt(10 * time.Second)
+	response, err := ws.readUntilPrompt(ws.timeouts.Login)
 	if err != nil {
 		e.pool.Discard(conn)
 		return fmt.Errorf("failed to read password change response: %w", err)
@@ -524,7 +538,7 @@ func (e *PooledExecutor) GenerateSSHDHostKey(ctx context.Context) error {
 	// Read response - either:
 	// 1. Confirmation prompt (Y/N) if host key already exists
 	// 2. Direct completion with prompt if no existing key
-	keyGenTimeout := 10 * time.Minute
+	keyGenTimeout := ws.timeouts.longOperationTimeout(ctx)
 	response, err := ws.readUntilPromptOrConfirmation(keyGenTimeout)
 	if err != nil {
 		e.pool.Discard(conn)
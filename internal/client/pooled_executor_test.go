@@ -55,7 +55,7 @@ func TestNewPooledExecutor(t *testing.T) {
 	promptDetector := &mockPromptDetector{matched: true, prompt: ">"}
 	rtxConfig := &Config{}
 
-	executor := NewPooledExecutor(pool, promptDetector, rtxConfig)
+	executor := NewPooledExecutor(pool, promptDetector, rtxConfig, nil)
 
 	assert.NotNil(t, executor, "executor should not be nil")
 	pe, ok := executor.(*PooledExecutor)
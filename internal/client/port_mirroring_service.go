@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// PortMirroringService handles "lan port-mirroring" operations
+type PortMirroringService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewPortMirroringService creates a new port mirroring service instance
+func NewPortMirroringService(executor Executor, client *rtxClient) *PortMirroringService {
+	return &PortMirroringService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the mirroring session of a LAN interface
+func (s *PortMirroringService) Get(ctx context.Context, iface string) (*PortMirroring, error) {
+	sessions, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, session := range sessions {
+		if session.Interface == iface {
+			return &session, nil
+		}
+	}
+
+	return nil, fmt.Errorf("port mirroring on %s not found", iface)
+}
+
+// List retrieves all configured port mirroring sessions
+func (s *PortMirroringService) List(ctx context.Context) ([]PortMirroring, error) {
+	cmd := parsers.BuildShowPortMirroringCommand()
+	logging.FromContext(ctx).Debug().Str("service", "port_mirroring").Msgf("Listing port mirroring sessions with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list port mirroring sessions: %w", err)
+	}
+
+	parsed := parsers.ParsePortMirrorings(string(output))
+	sessions := make([]PortMirroring, len(parsed))
+	for i, p := range parsed {
+		sessions[i] = PortMirroring(p)
+	}
+	return sessions, nil
+}
+
+// Create starts a mirroring session on a LAN interface
+func (s *PortMirroringService) Create(ctx context.Context, session PortMirroring) error {
+	return s.apply(ctx, session, "created")
+}
+
+// Update changes a mirroring session. The command replaces the previous
+// session of the interface, so no delete is needed.
+func (s *PortMirroringService) Update(ctx context.Context, session PortMirroring) error {
+	return s.apply(ctx, session, "updated")
+}
+
+// apply validates and writes the port mirroring session
+func (s *PortMirroringService) apply(ctx context.Context, session PortMirroring, action string) error {
+	parserSession := parsers.PortMirroring(session)
+	if err := parsers.ValidatePortMirroring(parserSession); err != nil {
+		return fmt.Errorf("invalid port mirroring: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	cmd := parsers.BuildPortMirroringCommand(parserSession)
+	logging.FromContext(ctx).Debug().Str("service", "port_mirroring").Msgf("Applying port mirroring with command: %s", cmd)
+
+	if err := runCommand(ctx, s.executor, cmd); err != nil {
+		return fmt.Errorf("failed to configure port mirroring on %s: %w", session.Interface, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("port mirroring on %s %s", session.Interface, action))
+}
+
+// Delete stops the mirroring session of a LAN interface
+func (s *PortMirroringService) Delete(ctx context.Context, iface string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	cmd := parsers.BuildDeletePortMirroringCommand(iface)
+	logging.FromContext(ctx).Debug().Str("service", "port_mirroring").Msgf("Deleting port mirroring with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return fmt.Errorf("failed to delete port mirroring on %s: %w", iface, err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete port mirroring"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("port mirroring on %s deleted", iface))
+}
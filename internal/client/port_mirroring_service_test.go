@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testPortMirroringConfig = `lan port-mirroring lan1 1 in 2,3 out 2,3
+`
+
+func TestPortMirroringService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "lan port-mirroring"`).Return([]byte(testPortMirroringConfig), nil)
+
+	service := NewPortMirroringService(mockExecutor, nil)
+
+	session, err := service.Get(context.Background(), "lan1")
+	assert.NoError(t, err)
+	assert.Equal(t, &PortMirroring{Interface: "lan1", DestinationPort: 1, SourcePorts: []int{2, 3}, Direction: "both"}, session)
+
+	_, err = service.Get(context.Background(), "lan2")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestPortMirroringService_Create(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "lan port-mirroring lan1 1 out 2").Return([]byte(""), nil)
+
+	service := NewPortMirroringService(mockExecutor, nil)
+
+	err := service.Create(context.Background(), PortMirroring{Interface: "lan1", DestinationPort: 1, SourcePorts: []int{2}, Direction: "out"})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+
+	err = service.Create(context.Background(), PortMirroring{Interface: "lan1", DestinationPort: 1, SourcePorts: []int{1}, Direction: "out"})
+	assert.ErrorContains(t, err, "invalid port mirroring")
+}
+
+func TestPortMirroringService_Delete(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "no lan port-mirroring lan1").Return([]byte(""), nil)
+
+	service := NewPortMirroringService(mockExecutor, nil)
+
+	err := service.Delete(context.Background(), "lan1")
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// PPStatusService handles PP session state queries ("show status pp N")
+type PPStatusService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewPPStatusService creates a new PP status service instance
+func NewPPStatusService(executor Executor, client *rtxClient) *PPStatusService {
+	return &PPStatusService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the session state of a PP interface
+func (s *PPStatusService) Get(ctx context.Context, ppNum int) (*PPStatus, error) {
+	if ppNum < 1 {
+		return nil, fmt.Errorf("invalid PP number: %d", ppNum)
+	}
+
+	cmd := parsers.BuildShowPPStatusCommand(ppNum)
+	logging.FromContext(ctx).Debug().Str("service", "pp_status").Msgf("Getting PP status with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status of pp %d: %w", ppNum, err)
+	}
+	if line := rejectionLine(string(output)); line != "" {
+		return nil, fmt.Errorf("status of pp %d not found: %s", ppNum, line)
+	}
+
+	parsed, err := parsers.ParsePPStatus(ppNum, string(output))
+	if err != nil {
+		return nil, err
+	}
+
+	status := PPStatus(*parsed)
+	return &status, nil
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPPStatusService_Get(t *testing.T) {
+	t.Run("connected", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status pp 1").Return([]byte(`PP[01]:
+PPPoE session is connected.
+Time Connected: 00:10:00
+ PP IP Address Local: 203.0.113.10, Remote: 203.0.113.1
+ PP DNS Server: primary 198.51.100.1
+`), nil)
+
+		service := NewPPStatusService(mockExecutor, nil)
+
+		status, err := service.Get(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, &PPStatus{
+			PPNumber:      1,
+			Connected:     true,
+			LocalAddress:  "203.0.113.10",
+			RemoteAddress: "203.0.113.1",
+			DNSServers:    []string{"198.51.100.1"},
+			UptimeSeconds: 600,
+		}, status)
+	})
+
+	t.Run("disconnect reason is not an error", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status pp 1").Return([]byte(`PP[01]:
+PPPoE session is disconnected.
+Last disconnection reason: access concentrator not found
+`), nil)
+
+		service := NewPPStatusService(mockExecutor, nil)
+
+		status, err := service.Get(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.False(t, status.Connected)
+	})
+
+	t.Run("unknown pp", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status pp 99").Return([]byte("Error: Invalid PP number\n"), nil)
+
+		service := NewPPStatusService(mockExecutor, nil)
+
+		_, err := service.Get(context.Background(), 99)
+		assert.ErrorContains(t, err, "not found")
+	})
+}
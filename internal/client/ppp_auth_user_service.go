@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// PPPAuthUserService handles "pp auth username" operations
+type PPPAuthUserService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewPPPAuthUserService creates a new PP auth user service instance
+func NewPPPAuthUserService(executor Executor, client *rtxClient) *PPPAuthUserService {
+	return &PPPAuthUserService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves a PP auth user
+func (s *PPPAuthUserService) Get(ctx context.Context, pp, username string) (*PPPAuthUser, error) {
+	users, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		if user.PP == pp && user.Username == username {
+			return &user, nil
+		}
+	}
+
+	return nil, fmt.Errorf("PP auth user %s in pp %s not found", username, pp)
+}
+
+// List retrieves all PP auth users
+func (s *PPPAuthUserService) List(ctx context.Context) ([]PPPAuthUser, error) {
+	cmd := parsers.BuildShowPPAuthUsersCommand()
+	logging.FromContext(ctx).Debug().Str("service", "ppp_auth_user").Msgf("Listing PP auth users with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PP auth users: %w", err)
+	}
+
+	parsed := parsers.ParsePPAuthUsers(string(output))
+	users := make([]PPPAuthUser, len(parsed))
+	for i, p := range parsed {
+		users[i] = s.fromParserUser(p)
+	}
+	return users, nil
+}
+
+// Create adds a new PP auth user
+func (s *PPPAuthUserService) Create(ctx context.Context, user PPPAuthUser) error {
+	return s.apply(ctx, user, "created")
+}
+
+// Update changes an existing PP auth user. The command replaces the previous
+// entry with the same username, so no delete is needed.
+func (s *PPPAuthUserService) Update(ctx context.Context, user PPPAuthUser) error {
+	return s.apply(ctx, user, "updated")
+}
+
+// apply validates and writes the PP auth user
+func (s *PPPAuthUserService) apply(ctx context.Context, user PPPAuthUser, action string) error {
+	parserUser := s.toParserUser(user)
+	if err := parsers.ValidatePPAuthUser(parserUser); err != nil {
+		return fmt.Errorf("invalid PP auth user: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	// The command carries the password, so only the target is logged
+	logging.FromContext(ctx).Debug().Str("service", "ppp_auth_user").Msgf("Applying PP auth user %s in pp %s", user.Username, user.PP)
+
+	if err := runBatchCommands(ctx, s.executor, parsers.BuildPPAuthUserCommands(parserUser)); err != nil {
+		return fmt.Errorf("failed to apply PP auth user %s: %w", user.Username, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("PP auth user %s %s", user.Username, action))
+}
+
+// Delete removes a PP auth user
+func (s *PPPAuthUserService) Delete(ctx context.Context, pp, username string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := parsers.BuildDeletePPAuthUserCommands(pp, username)
+	logging.FromContext(ctx).Debug().Str("service", "ppp_auth_user").Msgf("Deleting PP auth user with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to delete PP auth user %s: %w", username, err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete PP auth user"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("PP auth user %s deleted", username))
+}
+
+// toParserUser converts client.PPPAuthUser to parsers.PPAuthUser
+func (s *PPPAuthUserService) toParserUser(u PPPAuthUser) parsers.PPAuthUser {
+	return parsers.PPAuthUser{
+		PP:        u.PP,
+		Username:  u.Username,
+		Password:  u.Password,
+		IPAddress: u.IPAddress,
+	}
+}
+
+// fromParserUser converts parsers.PPAuthUser to client.PPPAuthUser
+func (s *PPPAuthUserService) fromParserUser(u parsers.PPAuthUser) PPPAuthUser {
+	return PPPAuthUser{
+		PP:        u.PP,
+		Username:  u.Username,
+		Password:  u.Password,
+		IPAddress: u.IPAddress,
+	}
+}
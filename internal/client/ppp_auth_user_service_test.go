@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testPPAuthUserConfig = `pp select anonymous
+ pp auth accept chap
+ pp auth username alice secret1
+ pp auth username bob secret2 192.168.100.10
+pp select none
+`
+
+func TestPPPAuthUserService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(testPPAuthUserConfig), nil)
+
+	service := NewPPPAuthUserService(mockExecutor, nil)
+
+	user, err := service.Get(context.Background(), "anonymous", "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, &PPPAuthUser{PP: "anonymous", Username: "bob", Password: "secret2", IPAddress: "192.168.100.10"}, user)
+
+	_, err = service.Get(context.Background(), "1", "bob")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestPPPAuthUserService_Create(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"pp select anonymous",
+		"pp auth username alice secret1",
+		"pp select none",
+	}).Return([]byte(""), nil)
+
+	service := NewPPPAuthUserService(mockExecutor, nil)
+
+	err := service.Create(context.Background(), PPPAuthUser{PP: "anonymous", Username: "alice", Password: "secret1"})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestPPPAuthUserService_Delete(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"pp select anonymous",
+		"no pp auth username alice",
+		"pp select none",
+	}).Return([]byte(""), nil)
+
+	service := NewPPPAuthUserService(mockExecutor, nil)
+
+	err := service.Delete(context.Background(), "anonymous", "alice")
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
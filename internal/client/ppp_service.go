@@ -89,7 +89,7 @@ func (s *PPPService) Create(ctx context.Context, config PPPoEConfig) error {
 	// Build and execute commands
 	commands := parsers.BuildPPPoECommand(parserConfig)
 	for _, cmd := range commands {
-		logging.FromContext(ctx).Debug().Str("service", "UpppService").Msgf("Executing PPPoE command: %s", cmd)
+		logging.FromContext(ctx).Debug().Str("service", "UpppService").Msgf("Executing PPPoE command: %s", logging.RedactCommand(cmd))
 		if _, err := s.executor.Run(ctx, cmd); err != nil {
 			return fmt.Errorf("failed to execute command %q: %w", cmd, err)
 		}
@@ -278,16 +278,15 @@ func (s *PPPService) Delete(ctx context.Context, ppNum int) error {
 
 // GetIPConfig retrieves PP interface IP configuration
 func (s *PPPService) GetIPConfig(ctx context.Context, ppNum int) (*PPIPConfig, error) {
-	cmd := "show config"
-	logging.FromContext(ctx).Debug().Str("service", "UpppService").Msgf("Getting PP IP config with command: %s", cmd)
+	logging.FromContext(ctx).Debug().Str("service", "UpppService").Msgf("Getting PP IP config for PP %d", ppNum)
 
-	output, err := s.executor.Run(ctx, cmd)
+	output, err := configSectionReader(s.executor, s.client).GetSection(ctx, parsers.ConfigSectionPP, ppNum)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get PP IP config: %w", err)
 	}
 
 	parser := parsers.NewPPPParser()
-	parserConfig, err := parser.ParsePPInterfaceConfig(string(output), ppNum)
+	parserConfig, err := parser.ParsePPInterfaceConfig(output, ppNum)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse PP IP config: %w", err)
 	}
@@ -370,16 +369,15 @@ func (s *PPPService) ConfigureIPConfig(ctx context.Context, ppNum int, config PP
 
 // GetIPConfigForPP retrieves PP interface IP configuration by PP number
 func (s *PPPService) GetIPConfigForPP(ctx context.Context, ppNum int) (*PPIPConfig, error) {
-	cmd := "show config"
 	logging.FromContext(ctx).Debug().Str("service", "UpppService").Msgf("Getting PP IP config for PP %d", ppNum)
 
-	output, err := s.executor.Run(ctx, cmd)
+	output, err := configSectionReader(s.executor, s.client).GetSection(ctx, parsers.ConfigSectionPP, ppNum)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get PP IP config: %w", err)
 	}
 
 	parser := parsers.NewPPPParser()
-	parserConfig, err := parser.ParsePPInterfaceConfig(string(output), ppNum)
+	parserConfig, err := parser.ParsePPInterfaceConfig(output, ppNum)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse PP IP config: %w", err)
 	}
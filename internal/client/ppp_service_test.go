@@ -554,3 +554,24 @@ func TestContains(t *testing.T) {
 		})
 	}
 }
+
+func TestPPPService_GetIPConfigForPP_Section(t *testing.T) {
+	executor := newMockPPPExecutor()
+	executor.setResponse("show config pp 2", []byte("pp select 2\r\n ip pp address 203.0.113.1/32\r\n ip pp mtu 1454\r\n pp enable 2\r\n"))
+
+	service := &PPPService{
+		executor: executor,
+		client:   &rtxClient{},
+	}
+
+	config, err := service.GetIPConfigForPP(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("GetIPConfigForPP() unexpected error: %v", err)
+	}
+	if config.Address != "203.0.113.1/32" || config.MTU != 1454 {
+		t.Errorf("GetIPConfigForPP() = %+v, want address 203.0.113.1/32 and MTU 1454", config)
+	}
+	if len(executor.commands) != 1 || executor.commands[0] != "show config pp 2" {
+		t.Errorf("GetIPConfigForPP() ran %v, want only the section read", executor.commands)
+	}
+}
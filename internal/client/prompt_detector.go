@@ -2,7 +2,9 @@ package client
 
 import (
 	"bytes"
+	"fmt"
 	"regexp"
+	"strings"
 )
 
 // defaultPromptDetector is the default implementation for detecting RTX router prompts
@@ -52,3 +54,121 @@ func (d *customPromptDetector) DetectPrompt(output []byte) (matched bool, prompt
 	}
 	return true, string(bytes.TrimSpace(match))
 }
+
+// PromptConfig overrides how console prompts are recognized, for routers with
+// a customized "console prompt". Each field is a regular expression; empty
+// fields keep the built-in detection.
+type PromptConfig struct {
+	// Login matches the user-level prompt, as the last line of output (e.g., `^office> ?$`)
+	Login string
+	// Admin matches the administrator prompt, as the last line of output (e.g., `^office# ?$`)
+	Admin string
+	// Confirmation matches Y/N confirmation prompts anywhere in the pending output
+	Confirmation string
+}
+
+// Prompt patterns used for the kind that is not configured when only one of
+// PromptConfig.Login and PromptConfig.Admin is set
+const (
+	defaultLoginPromptPattern = `>\s?$`
+	defaultAdminPromptPattern = `#\s?$`
+)
+
+// validatePromptConfig checks that the configured prompt patterns compile
+func validatePromptConfig(config *PromptConfig) error {
+	if config == nil {
+		return nil
+	}
+	patterns := []struct{ name, pattern string }{
+		{"login", config.Login},
+		{"admin", config.Admin},
+		{"confirmation", config.Confirmation},
+	}
+	for _, p := range patterns {
+		if p.pattern == "" {
+			continue
+		}
+		if _, err := regexp.Compile(p.pattern); err != nil {
+			return fmt.Errorf("invalid %s prompt pattern %q: %w", p.name, p.pattern, err)
+		}
+	}
+	return nil
+}
+
+// promptPatterns are the compiled prompt overrides. A nil *promptPatterns,
+// or one without login and admin patterns, leaves prompt detection to the
+// built-in heuristics.
+type promptPatterns struct {
+	login        *regexp.Regexp
+	admin        *regexp.Regexp
+	confirmation *regexp.Regexp
+}
+
+// newPromptPatterns compiles the configured prompt patterns; it returns nil
+// when nothing is configured. Patterns are validated with the client config,
+// so ones that do not compile are ignored here.
+func newPromptPatterns(config *PromptConfig) *promptPatterns {
+	if config == nil || (config.Login == "" && config.Admin == "" && config.Confirmation == "") {
+		return nil
+	}
+
+	compile := func(pattern string) *regexp.Regexp {
+		if pattern == "" {
+			return nil
+		}
+		re, _ := regexp.Compile(pattern)
+		return re
+	}
+
+	p := &promptPatterns{
+		login:        compile(config.Login),
+		admin:        compile(config.Admin),
+		confirmation: compile(config.Confirmation),
+	}
+	if p.login != nil && p.admin == nil {
+		p.admin = regexp.MustCompile(defaultAdminPromptPattern)
+	}
+	if p.admin != nil && p.login == nil {
+		p.login = regexp.MustCompile(defaultLoginPromptPattern)
+	}
+	return p
+}
+
+// matchPrompt reports whether lastLine is a user or administrator prompt.
+// ok is false when no prompt patterns are configured.
+func (p *promptPatterns) matchPrompt(lastLine string) (matched, ok bool) {
+	if p == nil || p.login == nil {
+		return false, false
+	}
+	line := strings.TrimLeft(lastLine, "\r")
+	return p.login.MatchString(line) || p.admin.MatchString(line), true
+}
+
+// matchAdminPrompt reports whether lastLine is the administrator prompt.
+// ok is false when no prompt patterns are configured.
+func (p *promptPatterns) matchAdminPrompt(lastLine string) (matched, ok bool) {
+	if p == nil || p.admin == nil {
+		return false, false
+	}
+	return p.admin.MatchString(strings.TrimLeft(lastLine, "\r")), true
+}
+
+// matchConfirmation reports whether text contains a configured confirmation prompt
+func (p *promptPatterns) matchConfirmation(text string) bool {
+	return p != nil && p.confirmation != nil && p.confirmation.MatchString(text)
+}
+
+// configuredPromptDetector detects prompts with the configured patterns
+type configuredPromptDetector struct {
+	patterns *promptPatterns
+}
+
+// DetectPrompt checks if the last line of output is a configured prompt
+func (d *configuredPromptDetector) DetectPrompt(output []byte) (matched bool, prompt string) {
+	lines := strings.Split(string(output), "\n")
+	lastLine := lines[len(lines)-1]
+	if matched, _ := d.patterns.matchPrompt(lastLine); !matched {
+		return false, ""
+	}
+	return true, strings.TrimSpace(lastLine)
+}
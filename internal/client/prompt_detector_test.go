@@ -0,0 +1,76 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePromptConfig(t *testing.T) {
+	assert.NoError(t, validatePromptConfig(nil))
+	assert.NoError(t, validatePromptConfig(&PromptConfig{Login: `^office\$ ?$`, Confirmation: `\[y/n\]`}))
+
+	err := validatePromptConfig(&PromptConfig{Admin: `^office(# ?$`})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "admin prompt pattern")
+}
+
+func TestNewPromptPatterns(t *testing.T) {
+	assert.Nil(t, newPromptPatterns(nil))
+	assert.Nil(t, newPromptPatterns(&PromptConfig{}))
+
+	t.Run("login only keeps a default admin prompt", func(t *testing.T) {
+		p := newPromptPatterns(&PromptConfig{Login: `^office\$ ?$`})
+
+		matched, ok := p.matchPrompt("office$ ")
+		assert.True(t, ok)
+		assert.True(t, matched)
+
+		matched, _ = p.matchPrompt("\roffice# ")
+		assert.True(t, matched, "administrator prompt should fall back to the default pattern")
+
+		matched, _ = p.matchPrompt("[RTX1210] > ")
+		assert.False(t, matched, "the default user prompt no longer applies once overridden")
+
+		matched, _ = p.matchPrompt("ip route default gateway pp 1")
+		assert.False(t, matched)
+	})
+
+	t.Run("confirmation only keeps built-in prompt detection", func(t *testing.T) {
+		p := newPromptPatterns(&PromptConfig{Confirmation: `Proceed\? \(o/n\)`})
+
+		_, ok := p.matchPrompt("office$ ")
+		assert.False(t, ok)
+		assert.True(t, p.matchConfirmation("Proceed? (o/n) "))
+	})
+}
+
+func TestConfiguredPromptDetector(t *testing.T) {
+	d := &configuredPromptDetector{patterns: newPromptPatterns(&PromptConfig{Login: `^office\$ ?$`, Admin: `^office! ?$`})}
+
+	matched, prompt := d.DetectPrompt([]byte("show environment\r\nRTX1210 Rev.14.01.42\r\noffice! "))
+	assert.True(t, matched)
+	assert.Equal(t, "office!", prompt)
+
+	matched, _ = d.DetectPrompt([]byte("show environment\r\nRTX1210 Rev.14.01.42\r\n[RTX1210] # "))
+	assert.False(t, matched, "only the configured prompts should count")
+}
+
+func TestWorkingSession_CustomPrompt(t *testing.T) {
+	output := "show status lan1\r\nLAN1\r\noffice$ "
+	s := &workingSession{
+		timeouts: Timeouts{}.withDefaults(),
+		prompts:  newPromptPatterns(&PromptConfig{Login: `^office\$ ?$`}),
+		readCh:   make(chan readResult, len(output)),
+	}
+	for i := 0; i < len(output); i++ {
+		s.readCh <- readResult{b: output[i]}
+	}
+
+	got, err := s.readUntilPrompt(100 * time.Millisecond)
+	require.NoError(t, err)
+	assert.Contains(t, string(got), "office$")
+	assert.Equal(t, "LAN1", s.cleanOutput(string(got), "show status lan1"))
+}
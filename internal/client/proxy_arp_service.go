@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// ProxyARPService handles "ip <interface> proxyarp" operations
+type ProxyARPService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewProxyARPService creates a new proxy ARP service instance
+func NewProxyARPService(executor Executor, client *rtxClient) *ProxyARPService {
+	return &ProxyARPService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the interfaces with proxy ARP enabled
+func (s *ProxyARPService) Get(ctx context.Context) (*ProxyARPConfig, error) {
+	cmd := parsers.BuildShowProxyARPCommand()
+	logging.FromContext(ctx).Debug().Str("service", "proxy_arp").Msgf("Getting proxy ARP configuration with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get proxy ARP configuration: %w", err)
+	}
+
+	config := ProxyARPConfig(*parsers.ParseProxyARPConfig(string(output)))
+	return &config, nil
+}
+
+// Configure enables proxy ARP on the given interfaces and disables it on
+// all other interfaces
+func (s *ProxyARPService) Configure(ctx context.Context, config ProxyARPConfig) error {
+	if err := parsers.ValidateProxyARPConfig(parsers.ProxyARPConfig(config)); err != nil {
+		return fmt.Errorf("invalid proxy ARP configuration: %w", err)
+	}
+
+	current, err := s.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var commands []string
+	for _, iface := range current.Interfaces {
+		if !slices.Contains(config.Interfaces, iface) {
+			commands = append(commands, parsers.BuildDeleteProxyARPCommand(iface))
+		}
+	}
+	for _, iface := range config.Interfaces {
+		commands = append(commands, parsers.BuildProxyARPCommand(iface, true))
+	}
+
+	logging.FromContext(ctx).Debug().Str("service", "proxy_arp").Msgf("Configuring proxy ARP with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to configure proxy ARP: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "proxy ARP configured")
+}
+
+// Update enables proxy ARP on the given interfaces and disables it on all
+// other interfaces
+func (s *ProxyARPService) Update(ctx context.Context, config ProxyARPConfig) error {
+	return s.Configure(ctx, config)
+}
+
+// Reset disables proxy ARP on all interfaces
+func (s *ProxyARPService) Reset(ctx context.Context) error {
+	current, err := s.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if len(current.Interfaces) == 0 {
+		return nil
+	}
+
+	commands := make([]string, len(current.Interfaces))
+	for i, iface := range current.Interfaces {
+		commands[i] = parsers.BuildDeleteProxyARPCommand(iface)
+	}
+	logging.FromContext(ctx).Debug().Str("service", "proxy_arp").Msgf("Resetting proxy ARP with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to reset proxy ARP: %w", err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to reset proxy ARP"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, "proxy ARP reset")
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testProxyARPConfig = "ip lan1 proxyarp on\nip lan2 proxyarp on\n"
+
+func TestProxyARPService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config | grep proxyarp").Return([]byte(testProxyARPConfig), nil)
+
+	service := NewProxyARPService(mockExecutor, nil)
+
+	config, err := service.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"lan1", "lan2"}, config.Interfaces)
+}
+
+func TestProxyARPService_Configure(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config | grep proxyarp").Return([]byte(testProxyARPConfig), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no ip lan2 proxyarp",
+		"ip lan1 proxyarp on",
+		"ip bridge1 proxyarp on",
+	}).Return([]byte(""), nil)
+
+	service := NewProxyARPService(mockExecutor, nil)
+	err := service.Configure(context.Background(), ProxyARPConfig{Interfaces: []string{"lan1", "bridge1"}})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestProxyARPService_Reset(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config | grep proxyarp").Return([]byte(testProxyARPConfig), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no ip lan1 proxyarp",
+		"no ip lan2 proxyarp",
+	}).Return([]byte(""), nil)
+
+	service := NewProxyARPService(mockExecutor, nil)
+	assert.NoError(t, service.Reset(context.Background()))
+	mockExecutor.AssertExpectations(t)
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// QoSStatusService handles queue statistics queries ("show status qos")
+type QoSStatusService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewQoSStatusService creates a new QoS status service instance
+func NewQoSStatusService(executor Executor, client *rtxClient) *QoSStatusService {
+	return &QoSStatusService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the queue statistics per class of a LAN interface
+func (s *QoSStatusService) Get(ctx context.Context, iface string) (*QoSStatus, error) {
+	if err := parsers.ValidateInterfaceStatusName(iface); err != nil {
+		return nil, err
+	}
+
+	cmd := parsers.BuildShowQoSStatusCommand(iface)
+	logging.FromContext(ctx).Debug().Str("service", "qos_status").Msgf("Getting QoS status with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get QoS status of %s: %w", iface, err)
+	}
+	if line := rejectionLine(string(output)); line != "" {
+		return nil, fmt.Errorf("failed to get QoS status of %s: %s", iface, line)
+	}
+
+	parsed := parsers.ParseQoSStatus(iface, string(output))
+	status := &QoSStatus{
+		Interface: parsed.Interface,
+		QueueType: parsed.QueueType,
+		Classes:   make([]QoSClassStatus, len(parsed.Classes)),
+	}
+	for i, c := range parsed.Classes {
+		status.Classes[i] = QoSClassStatus(c)
+	}
+	return status, nil
+}
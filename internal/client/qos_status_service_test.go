@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestQoSStatusService_Get(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status qos lan2").Return([]byte(`Queuing type:   Priority
+    1          1200        153600        0       0/200
+`), nil)
+
+		service := NewQoSStatusService(mockExecutor, nil)
+
+		status, err := service.Get(context.Background(), "lan2")
+		assert.NoError(t, err)
+		assert.Equal(t, &QoSStatus{
+			Interface: "lan2",
+			QueueType: "priority",
+			Classes: []QoSClassStatus{
+				{Class: 1, SentPackets: 1200, SentOctets: 153600, QueueLimit: 200},
+			},
+		}, status)
+	})
+
+	t.Run("counter labels are not errors", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status qos lan2").Return([]byte(`Queuing type:   Priority
+Transmit error:   0 packets
+    1          1200        153600        0       0/200
+`), nil)
+
+		service := NewQoSStatusService(mockExecutor, nil)
+
+		status, err := service.Get(context.Background(), "lan2")
+		assert.NoError(t, err)
+		assert.Len(t, status.Classes, 1)
+	})
+
+	t.Run("router error", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status qos lan9").Return([]byte("Error: Invalid interface name\n"), nil)
+
+		service := NewQoSStatusService(mockExecutor, nil)
+
+		_, err := service.Get(context.Background(), "lan9")
+		assert.Error(t, err)
+	})
+}
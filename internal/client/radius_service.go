@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// RADIUSService handles RADIUS client configuration operations
+type RADIUSService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewRADIUSService creates a new RADIUS service instance
+func NewRADIUSService(executor Executor, client *rtxClient) *RADIUSService {
+	return &RADIUSService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves RADIUS client configuration
+func (s *RADIUSService) Get(ctx context.Context) (*RADIUSConfig, error) {
+	cmd := parsers.BuildShowRADIUSConfigCommand()
+	logging.FromContext(ctx).Debug().Str("service", "radius").Msgf("Getting RADIUS configuration with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get RADIUS configuration: %w", err)
+	}
+
+	parsed := parsers.ParseRADIUSConfig(string(output))
+	if parsed == nil {
+		return nil, fmt.Errorf("RADIUS configuration not found")
+	}
+
+	config := s.fromParserConfig(*parsed)
+	return &config, nil
+}
+
+// Configure creates RADIUS client configuration
+func (s *RADIUSService) Configure(ctx context.Context, config RADIUSConfig) error {
+	if err := s.apply(ctx, config); err != nil {
+		return fmt.Errorf("failed to configure RADIUS: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "RADIUS configured")
+}
+
+// Update updates RADIUS client configuration
+func (s *RADIUSService) Update(ctx context.Context, config RADIUSConfig) error {
+	if err := s.apply(ctx, config); err != nil {
+		return fmt.Errorf("failed to update RADIUS: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "RADIUS updated")
+}
+
+// Reset removes RADIUS client configuration
+func (s *RADIUSService) Reset(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := parsers.BuildDeleteRADIUSCommands()
+	logging.FromContext(ctx).Debug().Str("service", "radius").Msgf("Resetting RADIUS with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to reset RADIUS: %w", err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to reset RADIUS"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, "RADIUS reset")
+}
+
+// apply validates and sends the full RADIUS configuration.
+// The router overwrites each setting in place, so create and update share this path.
+func (s *RADIUSService) apply(ctx context.Context, config RADIUSConfig) error {
+	parserConfig := s.toParserConfig(config)
+	if err := parsers.ValidateRADIUSConfig(parserConfig); err != nil {
+		return fmt.Errorf("invalid RADIUS configuration: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := parsers.BuildRADIUSCommands(parserConfig)
+	for _, cmd := range commands {
+		// The shared secret is part of the command set and must never be logged
+		logging.FromContext(ctx).Debug().Str("service", "radius").Str("command", SanitizeCommandForLog(cmd)).Msg("Applying RADIUS command")
+	}
+
+	return runBatchCommands(ctx, s.executor, commands)
+}
+
+// toParserConfig converts client.RADIUSConfig to parsers.RADIUSConfig
+func (s *RADIUSService) toParserConfig(config RADIUSConfig) parsers.RADIUSConfig {
+	return parsers.RADIUSConfig{
+		Auth:       config.Auth,
+		Accounting: config.Accounting,
+		Servers:    config.Servers,
+		Secret:     config.Secret,
+		AuthPort:   config.AuthPort,
+		Retry:      config.Retry,
+		Wait:       config.Wait,
+	}
+}
+
+// fromParserConfig converts parsers.RADIUSConfig to client.RADIUSConfig
+func (s *RADIUSService) fromParserConfig(config parsers.RADIUSConfig) RADIUSConfig {
+	return RADIUSConfig{
+		Auth:       config.Auth,
+		Accounting: config.Accounting,
+		Servers:    config.Servers,
+		Secret:     config.Secret,
+		AuthPort:   config.AuthPort,
+		Retry:      config.Retry,
+		Wait:       config.Wait,
+	}
+}
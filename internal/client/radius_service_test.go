@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRADIUSService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config | grep radius").Return([]byte(
+		"radius auth on\nradius server 192.168.1.10 192.168.1.11\nradius secret s3cr3t\nradius retry 3\n"), nil)
+
+	service := NewRADIUSService(mockExecutor, nil)
+
+	config, err := service.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &RADIUSConfig{
+		Auth:     true,
+		Servers:  []string{"192.168.1.10", "192.168.1.11"},
+		Secret:   "s3cr3t",
+		AuthPort: 1812,
+		Retry:    3,
+		Wait:     9,
+	}, config)
+}
+
+func TestRADIUSService_GetNotFound(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config | grep radius").Return([]byte(""), nil)
+
+	service := NewRADIUSService(mockExecutor, nil)
+
+	_, err := service.Get(context.Background())
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestRADIUSService_Configure(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"radius server 192.168.1.10",
+		"radius secret s3cr3t",
+		"radius auth port 1812",
+		"radius retry 4",
+		"radius wait 9",
+		"radius account off",
+		"radius auth on",
+	}).Return([]byte(""), nil)
+
+	service := NewRADIUSService(mockExecutor, nil)
+
+	err := service.Configure(context.Background(), RADIUSConfig{
+		Auth:     true,
+		Servers:  []string{"192.168.1.10"},
+		Secret:   "s3cr3t",
+		AuthPort: 1812,
+		Retry:    4,
+		Wait:     9,
+	})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestRADIUSService_ConfigureInvalid(t *testing.T) {
+	service := NewRADIUSService(new(MockExecutor), nil)
+
+	err := service.Configure(context.Background(), RADIUSConfig{
+		Servers:  []string{"192.168.1.10"},
+		AuthPort: 1812,
+		Retry:    4,
+		Wait:     9,
+	})
+	assert.ErrorContains(t, err, "shared secret is required")
+}
+
+func TestRADIUSService_Reset(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no radius auth",
+		"no radius account",
+		"no radius wait",
+		"no radius retry",
+		"no radius auth port",
+		"no radius secret",
+		"no radius server",
+	}).Return([]byte(""), nil)
+
+	service := NewRADIUSService(mockExecutor, nil)
+
+	err := service.Reset(context.Background())
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
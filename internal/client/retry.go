@@ -1,10 +1,19 @@
 package client
 
 import (
+	"context"
 	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
 	"math"
 	"math/big"
+	"net"
+	"strings"
+	"syscall"
 	"time"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
 )
 
 // noRetry is a retry strategy that never retries
@@ -90,22 +99,169 @@ func (e *RetryableError) Unwrap() error {
 	return e.Err
 }
 
-// IsRetryable checks if an error should trigger a retry
+// commandSentError marks a failure that happened after a command reached the
+// router, which may have applied it before the connection failed
+type commandSentError struct {
+	Err error
+}
+
+func (e *commandSentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *commandSentError) Unwrap() error {
+	return e.Err
+}
+
+// mayHaveRun reports whether a failed command may have taken effect on the
+// router. Busy responses and corrupted echoes mean the command was refused
+// or never entered, so it did not run.
+func mayHaveRun(err error) bool {
+	var sent *commandSentError
+	return errors.As(err, &sent) && !errors.Is(err, ErrRouterBusy) && !errors.Is(err, ErrEchoMismatch)
+}
+
+// transientErrorPatterns are fragments of error messages caused by dropped or
+// half-open connections, for errors that do not wrap a typed cause
+var transientErrorPatterns = []string{
+	"connection reset by peer",
+	"broken pipe",
+	"use of closed network connection",
+	"handshake failed: EOF",
+	"session is closed",
+	"timeout waiting for prompt",
+}
+
+// busyPatterns are fragments of RTX error lines reporting that the router is
+// temporarily unable to accept the request (English and Japanese)
+var busyPatterns = []string{
+	"busy",
+	"try again",
+	"another user",
+	"too many users",
+	"too many sessions",
+	"使用中",
+	"しばらく",
+}
+
+// IsBusyOutput reports whether command output contains an RTX error line
+// saying that the router is busy. Only error lines are inspected, so regular
+// output that happens to contain these words is not misclassified.
+func IsBusyOutput(output []byte) bool {
+	for _, line := range strings.Split(string(output), "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimPrefix(line, "% ")
+		if !strings.HasPrefix(line, "Error:") && !strings.HasPrefix(line, "エラー:") {
+			continue
+		}
+		lower := strings.ToLower(line)
+		for _, pattern := range busyPatterns {
+			if strings.Contains(lower, pattern) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsRetryable checks if an error should trigger a retry.
+// Authentication, host key and command errors reported by the router are
+// fatal; dropped connections, timeouts and busy responses are transient.
 func IsRetryable(err error) bool {
 	if err == nil {
 		return false
 	}
 
+	// Fatal errors never succeed on retry
+	if errors.Is(err, ErrAuthFailed) || errors.Is(err, ErrHostKeyMismatch) || errors.Is(err, ErrCommandFailed) ||
+		errors.Is(err, ErrAdminRequired) ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
 	// Check if it's explicitly marked as retryable
-	if _, ok := err.(*RetryableError); ok {
+	var retryable *RetryableError
+	if errors.As(err, &retryable) {
 		return true
 	}
 
 	// Check for specific error conditions that are retryable
-	switch err {
-	case ErrTimeout:
+	if errors.Is(err, ErrTimeout) || errors.Is(err, ErrRouterBusy) || errors.Is(err, ErrPrompt) || errors.Is(err, ErrDial) ||
+		errors.Is(err, ErrEchoMismatch) {
 		return true
-	default:
-		return false
+	}
+
+	// Dropped connections, including login races where the router closes
+	// new connections while it is busy with others
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, net.ErrClosed) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	msg := err.Error()
+	for _, pattern := range transientErrorPatterns {
+		if strings.Contains(msg, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// noRetryKey marks contexts whose commands must run at most once
+type noRetryKey struct{}
+
+// withoutRetry returns a context in which failed commands are not retried,
+// for commands such as "restart" whose connection is expected to drop
+func withoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+// retryWithStrategy runs op until it succeeds, fails with a non-retryable
+// error, or the strategy gives up. Waiting between attempts honours ctx.
+// Unless readOnly is set, a failure after the command reached the router is
+// not retried, as resending a configuration command that did take effect is
+// not safe (e.g. "no ..." or "save"); only failures while connecting or
+// logging in are.
+func retryWithStrategy(ctx context.Context, strategy RetryStrategy, readOnly bool, op func(attempt int) error) error {
+	if strategy == nil || ctx.Value(noRetryKey{}) != nil {
+		strategy = &noRetry{}
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := op(attempt)
+		if err == nil || !IsRetryable(err) {
+			return err
+		}
+		if !readOnly && mayHaveRun(err) {
+			logging.FromContext(ctx).Warn().
+				Err(err).
+				Msg("Configuration command may have run before the failure, not retrying")
+			return err
+		}
+
+		delay, giveUp := strategy.Next(attempt)
+		if giveUp {
+			if attempt == 0 {
+				return err
+			}
+			return fmt.Errorf("failed after %d attempts: %w", attempt+1, err)
+		}
+
+		logging.FromContext(ctx).Warn().
+			Err(err).
+			Int("attempt", attempt+1).
+			Dur("delay", delay).
+			Msg("Transient error, retrying")
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %v (last error: %v)", ErrTimeout, ctx.Err(), err)
+		case <-time.After(delay):
+		}
 	}
 }
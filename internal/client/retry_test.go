@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "explicitly retryable", err: &RetryableError{Err: errors.New("login race")}, want: true},
+		{name: "wrapped retryable", err: fmt.Errorf("outer: %w", &RetryableError{Err: errors.New("x")}), want: true},
+		{name: "timeout", err: fmt.Errorf("%w: deadline", ErrTimeout), want: true},
+		{name: "router busy", err: fmt.Errorf("%w: Error: System is busy", ErrRouterBusy), want: true},
+		{name: "prompt not found", err: ErrPrompt, want: true},
+		{name: "connection reset", err: fmt.Errorf("failed to dial: %w", syscall.ECONNRESET), want: true},
+		{name: "connection refused", err: fmt.Errorf("failed to dial: %w", syscall.ECONNREFUSED), want: true},
+		{name: "read EOF", err: fmt.Errorf("read error: %w", io.EOF), want: true},
+		{name: "handshake EOF message", err: errors.New("ssh: handshake failed: EOF"), want: true},
+		{name: "closed session", err: errors.New("session is closed"), want: true},
+		{name: "authentication failure", err: fmt.Errorf("administrator %w: Password incorrect", ErrAuthFailed), want: false},
+		{name: "retryable wrapping auth failure", err: &RetryableError{Err: ErrAuthFailed}, want: false},
+		{name: "host key mismatch", err: fmt.Errorf("%w: host key mismatch", ErrHostKeyMismatch), want: false},
+		{name: "command failed", err: fmt.Errorf("%w: Error: Invalid parameter", ErrCommandFailed), want: false},
+		{name: "context canceled", err: context.Canceled, want: false},
+		{name: "unknown error", err: errors.New("ssh: unable to authenticate"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsBusyOutput(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   bool
+	}{
+		{name: "busy error", output: "Error: System is busy. Please try again later.\r\n# ", want: true},
+		{name: "another user", output: "% Error: Another user is in administrator mode\n> ", want: true},
+		{name: "japanese busy", output: "エラー: 他のユーザーが使用中です\n# ", want: true},
+		{name: "regular error", output: "Error: Invalid parameter\n# ", want: false},
+		{name: "too many parameters", output: "Error: Too many parameters\n# ", want: false},
+		{name: "busy in normal output", output: "description lan1 \"busy uplink\"\n# ", want: false},
+		{name: "empty", output: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsBusyOutput([]byte(tt.output)); got != tt.want {
+				t.Errorf("IsBusyOutput(%q) = %v, want %v", tt.output, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryWithStrategy(t *testing.T) {
+	transient := fmt.Errorf("read error: %w", io.EOF)
+	fatal := fmt.Errorf("administrator %w", ErrAuthFailed)
+	sent := &commandSentError{Err: transient}
+	sentBusy := &commandSentError{Err: fmt.Errorf("%w: Error: busy", ErrRouterBusy)}
+
+	tests := []struct {
+		name         string
+		strategy     RetryStrategy
+		readOnly     bool
+		errs         []error
+		wantAttempts int
+		wantErr      error
+	}{
+		{
+			name:         "succeeds after transient errors",
+			strategy:     NewLinearBackoff(time.Millisecond, 3),
+			errs:         []error{transient, transient, nil},
+			wantAttempts: 3,
+		},
+		{
+			name:         "fatal error is not retried",
+			strategy:     NewLinearBackoff(time.Millisecond, 3),
+			errs:         []error{fatal},
+			wantAttempts: 1,
+			wantErr:      ErrAuthFailed,
+		},
+		{
+			name:         "gives up after max retries",
+			strategy:     NewLinearBackoff(time.Millisecond, 2),
+			errs:         []error{transient, transient, transient, nil},
+			wantAttempts: 3,
+			wantErr:      io.EOF,
+		},
+		{
+			name:         "nil strategy does not retry",
+			strategy:     nil,
+			errs:         []error{transient, nil},
+			wantAttempts: 1,
+			wantErr:      io.EOF,
+		},
+		{
+			name:         "configuration command is resent after a failure before sending",
+			strategy:     NewLinearBackoff(time.Millisecond, 3),
+			errs:         []error{transient, nil},
+			wantAttempts: 2,
+		},
+		{
+			name:         "configuration command is not resent after it was sent",
+			strategy:     NewLinearBackoff(time.Millisecond, 3),
+			errs:         []error{sent, nil},
+			wantAttempts: 1,
+			wantErr:      io.EOF,
+		},
+		{
+			name:         "configuration command refused as busy is resent",
+			strategy:     NewLinearBackoff(time.Millisecond, 3),
+			errs:         []error{sentBusy, nil},
+			wantAttempts: 2,
+		},
+		{
+			name:         "read-only command is resent after it was sent",
+			strategy:     NewLinearBackoff(time.Millisecond, 3),
+			readOnly:     true,
+			errs:         []error{sent, nil},
+			wantAttempts: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			err := retryWithStrategy(context.Background(), tt.strategy, tt.readOnly, func(attempt int) error {
+				if attempt != attempts {
+					t.Errorf("attempt = %d, want %d", attempt, attempts)
+				}
+				attempts++
+				return tt.errs[attempt]
+			})
+
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRetryWithStrategy_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+
+	err := retryWithStrategy(ctx, NewLinearBackoff(time.Hour, 3), true, func(attempt int) error {
+		attempts++
+		cancel()
+		return io.EOF
+	})
+
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1", attempts)
+	}
+	if !errors.Is(err, ErrTimeout) {
+		t.Errorf("error = %v, want ErrTimeout", err)
+	}
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// RunningConfigService reads the raw router configuration ("show config")
+type RunningConfigService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+
+	// sectionsUnsupported is set once the router rejects "show config <section> <n>"
+	sectionsUnsupported atomic.Bool
+}
+
+// NewRunningConfigService creates a new running config service instance
+func NewRunningConfigService(executor Executor, client *rtxClient) *RunningConfigService {
+	return &RunningConfigService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the router configuration, optionally filtered by a grep pattern
+func (s *RunningConfigService) Get(ctx context.Context, pattern string) (string, error) {
+	if err := parsers.ValidateConfigGrepPattern(pattern); err != nil {
+		return "", err
+	}
+
+	cmd := parsers.BuildShowRunningConfigCommand(pattern)
+	logging.FromContext(ctx).Debug().Str("service", "running_config").Msgf("Reading configuration with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return "", fmt.Errorf("failed to read configuration: %w", err)
+	}
+
+	return parsers.NormalizeRunningConfig(string(output)), nil
+}
+
+// GetSection retrieves the configuration of one PP or tunnel interface with
+// "show config pp N" or "show config tunnel N". Routers that do not support
+// section reads get the section cut out of the full configuration instead.
+// An empty string means the interface is not configured.
+func (s *RunningConfigService) GetSection(ctx context.Context, section string, number int) (string, error) {
+	if err := parsers.ValidateConfigSection(section, number); err != nil {
+		return "", err
+	}
+	logger := logging.FromContext(ctx).Debug().Str("service", "running_config")
+
+	if !s.sectionsUnsupported.Load() {
+		cmd := parsers.BuildShowConfigSectionCommand(section, number)
+		logger.Msgf("Reading configuration section with command: %s", cmd)
+
+		output, err := s.executor.Run(ctx, cmd)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s %d configuration: %w", section, number, err)
+		}
+		if rejectionLine(string(output)) == "" {
+			return parsers.NormalizeRunningConfig(string(output)), nil
+		}
+		logging.FromContext(ctx).Info().Str("service", "running_config").
+			Msg("Router does not support section reads, reading the full configuration instead")
+		s.sectionsUnsupported.Store(true)
+	}
+
+	output, err := s.executor.Run(ctx, parsers.BuildShowRunningConfigCommand(""))
+	if err != nil {
+		return "", fmt.Errorf("failed to read configuration: %w", err)
+	}
+	return parsers.ExtractConfigSection(string(output), section, number), nil
+}
+
+// configSectionReader returns the client's running config service, so that the
+// result of section read detection is shared, or a standalone one over executor
+func configSectionReader(executor Executor, client *rtxClient) *RunningConfigService {
+	if client != nil {
+		client.mu.Lock()
+		service := client.runningConfigService
+		client.mu.Unlock()
+		if service != nil {
+			return service
+		}
+	}
+	return NewRunningConfigService(executor, client)
+}
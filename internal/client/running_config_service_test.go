@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRunningConfigService_Get(t *testing.T) {
+	t.Run("filtered", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, `show config | grep "ip route"`).Return([]byte("ip route default gateway pp 1\r\n\r\n"), nil)
+
+		service := NewRunningConfigService(mockExecutor, nil)
+
+		config, err := service.Get(context.Background(), "ip route")
+		assert.NoError(t, err)
+		assert.Equal(t, "ip route default gateway pp 1", config)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		service := NewRunningConfigService(new(MockExecutor), nil)
+
+		_, err := service.Get(context.Background(), `ip "route"`)
+		assert.ErrorContains(t, err, "double quotes")
+	})
+}
+
+func TestRunningConfigService_GetSection(t *testing.T) {
+	t.Run("section read", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show config pp 1").Return([]byte("pp select 1\r\n ip pp mtu 1454\r\n pp enable 1\r\n"), nil)
+
+		service := NewRunningConfigService(mockExecutor, nil)
+
+		config, err := service.GetSection(context.Background(), "pp", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "pp select 1\n ip pp mtu 1454\n pp enable 1", config)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("configuration text is not a rejection", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show config tunnel 1").Return([]byte("tunnel select 1\r\n description tunnel \"page not found error: fallback\"\r\n"), nil)
+
+		service := NewRunningConfigService(mockExecutor, nil)
+
+		config, err := service.GetSection(context.Background(), "tunnel", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "tunnel select 1\n description tunnel \"page not found error: fallback\"", config)
+		assert.False(t, service.sectionsUnsupported.Load())
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("falls back to the full configuration once unsupported", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show config tunnel 1").Return([]byte("Error: Invalid parameter\r\n"), nil).Once()
+		mockExecutor.On("Run", mock.Anything, "show config").Return([]byte("tunnel select 1\r\n tunnel enable 1\r\ntunnel select 2\r\n tunnel enable 2\r\n"), nil).Twice()
+
+		service := NewRunningConfigService(mockExecutor, nil)
+
+		config, err := service.GetSection(context.Background(), "tunnel", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "tunnel select 1\n tunnel enable 1", config)
+
+		// Later reads skip the section command
+		config, err = service.GetSection(context.Background(), "tunnel", 2)
+		assert.NoError(t, err)
+		assert.Equal(t, "tunnel select 2\n tunnel enable 2", config)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("invalid section", func(t *testing.T) {
+		service := NewRunningConfigService(new(MockExecutor), nil)
+
+		_, err := service.GetSection(context.Background(), "lan", 1)
+		assert.ErrorContains(t, err, "config section")
+	})
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+// SaveMode controls when the running configuration is written to flash memory
+type SaveMode string
+
+const (
+	// SaveModeImmediate saves after every change (default)
+	SaveModeImmediate SaveMode = "immediate"
+	// SaveModeBatch saves once when the changes that run at the same time
+	// have completed, as part of the last of them
+	SaveModeBatch SaveMode = "batch"
+	// SaveModeManual never saves; the operator runs "save" on the router
+	SaveModeManual SaveMode = "manual"
+)
+
+// saveScheduler coalesces save requests in batch mode. Changes are bracketed
+// by begin and end; a save requested during a change is deferred until no
+// change is running, and the change that ends last runs it synchronously so
+// that its caller sees a failed save.
+type saveScheduler struct {
+	save func(ctx context.Context) error
+
+	mu      sync.Mutex
+	running int  // Changes in progress
+	pending bool // A save was requested and has not succeeded yet
+
+	saveMu sync.Mutex // Serializes saves
+}
+
+// newSaveScheduler creates a scheduler that runs save when changes complete
+func newSaveScheduler(save func(ctx context.Context) error) *saveScheduler {
+	return &saveScheduler{save: save}
+}
+
+// begin marks the start of a change
+func (s *saveScheduler) begin() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.running++
+}
+
+// end marks the end of a change and, if no other change is running, runs
+// the pending save
+func (s *saveScheduler) end(ctx context.Context) error {
+	s.mu.Lock()
+	s.running--
+	last := s.running == 0
+	s.mu.Unlock()
+
+	if !last {
+		return nil
+	}
+	return s.Flush(ctx)
+}
+
+// Schedule requests a save. Outside a change the save runs now.
+func (s *saveScheduler) Schedule(ctx context.Context) error {
+	s.mu.Lock()
+	s.pending = true
+	idle := s.running == 0
+	s.mu.Unlock()
+
+	if idle {
+		return s.Flush(ctx)
+	}
+	return nil
+}
+
+// Flush saves immediately if a save is pending
+func (s *saveScheduler) Flush(ctx context.Context) error {
+	s.saveMu.Lock()
+	defer s.saveMu.Unlock()
+
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = false
+	s.mu.Unlock()
+
+	if !pending {
+		return nil
+	}
+	logging.Global().Debug().Msg("Saving batched configuration changes")
+	if err := s.save(ctx); err != nil {
+		// Keep the save pending so that a later flush retries it
+		s.mu.Lock()
+		s.pending = true
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
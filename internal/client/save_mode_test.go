@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSaveScheduler_SavesWhenLastChangeEnds(t *testing.T) {
+	var saves atomic.Int32
+	s := newSaveScheduler(func(ctx context.Context) error {
+		saves.Add(1)
+		return nil
+	})
+
+	s.begin()
+	s.begin()
+	assert.NoError(t, s.Schedule(context.Background()))
+	assert.NoError(t, s.Schedule(context.Background()))
+	assert.NoError(t, s.end(context.Background()))
+	assert.Equal(t, int32(0), saves.Load(), "save should wait for running changes")
+
+	assert.NoError(t, s.Schedule(context.Background()))
+	assert.NoError(t, s.end(context.Background()))
+	assert.Equal(t, int32(1), saves.Load(), "changes running together should be saved once")
+
+	s.begin()
+	assert.NoError(t, s.end(context.Background()))
+	assert.Equal(t, int32(1), saves.Load(), "change without a save request should not save")
+}
+
+func TestSaveScheduler_ScheduleOutsideChangeSavesNow(t *testing.T) {
+	var saves atomic.Int32
+	s := newSaveScheduler(func(ctx context.Context) error {
+		saves.Add(1)
+		return nil
+	})
+
+	assert.NoError(t, s.Schedule(context.Background()))
+	assert.Equal(t, int32(1), saves.Load())
+
+	assert.NoError(t, s.Flush(context.Background()))
+	assert.Equal(t, int32(1), saves.Load(), "flush should not repeat a completed save")
+}
+
+func TestSaveScheduler_FailedSaveReturnedByLastChange(t *testing.T) {
+	fail := true
+	s := newSaveScheduler(func(ctx context.Context) error {
+		if fail {
+			return errors.New("connection lost")
+		}
+		return nil
+	})
+
+	s.begin()
+	assert.NoError(t, s.Schedule(context.Background()))
+	assert.EqualError(t, s.end(context.Background()), "connection lost")
+	assert.True(t, s.pending, "failed save should stay pending")
+
+	fail = false
+	assert.NoError(t, s.Flush(context.Background()))
+	assert.False(t, s.pending)
+}
+
+func TestRTXClient_SaveConfig_Modes(t *testing.T) {
+	t.Run("immediate saves every time", func(t *testing.T) {
+		executor := new(MockExecutor)
+		executor.On("Run", mock.Anything, "save").Return([]byte(""), nil).Twice()
+		c := &rtxClient{config: &Config{SaveMode: SaveModeImmediate}, executor: executor, active: true}
+
+		assert.NoError(t, c.SaveConfig(context.Background()))
+		assert.NoError(t, c.SaveConfig(context.Background()))
+		executor.AssertExpectations(t)
+	})
+
+	t.Run("manual never saves", func(t *testing.T) {
+		executor := new(MockExecutor)
+		c := &rtxClient{config: &Config{SaveMode: SaveModeManual}, executor: executor, active: true}
+
+		assert.NoError(t, c.SaveConfig(context.Background()))
+		executor.AssertNotCalled(t, "Run", mock.Anything, "save")
+	})
+
+	t.Run("batch saves when the last change ends", func(t *testing.T) {
+		executor := new(MockExecutor)
+		executor.On("Run", mock.Anything, "save").Return([]byte(""), nil).Once()
+		c := &rtxClient{config: &Config{SaveMode: SaveModeBatch}, executor: executor, active: true}
+		c.saveScheduler = newSaveScheduler(c.saveNow)
+
+		endFirst := c.BeginChange()
+		endSecond := c.BeginChange()
+		assert.NoError(t, c.SaveConfig(context.Background()))
+		assert.NoError(t, endFirst(context.Background()))
+		assert.NoError(t, c.SaveConfig(context.Background()))
+		executor.AssertNotCalled(t, "Run", mock.Anything, "save")
+
+		assert.NoError(t, endSecond(context.Background()))
+		executor.AssertExpectations(t)
+	})
+}
@@ -738,6 +738,11 @@ func (m *mockSFTPClientForServiceManager) WriteFile(ctx context.Context, path st
 	return nil
 }
 
+func (m *mockSFTPClientForServiceManager) Remove(ctx context.Context, path string) error {
+	delete(m.writtenFiles, path)
+	return nil
+}
+
 func (m *mockSFTPClientForServiceManager) Close() error {
 	return nil
 }
@@ -35,6 +35,9 @@ type SFTPClient interface {
 	// writes are treated as failures by closing the file handle and returning the
 	// first error encountered. Implementations must honour ctx cancellation.
 	WriteFile(ctx context.Context, path string, content []byte) error
+
+	// Remove deletes a file from the remote host
+	Remove(ctx context.Context, path string) error
 }
 
 // sshClientInterface abstracts the SSH client for testing
@@ -56,6 +59,7 @@ type sftpClientInterface interface {
 	Open(path string) (sftpFileInterface, error)
 	ReadDir(path string) ([]os.FileInfo, error)
 	Create(path string) (sftpFileWriteCloserInterface, error)
+	Remove(path string) error
 	Close() error
 }
 
@@ -135,6 +139,11 @@ func (w *sftpClientWrapper) Create(path string) (sftpFileWriteCloserInterface, e
 	return w.client.Create(path)
 }
 
+// Remove deletes a remote file
+func (w *sftpClientWrapper) Remove(path string) error {
+	return w.client.Remove(path)
+}
+
 func (w *sftpClientWrapper) Close() error {
 	return w.client.Close()
 }
@@ -178,7 +187,7 @@ func NewSFTPClient(ctx context.Context, config *Config) (SFTPClient, error) {
 
 	// Establish SSH connection
 	logger.Debug().Str("addr", addr).Msg("Establishing SSH connection for SFTP")
-	sshClient, err := DialContext(ctx, "tcp", addr, sshConfig)
+	sshClient, err := dialSSHContext(ctx, config.JumpHost, addr, sshConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to establish SSH connection for SFTP: %w", err)
 	}
@@ -310,6 +319,29 @@ func (c *sftpClientImpl) WriteFile(ctx context.Context, path string, content []b
 	return nil
 }
 
+// Remove deletes a file from the remote host
+func (c *sftpClientImpl) Remove(ctx context.Context, path string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return ErrSFTPClosed
+	}
+	c.mu.Unlock()
+
+	logging.FromContext(ctx).Debug().Str("path", path).Msg("Removing file via SFTP")
+
+	if err := c.sftpClient.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove file %q: %w", path, err)
+	}
+	return nil
+}
+
 // Close closes the SFTP connection
 func (c *sftpClientImpl) Close() error {
 	c.mu.Lock()
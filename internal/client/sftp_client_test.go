@@ -16,6 +16,7 @@ type mockSFTPClient struct {
 	openFunc    func(path string) (sftpFileInterface, error)
 	readDirFunc func(path string) ([]os.FileInfo, error)
 	createFunc  func(path string) (sftpFileWriteCloserInterface, error)
+	removeFunc  func(path string) error
 	closeFunc   func() error
 }
 
@@ -40,6 +41,13 @@ func (m *mockSFTPClient) Create(path string) (sftpFileWriteCloserInterface, erro
 	return nil, errors.New("Create not implemented")
 }
 
+func (m *mockSFTPClient) Remove(path string) error {
+	if m.removeFunc != nil {
+		return m.removeFunc(path)
+	}
+	return nil
+}
+
 func (m *mockSFTPClient) Close() error {
 	if m.closeFunc != nil {
 		return m.closeFunc()
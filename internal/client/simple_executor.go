@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	"golang.org/x/crypto/ssh"
 
@@ -17,16 +16,67 @@ type simpleExecutor struct {
 	addr           string
 	promptDetector PromptDetector
 	rtxConfig      *Config // RTX router configuration including admin password
+	retryStrategy  RetryStrategy
 }
 
-// NewSimpleExecutor creates a new simple executor
-func NewSimpleExecutor(config *ssh.ClientConfig, addr string, promptDetector PromptDetector, rtxConfig *Config) Executor {
+// NewSimpleExecutor creates a new simple executor.
+// If retryStrategy is nil, failed commands are not retried.
+func NewSimpleExecutor(config *ssh.ClientConfig, addr string, promptDetector PromptDetector, rtxConfig *Config, retryStrategy RetryStrategy) Executor {
+	if retryStrategy == nil {
+		retryStrategy = &noRetry{}
+	}
 	return &simpleExecutor{
 		config:         config,
 		addr:           addr,
 		promptDetector: promptDetector,
 		rtxConfig:      rtxConfig,
+		retryStrategy:  retryStrategy,
+	}
+}
+
+// jumpHost returns the jump host to connect through, or nil for a direct connection
+func (e *simpleExecutor) jumpHost() *JumpHostConfig {
+	if e.rtxConfig == nil {
+		return nil
+	}
+	return e.rtxConfig.JumpHost
+}
+
+// timeouts returns the configured router response timeouts
+func (e *simpleExecutor) timeouts() Timeouts {
+	if e.rtxConfig == nil {
+		return Timeouts{}
+	}
+	return e.rtxConfig.Timeouts
+}
+
+// pacing returns how fast commands are typed into the console
+func (e *simpleExecutor) pacing() Pacing {
+	if e.rtxConfig == nil {
+		return Pacing{}
 	}
+	return e.rtxConfig.Pacing
+}
+
+// consoleEncoding returns the configured console character encoding
+func (e *simpleExecutor) consoleEncoding() ConsoleEncoding {
+	if e.rtxConfig == nil {
+		return ""
+	}
+	return e.rtxConfig.ConsoleEncoding
+}
+
+// prompts returns the configured prompt patterns (nil: built-in detection)
+func (e *simpleExecutor) prompts() *promptPatterns {
+	if e.rtxConfig == nil {
+		return nil
+	}
+	return newPromptPatterns(e.rtxConfig.Prompts)
+}
+
+// skipSaveOnExit reports whether sessions must not save when leaving administrator mode
+func (e *simpleExecutor) skipSaveOnExit() bool {
+	return e.rtxConfig != nil && e.rtxConfig.SaveMode == SaveModeManual
 }
 
 // Run executes a command by creating a new SSH connection
@@ -34,7 +84,7 @@ func (e *simpleExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
 	logger := logging.FromContext(ctx)
 
 	// Log command with resource context if available
-	logEvent := logger.Info().Str("command", logging.SanitizeString(cmd))
+	logEvent := logger.Info().Str("command", logging.RedactCommand(cmd))
 	if res := logging.ResourceFromContext(ctx); res != nil {
 		logEvent = logEvent.Str("resource", res.Type)
 		if res.ID != "" {
@@ -43,18 +93,36 @@ func (e *simpleExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
 	}
 	logEvent.Msg("RTX command")
 
+	var output []byte
+	err := retryWithStrategy(ctx, e.retryStrategy, isReadOnlyCommand(cmd), func(attempt int) error {
+		out, err := e.runOnce(ctx, cmd)
+		output = out
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// runOnce executes a command on a new SSH connection. Failures after the
+// command was sent are marked with commandSentError.
+func (e *simpleExecutor) runOnce(ctx context.Context, cmd string) ([]byte, error) {
+	logger := logging.FromContext(ctx)
+
 	// Create a new SSH connection for each command
-	client, err := ssh.Dial("tcp", e.addr, e.config)
+	client, err := dialSSH(ctx, e.jumpHost(), e.addr, e.config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to dial: %w", err)
 	}
 	defer client.Close()
 
 	// Create a working session
-	session, err := newWorkingSession(client)
+	session, err := newWorkingSession(client, e.timeouts(), e.pacing(), e.consoleEncoding(), e.prompts())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create session: %w", err)
 	}
+	session.skipSaveOnExit = e.skipSaveOnExit()
 	defer session.Close()
 
 	// Check if this command requires administrator privileges
@@ -69,55 +137,50 @@ func (e *simpleExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
 	}
 
 	// Execute the command
-	output, err := session.Send(cmd)
+	output, err := session.SendContext(ctx, cmd)
 	if err != nil {
-		return nil, fmt.Errorf("command execution failed: %w", err)
+		return nil, &commandSentError{Err: fmt.Errorf("command execution failed: %w", err)}
+	}
+
+	// The router refused the command at user level: escalate and run it again.
+	// Leaving administrator mode happens when the session is closed.
+	if !session.adminMode && IsAdminRequiredOutput(output) {
+		logger.Debug().Msg("SimpleExecutor: Router requires administrator level, escalating")
+		if err := e.authenticateAsAdmin(ctx, session); err != nil {
+			return nil, fmt.Errorf("%w for %q: %w", ErrAdminRequired, cmd, err)
+		}
+		session.SetAdminMode(true)
+
+		output, err = session.SendContext(ctx, cmd)
+		if err != nil {
+			return nil, &commandSentError{Err: fmt.Errorf("command execution failed: %w", err)}
+		}
 	}
 
 	// Check for prompt
 	matched, prompt := e.promptDetector.DetectPrompt(output)
 	if !matched {
 		logger.Debug().Str("output", string(output)).Msg("SimpleExecutor: Prompt detection failed")
-		return nil, fmt.Errorf("%w: output does not contain expected prompt", ErrPrompt)
+		return nil, &commandSentError{Err: fmt.Errorf("%w: output does not contain expected prompt", ErrPrompt)}
 	}
 	logger.Debug().Str("prompt", prompt).Msg("SimpleExecutor: Prompt detected")
 
+	if IsBusyOutput(output) {
+		return nil, fmt.Errorf("%w: %s", ErrRouterBusy, strings.TrimSpace(string(output)))
+	}
+
 	return output, nil
 }
 
 // requiresAdminPrivileges checks if a command requires administrator privileges.
 // Read-only commands (show, console) do not require admin privileges.
-// Configuration commands require admin authentication when password is configured.
+// Configuration commands require admin authentication when password is configured;
+// without a password, escalation happens only when the router asks for it.
 func (e *simpleExecutor) requiresAdminPrivileges(cmd string) bool {
-	hasConfig := e.rtxConfig != nil
-	hasPassword := hasConfig && e.rtxConfig.AdminPassword != ""
-	if !hasPassword {
+	if e.rtxConfig == nil || e.rtxConfig.AdminPassword == "" {
 		return false
 	}
-
-	// Normalize command for checking
-	cmdLower := strings.ToLower(strings.TrimSpace(cmd))
-
-	// Read-only commands do not require admin privileges
-	readOnlyPrefixes := []string{
-		"show ",    // show commands (show config, show status, show sshd host key, etc.)
-		"console ", // console display commands
-		"less ",    // pager commands
-	}
-	for _, prefix := range readOnlyPrefixes {
-		if strings.HasPrefix(cmdLower, prefix) {
-			logging.Global().Debug().
-				Str("command", cmd).
-				Msg("SimpleExecutor: read-only command, no admin required")
-			return false
-		}
-	}
-
-	// All other commands require admin when password is configured
-	logging.Global().Debug().
-		Str("command", cmd).
-		Msg("SimpleExecutor: admin required for this command")
-	return true
+	return !isReadOnlyCommand(cmd)
 }
 
 // authenticateAsAdmin authenticates as administrator using the administrator command
@@ -158,7 +221,7 @@ func (e *simpleExecutor) sendAdministratorCommand(ctx context.Context, ws *worki
 	}
 
 	// Read until we get password prompt
-	_, err := ws.readUntilString("Password:", 10*time.Second)
+	_, err := ws.readUntilString("Password:", ws.timeouts.Login)
 	if err != nil {
 		return fmt.Errorf("failed to get password prompt: %w", err)
 	}
@@ -171,7 +234,7 @@ func (e *simpleExecutor) sendAdministratorCommand(ctx context.Context, ws *worki
 	}
 
 	// Read response after password - look for administrator prompt (# instead of >)
-	response, err := ws.readUntilPrompt(10 * time.Second)
+	response, err := ws.readUntilPrompt(ws.timeouts.Login)
 	if err != nil {
 		return fmt.Errorf("failed to read password response: %w", err)
 	}
@@ -182,7 +245,7 @@ func (e *simpleExecutor) sendAdministratorCommand(ctx context.Context, ws *worki
 	// Check for authentication failure
 	if strings.Contains(responseStr, "incorrect") || strings.Contains(responseStr, "failed") || strings.Contains(responseStr, "Invalid") ||
 		strings.Contains(responseStr, "エラー") || strings.Contains(responseStr, "パスワードが違います") {
-		return fmt.Errorf("administrator authentication failed: %s", responseStr)
+		return fmt.Errorf("administrator %w: %s", ErrAuthFailed, responseStr)
 	}
 
 	// Verify we actually got the admin prompt (#) not user prompt (>)
@@ -198,10 +261,10 @@ func (e *simpleExecutor) sendAdministratorCommand(ctx context.Context, ws *worki
 func (e *simpleExecutor) RunBatch(ctx context.Context, cmds []string) ([]byte, error) {
 	var allOutput []byte
 
-	for _, cmd := range cmds {
+	for i, cmd := range cmds {
 		output, err := e.Run(ctx, cmd)
 		if err != nil {
-			return allOutput, fmt.Errorf("batch command '%s' failed: %w", cmd, err)
+			return allOutput, &BatchCommandError{Index: i, Command: cmd, Err: err}
 		}
 		allOutput = append(allOutput, output...)
 	}
@@ -216,17 +279,18 @@ func (e *simpleExecutor) SetAdministratorPassword(ctx context.Context, oldPasswo
 	logger.Debug().Msg("SimpleExecutor: Setting administrator password")
 
 	// Create a new SSH connection for the interactive password command
-	client, err := ssh.Dial("tcp", e.addr, e.config)
+	client, err := dialSSH(ctx, e.jumpHost(), e.addr, e.config)
 	if err != nil {
 		return fmt.Errorf("failed to dial: %w", err)
 	}
 	defer client.Close()
 
 	// Create a working session
-	ws, err := newWorkingSession(client)
+	ws, err := newWorkingSession(client, e.timeouts(), e.pacing(), e.consoleEncoding(), e.prompts())
 	if err != nil {
 		return fmt.Errorf("failed to create session: %w", err)
 	}
+	ws.skipSaveOnExit = e.skipSaveOnExit()
 	defer ws.Close()
 
 	// Authenticate as administrator first (required for password commands)
@@ -245,7 +309,7 @@ func (e *simpleExecutor) SetAdministratorPassword(ctx context.Context, oldPasswo
 	}
 
 	// Wait for Old_Password: prompt
-	_, err = ws.readUntilString("Old_Password:", 10*time.Second)
+	_, err = ws.readUntilString("Old_Password:", ws.timeouts.Login)
 	if err != nil {
 		return fmt.Errorf("failed to get Old_Password prompt: %w", err)
 	}
@@ -257,7 +321,7 @@ func (e *simpleExecutor) SetAdministratorPassword(ctx context.Context, oldPasswo
 	}
 
 	// Wait for first New_Password: prompt
-	_, err = ws.readUntilString("New_Password:", 10*time.Second)
+	_, err = ws.readUntilString("New_Password:", ws.timeouts.Login)
 	if err != nil {
 		return fmt.Errorf("failed to get first New_Password prompt: %w", err)
 	}
@@ -269,7 +333,7 @@ func (e *simpleExecutor) SetAdministratorPassword(ctx context.Context, oldPasswo
 	}
 
 	// Wait for second New_Password: prompt (confirmation)
-	_, err = ws.readUntilString("New_Password:", 10*time.Second)
+	_, err = ws.readUntilString("New_Password:", ws.timeouts.Login)
 	if err != nil {
 		return fmt.Errorf("failed to get second New_Password prompt: %w", err)
 	}
@@ -281,7 +345,7 @@ func (e *simpleExecutor) SetAdministratorPassword(ctx context.Context, oldPasswo
 	}
 
 	// Wait for completion (Password Strength or prompt)
-	response, err := ws.readUntilPrompt(10 * time.Second)
+	response, err := ws.readUntilPrompt(ws.timeouts.Login)
 	if err != nil {
 		return fmt.Errorf("failed to read password change response: %w", err)
 	}
@@ -306,17 +370,18 @@ func (e *simpleExecutor) SetLoginPassword(ctx context.Context, newPassword strin
 	logger.Debug().Msg("SimpleExecutor: Setting login password")
 
 	// Create a new SSH connection for the interactive password command
-	client, err := ssh.Dial("tcp", e.addr, e.config)
+	client, err := dialSSH(ctx, e.jumpHost(), e.addr, e.config)
 	if err != nil {
 		return fmt.Errorf("failed to dial: %w", err)
 	}
 	defer client.Close()
 
 	// Create a working session
-	ws, err := newWorkingSession(client)
+	ws, err := newWorkingSession(client, e.timeouts(), e.pacing(), e.consoleEncoding(), e.prompts())
 	if err != nil {
 		return fmt.Errorf("failed to create session: %w", err)
 	}
+	ws.skipSaveOnExit = e.skipSaveOnExit()
 	defer ws.Close()
 
 	// Authenticate as administrator first (required for password commands)
@@ -335,7 +400,7 @@ func (e *simpleExecutor) SetLoginPassword(ctx context.Context, newPassword strin
 	}
 
 	// Wait for New_Password: prompt (login password may not have old password prompt if not set)
-	_, err = ws.readUntilString("New_Password:", 10*time.Second)
+	_, err = ws.readUntilString("New_Password:", ws.timeouts.Login)
 	if err != nil {
 		return fmt.Errorf("failed to get first New_Password prompt: %w", err)
 	}
@@ -347,7 +412,7 @@ func (e *simpleExecutor) SetLoginPassword(ctx context.Context, newPassword strin
 	}
 
 	// Wait for second New_Password: prompt (confirmation)
-	_, err = ws.readUntilString("New_Password:", 10*time.Second)
+	_, err = ws.readUntilString("New_Password:", ws.timeouts.Login)
 	if err != nil {
 		return fmt.Errorf("failed to get second New_Password prompt: %w", err)
 	}
@@ -359,7 +424,7 @@ func (e *simpleExecutor) SetLoginPassword(ctx context.Context, newPassword strin
 	}
 
 	// Wait for completion (Password Strength or prompt)
-	response, err := ws.readUntilPrompt(10 * time.Second)
+	response, err := ws.readUntilPrompt(ws.timeouts.Login)
 	if err != nil {
 		return fmt.Errorf("failed to read password change response: %w", err)
 	}
@@ -396,7 +461,7 @@ func (e *simpleExecutor) authenticateAsAdminWithSession(ctx context.Context, ws
 	}
 
 	// Read until we get password prompt or admin prompt (already administrator)
-	response, err := ws.readUntilPasswordPromptOrAdminMode(10 * time.Second)
+	response, err := ws.readUntilPasswordPromptOrAdminMode(ws.timeouts.Login)
 	if err != nil {
 		return fmt.Errorf("failed to get response after administrator command: %w", err)
 	}
@@ -433,7 +498,7 @@ func (e *simpleExecutor) authenticateAsAdminWithSession(ctx context.Context, ws
 	}
 
 	// Read response after password - look for administrator prompt (# instead of >)
-	response, err = ws.readUntilPrompt(10 * time.Second)
+	response, err = ws.readUntilPrompt(ws.timeouts.Login)
 	if err != nil {
 		return fmt.Errorf("failed to read password response: %w", err)
 	}
@@ -443,7 +508,7 @@ func (e *simpleExecutor) authenticateAsAdminWithSession(ctx context.Context, ws
 	// Check for authentication success
 	if strings.Contains(responseStr, "incorrect") || strings.Contains(responseStr, "failed") || strings.Contains(responseStr, "Invalid") ||
 		strings.Contains(responseStr, "エラー") || strings.Contains(responseStr, "パスワードが違います") {
-		return fmt.Errorf("administrator authentication failed: %s", responseStr)
+		return fmt.Errorf("administrator %w: %s", ErrAuthFailed, responseStr)
 	}
 
 	logger.Debug().Msg("SimpleExecutor: Administrator authentication successful")
@@ -457,17 +522,18 @@ func (e *simpleExecutor) GenerateSSHDHostKey(ctx context.Context) error {
 	logger.Debug().Msg("SimpleExecutor: Generating SSHD host key")
 
 	// Create a new SSH connection for the interactive command
-	client, err := ssh.Dial("tcp", e.addr, e.config)
+	client, err := dialSSH(ctx, e.jumpHost(), e.addr, e.config)
 	if err != nil {
 		return fmt.Errorf("failed to dial: %w", err)
 	}
 	defer client.Close()
 
 	// Create a working session
-	ws, err := newWorkingSession(client)
+	ws, err := newWorkingSession(client, e.timeouts(), e.pacing(), e.consoleEncoding(), e.prompts())
 	if err != nil {
 		return fmt.Errorf("failed to create session: %w", err)
 	}
+	ws.skipSaveOnExit = e.skipSaveOnExit()
 	defer ws.Close()
 
 	// Authenticate as administrator first (required for sshd commands)
@@ -490,7 +556,7 @@ func (e *simpleExecutor) GenerateSSHDHostKey(ctx context.Context) error {
 	// Read response - either:
 	// 1. Confirmation prompt (Y/N) if host key already exists
 	// 2. Direct completion with prompt if no existing key
-	keyGenTimeout := 10 * time.Minute
+	keyGenTimeout := ws.timeouts.longOperationTimeout(ctx)
 	response, err := ws.readUntilPromptOrConfirmation(keyGenTimeout)
 	if err != nil {
 		return fmt.Errorf("failed to read sshd host key generate response: %w", err)
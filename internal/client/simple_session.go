@@ -102,7 +102,7 @@ func (s *simpleRTXSession) Send(cmd string) ([]byte, error) {
 		return nil, fmt.Errorf("session is closed")
 	}
 
-	logging.Global().Debug().Str("component", "simple-session").Msgf("Sending RTX command: %s", cmd)
+	logging.Global().Debug().Str("component", "simple-session").Msgf("Sending RTX command: %s", logging.RedactCommand(cmd))
 
 	output, err := s.sendCommand(cmd)
 	if err != nil {
@@ -132,7 +132,7 @@ func (s *SNMPService) Create(ctx context.Context, config SNMPConfig) error {
 
 	// Execute all commands
 	for _, cmd := range commands {
-		logging.FromContext(ctx).Debug().Str("service", "snmp").Msgf("Executing SNMP command: %s", cmd)
+		logging.FromContext(ctx).Debug().Str("service", "snmp").Msgf("Executing SNMP command: %s", logging.RedactCommand(cmd))
 		output, err := s.executor.Run(ctx, cmd)
 		if err != nil {
 			return fmt.Errorf("failed to execute SNMP command '%s': %w", cmd, err)
@@ -302,7 +302,7 @@ func (s *SNMPService) Update(ctx context.Context, config SNMPConfig) error {
 
 	// Execute all commands
 	for _, cmd := range commands {
-		logging.FromContext(ctx).Debug().Str("service", "snmp").Msgf("Executing SNMP command: %s", cmd)
+		logging.FromContext(ctx).Debug().Str("service", "snmp").Msgf("Executing SNMP command: %s", logging.RedactCommand(cmd))
 		output, err := s.executor.Run(ctx, cmd)
 		if err != nil {
 			return fmt.Errorf("failed to execute SNMP command '%s': %w", cmd, err)
@@ -369,7 +369,7 @@ func (s *SNMPService) Delete(ctx context.Context) error {
 
 	// Execute all commands
 	for _, cmd := range commands {
-		logging.FromContext(ctx).Debug().Str("service", "snmp").Msgf("Executing SNMP delete command: %s", cmd)
+		logging.FromContext(ctx).Debug().Str("service", "snmp").Msgf("Executing SNMP delete command: %s", logging.RedactCommand(cmd))
 		output, err := s.executor.Run(ctx, cmd)
 		if err != nil {
 			// Ignore errors for delete commands - resource may already be gone
@@ -1,11 +1,13 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"fmt"
 	"net"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -47,7 +49,7 @@ func (d *sshDialer) Dial(ctx context.Context, host string, config *Config) (Sess
 
 	// Use DialContext to prevent goroutine leaks
 	logger.Debug().Str("addr", addr).Int("auth_methods_count", len(authMethods)).Msg("Dialing SSH")
-	client, err := DialContext(ctx, "tcp", addr, sshConfig)
+	client, err := dialSSHContext(ctx, config.JumpHost, addr, sshConfig)
 	if err != nil {
 		// Check if it's an authentication error by examining the error message
 		errMsg := err.Error()
@@ -59,11 +61,12 @@ func (d *sshDialer) Dial(ctx context.Context, host string, config *Config) (Sess
 	logger.Debug().Msg("SSH connection established")
 
 	// Use the working session implementation that matches our successful test
-	session, err := newWorkingSession(client)
+	session, err := newWorkingSession(client, config.Timeouts, config.Pacing, config.ConsoleEncoding, newPromptPatterns(config.Prompts))
 	if err != nil {
 		client.Close()
 		return nil, fmt.Errorf("failed to create RTX session: %w", err)
 	}
+	session.skipSaveOnExit = config.SaveMode == SaveModeManual
 
 	return session, nil
 }
@@ -93,8 +96,8 @@ func (d *sshDialer) buildAuthMethods(config *Config) []ssh.AuthMethod {
 		Msg("buildAuthMethods: checking authentication options")
 
 	// If no explicit key is provided, try SSH agent first
-	if !hasExplicitKey {
-		if agentAuth := d.trySSHAgent(); agentAuth != nil {
+	if !hasExplicitKey && !config.DisableSSHAgent {
+		if agentAuth := d.trySSHAgent(config.SSHAgentSocket); agentAuth != nil {
 			logger.Debug().Msg("SSH agent authentication available")
 			methods = append(methods, agentAuth)
 		}
@@ -108,7 +111,19 @@ func (d *sshDialer) buildAuthMethods(config *Config) []ssh.AuthMethod {
 			signer = wrapSignerForRTX(signer)
 			fingerprint := ssh.FingerprintSHA256(signer.PublicKey())
 			logger.Debug().Str("fingerprint", fingerprint).Str("key_type", signer.PublicKey().Type()).Msg("Private key authentication configured (RTX legacy mode)")
-			methods = append(methods, ssh.PublicKeys(signer))
+
+			// Offer the certificate first, then the plain key (same order as OpenSSH)
+			signers := []ssh.Signer{signer}
+			if cert := d.loadCertificate(config, signer.PublicKey()); cert != nil {
+				certSigner, err := ssh.NewCertSigner(cert, signer)
+				if err != nil {
+					logger.Error().Err(err).Msg("Failed to create certificate signer")
+				} else {
+					logger.Debug().Str("key_id", cert.KeyId).Msg("Certificate authentication configured")
+					signers = []ssh.Signer{certSigner, signer}
+				}
+			}
+			methods = append(methods, ssh.PublicKeys(signers...))
 		} else {
 			logger.Error().Msg("Failed to load private key - signer is nil")
 		}
@@ -151,14 +166,10 @@ func (d *sshDialer) loadPrivateKey(config *Config) ssh.Signer {
 		logger.Debug().Msg("Using private key from content")
 	} else if config.PrivateKeyFile != "" {
 		// Read key from file, handling ~ expansion
-		keyPath := config.PrivateKeyFile
-		if strings.HasPrefix(keyPath, "~/") {
-			homeDir, homeErr := os.UserHomeDir()
-			if homeErr != nil {
-				logger.Error().Err(homeErr).Msg("Failed to get user home directory")
-				return nil
-			}
-			keyPath = homeDir + keyPath[1:]
+		keyPath, homeErr := expandUserPath(config.PrivateKeyFile)
+		if homeErr != nil {
+			logger.Error().Err(homeErr).Msg("Failed to get user home directory")
+			return nil
 		}
 
 		keyData, err = os.ReadFile(keyPath)
@@ -189,12 +200,80 @@ func (d *sshDialer) loadPrivateKey(config *Config) ssh.Signer {
 	return signer
 }
 
+// loadCertificate loads the OpenSSH certificate for the private key, from
+// Certificate, CertificateFile or, like OpenSSH, "<private key file>-cert.pub".
+// Returns nil if no certificate is configured or it cannot be used.
+func (d *sshDialer) loadCertificate(config *Config, publicKey ssh.PublicKey) *ssh.Certificate {
+	logger := logging.Global()
+
+	var certData []byte
+	switch {
+	case config.Certificate != "":
+		certData = []byte(config.Certificate)
+	case config.CertificateFile != "":
+		certPath, err := expandUserPath(config.CertificateFile)
+		if err == nil {
+			certData, err = os.ReadFile(certPath)
+		}
+		if err != nil {
+			logger.Error().Err(err).Str("file", config.CertificateFile).Msg("Failed to read certificate file")
+			return nil
+		}
+	case config.PrivateKeyFile != "":
+		keyPath, err := expandUserPath(config.PrivateKeyFile)
+		if err != nil {
+			return nil
+		}
+		certData, err = os.ReadFile(keyPath + "-cert.pub")
+		if err != nil {
+			return nil // No certificate next to the key
+		}
+	default:
+		return nil
+	}
+
+	pub, _, _, _, err := ssh.ParseAuthorizedKey(certData)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to parse certificate")
+		return nil
+	}
+	cert, ok := pub.(*ssh.Certificate)
+	if !ok {
+		logger.Error().Str("type", pub.Type()).Msg("Certificate is not an OpenSSH certificate")
+		return nil
+	}
+	if !bytes.Equal(cert.Key.Marshal(), publicKey.Marshal()) {
+		logger.Error().Str("key_id", cert.KeyId).Msg("Certificate does not match the private key")
+		return nil
+	}
+	if cert.ValidBefore != ssh.CertTimeInfinity && time.Now().After(time.Unix(int64(cert.ValidBefore), 0)) {
+		logger.Warn().Str("key_id", cert.KeyId).Msg("Certificate has expired")
+	}
+
+	return cert
+}
+
+// expandUserPath expands a leading "~/" to the user's home directory
+func expandUserPath(path string) (string, error) {
+	if !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return homeDir + path[1:], nil
+}
+
 // trySSHAgent attempts to connect to SSH agent and returns an auth method.
+// socketPath overrides SSH_AUTH_SOCK when set.
 // Returns nil if SSH agent is not available.
-func (d *sshDialer) trySSHAgent() ssh.AuthMethod {
+func (d *sshDialer) trySSHAgent(socketPath string) ssh.AuthMethod {
 	logger := logging.Global()
 
-	socketPath := os.Getenv("SSH_AUTH_SOCK")
+	if socketPath == "" {
+		socketPath = os.Getenv("SSH_AUTH_SOCK")
+	}
 	if socketPath == "" {
 		logger.Debug().Msg("SSH_AUTH_SOCK not set, SSH agent not available")
 		return nil
@@ -210,36 +289,119 @@ func (d *sshDialer) trySSHAgent() ssh.AuthMethod {
 	return ssh.PublicKeysCallback(agentClient.Signers)
 }
 
-// getHostKeyCallback returns the appropriate host key callback based on configuration
+// defaultKnownHostsFile is verified against when no host key, fingerprint or
+// known_hosts file is configured
+const defaultKnownHostsFile = "~/.ssh/known_hosts"
+
+// getHostKeyCallback returns the appropriate host key callback based on configuration.
+// Priority: 1) Fixed host key, 2) Pinned fingerprint, 3) known_hosts file,
+// 4) ~/.ssh/known_hosts. Verification failures, including a known_hosts file
+// that cannot be loaded, reject the connection unless HostKeyWarnOnly is set.
+// Host keys are only left unchecked when SkipHostKeyCheck is set.
 func (d *sshDialer) getHostKeyCallback(config *Config) ssh.HostKeyCallback {
 	// If skip host key check is enabled, use insecure callback
 	if config.SkipHostKeyCheck {
 		return ssh.InsecureIgnoreHostKey()
 	}
 
-	// If a fixed host key is provided, use it for verification
-	if config.HostKey != "" {
-		return d.createFixedHostKeyCallback(config.HostKey)
+	var callback ssh.HostKeyCallback
+	switch {
+	case config.HostKey != "":
+		// If a fixed host key is provided, use it for verification
+		callback = d.createFixedHostKeyCallback(config.HostKey)
+	case config.HostKeyFingerprint != "":
+		// If a fingerprint is pinned, compare against it
+		callback = d.createFingerprintHostKeyCallback(config.HostKeyFingerprint)
+	case config.KnownHostsFile != "":
+		// If known_hosts file is provided, use it for verification
+		callback = d.loadKnownHostsCallback(config.KnownHostsFile)
+	default:
+		// Fall back to the user's known_hosts file rather than accepting any key
+		callback = d.loadKnownHostsCallback(defaultKnownHostsFile)
 	}
 
-	// If known_hosts file is provided, use it for verification
-	if config.KnownHostsFile != "" {
-		callback, err := d.createKnownHostsCallback(config.KnownHostsFile)
-		if err != nil {
-			// Return a callback that will fail with the error
-			return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
-				return fmt.Errorf("failed to load known_hosts file %q: %w", config.KnownHostsFile, err)
-			}
+	if config.HostKeyWarnOnly {
+		return warnOnlyHostKeyCallback(callback)
+	}
+	return callback
+}
+
+// loadKnownHostsCallback returns a callback verifying against the known_hosts
+// file at path. If the file cannot be loaded, the callback rejects every key.
+func (d *sshDialer) loadKnownHostsCallback(path string) ssh.HostKeyCallback {
+	expanded, err := expandUserPath(path)
+	if err == nil {
+		var callback ssh.HostKeyCallback
+		if callback, err = d.createKnownHostsCallback(expanded); err == nil {
+			return callback
 		}
-		return callback
 	}
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		return fmt.Errorf("%w: failed to load known_hosts file %q: %v "+
+			"(set 'ssh_host_key', 'host_key_fingerprint' or 'known_hosts_file', or 'skip_host_key_check' for testing)",
+			ErrHostKeyMismatch, path, err)
+	}
+}
 
-	// Default to insecure (backward compatibility)
-	logger := logging.Global()
-	logger.Warn().Msg("SSH host key verification is disabled. " +
-		"This makes the connection vulnerable to man-in-the-middle attacks. " +
-		"Consider using 'known_hosts_file' or 'host_key' for production environments.")
-	return ssh.InsecureIgnoreHostKey()
+// warnOnlyHostKeyCallback wraps a host key callback so that verification failures
+// are logged instead of rejecting the connection (non-strict host key checking)
+func warnOnlyHostKeyCallback(callback ssh.HostKeyCallback) ssh.HostKeyCallback {
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		if err := callback(hostname, remote, key); err != nil {
+			logging.Global().Warn().
+				Err(err).
+				Str("host", hostname).
+				Str("fingerprint", ssh.FingerprintSHA256(key)).
+				Msg("SSH host key verification failed; continuing because strict host key checking is disabled")
+		}
+		return nil
+	}
+}
+
+// createFingerprintHostKeyCallback creates a callback that verifies the host key
+// against a pinned fingerprint as printed by ssh-keygen -l
+func (d *sshDialer) createFingerprintHostKeyCallback(fingerprint string) ssh.HostKeyCallback {
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		if !hostKeyFingerprintMatches(fingerprint, key) {
+			return fmt.Errorf("%w: host key fingerprint for %s is %s, expected %s",
+				ErrHostKeyMismatch, hostname, ssh.FingerprintSHA256(key), fingerprint)
+		}
+		return nil
+	}
+}
+
+// hostKeyFingerprintMatches reports whether key has the given fingerprint.
+// SHA256 fingerprints ("SHA256:" followed by unpadded base64) and legacy MD5
+// fingerprints (colon-separated hex, optionally prefixed with "MD5:") are accepted.
+func hostKeyFingerprintMatches(fingerprint string, key ssh.PublicKey) bool {
+	fingerprint = strings.TrimSpace(fingerprint)
+	if isMD5Fingerprint(fingerprint) {
+		md5 := fingerprint
+		if len(md5) > 4 && strings.EqualFold(md5[:4], "MD5:") {
+			md5 = md5[4:]
+		}
+		return strings.EqualFold(ssh.FingerprintLegacyMD5(key), md5)
+	}
+	sha := strings.TrimPrefix(fingerprint, "SHA256:")
+	return ssh.FingerprintSHA256(key) == "SHA256:"+strings.TrimRight(sha, "=")
+}
+
+// isMD5Fingerprint reports whether fingerprint is in legacy MD5 format
+func isMD5Fingerprint(fingerprint string) bool {
+	return md5FingerprintPattern.MatchString(fingerprint)
+}
+
+// HostKeyFingerprintPattern matches host key fingerprints as printed by ssh-keygen -l:
+// SHA256 ("SHA256:" followed by base64) or legacy MD5 (colon-separated hex)
+var HostKeyFingerprintPattern = regexp.MustCompile(`^(SHA256:)?[A-Za-z0-9+/]{43}=?$|` + md5FingerprintExpr)
+
+const md5FingerprintExpr = `^((?i:MD5:))?([0-9a-fA-F]{2}:){15}[0-9a-fA-F]{2}$`
+
+var md5FingerprintPattern = regexp.MustCompile(md5FingerprintExpr)
+
+// validHostKeyFingerprint reports whether fingerprint is a well-formed SHA256 or MD5 fingerprint
+func validHostKeyFingerprint(fingerprint string) bool {
+	return HostKeyFingerprintPattern.MatchString(strings.TrimSpace(fingerprint))
 }
 
 // createFixedHostKeyCallback creates a callback that verifies against a fixed host key
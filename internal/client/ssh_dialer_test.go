@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -15,6 +16,7 @@ import (
 	"testing"
 
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
 )
 
 // TestSSHDialer_HostKeyVerification tests host key verification using mock dialer
@@ -168,6 +170,105 @@ func TestHostKeyCallback_FixedHostKey(t *testing.T) {
 	}
 }
 
+// TestHostKeyCallback_Fingerprint tests host key fingerprint pinning
+func TestHostKeyCallback_Fingerprint(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate test key: %v", err)
+	}
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+	key := signer.PublicKey()
+	sha := ssh.FingerprintSHA256(key)
+	md5 := ssh.FingerprintLegacyMD5(key)
+
+	tests := []struct {
+		name        string
+		fingerprint string
+		wantErr     bool
+	}{
+		{name: "SHA256 fingerprint", fingerprint: sha},
+		{name: "SHA256 without prefix", fingerprint: strings.TrimPrefix(sha, "SHA256:")},
+		{name: "SHA256 with padding", fingerprint: sha + "="},
+		{name: "MD5 fingerprint", fingerprint: md5},
+		{name: "MD5 with prefix in upper case", fingerprint: "MD5:" + strings.ToUpper(md5)},
+		{name: "wrong SHA256 fingerprint", fingerprint: "SHA256:" + strings.Repeat("A", 43), wantErr: true},
+		{name: "wrong MD5 fingerprint", fingerprint: "00:11:22:33:44:55:66:77:88:99:aa:bb:cc:dd:ee:ff", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dialer := &sshDialer{}
+			callback := dialer.getHostKeyCallback(&Config{HostKeyFingerprint: tt.fingerprint})
+
+			err := callback("test-host:22", nil, key)
+			if tt.wantErr {
+				if !errors.Is(err, ErrHostKeyMismatch) {
+					t.Errorf("Expected ErrHostKeyMismatch, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestHostKeyCallback_WarnOnly tests that non-strict checking logs instead of failing
+func TestHostKeyCallback_WarnOnly(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate test key: %v", err)
+	}
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+	wrongFingerprint := "SHA256:" + strings.Repeat("A", 43)
+
+	dialer := &sshDialer{}
+	strict := dialer.getHostKeyCallback(&Config{HostKeyFingerprint: wrongFingerprint})
+	if err := strict("test-host:22", nil, signer.PublicKey()); !errors.Is(err, ErrHostKeyMismatch) {
+		t.Errorf("strict callback: expected ErrHostKeyMismatch, got %v", err)
+	}
+
+	warnOnly := dialer.getHostKeyCallback(&Config{HostKeyFingerprint: wrongFingerprint, HostKeyWarnOnly: true})
+	if err := warnOnly("test-host:22", nil, signer.PublicKey()); err != nil {
+		t.Errorf("warn-only callback: expected no error, got %v", err)
+	}
+
+	missingKnownHosts := dialer.getHostKeyCallback(&Config{KnownHostsFile: "/nonexistent/known_hosts", HostKeyWarnOnly: true})
+	if err := missingKnownHosts("test-host:22", nil, signer.PublicKey()); err != nil {
+		t.Errorf("warn-only callback with missing known_hosts: expected no error, got %v", err)
+	}
+}
+
+// TestValidHostKeyFingerprint tests host key fingerprint format validation
+func TestValidHostKeyFingerprint(t *testing.T) {
+	tests := []struct {
+		fingerprint string
+		want        bool
+	}{
+		{"SHA256:" + strings.Repeat("a", 43), true},
+		{strings.Repeat("a", 43), true},
+		{"SHA256:" + strings.Repeat("a", 43) + "=", true},
+		{"MD5:00:11:22:33:44:55:66:77:88:99:aa:bb:cc:dd:ee:ff", true},
+		{"00:11:22:33:44:55:66:77:88:99:AA:BB:CC:DD:EE:FF", true},
+		{"SHA256:short", false},
+		{"00:11:22", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := validHostKeyFingerprint(tt.fingerprint); got != tt.want {
+			t.Errorf("validHostKeyFingerprint(%q) = %v, want %v", tt.fingerprint, got, tt.want)
+		}
+	}
+}
+
 // TestHostKeyCallback_KnownHosts tests the known_hosts file verification logic
 func TestHostKeyCallback_KnownHosts(t *testing.T) {
 	// Generate test key pair
@@ -264,6 +365,51 @@ func TestHostKeyCallback_KnownHosts(t *testing.T) {
 	}
 }
 
+// TestSSHDialer_DefaultKnownHosts tests that without any host key settings
+// the user's known_hosts file is used, and that connections are rejected
+// when it does not exist
+func TestSSHDialer_DefaultKnownHosts(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate test key: %v", err)
+	}
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+	hostKey := base64.StdEncoding.EncodeToString(signer.PublicKey().Marshal())
+	mockAddr := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 22}
+	dialer := &sshDialer{}
+
+	t.Run("missing known_hosts rejects", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		err := dialer.getHostKeyCallback(&Config{})("testhost:22", mockAddr, signer.PublicKey())
+		if !errors.Is(err, ErrHostKeyMismatch) {
+			t.Errorf("expected ErrHostKeyMismatch, got %v", err)
+		}
+	})
+
+	t.Run("known host accepted", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		if err := os.MkdirAll(filepath.Join(home, ".ssh"), 0700); err != nil {
+			t.Fatalf("Failed to create .ssh directory: %v", err)
+		}
+		content := fmt.Sprintf("testhost ssh-rsa %s\n", hostKey)
+		if err := os.WriteFile(filepath.Join(home, ".ssh", "known_hosts"), []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to create known_hosts file: %v", err)
+		}
+
+		if err := dialer.getHostKeyCallback(&Config{})("testhost:22", mockAddr, signer.PublicKey()); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if err := dialer.getHostKeyCallback(&Config{})("otherhost:22", mockAddr, signer.PublicKey()); err == nil {
+			t.Error("Expected error for unknown host, got nil")
+		}
+	})
+}
+
 // TestSSHDialer_HostKeyCallbackSelection tests that the dialer selects the correct callback
 func TestSSHDialer_HostKeyCallbackSelection(t *testing.T) {
 	tests := []struct {
@@ -295,9 +441,9 @@ func TestSSHDialer_HostKeyCallbackSelection(t *testing.T) {
 			expectedCallback: "insecure",
 		},
 		{
-			name:             "insecure when no keys configured",
+			name:             "default known_hosts when no keys configured",
 			config:           &Config{},
-			expectedCallback: "insecure",
+			expectedCallback: "known_hosts",
 		},
 	}
 
@@ -577,7 +723,7 @@ func TestSSHDialer_TrySSHAgent(t *testing.T) {
 	originalSock := os.Getenv("SSH_AUTH_SOCK")
 	t.Setenv("SSH_AUTH_SOCK", "") // Use t.Setenv which automatically restores
 
-	authMethod := dialer.trySSHAgent()
+	authMethod := dialer.trySSHAgent("")
 	if authMethod != nil {
 		t.Error("Expected nil when SSH_AUTH_SOCK is not set")
 	}
@@ -589,7 +735,7 @@ func TestSSHDialer_TrySSHAgent(t *testing.T) {
 		// If SSH agent is available, trySSHAgent should return an auth method
 		// Note: We don't assert authMethod != nil here because the agent
 		// might not be running even if SSH_AUTH_SOCK is set
-		_ = dialer.trySSHAgent()
+		_ = dialer.trySSHAgent("")
 	}
 }
 
@@ -641,3 +787,214 @@ func TestSSHDialer_BuildAuthMethods_AgentFallback(t *testing.T) {
 		t.Errorf("Expected 2 auth methods with password only (no agent), got %d", len(methods))
 	}
 }
+
+// newTestCertificate signs an OpenSSH user certificate for key with a throwaway CA
+func newTestCertificate(t *testing.T, key ssh.PublicKey, validBefore uint64) *ssh.Certificate {
+	t.Helper()
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate CA key: %v", err)
+	}
+	caSigner, err := ssh.NewSignerFromKey(caKey)
+	if err != nil {
+		t.Fatalf("Failed to create CA signer: %v", err)
+	}
+	cert := &ssh.Certificate{
+		Key:             key,
+		KeyId:           "terraform",
+		CertType:        ssh.UserCert,
+		ValidPrincipals: []string{"admin"},
+		ValidBefore:     validBefore,
+	}
+	if err := cert.SignCert(rand.Reader, caSigner); err != nil {
+		t.Fatalf("Failed to sign certificate: %v", err)
+	}
+	return cert
+}
+
+// TestSSHDialer_LoadCertificate tests OpenSSH certificate loading
+func TestSSHDialer_LoadCertificate(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate test key: %v", err)
+	}
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate other key: %v", err)
+	}
+	otherSigner, err := ssh.NewSignerFromKey(otherKey)
+	if err != nil {
+		t.Fatalf("Failed to create other signer: %v", err)
+	}
+
+	cert := newTestCertificate(t, signer.PublicKey(), ssh.CertTimeInfinity)
+	certText := string(ssh.MarshalAuthorizedKey(cert))
+	otherCertText := string(ssh.MarshalAuthorizedKey(newTestCertificate(t, otherSigner.PublicKey(), ssh.CertTimeInfinity)))
+
+	tmpDir := t.TempDir()
+	keyFile := filepath.Join(tmpDir, "id_rsa")
+	if err := os.WriteFile(keyFile+"-cert.pub", []byte(certText), 0600); err != nil {
+		t.Fatalf("Failed to write certificate: %v", err)
+	}
+	certFile := filepath.Join(tmpDir, "router-cert.pub")
+	if err := os.WriteFile(certFile, []byte(certText), 0600); err != nil {
+		t.Fatalf("Failed to write certificate: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		config   *Config
+		wantCert bool
+	}{
+		{
+			name:     "certificate content",
+			config:   &Config{Certificate: certText},
+			wantCert: true,
+		},
+		{
+			name:     "certificate file",
+			config:   &Config{CertificateFile: certFile},
+			wantCert: true,
+		},
+		{
+			name:     "certificate next to private key file",
+			config:   &Config{PrivateKeyFile: keyFile},
+			wantCert: true,
+		},
+		{
+			name:     "no certificate next to private key file",
+			config:   &Config{PrivateKeyFile: filepath.Join(tmpDir, "id_ed25519")},
+			wantCert: false,
+		},
+		{
+			name:     "certificate for another key",
+			config:   &Config{Certificate: otherCertText},
+			wantCert: false,
+		},
+		{
+			name:     "plain public key is not a certificate",
+			config:   &Config{Certificate: string(ssh.MarshalAuthorizedKey(signer.PublicKey()))},
+			wantCert: false,
+		},
+		{
+			name:     "no certificate configured",
+			config:   &Config{},
+			wantCert: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dialer := &sshDialer{}
+			got := dialer.loadCertificate(tt.config, signer.PublicKey())
+			if (got != nil) != tt.wantCert {
+				t.Errorf("loadCertificate() = %v, want certificate: %v", got, tt.wantCert)
+			}
+		})
+	}
+}
+
+// TestSSHDialer_CertificateAuthentication tests certificate authentication against an SSH server
+func TestSSHDialer_CertificateAuthentication(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate test key: %v", err)
+	}
+	privPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	})
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+	cert := newTestCertificate(t, signer.PublicKey(), ssh.CertTimeInfinity)
+
+	// The server only accepts certificates signed by the test CA
+	checker := &ssh.CertChecker{
+		IsUserAuthority: func(auth ssh.PublicKey) bool {
+			return bytes.Equal(auth.Marshal(), cert.SignatureKey.Marshal())
+		},
+	}
+	serverConfig := &ssh.ServerConfig{PublicKeyCallback: checker.Authenticate}
+	hostKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate host key: %v", err)
+	}
+	hostSigner, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		t.Fatalf("Failed to create host signer: %v", err)
+	}
+	serverConfig.AddHostKey(hostSigner)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _, _, _ = ssh.NewServerConn(conn, serverConfig)
+	}()
+
+	t.Setenv("SSH_AUTH_SOCK", "")
+	dialer := &sshDialer{}
+	clientConfig := &ssh.ClientConfig{
+		User:            "admin",
+		Auth:            dialer.buildAuthMethods(&Config{PrivateKey: string(privPEM), Certificate: string(ssh.MarshalAuthorizedKey(cert))}),
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	}
+	client, err := ssh.Dial("tcp", listener.Addr().String(), clientConfig)
+	if err != nil {
+		t.Fatalf("Certificate authentication failed: %v", err)
+	}
+	client.Close()
+}
+
+// TestSSHDialer_AgentSocket tests the SSH agent socket override and opt-out
+func TestSSHDialer_AgentSocket(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "agent.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Skipf("Unix sockets not available: %v", err)
+	}
+	defer listener.Close()
+	keyring := agent.NewKeyring()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_ = agent.ServeAgent(keyring, conn)
+			}()
+		}
+	}()
+
+	t.Setenv("SSH_AUTH_SOCK", "")
+	dialer := &sshDialer{}
+
+	if dialer.trySSHAgent(socketPath) == nil {
+		t.Error("Expected agent auth method for explicit socket path")
+	}
+
+	methods := dialer.buildAuthMethods(&Config{SSHAgentSocket: socketPath, Password: "testpass"})
+	if len(methods) != 3 {
+		t.Errorf("Expected agent, password and keyboard-interactive methods, got %d", len(methods))
+	}
+
+	methods = dialer.buildAuthMethods(&Config{SSHAgentSocket: socketPath, DisableSSHAgent: true, Password: "testpass"})
+	if len(methods) != 2 {
+		t.Errorf("Expected only password methods with the agent disabled, got %d", len(methods))
+	}
+}
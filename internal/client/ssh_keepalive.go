@@ -0,0 +1,106 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+const (
+	// DefaultSSHKeepaliveInterval is the default interval between SSH keepalive requests
+	DefaultSSHKeepaliveInterval = 30 * time.Second
+	// DefaultSSHKeepaliveMaxMissed is the default number of unanswered keepalives
+	// after which a connection is considered dead
+	DefaultSSHKeepaliveMaxMissed = 3
+)
+
+// sshKeepaliveRequest is the global request sent as keepalive. Servers that do not
+// know it reply with a failure, which still proves the connection is alive.
+const sshKeepaliveRequest = "keepalive@openssh.com"
+
+// SSHKeepaliveConfig configures SSH keepalives on long-lived connections
+type SSHKeepaliveConfig struct {
+	Interval  time.Duration // Interval between keepalive requests (0 disables keepalives)
+	MaxMissed int           // Unanswered keepalives before the connection is closed (default: 3)
+}
+
+// DefaultSSHKeepaliveConfig returns the keepalive settings used by the provider
+func DefaultSSHKeepaliveConfig() SSHKeepaliveConfig {
+	return SSHKeepaliveConfig{
+		Interval:  DefaultSSHKeepaliveInterval,
+		MaxMissed: DefaultSSHKeepaliveMaxMissed,
+	}
+}
+
+// keepaliveConn is the part of *ssh.Client used for keepalives
+type keepaliveConn interface {
+	SendRequest(name string, wantReply bool, payload []byte) (bool, []byte, error)
+	Close() error
+}
+
+// startSSHKeepalive sends keepalive requests on conn until the returned stop
+// function is called. When the connection fails, or MaxMissed keepalives in a row
+// go unanswered, conn is closed and onDead is called so that the connection is
+// replaced instead of failing the next command.
+func startSSHKeepalive(conn keepaliveConn, config SSHKeepaliveConfig, onDead func()) (stop func()) {
+	if config.Interval <= 0 {
+		return func() {}
+	}
+	maxMissed := config.MaxMissed
+	if maxMissed <= 0 {
+		maxMissed = DefaultSSHKeepaliveMaxMissed
+	}
+
+	stopCh := make(chan struct{})
+	var once sync.Once
+	stop = func() {
+		once.Do(func() { close(stopCh) })
+	}
+
+	go func() {
+		logger := logging.Global()
+		ticker := time.NewTicker(config.Interval)
+		defer ticker.Stop()
+
+		missed := 0
+		for {
+			select {
+			case <-stopCh:
+				return
+			case <-ticker.C:
+			}
+
+			// SendRequest blocks until the server replies, so wait for it in the background
+			reply := make(chan error, 1)
+			go func() {
+				_, _, err := conn.SendRequest(sshKeepaliveRequest, true, nil)
+				reply <- err
+			}()
+
+			select {
+			case <-stopCh:
+				return
+			case err := <-reply:
+				if err != nil {
+					logger.Warn().Err(err).Msg("SSH keepalive failed, connection lost")
+					_ = conn.Close()
+					onDead()
+					return
+				}
+				missed = 0
+			case <-time.After(config.Interval):
+				missed++
+				logger.Debug().Int("missed", missed).Int("max_missed", maxMissed).Msg("SSH keepalive unanswered")
+				if missed >= maxMissed {
+					logger.Warn().Int("missed", missed).Msg("SSH keepalives unanswered, closing connection")
+					_ = conn.Close()
+					onDead()
+					return
+				}
+			}
+		}
+	}()
+
+	return stop
+}
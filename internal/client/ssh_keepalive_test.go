@@ -0,0 +1,90 @@
+package client
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeKeepaliveConn is a keepaliveConn whose replies are controlled by the test
+type fakeKeepaliveConn struct {
+	mu       sync.Mutex
+	requests int
+	block    chan struct{} // when non-nil, SendRequest blocks until it is closed
+	err      error
+	closed   atomic.Bool
+}
+
+func (c *fakeKeepaliveConn) SendRequest(name string, wantReply bool, payload []byte) (bool, []byte, error) {
+	c.mu.Lock()
+	c.requests++
+	block, err := c.block, c.err
+	c.mu.Unlock()
+
+	if block != nil {
+		<-block
+	}
+	return false, nil, err
+}
+
+func (c *fakeKeepaliveConn) Close() error {
+	c.closed.Store(true)
+	return nil
+}
+
+func (c *fakeKeepaliveConn) requestCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.requests
+}
+
+func TestStartSSHKeepalive_RepliesKeepConnectionOpen(t *testing.T) {
+	conn := &fakeKeepaliveConn{}
+	var dead atomic.Bool
+
+	stop := startSSHKeepalive(conn, SSHKeepaliveConfig{Interval: 10 * time.Millisecond, MaxMissed: 2}, func() { dead.Store(true) })
+	time.Sleep(100 * time.Millisecond)
+	stop()
+
+	assert.GreaterOrEqual(t, conn.requestCount(), 3, "should send keepalives periodically")
+	assert.False(t, dead.Load())
+	assert.False(t, conn.closed.Load())
+}
+
+func TestStartSSHKeepalive_MissedRepliesCloseConnection(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	conn := &fakeKeepaliveConn{block: block}
+	var dead atomic.Bool
+
+	stop := startSSHKeepalive(conn, SSHKeepaliveConfig{Interval: 10 * time.Millisecond, MaxMissed: 2}, func() { dead.Store(true) })
+	defer stop()
+
+	assert.Eventually(t, dead.Load, time.Second, 5*time.Millisecond, "connection should be reported dead")
+	assert.True(t, conn.closed.Load())
+}
+
+func TestStartSSHKeepalive_RequestErrorMarksDead(t *testing.T) {
+	conn := &fakeKeepaliveConn{err: errors.New("connection reset by peer")}
+	var dead atomic.Bool
+
+	stop := startSSHKeepalive(conn, SSHKeepaliveConfig{Interval: 10 * time.Millisecond, MaxMissed: 3}, func() { dead.Store(true) })
+	defer stop()
+
+	assert.Eventually(t, dead.Load, time.Second, 5*time.Millisecond, "connection should be reported dead")
+	assert.Equal(t, 1, conn.requestCount())
+}
+
+func TestStartSSHKeepalive_Disabled(t *testing.T) {
+	conn := &fakeKeepaliveConn{}
+
+	stop := startSSHKeepalive(conn, SSHKeepaliveConfig{}, func() {})
+	time.Sleep(30 * time.Millisecond)
+	stop()
+
+	assert.Equal(t, 0, conn.requestCount())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/crypto/ssh"
@@ -13,9 +14,16 @@ import (
 
 // SSHPoolConfig configures the SSH connection pool
 type SSHPoolConfig struct {
-	MaxSessions    int           // Maximum concurrent SSH connections (default: 2)
-	IdleTimeout    time.Duration // Close SSH connections after idle time (default: 5m)
-	AcquireTimeout time.Duration // Max wait for SSH connection acquisition (default: 30s)
+	MaxSessions     int                // Maximum concurrent SSH connections (default: 2)
+	IdleTimeout     time.Duration      // Close SSH connections after idle time (default: 5m)
+	AcquireTimeout  time.Duration      // Max wait for SSH connection acquisition (default: 30s)
+	JumpHost        *JumpHostConfig    // Jump host to connect through (nil for direct connections)
+	Timeouts        Timeouts           // Router response timeouts for sessions on new connections
+	Pacing          Pacing             // Console input pacing for sessions on new connections
+	ConsoleEncoding ConsoleEncoding    // Console character encoding of the router
+	Keepalive       SSHKeepaliveConfig // SSH keepalives on pooled connections (zero interval disables)
+	SkipSaveOnExit  bool               // Decline the save prompt when leaving administrator mode (manual save mode)
+	Prompts         *PromptConfig      // Console prompt overrides (nil: built-in detection)
 }
 
 // DefaultSSHPoolConfig returns sensible defaults for SSH connection pool
@@ -36,6 +44,14 @@ type PooledConnection struct {
 	lastUsed    time.Time
 	useCount    int
 	initialized bool
+
+	dead          atomic.Bool // Set when keepalives detect that the connection is lost
+	stopKeepalive func()      // Stops the keepalive goroutine (nil if keepalives are disabled)
+}
+
+// isDead reports whether keepalives detected that the connection is lost
+func (c *PooledConnection) isDead() bool {
+	return c.dead.Load()
 }
 
 // ConnectionFactory is a function that creates a new PooledConnection.
@@ -144,6 +160,15 @@ func (p *SSHConnectionPool) Acquire(ctx context.Context) (*PooledConnection, err
 		if len(p.available) > 0 {
 			conn := p.available[len(p.available)-1]
 			p.available = p.available[:len(p.available)-1]
+
+			// Replace connections lost while idle (e.g., dropped by a firewall)
+			if conn.isDead() {
+				logger.Info().
+					Str("pool_id", conn.poolID).
+					Msg("Pooled SSH connection was lost, reconnecting")
+				p.closeConnection(conn)
+				continue
+			}
 			p.inUse[conn] = true
 			conn.lastUsed = time.Now()
 			conn.useCount++
@@ -287,6 +312,9 @@ func (p *SSHConnectionPool) Discard(conn *PooledConnection) {
 
 // closeConnection closes both the session and client of a connection
 func (p *SSHConnectionPool) closeConnection(conn *PooledConnection) {
+	if conn.stopKeepalive != nil {
+		conn.stopKeepalive()
+	}
 	if conn.session != nil {
 		conn.session.Close()
 	}
@@ -366,7 +394,7 @@ func (p *SSHConnectionPool) createConnection() (*PooledConnection, error) {
 		Msg("Dialing new SSH connection for pool")
 
 	// Establish new TCP connection + SSH handshake
-	client, err := ssh.Dial("tcp", p.address, p.sshConfig)
+	client, err := dialSSH(context.Background(), p.config.JumpHost, p.address, p.sshConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to dial SSH: %w", err)
 	}
@@ -376,11 +404,12 @@ func (p *SSHConnectionPool) createConnection() (*PooledConnection, error) {
 		Msg("Creating working session on new connection")
 
 	// Create working session on the new connection
-	session, err := newWorkingSession(client)
+	session, err := newWorkingSession(client, p.config.Timeouts, p.config.Pacing, p.config.ConsoleEncoding, newPromptPatterns(p.config.Prompts))
 	if err != nil {
 		client.Close()
 		return nil, fmt.Errorf("failed to create working session: %w", err)
 	}
+	session.skipSaveOnExit = p.config.SkipSaveOnExit
 
 	pooledConn := &PooledConnection{
 		client:      client,
@@ -391,6 +420,9 @@ func (p *SSHConnectionPool) createConnection() (*PooledConnection, error) {
 		useCount:    1,
 		initialized: true,
 	}
+	pooledConn.stopKeepalive = startSSHKeepalive(client, p.config.Keepalive, func() {
+		pooledConn.dead.Store(true)
+	})
 
 	logger.Debug().
 		Str("pool_id", pooledConn.poolID).
@@ -419,6 +451,14 @@ func (p *SSHConnectionPool) idleCleanup() {
 		closedCount := 0
 
 		for _, conn := range p.available {
+			if conn.isDead() {
+				logger.Debug().
+					Str("pool_id", conn.poolID).
+					Msg("Closing lost SSH connection")
+				p.closeConnection(conn)
+				closedCount++
+				continue
+			}
 			if len(remaining) == 0 || now.Sub(conn.lastUsed) < p.config.IdleTimeout {
 				remaining = append(remaining, conn)
 			} else {
@@ -494,6 +534,14 @@ func (c *PooledConnection) Send(cmd string) ([]byte, error) {
 	return c.session.Send(cmd)
 }
 
+// SendContext sends a command to the session, applying the command timeout for ctx
+func (c *PooledConnection) SendContext(ctx context.Context, cmd string) ([]byte, error) {
+	if c.session == nil {
+		return nil, fmt.Errorf("connection has no active session")
+	}
+	return c.session.SendContext(ctx, cmd)
+}
+
 // Close closes the session (but not the client connection)
 func (c *PooledConnection) Close() error {
 	if c.session != nil {
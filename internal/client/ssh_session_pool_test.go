@@ -193,6 +193,36 @@ func TestSSHConnectionPool_Acquire_ReusesAvailableConnection(t *testing.T) {
 	assert.Equal(t, 1, stats.TotalCreated, "should only have created 1 connection total")
 }
 
+func TestSSHConnectionPool_Acquire_ReplacesLostConnection(t *testing.T) {
+	config := SSHPoolConfig{
+		MaxSessions:    2,
+		IdleTimeout:    5 * time.Minute,
+		AcquireTimeout: 5 * time.Second,
+	}
+	pool := createTestPool(config)
+	defer pool.Close()
+
+	ctx := context.Background()
+
+	conn1, err := pool.Acquire(ctx)
+	require.NoError(t, err)
+	pool.Release(conn1)
+
+	// Simulate keepalives detecting a dropped connection while it was idle
+	conn1.dead.Store(true)
+
+	conn2, err := pool.Acquire(ctx)
+	require.NoError(t, err)
+
+	assert.NotSame(t, conn1, conn2, "lost connection should be replaced")
+	assert.False(t, conn2.isDead())
+
+	stats := pool.Stats()
+	assert.Equal(t, 2, stats.TotalCreated, "should have reconnected once")
+	assert.Equal(t, 0, stats.Available)
+	assert.Equal(t, 1, stats.InUse)
+}
+
 func TestSSHConnectionPool_Release_ReturnsToPool(t *testing.T) {
 	config := SSHPoolConfig{
 		MaxSessions:    2,
@@ -227,12 +227,13 @@ func (s *StaticRouteService) toParserRoute(route StaticRoute) parsers.StaticRout
 	nextHops := make([]parsers.NextHop, len(route.NextHops))
 	for i, h := range route.NextHops {
 		nextHops[i] = parsers.NextHop{
-			NextHop:   h.NextHop,
-			Interface: h.Interface,
-			Distance:  h.Distance,
-			Name:      h.Name,
-			Permanent: h.Permanent,
-			Filter:    h.Filter,
+			NextHop:     h.NextHop,
+			Interface:   h.Interface,
+			Distance:    h.Distance,
+			Name:        h.Name,
+			Permanent:   h.Permanent,
+			Filter:      h.Filter,
+			KeepaliveID: h.KeepaliveID,
 		}
 	}
 
@@ -246,12 +247,13 @@ func (s *StaticRouteService) toParserRoute(route StaticRoute) parsers.StaticRout
 // toParserHop converts client.StaticRouteHop to parsers.NextHop
 func (s *StaticRouteService) toParserHop(hop StaticRouteHop) parsers.NextHop {
 	return parsers.NextHop{
-		NextHop:   hop.NextHop,
-		Interface: hop.Interface,
-		Distance:  hop.Distance,
-		Name:      hop.Name,
-		Permanent: hop.Permanent,
-		Filter:    hop.Filter,
+		NextHop:     hop.NextHop,
+		Interface:   hop.Interface,
+		Distance:    hop.Distance,
+		Name:        hop.Name,
+		Permanent:   hop.Permanent,
+		Filter:      hop.Filter,
+		KeepaliveID: hop.KeepaliveID,
 	}
 }
 
@@ -260,12 +262,13 @@ func (s *StaticRouteService) fromParserRoute(pr parsers.StaticRoute) StaticRoute
 	nextHops := make([]StaticRouteHop, len(pr.NextHops))
 	for i, h := range pr.NextHops {
 		nextHops[i] = StaticRouteHop{
-			NextHop:   h.NextHop,
-			Interface: h.Interface,
-			Distance:  h.Distance,
-			Name:      h.Name,
-			Permanent: h.Permanent,
-			Filter:    h.Filter,
+			NextHop:     h.NextHop,
+			Interface:   h.Interface,
+			Distance:    h.Distance,
+			Name:        h.Name,
+			Permanent:   h.Permanent,
+			Filter:      h.Filter,
+			KeepaliveID: h.KeepaliveID,
 		}
 	}
 
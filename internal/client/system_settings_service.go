@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// SystemSettingsService handles global forwarding toggles (ip/ipv6 routing,
+// routing process and source-route filtering)
+type SystemSettingsService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewSystemSettingsService creates a new system settings service instance
+func NewSystemSettingsService(executor Executor, client *rtxClient) *SystemSettingsService {
+	return &SystemSettingsService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the system settings
+func (s *SystemSettingsService) Get(ctx context.Context) (*SystemSettings, error) {
+	cmd := parsers.BuildShowSystemSettingsCommand()
+	logging.FromContext(ctx).Debug().Str("service", "system_settings").Msgf("Getting system settings with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get system settings: %w", err)
+	}
+
+	settings := SystemSettings(*parsers.ParseSystemSettings(string(output)))
+	return &settings, nil
+}
+
+// Configure applies the system settings
+func (s *SystemSettingsService) Configure(ctx context.Context, settings SystemSettings) error {
+	parserSettings := parsers.SystemSettings(settings)
+	if err := parsers.ValidateSystemSettings(parserSettings); err != nil {
+		return fmt.Errorf("invalid system settings: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := parsers.BuildSystemSettingsCommands(parserSettings)
+	logging.FromContext(ctx).Debug().Str("service", "system_settings").Msgf("Configuring system settings with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to configure system settings: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "system settings configured")
+}
+
+// Update applies the system settings
+func (s *SystemSettingsService) Update(ctx context.Context, settings SystemSettings) error {
+	return s.Configure(ctx, settings)
+}
+
+// Reset restores the default system settings
+func (s *SystemSettingsService) Reset(ctx context.Context) error {
+	commands := parsers.BuildDeleteSystemSettingsCommands()
+	logging.FromContext(ctx).Debug().Str("service", "system_settings").Msgf("Resetting system settings with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to reset system settings: %w", err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to reset system settings"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, "system settings reset")
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSystemSettingsService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte("ip lan1 address 192.168.1.1/24\nipv6 routing off\nip filter source-route off\n"), nil)
+
+	service := NewSystemSettingsService(mockExecutor, nil)
+
+	settings, err := service.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &SystemSettings{
+		IPRouting:          true,
+		IPv6Routing:        false,
+		IPRoutingProcess:   "fast",
+		IPv6RoutingProcess: "fast",
+	}, settings)
+}
+
+func TestSystemSettingsService_Configure(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("RunBatch", mock.Anything, []string{
+			"ip routing on",
+			"ipv6 routing on",
+			"ip routing process normal",
+			"ipv6 routing process fast",
+			"ip filter source-route on",
+		}).Return([]byte(""), nil)
+
+		service := NewSystemSettingsService(mockExecutor, nil)
+		err := service.Configure(context.Background(), SystemSettings{
+			IPRouting: true, IPv6Routing: true, IPRoutingProcess: "normal", IPv6RoutingProcess: "fast", IPFilterSourceRoute: true,
+		})
+		assert.NoError(t, err)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("invalid routing process", func(t *testing.T) {
+		service := NewSystemSettingsService(new(MockExecutor), nil)
+		err := service.Configure(context.Background(), SystemSettings{IPRoutingProcess: "turbo", IPv6RoutingProcess: "fast"})
+		assert.ErrorContains(t, err, "invalid system settings")
+	})
+}
+
+func TestSystemSettingsService_Reset(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no ip routing",
+		"no ipv6 routing",
+		"no ip routing process",
+		"no ipv6 routing process",
+		"no ip filter source-route",
+	}).Return([]byte(""), nil)
+
+	service := NewSystemSettingsService(mockExecutor, nil)
+	assert.NoError(t, service.Reset(context.Background()))
+	mockExecutor.AssertExpectations(t)
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+// TFTP opcodes and limits (RFC 1350)
+const (
+	tftpOpWRQ   = 2
+	tftpOpData  = 3
+	tftpOpAck   = 4
+	tftpOpError = 5
+
+	tftpBlockSize   = 512
+	tftpMaxAttempts = 5
+	tftpPort        = 69
+)
+
+// tftpPut writes data to filename on the TFTP server at addr in octet mode.
+// Each packet is retransmitted up to tftpMaxAttempts times, waiting timeout
+// for its acknowledgement.
+func tftpPut(ctx context.Context, addr, filename string, data []byte, timeout time.Duration) error {
+	server, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return fmt.Errorf("%w: invalid TFTP address %q: %v", ErrDial, addr, err)
+	}
+	conn, err := net.ListenUDP("udp", nil)
+	if err != nil {
+		return fmt.Errorf("failed to open TFTP socket: %w", err)
+	}
+	defer conn.Close()
+
+	// Unblock reads when the context ends
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	wrq := make([]byte, 0, 2+len(filename)+1+len("octet")+1)
+	wrq = binary.BigEndian.AppendUint16(wrq, tftpOpWRQ)
+	wrq = append(wrq, filename...)
+	wrq = append(wrq, 0)
+	wrq = append(wrq, "octet"...)
+	wrq = append(wrq, 0)
+
+	// The server answers the request from a new port, which is used for the
+	// rest of the transfer
+	peer, err := tftpExchange(ctx, conn, server, nil, wrq, 0, timeout)
+	if err != nil {
+		return err
+	}
+
+	for block := 1; ; block++ {
+		start := (block - 1) * tftpBlockSize
+		end := min(start+tftpBlockSize, len(data))
+		packet := make([]byte, 0, 4+end-start)
+		packet = binary.BigEndian.AppendUint16(packet, tftpOpData)
+		packet = binary.BigEndian.AppendUint16(packet, uint16(block))
+		packet = append(packet, data[start:end]...)
+
+		if _, err := tftpExchange(ctx, conn, peer, peer, packet, uint16(block), timeout); err != nil {
+			return err
+		}
+		// A block shorter than the block size ends the transfer
+		if end-start < tftpBlockSize {
+			return nil
+		}
+	}
+}
+
+// tftpExchange sends packet to dst until it is acknowledged with block. Once
+// the transfer is established, packets from anyone but peer are ignored.
+// It returns the address the acknowledgement came from.
+func tftpExchange(ctx context.Context, conn *net.UDPConn, dst, peer *net.UDPAddr, packet []byte, block uint16, timeout time.Duration) (*net.UDPAddr, error) {
+	buf := make([]byte, 4+tftpBlockSize)
+	for attempt := 0; attempt < tftpMaxAttempts; attempt++ {
+		if _, err := conn.WriteToUDP(packet, dst); err != nil {
+			return nil, fmt.Errorf("%w: failed to send TFTP packet: %v", ErrDial, err)
+		}
+		if err := conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+			return nil, err
+		}
+
+		for {
+			n, from, err := conn.ReadFromUDP(buf)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				var netErr net.Error
+				if errors.As(err, &netErr) && netErr.Timeout() {
+					break // Retransmit
+				}
+				return nil, fmt.Errorf("%w: TFTP receive failed: %v", ErrDial, err)
+			}
+			if peer != nil && (!from.IP.Equal(peer.IP) || from.Port != peer.Port) {
+				continue
+			}
+			if n < 4 {
+				continue
+			}
+
+			switch binary.BigEndian.Uint16(buf[:2]) {
+			case tftpOpAck:
+				if binary.BigEndian.Uint16(buf[2:4]) == block {
+					return from, nil
+				}
+				// Duplicate acknowledgement of an earlier block
+			case tftpOpError:
+				msg := string(buf[4:n])
+				if i := len(msg) - 1; i >= 0 && msg[i] == 0 {
+					msg = msg[:i]
+				}
+				return nil, fmt.Errorf("%w: TFTP server error %d: %s", ErrCommandFailed, binary.BigEndian.Uint16(buf[2:4]), msg)
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: no TFTP acknowledgement for block %d", ErrTimeout, block)
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tftpTestServer accepts one write request and records the file it receives
+type tftpTestServer struct {
+	addr     string
+	filename string
+	data     bytes.Buffer
+	done     chan struct{}
+}
+
+// startTFTPTestServer starts a server that answers the write request with
+// reject as a TFTP error when set, and drops the first copy of block dropBlock
+func startTFTPTestServer(t *testing.T, reject string, dropBlock uint16) *tftpTestServer {
+	t.Helper()
+	listener, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	s := &tftpTestServer{addr: listener.LocalAddr().String(), done: make(chan struct{})}
+	go func() {
+		defer close(s.done)
+		buf := make([]byte, 1024)
+		n, client, err := listener.ReadFromUDP(buf)
+		if err != nil || binary.BigEndian.Uint16(buf[:2]) != tftpOpWRQ {
+			return
+		}
+		s.filename = strings.SplitN(string(buf[2:n]), "\x00", 2)[0]
+
+		// Like real servers, answer from a new port
+		conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		if reject != "" {
+			packet := binary.BigEndian.AppendUint16(nil, tftpOpError)
+			packet = binary.BigEndian.AppendUint16(packet, 2)
+			_, _ = conn.WriteToUDP(append(append(packet, reject...), 0), client)
+			return
+		}
+
+		ack := func(block uint16) {
+			packet := binary.BigEndian.AppendUint16(nil, tftpOpAck)
+			_, _ = conn.WriteToUDP(binary.BigEndian.AppendUint16(packet, block), client)
+		}
+		ack(0)
+
+		dropped := false
+		for {
+			_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+			n, _, err := conn.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+			block := binary.BigEndian.Uint16(buf[2:4])
+			if block == dropBlock && !dropped {
+				dropped = true
+				continue
+			}
+			s.data.Write(buf[4:n])
+			ack(block)
+			if n-4 < tftpBlockSize {
+				return
+			}
+		}
+	}()
+	return s
+}
+
+func TestTFTPPut(t *testing.T) {
+	server := startTFTPTestServer(t, "", 2)
+	data := bytes.Repeat([]byte("ip route default gateway pp 1\r\n"), 100)
+
+	err := tftpPut(context.Background(), server.addr, "config0/secret", data, 200*time.Millisecond)
+	require.NoError(t, err)
+	<-server.done
+
+	assert.Equal(t, "config0/secret", server.filename)
+	assert.Equal(t, data, server.data.Bytes(), "the dropped block should have been retransmitted")
+}
+
+func TestTFTPPut_ServerError(t *testing.T) {
+	server := startTFTPTestServer(t, "Access violation", 0)
+
+	err := tftpPut(context.Background(), server.addr, "config0", []byte("console prompt RTX\r\n"), 200*time.Millisecond)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrCommandFailed))
+	assert.Contains(t, err.Error(), "Access violation")
+}
+
+func TestTFTPPut_NoServer(t *testing.T) {
+	listener, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	defer listener.Close()
+
+	err = tftpPut(context.Background(), listener.LocalAddr().String(), "config0", []byte("x"), 10*time.Millisecond)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrTimeout))
+}
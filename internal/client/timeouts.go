@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultCommandTimeout is how long to wait for the output of a regular command
+	DefaultCommandTimeout = 15 * time.Second
+	// DefaultLoginTimeout is how long to wait for login and password prompts
+	DefaultLoginTimeout = 10 * time.Second
+	// DefaultSaveTimeout is how long to wait for the configuration to be written to flash
+	DefaultSaveTimeout = 30 * time.Second
+	// DefaultLongOperationTimeout is how long to wait for key generation and firmware updates
+	DefaultLongOperationTimeout = 10 * time.Minute
+)
+
+// Minimum waits for commands known to produce large or slow output
+const (
+	showConfigTimeout      = 120 * time.Second
+	showStatusDHCPTimeout  = 30 * time.Second
+	showEnvironmentTimeout = 20 * time.Second
+)
+
+// Timeouts holds how long to wait for the router at each stage of an operation.
+// Zero values fall back to the defaults.
+type Timeouts struct {
+	Command       time.Duration // Output of a regular command (default: 15s)
+	Login         time.Duration // Session start-up, administrator and password prompts (default: 10s)
+	Save          time.Duration // "save" and the save confirmation on exit (default: 30s)
+	LongOperation time.Duration // Host key generation and firmware updates (default: 10m)
+}
+
+// DefaultTimeouts returns the timeouts used when none are configured
+func DefaultTimeouts() Timeouts {
+	return Timeouts{
+		Command:       DefaultCommandTimeout,
+		Login:         DefaultLoginTimeout,
+		Save:          DefaultSaveTimeout,
+		LongOperation: DefaultLongOperationTimeout,
+	}
+}
+
+// withDefaults returns a copy with unset timeouts replaced by the defaults
+func (t Timeouts) withDefaults() Timeouts {
+	defaults := DefaultTimeouts()
+	if t.Command <= 0 {
+		t.Command = defaults.Command
+	}
+	if t.Login <= 0 {
+		t.Login = defaults.Login
+	}
+	if t.Save <= 0 {
+		t.Save = defaults.Save
+	}
+	if t.LongOperation <= 0 {
+		t.LongOperation = defaults.LongOperation
+	}
+	return t
+}
+
+// commandTimeoutKey is the context key for per-operation command timeouts
+type commandTimeoutKey struct{}
+
+// WithCommandTimeout returns a context in which every command waits up to d for
+// the router's response, replacing the provider-level defaults. Resources use it
+// to apply their timeouts block.
+func WithCommandTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, commandTimeoutKey{}, d)
+}
+
+// commandTimeoutFromContext returns the timeout set by WithCommandTimeout, or 0
+func commandTimeoutFromContext(ctx context.Context) time.Duration {
+	d, _ := ctx.Value(commandTimeoutKey{}).(time.Duration)
+	return d
+}
+
+// commandTimeout returns how long to wait for the output of cmd.
+// A timeout set with WithCommandTimeout takes precedence, and the wait never
+// extends past the context deadline.
+func (t Timeouts) commandTimeout(ctx context.Context, cmd string) time.Duration {
+	t = t.withDefaults()
+	cmd = strings.TrimSpace(cmd)
+
+	timeout := t.Command
+	switch {
+	case cmd == "save" || strings.HasPrefix(cmd, "save "):
+		timeout = t.Save
+	case strings.HasPrefix(cmd, "http revision-up go"):
+		timeout = t.LongOperation
+	case strings.Contains(cmd, "show config"):
+		timeout = max(timeout, showConfigTimeout) // show config produces large output
+	case strings.Contains(cmd, "show status dhcp"):
+		timeout = max(timeout, showStatusDHCPTimeout)
+	case strings.Contains(cmd, "show environment"):
+		timeout = max(timeout, showEnvironmentTimeout)
+	}
+
+	if override := commandTimeoutFromContext(ctx); override > 0 {
+		timeout = override
+	}
+	return capToDeadline(ctx, timeout)
+}
+
+// longOperationTimeout returns how long to wait for a long-running operation
+func (t Timeouts) longOperationTimeout(ctx context.Context) time.Duration {
+	timeout := t.withDefaults().LongOperation
+	if override := commandTimeoutFromContext(ctx); override > 0 {
+		timeout = override
+	}
+	return capToDeadline(ctx, timeout)
+}
+
+// capToDeadline shortens timeout so that it does not outlive the context deadline
+func capToDeadline(ctx context.Context, timeout time.Duration) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); remaining < timeout {
+			return remaining
+		}
+	}
+	return timeout
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTimeouts_commandTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeouts Timeouts
+		ctx      context.Context
+		cmd      string
+		want     time.Duration
+	}{
+		{
+			name: "default command timeout",
+			ctx:  context.Background(),
+			cmd:  "ip route default gateway 192.168.0.1",
+			want: DefaultCommandTimeout,
+		},
+		{
+			name:     "configured command timeout",
+			timeouts: Timeouts{Command: 45 * time.Second},
+			ctx:      context.Background(),
+			cmd:      "show status lan1",
+			want:     45 * time.Second,
+		},
+		{
+			name: "show config waits at least two minutes",
+			ctx:  context.Background(),
+			cmd:  "show config",
+			want: showConfigTimeout,
+		},
+		{
+			name:     "show config with longer command timeout",
+			timeouts: Timeouts{Command: 5 * time.Minute},
+			ctx:      context.Background(),
+			cmd:      "show config",
+			want:     5 * time.Minute,
+		},
+		{
+			name: "show environment",
+			ctx:  context.Background(),
+			cmd:  "show environment",
+			want: showEnvironmentTimeout,
+		},
+		{
+			name:     "save uses save timeout",
+			timeouts: Timeouts{Save: 90 * time.Second},
+			ctx:      context.Background(),
+			cmd:      "save",
+			want:     90 * time.Second,
+		},
+		{
+			name: "save default",
+			ctx:  context.Background(),
+			cmd:  "save",
+			want: DefaultSaveTimeout,
+		},
+		{
+			name:     "firmware update uses long operation timeout",
+			timeouts: Timeouts{LongOperation: 20 * time.Minute},
+			ctx:      context.Background(),
+			cmd:      "http revision-up go no-confirm",
+			want:     20 * time.Minute,
+		},
+		{
+			name:     "context override replaces configured timeout",
+			timeouts: Timeouts{Command: 45 * time.Second},
+			ctx:      WithCommandTimeout(context.Background(), 3*time.Minute),
+			cmd:      "show config",
+			want:     3 * time.Minute,
+		},
+		{
+			name: "zero context override is ignored",
+			ctx:  WithCommandTimeout(context.Background(), 0),
+			cmd:  "show status lan1",
+			want: DefaultCommandTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.timeouts.commandTimeout(tt.ctx, tt.cmd)
+			if got != tt.want {
+				t.Errorf("commandTimeout(%q) = %v, want %v", tt.cmd, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeouts_commandTimeoutCappedByDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	got := Timeouts{}.commandTimeout(ctx, "show config")
+	if got <= 0 || got > 2*time.Second {
+		t.Errorf("commandTimeout() = %v, want at most the 2s until the deadline", got)
+	}
+}
+
+func TestTimeouts_longOperationTimeout(t *testing.T) {
+	if got := (Timeouts{}).longOperationTimeout(context.Background()); got != DefaultLongOperationTimeout {
+		t.Errorf("longOperationTimeout() = %v, want %v", got, DefaultLongOperationTimeout)
+	}
+
+	ctx := WithCommandTimeout(context.Background(), 30*time.Minute)
+	if got := (Timeouts{LongOperation: time.Minute}).longOperationTimeout(ctx); got != 30*time.Minute {
+		t.Errorf("longOperationTimeout() with override = %v, want %v", got, 30*time.Minute)
+	}
+}
+
+func TestTimeouts_withDefaults(t *testing.T) {
+	got := Timeouts{Login: 20 * time.Second}.withDefaults()
+	want := DefaultTimeouts()
+	want.Login = 20 * time.Second
+	if got != want {
+		t.Errorf("withDefaults() = %+v, want %+v", got, want)
+	}
+}
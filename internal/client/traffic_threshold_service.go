@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// TrafficThresholdService handles "account threshold" operations
+type TrafficThresholdService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewTrafficThresholdService creates a new traffic threshold service instance
+func NewTrafficThresholdService(executor Executor, client *rtxClient) *TrafficThresholdService {
+	return &TrafficThresholdService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the traffic threshold of an interface
+func (s *TrafficThresholdService) Get(ctx context.Context, iface string) (*TrafficThreshold, error) {
+	cmd := parsers.BuildShowTrafficThresholdCommand()
+	logging.FromContext(ctx).Debug().Str("service", "traffic_threshold").Msgf("Getting traffic threshold with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get traffic threshold: %w", err)
+	}
+
+	parsed, err := parsers.ParseSingleTrafficThreshold(string(output), iface)
+	if err != nil {
+		return nil, err
+	}
+
+	threshold := s.fromParserThreshold(*parsed)
+	return &threshold, nil
+}
+
+// List retrieves all traffic thresholds
+func (s *TrafficThresholdService) List(ctx context.Context) ([]TrafficThreshold, error) {
+	cmd := parsers.BuildShowTrafficThresholdCommand()
+	logging.FromContext(ctx).Debug().Str("service", "traffic_threshold").Msgf("Listing traffic thresholds with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list traffic thresholds: %w", err)
+	}
+
+	parsed, err := parsers.ParseTrafficThresholdConfig(string(output))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse traffic thresholds: %w", err)
+	}
+
+	thresholds := make([]TrafficThreshold, len(parsed))
+	for i, p := range parsed {
+		thresholds[i] = s.fromParserThreshold(p)
+	}
+	return thresholds, nil
+}
+
+// Create sets a new traffic threshold
+func (s *TrafficThresholdService) Create(ctx context.Context, threshold TrafficThreshold) error {
+	return s.apply(ctx, threshold, "created")
+}
+
+// Update changes an existing traffic threshold. The command overwrites the
+// previous setting of the interface, so no delete is needed.
+func (s *TrafficThresholdService) Update(ctx context.Context, threshold TrafficThreshold) error {
+	return s.apply(ctx, threshold, "updated")
+}
+
+// apply validates and writes the traffic threshold
+func (s *TrafficThresholdService) apply(ctx context.Context, threshold TrafficThreshold, action string) error {
+	parserThreshold := s.toParserThreshold(threshold)
+	if err := parsers.ValidateTrafficThreshold(parserThreshold); err != nil {
+		return fmt.Errorf("invalid traffic threshold: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	cmd := parsers.BuildTrafficThresholdCommand(parserThreshold)
+	logging.FromContext(ctx).Debug().Str("service", "traffic_threshold").Msgf("Applying traffic threshold with command: %s", cmd)
+
+	if err := runCommand(ctx, s.executor, cmd); err != nil {
+		return fmt.Errorf("failed to apply traffic threshold for %s: %w", threshold.Interface, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("traffic threshold for %s %s", threshold.Interface, action))
+}
+
+// Delete removes the traffic threshold of an interface
+func (s *TrafficThresholdService) Delete(ctx context.Context, iface string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	cmd := parsers.BuildDeleteTrafficThresholdCommand(iface)
+	logging.FromContext(ctx).Debug().Str("service", "traffic_threshold").Msgf("Deleting traffic threshold with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return fmt.Errorf("failed to delete traffic threshold for %s: %w", iface, err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete traffic threshold"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("traffic threshold for %s deleted", iface))
+}
+
+// toParserThreshold converts client.TrafficThreshold to parsers.TrafficThreshold
+func (s *TrafficThresholdService) toParserThreshold(t TrafficThreshold) parsers.TrafficThreshold {
+	return parsers.TrafficThreshold{
+		Interface: t.Interface,
+		Direction: strings.ToLower(t.Direction),
+		Megabytes: t.Megabytes,
+		Action:    strings.ToLower(t.Action),
+	}
+}
+
+// fromParserThreshold converts parsers.TrafficThreshold to client.TrafficThreshold
+func (s *TrafficThresholdService) fromParserThreshold(t parsers.TrafficThreshold) TrafficThreshold {
+	return TrafficThreshold{
+		Interface: t.Interface,
+		Direction: t.Direction,
+		Megabytes: t.Megabytes,
+		Action:    t.Action,
+	}
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTrafficThresholdService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "account threshold"`).Return([]byte(
+		"account threshold lan2 both 10240\naccount threshold pp1 in 500 action=disconnect\n",
+	), nil)
+
+	service := NewTrafficThresholdService(mockExecutor, nil)
+
+	threshold, err := service.Get(context.Background(), "pp1")
+	assert.NoError(t, err)
+	assert.Equal(t, &TrafficThreshold{Interface: "pp1", Direction: "in", Megabytes: 500, Action: "disconnect"}, threshold)
+
+	_, err = service.Get(context.Background(), "lan1")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestTrafficThresholdService_Create(t *testing.T) {
+	tests := []struct {
+		name        string
+		threshold   TrafficThreshold
+		mockSetup   func(*MockExecutor)
+		expectedErr bool
+	}{
+		{
+			name:      "valid threshold",
+			threshold: TrafficThreshold{Interface: "pp1", Direction: "both", Megabytes: 30720, Action: "disconnect"},
+			mockSetup: func(m *MockExecutor) {
+				m.On("Run", mock.Anything, "account threshold pp1 both 30720 action=disconnect").Return([]byte(""), nil)
+			},
+			expectedErr: false,
+		},
+		{
+			name:        "disconnect on lan interface",
+			threshold:   TrafficThreshold{Interface: "lan2", Direction: "both", Megabytes: 30720, Action: "disconnect"},
+			mockSetup:   func(m *MockExecutor) {},
+			expectedErr: true,
+		},
+		{
+			name:      "executor error",
+			threshold: TrafficThreshold{Interface: "lan2", Direction: "both", Megabytes: 100, Action: "syslog"},
+			mockSetup: func(m *MockExecutor) {
+				m.On("Run", mock.Anything, mock.Anything).Return(nil, errors.New("connection lost"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExecutor := new(MockExecutor)
+			tt.mockSetup(mockExecutor)
+
+			service := NewTrafficThresholdService(mockExecutor, nil)
+			err := service.Create(context.Background(), tt.threshold)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockExecutor.AssertExpectations(t)
+		})
+	}
+}
@@ -81,8 +81,7 @@ func ExecuteTunnelUpdate(ctx context.Context, client Client, config *TunnelUpdat
 
 	// Phase 3: Save configuration
 	logger.Debug().Msg("Saving configuration")
-	_, err = client.Run(ctx, Command{Key: "save", Payload: "save"})
-	if err != nil {
+	if err := client.SaveConfig(ctx); err != nil {
 		return fmt.Errorf("failed to save configuration after tunnel update: %w", err)
 	}
 
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
 )
@@ -23,6 +24,27 @@ func NewTunnelService(executor Executor, client *rtxClient) *TunnelService {
 
 // Get retrieves a specific unified tunnel configuration
 func (s *TunnelService) Get(ctx context.Context, tunnelID int) (*Tunnel, error) {
+	section, err := configSectionReader(s.executor, s.client).GetSection(ctx, parsers.ConfigSectionTunnel, tunnelID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(section) == "" {
+		return nil, fmt.Errorf("tunnel %d not found", tunnelID)
+	}
+
+	// L2TPv2 tunnels take their authentication and address pool from the
+	// anonymous PP interface, which is outside the tunnel section
+	parsed, err := parsers.NewTunnelParser().ParseTunnelConfig(section)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tunnel config: %w", err)
+	}
+	for _, p := range parsed {
+		if p.ID == tunnelID && p.Encapsulation != "l2tp" {
+			tunnel := convertFromParserTunnel(p)
+			return &tunnel, nil
+		}
+	}
+
 	tunnels, err := s.List(ctx)
 	if err != nil {
 		return nil, err
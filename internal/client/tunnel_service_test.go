@@ -31,7 +31,7 @@ func TestTunnelService_Get(t *testing.T) {
  ipsec ike keepalive use 1 on dpd 30 3
  tunnel enable 1
 `
-				m.On("Run", mock.Anything, "show config").Return([]byte(output), nil)
+				m.On("Run", mock.Anything, "show config tunnel 1").Return([]byte(output), nil)
 			},
 			expected: &Tunnel{
 				ID:            1,
@@ -50,15 +50,57 @@ func TestTunnelService_Get(t *testing.T) {
 			name:     "Tunnel not found",
 			tunnelID: 99,
 			mockSetup: func(m *MockExecutor) {
-				output := `tunnel select 1
- ipsec tunnel 1
-`
-				m.On("Run", mock.Anything, "show config").Return([]byte(output), nil)
+				m.On("Run", mock.Anything, "show config tunnel 99").Return([]byte(""), nil)
 			},
 			expected:    nil,
 			expectError: true,
 			errMessage:  "not found",
 		},
+		{
+			name:     "L2TPv2 tunnel reads the anonymous PP interface",
+			tunnelID: 1,
+			mockSetup: func(m *MockExecutor) {
+				section := `tunnel select 1
+ tunnel encapsulation l2tp
+ tunnel enable 1
+`
+				m.On("Run", mock.Anything, "show config tunnel 1").Return([]byte(section), nil)
+				m.On("Run", mock.Anything, "show config").Return([]byte(`pp select anonymous
+ pp bind tunnel1
+ pp auth request chap
+ ip pp remote address pool 192.168.100.10-192.168.100.20
+tunnel select 1
+ tunnel encapsulation l2tp
+ tunnel enable 1
+`), nil)
+			},
+			expected: &Tunnel{
+				ID:            1,
+				Encapsulation: "l2tp",
+				Enabled:       true,
+			},
+			expectError: false,
+		},
+		{
+			name:     "Section reads unsupported",
+			tunnelID: 2,
+			mockSetup: func(m *MockExecutor) {
+				m.On("Run", mock.Anything, "show config tunnel 2").Return([]byte("Error: Invalid parameter\r\n"), nil)
+				m.On("Run", mock.Anything, "show config").Return([]byte(`tunnel select 1
+ tunnel enable 1
+tunnel select 2
+ tunnel encapsulation l2tpv3
+ tunnel enable 2
+ip route default gateway pp 1
+`), nil)
+			},
+			expected: &Tunnel{
+				ID:            2,
+				Encapsulation: "l2tpv3",
+				Enabled:       true,
+			},
+			expectError: false,
+		},
 		{
 			name:     "Execution error",
 			tunnelID: 1,
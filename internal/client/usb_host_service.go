@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// USBHostService handles USB host ("usbhost") operations
+type USBHostService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewUSBHostService creates a new USB host service instance
+func NewUSBHostService(executor Executor, client *rtxClient) *USBHostService {
+	return &USBHostService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the USB host settings
+func (s *USBHostService) Get(ctx context.Context) (*USBHostConfig, error) {
+	cmd := parsers.BuildShowUSBHostConfigCommand()
+	logging.FromContext(ctx).Debug().Str("service", "usb_host").Msgf("Getting USB host settings with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get USB host settings: %w", err)
+	}
+
+	config := USBHostConfig(*parsers.ParseUSBHostConfig(string(output)))
+	return &config, nil
+}
+
+// Configure applies the USB host settings
+func (s *USBHostService) Configure(ctx context.Context, config USBHostConfig) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := parsers.BuildUSBHostCommands(parsers.USBHostConfig(config))
+	logging.FromContext(ctx).Debug().Str("service", "usb_host").Msgf("Configuring USB host with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to configure USB host: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "USB host configured")
+}
+
+// Update applies the USB host settings
+func (s *USBHostService) Update(ctx context.Context, config USBHostConfig) error {
+	return s.Configure(ctx, config)
+}
+
+// Reset restores the default USB host settings
+func (s *USBHostService) Reset(ctx context.Context) error {
+	commands := parsers.BuildDeleteUSBHostCommands()
+	logging.FromContext(ctx).Debug().Str("service", "usb_host").Msgf("Resetting USB host with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to reset USB host: %w", err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to reset USB host"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, "USB host reset")
+}
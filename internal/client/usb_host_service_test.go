@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUSBHostService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "usbhost "`).Return([]byte("usbhost storage use off\n"), nil)
+
+	service := NewUSBHostService(mockExecutor, nil)
+
+	config, err := service.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &USBHostConfig{Enabled: true, ModemEnabled: true, StorageEnabled: false}, config)
+}
+
+func TestUSBHostService_Configure(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"usbhost use on",
+		"usbhost modem use off",
+		"usbhost storage use on",
+	}).Return([]byte(""), nil)
+
+	service := NewUSBHostService(mockExecutor, nil)
+	err := service.Configure(context.Background(), USBHostConfig{Enabled: true, ModemEnabled: false, StorageEnabled: true})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestUSBHostService_Reset(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no usbhost storage use",
+		"no usbhost modem use",
+		"no usbhost use",
+	}).Return([]byte(""), nil)
+
+	service := NewUSBHostService(mockExecutor, nil)
+	assert.NoError(t, service.Reset(context.Background()))
+	mockExecutor.AssertExpectations(t)
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// VPNAddressPoolService handles "ip pp remote address pool" operations
+type VPNAddressPoolService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewVPNAddressPoolService creates a new VPN address pool service instance
+func NewVPNAddressPoolService(executor Executor, client *rtxClient) *VPNAddressPoolService {
+	return &VPNAddressPoolService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the address pool of a PP context
+func (s *VPNAddressPoolService) Get(ctx context.Context, pp string) (*VPNAddressPool, error) {
+	pools, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pool := range pools {
+		if pool.PP == pp {
+			return &pool, nil
+		}
+	}
+
+	return nil, fmt.Errorf("VPN address pool for pp %s not found", pp)
+}
+
+// List retrieves all address pools
+func (s *VPNAddressPoolService) List(ctx context.Context) ([]VPNAddressPool, error) {
+	cmd := parsers.BuildShowVPNAddressPoolsCommand()
+	logging.FromContext(ctx).Debug().Str("service", "vpn_address_pool").Msgf("Listing VPN address pools with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VPN address pools: %w", err)
+	}
+
+	parsed := parsers.ParseVPNAddressPools(string(output))
+	pools := make([]VPNAddressPool, len(parsed))
+	for i, p := range parsed {
+		pools[i] = s.fromParserPool(p)
+	}
+	return pools, nil
+}
+
+// Create sets a new address pool
+func (s *VPNAddressPoolService) Create(ctx context.Context, pool VPNAddressPool) error {
+	return s.apply(ctx, pool, "created")
+}
+
+// Update changes an existing address pool in place. The pool command
+// overwrites the previous range, so the VPN service is left untouched.
+func (s *VPNAddressPoolService) Update(ctx context.Context, pool VPNAddressPool) error {
+	return s.apply(ctx, pool, "updated")
+}
+
+// apply validates and writes the address pool
+func (s *VPNAddressPoolService) apply(ctx context.Context, pool VPNAddressPool, action string) error {
+	parserPool := s.toParserPool(pool)
+	if err := parsers.ValidateVPNAddressPool(parserPool); err != nil {
+		return fmt.Errorf("invalid VPN address pool: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := parsers.BuildVPNAddressPoolCommands(parserPool)
+	logging.FromContext(ctx).Debug().Str("service", "vpn_address_pool").Msgf("Applying VPN address pool with commands: %v", commands)
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to apply VPN address pool for pp %s: %w", pool.PP, err)
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("VPN address pool for pp %s %s", pool.PP, action))
+}
+
+// Delete removes the address pool of a PP context
+func (s *VPNAddressPoolService) Delete(ctx context.Context, pp string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	commands := parsers.BuildDeleteVPNAddressPoolCommands(pp)
+	logging.FromContext(ctx).Debug().Str("service", "vpn_address_pool").Msgf("Deleting VPN address pool with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to delete VPN address pool for pp %s: %w", pp, err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to delete VPN address pool"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, fmt.Sprintf("VPN address pool for pp %s deleted", pp))
+}
+
+// toParserPool converts client.VPNAddressPool to parsers.VPNAddressPool
+func (s *VPNAddressPoolService) toParserPool(p VPNAddressPool) parsers.VPNAddressPool {
+	return parsers.VPNAddressPool{
+		PP:           p.PP,
+		Start:        p.Start,
+		End:          p.End,
+		DNSToClients: p.DNSToClients,
+	}
+}
+
+// fromParserPool converts parsers.VPNAddressPool to client.VPNAddressPool
+func (s *VPNAddressPoolService) fromParserPool(p parsers.VPNAddressPool) VPNAddressPool {
+	return VPNAddressPool{
+		PP:           p.PP,
+		Start:        p.Start,
+		End:          p.End,
+		DNSToClients: p.DNSToClients,
+	}
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVPNAddressPoolService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, "show config").Return([]byte(`pp select anonymous
+ ppp ipcp msext on
+ ip pp remote address pool 192.168.100.10-192.168.100.50
+pp select none
+`), nil)
+
+	service := NewVPNAddressPoolService(mockExecutor, nil)
+
+	pool, err := service.Get(context.Background(), "anonymous")
+	assert.NoError(t, err)
+	assert.Equal(t, &VPNAddressPool{PP: "anonymous", Start: "192.168.100.10", End: "192.168.100.50", DNSToClients: true}, pool)
+
+	_, err = service.Get(context.Background(), "1")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestVPNAddressPoolService_Update(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"pp select anonymous",
+		"ip pp remote address pool 192.168.100.10-192.168.100.100",
+		"ppp ipcp msext off",
+		"pp select none",
+	}).Return([]byte(""), nil)
+
+	service := NewVPNAddressPoolService(mockExecutor, nil)
+
+	err := service.Update(context.Background(), VPNAddressPool{PP: "anonymous", Start: "192.168.100.10", End: "192.168.100.100"})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestVPNAddressPoolService_Delete(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"pp select anonymous",
+		"no ip pp remote address pool",
+		"no ppp ipcp msext",
+		"pp select none",
+	}).Return([]byte(""), nil)
+
+	service := NewVPNAddressPoolService(mockExecutor, nil)
+
+	err := service.Delete(context.Background(), "anonymous")
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
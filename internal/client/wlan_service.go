@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// WLANService handles wireless LAN ("wlan") operations on models with Wi-Fi
+type WLANService struct {
+	executor Executor
+	client   *rtxClient // Reference to the main client for save functionality
+}
+
+// NewWLANService creates a new wireless LAN service instance
+func NewWLANService(executor Executor, client *rtxClient) *WLANService {
+	return &WLANService{
+		executor: executor,
+		client:   client,
+	}
+}
+
+// Get retrieves the wireless LAN configuration
+func (s *WLANService) Get(ctx context.Context) (*WLANConfig, error) {
+	cmd := parsers.BuildShowWLANConfigCommand()
+	logging.FromContext(ctx).Debug().Str("service", "wlan").Msgf("Getting wireless LAN configuration with command: %s", cmd)
+
+	output, err := s.executor.Run(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wireless LAN configuration: %w", err)
+	}
+
+	parsed := parsers.ParseWLANConfig(string(output))
+	config := s.fromParserConfig(*parsed)
+	return &config, nil
+}
+
+// Configure applies the wireless LAN configuration
+func (s *WLANService) Configure(ctx context.Context, config WLANConfig) error {
+	return s.apply(ctx, config, nil)
+}
+
+// Update applies the wireless LAN configuration and removes SSIDs that are
+// no longer configured
+func (s *WLANService) Update(ctx context.Context, config WLANConfig) error {
+	current, err := s.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[int]bool, len(config.SSIDs))
+	for _, ssid := range config.SSIDs {
+		wanted[ssid.ID] = true
+	}
+
+	var removals []string
+	for _, ssid := range current.SSIDs {
+		if !wanted[ssid.ID] {
+			removals = append(removals, parsers.BuildDeleteWLANSSIDCommands(ssid.ID)...)
+		}
+	}
+
+	return s.apply(ctx, config, removals)
+}
+
+// apply validates the configuration, checks that the router has a wireless
+// LAN and writes the configuration after the given removal commands
+func (s *WLANService) apply(ctx context.Context, config WLANConfig, removals []string) error {
+	parserConfig := s.toParserConfig(config)
+	if err := parsers.ValidateWLANConfig(parserConfig); err != nil {
+		return fmt.Errorf("invalid wireless LAN configuration: %w", err)
+	}
+
+	if err := s.checkSupported(ctx); err != nil {
+		return err
+	}
+
+	commands := append(removals, parsers.BuildWLANCommands(parserConfig)...)
+	logging.FromContext(ctx).Debug().Str("service", "wlan").Msgf("Configuring wireless LAN with %d commands", len(commands))
+
+	if err := runBatchCommands(ctx, s.executor, commands); err != nil {
+		return fmt.Errorf("failed to configure wireless LAN: %w", err)
+	}
+
+	return saveConfig(ctx, s.client, "wireless LAN configured")
+}
+
+// Reset disables the wireless LAN and removes its SSIDs
+func (s *WLANService) Reset(ctx context.Context) error {
+	current, err := s.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	commands := parsers.BuildDeleteWLANCommands(s.toParserConfig(*current))
+	logging.FromContext(ctx).Debug().Str("service", "wlan").Msgf("Resetting wireless LAN with commands: %v", commands)
+
+	output, err := s.executor.RunBatch(ctx, commands)
+	if err != nil {
+		return fmt.Errorf("failed to reset wireless LAN: %w", err)
+	}
+	if err := checkOutputErrorIgnoringNotFound(output, "failed to reset wireless LAN"); err != nil {
+		return err
+	}
+
+	return saveConfig(ctx, s.client, "wireless LAN reset")
+}
+
+// checkSupported detects whether the router has a wireless LAN. Models
+// without Wi-Fi reject the wlan status command.
+func (s *WLANService) checkSupported(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	output, err := s.executor.Run(ctx, parsers.BuildShowWLANStatusCommand())
+	if err != nil {
+		return fmt.Errorf("failed to detect wireless LAN support: %w", err)
+	}
+	if containsError(string(output)) {
+		return fmt.Errorf("wireless LAN is not supported on this router")
+	}
+	return nil
+}
+
+// toParserConfig converts a client WLANConfig to the parser representation
+func (s *WLANService) toParserConfig(config WLANConfig) parsers.WLANConfig {
+	ssids := make([]parsers.WLANSSID, len(config.SSIDs))
+	for i, ssid := range config.SSIDs {
+		ssids[i] = parsers.WLANSSID(ssid)
+	}
+	return parsers.WLANConfig{
+		Enabled: config.Enabled,
+		Channel: config.Channel,
+		SSIDs:   ssids,
+	}
+}
+
+// fromParserConfig converts a parser WLANConfig to the client representation
+func (s *WLANService) fromParserConfig(config parsers.WLANConfig) WLANConfig {
+	ssids := make([]WLANSSID, len(config.SSIDs))
+	for i, ssid := range config.SSIDs {
+		ssids[i] = WLANSSID(ssid)
+	}
+	return WLANConfig{
+		Enabled: config.Enabled,
+		Channel: config.Channel,
+		SSIDs:   ssids,
+	}
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testWLANConfig = `wlan channel auto
+wlan ssid 1 "office"
+wlan security 1 wpa2-psk
+wlan ssid 2 "guest"
+wlan security 2 none
+wlan use on
+`
+
+func TestWLANService_Get(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "wlan "`).Return([]byte(testWLANConfig), nil)
+
+	service := NewWLANService(mockExecutor, nil)
+
+	config, err := service.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &WLANConfig{
+		Enabled: true,
+		SSIDs: []WLANSSID{
+			{ID: 1, Name: "office", Security: "wpa2-psk"},
+			{ID: 2, Name: "guest", Security: "none"},
+		},
+	}, config)
+}
+
+func TestWLANService_Configure(t *testing.T) {
+	config := WLANConfig{Enabled: true, Channel: 6, SSIDs: []WLANSSID{{ID: 1, Name: "office", Security: "wpa3-sae", PSK: "secretpass"}}}
+
+	t.Run("supported", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status wlan").Return([]byte("Wireless LAN: enabled\n"), nil)
+		mockExecutor.On("RunBatch", mock.Anything, []string{
+			"wlan channel 6",
+			`wlan ssid 1 "office"`,
+			"wlan security 1 wpa3-sae",
+			"wlan pre-shared-key 1 text secretpass",
+			"wlan use on",
+		}).Return([]byte(""), nil)
+
+		service := NewWLANService(mockExecutor, nil)
+		assert.NoError(t, service.Configure(context.Background(), config))
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("not supported", func(t *testing.T) {
+		mockExecutor := new(MockExecutor)
+		mockExecutor.On("Run", mock.Anything, "show status wlan").Return([]byte("Error: Invalid command name\n"), nil)
+
+		service := NewWLANService(mockExecutor, nil)
+		assert.ErrorContains(t, service.Configure(context.Background(), config), "not supported")
+	})
+}
+
+func TestWLANService_Update(t *testing.T) {
+	mockExecutor := new(MockExecutor)
+	mockExecutor.On("Run", mock.Anything, `show config | grep "wlan "`).Return([]byte(testWLANConfig), nil)
+	mockExecutor.On("Run", mock.Anything, "show status wlan").Return([]byte(""), nil)
+	mockExecutor.On("RunBatch", mock.Anything, []string{
+		"no wlan pre-shared-key 2",
+		"no wlan security 2",
+		"no wlan ssid 2",
+		"wlan channel auto",
+		`wlan ssid 1 "office"`,
+		"wlan security 1 wpa2-psk",
+		"wlan pre-shared-key 1 text secretpass",
+		"wlan use on",
+	}).Return([]byte(""), nil)
+
+	service := NewWLANService(mockExecutor, nil)
+
+	err := service.Update(context.Background(), WLANConfig{Enabled: true, SSIDs: []WLANSSID{{ID: 1, Name: "office", Security: "wpa2-psk", PSK: "secretpass"}}})
+	assert.NoError(t, err)
+	mockExecutor.AssertExpectations(t)
+}
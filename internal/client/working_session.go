@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"strings"
@@ -28,7 +29,20 @@ type workingSession struct {
 	stdout    io.Reader
 	mu        sync.Mutex
 	closed    bool
-	adminMode bool // Track if we're in administrator mode
+	adminMode bool     // Track if we're in administrator mode
+	timeouts  Timeouts // How long to wait for the router (defaults applied)
+
+	pacing      Pacing    // How fast commands are typed into the console
+	lastCommand time.Time // When the previous command was sent, for Pacing.CommandDelay
+
+	console *consoleTranscoder // Converts commands and output to and from the console encoding
+
+	// prompts overrides the built-in prompt detection (nil: built-in)
+	prompts *promptPatterns
+
+	// skipSaveOnExit declines the save prompt shown when leaving administrator
+	// mode with unsaved changes (manual save mode)
+	skipSaveOnExit bool
 
 	// Single reader goroutine pattern to avoid goroutine leaks
 	readCh   chan readResult // Channel for bytes read from stdout
@@ -37,7 +51,7 @@ type workingSession struct {
 }
 
 // newWorkingSession creates a new working session
-func newWorkingSession(client *ssh.Client) (*workingSession, error) {
+func newWorkingSession(client *ssh.Client, timeouts Timeouts, pacing Pacing, encoding ConsoleEncoding, prompts *promptPatterns) (*workingSession, error) {
 	logger := logging.Global()
 	logger.Debug().Msg("Creating new working session")
 
@@ -82,21 +96,26 @@ func newWorkingSession(client *ssh.Client) (*workingSession, error) {
 	}
 
 	s := &workingSession{
-		client:  client,
-		session: session,
-		stdin:   stdin,
-		stdout:  stdout,
-		readCh:  make(chan readResult, 256), // Buffer for read bytes
-		doneCh:  make(chan struct{}),
+		client:   client,
+		session:  session,
+		stdin:    newPacedWriter(stdin, pacing.CharacterDelay),
+		stdout:   stdout,
+		timeouts: timeouts.withDefaults(),
+		pacing:   pacing,
+		console:  newConsoleTranscoder(encoding),
+		prompts:  prompts,
+		readCh:   make(chan readResult, 256), // Buffer for read bytes
+		doneCh:   make(chan struct{}),
 	}
 
 	// Start dedicated reader goroutine
 	s.readerWg.Add(1)
 	go s.readerLoop()
 
-	// Wait for initial prompt
+	// Wait for initial prompt, past any login banner and the questions
+	// routers ask before it
 	logger.Debug().Msg("Waiting for initial prompt")
-	initialOutput, err := s.readUntilPrompt(10 * time.Second)
+	initialOutput, err := s.expectPrompt(s.timeouts.Login, loginInteractions, loginSettleDelay)
 	if err != nil {
 		s.Close()
 		return nil, fmt.Errorf("failed to get initial prompt: %w", err)
@@ -106,11 +125,12 @@ func newWorkingSession(client *ssh.Client) (*workingSession, error) {
 	// NOTE: We intentionally do NOT set "console character en.ascii" here.
 	// Setting it would cause state drift for rtx_system.console.character
 	// because Terraform would read the value we just set (en.ascii) instead
-	// of the user's actual configured value (e.g., ja.utf8).
+	// of the user's actual configured value (e.g., ja.utf8). Output in ja.sjis
+	// or euc-jp is transcoded to UTF-8 by s.console instead.
 
 	// Disable paging to get full output from commands like "show config"
 	logger.Debug().Msg("Disabling console paging")
-	if _, err := s.executeCommand("console lines infinity", 5*time.Second); err != nil {
+	if _, err := s.executeCommand("console lines infinity", s.timeouts.Login); err != nil {
 		logger.Warn().Err(err).Msg("Failed to disable paging (continuing anyway)")
 	}
 
@@ -159,6 +179,12 @@ func (s *workingSession) readerLoop() {
 
 // Send executes a command and returns the output
 func (s *workingSession) Send(cmd string) ([]byte, error) {
+	return s.SendContext(context.Background(), cmd)
+}
+
+// SendContext executes a command and returns the output, waiting for the prompt
+// as long as the command timeout for ctx allows
+func (s *workingSession) SendContext(ctx context.Context, cmd string) ([]byte, error) {
 	logger := logging.Global()
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -171,16 +197,7 @@ func (s *workingSession) Send(cmd string) ([]byte, error) {
 
 	// The executor expects the raw output including the prompt
 	// So we return the raw output without cleaning
-	// Use reasonable timeout for commands
-	timeout := 15 * time.Second
-	if strings.Contains(cmd, "show config") {
-		timeout = 120 * time.Second // show config produces large output
-	} else if strings.Contains(cmd, "show status dhcp") {
-		timeout = 30 * time.Second
-	} else if strings.Contains(cmd, "show environment") {
-		timeout = 20 * time.Second
-	}
-	output, err := s.executeCommandRaw(cmd, timeout)
+	output, err := s.executeCommandRaw(cmd, s.timeouts.commandTimeout(ctx, cmd))
 	if err != nil {
 		logger.Error().Err(err).Msg("workingSession.Send failed")
 		return nil, err
@@ -192,11 +209,16 @@ func (s *workingSession) Send(cmd string) ([]byte, error) {
 // executeCommand sends command and reads response (cleaned)
 func (s *workingSession) executeCommand(cmd string, timeout time.Duration) ([]byte, error) {
 	logger := logging.Global()
-	logger.Debug().Str("command", cmd).Msg("Executing command")
+	logger.Debug().Str("command", logging.RedactCommand(cmd)).Msg("Executing command")
 
 	// Send command with carriage return (like expect script)
-	if _, err := fmt.Fprintf(s.stdin, "%s\r", cmd); err != nil {
-		return nil, fmt.Errorf("failed to send command: %w", err)
+	wire, err := s.console.Encode(cmd)
+	if err != nil {
+		return nil, err
+	}
+	echo, err := s.sendCommandLine(wire)
+	if err != nil {
+		return nil, err
 	}
 
 	// Read response until prompt
@@ -204,6 +226,7 @@ func (s *workingSession) executeCommand(cmd string, timeout time.Duration) ([]by
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
+	output = s.console.Decode(append(echo, output...))
 
 	// Clean output - remove command echo and prompt
 	cleanOutput := s.cleanOutput(string(output), cmd)
@@ -215,11 +238,16 @@ func (s *workingSession) executeCommand(cmd string, timeout time.Duration) ([]by
 // executeCommandRaw sends command and returns raw response including prompt
 func (s *workingSession) executeCommandRaw(cmd string, timeout time.Duration) ([]byte, error) {
 	logger := logging.Global()
-	logger.Debug().Str("command", cmd).Msg("Executing command (raw)")
+	logger.Debug().Str("command", logging.RedactCommand(cmd)).Msg("Executing command (raw)")
 
 	// Send command with carriage return
-	if _, err := fmt.Fprintf(s.stdin, "%s\r", cmd); err != nil {
-		return nil, fmt.Errorf("failed to send command: %w", err)
+	wire, err := s.console.Encode(cmd)
+	if err != nil {
+		return nil, err
+	}
+	echo, err := s.sendCommandLine(wire)
+	if err != nil {
+		return nil, err
 	}
 
 	// Read response until prompt
@@ -227,74 +255,111 @@ func (s *workingSession) executeCommandRaw(cmd string, timeout time.Duration) ([
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
+	output = s.console.Decode(append(echo, output...))
 
 	logger.Debug().Int("bytes", len(output)).Msg("Command completed (raw)")
 	return output, nil
 }
 
-// readUntilPrompt reads until we see a prompt character
+// readUntilPrompt reads until we see a prompt character, answering the
+// questions in commandInteractions on the way
 // Uses the shared reader goroutine channel to avoid goroutine leaks
 func (s *workingSession) readUntilPrompt(timeout time.Duration) ([]byte, error) {
+	return s.expectPrompt(timeout, commandInteractions, 0)
+}
+
+// expectPrompt reads until a prompt, answering the questions in interactions.
+// With a non-zero settle, the prompt only counts once no more output arrives
+// for that long; output after it is read as well.
+func (s *workingSession) expectPrompt(timeout time.Duration, interactions []consoleInteraction, settle time.Duration) ([]byte, error) {
 	logger := logging.Global()
 	var buffer bytes.Buffer
+	answered := 0 // Output up to here has been answered already
 
 	// Read with timeout using shared channel
 	timeoutTimer := time.NewTimer(timeout)
 	defer timeoutTimer.Stop()
 
+	// Fire when the console stayed quiet after a prompt or a question
+	var settled <-chan time.Time
+	var asked <-chan time.Time
+	var question *consoleInteraction
+
 	for {
 		select {
 		case <-timeoutTimer.C:
 			logger.Debug().Str("buffer", buffer.String()).Msg("readUntilPrompt: Timeout waiting for prompt")
 			return buffer.Bytes(), fmt.Errorf("timeout waiting for prompt")
+		case <-settled:
+			return buffer.Bytes(), nil
+		case <-asked:
+			if err := s.answerInteraction(*question); err != nil {
+				return buffer.Bytes(), err
+			}
+			answered = buffer.Len()
+			asked, question = nil, nil
 		case result := <-s.readCh:
 			if result.err != nil {
 				return buffer.Bytes(), fmt.Errorf("read error: %w", result.err)
 			}
 
 			buffer.WriteByte(result.b)
+			settled, asked, question = nil, nil, nil
 
-			// Check if we have a prompt
+			// A question is only answered once the router waits for input,
+			// so that command output that happens to match is left alone
 			content := buffer.String()
+			if question = matchInteraction(content[answered:], interactions); question != nil {
+				asked = time.After(interactionQuietDelay)
+				continue
+			}
+
+			// Check if we have a prompt
 			lines := strings.Split(content, "\n")
-			if len(lines) > 0 {
-				lastLine := lines[len(lines)-1]
-				// Detect RTX prompt generically without depending on hostname
-				// Conditions:
-				// 1. Line is short (prompts are typically < 100 chars)
-				// 2. Line ends with "> " or "# " (with trailing space)
-				// 3. Line doesn't start with whitespace (config content is often indented)
-				if len(lastLine) > 0 && len(lastLine) < 100 {
-					trimmedLeft := strings.TrimLeft(lastLine, "\r")
-					// Skip if line starts with # (config comment line, not a prompt)
-					// RTX config comments start with "#", but prompts end with "# "
-					if strings.HasPrefix(trimmedLeft, "#") {
-						continue
-					}
-					// Skip if line starts with whitespace (indented config content)
-					if strings.HasPrefix(trimmedLeft, " ") || strings.HasPrefix(trimmedLeft, "\t") {
-						continue
-					}
-					// Check for user mode prompt ending with "> "
-					if strings.HasSuffix(lastLine, "> ") {
-						return buffer.Bytes(), nil
-					}
-					// Check for admin mode prompt ending with "# "
-					if strings.HasSuffix(lastLine, "# ") {
-						return buffer.Bytes(), nil
-					}
-					// Also check without trailing space (some terminals)
-					// Require minimum length to avoid matching single "#" or ">"
-					if len(trimmedLeft) >= 3 &&
-						(strings.HasSuffix(lastLine, ">") || strings.HasSuffix(lastLine, "#")) {
-						return buffer.Bytes(), nil
-					}
-				}
+			if !s.isPromptLine(lines[len(lines)-1]) {
+				continue
+			}
+			if settle <= 0 {
+				return buffer.Bytes(), nil
 			}
+			settled = time.After(settle)
 		}
 	}
 }
 
+// isPromptLine reports whether the last, unfinished line of output is a prompt
+func (s *workingSession) isPromptLine(lastLine string) bool {
+	if matched, ok := s.prompts.matchPrompt(lastLine); ok {
+		return matched
+	}
+	// Detect RTX prompt generically without depending on hostname
+	// Conditions:
+	// 1. Line is short (prompts are typically < 100 chars)
+	// 2. Line ends with "> " or "# " (with trailing space)
+	// 3. Line doesn't start with whitespace (config content is often indented)
+	if len(lastLine) == 0 || len(lastLine) >= 100 {
+		return false
+	}
+	trimmedLeft := strings.TrimLeft(lastLine, "\r")
+	// Skip if line starts with # (config comment line, not a prompt)
+	// RTX config comments start with "#", but prompts end with "# "
+	if strings.HasPrefix(trimmedLeft, "#") {
+		return false
+	}
+	// Skip if line starts with whitespace (indented config content)
+	if strings.HasPrefix(trimmedLeft, " ") || strings.HasPrefix(trimmedLeft, "\t") {
+		return false
+	}
+	// Check for user mode prompt ending with "> " or admin mode prompt ending with "# "
+	if strings.HasSuffix(lastLine, "> ") || strings.HasSuffix(lastLine, "# ") {
+		return true
+	}
+	// Also check without trailing space (some terminals)
+	// Require minimum length to avoid matching single "#" or ">"
+	return len(trimmedLeft) >= 3 &&
+		(strings.HasSuffix(lastLine, ">") || strings.HasSuffix(lastLine, "#"))
+}
+
 // readUntilString reads from stdout until the specified string appears
 // Uses the shared reader goroutine channel to avoid goroutine leaks
 func (s *workingSession) readUntilString(target string, timeout time.Duration) ([]byte, error) {
@@ -346,7 +411,9 @@ func (s *workingSession) cleanOutput(output, cmd string) string {
 	if len(lines) > 0 {
 		lastIdx := len(lines) - 1
 		lastLine := lines[lastIdx]
-		if strings.Contains(lastLine, ">") || strings.Contains(lastLine, "#") {
+		if matched, _ := s.prompts.matchPrompt(lastLine); matched {
+			lines = lines[:lastIdx]
+		} else if strings.Contains(lastLine, ">") || strings.Contains(lastLine, "#") {
 			// Remove the line if it's just a prompt
 			trimmed := strings.TrimSpace(lastLine)
 			if trimmed == ">" || trimmed == "#" || strings.HasPrefix(trimmed, "[") {
@@ -434,7 +501,7 @@ func (s *workingSession) exitAdminMode() error {
 	}
 
 	// Read response and check for configuration save prompt
-	response, err := s.readUntilPromptOrSaveConfirmation(5 * time.Second)
+	response, err := s.readUntilPromptOrSaveConfirmation(s.timeouts.Login)
 	if err != nil {
 		logger.Warn().Err(err).Msg("Error reading response after exit")
 		return err
@@ -443,7 +510,16 @@ func (s *workingSession) exitAdminMode() error {
 	responseStr := string(response)
 
 	// Check if we got a configuration save confirmation prompt
-	if s.isSaveConfigurationPrompt(responseStr) {
+	if s.isSaveConfigurationPrompt(responseStr) && s.skipSaveOnExit {
+		logger.Debug().Msg("Configuration save prompt detected, responding with 'N' (manual save mode)")
+		if _, err := fmt.Fprintf(s.stdin, "N\r"); err != nil {
+			return fmt.Errorf("failed to respond to save prompt: %w", err)
+		}
+		if _, err := s.readUntilPrompt(s.timeouts.Login); err != nil {
+			logger.Warn().Err(err).Msg("Error reading final response after declining save")
+			return err
+		}
+	} else if s.isSaveConfigurationPrompt(responseStr) {
 		logger.Debug().Msg("Configuration save prompt detected, responding with 'Y' to save changes")
 		// Respond with 'Y' to save configuration changes made in this session
 		// This is important because each command runs in a separate SSH session,
@@ -453,7 +529,7 @@ func (s *workingSession) exitAdminMode() error {
 		}
 
 		// Read final response after save confirmation
-		_, err := s.readUntilPrompt(3 * time.Second)
+		_, err := s.readUntilPrompt(s.timeouts.Save)
 		if err != nil {
 			logger.Warn().Err(err).Msg("Error reading final response after save")
 			return err
@@ -496,6 +572,12 @@ func (s *workingSession) readUntilPromptOrSaveConfirmation(timeout time.Duration
 			lines := strings.Split(content, "\n")
 			if len(lines) > 0 {
 				lastLine := lines[len(lines)-1]
+				if matched, ok := s.prompts.matchPrompt(lastLine); ok {
+					if matched {
+						return buffer.Bytes(), nil
+					}
+					continue
+				}
 				if len(lastLine) > 0 {
 					trimmed := strings.TrimSpace(lastLine)
 					// Check for user mode prompt: "[RTX1210] >"
@@ -518,6 +600,9 @@ func (s *workingSession) readUntilPromptOrSaveConfirmation(timeout time.Duration
 
 // isSaveConfigurationPrompt checks if the text contains a configuration save prompt
 func (s *workingSession) isSaveConfigurationPrompt(text string) bool {
+	if s.prompts.matchConfirmation(text) {
+		return true
+	}
 	lowerText := strings.ToLower(text)
 
 	// Common RTX router save configuration prompts
@@ -573,6 +658,12 @@ func (s *workingSession) readUntilPromptOrConfirmation(timeout time.Duration) ([
 			lines := strings.Split(content, "\n")
 			if len(lines) > 0 {
 				lastLine := lines[len(lines)-1]
+				if matched, ok := s.prompts.matchPrompt(lastLine); ok {
+					if matched {
+						return buffer.Bytes(), nil
+					}
+					continue
+				}
 				if len(lastLine) > 0 {
 					trimmed := strings.TrimSpace(lastLine)
 					// Check for user mode prompt: "[RTX1210] >"
@@ -624,6 +715,14 @@ func (s *workingSession) readUntilPasswordPromptOrAdminMode(timeout time.Duratio
 				return buffer.Bytes(), nil
 			}
 
+			// A configured administrator prompt replaces the checks below
+			if matched, ok := s.prompts.matchAdminPrompt(content[strings.LastIndex(content, "\n")+1:]); ok {
+				if matched {
+					return buffer.Bytes(), nil
+				}
+				continue
+			}
+
 			// Check for "already administrator" message (Japanese and English)
 			if strings.Contains(content, "すでに管理レベル") || strings.Contains(strings.ToLower(content), "already") {
 				// Wait for prompt to appear
@@ -654,6 +753,9 @@ func (s *workingSession) readUntilPasswordPromptOrAdminMode(timeout time.Duratio
 
 // isHostKeyUpdatePrompt checks if the text contains a host key update confirmation prompt
 func (s *workingSession) isHostKeyUpdatePrompt(text string) bool {
+	if s.prompts.matchConfirmation(text) {
+		return true
+	}
 	lowerText := strings.ToLower(text)
 
 	// RTX router host key update prompts
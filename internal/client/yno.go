@@ -0,0 +1,212 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+// YNOConfig routes commands through Yamaha Network Organizer (YNO) instead of
+// connecting to the router directly, for routers that are only reachable
+// through their YNO agent.
+type YNOConfig struct {
+	APIURL   string // Base URL of the YNO API
+	APIToken string // API access token
+	DeviceID string // Identifier of the router in YNO
+}
+
+// maxYNOResponseSize bounds the response read from one YNO request
+const maxYNOResponseSize = 16 << 20
+
+// ynoCommandRequest is the body of a command execution request
+type ynoCommandRequest struct {
+	Commands []string `json:"commands"`
+}
+
+// ynoCommandResponse is the result of a command execution request
+type ynoCommandResponse struct {
+	Output string `json:"output"`
+}
+
+// ynoExecutor runs commands on a router through the YNO API. YNO relays
+// them over the router's own management tunnel and runs them at
+// administrator level, so no login or escalation happens on this side.
+type ynoExecutor struct {
+	endpoint      string
+	token         string
+	deviceID      string
+	httpClient    *http.Client
+	retryStrategy RetryStrategy
+}
+
+// newYNOExecutor creates an executor for the router config.YNO refers to.
+// If retryStrategy is nil, failed requests are retried maxRetries times.
+func newYNOExecutor(config *Config, retryStrategy RetryStrategy) Executor {
+	if retryStrategy == nil {
+		retryStrategy = &ExponentialBackoff{
+			BaseDelay:  retryBaseDelay,
+			MaxDelay:   retryBaseDelay * maxRetries,
+			MaxRetries: maxRetries,
+		}
+	}
+	timeouts := config.Timeouts.withDefaults()
+	return &ynoExecutor{
+		endpoint:      strings.TrimSuffix(config.YNO.APIURL, "/") + "/devices/" + url.PathEscape(config.YNO.DeviceID) + "/commands",
+		token:         config.YNO.APIToken,
+		deviceID:      config.YNO.DeviceID,
+		httpClient:    &http.Client{Timeout: timeouts.Command + timeouts.Save},
+		retryStrategy: retryStrategy,
+	}
+}
+
+// Run executes a single command through YNO
+func (e *ynoExecutor) Run(ctx context.Context, cmd string) ([]byte, error) {
+	return e.RunBatch(ctx, []string{cmd})
+}
+
+// RunBatch executes the commands in order in one YNO request
+func (e *ynoExecutor) RunBatch(ctx context.Context, cmds []string) ([]byte, error) {
+	if len(cmds) == 0 {
+		return []byte{}, nil
+	}
+
+	var output []byte
+	err := retryWithStrategy(ctx, e.retryStrategy, allReadOnly(cmds), func(attempt int) error {
+		out, err := e.post(ctx, cmds)
+		if err != nil {
+			return err
+		}
+		if IsBusyOutput(out) {
+			return fmt.Errorf("%w: %s", ErrRouterBusy, strings.TrimSpace(string(out)))
+		}
+		output = out
+		return nil
+	})
+	return output, err
+}
+
+// SetAdministratorPassword is not available through YNO, whose command
+// execution cannot answer the interactive password prompts
+func (e *ynoExecutor) SetAdministratorPassword(ctx context.Context, oldPassword, newPassword string) error {
+	return errors.New("changing the administrator password is not supported through YNO")
+}
+
+// SetLoginPassword is not available through YNO, whose command execution
+// cannot answer the interactive password prompts
+func (e *ynoExecutor) SetLoginPassword(ctx context.Context, newPassword string) error {
+	return errors.New("changing the login password is not supported through YNO")
+}
+
+// GenerateSSHDHostKey is not available through YNO, whose command execution
+// cannot answer the confirmation prompt
+func (e *ynoExecutor) GenerateSSHDHostKey(ctx context.Context) error {
+	return errors.New("generating the SSHD host key is not supported through YNO")
+}
+
+// post sends one command execution request and returns the router output
+func (e *ynoExecutor) post(ctx context.Context, cmds []string) ([]byte, error) {
+	body, err := json.Marshal(ynoCommandRequest{Commands: cmds})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode YNO request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("invalid YNO API URL: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+e.token)
+
+	start := time.Now()
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		requestErr := fmt.Errorf("%w: YNO request failed: %v", ErrDial, err)
+		if !isDialError(err) {
+			// The request may have reached YNO before the failure
+			requestErr = &commandSentError{Err: requestErr}
+		}
+		return nil, &RetryableError{Err: requestErr}
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxYNOResponseSize))
+	if err != nil {
+		return nil, &RetryableError{Err: &commandSentError{Err: fmt.Errorf("failed to read YNO response: %w", err)}}
+	}
+	logging.FromContext(ctx).Debug().
+		Str("device_id", e.deviceID).
+		Int("status", resp.StatusCode).
+		Dur("duration", time.Since(start)).
+		Msg("YNO command request")
+
+	if err := ynoStatusError(resp, data); err != nil {
+		if resp.StatusCode == http.StatusGatewayTimeout {
+			// YNO may have given up while the router was running the commands
+			err = &commandSentError{Err: err}
+		}
+		return nil, err
+	}
+
+	var result ynoCommandResponse
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("%w: invalid YNO response: %v", ErrParse, err)
+	}
+	return []byte(strings.ReplaceAll(result.Output, "\r\n", "\n")), nil
+}
+
+// isDialError reports whether a request failed while connecting, before
+// anything was sent
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// allReadOnly reports whether none of the commands changes the configuration
+func allReadOnly(cmds []string) bool {
+	for _, cmd := range cmds {
+		if !isReadOnlyCommand(cmd) {
+			return false
+		}
+	}
+	return true
+}
+
+// ynoStatusError converts an unsuccessful YNO response to the matching client error
+func ynoStatusError(resp *http.Response, body []byte) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	detail := strings.TrimSpace(string(body))
+	if len(detail) > 200 {
+		detail = detail[:200] + "..."
+	}
+	if detail == "" {
+		detail = resp.Status
+	}
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("%w: YNO rejected the API token: %s", ErrAuthFailed, detail)
+	case http.StatusNotFound:
+		return fmt.Errorf("router is not registered in YNO: %s", detail)
+	case http.StatusConflict, http.StatusLocked, http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		// Another operation on the router is in progress, or the API is rate limited
+		return fmt.Errorf("%w: %s", ErrRouterBusy, detail)
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		// YNO could not reach the router through its agent
+		return fmt.Errorf("%w: router is not reachable through YNO: %s", ErrDial, detail)
+	default:
+		return fmt.Errorf("%w: YNO returned %s: %s", ErrCommandFailed, resp.Status, detail)
+	}
+}
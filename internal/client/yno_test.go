@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newYNOTestExecutor returns an executor whose YNO API is served by handler
+func newYNOTestExecutor(t *testing.T, handler http.HandlerFunc) Executor {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return newYNOExecutor(&Config{
+		YNO: &YNOConfig{APIURL: server.URL + "/api/", APIToken: "token-1", DeviceID: "S123/45"},
+	}, &ExponentialBackoff{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, MaxRetries: 2})
+}
+
+func TestYNOExecutor_RunBatch(t *testing.T) {
+	executor := newYNOTestExecutor(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/devices/S123%2F45/commands", r.URL.EscapedPath())
+		assert.Equal(t, "Bearer token-1", r.Header.Get("Authorization"))
+
+		var req ynoCommandRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, []string{"ip route default gateway pp 1", "save"}, req.Commands)
+		_ = json.NewEncoder(w).Encode(ynoCommandResponse{Output: "# ip route default gateway pp 1\r\n# save\r\n"})
+	})
+
+	output, err := executor.RunBatch(context.Background(), []string{"ip route default gateway pp 1", "save"})
+	require.NoError(t, err)
+	assert.Equal(t, "# ip route default gateway pp 1\n# save\n", string(output))
+}
+
+func TestYNOExecutor_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		wantErr      error
+		wantRequests int32
+	}{
+		{name: "token rejected", status: http.StatusUnauthorized, wantErr: ErrAuthFailed, wantRequests: 1},
+		{name: "router offline", status: http.StatusGatewayTimeout, wantErr: ErrDial, wantRequests: 3},
+		{name: "router busy", status: http.StatusConflict, wantErr: ErrRouterBusy, wantRequests: 3},
+		{name: "rejected request", status: http.StatusBadRequest, wantErr: ErrCommandFailed, wantRequests: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			executor := newYNOTestExecutor(t, func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				http.Error(w, "error detail", tt.status)
+			})
+
+			_, err := executor.Run(context.Background(), "show config")
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.wantErr), "unexpected error: %v", err)
+			assert.Contains(t, err.Error(), "error detail")
+			assert.Equal(t, tt.wantRequests, requests.Load())
+		})
+	}
+}
+
+func TestValidateConfig_YNO(t *testing.T) {
+	config := &Config{Host: "branch-1", Port: 22, Username: "admin"}
+
+	// No password is needed as YNO logs in to the router
+	config.YNO = &YNOConfig{APIURL: "https://yno.example.com/api", APIToken: "token", DeviceID: "S123"}
+	assert.NoError(t, validateConfig(config))
+
+	config.YNO = &YNOConfig{APIURL: "https://yno.example.com/api", DeviceID: "S123"}
+	assert.Error(t, validateConfig(config))
+
+	config.YNO = &YNOConfig{APIURL: "https://yno.example.com/api", APIToken: "token", DeviceID: "S123"}
+	config.SFTPEnabled = true
+	assert.Error(t, validateConfig(config))
+}
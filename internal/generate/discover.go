@@ -0,0 +1,271 @@
+package generate
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Target is a resource found in the router configuration, identified the way
+// "terraform import" identifies it
+type Target struct {
+	Type     string // Resource type (e.g., "rtx_nat_masquerade")
+	ImportID string // Import ID accepted by the resource's importer
+	Name     string // Suggested resource name in the generated configuration
+}
+
+// singletons are the resources that exist once per router, with their fixed
+// import IDs. Their Read reports whether the router configures them.
+var singletons = []struct {
+	resourceType string
+	importID     string
+}{
+	{"rtx_system", "system"},
+	{"rtx_system_settings", "system_settings"},
+	{"rtx_clock_timezone", "clock_timezone"},
+	{"rtx_admin", "admin"},
+	{"rtx_dns_server", "dns"},
+	{"rtx_syslog", "syslog"},
+	{"rtx_snmp_server", "snmp"},
+	{"rtx_httpd", "httpd"},
+	{"rtx_sshd", "sshd"},
+	{"rtx_sftpd", "sftpd"},
+	{"rtx_icmp_stealth", "icmp_stealth"},
+	{"rtx_proxy_arp", "proxy_arp"},
+	{"rtx_lldp", "lldp"},
+	{"rtx_bgp", "bgp"},
+	{"rtx_ospf", "ospf"},
+	{"rtx_pptp", "pptp"},
+	{"rtx_l2tp_service", "default"},
+	{"rtx_radius_auth", "radius_auth"},
+	{"rtx_flow_export", "flow_export"},
+	{"rtx_external_memory_backup", "external_memory_backup"},
+	{"rtx_firmware_update", "firmware_update"},
+	{"rtx_usb_host", "usb_host"},
+	{"rtx_wlan", "wlan"},
+}
+
+var (
+	// interfaceLinePattern matches global IPv4 settings of a LAN or bridge interface
+	interfaceLinePattern = regexp.MustCompile(`^ip (lan\d+|bridge\d+) `)
+	// ipv6InterfaceLinePattern matches global IPv6 settings of a LAN or bridge interface
+	ipv6InterfaceLinePattern = regexp.MustCompile(`^ipv6 (lan\d+|bridge\d+) `)
+)
+
+// Discover returns the resources configured on the router, in a stable order.
+//
+// Where several resources can manage the same commands, one is chosen so
+// that the generated configuration never manages a command twice: tunnels
+// become rtx_tunnel (or rtx_ikev2_tunnel for IKEv2), interface IPv6 settings
+// including router advertisements become rtx_ipv6_interface, and the IPv4
+// and IPv6 filters each become one access list. Resources with names that
+// only exist in Terraform, such as class maps, kron policies and MAC access
+// lists, and rtx_config_block and rtx_bulk_config are not generated.
+func Discover(pc *parsers.ParsedConfig) []Target {
+	d := &discovery{seen: make(map[string]bool)}
+
+	for _, s := range singletons {
+		d.add(s.resourceType, s.importID, "main")
+	}
+
+	d.discoverInterfaces(pc)
+	d.discoverContexts(pc)
+
+	for _, route := range pc.ExtractStaticRoutes() {
+		d.add("rtx_static_route", route.Prefix+"/"+route.Mask, routeName(route.Prefix, route.Mask))
+	}
+	for _, keepalive := range pc.ExtractIPKeepalives() {
+		d.add("rtx_ip_keepalive", fmt.Sprint(keepalive.ID), fmt.Sprintf("keepalive_%d", keepalive.ID))
+	}
+	for _, scope := range pc.ExtractDHCPScopes() {
+		d.add("rtx_dhcp_scope", fmt.Sprint(scope.ScopeID), fmt.Sprintf("scope_%d", scope.ScopeID))
+	}
+	for _, binding := range pc.ExtractDHCPBindings() {
+		identifier := binding.MACAddress
+		if identifier == "" {
+			identifier = binding.IPAddress
+		}
+		d.add("rtx_dhcp_binding", fmt.Sprintf("%d:%s", binding.ScopeID, identifier),
+			fmt.Sprintf("scope_%d_%s", binding.ScopeID, binding.IPAddress))
+	}
+	for _, nat := range pc.ExtractNATMasquerade() {
+		d.add("rtx_nat_masquerade", fmt.Sprint(nat.DescriptorID), fmt.Sprintf("descriptor_%d", nat.DescriptorID))
+	}
+	for _, nat := range extractNATStatics(pc) {
+		d.add("rtx_nat_static", fmt.Sprint(nat.DescriptorID), fmt.Sprintf("descriptor_%d", nat.DescriptorID))
+	}
+	if len(pc.ExtractIPFilters()) > 0 {
+		d.add("rtx_access_list_ip", "router", "router")
+	}
+	if len(pc.ExtractAccessListIPv6()) > 0 {
+		d.add("rtx_access_list_ipv6", "router", "router")
+	}
+	for _, prefix := range pc.ExtractIPv6Prefixes() {
+		d.add("rtx_ipv6_prefix", fmt.Sprint(prefix.ID), fmt.Sprintf("prefix_%d", prefix.ID))
+	}
+	for _, neighbor := range pc.ExtractIPv6NeighborStatics() {
+		d.add("rtx_ipv6_neighbor_static", neighbor.Interface+":"+neighbor.Address, neighbor.Interface+"_"+neighbor.Address)
+	}
+	if mld := pc.ExtractMLD(); mld != nil {
+		for _, iface := range mld.Interfaces {
+			if iface.Mode == parsers.MLDModeHost {
+				d.add("rtx_mld_proxy", iface.Interface, iface.Interface)
+			}
+		}
+	}
+	for _, group := range pc.ExtractLinkAggregations() {
+		d.add("rtx_link_aggregation", fmt.Sprint(group.ID), fmt.Sprintf("group_%d", group.ID))
+	}
+	for _, mirroring := range pc.ExtractPortMirrorings() {
+		d.add("rtx_port_mirroring", mirroring.Interface, mirroring.Interface)
+	}
+	for _, threshold := range pc.ExtractTrafficThresholds() {
+		d.add("rtx_traffic_threshold", threshold.Interface, threshold.Interface)
+	}
+	for _, certificate := range pc.ExtractCertificates() {
+		d.add("rtx_certificate", fmt.Sprint(certificate.ID), fmt.Sprintf("certificate_%d", certificate.ID))
+	}
+	for _, user := range pc.ExtractAdminUsers() {
+		d.add("rtx_admin_user", user.Username, user.Username)
+	}
+	for _, user := range pc.ExtractPPAuthUsers() {
+		d.add("rtx_ppp_auth_user", user.PP+":"+user.Username, user.PP+"_"+user.Username)
+	}
+	for _, pool := range pc.ExtractVPNAddressPools() {
+		d.add("rtx_vpn_address_pool", pool.PP, "pp_"+pool.PP)
+	}
+	for _, schedule := range extractSchedules(pc) {
+		d.add("rtx_kron_schedule", fmt.Sprint(schedule.ID), fmt.Sprintf("schedule_%d", schedule.ID))
+	}
+
+	return d.targets
+}
+
+// discovery collects targets, dropping duplicates
+type discovery struct {
+	targets []Target
+	seen    map[string]bool
+}
+
+func (d *discovery) add(resourceType, importID, name string) {
+	key := resourceType + "\x00" + importID
+	if d.seen[key] {
+		return
+	}
+	d.seen[key] = true
+	d.targets = append(d.targets, Target{Type: resourceType, ImportID: importID, Name: name})
+}
+
+// discoverInterfaces adds the interface resources: LAN and bridge interfaces
+// with IPv4 or IPv6 settings, bridges, loopbacks and VLANs
+func (d *discovery) discoverInterfaces(pc *parsers.ParsedConfig) {
+	var ipv4, ipv6 []string
+	seen := make(map[string]bool)
+	for _, cmd := range pc.GetGlobalCommands() {
+		if matches := interfaceLinePattern.FindStringSubmatch(cmd.Line); matches != nil && !seen["ip "+matches[1]] {
+			seen["ip "+matches[1]] = true
+			ipv4 = append(ipv4, matches[1])
+		}
+		if matches := ipv6InterfaceLinePattern.FindStringSubmatch(cmd.Line); matches != nil && !seen["ipv6 "+matches[1]] {
+			seen["ipv6 "+matches[1]] = true
+			ipv6 = append(ipv6, matches[1])
+		}
+	}
+	sort.Strings(ipv4)
+	sort.Strings(ipv6)
+
+	for _, bridge := range pc.ExtractBridges() {
+		d.add("rtx_bridge", bridge.Name, bridge.Name)
+	}
+	for _, iface := range ipv4 {
+		d.add("rtx_interface", iface, iface)
+	}
+	for _, iface := range ipv6 {
+		d.add("rtx_ipv6_interface", iface, iface)
+	}
+	for _, loopback := range pc.ExtractLoopbackInterfaces() {
+		d.add("rtx_loopback_interface", loopback.Name, loopback.Name)
+	}
+	for _, vlan := range extractVLANs(pc) {
+		d.add("rtx_vlan", fmt.Sprintf("%s/%d", vlan.Interface, vlan.VlanID), fmt.Sprintf("%s_vlan_%d", vlan.Interface, vlan.VlanID))
+	}
+}
+
+// discoverContexts adds the resources configured in "tunnel select" and
+// "pp select" sections
+func (d *discovery) discoverContexts(pc *parsers.ParsedConfig) {
+	ikev2 := make(map[int]bool)
+	for _, tunnel := range pc.ExtractIKEv2Tunnels() {
+		ikev2[tunnel.ID] = true
+	}
+	mobile := make(map[int]bool)
+	for _, wan := range pc.ExtractMobileWANs() {
+		mobile[wan.PPNumber] = true
+	}
+
+	for _, ctx := range pc.Contexts {
+		switch {
+		case ctx.Type == parsers.ContextTunnel && ikev2[ctx.ID]:
+			d.add("rtx_ikev2_tunnel", fmt.Sprint(ctx.ID), fmt.Sprintf("tunnel_%d", ctx.ID))
+		case ctx.Type == parsers.ContextTunnel:
+			d.add("rtx_tunnel", fmt.Sprint(ctx.ID), fmt.Sprintf("tunnel_%d", ctx.ID))
+		case ctx.Type == parsers.ContextPP && ctx.ID > 0 && mobile[ctx.ID]:
+			d.add("rtx_mobile_wan", fmt.Sprint(ctx.ID), fmt.Sprintf("pp_%d", ctx.ID))
+		case ctx.Type == parsers.ContextPP && ctx.ID > 0:
+			for _, cmd := range pc.GetCommandsInContext(ctx) {
+				if strings.HasPrefix(cmd.Line, "pppoe use ") {
+					d.add("rtx_pppoe", fmt.Sprint(ctx.ID), fmt.Sprintf("pp_%d", ctx.ID))
+					break
+				}
+			}
+			d.add("rtx_pp_interface", fmt.Sprint(ctx.ID), fmt.Sprintf("pp_%d", ctx.ID))
+		}
+	}
+}
+
+// extractNATStatics returns the static NAT descriptors of the configuration
+func extractNATStatics(pc *parsers.ParsedConfig) []parsers.NATStatic {
+	nats, _ := parsers.ParseNATStaticConfig(globalLines(pc, "nat descriptor "))
+	return nats
+}
+
+// extractSchedules returns the "schedule at" entries of the configuration
+func extractSchedules(pc *parsers.ParsedConfig) []parsers.Schedule {
+	schedules, _ := parsers.NewScheduleParser().ParseScheduleConfig(globalLines(pc, "schedule at "))
+	return schedules
+}
+
+// extractVLANs returns the 802.1Q VLAN interfaces of the configuration
+func extractVLANs(pc *parsers.ParsedConfig) []parsers.VLAN {
+	vlans, _ := parsers.NewVLANParser().ParseVLANConfig(globalLines(pc, ""))
+	return vlans
+}
+
+// globalLines returns the global commands starting with prefix, one per line
+func globalLines(pc *parsers.ParsedConfig, prefix string) string {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, prefix) {
+			lines = append(lines, cmd.Line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// routeName names a static route after its destination, such as
+// "default" or "route_10_0_0_0_8"
+func routeName(prefix, mask string) string {
+	if prefix == "0.0.0.0" && mask == "0.0.0.0" {
+		return "default"
+	}
+	if ip := net.ParseIP(mask).To4(); ip != nil {
+		if ones, bits := net.IPMask(ip).Size(); bits != 0 {
+			return fmt.Sprintf("route_%s_%d", prefix, ones)
+		}
+	}
+	return "route_" + prefix + "_" + mask
+}
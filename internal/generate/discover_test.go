@@ -0,0 +1,75 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+const discoverTestConfig = `# RTX1210 Rev.14.01.42
+login user operator secret
+ip route default gateway pp 1
+ip route 10.0.0.0/8 gateway 192.168.1.254
+ip lan1 address 192.168.1.1/24
+ip lan1 secure filter in 100
+ipv6 lan1 address ra-prefix@lan2::1/64
+vlan lan1/1 802.1q vid=10
+pp select 1
+ pp bind lan2
+ pppoe use lan2
+ ip pp nat descriptor 1
+pp select none
+tunnel select 1
+ ipsec tunnel 101
+ tunnel enable 1
+tunnel select none
+ip filter 100 pass * * tcp * www
+nat descriptor type 1 masquerade
+nat descriptor address outer 1 ipcp
+nat descriptor address inner 1 192.168.1.0-192.168.1.255
+dhcp scope 1 192.168.1.100-192.168.1.199/24
+schedule at 1 3:00 save
+`
+
+func TestDiscover(t *testing.T) {
+	parsed, err := parsers.NewConfigFileParser().Parse(discoverTestConfig)
+	require.NoError(t, err)
+
+	targets := Discover(parsed)
+	found := make(map[string]Target)
+	for _, target := range targets {
+		found[target.Type+" "+target.ImportID] = target
+	}
+
+	for _, want := range []Target{
+		{Type: "rtx_system", ImportID: "system", Name: "main"},
+		{Type: "rtx_admin_user", ImportID: "operator", Name: "operator"},
+		{Type: "rtx_static_route", ImportID: "0.0.0.0/0.0.0.0", Name: "default"},
+		{Type: "rtx_static_route", ImportID: "10.0.0.0/255.0.0.0", Name: "route_10.0.0.0_8"},
+		{Type: "rtx_interface", ImportID: "lan1", Name: "lan1"},
+		{Type: "rtx_ipv6_interface", ImportID: "lan1", Name: "lan1"},
+		{Type: "rtx_vlan", ImportID: "lan1/10", Name: "lan1_vlan_10"},
+		{Type: "rtx_pppoe", ImportID: "1", Name: "pp_1"},
+		{Type: "rtx_pp_interface", ImportID: "1", Name: "pp_1"},
+		{Type: "rtx_tunnel", ImportID: "1", Name: "tunnel_1"},
+		{Type: "rtx_access_list_ip", ImportID: "router", Name: "router"},
+		{Type: "rtx_nat_masquerade", ImportID: "1", Name: "descriptor_1"},
+		{Type: "rtx_dhcp_scope", ImportID: "1", Name: "scope_1"},
+		{Type: "rtx_kron_schedule", ImportID: "1", Name: "schedule_1"},
+	} {
+		assert.Equal(t, want, found[want.Type+" "+want.ImportID], "%s %s", want.Type, want.ImportID)
+	}
+
+	assert.NotContains(t, found, "rtx_access_list_ipv6 router")
+	assert.NotContains(t, found, "rtx_nat_static 1")
+	assert.NotContains(t, found, "rtx_mobile_wan 1")
+	assert.Len(t, found, len(targets), "targets are not duplicated")
+}
+
+func TestRouteName(t *testing.T) {
+	assert.Equal(t, "default", routeName("0.0.0.0", "0.0.0.0"))
+	assert.Equal(t, "route_192.168.10.0_24", routeName("192.168.10.0", "255.255.255.0"))
+}
@@ -0,0 +1,209 @@
+// Package generate writes Terraform configuration for a router that is not
+// managed by Terraform yet. It reads the router configuration, finds the
+// resources configured on it, reads each of them through the provider's own
+// import and read logic, and writes resource blocks together with import
+// blocks, so that "terraform plan" adopts the router without changes.
+package generate
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Main runs the generate subcommand with its command line arguments and
+// returns the exit code. The router connection is configured with the same
+// RTX_* environment variables as the provider.
+func Main(ctx context.Context, newProvider func() provider.Provider, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	out := flags.String("out", "", "write the configuration to this file instead of standard output")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: terraform-provider-rtx generate [-out FILE]")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Writes resource and import blocks for the configuration of the router")
+		fmt.Fprintln(stderr, "set in RTX_HOST, RTX_USERNAME, RTX_PASSWORD and the other RTX_* variables.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	config, err := Run(ctx, newProvider(), stderr)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	if *out == "" {
+		fmt.Fprint(stdout, config)
+		return 0
+	}
+	if err := os.WriteFile(*out, []byte(config), 0o644); err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// Run connects to the router through the provider and returns the generated
+// configuration. Resources that cannot be read are reported on warnings and
+// left out.
+func Run(ctx context.Context, p provider.Provider, warnings io.Writer) (string, error) {
+	providerData, err := configureProvider(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	defer providerData.Client.Close()
+
+	raw, err := providerData.Client.GetRunningConfig(ctx, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to read the router configuration: %w", err)
+	}
+	parsed, err := parsers.NewConfigFileParser().Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the router configuration: %w", err)
+	}
+
+	resources := make(map[string]resource.Resource)
+	for _, factory := range p.Resources(ctx) {
+		r := factory()
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "rtx"}, &metadata)
+		if configurable, ok := r.(resource.ResourceWithConfigure); ok {
+			var configured resource.ConfigureResponse
+			configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: providerData}, &configured)
+			if configured.Diagnostics.HasError() {
+				return "", diagnosticsError(configured.Diagnostics)
+			}
+		}
+		resources[metadata.TypeName] = r
+	}
+
+	out := &renderer{}
+	names := make(map[string]bool)
+	for _, target := range Discover(parsed) {
+		r, ok := resources[target.Type]
+		if !ok {
+			continue
+		}
+		s, state, err := importResource(ctx, r, target.ImportID)
+		if err != nil {
+			fmt.Fprintf(warnings, "Warning: skipping %s %q: %v\n", target.Type, target.ImportID, err)
+			continue
+		}
+		if state.IsNull() {
+			continue
+		}
+
+		name := uniqueName(names, target.Type, target.Name)
+		var block renderer
+		block.variables = out.variables
+		block.writeImport(target.Type, name, target.ImportID)
+		if err := block.writeResource(target.Type, name, s, state); err != nil {
+			fmt.Fprintf(warnings, "Warning: skipping %s %q: %v\n", target.Type, target.ImportID, err)
+			continue
+		}
+		out.b.WriteString(block.b.String())
+		out.variables = block.variables
+	}
+	out.writeVariables()
+	return out.String(), nil
+}
+
+// configureProvider configures the provider with every attribute unset, so
+// that the connection settings come from the environment
+func configureProvider(ctx context.Context, p provider.Provider) (*fwhelpers.ProviderData, error) {
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return nil, diagnosticsError(schemaResp.Diagnostics)
+	}
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	unset := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		unset[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	req := provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, unset)},
+	}
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		return nil, diagnosticsError(resp.Diagnostics)
+	}
+	providerData, ok := resp.ResourceData.(*fwhelpers.ProviderData)
+	if !ok || providerData.Client == nil {
+		return nil, errors.New("the provider did not connect to a router")
+	}
+	return providerData, nil
+}
+
+// importResource imports a resource like "terraform import" does: it runs
+// the importer with the import ID, then reads the resource. A null state
+// means the router does not configure the resource.
+func importResource(ctx context.Context, r resource.Resource, importID string) (schema.Schema, tftypes.Value, error) {
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return schema.Schema{}, tftypes.Value{}, diagnosticsError(schemaResp.Diagnostics)
+	}
+	s := schemaResp.Schema
+
+	importer, ok := r.(resource.ResourceWithImportState)
+	if !ok {
+		return s, tftypes.Value{}, errors.New("the resource does not support import")
+	}
+	empty := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	importResp := resource.ImportStateResponse{State: empty}
+	importer.ImportState(ctx, resource.ImportStateRequest{ID: importID}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		return s, tftypes.Value{}, diagnosticsError(importResp.Diagnostics)
+	}
+
+	readResp := resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		return s, tftypes.Value{}, diagnosticsError(readResp.Diagnostics)
+	}
+	return s, readResp.State.Raw, nil
+}
+
+// uniqueName returns a valid resource name based on hint that is not used
+// yet by another resource of the same type
+func uniqueName(used map[string]bool, resourceType, hint string) string {
+	base := sanitizeName(hint)
+	name := base
+	for i := 2; used[resourceType+"."+name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[resourceType+"."+name] = true
+	return name
+}
+
+// diagnosticsError returns the error diagnostics as one error
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return errors.Join(errs...)
+}
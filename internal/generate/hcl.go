@@ -0,0 +1,383 @@
+package generate
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// variable is an input variable standing in for a sensitive value, which is
+// never written into the generated configuration
+type variable struct {
+	name     string
+	typeExpr string
+}
+
+// renderer writes resources as HCL, formatted like "terraform fmt" does
+type renderer struct {
+	b         strings.Builder
+	variables []variable
+}
+
+// assignment is one "name = value" line or multi-line value of a body
+type assignment struct {
+	name  string
+	value string
+}
+
+// writeImport writes the import block adopting the resource
+func (r *renderer) writeImport(resourceType, name, importID string) {
+	fmt.Fprintf(&r.b, "import {\n  to = %s.%s\n  id = %s\n}\n\n", resourceType, name, quote(importID))
+}
+
+// writeResource writes the resource block for a state read from the router.
+// Only attributes that can be configured are written; sensitive values are
+// replaced by references to input variables.
+func (r *renderer) writeResource(resourceType, name string, s schema.Schema, state tftypes.Value) error {
+	values, err := objectValues(state)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(&r.b, "resource %s %s {\n", quote(resourceType), quote(name))
+	if err := r.writeBody(1, s.Attributes, s.Blocks, values, resourceType+"_"+name); err != nil {
+		return err
+	}
+	r.b.WriteString("}\n\n")
+	return nil
+}
+
+// writeVariables writes the declarations of the variables referenced so far
+func (r *renderer) writeVariables() {
+	for _, v := range r.variables {
+		fmt.Fprintf(&r.b, "variable %s {\n  type      = %s\n  sensitive = true\n}\n\n", quote(v.name), v.typeExpr)
+	}
+}
+
+// String returns the configuration written so far
+func (r *renderer) String() string {
+	return strings.TrimSuffix(r.b.String(), "\n")
+}
+
+// writeBody writes the attributes and nested blocks of a block body
+func (r *renderer) writeBody(indent int, attributes map[string]schema.Attribute, blocks map[string]schema.Block, values map[string]tftypes.Value, varPrefix string) error {
+	assignments, err := r.assignments(indent, attributes, values, varPrefix)
+	if err != nil {
+		return err
+	}
+	writeAssignments(&r.b, indent, assignments)
+
+	for _, name := range sortedKeys(blocks) {
+		value, ok := values[name]
+		if !ok || value.IsNull() || !value.IsKnown() {
+			continue
+		}
+		if err := r.writeBlock(indent, name, blocks[name], value, varPrefix+"_"+name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeBlock writes the nested blocks holding value
+func (r *renderer) writeBlock(indent int, name string, block schema.Block, value tftypes.Value, varPrefix string) error {
+	var attributes map[string]schema.Attribute
+	var blocks map[string]schema.Block
+	var elements []tftypes.Value
+	switch block := block.(type) {
+	case schema.ListNestedBlock:
+		attributes, blocks = block.NestedObject.Attributes, block.NestedObject.Blocks
+		if err := value.As(&elements); err != nil {
+			return err
+		}
+	case schema.SetNestedBlock:
+		attributes, blocks = block.NestedObject.Attributes, block.NestedObject.Blocks
+		if err := value.As(&elements); err != nil {
+			return err
+		}
+	case schema.SingleNestedBlock:
+		attributes, blocks = block.Attributes, block.Blocks
+		elements = []tftypes.Value{value}
+	default:
+		return fmt.Errorf("unsupported block type %T for %s", block, name)
+	}
+
+	pad := strings.Repeat("  ", indent)
+	for i, element := range elements {
+		values, err := objectValues(element)
+		if err != nil {
+			return err
+		}
+
+		body := &renderer{variables: r.variables}
+		prefix := varPrefix
+		if len(elements) > 1 {
+			prefix = fmt.Sprintf("%s_%d", varPrefix, i)
+		}
+		if err := body.writeBody(indent+1, attributes, blocks, values, prefix); err != nil {
+			return err
+		}
+		r.variables = body.variables
+
+		// A single block that configures nothing, such as unset timeouts, is left out
+		if _, single := block.(schema.SingleNestedBlock); single && body.b.Len() == 0 {
+			continue
+		}
+		fmt.Fprintf(&r.b, "\n%s%s {\n%s%s}\n", pad, name, body.b.String(), pad)
+	}
+	return nil
+}
+
+// assignments returns the configurable attributes of a body that have a value
+func (r *renderer) assignments(indent int, attributes map[string]schema.Attribute, values map[string]tftypes.Value, varPrefix string) ([]assignment, error) {
+	var result []assignment
+	for _, name := range sortedKeys(attributes) {
+		attribute := attributes[name]
+		if !attribute.IsRequired() && !attribute.IsOptional() {
+			continue
+		}
+		value, ok := values[name]
+		missing := !ok || value.IsNull() || !value.IsKnown()
+
+		if attribute.IsSensitive() {
+			if missing && !attribute.IsRequired() {
+				continue
+			}
+			variableName := sanitizeName(varPrefix + "_" + name)
+			r.variables = append(r.variables, variable{name: variableName, typeExpr: variableType(value)})
+			result = append(result, assignment{name: name, value: "var." + variableName})
+			continue
+		}
+		if missing {
+			continue
+		}
+
+		expr, err := r.expression(indent, value, nestedAttributes(attribute), varPrefix+"_"+name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		result = append(result, assignment{name: name, value: expr})
+	}
+	return result, nil
+}
+
+// expression returns the HCL expression for a value. nested holds the
+// attributes of nested attribute objects, nil for plain values.
+func (r *renderer) expression(indent int, value tftypes.Value, nested map[string]schema.Attribute, varPrefix string) (string, error) {
+	typ := value.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		if err := value.As(&s); err != nil {
+			return "", err
+		}
+		return quote(s), nil
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		if err := value.As(&n); err != nil {
+			return "", err
+		}
+		return n.Text('f', -1), nil
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := value.As(&b); err != nil {
+			return "", err
+		}
+		return fmt.Sprint(b), nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return "", err
+		}
+		return r.listExpression(indent, elements, nested, varPrefix)
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		values := make(map[string]tftypes.Value)
+		if err := value.As(&values); err != nil {
+			return "", err
+		}
+		return r.objectExpression(indent, values, nested, varPrefix)
+	}
+	return "", fmt.Errorf("unsupported value type %s", typ)
+}
+
+// listExpression writes primitive lists on one line and lists of objects
+// with one element per line
+func (r *renderer) listExpression(indent int, elements []tftypes.Value, nested map[string]schema.Attribute, varPrefix string) (string, error) {
+	if len(elements) == 0 {
+		return "[]", nil
+	}
+	exprs := make([]string, 0, len(elements))
+	multiline := false
+	for i, element := range elements {
+		expr, err := r.expression(indent+1, element, nested, fmt.Sprintf("%s_%d", varPrefix, i))
+		if err != nil {
+			return "", err
+		}
+		multiline = multiline || strings.Contains(expr, "\n")
+		exprs = append(exprs, expr)
+	}
+	if !multiline {
+		return "[" + strings.Join(exprs, ", ") + "]", nil
+	}
+	pad := strings.Repeat("  ", indent+1)
+	var b strings.Builder
+	b.WriteString("[\n")
+	for _, expr := range exprs {
+		b.WriteString(pad + expr + ",\n")
+	}
+	b.WriteString(strings.Repeat("  ", indent) + "]")
+	return b.String(), nil
+}
+
+// objectExpression writes a map or nested attribute object, keeping only the
+// configurable attributes of nested attribute objects
+func (r *renderer) objectExpression(indent int, values map[string]tftypes.Value, nested map[string]schema.Attribute, varPrefix string) (string, error) {
+	var assignments []assignment
+	if nested != nil {
+		var err error
+		if assignments, err = r.assignments(indent+1, nested, values, varPrefix); err != nil {
+			return "", err
+		}
+	} else {
+		for _, key := range sortedKeys(values) {
+			if values[key].IsNull() || !values[key].IsKnown() {
+				continue
+			}
+			expr, err := r.expression(indent+1, values[key], nil, varPrefix+"_"+key)
+			if err != nil {
+				return "", err
+			}
+			assignments = append(assignments, assignment{name: quote(key), value: expr})
+		}
+	}
+	if len(assignments) == 0 {
+		return "{}", nil
+	}
+	var b strings.Builder
+	b.WriteString("{\n")
+	writeAssignments(&b, indent+1, assignments)
+	b.WriteString(strings.Repeat("  ", indent) + "}")
+	return b.String(), nil
+}
+
+// writeAssignments writes assignments, aligning the equals signs of
+// consecutive single-line values like "terraform fmt"
+func writeAssignments(b *strings.Builder, indent int, assignments []assignment) {
+	pad := strings.Repeat("  ", indent)
+	for start := 0; start < len(assignments); {
+		end := start + 1
+		width := len(assignments[start].name)
+		if !strings.Contains(assignments[start].value, "\n") {
+			for end < len(assignments) && !strings.Contains(assignments[end].value, "\n") {
+				width = max(width, len(assignments[end].name))
+				end++
+			}
+		}
+		for _, a := range assignments[start:end] {
+			fmt.Fprintf(b, "%s%-*s = %s\n", pad, width, a.name, a.value)
+		}
+		start = end
+	}
+}
+
+// nestedAttributes returns the attributes of a nested attribute's objects
+func nestedAttributes(attribute schema.Attribute) map[string]schema.Attribute {
+	switch attribute := attribute.(type) {
+	case schema.ListNestedAttribute:
+		return attribute.NestedObject.Attributes
+	case schema.SetNestedAttribute:
+		return attribute.NestedObject.Attributes
+	case schema.MapNestedAttribute:
+		return attribute.NestedObject.Attributes
+	case schema.SingleNestedAttribute:
+		return attribute.Attributes
+	}
+	return nil
+}
+
+// objectValues returns the attribute values of an object
+func objectValues(value tftypes.Value) (map[string]tftypes.Value, error) {
+	values := make(map[string]tftypes.Value)
+	if value.IsNull() || !value.IsKnown() {
+		return values, nil
+	}
+	if err := value.As(&values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// variableType returns the type constraint of the variable replacing value
+func variableType(value tftypes.Value) string {
+	switch {
+	case value.Type() == nil:
+		return "string"
+	case value.Type().Is(tftypes.Number):
+		return "number"
+	case value.Type().Is(tftypes.Bool):
+		return "bool"
+	case value.Type().Is(tftypes.String):
+		return "string"
+	}
+	return "any"
+}
+
+// quote returns s as an HCL string literal. Template sequences are escaped
+// so that router values such as "${...}" are written literally.
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			b.WriteRune(r)
+			b.WriteRune(r)
+		case !unicode.IsPrint(r):
+			fmt.Fprintf(&b, `\u%04X`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// sanitizeName turns s into a valid Terraform identifier
+func sanitizeName(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if r == '_' || r == '-' || (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	name := b.String()
+	if name == "" || !(name[0] == '_' || (name[0] >= 'a' && name[0] <= 'z')) {
+		name = "r_" + name
+	}
+	return name
+}
+
+// sortedKeys returns the keys of m in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,128 @@
+package generate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRendererWriteResource(t *testing.T) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":            schema.StringAttribute{Computed: true},
+			"descriptor_id": schema.Int64Attribute{Required: true},
+			"outer_address": schema.StringAttribute{Required: true},
+			"description":   schema.StringAttribute{Optional: true},
+			"sip":           schema.BoolAttribute{Optional: true, Computed: true},
+			"ports":         schema.ListAttribute{Optional: true, ElementType: types.Int64Type},
+			"secret":        schema.StringAttribute{Required: true, Sensitive: true},
+		},
+		Blocks: map[string]schema.Block{
+			"entry": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"protocol": schema.StringAttribute{Optional: true},
+						"port":     schema.Int64Attribute{Optional: true},
+					},
+				},
+			},
+			"timeouts": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{Optional: true},
+				},
+			},
+		},
+	}
+
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+	entryType := objectType.AttributeTypes["entry"].(tftypes.List).ElementType
+	timeoutsType := objectType.AttributeTypes["timeouts"]
+	state := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":            tftypes.NewValue(tftypes.String, "1"),
+		"descriptor_id": tftypes.NewValue(tftypes.Number, 1),
+		"outer_address": tftypes.NewValue(tftypes.String, "ipcp"),
+		"description":   tftypes.NewValue(tftypes.String, nil),
+		"sip":           tftypes.NewValue(tftypes.Bool, true),
+		"ports":         tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, []tftypes.Value{tftypes.NewValue(tftypes.Number, 21), tftypes.NewValue(tftypes.Number, 8021)}),
+		"secret":        tftypes.NewValue(tftypes.String, nil),
+		"entry": tftypes.NewValue(objectType.AttributeTypes["entry"], []tftypes.Value{
+			tftypes.NewValue(entryType, map[string]tftypes.Value{
+				"protocol": tftypes.NewValue(tftypes.String, "tcp"),
+				"port":     tftypes.NewValue(tftypes.Number, 443),
+			}),
+		}),
+		"timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+			"create": tftypes.NewValue(tftypes.String, nil),
+		}),
+	})
+
+	r := &renderer{}
+	r.writeImport("rtx_nat_masquerade", "descriptor_1", "1")
+	require.NoError(t, r.writeResource("rtx_nat_masquerade", "descriptor_1", s, state))
+	r.writeVariables()
+
+	assert.Equal(t, `import {
+  to = rtx_nat_masquerade.descriptor_1
+  id = "1"
+}
+
+resource "rtx_nat_masquerade" "descriptor_1" {
+  descriptor_id = 1
+  outer_address = "ipcp"
+  ports         = [21, 8021]
+  secret        = var.rtx_nat_masquerade_descriptor_1_secret
+  sip           = true
+
+  entry {
+    port     = 443
+    protocol = "tcp"
+  }
+}
+
+variable "rtx_nat_masquerade_descriptor_1_secret" {
+  type      = string
+  sensitive = true
+}
+`, r.String())
+}
+
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "plain", want: `"plain"`},
+		{in: `say "hi"`, want: `"say \"hi\""`},
+		{in: `C:\path`, want: `"C:\\path"`},
+		{in: "a\nb", want: `"a\nb"`},
+		{in: "${var}", want: `"$${var}"`},
+		{in: "%{if}", want: `"%%{if}"`},
+		{in: "100%", want: `"100%"`},
+		{in: "ヤマハ", want: `"ヤマハ"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, quote(tt.in))
+		})
+	}
+}
+
+func TestSanitizeName(t *testing.T) {
+	assert.Equal(t, "lan1", sanitizeName("lan1"))
+	assert.Equal(t, "route_10_0_0_0_8", sanitizeName("route_10.0.0.0_8"))
+	assert.Equal(t, "r_1", sanitizeName("1"))
+	assert.Equal(t, "anonymous_alice", sanitizeName("anonymous_Alice"))
+}
+
+func TestUniqueName(t *testing.T) {
+	used := make(map[string]bool)
+	assert.Equal(t, "lan1", uniqueName(used, "rtx_interface", "lan1"))
+	assert.Equal(t, "lan1_2", uniqueName(used, "rtx_interface", "lan1"))
+	assert.Equal(t, "lan1", uniqueName(used, "rtx_ipv6_interface", "lan1"))
+}
@@ -1,6 +1,7 @@
 package logging
 
 import (
+	"regexp"
 	"strings"
 
 	"github.com/rs/zerolog"
@@ -31,6 +32,28 @@ var sensitiveFields = map[string]bool{
 // redactedMessage is the replacement text for sensitive data.
 const redactedMessage = "[REDACTED]"
 
+// secretArgumentPatterns match RTX commands that carry a secret. The first
+// group is the part of the command kept in logs; the argument that follows it
+// is the secret. SNMP community names are secrets even when removed.
+// TestRedactCommand_ParserCommands checks every parser command builder that
+// takes a secret against this list.
+var secretArgumentPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)^((?:administrator|login) password (?:encrypted )?)\S+`),
+	regexp.MustCompile(`(?i)^(login user \S+ (?:encrypted )?)\S+`),
+	regexp.MustCompile(`(?i)^(.*\bpre-shared-key \S+ (?:(?:text|hex) )?)\S+`),
+	regexp.MustCompile(`(?i)^(pp auth (?:username|myname) \S+ )\S+`),
+	regexp.MustCompile(`(?i)^(ipsec ike eap myname \S+ \S+ )\S+`),
+	regexp.MustCompile(`(?i)^(ddns server user \S+ \S+ )\S+`),
+	regexp.MustCompile(`(?i)^(l2tp tunnel auth on )\S+`),
+	regexp.MustCompile(`(?i)^(radius secret )\S+`),
+	regexp.MustCompile(`(?i)^((?:no )?snmp community (?:read-only|read-write) )\S+`),
+	regexp.MustCompile(`(?i)^(snmp trap community )\S+`),
+	regexp.MustCompile(`(?i)^(mobile pin code \S+ )\S+`),
+}
+
+// sensitiveWordPattern matches a sensitive word as a whole command token.
+var sensitiveWordPattern = regexp.MustCompile(`(?i)(?:^|\s)(?:password|pre-shared-key|secret|community|token|key|credential)(?:\s|$)`)
+
 // SanitizingHook implements zerolog.Hook to automatically redact sensitive data.
 type SanitizingHook struct{}
 
@@ -76,6 +99,29 @@ func SanitizeString(s string) string {
 	return s
 }
 
+// RedactCommand redacts the secret arguments of an RTX command while keeping
+// the rest of the command readable, e.g. "ipsec ike pre-shared-key 1 text
+// [REDACTED]". Commands that contain a sensitive word but no known secret
+// syntax are redacted entirely.
+func RedactCommand(cmd string) string {
+	if cmd == "" {
+		return cmd
+	}
+
+	redacted := false
+	for _, pattern := range secretArgumentPatterns {
+		if pattern.MatchString(cmd) {
+			cmd = pattern.ReplaceAllString(cmd, "${1}"+redactedMessage)
+			redacted = true
+		}
+	}
+	// Other removals name the setting but not its secret
+	if !redacted && !strings.HasPrefix(strings.ToLower(cmd), "no ") && sensitiveWordPattern.MatchString(cmd) {
+		return redactedMessage
+	}
+	return cmd
+}
+
 // IsSensitiveField checks if a field name indicates sensitive data.
 func IsSensitiveField(fieldName string) bool {
 	return sensitiveFields[strings.ToLower(fieldName)]
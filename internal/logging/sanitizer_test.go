@@ -2,9 +2,12 @@ package logging
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/rs/zerolog"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
 )
 
 func TestContainsSensitivePattern(t *testing.T) {
@@ -118,6 +121,89 @@ func TestSanitizeString(t *testing.T) {
 	}
 }
 
+func TestRedactCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "empty string", input: "", expected: ""},
+		{name: "safe command unchanged", input: "ip route 192.168.1.0/24 gateway 192.168.0.1", expected: "ip route 192.168.1.0/24 gateway 192.168.0.1"},
+		{name: "login password", input: "login password mypass", expected: "login password " + redactedMessage},
+		{name: "administrator password encrypted", input: "administrator password encrypted ABCDEF", expected: "administrator password encrypted " + redactedMessage},
+		{name: "login user", input: "login user admin mypass", expected: "login user admin " + redactedMessage},
+		{name: "ipsec pre-shared-key", input: "ipsec ike pre-shared-key 1 text secret123", expected: "ipsec ike pre-shared-key 1 text " + redactedMessage},
+		{name: "wlan pre-shared-key", input: "wlan pre-shared-key 2 text psk", expected: "wlan pre-shared-key 2 text " + redactedMessage},
+		{name: "bgp neighbor pre-shared-key", input: "bgp neighbor pre-shared-key 1 text md5pass", expected: "bgp neighbor pre-shared-key 1 text " + redactedMessage},
+		{name: "pre-shared-key removal unchanged", input: "no ipsec ike pre-shared-key 1", expected: "no ipsec ike pre-shared-key 1"},
+		{name: "pp auth username keeps options", input: "pp auth username user1 pass1 myname user1 pass1", expected: "pp auth username user1 " + redactedMessage + " myname user1 pass1"},
+		{name: "pp auth myname", input: "pp auth myname isp pass", expected: "pp auth myname isp " + redactedMessage},
+		{name: "eap myname", input: "ipsec ike eap myname 1 user pass", expected: "ipsec ike eap myname 1 user " + redactedMessage},
+		{name: "ddns server user", input: "ddns server user 1 user pass", expected: "ddns server user 1 user " + redactedMessage},
+		{name: "l2tp tunnel auth", input: "l2tp tunnel auth on tunnelpass", expected: "l2tp tunnel auth on " + redactedMessage},
+		{name: "radius secret", input: "radius secret shared", expected: "radius secret " + redactedMessage},
+		{name: "snmp community keeps acl", input: "snmp community read-only public 1", expected: "snmp community read-only " + redactedMessage + " 1"},
+		{name: "snmp community removal", input: "no snmp community read-write private", expected: "no snmp community read-write " + redactedMessage},
+		{name: "snmp trap community", input: "snmp trap community public", expected: "snmp trap community " + redactedMessage},
+		{name: "mobile pin code", input: "mobile pin code usb1 1234", expected: "mobile pin code usb1 " + redactedMessage},
+		{name: "mobile pin code removal unchanged", input: "no mobile pin code usb1", expected: "no mobile pin code usb1"},
+		{name: "unknown secret syntax redacted entirely", input: "foo secret bar", expected: redactedMessage},
+		{name: "sensitive word inside token unchanged", input: "ipsec ike keepalive use 1 on", expected: "ipsec ike keepalive use 1 on"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := RedactCommand(tt.input)
+			if result != tt.expected {
+				t.Errorf("RedactCommand(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestRedactCommand_ParserCommands builds every command that carries a secret
+// with the parsers and checks that the secret does not survive redaction, so
+// that a new secret-bearing command cannot be added without a pattern.
+func TestRedactCommand_ParserCommands(t *testing.T) {
+	const secret = "s3cr3t-value"
+
+	var commands []string
+	commands = append(commands,
+		parsers.BuildLoginPasswordCommand(secret),
+		parsers.BuildAdminPasswordCommand(secret),
+		parsers.BuildUserCommand(parsers.UserConfig{Username: "admin", Password: secret}),
+		parsers.BuildUserCommand(parsers.UserConfig{Username: "admin", Password: secret, Encrypted: true}),
+		parsers.BuildBGPNeighborPreSharedKeyCommand(1, secret),
+		parsers.BuildDDNSUserCommand(1, "user", secret),
+		parsers.BuildIPsecIKEPreSharedKeyCommand(1, secret),
+		parsers.BuildPPAuthMynameCommand("user", secret),
+		parsers.BuildPPPAuthMynameCommand("user", secret),
+		parsers.BuildPPTPAuthMynameCommand("user", secret),
+		parsers.BuildPPAuthUsernameCommand(parsers.PPAuthUser{Username: "user", Password: secret, IPAddress: "192.168.1.10"}),
+		parsers.BuildRADIUSSecretCommand(secret),
+		parsers.BuildSNMPCommunityCommand(parsers.SNMPCommunity{Name: secret, Permission: "ro", ACL: "1"}),
+		parsers.BuildSNMPTrapCommunityCommand(secret),
+		parsers.BuildL2TPTunnelAuthCommand(secret),
+	)
+	commands = append(commands, parsers.BuildIKEv2TunnelCommands(parsers.IKEv2Tunnel{
+		ID: 1, RemoteAddress: "203.0.113.1", LocalAuthMethod: "psk", RemoteAuthMethod: "psk",
+		PreSharedKey: secret, EAPUsername: "user", EAPPassword: secret,
+	})...)
+	commands = append(commands, parsers.BuildWLANCommands(parsers.WLANConfig{
+		SSIDs: []parsers.WLANSSID{{ID: 1, Name: "home", Security: "wpa2-psk", PSK: secret}},
+	})...)
+	commands = append(commands, parsers.BuildMobileWANCommands(parsers.MobileWAN{
+		PPNumber: 1, Interface: "usb1", APN: "example", CID: 1, PDPType: "ip",
+		AuthMethod: "chap", Username: "user", Password: secret, PIN: secret,
+	})...)
+
+	for _, cmd := range commands {
+		if got := RedactCommand(cmd); strings.Contains(got, secret) {
+			t.Errorf("RedactCommand(%q) = %q, secret not redacted", cmd, got)
+		}
+	}
+}
+
 func TestIsSensitiveField(t *testing.T) {
 	tests := []struct {
 		name      string
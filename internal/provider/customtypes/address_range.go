@@ -0,0 +1,119 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+var (
+	_ basetypes.StringTypable                    = AddressRangeType{}
+	_ basetypes.StringValuableWithSemanticEquals = AddressRange{}
+)
+
+// AddressRangeType is a string type for IPv4 address specifications that
+// may be written in CIDR notation or as an RTX range. The router shows
+// "192.168.1.0/24" as "192.168.1.0-192.168.1.255", so both forms are
+// semantically equal and reading one back does not produce a diff.
+type AddressRangeType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if o is also an AddressRangeType
+func (t AddressRangeType) Equal(o attr.Type) bool {
+	other, ok := o.(AddressRangeType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// String returns a human readable name of the type
+func (t AddressRangeType) String() string {
+	return "customtypes.AddressRangeType"
+}
+
+// ValueFromString converts a string value to an AddressRange
+func (t AddressRangeType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return AddressRange{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a Terraform value to an AddressRange
+func (t AddressRangeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	value, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to AddressRange: %v", diags)
+	}
+	return value, nil
+}
+
+// ValueType returns the value type of this type
+func (t AddressRangeType) ValueType(_ context.Context) attr.Value {
+	return AddressRange{}
+}
+
+// AddressRange is a value of AddressRangeType
+type AddressRange struct {
+	basetypes.StringValue
+}
+
+// AddressRangeValue returns a known AddressRange
+func AddressRangeValue(value string) AddressRange {
+	return AddressRange{StringValue: basetypes.NewStringValue(value)}
+}
+
+// AddressRangeNull returns a null AddressRange
+func AddressRangeNull() AddressRange {
+	return AddressRange{StringValue: basetypes.NewStringNull()}
+}
+
+// AddressRangeValueOrNull returns a null AddressRange for an empty string
+func AddressRangeValueOrNull(value string) AddressRange {
+	if value == "" {
+		return AddressRangeNull()
+	}
+	return AddressRangeValue(value)
+}
+
+// Type returns AddressRangeType
+func (v AddressRange) Type(_ context.Context) attr.Type {
+	return AddressRangeType{}
+}
+
+// Equal returns true if o is an AddressRange with the same string value
+func (v AddressRange) Equal(o attr.Value) bool {
+	other, ok := o.(AddressRange)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both values cover the same addresses,
+// such as "192.168.1.0/24" and "192.168.1.0-192.168.1.255"
+func (v AddressRange) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(AddressRange)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+	return parsers.AddressRangesEqual(v.ValueString(), newValue.ValueString()), diags
+}
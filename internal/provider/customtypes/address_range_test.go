@@ -0,0 +1,65 @@
+package customtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAddressRange_StringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    AddressRange
+		newValue AddressRange
+		want     bool
+	}{
+		{"CIDR and RTX range", AddressRangeValue("192.168.1.0/24"), AddressRangeValue("192.168.1.0-192.168.1.255"), true},
+		{"same string", AddressRangeValue("*"), AddressRangeValue("*"), true},
+		{"different networks", AddressRangeValue("192.168.1.0/24"), AddressRangeValue("192.168.2.0-192.168.2.255"), false},
+		{"different sizes", AddressRangeValue("192.168.1.0/25"), AddressRangeValue("192.168.1.0-192.168.1.255"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := tt.prior.StringSemanticEquals(context.Background(), tt.newValue)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("StringSemanticEquals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddressRange_StringSemanticEqualsWrongType(t *testing.T) {
+	_, diags := AddressRangeValue("10.0.0.0/8").StringSemanticEquals(context.Background(), types.StringValue("10.0.0.0/8"))
+	if !diags.HasError() {
+		t.Error("expected an error for a plain string value")
+	}
+}
+
+func TestAddressRangeType_ValueFromTerraform(t *testing.T) {
+	ctx := context.Background()
+	value, err := AddressRangeType{}.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, "10.0.0.0/8"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !value.Equal(AddressRangeValue("10.0.0.0/8")) {
+		t.Errorf("ValueFromTerraform() = %v, want AddressRange 10.0.0.0/8", value)
+	}
+	if !value.Type(ctx).Equal(AddressRangeType{}) {
+		t.Errorf("value type = %v, want AddressRangeType", value.Type(ctx))
+	}
+}
+
+func TestAddressRangeValueOrNull(t *testing.T) {
+	if !AddressRangeValueOrNull("").IsNull() {
+		t.Error("expected null for an empty string")
+	}
+	if got := AddressRangeValueOrNull("10.0.0.1").ValueString(); got != "10.0.0.1" {
+		t.Errorf("ValueString() = %q, want %q", got, "10.0.0.1")
+	}
+}
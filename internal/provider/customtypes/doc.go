@@ -0,0 +1,4 @@
+// Package customtypes contains custom Terraform Plugin Framework attribute
+// types, used where the router writes a value in a different but equivalent
+// form than the configuration.
+package customtypes
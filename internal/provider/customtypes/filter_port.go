@@ -0,0 +1,111 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+var (
+	_ basetypes.StringTypable                    = FilterPortType{}
+	_ basetypes.StringValuableWithSemanticEquals = FilterPort{}
+)
+
+// FilterPortType is a string type for IP filter port fields, which may list
+// ports and service keywords with spaces after the commas or keywords in
+// upper case. The router writes lists without spaces and keywords in lower
+// case, so all forms of the same field are semantically equal.
+type FilterPortType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if o is also a FilterPortType
+func (t FilterPortType) Equal(o attr.Type) bool {
+	other, ok := o.(FilterPortType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// String returns a human readable name of the type
+func (t FilterPortType) String() string {
+	return "customtypes.FilterPortType"
+}
+
+// ValueFromString converts a string value to a FilterPort
+func (t FilterPortType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return FilterPort{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a Terraform value to a FilterPort
+func (t FilterPortType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	value, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to FilterPort: %v", diags)
+	}
+	return value, nil
+}
+
+// ValueType returns the value type of this type
+func (t FilterPortType) ValueType(_ context.Context) attr.Value {
+	return FilterPort{}
+}
+
+// FilterPort is a value of FilterPortType
+type FilterPort struct {
+	basetypes.StringValue
+}
+
+// FilterPortValue returns a known FilterPort
+func FilterPortValue(value string) FilterPort {
+	return FilterPort{StringValue: basetypes.NewStringValue(value)}
+}
+
+// FilterPortNull returns a null FilterPort
+func FilterPortNull() FilterPort {
+	return FilterPort{StringValue: basetypes.NewStringNull()}
+}
+
+// Type returns FilterPortType
+func (v FilterPort) Type(_ context.Context) attr.Type {
+	return FilterPortType{}
+}
+
+// Equal returns true if o is a FilterPort with the same string value
+func (v FilterPort) Equal(o attr.Value) bool {
+	other, ok := o.(FilterPort)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both values are the same port field,
+// such as "www, smtp" and "www,smtp"
+func (v FilterPort) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(FilterPort)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+	return parsers.NormalizeIPFilterPort(v.ValueString()) == parsers.NormalizeIPFilterPort(newValue.ValueString()), diags
+}
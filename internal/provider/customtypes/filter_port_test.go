@@ -0,0 +1,33 @@
+package customtypes
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFilterPort_StringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    FilterPort
+		newValue FilterPort
+		want     bool
+	}{
+		{"spaces after commas", FilterPortValue("www, smtp"), FilterPortValue("www,smtp"), true},
+		{"upper case keyword", FilterPortValue("WWW,8080"), FilterPortValue("www,8080"), true},
+		{"range", FilterPortValue("1024-65535"), FilterPortValue("1024-65535"), true},
+		{"different ports", FilterPortValue("www"), FilterPortValue("smtp"), false},
+		{"different order", FilterPortValue("www,smtp"), FilterPortValue("smtp,www"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := tt.prior.StringSemanticEquals(context.Background(), tt.newValue)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("StringSemanticEquals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+var (
+	_ basetypes.StringTypable                    = MACAddressType{}
+	_ basetypes.StringValuableWithSemanticEquals = MACAddress{}
+)
+
+// MACAddressType is a string type for MAC addresses, which may be written
+// colon-separated, hyphen-separated, in Cisco dot notation or without
+// separators. The router shows every form colon-separated, so all forms of
+// the same address are semantically equal.
+type MACAddressType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if o is also a MACAddressType
+func (t MACAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(MACAddressType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// String returns a human readable name of the type
+func (t MACAddressType) String() string {
+	return "customtypes.MACAddressType"
+}
+
+// ValueFromString converts a string value to a MACAddress
+func (t MACAddressType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return MACAddress{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a Terraform value to a MACAddress
+func (t MACAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	value, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to MACAddress: %v", diags)
+	}
+	return value, nil
+}
+
+// ValueType returns the value type of this type
+func (t MACAddressType) ValueType(_ context.Context) attr.Value {
+	return MACAddress{}
+}
+
+// MACAddress is a value of MACAddressType
+type MACAddress struct {
+	basetypes.StringValue
+}
+
+// MACAddressValue returns a known MACAddress
+func MACAddressValue(value string) MACAddress {
+	return MACAddress{StringValue: basetypes.NewStringValue(value)}
+}
+
+// MACAddressNull returns a null MACAddress
+func MACAddressNull() MACAddress {
+	return MACAddress{StringValue: basetypes.NewStringNull()}
+}
+
+// MACAddressValueOrNull returns a null MACAddress for an empty string
+func MACAddressValueOrNull(value string) MACAddress {
+	if value == "" {
+		return MACAddressNull()
+	}
+	return MACAddressValue(value)
+}
+
+// Type returns MACAddressType
+func (v MACAddress) Type(_ context.Context) attr.Type {
+	return MACAddressType{}
+}
+
+// Equal returns true if o is a MACAddress with the same string value
+func (v MACAddress) Equal(o attr.Value) bool {
+	other, ok := o.(MACAddress)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both values are the same address,
+// such as "0011.2233.4455" and "00:11:22:33:44:55"
+func (v MACAddress) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(MACAddress)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+	return parsers.MACAddressesEqual(v.ValueString(), newValue.ValueString()), diags
+}
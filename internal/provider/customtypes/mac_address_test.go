@@ -0,0 +1,33 @@
+package customtypes
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMACAddress_StringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    MACAddress
+		newValue MACAddress
+		want     bool
+	}{
+		{"Cisco dot and colon", MACAddressValue("0011.2233.4455"), MACAddressValue("00:11:22:33:44:55"), true},
+		{"hyphen and colon", MACAddressValue("00-11-22-33-44-55"), MACAddressValue("00:11:22:33:44:55"), true},
+		{"bare upper case and colon", MACAddressValue("AABBCCDDEEFF"), MACAddressValue("aa:bb:cc:dd:ee:ff"), true},
+		{"different addresses", MACAddressValue("0011.2233.4455"), MACAddressValue("00:11:22:33:44:56"), false},
+		{"wildcard", MACAddressValue("*"), MACAddressValue("*"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := tt.prior.StringSemanticEquals(context.Background(), tt.newValue)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("StringSemanticEquals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package bgp_peers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BGPPeersDataSource{}
+
+// NewBGPPeersDataSource creates a new BGP peers data source.
+func NewBGPPeersDataSource() datasource.DataSource {
+	return &BGPPeersDataSource{}
+}
+
+// BGPPeersDataSource defines the data source implementation.
+type BGPPeersDataSource struct {
+	client client.Client
+}
+
+// BGPPeersModel describes the data source data model.
+type BGPPeersModel struct {
+	Address types.String `tfsdk:"address"`
+	Peers   []PeerModel  `tfsdk:"peers"`
+}
+
+// PeerModel describes the session state of a single BGP peer.
+type PeerModel struct {
+	Address          types.String `tfsdk:"address"`
+	RemoteAS         types.String `tfsdk:"remote_as"`
+	RouterID         types.String `tfsdk:"router_id"`
+	State            types.String `tfsdk:"state"`
+	Established      types.Bool   `tfsdk:"established"`
+	Uptime           types.String `tfsdk:"uptime"`
+	PrefixesReceived types.Int64  `tfsdk:"prefixes_received"`
+	PrefixesAccepted types.Int64  `tfsdk:"prefixes_accepted"`
+	PrefixesSent     types.Int64  `tfsdk:"prefixes_sent"`
+}
+
+// Metadata returns the data source type name.
+func (d *BGPPeersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bgp_peers"
+}
+
+// Schema defines the schema for the data source.
+func (d *BGPPeersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports the session state and prefix counts of BGP peers ('show status bgp neighbor') for post-change validation of routing.",
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Description: "Only return the peer with this address. If omitted, all peers are returned.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"peers": schema.ListNestedAttribute{
+				Description: "BGP peers.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Description: "Peer IP address.",
+							Computed:    true,
+						},
+						"remote_as": schema.StringAttribute{
+							Description: "Remote AS number.",
+							Computed:    true,
+						},
+						"router_id": schema.StringAttribute{
+							Description: "BGP identifier of the peer. Empty if not reported.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Session state (idle, connect, active, opensent, openconfirm, established).",
+							Computed:    true,
+						},
+						"established": schema.BoolAttribute{
+							Description: "Whether the session is in the established state.",
+							Computed:    true,
+						},
+						"uptime": schema.StringAttribute{
+							Description: "Time since the session was established, as reported by the router. Empty if the session is down.",
+							Computed:    true,
+						},
+						"prefixes_received": schema.Int64Attribute{
+							Description: "Number of prefixes received from the peer.",
+							Computed:    true,
+						},
+						"prefixes_accepted": schema.Int64Attribute{
+							Description: "Number of received prefixes accepted into the BGP table.",
+							Computed:    true,
+						},
+						"prefixes_sent": schema.Int64Attribute{
+							Description: "Number of prefixes advertised to the peer.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *BGPPeersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current BGP peer sessions.
+func (d *BGPPeersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BGPPeersModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	address := fwhelpers.GetStringValue(data.Address)
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_bgp_peers").Msgf("Reading BGP peers (address: %q)", address)
+
+	peers, err := d.client.ListBGPPeerStatuses(ctx, address)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read BGP peers",
+			fmt.Sprintf("Could not read the BGP peer status: %v", err),
+		)
+		return
+	}
+
+	data.Peers = make([]PeerModel, 0, len(peers))
+	for _, p := range peers {
+		data.Peers = append(data.Peers, PeerModel{
+			Address:          types.StringValue(p.Address),
+			RemoteAS:         types.StringValue(p.RemoteAS),
+			RouterID:         types.StringValue(p.RouterID),
+			State:            types.StringValue(p.State),
+			Established:      types.BoolValue(p.State == "established"),
+			Uptime:           types.StringValue(p.Uptime),
+			PrefixesReceived: types.Int64Value(int64(p.PrefixesReceived)),
+			PrefixesAccepted: types.Int64Value(int64(p.PrefixesAccepted)),
+			PrefixesSent:     types.Int64Value(int64(p.PrefixesSent)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CommandDataSource{}
+
+// NewCommandDataSource creates a new command data source.
+func NewCommandDataSource() datasource.DataSource {
+	return &CommandDataSource{}
+}
+
+// CommandDataSource defines the data source implementation.
+type CommandDataSource struct {
+	client client.Client
+}
+
+// CommandModel describes the data source data model.
+type CommandModel struct {
+	Command    types.String `tfsdk:"command"`
+	Output     types.String `tfsdk:"output"`
+	ExitStatus types.Int64  `tfsdk:"exit_status"`
+}
+
+// Metadata returns the data source type name.
+func (d *CommandDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_command"
+}
+
+// Schema defines the schema for the data source.
+func (d *CommandDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a read-only 'show' command on the router and returns its raw output. " +
+			"Intended for checks that are not yet covered by structured data sources. " +
+			"Pipes and command separators are rejected, and commands that may expose credentials ('show config', 'show file', 'show techinfo') must use rtx_config instead. " +
+			"The output is marked sensitive because status output can still name users, peers and addresses.",
+		Attributes: map[string]schema.Attribute{
+			"command": schema.StringAttribute{
+				Description: "The command to run (e.g., 'show status lan1'). Must start with 'show'.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"output": schema.StringAttribute{
+				Description: "Raw command output with LF line endings.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"exit_status": schema.Int64Attribute{
+				Description: "0 if the command succeeded, 1 if the router reported an error. The output contains the error message.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *CommandDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read runs the command and stores its output in the Terraform state.
+func (d *CommandDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CommandModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	command := data.Command.ValueString()
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_command").Msgf("Running command %q", command)
+
+	result, err := d.client.RunReadOnlyCommand(ctx, command)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to run command",
+			fmt.Sprintf("Could not run %q on the router: %v", command, err),
+		)
+		return
+	}
+
+	data.Output = types.StringValue(result.Output)
+	data.ExitStatus = types.Int64Value(int64(result.ExitStatus))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,150 @@
+package dns_cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DNSCacheDataSource{}
+
+// NewDNSCacheDataSource creates a new DNS cache data source.
+func NewDNSCacheDataSource() datasource.DataSource {
+	return &DNSCacheDataSource{}
+}
+
+// DNSCacheDataSource defines the data source implementation.
+type DNSCacheDataSource struct {
+	client client.Client
+}
+
+// DNSCacheModel describes the data source data model.
+type DNSCacheModel struct {
+	Name       types.String `tfsdk:"name"`
+	MaxEntries types.Int64  `tfsdk:"max_entries"`
+	EntryCount types.Int64  `tfsdk:"entry_count"`
+	Entries    []EntryModel `tfsdk:"entries"`
+}
+
+// EntryModel describes a cached DNS record.
+type EntryModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+	TTL  types.Int64  `tfsdk:"ttl"`
+	Data types.String `tfsdk:"data"`
+}
+
+// Metadata returns the data source type name.
+func (d *DNSCacheDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_cache"
+}
+
+// Schema defines the schema for the data source.
+func (d *DNSCacheDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the DNS cache statistics and cached records of the router's DNS proxy ('show dns cache'), " +
+			"so that split-DNS changes can be verified after apply.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return cached records for this name (case-insensitive, trailing dot ignored). If omitted, all records are returned.",
+				Optional:    true,
+			},
+			"max_entries": schema.Int64Attribute{
+				Description: "Configured maximum number of cache entries.",
+				Computed:    true,
+			},
+			"entry_count": schema.Int64Attribute{
+				Description: "Number of records currently cached, regardless of the name filter.",
+				Computed:    true,
+			},
+			"entries": schema.ListNestedAttribute{
+				Description: "Cached records.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Query name without the trailing dot.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Record type (A, AAAA, CNAME, etc.).",
+							Computed:    true,
+						},
+						"ttl": schema.Int64Attribute{
+							Description: "Remaining TTL in seconds.",
+							Computed:    true,
+						},
+						"data": schema.StringAttribute{
+							Description: "Record data, such as the address or canonical name.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DNSCacheDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current DNS cache.
+func (d *DNSCacheDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSCacheModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := fwhelpers.GetStringValue(data.Name)
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_dns_cache").Msgf("Reading DNS cache (name: %q)", name)
+
+	cache, err := d.client.GetDNSCache(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read DNS cache",
+			fmt.Sprintf("Could not read the DNS cache: %v", err),
+		)
+		return
+	}
+
+	data.MaxEntries = types.Int64Value(int64(cache.MaxEntries))
+	data.EntryCount = types.Int64Value(int64(cache.EntryCount))
+	data.Entries = make([]EntryModel, 0, len(cache.Entries))
+	for _, e := range cache.Entries {
+		data.Entries = append(data.Entries, EntryModel{
+			Name: types.StringValue(e.Name),
+			Type: types.StringValue(e.Type),
+			TTL:  types.Int64Value(int64(e.TTL)),
+			Data: types.StringValue(e.Data),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
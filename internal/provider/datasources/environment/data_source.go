@@ -0,0 +1,149 @@
+package environment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EnvironmentDataSource{}
+
+// NewEnvironmentDataSource creates a new environment data source.
+func NewEnvironmentDataSource() datasource.DataSource {
+	return &EnvironmentDataSource{}
+}
+
+// EnvironmentDataSource defines the data source implementation.
+type EnvironmentDataSource struct {
+	client client.Client
+}
+
+// EnvironmentModel describes the data source data model.
+type EnvironmentModel struct {
+	Model              types.String `tfsdk:"model"`
+	FirmwareVersion    types.String `tfsdk:"firmware_version"`
+	CPU5Sec            types.Int64  `tfsdk:"cpu_5sec"`
+	CPU1Min            types.Int64  `tfsdk:"cpu_1min"`
+	CPU5Min            types.Int64  `tfsdk:"cpu_5min"`
+	MemoryUsedPercent  types.Int64  `tfsdk:"memory_used_percent"`
+	TemperatureCelsius types.Int64  `tfsdk:"temperature_celsius"`
+	FanStatus          types.String `tfsdk:"fan_status"`
+	Uptime             types.String `tfsdk:"uptime"`
+}
+
+// Metadata returns the data source type name.
+func (d *EnvironmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment"
+}
+
+// Schema defines the schema for the data source.
+func (d *EnvironmentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the hardware state of the router ('show environment'): CPU load, memory usage, " +
+			"temperature and fan status, for capacity-aware automation.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "Router model (e.g., RTX1210).",
+				Computed:    true,
+			},
+			"firmware_version": schema.StringAttribute{
+				Description: "Firmware revision (e.g., 14.01.42).",
+				Computed:    true,
+			},
+			"cpu_5sec": schema.Int64Attribute{
+				Description: "CPU load over the last 5 seconds, in percent.",
+				Computed:    true,
+			},
+			"cpu_1min": schema.Int64Attribute{
+				Description: "CPU load over the last minute, in percent.",
+				Computed:    true,
+			},
+			"cpu_5min": schema.Int64Attribute{
+				Description: "CPU load over the last 5 minutes, in percent.",
+				Computed:    true,
+			},
+			"memory_used_percent": schema.Int64Attribute{
+				Description: "Memory usage, in percent.",
+				Computed:    true,
+			},
+			"temperature_celsius": schema.Int64Attribute{
+				Description: "Inside temperature in degrees Celsius. Null if the model has no temperature sensor.",
+				Computed:    true,
+			},
+			"fan_status": schema.StringAttribute{
+				Description: "Fan status as reported by the router. Null if the model has no fan.",
+				Computed:    true,
+			},
+			"uptime": schema.StringAttribute{
+				Description: "Elapsed time from boot as printed by the router.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *EnvironmentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current hardware state.
+func (d *EnvironmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EnvironmentModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_environment").Msg("Reading environment")
+
+	env, err := d.client.GetEnvironment(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read environment",
+			fmt.Sprintf("Could not read the router environment: %v", err),
+		)
+		return
+	}
+
+	data.Model = types.StringValue(env.Model)
+	data.FirmwareVersion = types.StringValue(env.FirmwareVersion)
+	data.CPU5Sec = types.Int64Value(int64(env.CPU5Sec))
+	data.CPU1Min = types.Int64Value(int64(env.CPU1Min))
+	data.CPU5Min = types.Int64Value(int64(env.CPU5Min))
+	data.MemoryUsedPercent = types.Int64Value(int64(env.MemoryUsedPercent))
+	data.TemperatureCelsius = types.Int64Null()
+	if env.TemperatureCelsius != nil {
+		data.TemperatureCelsius = types.Int64Value(int64(*env.TemperatureCelsius))
+	}
+	data.FanStatus = types.StringNull()
+	if env.FanStatus != "" {
+		data.FanStatus = types.StringValue(env.FanStatus)
+	}
+	data.Uptime = types.StringValue(env.Uptime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,143 @@
+package firmware_revisions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FirmwareRevisionsDataSource{}
+
+var listURLPattern = regexp.MustCompile(`^https?://\S+$`)
+
+// NewFirmwareRevisionsDataSource creates a new firmware revisions data source.
+func NewFirmwareRevisionsDataSource() datasource.DataSource {
+	return &FirmwareRevisionsDataSource{}
+}
+
+// FirmwareRevisionsDataSource defines the data source implementation.
+type FirmwareRevisionsDataSource struct {
+	client client.Client
+}
+
+// FirmwareRevisionsModel describes the data source data model.
+type FirmwareRevisionsModel struct {
+	RevisionListURL    types.String `tfsdk:"revision_list_url"`
+	Model              types.String `tfsdk:"model"`
+	InstalledRevision  types.String `tfsdk:"installed_revision"`
+	AvailableRevisions types.List   `tfsdk:"available_revisions"`
+	LatestRevision     types.String `tfsdk:"latest_revision"`
+	UpdateAvailable    types.Bool   `tfsdk:"update_available"`
+}
+
+// Metadata returns the data source type name.
+func (d *FirmwareRevisionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firmware_revisions"
+}
+
+// Schema defines the schema for the data source.
+func (d *FirmwareRevisionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Compares the installed firmware revision ('show environment') with the revisions published in a revision list, " +
+			"so that upgrade pipelines can decide whether an update is due. The revision list is downloaded by the provider, " +
+			"because the router's own 'http revision-up go' check is interactive and may install firmware.",
+		Attributes: map[string]schema.Attribute{
+			"revision_list_url": schema.StringAttribute{
+				Description: "HTTP(S) URL of a page listing firmware revisions as 'Rev.x.y.z' (e.g., the release notes of the model or an internal mirror). " +
+					"If omitted, only the installed revision is reported.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(listURLPattern, "must be an http or https URL"),
+				},
+			},
+			"model": schema.StringAttribute{
+				Description: "Router model (e.g., RTX1210).",
+				Computed:    true,
+			},
+			"installed_revision": schema.StringAttribute{
+				Description: "Running firmware revision (e.g., 14.01.38).",
+				Computed:    true,
+			},
+			"available_revisions": schema.ListAttribute{
+				Description: "Revisions of the installed series (same first two components) found in the revision list, in ascending order.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"latest_revision": schema.StringAttribute{
+				Description: "Newest revision of the installed series found in the revision list. Empty if none was found.",
+				Computed:    true,
+			},
+			"update_available": schema.BoolAttribute{
+				Description: "True if latest_revision is newer than installed_revision.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *FirmwareRevisionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current firmware revisions.
+func (d *FirmwareRevisionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirmwareRevisionsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listURL := fwhelpers.GetStringValue(data.RevisionListURL)
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_firmware_revisions").Msgf("Reading firmware revisions (list: %q)", listURL)
+
+	revisions, err := d.client.GetFirmwareRevisions(ctx, listURL)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read firmware revisions",
+			fmt.Sprintf("Could not read firmware revisions: %v", err),
+		)
+		return
+	}
+
+	available, diags := types.ListValueFrom(ctx, types.StringType, revisions.AvailableRevisions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Model = types.StringValue(revisions.Model)
+	data.InstalledRevision = types.StringValue(revisions.InstalledRevision)
+	data.AvailableRevisions = available
+	data.LatestRevision = types.StringValue(revisions.LatestRevision)
+	data.UpdateAvailable = types.BoolValue(revisions.UpdateAvailable)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
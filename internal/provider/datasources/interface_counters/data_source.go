@@ -0,0 +1,174 @@
+package interface_counters
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InterfaceCountersDataSource{}
+
+var namePattern = regexp.MustCompile(`^lan\d+$`)
+
+// NewInterfaceCountersDataSource creates a new interface counters data source.
+func NewInterfaceCountersDataSource() datasource.DataSource {
+	return &InterfaceCountersDataSource{}
+}
+
+// InterfaceCountersDataSource defines the data source implementation.
+type InterfaceCountersDataSource struct {
+	client client.Client
+}
+
+// InterfaceCountersModel describes the data source data model.
+type InterfaceCountersModel struct {
+	Names      types.List      `tfsdk:"names"`
+	Interfaces []CountersModel `tfsdk:"interfaces"`
+}
+
+// CountersModel describes the traffic counters of an interface.
+type CountersModel struct {
+	Name           types.String `tfsdk:"name"`
+	TxPackets      types.Int64  `tfsdk:"tx_packets"`
+	TxOctets       types.Int64  `tfsdk:"tx_octets"`
+	RxPackets      types.Int64  `tfsdk:"rx_packets"`
+	RxOctets       types.Int64  `tfsdk:"rx_octets"`
+	RxOverruns     types.Int64  `tfsdk:"rx_overruns"`
+	RxBufferErrors types.Int64  `tfsdk:"rx_buffer_errors"`
+}
+
+// Metadata returns the data source type name.
+func (d *InterfaceCountersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interface_counters"
+}
+
+// Schema defines the schema for the data source.
+func (d *InterfaceCountersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns per-interface packet, octet and error counters of LAN interfaces ('show status lanN'), " +
+			"so that tests and canary pipelines can assert that traffic is flowing after a filter or NAT change.",
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				Description: "LAN interfaces to read (e.g., ['lan1', 'lan2']). If omitted, lan1, lan2, ... are read until the router reports an unknown interface.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(namePattern, "must be a LAN interface (e.g., 'lan1')"),
+					),
+				},
+			},
+			"interfaces": schema.ListNestedAttribute{
+				Description: "Counters per interface. Counters are cumulative since boot or the last 'clear status'.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Interface name (e.g., 'lan1').",
+							Computed:    true,
+						},
+						"tx_packets": schema.Int64Attribute{
+							Description: "Transmitted packets.",
+							Computed:    true,
+						},
+						"tx_octets": schema.Int64Attribute{
+							Description: "Transmitted octets.",
+							Computed:    true,
+						},
+						"rx_packets": schema.Int64Attribute{
+							Description: "Received packets.",
+							Computed:    true,
+						},
+						"rx_octets": schema.Int64Attribute{
+							Description: "Received octets.",
+							Computed:    true,
+						},
+						"rx_overruns": schema.Int64Attribute{
+							Description: "Packets dropped by receive overrun.",
+							Computed:    true,
+						},
+						"rx_buffer_errors": schema.Int64Attribute{
+							Description: "Packets dropped for lack of receive buffers.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *InterfaceCountersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current interface counters.
+func (d *InterfaceCountersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InterfaceCountersModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var names []string
+	if !data.Names.IsNull() && !data.Names.IsUnknown() {
+		resp.Diagnostics.Append(data.Names.ElementsAs(ctx, &names, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_interface_counters").Msgf("Reading interface counters: %v", names)
+
+	counters, err := d.client.ListInterfaceCounters(ctx, names)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read interface counters",
+			fmt.Sprintf("Could not read interface counters: %v", err),
+		)
+		return
+	}
+
+	data.Interfaces = make([]CountersModel, len(counters))
+	for i, c := range counters {
+		data.Interfaces[i] = CountersModel{
+			Name:           types.StringValue(c.Name),
+			TxPackets:      types.Int64Value(c.TxPackets),
+			TxOctets:       types.Int64Value(c.TxOctets),
+			RxPackets:      types.Int64Value(c.RxPackets),
+			RxOctets:       types.Int64Value(c.RxOctets),
+			RxOverruns:     types.Int64Value(c.RxOverruns),
+			RxBufferErrors: types.Int64Value(c.RxBufferErrors),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,186 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InterfacesDataSource{}
+
+var namePattern = regexp.MustCompile(`^lan\d+$`)
+
+// NewInterfacesDataSource creates a new interfaces data source.
+func NewInterfacesDataSource() datasource.DataSource {
+	return &InterfacesDataSource{}
+}
+
+// InterfacesDataSource defines the data source implementation.
+type InterfacesDataSource struct {
+	client client.Client
+}
+
+// InterfacesModel describes the data source data model.
+type InterfacesModel struct {
+	Names      types.List       `tfsdk:"names"`
+	Interfaces []InterfaceModel `tfsdk:"interfaces"`
+}
+
+// InterfaceModel describes the status of an interface.
+type InterfaceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	MACAddress  types.String `tfsdk:"mac_address"`
+	LinkUp      types.Bool   `tfsdk:"link_up"`
+	Speed       types.String `tfsdk:"speed"`
+	Duplex      types.String `tfsdk:"duplex"`
+	IPAddresses types.List   `tfsdk:"ip_addresses"`
+	MTU         types.Int64  `tfsdk:"mtu"`
+}
+
+// Metadata returns the data source type name.
+func (d *InterfacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interfaces"
+}
+
+// Schema defines the schema for the data source.
+func (d *InterfacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists LAN interfaces with their link state, negotiated speed and IPv4 addresses ('show status lanN'). " +
+			"Useful for checking that an interface exists and is up before binding filters or addresses to it.",
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				Description: "LAN interfaces to read (e.g., ['lan1', 'lan2']). If omitted, lan1, lan2, ... are read until the router reports an unknown interface.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(namePattern, "must be a LAN interface (e.g., 'lan1')"),
+					),
+				},
+			},
+			"interfaces": schema.ListNestedAttribute{
+				Description: "Interface status entries.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Interface name (e.g., 'lan1').",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Interface description.",
+							Computed:    true,
+						},
+						"mac_address": schema.StringAttribute{
+							Description: "Ethernet address of the interface.",
+							Computed:    true,
+						},
+						"link_up": schema.BoolAttribute{
+							Description: "Whether the interface has link. For switching hub interfaces, true if any port has link.",
+							Computed:    true,
+						},
+						"speed": schema.StringAttribute{
+							Description: "Negotiated speed (e.g., '1000BASE-T'). Empty when the link is down.",
+							Computed:    true,
+						},
+						"duplex": schema.StringAttribute{
+							Description: "Negotiated duplex mode ('full' or 'half'). Empty when the link is down.",
+							Computed:    true,
+						},
+						"ip_addresses": schema.ListAttribute{
+							Description: "IPv4 addresses assigned to the interface in CIDR notation.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"mtu": schema.Int64Attribute{
+							Description: "Maximum transmission unit in octets.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *InterfacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current interface status.
+func (d *InterfacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InterfacesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var names []string
+	if !data.Names.IsNull() && !data.Names.IsUnknown() {
+		resp.Diagnostics.Append(data.Names.ElementsAs(ctx, &names, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_interfaces").Msgf("Reading interface status: %v", names)
+
+	statuses, err := d.client.ListInterfaceStatuses(ctx, names)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read interface status",
+			fmt.Sprintf("Could not read interface status: %v", err),
+		)
+		return
+	}
+
+	data.Interfaces = make([]InterfaceModel, len(statuses))
+	for i, status := range statuses {
+		addresses, diags := types.ListValueFrom(ctx, types.StringType, status.IPAddresses)
+		resp.Diagnostics.Append(diags...)
+		data.Interfaces[i] = InterfaceModel{
+			Name:        types.StringValue(status.Name),
+			Description: types.StringValue(status.Description),
+			MACAddress:  types.StringValue(status.MACAddress),
+			LinkUp:      types.BoolValue(status.LinkUp),
+			Speed:       types.StringValue(status.Speed),
+			Duplex:      types.StringValue(status.Duplex),
+			IPAddresses: addresses,
+			MTU:         types.Int64Value(int64(status.MTU)),
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,186 @@
+package ipsec_sa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IPsecSADataSource{}
+
+// NewIPsecSADataSource creates a new IPsec SA data source.
+func NewIPsecSADataSource() datasource.DataSource {
+	return &IPsecSADataSource{}
+}
+
+// IPsecSADataSource defines the data source implementation.
+type IPsecSADataSource struct {
+	client client.Client
+}
+
+// IPsecSADataModel describes the data source data model.
+type IPsecSADataModel struct {
+	TunnelID    types.Int64 `tfsdk:"tunnel_id"`
+	Established types.Bool  `tfsdk:"established"`
+	SAs         []SAModel   `tfsdk:"sas"`
+}
+
+// SAModel describes an established security association.
+type SAModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	TunnelID   types.Int64  `tfsdk:"tunnel_id"`
+	Type       types.String `tfsdk:"type"`
+	Direction  types.String `tfsdk:"direction"`
+	SPI        types.String `tfsdk:"spi"`
+	Lifetime   types.Int64  `tfsdk:"lifetime"`
+	Peer       types.String `tfsdk:"peer"`
+	LocalHost  types.String `tfsdk:"local_host"`
+	RemoteHost types.String `tfsdk:"remote_host"`
+}
+
+// Metadata returns the data source type name.
+func (d *IPsecSADataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipsec_sa"
+}
+
+// Schema defines the schema for the data source.
+func (d *IPsecSADataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the established ISAKMP and IPsec security associations ('show ipsec sa'), " +
+			"so that health checks can assert that tunnels are actually up after apply.",
+		Attributes: map[string]schema.Attribute{
+			"tunnel_id": schema.Int64Attribute{
+				Description: "Only return SAs of this IPsec tunnel (security gateway) number. If omitted, SAs of all tunnels are returned.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"established": schema.BoolAttribute{
+				Description: "True if the returned SAs include both a send and a receive IPsec (ESP or AH) SA.",
+				Computed:    true,
+			},
+			"sas": schema.ListNestedAttribute{
+				Description: "Established security associations.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "SA number.",
+							Computed:    true,
+						},
+						"tunnel_id": schema.Int64Attribute{
+							Description: "IPsec tunnel (security gateway) number.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "SA type: 'isakmp', 'esp', or 'ah'.",
+							Computed:    true,
+						},
+						"direction": schema.StringAttribute{
+							Description: "Direction of an IPsec SA ('send' or 'recv'). Empty for ISAKMP SAs.",
+							Computed:    true,
+						},
+						"spi": schema.StringAttribute{
+							Description: "Security parameter index as a hex string. Empty for ISAKMP SAs or if the router does not report it.",
+							Computed:    true,
+						},
+						"lifetime": schema.Int64Attribute{
+							Description: "Remaining lifetime in seconds.",
+							Computed:    true,
+						},
+						"peer": schema.StringAttribute{
+							Description: "Identifier of the remote peer.",
+							Computed:    true,
+						},
+						"local_host": schema.StringAttribute{
+							Description: "Local endpoint address.",
+							Computed:    true,
+						},
+						"remote_host": schema.StringAttribute{
+							Description: "Remote endpoint address.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *IPsecSADataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the security associations currently established.
+func (d *IPsecSADataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IPsecSADataModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_ipsec_sa").Msg("Listing IPsec SAs")
+
+	sas, err := d.client.ListIPsecSAs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list IPsec SAs",
+			fmt.Sprintf("Could not list IPsec security associations: %v", err),
+		)
+		return
+	}
+
+	tunnelID := fwhelpers.GetInt64Value(data.TunnelID)
+	send, recv := false, false
+	data.SAs = []SAModel{}
+	for _, sa := range sas {
+		if tunnelID != 0 && sa.Gateway != tunnelID {
+			continue
+		}
+		if sa.Type != "isakmp" {
+			send = send || sa.Direction == "send"
+			recv = recv || sa.Direction == "recv"
+		}
+		data.SAs = append(data.SAs, SAModel{
+			ID:         types.Int64Value(int64(sa.ID)),
+			TunnelID:   types.Int64Value(int64(sa.Gateway)),
+			Type:       types.StringValue(sa.Type),
+			Direction:  types.StringValue(sa.Direction),
+			SPI:        types.StringValue(sa.SPI),
+			Lifetime:   types.Int64Value(int64(sa.Lifetime)),
+			Peer:       types.StringValue(sa.RemoteID),
+			LocalHost:  types.StringValue(sa.LocalHost),
+			RemoteHost: types.StringValue(sa.RemoteHost),
+		})
+	}
+	data.Established = types.BoolValue(send && recv)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
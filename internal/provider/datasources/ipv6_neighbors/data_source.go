@@ -0,0 +1,137 @@
+package ipv6_neighbors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IPv6NeighborsDataSource{}
+
+// NewIPv6NeighborsDataSource creates a new IPv6 neighbors data source.
+func NewIPv6NeighborsDataSource() datasource.DataSource {
+	return &IPv6NeighborsDataSource{}
+}
+
+// IPv6NeighborsDataSource defines the data source implementation.
+type IPv6NeighborsDataSource struct {
+	client client.Client
+}
+
+// IPv6NeighborsModel describes the data source data model.
+type IPv6NeighborsModel struct {
+	Interface types.String    `tfsdk:"interface"`
+	Neighbors []NeighborModel `tfsdk:"neighbors"`
+}
+
+// NeighborModel describes a single neighbor cache entry.
+type NeighborModel struct {
+	Address    types.String `tfsdk:"address"`
+	Interface  types.String `tfsdk:"interface"`
+	MACAddress types.String `tfsdk:"mac_address"`
+	State      types.String `tfsdk:"state"`
+}
+
+// Metadata returns the data source type name.
+func (d *IPv6NeighborsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipv6_neighbors"
+}
+
+// Schema defines the schema for the data source.
+func (d *IPv6NeighborsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the entries of the IPv6 neighbor cache ('show ipv6 neighbor cache') for IPv6 inventory use cases.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description: "Only return neighbors learned on this interface (e.g., lan1). If omitted, neighbors of all interfaces are returned.",
+				Optional:    true,
+			},
+			"neighbors": schema.ListNestedAttribute{
+				Description: "Neighbor cache entries.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Description: "IPv6 address of the neighbor.",
+							Computed:    true,
+						},
+						"interface": schema.StringAttribute{
+							Description: "Interface the neighbor was learned on.",
+							Computed:    true,
+						},
+						"mac_address": schema.StringAttribute{
+							Description: "MAC address of the neighbor. Empty while address resolution is incomplete.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Neighbor state (incomplete, reachable, stale, delay, probe, static).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *IPv6NeighborsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current neighbor cache.
+func (d *IPv6NeighborsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IPv6NeighborsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := fwhelpers.GetStringValue(data.Interface)
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_ipv6_neighbors").Msgf("Reading IPv6 neighbor cache (interface: %q)", iface)
+
+	neighbors, err := d.client.ListIPv6Neighbors(ctx, iface)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read IPv6 neighbor cache",
+			fmt.Sprintf("Could not read the IPv6 neighbor cache: %v", err),
+		)
+		return
+	}
+
+	data.Neighbors = make([]NeighborModel, 0, len(neighbors))
+	for _, n := range neighbors {
+		data.Neighbors = append(data.Neighbors, NeighborModel{
+			Address:    types.StringValue(n.Address),
+			Interface:  types.StringValue(n.Interface),
+			MACAddress: types.StringValue(n.MACAddress),
+			State:      types.StringValue(n.State),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
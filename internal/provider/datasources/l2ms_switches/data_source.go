@@ -0,0 +1,131 @@
+package l2ms_switches
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &L2MSSwitchesDataSource{}
+
+// NewL2MSSwitchesDataSource creates a new L2MS switches data source.
+func NewL2MSSwitchesDataSource() datasource.DataSource {
+	return &L2MSSwitchesDataSource{}
+}
+
+// L2MSSwitchesDataSource defines the data source implementation.
+type L2MSSwitchesDataSource struct {
+	client client.Client
+}
+
+// L2MSSwitchesModel describes the data source data model.
+type L2MSSwitchesModel struct {
+	Switches []SwitchModel `tfsdk:"switches"`
+}
+
+// SwitchModel describes a discovered switch.
+type SwitchModel struct {
+	MACAddress types.String `tfsdk:"mac_address"`
+	Model      types.String `tfsdk:"model"`
+	Route      types.String `tfsdk:"route"`
+	Name       types.String `tfsdk:"name"`
+}
+
+// Metadata returns the data source type name.
+func (d *L2MSSwitchesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_l2ms_switches"
+}
+
+// Schema defines the schema for the data source.
+func (d *L2MSSwitchesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Yamaha switches discovered by the L2MS manager ('show status switch control'), " +
+			"for use with rtx_l2ms_managed_switch.",
+		Attributes: map[string]schema.Attribute{
+			"switches": schema.ListNestedAttribute{
+				Description: "Discovered switches.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"mac_address": schema.StringAttribute{
+							Description: "MAC address of the switch.",
+							Computed:    true,
+						},
+						"model": schema.StringAttribute{
+							Description: "Switch model (e.g., 'SWX2200-8G').",
+							Computed:    true,
+						},
+						"route": schema.StringAttribute{
+							Description: "Route from the router to the switch (e.g., 'lan1:1').",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "System name of the switch.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *L2MSSwitchesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the switches currently discovered.
+func (d *L2MSSwitchesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data L2MSSwitchesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_l2ms_switches").Msg("Listing L2MS switches")
+
+	switches, err := d.client.ListL2MSSwitches(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list L2MS switches",
+			fmt.Sprintf("Could not list switches discovered by L2MS: %v", err),
+		)
+		return
+	}
+
+	data.Switches = make([]SwitchModel, len(switches))
+	for i, sw := range switches {
+		data.Switches[i] = SwitchModel{
+			MACAddress: types.StringValue(sw.MACAddress),
+			Model:      types.StringValue(sw.Model),
+			Route:      types.StringValue(sw.Route),
+			Name:       types.StringValue(sw.Name),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,162 @@
+package l2tp_sessions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &L2TPSessionsDataSource{}
+
+// NewL2TPSessionsDataSource creates a new L2TP sessions data source.
+func NewL2TPSessionsDataSource() datasource.DataSource {
+	return &L2TPSessionsDataSource{}
+}
+
+// L2TPSessionsDataSource defines the data source implementation.
+type L2TPSessionsDataSource struct {
+	client client.Client
+}
+
+// L2TPSessionsModel describes the data source data model.
+type L2TPSessionsModel struct {
+	Username types.String   `tfsdk:"username"`
+	Sessions []SessionModel `tfsdk:"sessions"`
+}
+
+// SessionModel describes a connected L2TP session.
+type SessionModel struct {
+	TunnelID        types.Int64  `tfsdk:"tunnel_id"`
+	SessionID       types.Int64  `tfsdk:"session_id"`
+	State           types.String `tfsdk:"state"`
+	Username        types.String `tfsdk:"username"`
+	AssignedAddress types.String `tfsdk:"assigned_address"`
+	RemoteAddress   types.String `tfsdk:"remote_address"`
+	Version         types.String `tfsdk:"version"`
+	UptimeSeconds   types.Int64  `tfsdk:"uptime_seconds"`
+}
+
+// Metadata returns the data source type name.
+func (d *L2TPSessionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_l2tp_sessions"
+}
+
+// Schema defines the schema for the data source.
+func (d *L2TPSessionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the currently connected L2TP sessions, such as remote access VPN clients ('show status l2tp'), " +
+			"with the user name, assigned address and connection time.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Description: "Only return sessions of this user. If omitted, all sessions are returned.",
+				Optional:    true,
+			},
+			"sessions": schema.ListNestedAttribute{
+				Description: "Connected sessions.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tunnel_id": schema.Int64Attribute{
+							Description: "Index of the L2TP tunnel carrying the session.",
+							Computed:    true,
+						},
+						"session_id": schema.Int64Attribute{
+							Description: "Local session ID.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Session state (e.g., 'established').",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "Authenticated user name.",
+							Computed:    true,
+						},
+						"assigned_address": schema.StringAttribute{
+							Description: "IP address assigned to the client.",
+							Computed:    true,
+						},
+						"remote_address": schema.StringAttribute{
+							Description: "Public IP address of the client.",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "L2TP version ('L2TPv2' or 'L2TPv3').",
+							Computed:    true,
+						},
+						"uptime_seconds": schema.Int64Attribute{
+							Description: "Time connected in seconds.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *L2TPSessionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current L2TP sessions.
+func (d *L2TPSessionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data L2TPSessionsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := fwhelpers.GetStringValue(data.Username)
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_l2tp_sessions").Msgf("Reading L2TP sessions (username: %q)", username)
+
+	sessions, err := d.client.ListL2TPSessions(ctx, username)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read L2TP sessions",
+			fmt.Sprintf("Could not read L2TP sessions: %v", err),
+		)
+		return
+	}
+
+	data.Sessions = make([]SessionModel, len(sessions))
+	for i, s := range sessions {
+		data.Sessions[i] = SessionModel{
+			TunnelID:        types.Int64Value(int64(s.TunnelID)),
+			SessionID:       types.Int64Value(int64(s.SessionID)),
+			State:           types.StringValue(s.State),
+			Username:        types.StringValue(s.Username),
+			AssignedAddress: types.StringValue(s.AssignedAddress),
+			RemoteAddress:   types.StringValue(s.RemoteAddress),
+			Version:         types.StringValue(s.Version),
+			UptimeSeconds:   types.Int64Value(int64(s.UptimeSeconds)),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,144 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LogDataSource{}
+
+var keywordPattern = regexp.MustCompile(`^[^"|]+$`)
+
+// NewLogDataSource creates a new log data source.
+func NewLogDataSource() datasource.DataSource {
+	return &LogDataSource{}
+}
+
+// LogDataSource defines the data source implementation.
+type LogDataSource struct {
+	client client.Client
+}
+
+// LogDataModel describes the data source data model.
+type LogDataModel struct {
+	Keyword   types.String    `tfsdk:"keyword"`
+	TailLines types.Int64     `tfsdk:"tail_lines"`
+	Entries   []LogEntryModel `tfsdk:"entries"`
+}
+
+// LogEntryModel describes a single log entry.
+type LogEntryModel struct {
+	Timestamp types.String `tfsdk:"timestamp"`
+	Message   types.String `tfsdk:"message"`
+}
+
+// Metadata returns the data source type name.
+func (d *LogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log"
+}
+
+// Schema defines the schema for the data source.
+func (d *LogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns entries of the router's internal log ('show log'), optionally filtered by keyword " +
+			"and limited to the most recent entries, so that runbooks and automated checks can inspect recent events.",
+		Attributes: map[string]schema.Attribute{
+			"keyword": schema.StringAttribute{
+				Description: "Only return entries matching this pattern ('show log | grep'). Double quotes and pipes are not allowed.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(keywordPattern, "must not be empty or contain double quotes or pipes"),
+				},
+			},
+			"tail_lines": schema.Int64Attribute{
+				Description: "Only return the last N matching entries. If omitted, all entries kept by the router are returned.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				Description: "Log entries in chronological order (oldest first).",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							Description: "Time of the entry as printed by the router (YYYY/MM/DD HH:MM:SS).",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "Log message.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *LogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current log entries.
+func (d *LogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LogDataModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyword := fwhelpers.GetStringValue(data.Keyword)
+	tail := fwhelpers.GetInt64Value(data.TailLines)
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_log").Msgf("Reading log (keyword: %q, tail: %d)", keyword, tail)
+
+	entries, err := d.client.ListLogEntries(ctx, keyword, tail)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read log",
+			fmt.Sprintf("Could not read the router log: %v", err),
+		)
+		return
+	}
+
+	data.Entries = make([]LogEntryModel, 0, len(entries))
+	for _, e := range entries {
+		data.Entries = append(data.Entries, LogEntryModel{
+			Timestamp: types.StringValue(e.Timestamp),
+			Message:   types.StringValue(e.Message),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,181 @@
+package nat_descriptors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NATDescriptorsDataSource{}
+
+// NewNATDescriptorsDataSource creates a new NAT descriptors data source.
+func NewNATDescriptorsDataSource() datasource.DataSource {
+	return &NATDescriptorsDataSource{}
+}
+
+// NATDescriptorsDataSource defines the data source implementation.
+type NATDescriptorsDataSource struct {
+	client client.Client
+}
+
+// NATDescriptorsModel describes the data source data model.
+type NATDescriptorsModel struct {
+	Type        types.String      `tfsdk:"type"`
+	IDs         types.List        `tfsdk:"ids"`
+	Descriptors []DescriptorModel `tfsdk:"descriptors"`
+}
+
+// DescriptorModel describes a NAT descriptor.
+type DescriptorModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	Type           types.String `tfsdk:"type"`
+	OuterAddresses types.List   `tfsdk:"outer_addresses"`
+	InnerAddresses types.List   `tfsdk:"inner_addresses"`
+	Interfaces     types.List   `tfsdk:"interfaces"`
+}
+
+// Metadata returns the data source type name.
+func (d *NATDescriptorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nat_descriptors"
+}
+
+// Schema defines the schema for the data source.
+func (d *NATDescriptorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all NAT descriptors with their type, outer and inner addresses and the interfaces they are applied to, " +
+			"including descriptors not managed by Terraform. Useful for allocating free descriptor IDs and for audits.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Only return descriptors of this type ('masquerade', 'static', 'nat', 'nat-masquerade' or 'none').",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("masquerade", "static", "nat", "nat-masquerade", "none"),
+				},
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the returned descriptors in ascending order.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"descriptors": schema.ListNestedAttribute{
+				Description: "NAT descriptors ordered by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Descriptor ID.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Descriptor type. Empty if only addresses or bindings reference the ID.",
+							Computed:    true,
+						},
+						"outer_addresses": schema.ListAttribute{
+							Description: "Outer addresses ('ipcp', 'primary', addresses or ranges).",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"inner_addresses": schema.ListAttribute{
+							Description: "Inner addresses ('auto' or ranges).",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"interfaces": schema.ListAttribute{
+							Description: "Interfaces the descriptor is applied to (e.g., 'lan2', 'pp1', 'tunnel1').",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *NATDescriptorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current NAT descriptors.
+func (d *NATDescriptorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NATDescriptorsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typeFilter := fwhelpers.GetStringValue(data.Type)
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_nat_descriptors").Msgf("Reading NAT descriptors (type: %q)", typeFilter)
+
+	descriptors, err := d.client.ListNATDescriptors(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read NAT descriptors",
+			fmt.Sprintf("Could not read NAT descriptors: %v", err),
+		)
+		return
+	}
+
+	ids := []int64{}
+	data.Descriptors = []DescriptorModel{}
+	for _, desc := range descriptors {
+		if typeFilter != "" && desc.Type != typeFilter {
+			continue
+		}
+
+		outer, diags := types.ListValueFrom(ctx, types.StringType, desc.OuterAddresses)
+		resp.Diagnostics.Append(diags...)
+		inner, diags := types.ListValueFrom(ctx, types.StringType, desc.InnerAddresses)
+		resp.Diagnostics.Append(diags...)
+		interfaces, diags := types.ListValueFrom(ctx, types.StringType, desc.Interfaces)
+		resp.Diagnostics.Append(diags...)
+
+		ids = append(ids, int64(desc.ID))
+		data.Descriptors = append(data.Descriptors, DescriptorModel{
+			ID:             types.Int64Value(int64(desc.ID)),
+			Type:           types.StringValue(desc.Type),
+			OuterAddresses: outer,
+			InnerAddresses: inner,
+			Interfaces:     interfaces,
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idsValue, diags := types.ListValueFrom(ctx, types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IDs = idsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,183 @@
+package netvolante_status
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NetVolanteStatusDataSource{}
+
+// NewNetVolanteStatusDataSource creates a new NetVolante DNS status data source.
+func NewNetVolanteStatusDataSource() datasource.DataSource {
+	return &NetVolanteStatusDataSource{}
+}
+
+// NetVolanteStatusDataSource defines the data source implementation.
+type NetVolanteStatusDataSource struct {
+	client client.Client
+}
+
+// NetVolanteStatusModel describes the data source data model.
+type NetVolanteStatusModel struct {
+	Interface     types.String        `tfsdk:"interface"`
+	Hostname      types.String        `tfsdk:"hostname"`
+	IPAddress     types.String        `tfsdk:"ip_address"`
+	Status        types.String        `tfsdk:"status"`
+	Registrations []RegistrationModel `tfsdk:"registrations"`
+}
+
+// RegistrationModel describes the registration of an interface.
+type RegistrationModel struct {
+	Interface    types.String `tfsdk:"interface"`
+	Hostname     types.String `tfsdk:"hostname"`
+	IPAddress    types.String `tfsdk:"ip_address"`
+	Status       types.String `tfsdk:"status"`
+	LastUpdate   types.String `tfsdk:"last_update"`
+	ErrorMessage types.String `tfsdk:"error_message"`
+}
+
+// Metadata returns the data source type name.
+func (d *NetVolanteStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_netvolante_status"
+}
+
+// Schema defines the schema for the data source.
+func (d *NetVolanteStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the current NetVolante DNS registration status ('show status netvolante-dns'), " +
+			"so that the registered hostname and address can be consumed by other resources such as external DNS records.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description: "Only return the registration of this interface (e.g., 'pp 1', 'pp1' or 'lan2'). If omitted, all registrations are returned.",
+				Optional:    true,
+			},
+			"hostname": schema.StringAttribute{
+				Description: "Hostname of the first returned registration. Empty if there is none.",
+				Computed:    true,
+			},
+			"ip_address": schema.StringAttribute{
+				Description: "Registered IP address of the first returned registration. Empty if there is none.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the first returned registration (e.g., 'registered', 'error'). Empty if there is none.",
+				Computed:    true,
+			},
+			"registrations": schema.ListNestedAttribute{
+				Description: "NetVolante DNS registrations.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"interface": schema.StringAttribute{
+							Description: "Interface the hostname is registered for.",
+							Computed:    true,
+						},
+						"hostname": schema.StringAttribute{
+							Description: "Registered hostname.",
+							Computed:    true,
+						},
+						"ip_address": schema.StringAttribute{
+							Description: "Registered IP address.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Registration status.",
+							Computed:    true,
+						},
+						"last_update": schema.StringAttribute{
+							Description: "Time of the last update as printed by the router.",
+							Computed:    true,
+						},
+						"error_message": schema.StringAttribute{
+							Description: "Error reported by the last update, if any.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *NetVolanteStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current registration status.
+func (d *NetVolanteStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NetVolanteStatusModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := fwhelpers.GetStringValue(data.Interface)
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_netvolante_status").Msgf("Reading NetVolante DNS status (interface: %q)", iface)
+
+	statuses, err := d.client.GetNetVolanteDNSStatus(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read NetVolante DNS status",
+			fmt.Sprintf("Could not read NetVolante DNS status: %v", err),
+		)
+		return
+	}
+
+	data.Registrations = []RegistrationModel{}
+	for _, s := range statuses {
+		if iface != "" && !sameInterface(s.Interface, iface) {
+			continue
+		}
+		data.Registrations = append(data.Registrations, RegistrationModel{
+			Interface:    types.StringValue(s.Interface),
+			Hostname:     types.StringValue(s.Hostname),
+			IPAddress:    types.StringValue(s.CurrentIP),
+			Status:       types.StringValue(s.Status),
+			LastUpdate:   types.StringValue(s.LastUpdate),
+			ErrorMessage: types.StringValue(s.ErrorMessage),
+		})
+	}
+
+	data.Hostname = types.StringValue("")
+	data.IPAddress = types.StringValue("")
+	data.Status = types.StringValue("")
+	if len(data.Registrations) > 0 {
+		data.Hostname = data.Registrations[0].Hostname
+		data.IPAddress = data.Registrations[0].IPAddress
+		data.Status = data.Registrations[0].Status
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sameInterface compares interface names ignoring case and spaces ("pp 1" equals "pp1")
+func sameInterface(a, b string) bool {
+	return strings.EqualFold(strings.ReplaceAll(a, " ", ""), strings.ReplaceAll(b, " ", ""))
+}
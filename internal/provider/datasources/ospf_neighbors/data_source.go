@@ -0,0 +1,155 @@
+package ospf_neighbors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OSPFNeighborsDataSource{}
+
+// NewOSPFNeighborsDataSource creates a new OSPF neighbors data source.
+func NewOSPFNeighborsDataSource() datasource.DataSource {
+	return &OSPFNeighborsDataSource{}
+}
+
+// OSPFNeighborsDataSource defines the data source implementation.
+type OSPFNeighborsDataSource struct {
+	client client.Client
+}
+
+// OSPFNeighborsModel describes the data source data model.
+type OSPFNeighborsModel struct {
+	Interface types.String    `tfsdk:"interface"`
+	Neighbors []NeighborModel `tfsdk:"neighbors"`
+}
+
+// NeighborModel describes a single OSPF adjacency.
+type NeighborModel struct {
+	NeighborID types.String `tfsdk:"neighbor_id"`
+	Priority   types.Int64  `tfsdk:"priority"`
+	State      types.String `tfsdk:"state"`
+	Role       types.String `tfsdk:"role"`
+	DeadTime   types.String `tfsdk:"dead_time"`
+	Address    types.String `tfsdk:"address"`
+	Interface  types.String `tfsdk:"interface"`
+}
+
+// Metadata returns the data source type name.
+func (d *OSPFNeighborsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ospf_neighbors"
+}
+
+// Schema defines the schema for the data source.
+func (d *OSPFNeighborsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the OSPF adjacencies of the router ('show status ospf neighbor') for post-change validation of routing.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description: "Only return neighbors learned on this interface (e.g., lan1, tunnel1). If omitted, neighbors of all interfaces are returned.",
+				Optional:    true,
+			},
+			"neighbors": schema.ListNestedAttribute{
+				Description: "OSPF neighbors.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"neighbor_id": schema.StringAttribute{
+							Description: "Router ID of the neighbor.",
+							Computed:    true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "Router priority of the neighbor.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Adjacency state (down, attempt, init, 2way, exstart, exchange, loading, full).",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role of the neighbor on the segment (dr, bdr, drother). Empty on point-to-point links.",
+							Computed:    true,
+						},
+						"dead_time": schema.StringAttribute{
+							Description: "Time remaining until the neighbor is declared down.",
+							Computed:    true,
+						},
+						"address": schema.StringAttribute{
+							Description: "Interface address of the neighbor.",
+							Computed:    true,
+						},
+						"interface": schema.StringAttribute{
+							Description: "Local interface the neighbor was learned on.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *OSPFNeighborsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current OSPF adjacencies.
+func (d *OSPFNeighborsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OSPFNeighborsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := fwhelpers.GetStringValue(data.Interface)
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_ospf_neighbors").Msgf("Reading OSPF neighbors (interface: %q)", iface)
+
+	neighbors, err := d.client.ListOSPFNeighborStatuses(ctx, iface)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read OSPF neighbors",
+			fmt.Sprintf("Could not read the OSPF neighbors: %v", err),
+		)
+		return
+	}
+
+	data.Neighbors = make([]NeighborModel, 0, len(neighbors))
+	for _, n := range neighbors {
+		data.Neighbors = append(data.Neighbors, NeighborModel{
+			NeighborID: types.StringValue(n.NeighborID),
+			Priority:   types.Int64Value(int64(n.Priority)),
+			State:      types.StringValue(n.State),
+			Role:       types.StringValue(n.Role),
+			DeadTime:   types.StringValue(n.DeadTime),
+			Address:    types.StringValue(n.Address),
+			Interface:  types.StringValue(n.Interface),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
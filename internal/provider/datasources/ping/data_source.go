@@ -0,0 +1,192 @@
+package ping
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+const (
+	defaultCount          = 5
+	defaultTimeoutSeconds = 1
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PingDataSource{}
+
+// NewPingDataSource creates a new ping data source.
+func NewPingDataSource() datasource.DataSource {
+	return &PingDataSource{}
+}
+
+// PingDataSource defines the data source implementation.
+type PingDataSource struct {
+	client client.Client
+}
+
+// PingModel describes the data source data model.
+type PingModel struct {
+	Host              types.String  `tfsdk:"host"`
+	Count             types.Int64   `tfsdk:"count"`
+	Timeout           types.Int64   `tfsdk:"timeout"`
+	SourceAddress     types.String  `tfsdk:"source_address"`
+	SourceInterface   types.String  `tfsdk:"source_interface"`
+	PacketsSent       types.Int64   `tfsdk:"packets_sent"`
+	PacketsReceived   types.Int64   `tfsdk:"packets_received"`
+	PacketLossPercent types.Float64 `tfsdk:"packet_loss_percent"`
+	Reachable         types.Bool    `tfsdk:"reachable"`
+	RTTMinMs          types.Float64 `tfsdk:"rtt_min_ms"`
+	RTTAvgMs          types.Float64 `tfsdk:"rtt_avg_ms"`
+	RTTMaxMs          types.Float64 `tfsdk:"rtt_max_ms"`
+}
+
+// Metadata returns the data source type name.
+func (d *PingDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ping"
+}
+
+// Schema defines the schema for the data source.
+func (d *PingDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs ping from the router and returns packet loss and latency, so smoke tests can be expressed in Terraform after network changes. " +
+			"An unreachable host is reported through the result attributes, not as an error.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Description: "Destination host name or IP address. IPv6 addresses are pinged with ping6.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"count": schema.Int64Attribute{
+				Description: fmt.Sprintf("Number of echo requests to send (1-100). Defaults to %d.", defaultCount),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Description: fmt.Sprintf("Seconds to wait for each reply (1-60). Defaults to %d.", defaultTimeoutSeconds),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 60),
+				},
+			},
+			"source_address": schema.StringAttribute{
+				Description: "Source address of the echo requests.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("source_interface")),
+				},
+			},
+			"source_interface": schema.StringAttribute{
+				Description: "Send the echo requests from the static IPv4 address of this interface (e.g., lan1). " +
+					"The router selects the source by address, so the interface must have a static address. Only supported for IPv4 hosts.",
+				Optional: true,
+			},
+			"packets_sent": schema.Int64Attribute{
+				Description: "Number of echo requests sent.",
+				Computed:    true,
+			},
+			"packets_received": schema.Int64Attribute{
+				Description: "Number of echo replies received.",
+				Computed:    true,
+			},
+			"packet_loss_percent": schema.Float64Attribute{
+				Description: "Packet loss in percent.",
+				Computed:    true,
+			},
+			"reachable": schema.BoolAttribute{
+				Description: "Whether at least one echo reply was received.",
+				Computed:    true,
+			},
+			"rtt_min_ms": schema.Float64Attribute{
+				Description: "Minimum round-trip time in milliseconds. Null if no reply was received.",
+				Computed:    true,
+			},
+			"rtt_avg_ms": schema.Float64Attribute{
+				Description: "Average round-trip time in milliseconds. Null if no reply was received.",
+				Computed:    true,
+			},
+			"rtt_max_ms": schema.Float64Attribute{
+				Description: "Maximum round-trip time in milliseconds. Null if no reply was received.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *PingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read runs ping and stores the summary in the Terraform state.
+func (d *PingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PingModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := client.PingOptions{
+		Host:            data.Host.ValueString(),
+		Count:           defaultCount,
+		TimeoutSeconds:  defaultTimeoutSeconds,
+		SourceAddress:   fwhelpers.GetStringValue(data.SourceAddress),
+		SourceInterface: fwhelpers.GetStringValue(data.SourceInterface),
+	}
+	if !data.Count.IsNull() {
+		opts.Count = fwhelpers.GetInt64Value(data.Count)
+	}
+	if !data.Timeout.IsNull() {
+		opts.TimeoutSeconds = fwhelpers.GetInt64Value(data.Timeout)
+	}
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_ping").Msgf("Pinging %s (count: %d, timeout: %ds, source: %q, source interface: %q)", opts.Host, opts.Count, opts.TimeoutSeconds, opts.SourceAddress, opts.SourceInterface)
+
+	result, err := d.client.Ping(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to run ping",
+			fmt.Sprintf("Could not ping %s from the router: %v", opts.Host, err),
+		)
+		return
+	}
+
+	data.PacketsSent = types.Int64Value(int64(result.PacketsSent))
+	data.PacketsReceived = types.Int64Value(int64(result.PacketsReceived))
+	data.PacketLossPercent = types.Float64Value(result.PacketLossPercent)
+	data.Reachable = types.BoolValue(result.PacketsReceived > 0)
+	data.RTTMinMs = types.Float64PointerValue(result.RTTMinMs)
+	data.RTTAvgMs = types.Float64PointerValue(result.RTTAvgMs)
+	data.RTTMaxMs = types.Float64PointerValue(result.RTTMaxMs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
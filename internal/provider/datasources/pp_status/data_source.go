@@ -0,0 +1,144 @@
+package pp_status
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PPStatusDataSource{}
+
+// NewPPStatusDataSource creates a new PP status data source.
+func NewPPStatusDataSource() datasource.DataSource {
+	return &PPStatusDataSource{}
+}
+
+// PPStatusDataSource defines the data source implementation.
+type PPStatusDataSource struct {
+	client client.Client
+}
+
+// PPStatusModel describes the data source data model.
+type PPStatusModel struct {
+	PPNumber           types.Int64  `tfsdk:"pp_number"`
+	Connected          types.Bool   `tfsdk:"connected"`
+	LocalAddress       types.String `tfsdk:"local_address"`
+	RemoteAddress      types.String `tfsdk:"remote_address"`
+	DNSServers         types.List   `tfsdk:"dns_servers"`
+	UptimeSeconds      types.Int64  `tfsdk:"uptime_seconds"`
+	AccessConcentrator types.String `tfsdk:"access_concentrator"`
+}
+
+// Metadata returns the data source type name.
+func (d *PPStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pp_status"
+}
+
+// Schema defines the schema for the data source.
+func (d *PPStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the session state of a PP interface such as a PPPoE uplink ('show status pp N'): " +
+			"whether it is connected, the addresses and DNS servers negotiated by IPCP, and the connection time.",
+		Attributes: map[string]schema.Attribute{
+			"pp_number": schema.Int64Attribute{
+				Description: "PP interface number.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"connected": schema.BoolAttribute{
+				Description: "Whether the session is established.",
+				Computed:    true,
+			},
+			"local_address": schema.StringAttribute{
+				Description: "IPv4 address assigned to the router by IPCP. Empty while disconnected.",
+				Computed:    true,
+			},
+			"remote_address": schema.StringAttribute{
+				Description: "IPv4 address of the peer reported by IPCP. Empty while disconnected.",
+				Computed:    true,
+			},
+			"dns_servers": schema.ListAttribute{
+				Description: "DNS servers notified by IPCP.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"uptime_seconds": schema.Int64Attribute{
+				Description: "Time the session has been connected, in seconds.",
+				Computed:    true,
+			},
+			"access_concentrator": schema.StringAttribute{
+				Description: "Name of the PPPoE access concentrator, if reported.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *PPStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current session state.
+func (d *PPStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PPStatusModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ppNum := fwhelpers.GetInt64Value(data.PPNumber)
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_pp_status").Msgf("Reading status of pp %d", ppNum)
+
+	status, err := d.client.GetPPStatus(ctx, ppNum)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read PP status",
+			fmt.Sprintf("Could not read status of pp %d: %v", ppNum, err),
+		)
+		return
+	}
+
+	dnsServers, diags := types.ListValueFrom(ctx, types.StringType, status.DNSServers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Connected = types.BoolValue(status.Connected)
+	data.LocalAddress = types.StringValue(status.LocalAddress)
+	data.RemoteAddress = types.StringValue(status.RemoteAddress)
+	data.DNSServers = dnsServers
+	data.UptimeSeconds = types.Int64Value(int64(status.UptimeSeconds))
+	data.AccessConcentrator = types.StringValue(status.AccessConcentrator)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,164 @@
+package qos_status
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &QoSStatusDataSource{}
+
+var interfacePattern = regexp.MustCompile(`^lan\d+$`)
+
+// NewQoSStatusDataSource creates a new QoS status data source.
+func NewQoSStatusDataSource() datasource.DataSource {
+	return &QoSStatusDataSource{}
+}
+
+// QoSStatusDataSource defines the data source implementation.
+type QoSStatusDataSource struct {
+	client client.Client
+}
+
+// QoSStatusModel describes the data source data model.
+type QoSStatusModel struct {
+	Interface types.String `tfsdk:"interface"`
+	QueueType types.String `tfsdk:"queue_type"`
+	Classes   []ClassModel `tfsdk:"classes"`
+}
+
+// ClassModel describes the statistics of a queue class.
+type ClassModel struct {
+	Class          types.Int64 `tfsdk:"class"`
+	SentPackets    types.Int64 `tfsdk:"sent_packets"`
+	SentOctets     types.Int64 `tfsdk:"sent_octets"`
+	DroppedPackets types.Int64 `tfsdk:"dropped_packets"`
+	QueueLength    types.Int64 `tfsdk:"queue_length"`
+	QueueLimit     types.Int64 `tfsdk:"queue_limit"`
+}
+
+// Metadata returns the data source type name.
+func (d *QoSStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_qos_status"
+}
+
+// Schema defines the schema for the data source.
+func (d *QoSStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the queue statistics per class of an interface ('show status qos'), " +
+			"to verify that shaping and priority policies are taking effect.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description: "LAN interface to read (e.g., 'lan2').",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(interfacePattern, "must be a LAN interface (e.g., 'lan2')"),
+				},
+			},
+			"queue_type": schema.StringAttribute{
+				Description: "Queuing type as reported by the router (e.g., 'priority', 'cbq'). Empty if queuing is not configured.",
+				Computed:    true,
+			},
+			"classes": schema.ListNestedAttribute{
+				Description: "Statistics per class, ordered by class number.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"class": schema.Int64Attribute{
+							Description: "Class number.",
+							Computed:    true,
+						},
+						"sent_packets": schema.Int64Attribute{
+							Description: "Packets transmitted from the class.",
+							Computed:    true,
+						},
+						"sent_octets": schema.Int64Attribute{
+							Description: "Octets transmitted from the class.",
+							Computed:    true,
+						},
+						"dropped_packets": schema.Int64Attribute{
+							Description: "Packets discarded because the queue was full.",
+							Computed:    true,
+						},
+						"queue_length": schema.Int64Attribute{
+							Description: "Packets currently queued.",
+							Computed:    true,
+						},
+						"queue_limit": schema.Int64Attribute{
+							Description: "Maximum queue length. 0 if not reported.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *QoSStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current queue statistics.
+func (d *QoSStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data QoSStatusModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := data.Interface.ValueString()
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_qos_status").Msgf("Reading QoS status of %s", iface)
+
+	status, err := d.client.GetQoSStatus(ctx, iface)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read QoS status",
+			fmt.Sprintf("Could not read QoS status of %s: %v", iface, err),
+		)
+		return
+	}
+
+	data.QueueType = types.StringValue(status.QueueType)
+	data.Classes = make([]ClassModel, len(status.Classes))
+	for i, c := range status.Classes {
+		data.Classes[i] = ClassModel{
+			Class:          types.Int64Value(int64(c.Class)),
+			SentPackets:    types.Int64Value(c.SentPackets),
+			SentOctets:     types.Int64Value(c.SentOctets),
+			DroppedPackets: types.Int64Value(c.DroppedPackets),
+			QueueLength:    types.Int64Value(int64(c.QueueLength)),
+			QueueLimit:     types.Int64Value(int64(c.QueueLimit)),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
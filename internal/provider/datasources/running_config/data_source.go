@@ -0,0 +1,131 @@
+package running_config
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConfigDataSource{}
+
+var grepPattern = regexp.MustCompile(`^[^"|]+$`)
+
+// NewConfigDataSource creates a new config data source.
+func NewConfigDataSource() datasource.DataSource {
+	return &ConfigDataSource{}
+}
+
+// ConfigDataSource defines the data source implementation.
+type ConfigDataSource struct {
+	client client.Client
+}
+
+// ConfigModel describes the data source data model.
+type ConfigModel struct {
+	Grep    types.String `tfsdk:"grep"`
+	Content types.String `tfsdk:"content"`
+	Lines   types.List   `tfsdk:"lines"`
+}
+
+// Metadata returns the data source type name.
+func (d *ConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config"
+}
+
+// Schema defines the schema for the data source.
+func (d *ConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the raw router configuration ('show config'), optionally filtered by a grep pattern. " +
+			"Useful for custom checks and for auditing settings that are not yet managed by other resources. " +
+			"The configuration may contain credentials, so the result is marked sensitive.",
+		Attributes: map[string]schema.Attribute{
+			"grep": schema.StringAttribute{
+				Description: "Only return lines matching this pattern ('show config | grep'). Double quotes and pipes are not allowed.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(grepPattern, "must not be empty or contain double quotes or pipes"),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "The configuration text with LF line endings.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"lines": schema.ListAttribute{
+				Description: "The configuration split into lines.",
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the current router configuration.
+func (d *ConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConfigModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pattern := fwhelpers.GetStringValue(data.Grep)
+
+	logger := logging.FromContext(ctx)
+	logger.Debug().Str("data_source", "rtx_config").Msgf("Reading configuration (grep: %q)", pattern)
+
+	content, err := d.client.GetRunningConfig(ctx, pattern)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read configuration",
+			fmt.Sprintf("Could not read the router configuration: %v", err),
+		)
+		return
+	}
+
+	lines := []string{}
+	if content != "" {
+		lines = strings.Split(content, "\n")
+	}
+	linesValue, diags := types.ListValueFrom(ctx, types.StringType, lines)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Content = types.StringValue(content)
+	data.Lines = linesValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,57 @@
+package cidr_to_range
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &CIDRToRangeFunction{}
+
+// NewCIDRToRangeFunction creates a new cidr_to_range function.
+func NewCIDRToRangeFunction() function.Function {
+	return &CIDRToRangeFunction{}
+}
+
+// CIDRToRangeFunction converts IPv4 CIDR notation to an RTX address range.
+type CIDRToRangeFunction struct{}
+
+// Metadata returns the function name.
+func (f *CIDRToRangeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_to_range"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *CIDRToRangeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts IPv4 CIDR notation to an RTX address range",
+		Description: "Converts an IPv4 network in CIDR notation to the start-end address range the router uses, " +
+			"e.g. \"192.168.1.0/24\" to \"192.168.1.0-192.168.1.255\".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "IPv4 network in CIDR notation (e.g., 192.168.1.0/24).",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run converts the CIDR argument.
+func (f *CIDRToRangeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr))
+	if resp.Error != nil {
+		return
+	}
+
+	addressRange, err := parsers.ConvertRangeToRTXFormat(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, addressRange))
+}
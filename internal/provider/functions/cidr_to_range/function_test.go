@@ -0,0 +1,46 @@
+package cidr_to_range
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCIDRToRangeFunction_Run(t *testing.T) {
+	tests := []struct {
+		name    string
+		cidr    string
+		want    string
+		wantErr bool
+	}{
+		{name: "/24", cidr: "192.168.1.0/24", want: "192.168.1.0-192.168.1.255"},
+		{name: "host bits set", cidr: "10.0.0.77/28", want: "10.0.0.64-10.0.0.79"},
+		{name: "/32", cidr: "172.16.0.1/32", want: "172.16.0.1-172.16.0.1"},
+		{name: "no prefix length", cidr: "192.168.1.0", wantErr: true},
+		{name: "IPv6", cidr: "2001:db8::/32", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.cidr)})}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			NewCIDRToRangeFunction().Run(context.Background(), req, resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Errorf("Run(%q) returned no error", tt.cidr)
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("Run(%q) returned error: %v", tt.cidr, resp.Error)
+			}
+			if got := resp.Result.Value(); !got.Equal(types.StringValue(tt.want)) {
+				t.Errorf("Run(%q) = %s, want %q", tt.cidr, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,2 @@
+// Package functions contains Terraform Plugin Framework provider function implementations.
+package functions
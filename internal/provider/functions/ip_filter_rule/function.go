@@ -0,0 +1,153 @@
+package ip_filter_rule
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &IPFilterRuleFunction{}
+
+// ruleKeys are the keys of the rule argument
+var ruleKeys = map[string]bool{
+	"action":      true,
+	"source":      true,
+	"destination": true,
+	"protocol":    true,
+	"source_port": true,
+	"dest_port":   true,
+	"established": true,
+	"icmp_type":   true,
+	"icmp_code":   true,
+}
+
+// NewIPFilterRuleFunction creates a new ip_filter_rule function.
+func NewIPFilterRuleFunction() function.Function {
+	return &IPFilterRuleFunction{}
+}
+
+// IPFilterRuleFunction composes a validated "ip filter" command.
+type IPFilterRuleFunction struct{}
+
+// Metadata returns the function name.
+func (f *IPFilterRuleFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ip_filter_rule"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *IPFilterRuleFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a validated ip filter command",
+		Description: "Composes the canonical \"ip filter\" command for a filter number and a rule, " +
+			"validating the rule the same way the provider validates filter entries. " +
+			"The rule keys are action, source, destination and protocol (required), and source_port, dest_port, established, icmp_type and icmp_code (optional). " +
+			"Ports accept numbers, ranges, service keywords and \"*\"; established only applies to tcp, icmp_type and icmp_code only to icmp.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "number",
+				Description: "Filter number (1-65535).",
+			},
+			function.MapParameter{
+				Name:        "rule",
+				Description: "Rule fields, e.g. { action = \"pass\", source = \"*\", destination = \"192.168.1.10\", protocol = \"tcp\", dest_port = \"www\" }.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the filter command.
+func (f *IPFilterRuleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var number int64
+	var rule map[string]string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &number, &rule))
+	if resp.Error != nil {
+		return
+	}
+
+	filter, argument, err := buildFilter(int(number), rule)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(argument, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, parsers.BuildIPFilterCommand(filter)))
+}
+
+// buildFilter converts and validates the arguments. On error it also returns
+// the position of the argument at fault.
+func buildFilter(number int, rule map[string]string) (parsers.IPFilter, int64, error) {
+	if err := parsers.ValidateIPFilterNumber(number); err != nil {
+		return parsers.IPFilter{}, 0, err
+	}
+
+	var unknown []string
+	for key := range rule {
+		if !ruleKeys[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return parsers.IPFilter{}, 1, fmt.Errorf("unsupported rule keys: %s", strings.Join(unknown, ", "))
+	}
+	for _, key := range []string{"action", "source", "destination", "protocol"} {
+		if strings.TrimSpace(rule[key]) == "" {
+			return parsers.IPFilter{}, 1, fmt.Errorf("rule key %q is required", key)
+		}
+	}
+
+	filter := parsers.IPFilter{
+		Number:        number,
+		Action:        strings.ToLower(strings.TrimSpace(rule["action"])),
+		SourceAddress: strings.TrimSpace(rule["source"]),
+		DestAddress:   strings.TrimSpace(rule["destination"]),
+		Protocol:      strings.ToLower(strings.TrimSpace(rule["protocol"])),
+		SourcePort:    strings.TrimSpace(rule["source_port"]),
+		DestPort:      strings.TrimSpace(rule["dest_port"]),
+	}
+	if established, ok := rule["established"]; ok && established != "" {
+		value, err := strconv.ParseBool(established)
+		if err != nil {
+			return parsers.IPFilter{}, 1, fmt.Errorf("rule key \"established\" must be true or false, got %q", established)
+		}
+		filter.Established = value
+	}
+	for _, key := range []string{"icmp_type", "icmp_code"} {
+		value := strings.TrimSpace(rule[key])
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return parsers.IPFilter{}, 1, fmt.Errorf("rule key %q must be a number, got %q", key, value)
+		}
+		if key == "icmp_type" {
+			filter.ICMPType = &n
+		} else {
+			filter.ICMPCode = &n
+		}
+	}
+
+	for _, key := range []string{"source_port", "dest_port"} {
+		port := strings.TrimSpace(rule[key])
+		if port == "" {
+			continue
+		}
+		if err := parsers.ValidateIPFilterPort(port); err != nil {
+			return parsers.IPFilter{}, 1, fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	if err := parsers.ValidateIPFilter(filter); err != nil {
+		return parsers.IPFilter{}, 1, err
+	}
+	return filter, 0, nil
+}
@@ -0,0 +1,106 @@
+package ip_filter_rule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIPFilterRuleFunction_Run(t *testing.T) {
+	tests := []struct {
+		name    string
+		number  int64
+		rule    map[string]string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "any",
+			number: 100,
+			rule:   map[string]string{"action": "reject", "source": "10.0.0.0/8", "destination": "*", "protocol": "*"},
+			want:   "ip filter 100 reject 10.0.0.0/8 * *",
+		},
+		{
+			name:   "destination port only",
+			number: 200,
+			rule:   map[string]string{"action": "PASS", "source": "*", "destination": "192.168.1.10", "protocol": "TCP", "dest_port": "www"},
+			want:   "ip filter 200 pass * 192.168.1.10 tcp * www",
+		},
+		{
+			name:   "established",
+			number: 300,
+			rule:   map[string]string{"action": "pass", "source": "*", "destination": "*", "protocol": "tcp", "source_port": "*", "dest_port": "1024-", "established": "true"},
+			want:   "ip filter 300 pass * * tcp * 1024- established",
+		},
+		{
+			name:   "icmp type and code",
+			number: 400,
+			rule:   map[string]string{"action": "pass", "source": "*", "destination": "*", "protocol": "icmp", "icmp_type": "3", "icmp_code": "4"},
+			want:   "ip filter 400 pass * * icmp 3 4",
+		},
+		{
+			name:    "icmp type with tcp",
+			number:  401,
+			rule:    map[string]string{"action": "pass", "source": "*", "destination": "*", "protocol": "tcp", "icmp_type": "8"},
+			wantErr: true,
+		},
+		{
+			name:    "number out of range",
+			number:  0,
+			rule:    map[string]string{"action": "pass", "source": "*", "destination": "*", "protocol": "tcp"},
+			wantErr: true,
+		},
+		{
+			name:    "missing protocol",
+			number:  1,
+			rule:    map[string]string{"action": "pass", "source": "*", "destination": "*"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown key",
+			number:  1,
+			rule:    map[string]string{"action": "pass", "source": "*", "destination": "*", "protocol": "tcp", "dst_port": "80"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid port",
+			number:  1,
+			rule:    map[string]string{"action": "pass", "source": "*", "destination": "*", "protocol": "tcp", "dest_port": "http"},
+			wantErr: true,
+		},
+		{
+			name:    "established without tcp",
+			number:  1,
+			rule:    map[string]string{"action": "pass", "source": "*", "destination": "*", "protocol": "udp", "established": "true"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, diags := types.MapValueFrom(context.Background(), types.StringType, tt.rule)
+			if diags.HasError() {
+				t.Fatalf("MapValueFrom returned errors: %v", diags.Errors())
+			}
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.Int64Value(tt.number), rule})}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			NewIPFilterRuleFunction().Run(context.Background(), req, resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Errorf("Run() returned no error")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("Run() returned error: %v", resp.Error)
+			}
+			if got := resp.Result.Value(); !got.Equal(types.StringValue(tt.want)) {
+				t.Errorf("Run() = %s, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package normalize_mac
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NormalizeMACFunction{}
+
+// NewNormalizeMACFunction creates a new normalize_mac function.
+func NewNormalizeMACFunction() function.Function {
+	return &NormalizeMACFunction{}
+}
+
+// NormalizeMACFunction converts a MAC address to the format the provider stores.
+type NormalizeMACFunction struct{}
+
+// Metadata returns the function name.
+func (f *NormalizeMACFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_mac"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *NormalizeMACFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalizes a MAC address to lowercase colon-separated form",
+		Description: "Converts a MAC address written with colons, hyphens, Cisco dot notation or no separators " +
+			"to the lowercase colon-separated form the provider stores, e.g. \"0011.2233.44AA\" to \"00:11:22:33:44:aa\".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "mac",
+				Description: "MAC address (e.g., 00-11-22-33-44-AA or 0011.2233.44aa).",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run normalizes the MAC address argument.
+func (f *NormalizeMACFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var mac string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &mac))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := parsers.NormalizeMACAddress(mac)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
@@ -0,0 +1,47 @@
+package normalize_mac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeMACFunction_Run(t *testing.T) {
+	tests := []struct {
+		name    string
+		mac     string
+		want    string
+		wantErr bool
+	}{
+		{name: "colon", mac: "00:11:22:33:44:AA", want: "00:11:22:33:44:aa"},
+		{name: "hyphen", mac: "00-11-22-33-44-aa", want: "00:11:22:33:44:aa"},
+		{name: "Cisco dot", mac: "0011.2233.44aa", want: "00:11:22:33:44:aa"},
+		{name: "bare", mac: "0011223344AA", want: "00:11:22:33:44:aa"},
+		{name: "too short", mac: "00:11:22:33:44", wantErr: true},
+		{name: "not hex", mac: "00:11:22:33:44:zz", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.mac)})}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			NewNormalizeMACFunction().Run(context.Background(), req, resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Errorf("Run(%q) returned no error", tt.mac)
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("Run(%q) returned error: %v", tt.mac, resp.Error)
+			}
+			if got := resp.Result.Value(); !got.Equal(types.StringValue(tt.want)) {
+				t.Errorf("Run(%q) = %s, want %q", tt.mac, got, tt.want)
+			}
+		})
+	}
+}
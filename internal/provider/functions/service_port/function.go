@@ -0,0 +1,85 @@
+package service_port
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ServicePortFunction{}
+
+// NewServicePortFunction creates a new service_port function.
+func NewServicePortFunction() function.Function {
+	return &ServicePortFunction{}
+}
+
+// ServicePortFunction converts between RTX service keywords and port numbers.
+type ServicePortFunction struct{}
+
+// Metadata returns the function name.
+func (f *ServicePortFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "service_port"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *ServicePortFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts between RTX service keywords and port numbers",
+		Description: "Converts a service keyword the router accepts in IP filters to its port numbers, " +
+			"e.g. \"www\" to \"80\" and \"ftp\" to \"20,21\", and port numbers back to their keyword, e.g. \"587\" to \"submission\". " +
+			"Port numbers without a keyword are returned unchanged. Unknown keywords are an error.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "service",
+				Description: "Service keyword (e.g., www, domain, smtp, submission) or port numbers (e.g., 80 or 20,21).",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run converts the service argument.
+func (f *ServicePortFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var service string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &service))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := convert(service)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// convert returns the ports of a keyword, or the keyword of port numbers
+func convert(service string) (string, error) {
+	if ports, ok := parsers.ServicePorts(service); ok {
+		return ports, nil
+	}
+
+	var numbers []string
+	for _, part := range strings.Split(service, ",") {
+		part = strings.TrimSpace(part)
+		port, err := strconv.Atoi(part)
+		if err != nil {
+			return "", fmt.Errorf("unknown service keyword %q", service)
+		}
+		if port < 0 || port > 65535 {
+			return "", fmt.Errorf("port %d is out of range 0-65535", port)
+		}
+		numbers = append(numbers, part)
+	}
+	if keyword, ok := parsers.PortService(service); ok {
+		return keyword, nil
+	}
+	return strings.Join(numbers, ","), nil
+}
@@ -0,0 +1,48 @@
+package service_port
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServicePortFunction_Run(t *testing.T) {
+	tests := []struct {
+		name    string
+		service string
+		want    string
+		wantErr bool
+	}{
+		{name: "keyword", service: "www", want: "80"},
+		{name: "keyword with two ports", service: "ftp", want: "20,21"},
+		{name: "port", service: "587", want: "submission"},
+		{name: "ports", service: "20, 21", want: "ftp"},
+		{name: "port without keyword", service: "8080", want: "8080"},
+		{name: "unknown keyword", service: "http", wantErr: true},
+		{name: "port out of range", service: "70000", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.service)})}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			NewServicePortFunction().Run(context.Background(), req, resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Errorf("Run(%q) returned no error", tt.service)
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("Run(%q) returned error: %v", tt.service, resp.Error)
+			}
+			if got := resp.Result.Value(); !got.Equal(types.StringValue(tt.want)) {
+				t.Errorf("Run(%q) = %s, want %q", tt.service, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package fwhelpers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+// AppliedCommandsAttributeName is the attribute of resources that keep the
+// commands their last create or update sent to the router.
+const AppliedCommandsAttributeName = "applied_commands"
+
+// AppliedCommandsAttribute returns the schema of the applied_commands
+// attribute. It is set after every create and update and never causes a
+// change by itself.
+func AppliedCommandsAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: "The RTX commands the last create or update sent to the router, in order, with secrets redacted. " +
+			"Useful for change tickets or to replay the change manually.",
+		ElementType: types.StringType,
+		Computed:    true,
+	}
+}
+
+// setAppliedCommands stores the commands recorded in log in the
+// applied_commands attribute of state. Resources without the attribute are
+// ignored.
+func setAppliedCommands(ctx context.Context, log *client.CommandLog, state *tfsdk.State, diags *diag.Diagnostics) {
+	if state.Raw.IsNull() {
+		return
+	}
+	if _, attrDiags := state.Schema.AttributeAtPath(ctx, path.Root(AppliedCommandsAttributeName)); attrDiags.HasError() {
+		return
+	}
+
+	// An apply that changed nothing on the router leaves an empty list, not null
+	sent := log.Commands()
+	if sent == nil {
+		sent = []string{}
+	}
+	commands, listDiags := types.ListValueFrom(ctx, types.StringType, sent)
+	diags.Append(listDiags...)
+	if listDiags.HasError() {
+		return
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root(AppliedCommandsAttributeName), commands)...)
+}
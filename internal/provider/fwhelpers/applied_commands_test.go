@@ -0,0 +1,67 @@
+package fwhelpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+var appliedCommandsTestSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name":             schema.StringAttribute{Required: true},
+		"applied_commands": AppliedCommandsAttribute(),
+	},
+}
+
+func appliedCommandsTestValue(name string) tftypes.Value {
+	listType := tftypes.List{ElementType: tftypes.String}
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String, "applied_commands": listType}}, map[string]tftypes.Value{
+		"name":             tftypes.NewValue(tftypes.String, name),
+		"applied_commands": tftypes.NewValue(listType, tftypes.UnknownValue),
+	})
+}
+
+func TestAppliedCommands(t *testing.T) {
+	ctx := context.Background()
+	inner := &previewTestResource{}
+	r := WrapResources([]func() resource.Resource{func() resource.Resource { return inner }})[0]()
+
+	t.Run("create", func(t *testing.T) {
+		resp := &resource.CreateResponse{State: tfsdk.State{Schema: appliedCommandsTestSchema, Raw: appliedCommandsTestValue("a")}}
+		r.Create(ctx, resource.CreateRequest{}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		var commands types.List
+		resp.State.GetAttribute(ctx, path.Root("applied_commands"), &commands)
+		assert.False(t, commands.IsUnknown())
+		assert.False(t, commands.IsNull())
+		assert.Empty(t, commands.Elements())
+	})
+
+	t.Run("update", func(t *testing.T) {
+		resp := &resource.UpdateResponse{State: tfsdk.State{Schema: appliedCommandsTestSchema, Raw: appliedCommandsTestValue("b")}}
+		r.Update(ctx, resource.UpdateRequest{}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		var commands types.List
+		resp.State.GetAttribute(ctx, path.Root("applied_commands"), &commands)
+		assert.False(t, commands.IsUnknown())
+	})
+
+	t.Run("resource without attribute", func(t *testing.T) {
+		resp := &resource.CreateResponse{State: tfsdk.State{Schema: previewTestSchema, Raw: previewTestValue("a")}}
+		r.Create(ctx, resource.CreateRequest{}, resp)
+
+		assert.Empty(t, resp.Diagnostics)
+		assert.True(t, resp.State.Raw.Equal(previewTestValue("a")))
+	})
+
+	assert.Equal(t, []string{"create", "update", "create"}, inner.calls)
+}
@@ -0,0 +1,47 @@
+package fwhelpers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// verifyApply reads the resource back from the router after an apply and
+// fails when the router configuration does not match the plan. RTX routers
+// accept some commands without an error and then ignore or rewrite them;
+// like a drift report, the planned and the read-back state are both dry-run
+// through Create and the router lines they configure are compared.
+func (r *resourceWrapper) verifyApply(ctx context.Context, plan tfsdk.Plan, state tfsdk.State, diags *diag.Diagnostics) {
+	if !r.verify || r.client == nil || diags.HasError() || state.Raw.IsNull() {
+		return
+	}
+
+	summary := fmt.Sprintf("Apply verification failed for %s", r.typeName)
+	readResp := resource.ReadResponse{State: tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()}}
+	r.Resource.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		errs := readResp.Diagnostics.Errors()
+		diags.AddError(summary, fmt.Sprintf("The configuration could not be read back from the router: %s: %s", errs[0].Summary(), errs[0].Detail()))
+		return
+	}
+	if readResp.State.Raw.IsNull() {
+		diags.AddError(summary, "The configuration was sent, but the router configuration does not contain the resource. The router may have rejected a command.")
+		return
+	}
+
+	lines := driftLines(
+		r.createCommands(ctx, tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}),
+		r.createCommands(ctx, readResp.State),
+	)
+	if len(lines) == 0 {
+		return
+	}
+	diags.AddError(
+		summary,
+		"The router configuration differs from what was sent (- sent, + on the router); the router may have rejected or rewritten a command:\n"+strings.Join(lines, "\n"),
+	)
+}
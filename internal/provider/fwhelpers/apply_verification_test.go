@@ -0,0 +1,45 @@
+package fwhelpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyApply(t *testing.T) {
+	tests := []struct {
+		name      string
+		enabled   bool
+		removed   bool
+		wantCalls []string
+		wantError bool
+	}{
+		{name: "disabled", enabled: false, wantCalls: []string{"create"}},
+		{name: "matches plan", enabled: true, wantCalls: []string{"create", "create", "create"}},
+		{name: "missing on router", enabled: true, removed: true, wantCalls: []string{"create"}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &previewTestResource{removed: tt.removed}
+			r := WrapResources([]func() resource.Resource{func() resource.Resource { return inner }})[0]().(*resourceWrapper)
+			ctx := context.Background()
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "rtx"}, &resource.MetadataResponse{})
+			r.Configure(ctx, resource.ConfigureRequest{ProviderData: &ProviderData{Client: previewTestClient{}, VerifyApply: tt.enabled}}, &resource.ConfigureResponse{})
+
+			plan := tfsdk.Plan{Schema: previewTestSchema, Raw: previewTestValue("a")}
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: previewTestSchema, Raw: previewTestValue("a")}}
+			r.Create(ctx, resource.CreateRequest{Config: tfsdk.Config{Schema: previewTestSchema, Raw: plan.Raw}, Plan: plan}, resp)
+
+			assert.Equal(t, tt.wantCalls, inner.calls)
+			assert.False(t, inner.preview[0], "the apply itself must not be dry-run")
+			for _, inPreview := range inner.preview[1:] {
+				assert.True(t, inPreview, "verification commands must be dry-run")
+			}
+			assert.Equal(t, tt.wantError, resp.Diagnostics.HasError())
+		})
+	}
+}
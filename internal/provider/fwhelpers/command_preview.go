@@ -0,0 +1,60 @@
+package fwhelpers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+// commandPreviewTimeout bounds the dry run of a single resource during plan.
+const commandPreviewTimeout = time.Minute
+
+// previewCommands dry-runs the planned change against the router and shows
+// the commands it would send as a warning in the plan.
+func (r *resourceWrapper) previewCommands(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	action := planAction(req, resp)
+	if action == "" {
+		return
+	}
+
+	previewCtx, preview := client.WithCommandPreview(ctx)
+	previewCtx, cancel := context.WithTimeout(previewCtx, commandPreviewTimeout)
+	defer cancel()
+
+	var previewDiags diag.Diagnostics
+	if action == "destroy" || action == "replace" {
+		deleteResp := resource.DeleteResponse{State: req.State}
+		r.Resource.Delete(previewCtx, resource.DeleteRequest{State: req.State, ProviderMeta: req.ProviderMeta}, &deleteResp)
+		previewDiags.Append(deleteResp.Diagnostics...)
+	}
+	if action == "create" || action == "replace" {
+		createResp := resource.CreateResponse{State: tfsdk.State{Schema: resp.Plan.Schema, Raw: resp.Plan.Raw.Copy()}}
+		r.Resource.Create(previewCtx, resource.CreateRequest{Config: req.Config, Plan: resp.Plan, ProviderMeta: req.ProviderMeta}, &createResp)
+		previewDiags.Append(createResp.Diagnostics...)
+	}
+	if action == "update" {
+		updateResp := resource.UpdateResponse{State: tfsdk.State{Schema: resp.Plan.Schema, Raw: resp.Plan.Raw.Copy()}}
+		r.Resource.Update(previewCtx, resource.UpdateRequest{Config: req.Config, Plan: resp.Plan, State: req.State, ProviderMeta: req.ProviderMeta}, &updateResp)
+		previewDiags.Append(updateResp.Diagnostics...)
+	}
+
+	summary := fmt.Sprintf("RTX commands to %s %s", action, r.typeName)
+	commands := preview.Commands()
+	if len(commands) == 0 {
+		// Operations often read back what they wrote, which fails in a dry run;
+		// such errors only matter when no command could be previewed at all
+		if previewDiags.HasError() {
+			errs := previewDiags.Errors()
+			resp.Diagnostics.AddWarning(summary, fmt.Sprintf("The commands could not be previewed: %s: %s", errs[0].Summary(), errs[0].Detail()))
+		}
+		return
+	}
+	resp.Diagnostics.AddWarning(summary, strings.Join(commands, "\n"))
+}
@@ -0,0 +1,145 @@
+package fwhelpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+// previewTestClient satisfies client.Client and reports model as the router model
+type previewTestClient struct {
+	client.Client
+	model   string
+	saveErr error
+}
+
+func (c previewTestClient) LockDevice(ctx context.Context) (context.Context, func(), error) {
+	return ctx, func() {}, nil
+}
+
+func (c previewTestClient) BeginChange() func(ctx context.Context) error {
+	return func(ctx context.Context) error { return c.saveErr }
+}
+
+func (c previewTestClient) DetectModel(ctx context.Context) (*client.SystemInfo, error) {
+	return &client.SystemInfo{Model: c.model}, nil
+}
+
+// previewTestResource records which operations ran and whether they ran in a preview
+type previewTestResource struct {
+	typeName string
+	calls    []string
+	preview  []bool
+	removed  bool // Read finds the resource removed from the router
+}
+
+func (r *previewTestResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "rtx_test"
+	if r.typeName != "" {
+		resp.TypeName = r.typeName
+	}
+}
+
+func (r *previewTestResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = previewTestSchema
+}
+
+func (r *previewTestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.calls = append(r.calls, "create")
+	r.preview = append(r.preview, client.IsCommandPreview(ctx))
+}
+
+func (r *previewTestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.removed {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r *previewTestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.calls = append(r.calls, "update")
+	r.preview = append(r.preview, client.IsCommandPreview(ctx))
+}
+
+func (r *previewTestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.calls = append(r.calls, "delete")
+	r.preview = append(r.preview, client.IsCommandPreview(ctx))
+}
+
+var previewTestSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{Required: true},
+	},
+}
+
+func previewTestValue(name string) tftypes.Value {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}
+	if name == "" {
+		return tftypes.NewValue(objectType, nil)
+	}
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, name)})
+}
+
+// modifyPlan runs the wrapped inner resource's ModifyPlan for a change from state to plan
+func modifyPlan(inner *previewTestResource, providerData *ProviderData, state, plan string, replace bool) *resource.ModifyPlanResponse {
+	r := WrapResources([]func() resource.Resource{func() resource.Resource { return inner }})[0]().(*resourceWrapper)
+
+	ctx := context.Background()
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "rtx"}, &resource.MetadataResponse{})
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: providerData}, &resource.ConfigureResponse{})
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: previewTestSchema, Raw: previewTestValue(plan)},
+		State:  tfsdk.State{Schema: previewTestSchema, Raw: previewTestValue(state)},
+		Plan:   tfsdk.Plan{Schema: previewTestSchema, Raw: previewTestValue(plan)},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	if replace {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("name"))
+	}
+	r.ModifyPlan(ctx, req, resp)
+	return resp
+}
+
+func runPreviewModifyPlan(t *testing.T, enabled bool, state, plan string, replace bool) *previewTestResource {
+	t.Helper()
+	inner := &previewTestResource{}
+	resp := modifyPlan(inner, &ProviderData{Client: previewTestClient{}, PlanCommands: enabled}, state, plan, replace)
+	assert.False(t, resp.Diagnostics.HasError())
+	return inner
+}
+
+func TestCommandPreview_ModifyPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		state   string
+		plan    string
+		replace bool
+		want    []string
+	}{
+		{name: "disabled", enabled: false, plan: "a"},
+		{name: "create", enabled: true, plan: "a", want: []string{"create"}},
+		{name: "update", enabled: true, state: "a", plan: "b", want: []string{"update"}},
+		{name: "replace", enabled: true, state: "a", plan: "b", replace: true, want: []string{"delete", "create"}},
+		{name: "destroy", enabled: true, state: "a", want: []string{"delete"}},
+		{name: "no change", enabled: true, state: "a", plan: "a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := runPreviewModifyPlan(t, tt.enabled, tt.state, tt.plan, tt.replace)
+			assert.Equal(t, tt.want, inner.calls)
+			for _, inPreview := range inner.preview {
+				assert.True(t, inPreview, "operations must run in a command preview")
+			}
+		})
+	}
+}
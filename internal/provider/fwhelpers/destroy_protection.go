@@ -0,0 +1,67 @@
+package fwhelpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+// PreventDestroyOnDeviceAttributeName is the attribute of resources whose
+// destroy can be limited to the Terraform state, leaving the router as is.
+const PreventDestroyOnDeviceAttributeName = "prevent_destroy_on_device"
+
+// PreventDestroyOnDeviceAttribute returns the schema of the
+// prevent_destroy_on_device attribute of resources whose removal can cut off
+// management access to the router.
+func PreventDestroyOnDeviceAttribute(subject string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("Keep the %s on the router when the resource is destroyed or replaced; "+
+			"the resource is only removed from the Terraform state, with a warning. "+
+			"Must be applied before the destroy to take effect. Defaults to false.", subject),
+		Optional: true,
+	}
+}
+
+// DestroyPrevented reports whether the deletion of subject from the router is
+// skipped because prevent is true, and warns about it.
+func DestroyPrevented(ctx context.Context, prevent types.Bool, subject string, diags *diag.Diagnostics) bool {
+	if !prevent.ValueBool() {
+		return false
+	}
+
+	logging.FromContext(ctx).Warn().Msgf("Keeping %s on the router because prevent_destroy_on_device is set", subject)
+	diags.AddWarning(
+		"Resource Kept on Router",
+		fmt.Sprintf("%s was removed from the Terraform state but left on the router, because prevent_destroy_on_device is true. "+
+			"Remove it on the router manually if it is no longer needed.", subject),
+	)
+	return true
+}
+
+// warnPreventedDestroy warns at plan time that destroying or replacing a
+// resource with prevent_destroy_on_device set leaves the router unchanged.
+// Resources without the attribute are ignored.
+func (r *resourceWrapper) warnPreventedDestroy(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	action := planAction(req, resp)
+	if action != "destroy" && action != "replace" {
+		return
+	}
+
+	var prevent types.Bool
+	if diags := req.State.GetAttribute(ctx, path.Root(PreventDestroyOnDeviceAttributeName), &prevent); diags.HasError() || !prevent.ValueBool() {
+		return
+	}
+
+	detail := fmt.Sprintf("%s has prevent_destroy_on_device set, so destroying it only removes it from the Terraform state and leaves the router unchanged.", r.typeName)
+	if action == "replace" {
+		detail += " The replacement is then created over the existing router configuration."
+	}
+	resp.Diagnostics.AddWarning("Destroy Prevented on Router", detail)
+}
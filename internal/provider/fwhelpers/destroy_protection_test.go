@@ -0,0 +1,110 @@
+package fwhelpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+var protectedTestSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name":                      schema.StringAttribute{Required: true},
+		"prevent_destroy_on_device": PreventDestroyOnDeviceAttribute("test"),
+	},
+}
+
+func protectedTestValue(name string, prevent *bool) tftypes.Value {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String, "prevent_destroy_on_device": tftypes.Bool}}
+	if name == "" {
+		return tftypes.NewValue(objectType, nil)
+	}
+	var preventValue tftypes.Value
+	if prevent == nil {
+		preventValue = tftypes.NewValue(tftypes.Bool, nil)
+	} else {
+		preventValue = tftypes.NewValue(tftypes.Bool, *prevent)
+	}
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"name":                      tftypes.NewValue(tftypes.String, name),
+		"prevent_destroy_on_device": preventValue,
+	})
+}
+
+func TestDestroyPrevented(t *testing.T) {
+	tests := []struct {
+		name    string
+		prevent types.Bool
+		want    bool
+	}{
+		{"null", types.BoolNull(), false},
+		{"false", types.BoolValue(false), false},
+		{"true", types.BoolValue(true), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := DestroyPrevented(context.Background(), tt.prevent, "admin user foo", &diags)
+			assert.Equal(t, tt.want, got)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tt.want, diags.WarningsCount() == 1)
+		})
+	}
+}
+
+func TestWarnPreventedDestroy(t *testing.T) {
+	on, off := true, false
+	tests := []struct {
+		name        string
+		state       string
+		prevent     *bool
+		plan        string
+		replace     bool
+		wantWarning bool
+	}{
+		{"destroy protected", "a", &on, "", false, true},
+		{"replace protected", "a", &on, "b", true, true},
+		{"update protected", "a", &on, "b", false, false},
+		{"destroy unprotected", "a", &off, "", false, false},
+		{"destroy unset", "a", nil, "", false, false},
+		{"create", "", nil, "a", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &resourceWrapper{typeName: "rtx_test"}
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: protectedTestSchema, Raw: protectedTestValue(tt.state, tt.prevent)},
+				Plan:  tfsdk.Plan{Schema: protectedTestSchema, Raw: protectedTestValue(tt.plan, tt.prevent)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			if tt.replace {
+				resp.RequiresReplace = append(resp.RequiresReplace, path.Root("name"))
+			}
+
+			r.warnPreventedDestroy(context.Background(), req, resp)
+
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.wantWarning, resp.Diagnostics.WarningsCount() == 1)
+		})
+	}
+
+	t.Run("resource without attribute", func(t *testing.T) {
+		r := &resourceWrapper{typeName: "rtx_test"}
+		req := resource.ModifyPlanRequest{
+			State: tfsdk.State{Schema: previewTestSchema, Raw: previewTestValue("a")},
+			Plan:  tfsdk.Plan{Schema: previewTestSchema, Raw: previewTestValue("")},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+		r.warnPreventedDestroy(context.Background(), req, resp)
+
+		assert.Empty(t, resp.Diagnostics)
+	})
+}
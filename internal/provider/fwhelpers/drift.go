@@ -0,0 +1,79 @@
+package fwhelpers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+// importedKey marks in private state that the next read follows an import,
+// when the prior state only holds the import ID and cannot drift
+const importedKey = "rtx_imported"
+
+// reportDrift warns when the router configuration of a resource changed
+// since the last apply. The struct fields that changed are in the plan
+// already; the warning shows the router lines themselves: the commands the
+// last applied state configures next to the commands the state read from
+// the router configures, both dry-run through Create.
+func (r *resourceWrapper) reportDrift(ctx context.Context, prior, current tfsdk.State, diags *diag.Diagnostics) {
+	if prior.Raw.IsNull() || current.Raw.IsNull() || prior.Raw.Equal(current.Raw) {
+		return
+	}
+
+	lines := driftLines(r.createCommands(ctx, prior), r.createCommands(ctx, current))
+	if len(lines) == 0 {
+		return
+	}
+	diags.AddWarning(
+		fmt.Sprintf("Configuration drift on %s", r.typeName),
+		"The router configuration was changed outside Terraform (- last applied, + on the router):\n"+strings.Join(lines, "\n"),
+	)
+}
+
+// createCommands returns the commands that create the resource in state,
+// recorded in a command preview without sending them
+func (r *resourceWrapper) createCommands(ctx context.Context, state tfsdk.State) []string {
+	previewCtx, preview := client.WithCommandPreview(ctx)
+	previewCtx, cancel := context.WithTimeout(previewCtx, commandPreviewTimeout)
+	defer cancel()
+
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()}}
+	r.Resource.Create(previewCtx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw.Copy()},
+		Plan:   tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()},
+	}, &createResp)
+	return preview.Commands()
+}
+
+// driftLines returns the lines only expected, prefixed with "- ", followed by
+// the lines only on the router, prefixed with "+ ". Lines in both, such as
+// "save", are left out; repeated lines are matched one for one.
+func driftLines(expected, actual []string) []string {
+	remaining := make(map[string]int)
+	for _, line := range actual {
+		remaining[line]++
+	}
+	var removed []string
+	for _, line := range expected {
+		if remaining[line] > 0 {
+			remaining[line]--
+			continue
+		}
+		removed = append(removed, "- "+line)
+	}
+
+	var added []string
+	for _, line := range actual {
+		if remaining[line] > 0 {
+			remaining[line]--
+			added = append(added, "+ "+line)
+		}
+	}
+	return append(removed, added...)
+}
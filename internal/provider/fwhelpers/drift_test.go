@@ -0,0 +1,87 @@
+package fwhelpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriftLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected []string
+		actual   []string
+		want     []string
+	}{
+		{
+			name:     "changed line",
+			expected: []string{"ip lan1 address 192.168.1.1/24", "ip lan1 mtu 1500", "save"},
+			actual:   []string{"ip lan1 address 192.168.1.2/24", "ip lan1 mtu 1500", "save"},
+			want:     []string{"- ip lan1 address 192.168.1.1/24", "+ ip lan1 address 192.168.1.2/24"},
+		},
+		{
+			name:     "added line",
+			expected: []string{"ip filter 100 pass * * tcp * www"},
+			actual:   []string{"ip filter 100 pass * * tcp * www", "ip filter 101 pass * * tcp * ftp"},
+			want:     []string{"+ ip filter 101 pass * * tcp * ftp"},
+		},
+		{
+			name:     "repeated lines match one for one",
+			expected: []string{"save", "save"},
+			actual:   []string{"save"},
+			want:     []string{"- save"},
+		},
+		{
+			name:     "no drift",
+			expected: []string{"ip lan1 mtu 1500"},
+			actual:   []string{"ip lan1 mtu 1500"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, driftLines(tt.expected, tt.actual))
+		})
+	}
+}
+
+func TestReportDrift(t *testing.T) {
+	tests := []struct {
+		name        string
+		prior       string
+		current     string
+		wantCreates []string
+	}{
+		{name: "changed on router", prior: "a", current: "b", wantCreates: []string{"create", "create"}},
+		{name: "unchanged", prior: "a", current: "a"},
+		{name: "removed from router", prior: "a", current: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &previewTestResource{}
+			r := WrapResources([]func() resource.Resource{func() resource.Resource { return inner }})[0]().(*resourceWrapper)
+			ctx := context.Background()
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "rtx"}, &resource.MetadataResponse{})
+
+			var diags diag.Diagnostics
+			r.reportDrift(ctx,
+				tfsdk.State{Schema: previewTestSchema, Raw: previewTestValue(tt.prior)},
+				tfsdk.State{Schema: previewTestSchema, Raw: previewTestValue(tt.current)},
+				&diags)
+
+			assert.Equal(t, tt.wantCreates, inner.calls)
+			for _, inPreview := range inner.preview {
+				assert.True(t, inPreview, "drift commands must be dry-run")
+			}
+			// The test resource sends no commands, so there are no lines to show
+			require.False(t, diags.HasError())
+			assert.Empty(t, diags.Warnings())
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package fwhelpers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+// filterKind distinguishes the number spaces of static and dynamic IP filters
+type filterKind string
+
+const (
+	staticFilter  filterKind = "IP filter"
+	dynamicFilter filterKind = "dynamic IP filter"
+)
+
+// filterAttribute is a plan attribute holding IP filter numbers, either a
+// number or a list of numbers
+type filterAttribute struct {
+	expression path.Expression
+	kind       filterKind
+}
+
+// filterDefinitions lists the attributes of the resources that create IP
+// filters
+var filterDefinitions = map[string][]filterAttribute{
+	"rtx_access_list_ip":         {{path.MatchRoot("entry").AtAnyListIndex().AtName("sequence"), staticFilter}},
+	"rtx_access_list_extended":   {{path.MatchRoot("entry").AtAnyListIndex().AtName("sequence"), staticFilter}},
+	"rtx_access_list_ip_dynamic": {{path.MatchRoot("entry").AtAnyListIndex().AtName("sequence"), dynamicFilter}},
+}
+
+// filterReferences lists the attributes of the resources that refer to IP
+// filters by number
+var filterReferences = map[string][]filterAttribute{
+	"rtx_access_list_ip": {
+		{path.MatchRoot("apply").AtAnyListIndex().AtName("sequences"), staticFilter},
+		{path.MatchRoot("apply").AtAnyListIndex().AtName("dynamic_sequences"), dynamicFilter},
+	},
+	"rtx_access_list_ip_apply": {{path.MatchRoot("sequences"), staticFilter}},
+	"rtx_ipsec_tunnel": {
+		{path.MatchRoot("secure_filter_in"), staticFilter},
+		{path.MatchRoot("secure_filter_out"), staticFilter},
+	},
+	"rtx_tunnel": {
+		{path.MatchRoot("ipsec").AtName("secure_filter_in"), staticFilter},
+		{path.MatchRoot("ipsec").AtName("secure_filter_out"), staticFilter},
+	},
+	"rtx_static_route": {{path.MatchRoot("next_hop").AtAnyListIndex().AtName("filter"), staticFilter}},
+}
+
+// FilterRegistry records the IP filter numbers planned by the resources of
+// the configuration, so that references to filters that are created in the
+// same apply pass the plan-time check. A nil registry records nothing.
+type FilterRegistry struct {
+	mu      sync.Mutex
+	planned map[filterKind]map[int64]bool
+}
+
+// NewFilterRegistry creates an empty filter registry.
+func NewFilterRegistry() *FilterRegistry {
+	return &FilterRegistry{planned: make(map[filterKind]map[int64]bool)}
+}
+
+func (f *FilterRegistry) add(kind filterKind, number int64) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.planned[kind] == nil {
+		f.planned[kind] = make(map[int64]bool)
+	}
+	f.planned[kind][number] = true
+}
+
+func (f *FilterRegistry) has(kind filterKind, number int64) bool {
+	if f == nil {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.planned[kind][number]
+}
+
+// checkFilterReferences records the filters a resource plans and rejects
+// references to filters that are neither planned nor on the router.
+//
+// Terraform plans a resource after the resources it refers to, so filters
+// referenced through another resource's attributes are always recorded
+// first. A filter number written as a literal has no such ordering, neither
+// at plan nor at apply time, and must already exist on the router.
+func (r *resourceWrapper) checkFilterReferences(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if resp.Plan.Raw.IsNull() {
+		return
+	}
+
+	for _, definition := range filterDefinitions[r.typeName] {
+		for _, number := range r.planFilterNumbers(ctx, resp, definition.expression) {
+			r.filters.add(definition.kind, number.value)
+		}
+	}
+
+	references, ok := filterReferences[r.typeName]
+	if !ok || planAction(req, resp) == "" {
+		return
+	}
+
+	existing := make(map[filterKind]map[int64]bool)
+	for _, reference := range references {
+		for _, number := range r.planFilterNumbers(ctx, resp, reference.expression) {
+			if number.value == 0 || r.filters.has(reference.kind, number.value) {
+				continue
+			}
+			if existing[reference.kind] == nil {
+				numbers, err := r.routerFilters(ctx, reference.kind)
+				if err != nil {
+					logging.FromContext(ctx).Warn().Err(err).Str("resource", r.typeName).Msg("Could not read IP filters for plan-time validation")
+					return
+				}
+				existing[reference.kind] = numbers
+			}
+			if !existing[reference.kind][number.value] {
+				resp.Diagnostics.AddAttributeError(number.path,
+					"Undefined Filter Reference",
+					fmt.Sprintf("%s %d is neither defined in this configuration nor present on the router. "+
+						"Refer to a filter created in the same configuration through the attributes of its resource, "+
+						"so that it is created first.", reference.kind, number.value),
+				)
+			}
+		}
+	}
+}
+
+// filterNumber is a known filter number at path in the plan
+type filterNumber struct {
+	path  path.Path
+	value int64
+}
+
+// planFilterNumbers returns the known filter numbers of the plan attributes
+// matching expression
+func (r *resourceWrapper) planFilterNumbers(ctx context.Context, resp *resource.ModifyPlanResponse, expression path.Expression) []filterNumber {
+	paths, diags := resp.Plan.PathMatches(ctx, expression)
+	if diags.HasError() {
+		return nil
+	}
+
+	var numbers []filterNumber
+	for _, p := range paths {
+		var value attr.Value
+		if diags := resp.Plan.GetAttribute(ctx, p, &value); diags.HasError() {
+			continue
+		}
+		switch v := value.(type) {
+		case types.Int64:
+			if !v.IsNull() && !v.IsUnknown() {
+				numbers = append(numbers, filterNumber{path: p, value: v.ValueInt64()})
+			}
+		case types.List:
+			for i, element := range v.Elements() {
+				if n, ok := element.(types.Int64); ok && !n.IsNull() && !n.IsUnknown() {
+					numbers = append(numbers, filterNumber{path: p.AtListIndex(i), value: n.ValueInt64()})
+				}
+			}
+		}
+	}
+	return numbers
+}
+
+// routerFilters returns the numbers of the filters of a kind on the router
+func (r *resourceWrapper) routerFilters(ctx context.Context, kind filterKind) (map[int64]bool, error) {
+	var sequences []int
+	var err error
+	if kind == dynamicFilter {
+		sequences, err = r.client.GetAllIPFilterDynamicSequences(ctx)
+	} else {
+		sequences, err = r.client.GetAllIPFilterSequences(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	numbers := make(map[int64]bool, len(sequences))
+	for _, seq := range sequences {
+		numbers[int64(seq)] = true
+	}
+	return numbers, nil
+}
@@ -0,0 +1,150 @@
+package fwhelpers
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// filterTestClient reports the static and dynamic filters on the router
+type filterTestClient struct {
+	previewTestClient
+	static  []int
+	dynamic []int
+}
+
+func (c filterTestClient) GetAllIPFilterSequences(ctx context.Context) ([]int, error) {
+	return c.static, nil
+}
+
+func (c filterTestClient) GetAllIPFilterDynamicSequences(ctx context.Context) ([]int, error) {
+	return c.dynamic, nil
+}
+
+var filterDefinitionTestSchema = schema.Schema{
+	Blocks: map[string]schema.Block{
+		"entry": schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"sequence": schema.Int64Attribute{Optional: true, Computed: true},
+				},
+			},
+		},
+	},
+}
+
+var filterReferenceTestSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{Required: true},
+	},
+	Blocks: map[string]schema.Block{
+		"ipsec": schema.SingleNestedBlock{
+			Attributes: map[string]schema.Attribute{
+				"secure_filter_in": schema.ListAttribute{Optional: true, ElementType: types.Int64Type},
+			},
+		},
+	},
+}
+
+func filterDefinitionTestValue(sequences ...int64) tftypes.Value {
+	entryType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"sequence": tftypes.Number}}
+	var entries []tftypes.Value
+	for _, seq := range sequences {
+		value := tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)
+		if seq != 0 {
+			value = tftypes.NewValue(tftypes.Number, big.NewFloat(float64(seq)))
+		}
+		entries = append(entries, tftypes.NewValue(entryType, map[string]tftypes.Value{"sequence": value}))
+	}
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"entry": tftypes.List{ElementType: entryType}}},
+		map[string]tftypes.Value{"entry": tftypes.NewValue(tftypes.List{ElementType: entryType}, entries)})
+}
+
+// filterReferenceTestValue returns a plan referring to filters, without an
+// ipsec block when filters is nil
+func filterReferenceTestValue(name string, filters []int64) tftypes.Value {
+	listType := tftypes.List{ElementType: tftypes.Number}
+	ipsecType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"secure_filter_in": listType}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String, "ipsec": ipsecType}}
+	if name == "" {
+		return tftypes.NewValue(objectType, nil)
+	}
+
+	ipsec := tftypes.NewValue(ipsecType, nil)
+	if filters != nil {
+		var elements []tftypes.Value
+		for _, filter := range filters {
+			elements = append(elements, tftypes.NewValue(tftypes.Number, big.NewFloat(float64(filter))))
+		}
+		ipsec = tftypes.NewValue(ipsecType, map[string]tftypes.Value{"secure_filter_in": tftypes.NewValue(listType, elements)})
+	}
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, name), "ipsec": ipsec})
+}
+
+func runFilterCheck(r *resourceWrapper, s schema.Schema, state, plan tftypes.Value) *resource.ModifyPlanResponse {
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: state},
+		Plan:  tfsdk.Plan{Schema: s, Raw: plan},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	r.checkFilterReferences(context.Background(), req, resp)
+	return resp
+}
+
+func TestCheckFilterReferences(t *testing.T) {
+	client := filterTestClient{static: []int{100}}
+	registry := NewFilterRegistry()
+
+	definer := &resourceWrapper{typeName: "rtx_access_list_ip", client: client, filters: registry}
+	resp := runFilterCheck(definer, filterDefinitionTestSchema,
+		tftypes.NewValue(filterDefinitionTestSchema.Type().TerraformType(context.Background()), nil), filterDefinitionTestValue(200, 0))
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.True(t, registry.has(staticFilter, 200))
+	assert.False(t, registry.has(dynamicFilter, 200))
+
+	tests := []struct {
+		name     string
+		state    tftypes.Value
+		plan     tftypes.Value
+		wantPath path.Path
+	}{
+		{name: "filters on router or planned", state: filterReferenceTestValue("", nil), plan: filterReferenceTestValue("a", []int64{100, 200})},
+		{name: "undefined filter", state: filterReferenceTestValue("", nil), plan: filterReferenceTestValue("a", []int64{100, 200, 300}),
+			wantPath: path.Root("ipsec").AtName("secure_filter_in").AtListIndex(2)},
+		{name: "undefined filter on update", state: filterReferenceTestValue("a", []int64{100}), plan: filterReferenceTestValue("a", []int64{300}),
+			wantPath: path.Root("ipsec").AtName("secure_filter_in").AtListIndex(0)},
+		{name: "no change", state: filterReferenceTestValue("a", []int64{300}), plan: filterReferenceTestValue("a", []int64{300})},
+		{name: "without block", state: filterReferenceTestValue("", nil), plan: filterReferenceTestValue("a", nil)},
+		{name: "destroy", state: filterReferenceTestValue("a", []int64{300}), plan: filterReferenceTestValue("", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &resourceWrapper{typeName: "rtx_tunnel", client: client, filters: registry}
+			resp := runFilterCheck(r, filterReferenceTestSchema, tt.state, tt.plan)
+			if len(tt.wantPath.Steps()) == 0 {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			if assert.Equal(t, 1, resp.Diagnostics.ErrorsCount(), resp.Diagnostics) {
+				assert.Contains(t, resp.Diagnostics[0].Detail(), "IP filter 300 is neither defined")
+				assert.Equal(t, tt.wantPath, resp.Diagnostics[0].(diag.DiagnosticWithPath).Path())
+			}
+		})
+	}
+}
+
+func TestFilterRegistry_Nil(t *testing.T) {
+	var registry *FilterRegistry
+	registry.add(staticFilter, 100)
+	assert.False(t, registry.has(staticFilter, 100))
+}
@@ -0,0 +1,67 @@
+package fwhelpers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// AddImportIDError adds the diagnostic for an import ID that does not have
+// the expected format. expected describes the format with an example, so the
+// error itself tells the user how to write the ID.
+func AddImportIDError(diags *diag.Diagnostics, id, expected string) {
+	diags.AddError(
+		"Invalid Import ID",
+		fmt.Sprintf("Invalid import ID %q, expected %s", id, expected),
+	)
+}
+
+// ImportSingleton imports a resource that exists once per router. The import
+// ID must be the resource's fixed ID, which is stored as "id".
+func ImportSingleton(ctx context.Context, fixedID string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != fixedID {
+		AddImportIDError(&resp.Diagnostics, req.ID, fmt.Sprintf("'%s' for this singleton resource", fixedID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fixedID)...)
+}
+
+// ParseImportInt parses a numeric import ID such as a descriptor or filter
+// number, which must lie between min and max (no upper bound when max is 0).
+// On failure it adds the diagnostic naming the attribute and its range and
+// returns false.
+func ParseImportInt(diags *diag.Diagnostics, id, attribute string, min, max int64) (int64, bool) {
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || n < min || (max > 0 && n > max) {
+		expected := fmt.Sprintf("%s as a number of at least %d (e.g., '%d')", attribute, min, min)
+		if max > 0 {
+			expected = fmt.Sprintf("%s as a number from %d to %d (e.g., '%d')", attribute, min, max, min)
+		}
+		AddImportIDError(diags, id, expected)
+		return 0, false
+	}
+	return n, true
+}
+
+// SplitImportID splits an import ID made of parts joined by sep, such as
+// "interface:direction". Only the last part may contain sep. On failure it
+// adds the diagnostic describing format and returns false.
+func SplitImportID(diags *diag.Diagnostics, id, sep string, parts int, format string) ([]string, bool) {
+	split := strings.SplitN(id, sep, parts)
+	if len(split) != parts {
+		AddImportIDError(diags, id, format)
+		return nil, false
+	}
+	for _, part := range split {
+		if part == "" {
+			AddImportIDError(diags, id, format)
+			return nil, false
+		}
+	}
+	return split, true
+}
@@ -0,0 +1,72 @@
+package fwhelpers
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImportInt(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		max      int64
+		want     int64
+		wantErr  bool
+		errMatch string
+	}{
+		{name: "in range", id: "42", max: 65535, want: 42},
+		{name: "upper bound", id: "65535", max: 65535, want: 65535},
+		{name: "above range", id: "65536", max: 65535, wantErr: true, errMatch: "descriptor_id as a number from 1 to 65535 (e.g., '1')"},
+		{name: "below range", id: "0", max: 65535, wantErr: true},
+		{name: "not a number", id: "abc", max: 65535, wantErr: true},
+		{name: "no upper bound", id: "100000", want: 100000},
+		{name: "no upper bound below minimum", id: "0", wantErr: true, errMatch: "descriptor_id as a number of at least 1 (e.g., '1')"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got, ok := ParseImportInt(&diags, tt.id, "descriptor_id", 1, tt.max)
+			assert.Equal(t, !tt.wantErr, ok)
+			assert.Equal(t, tt.wantErr, diags.HasError())
+			if tt.wantErr {
+				assert.Contains(t, diags.Errors()[0].Detail(), tt.id)
+				assert.Contains(t, diags.Errors()[0].Detail(), tt.errMatch)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplitImportID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    []string
+		wantErr bool
+	}{
+		{name: "two parts", id: "lan1:in", want: []string{"lan1", "in"}},
+		{name: "separator in last part", id: "lan1:in:extra", want: []string{"lan1", "in:extra"}},
+		{name: "missing part", id: "lan1", wantErr: true},
+		{name: "empty part", id: "lan1:", wantErr: true},
+		{name: "empty id", id: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got, ok := SplitImportID(&diags, tt.id, ":", 2, "'interface:direction' (e.g., 'lan1:in')")
+			assert.Equal(t, !tt.wantErr, ok)
+			if tt.wantErr {
+				assert.True(t, diags.HasError())
+				assert.Contains(t, diags.Errors()[0].Detail(), "'interface:direction' (e.g., 'lan1:in')")
+				return
+			}
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
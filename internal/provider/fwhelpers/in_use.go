@@ -0,0 +1,68 @@
+package fwhelpers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// ForceDeleteAttribute returns the schema of the force attribute of resources
+// that are not destroyed while interfaces still use them. The router itself
+// accepts the deletion and leaves the interface bindings dangling.
+func ForceDeleteAttribute(subject string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("Destroy the resource even while interfaces still use its %s, leaving their bindings dangling. "+
+			"Must be applied before the destroy to take effect. Defaults to false.", subject),
+		Optional: true,
+	}
+}
+
+// CheckNotInUse fails the deletion of subject while the router configuration
+// still binds it to an interface, unless force is true. find returns the
+// bindings in the configuration that refer to subject. When the configuration
+// cannot be read, the deletion proceeds.
+func CheckNotInUse(ctx context.Context, c client.Client, force types.Bool, subject string, find func(*parsers.ParsedConfig) []parsers.InterfaceReference, diags *diag.Diagnostics) {
+	if force.ValueBool() {
+		return
+	}
+
+	config, err := c.GetCachedConfig(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Msgf("Could not read configuration to check whether %s is in use", subject)
+		return
+	}
+
+	refs := find(config)
+	if len(refs) == 0 {
+		return
+	}
+	lines := make([]string, len(refs))
+	for i, ref := range refs {
+		lines[i] = "  " + ref.String()
+	}
+	diags.AddError(
+		"Resource In Use",
+		fmt.Sprintf("%s is still used by:\n%s\n\nRemove these bindings first, or set force = true and apply before destroying to delete it anyway.",
+			subject, strings.Join(lines, "\n")),
+	)
+}
+
+// ExcludeReferences returns refs without the bindings of the interface and
+// direction pairs in own, given as "interface direction"
+func ExcludeReferences(refs []parsers.InterfaceReference, own map[string]bool) []parsers.InterfaceReference {
+	var result []parsers.InterfaceReference
+	for _, ref := range refs {
+		if !own[ref.Interface+" "+ref.Direction] {
+			result = append(result, ref)
+		}
+	}
+	return result
+}
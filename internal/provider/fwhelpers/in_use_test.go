@@ -0,0 +1,54 @@
+package fwhelpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// configTestClient serves a fixed router configuration
+type configTestClient struct {
+	previewTestClient
+	config string
+}
+
+func (c configTestClient) GetCachedConfig(ctx context.Context) (*parsers.ParsedConfig, error) {
+	return parsers.NewConfigFileParser().Parse(c.config)
+}
+
+func TestCheckNotInUse(t *testing.T) {
+	c := configTestClient{config: "ip lan1 secure filter in 200 201\nip lan2 secure filter out 201\n"}
+	find := func(own map[string]bool) func(*parsers.ParsedConfig) []parsers.InterfaceReference {
+		return func(pc *parsers.ParsedConfig) []parsers.InterfaceReference {
+			return ExcludeReferences(pc.FindSecureFilterReferences([]int{201}, false), own)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		force   types.Bool
+		own     map[string]bool
+		wantErr bool
+	}{
+		{name: "in use", force: types.BoolNull(), wantErr: true},
+		{name: "forced", force: types.BoolValue(true)},
+		{name: "only own bindings", force: types.BoolValue(false), own: map[string]bool{"lan1 in": true, "lan2 out": true}},
+		{name: "other binding remains", force: types.BoolValue(false), own: map[string]bool{"lan1 in": true}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			CheckNotInUse(context.Background(), c, tt.force, `IP access list "web"`, find(tt.own), &diags)
+			assert.Equal(t, tt.wantErr, diags.HasError(), diags)
+			if tt.wantErr {
+				assert.Contains(t, diags[0].Detail(), "ip lan2 secure filter out 201")
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package fwhelpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// limitedResources lists the resource types whose plans are checked against
+// the capacity of the detected router model
+var limitedResources = map[string]bool{
+	"rtx_tunnel":         true,
+	"rtx_ipsec_tunnel":   true,
+	"rtx_ikev2_tunnel":   true,
+	"rtx_l2tp":           true,
+	"rtx_nat_masquerade": true,
+	"rtx_nat_static":     true,
+	"rtx_access_list_ip": true,
+}
+
+// checkModelLimits rejects creating or updating a resource beyond the
+// capacity of the detected router model, such as a tunnel number the model
+// does not have, instead of failing with a device error at apply time.
+func (r *resourceWrapper) checkModelLimits(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !limitedResources[r.typeName] || resp.Plan.Raw.IsNull() {
+		return
+	}
+	action := planAction(req, resp)
+	if action == "" {
+		return
+	}
+
+	info, err := r.client.DetectModel(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Str("resource", r.typeName).Msg("Could not detect router model for plan-time validation")
+		return
+	}
+	model := info.Model
+	limits := parsers.GetModelLimits(model)
+
+	switch r.typeName {
+	case "rtx_tunnel", "rtx_ipsec_tunnel", "rtx_ikev2_tunnel", "rtx_l2tp":
+		var id types.Int64
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("tunnel_id"), &id)...)
+		if !id.IsNull() && !id.IsUnknown() {
+			r.addLimitError(resp, path.Root("tunnel_id"), parsers.CheckTunnelNumber(model, int(id.ValueInt64())))
+		}
+		if r.typeName == "rtx_tunnel" {
+			var encapsulation types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("encapsulation"), &encapsulation)...)
+			if !encapsulation.IsNull() && !encapsulation.IsUnknown() {
+				r.addLimitError(resp, path.Root("encapsulation"), parsers.CheckVPNProtocol(model, encapsulation.ValueString()))
+			}
+		}
+
+	case "rtx_nat_masquerade", "rtx_nat_static":
+		// Only a new descriptor adds to the count
+		if limits.NATDescriptors == 0 || (action != "create" && action != "replace") {
+			return
+		}
+		var id types.Int64
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("descriptor_id"), &id)...)
+		if resp.Diagnostics.HasError() || id.IsUnknown() {
+			return
+		}
+		descriptors, err := r.client.ListNATDescriptors(ctx)
+		if err != nil {
+			logging.FromContext(ctx).Warn().Err(err).Str("resource", r.typeName).Msg("Could not read NAT descriptors for plan-time validation")
+			return
+		}
+		count := 1
+		for _, desc := range descriptors {
+			if int64(desc.ID) != id.ValueInt64() {
+				count++
+			}
+		}
+		r.addLimitError(resp, path.Root("descriptor_id"), parsers.CheckNATDescriptorCount(model, count))
+
+	case "rtx_access_list_ip":
+		if limits.IPFilters == 0 {
+			return
+		}
+		var plannedEntries, priorEntries types.List
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("entry"), &plannedEntries)...)
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("entry"), &priorEntries)...)
+		}
+		if resp.Diagnostics.HasError() || plannedEntries.IsUnknown() {
+			return
+		}
+		count, known := entrySequences(plannedEntries)
+		_, prior := entrySequences(priorEntries)
+		sequences, err := r.client.GetAllIPFilterSequences(ctx)
+		if err != nil {
+			logging.FromContext(ctx).Warn().Err(err).Str("resource", r.typeName).Msg("Could not read IP filters for plan-time validation")
+			return
+		}
+		// Filters of other resources stay, the entries of this one replace its prior entries
+		for _, seq := range sequences {
+			if !prior[int64(seq)] && !known[int64(seq)] {
+				count++
+			}
+		}
+		r.addLimitError(resp, path.Root("entry"), parsers.CheckIPFilterCount(model, count))
+	}
+}
+
+// addLimitError reports a failed capacity check on the attribute at p
+func (r *resourceWrapper) addLimitError(resp *resource.ModifyPlanResponse, p path.Path, err error) {
+	if err == nil {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(p,
+		"Router Model Limit Exceeded",
+		fmt.Sprintf("%s cannot be applied to this router: %s.", r.typeName, err),
+	)
+}
+
+// entrySequences returns the number of entries of an access list and the
+// set of their known sequence numbers. Entries without a known sequence are
+// counted as new filters.
+func entrySequences(entries types.List) (int, map[int64]bool) {
+	known := make(map[int64]bool)
+	if entries.IsNull() || entries.IsUnknown() {
+		return 0, known
+	}
+	for _, element := range entries.Elements() {
+		entry, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		if seq, ok := entry.Attributes()["sequence"].(types.Int64); ok && !seq.IsNull() && !seq.IsUnknown() {
+			known[seq.ValueInt64()] = true
+		}
+	}
+	return len(entries.Elements()), known
+}
@@ -0,0 +1,93 @@
+package fwhelpers
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+var tunnelTestSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"tunnel_id":     schema.Int64Attribute{Required: true},
+		"encapsulation": schema.StringAttribute{Required: true},
+	},
+}
+
+func tunnelTestValue(id int64, encapsulation string) tftypes.Value {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"tunnel_id": tftypes.Number, "encapsulation": tftypes.String}}
+	if id == 0 {
+		return tftypes.NewValue(objectType, nil)
+	}
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"tunnel_id":     tftypes.NewValue(tftypes.Number, big.NewFloat(float64(id))),
+		"encapsulation": tftypes.NewValue(tftypes.String, encapsulation),
+	})
+}
+
+func TestCheckModelLimits(t *testing.T) {
+	tests := []struct {
+		name          string
+		typeName      string
+		model         string
+		state         int64
+		plan          int64
+		encapsulation string
+		wantErr       string
+	}{
+		{name: "tunnel within limit", typeName: "rtx_tunnel", model: "RTX830", plan: 20, encapsulation: "ipsec"},
+		{name: "tunnel over limit", typeName: "rtx_tunnel", model: "RTX830", plan: 21, encapsulation: "ipsec",
+			wantErr: "RTX830 supports at most 20 tunnels"},
+		{name: "tunnel over limit on update", typeName: "rtx_ipsec_tunnel", model: "RTX830", state: 1, plan: 50, encapsulation: "ipsec",
+			wantErr: "RTX830 supports at most 20 tunnels"},
+		{name: "tunnel on larger model", typeName: "rtx_tunnel", model: "RTX1210", plan: 50, encapsulation: "ipsec"},
+		{name: "unsupported protocol", typeName: "rtx_tunnel", model: "vRX", plan: 1, encapsulation: "pptp",
+			wantErr: "vRX does not support pptp"},
+		{name: "unknown model", typeName: "rtx_tunnel", model: "", plan: 5000, encapsulation: "pptp"},
+		{name: "destroy", typeName: "rtx_tunnel", model: "RTX830", state: 50, encapsulation: "ipsec"},
+		{name: "resource without limits", typeName: "rtx_test", model: "RTX830", plan: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &resourceWrapper{typeName: tt.typeName, client: previewTestClient{model: tt.model}}
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: tunnelTestSchema, Raw: tunnelTestValue(tt.state, tt.encapsulation)},
+				Plan:  tfsdk.Plan{Schema: tunnelTestSchema, Raw: tunnelTestValue(tt.plan, tt.encapsulation)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			r.checkModelLimits(context.Background(), req, resp)
+
+			if tt.wantErr == "" {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			if assert.True(t, resp.Diagnostics.HasError()) {
+				assert.Contains(t, resp.Diagnostics[0].Detail(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEntrySequences(t *testing.T) {
+	entryType := map[string]attr.Type{"sequence": types.Int64Type}
+	entries := types.ListValueMust(types.ObjectType{AttrTypes: entryType}, []attr.Value{
+		types.ObjectValueMust(entryType, map[string]attr.Value{"sequence": types.Int64Value(100)}),
+		types.ObjectValueMust(entryType, map[string]attr.Value{"sequence": types.Int64Unknown()}),
+	})
+
+	count, known := entrySequences(entries)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, map[int64]bool{100: true}, known)
+
+	count, known = entrySequences(types.ListNull(types.ObjectType{AttrTypes: entryType}))
+	assert.Equal(t, 0, count)
+	assert.Empty(t, known)
+}
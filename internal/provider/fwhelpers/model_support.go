@@ -0,0 +1,43 @@
+package fwhelpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// resourceFeatures maps resource types to the feature names of the model
+// support matrix in the parsers package. Resources that work on every
+// supported model are not listed.
+var resourceFeatures = map[string]string{
+	"rtx_link_aggregation": "link_aggregation",
+}
+
+// checkModelSupport rejects creating or updating a resource that the detected
+// router model does not support, instead of failing at apply time.
+func (r *resourceWrapper) checkModelSupport(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	feature, ok := resourceFeatures[r.typeName]
+	if !ok || resp.Plan.Raw.IsNull() {
+		return
+	}
+	if action := planAction(req, resp); action == "" {
+		return
+	}
+
+	info, err := r.client.DetectModel(ctx)
+	if err != nil {
+		// The model is checked again by the service at apply time
+		logging.FromContext(ctx).Warn().Err(err).Str("resource", r.typeName).Msg("Could not detect router model for plan-time validation")
+		return
+	}
+	if err := parsers.CheckModelSupport(feature, info.Model); err != nil {
+		resp.Diagnostics.AddError(
+			"Unsupported Router Model",
+			fmt.Sprintf("%s cannot be used on this router: %s.", r.typeName, err),
+		)
+	}
+}
@@ -0,0 +1,36 @@
+package fwhelpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckModelSupport_ModifyPlan(t *testing.T) {
+	tests := []struct {
+		name     string
+		typeName string
+		model    string
+		state    string
+		plan     string
+		wantErr  bool
+	}{
+		{name: "supported model", typeName: "rtx_link_aggregation", model: "RTX1300", plan: "a"},
+		{name: "unsupported model", typeName: "rtx_link_aggregation", model: "RTX830", plan: "a", wantErr: true},
+		{name: "unsupported model update", typeName: "rtx_link_aggregation", model: "RTX830", state: "a", plan: "b", wantErr: true},
+		{name: "destroy on unsupported model", typeName: "rtx_link_aggregation", model: "RTX830", state: "a"},
+		{name: "unknown model", typeName: "rtx_link_aggregation", model: "", plan: "a"},
+		{name: "resource without restrictions", typeName: "rtx_test", model: "RTX830", plan: "a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &previewTestResource{typeName: tt.typeName}
+			resp := modifyPlan(inner, &ProviderData{Client: previewTestClient{model: tt.model}}, tt.state, tt.plan, false)
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+			if tt.wantErr {
+				assert.Contains(t, resp.Diagnostics[0].Detail(), "RTX830")
+			}
+		})
+	}
+}
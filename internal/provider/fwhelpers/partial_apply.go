@@ -0,0 +1,103 @@
+package fwhelpers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+// pendingEntriesKey is the private state key holding the entries that a
+// failed apply did not write to the router
+const pendingEntriesKey = "pending_entries"
+
+// PrivateState is the private state of a resource, as found in the requests
+// and responses of the resource operations.
+type PrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// RecordPartialApply handles the error of applying the entries of subject.
+// When the router took some of the entries, it records the pending ones in
+// the private state and reports them, and returns true: the caller then
+// stores the state read back from the router, so that the next apply only
+// writes the pending entries. Otherwise it returns false and leaves the error
+// to the caller.
+//
+// A failed create leaves the resource tainted, which Terraform replaces as a
+// whole unless it is untainted first.
+func RecordPartialApply(ctx context.Context, private PrivateState, subject string, creating bool, err error, diags *diag.Diagnostics) bool {
+	var partial *client.PartialApplyError
+	if !errors.As(err, &partial) {
+		return false
+	}
+
+	value, marshalErr := json.Marshal(partial.Pending)
+	if marshalErr != nil {
+		return false
+	}
+	diags.Append(private.SetKey(ctx, pendingEntriesKey, value)...)
+
+	detail := fmt.Sprintf("%s was applied partially: entries %s were written to the router, entries %s were not: %v\n\n"+
+		"The state records the entries on the router, so the next apply only writes the pending entries.",
+		subject, joinEntries(partial.Applied), joinEntries(partial.Pending), partial.Err)
+	if creating {
+		detail += " Terraform marks the resource as tainted after a failed create; untaint it to keep the applied entries " +
+			"instead of recreating the whole resource."
+	}
+	diags.AddError("Partially Applied Entries", detail)
+	return true
+}
+
+// ClearPendingEntries removes the record of a partially failed apply after
+// an apply that succeeded.
+func ClearPendingEntries(ctx context.Context, private PrivateState, diags *diag.Diagnostics) {
+	diags.Append(private.SetKey(ctx, pendingEntriesKey, nil)...)
+}
+
+// PendingEntries returns the entries that the last apply did not write to the
+// router, if it failed partially.
+func PendingEntries(ctx context.Context, private PrivateState) []int {
+	value, diags := private.GetKey(ctx, pendingEntriesKey)
+	if diags.HasError() || len(value) == 0 {
+		return nil
+	}
+	var pending []int
+	if err := json.Unmarshal(value, &pending); err != nil {
+		return nil
+	}
+	return pending
+}
+
+// warnPendingEntries tells at plan time which entries of a partially failed
+// apply the update retries.
+func (r *resourceWrapper) warnPendingEntries(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Private == nil || planAction(req, resp) != "update" {
+		return
+	}
+	pending := PendingEntries(ctx, req.Private)
+	if len(pending) == 0 {
+		return
+	}
+	resp.Diagnostics.AddWarning(
+		"Retrying Partially Applied Entries",
+		fmt.Sprintf("The previous apply of %s failed before writing entries %s to the router; this update writes them.",
+			r.typeName, joinEntries(pending)),
+	)
+}
+
+func joinEntries(entries []int) string {
+	parts := make([]string, len(entries))
+	for i, entry := range entries {
+		parts[i] = strconv.Itoa(entry)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,67 @@
+package fwhelpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+// testPrivateState is an in-memory private state
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+	} else {
+		p[key] = value
+	}
+	return nil
+}
+
+func TestRecordPartialApply(t *testing.T) {
+	ctx := context.Background()
+	partial := &client.PartialApplyError{Applied: []int{1, 2}, Pending: []int{3}, Err: errors.New("static entry table is full")}
+
+	t.Run("other errors are left to the caller", func(t *testing.T) {
+		private := testPrivateState{}
+		var diags diag.Diagnostics
+		assert.False(t, RecordPartialApply(ctx, private, "NAT masquerade 1", false, errors.New("connection lost"), &diags))
+		assert.Empty(t, diags)
+		assert.Nil(t, PendingEntries(ctx, private))
+	})
+
+	t.Run("update records pending entries", func(t *testing.T) {
+		private := testPrivateState{}
+		var diags diag.Diagnostics
+		assert.True(t, RecordPartialApply(ctx, private, "NAT masquerade 1", false, fmt.Errorf("update: %w", partial), &diags))
+		assert.Equal(t, []int{3}, PendingEntries(ctx, private))
+		if assert.Len(t, diags.Errors(), 1) {
+			detail := diags.Errors()[0].Detail()
+			assert.Contains(t, detail, "entries 1, 2 were written")
+			assert.Contains(t, detail, "entries 3 were not")
+			assert.NotContains(t, detail, "untaint")
+		}
+
+		ClearPendingEntries(ctx, private, &diags)
+		assert.Nil(t, PendingEntries(ctx, private))
+	})
+
+	t.Run("create suggests untainting", func(t *testing.T) {
+		private := testPrivateState{}
+		var diags diag.Diagnostics
+		assert.True(t, RecordPartialApply(ctx, private, "NAT masquerade 1", true, partial, &diags))
+		if assert.Len(t, diags.Errors(), 1) {
+			assert.Contains(t, diags.Errors()[0].Detail(), "untaint")
+		}
+	})
+}
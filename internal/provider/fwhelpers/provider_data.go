@@ -7,4 +7,17 @@ import (
 // ProviderData holds the provider-configured data for use by resources and data sources.
 type ProviderData struct {
 	Client client.Client
+
+	// PlanCommands enables the plan-time preview of the commands each change sends
+	PlanCommands bool
+
+	// VerifyApply enables reading every applied resource back from the router
+	VerifyApply bool
+
+	// Rollback collects the commands that undo the changes of the apply, or nil
+	Rollback *RollbackScript
+
+	// Filters records the IP filters planned by the configuration for the
+	// plan-time check of filter references
+	Filters *FilterRegistry
 }
@@ -0,0 +1,265 @@
+package fwhelpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+// WrapResources wraps resource factories with the behavior shared by all
+// resources:
+//   - changes the detected router model does not support or has no capacity
+//     for are rejected at plan time
+//   - references to IP filters that are neither planned nor on the router are
+//     rejected at plan time
+//   - destroying a resource with prevent_destroy_on_device set, and retrying
+//     the entries of a partially failed apply, are announced in the plan
+//   - with plan_commands, the exact commands of every change are shown in the plan
+//   - router lines changed outside Terraform are reported when a refresh finds drift
+//   - the commands of every apply are kept in the applied_commands attribute
+//   - with verify_apply, every apply is read back from the router
+//   - with rollback_file, the commands that undo every change are written to a
+//     rollback script
+//   - the commands of every change hold the router's device lock together
+//   - in batch save mode, the configuration is saved when the last running
+//     change ends
+func WrapResources(factories []func() resource.Resource) []func() resource.Resource {
+	wrapped := make([]func() resource.Resource, len(factories))
+	for i, factory := range factories {
+		wrapped[i] = func() resource.Resource {
+			return &resourceWrapper{Resource: factory()}
+		}
+	}
+	return wrapped
+}
+
+// resourceWrapper adds the shared plan-time checks to a resource and forwards
+// the optional resource interfaces to the wrapped implementation.
+type resourceWrapper struct {
+	resource.Resource
+
+	typeName     string
+	client       client.Client
+	planCommands bool
+	verify       bool
+	rollback     *RollbackScript
+	filters      *FilterRegistry
+}
+
+var (
+	_ resource.ResourceWithConfigure      = &resourceWrapper{}
+	_ resource.ResourceWithImportState    = &resourceWrapper{}
+	_ resource.ResourceWithModifyPlan     = &resourceWrapper{}
+	_ resource.ResourceWithUpgradeState   = &resourceWrapper{}
+	_ resource.ResourceWithValidateConfig = &resourceWrapper{}
+)
+
+// Metadata returns the resource type name of the wrapped resource.
+func (r *resourceWrapper) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.Resource.Metadata(ctx, req, resp)
+	r.typeName = resp.TypeName
+}
+
+// Configure configures the wrapped resource and keeps the client for the plan-time checks.
+func (r *resourceWrapper) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if inner, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		inner.Configure(ctx, req, resp)
+	}
+	if providerData, ok := req.ProviderData.(*ProviderData); ok {
+		r.client = providerData.Client
+		r.planCommands = providerData.PlanCommands
+		r.verify = providerData.VerifyApply
+		r.rollback = providerData.Rollback
+		r.filters = providerData.Filters
+	}
+}
+
+// Create creates the wrapped resource, records the commands it sent and
+// verifies the result when enabled.
+func (r *resourceWrapper) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer r.beginChange()(ctx, &resp.Diagnostics)
+	ctx, unlock := r.lockDevice(ctx, &resp.Diagnostics)
+	if unlock == nil {
+		return
+	}
+	defer unlock()
+	ctx, log := client.WithCommandLog(ctx)
+	r.Resource.Create(ctx, req, resp)
+	setAppliedCommands(ctx, log, &resp.State, &resp.Diagnostics)
+	if !resp.State.Raw.IsNull() {
+		r.recordRollback(ctx, "create", tfsdk.State{Schema: resp.State.Schema}, resp.State, &resp.Diagnostics)
+	}
+	r.verifyApply(ctx, req.Plan, resp.State, &resp.Diagnostics)
+}
+
+// Update updates the wrapped resource, records the commands it sent and
+// verifies the result when enabled.
+func (r *resourceWrapper) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer r.beginChange()(ctx, &resp.Diagnostics)
+	ctx, unlock := r.lockDevice(ctx, &resp.Diagnostics)
+	if unlock == nil {
+		return
+	}
+	defer unlock()
+	ctx, log := client.WithCommandLog(ctx)
+	r.Resource.Update(ctx, req, resp)
+	setAppliedCommands(ctx, log, &resp.State, &resp.Diagnostics)
+	if !resp.State.Raw.IsNull() {
+		r.recordRollback(ctx, "update", req.State, resp.State, &resp.Diagnostics)
+	}
+	r.verifyApply(ctx, req.Plan, resp.State, &resp.Diagnostics)
+}
+
+// Delete deletes the wrapped resource and records how to create it again.
+func (r *resourceWrapper) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer r.beginChange()(ctx, &resp.Diagnostics)
+	ctx, unlock := r.lockDevice(ctx, &resp.Diagnostics)
+	if unlock == nil {
+		return
+	}
+	defer unlock()
+	r.Resource.Delete(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		r.recordRollback(ctx, "destroy", req.State, resp.State, &resp.Diagnostics)
+	}
+}
+
+// beginChange marks the start of a change to the router. The returned
+// function ends it; when the last running change ends, configuration saves
+// deferred by batch save mode run and a failed save is reported as an error.
+func (r *resourceWrapper) beginChange() func(ctx context.Context, diags *diag.Diagnostics) {
+	if r.client == nil {
+		return func(ctx context.Context, diags *diag.Diagnostics) {}
+	}
+	end := r.client.BeginChange()
+	return func(ctx context.Context, diags *diag.Diagnostics) {
+		if err := end(ctx); err != nil {
+			diags.AddError(
+				"Failed to Save Configuration",
+				fmt.Sprintf("The changes were applied, but saving the configuration to flash memory failed: %v", err),
+			)
+		}
+	}
+}
+
+// lockDevice holds the device lock of the router for a whole change, so
+// that the commands of concurrent changes to the same router cannot
+// interleave. It returns a nil unlock function when the lock could not be taken.
+func (r *resourceWrapper) lockDevice(ctx context.Context, diags *diag.Diagnostics) (context.Context, func()) {
+	if r.client == nil {
+		return ctx, func() {}
+	}
+	ctx, unlock, err := r.client.LockDevice(ctx)
+	if err != nil {
+		diags.AddError(
+			"Router Busy",
+			fmt.Sprintf("Could not lock the router for the change to %s: %v", r.typeName, err),
+		)
+		return ctx, nil
+	}
+	return ctx, unlock
+}
+
+// ImportState forwards to the wrapped resource.
+func (r *resourceWrapper) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	inner, ok := r.Resource.(resource.ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			fmt.Sprintf("The %s resource does not support import.", r.typeName),
+		)
+		return
+	}
+	inner.ImportState(ctx, req, resp)
+	if !resp.Diagnostics.HasError() && resp.Private != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, []byte("true"))...)
+	}
+}
+
+// Read reads the wrapped resource and reports configuration changed on the router.
+func (r *resourceWrapper) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.Resource.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() || r.client == nil || req.Private == nil {
+		return
+	}
+
+	imported, diags := req.Private.GetKey(ctx, importedKey)
+	resp.Diagnostics.Append(diags...)
+	if imported != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, nil)...)
+		return
+	}
+	r.reportDrift(ctx, req.State, resp.State, &resp.Diagnostics)
+}
+
+// UpgradeState forwards to the wrapped resource.
+func (r *resourceWrapper) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	if inner, ok := r.Resource.(resource.ResourceWithUpgradeState); ok {
+		return inner.UpgradeState(ctx)
+	}
+	return nil
+}
+
+// ValidateConfig forwards to the wrapped resource.
+func (r *resourceWrapper) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if inner, ok := r.Resource.(resource.ResourceWithValidateConfig); ok {
+		inner.ValidateConfig(ctx, req, resp)
+	}
+}
+
+// ModifyPlan runs the wrapped plan modifier, then the shared plan-time checks.
+func (r *resourceWrapper) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if inner, ok := r.Resource.(resource.ResourceWithModifyPlan); ok {
+		inner.ModifyPlan(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	r.warnPreventedDestroy(ctx, req, resp)
+	r.warnPendingEntries(ctx, req, resp)
+	if r.client == nil {
+		return
+	}
+
+	r.checkModelSupport(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.checkModelLimits(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.checkFilterReferences(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.planCommands {
+		r.previewCommands(ctx, req, resp)
+	}
+}
+
+// planAction returns the action Terraform plans for the resource: "create",
+// "update", "replace" or "destroy", or "" when nothing changes.
+func planAction(req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) string {
+	creating := req.State.Raw.IsNull()
+	deleting := resp.Plan.Raw.IsNull()
+	switch {
+	case creating && deleting:
+		return ""
+	case creating:
+		return "create"
+	case deleting:
+		return "destroy"
+	case req.State.Raw.Equal(resp.Plan.Raw):
+		return ""
+	case len(resp.RequiresReplace) > 0:
+		return "replace"
+	default:
+		return "update"
+	}
+}
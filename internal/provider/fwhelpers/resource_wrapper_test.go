@@ -0,0 +1,42 @@
+package fwhelpers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceWrapper_SaveFailure(t *testing.T) {
+	tests := []struct {
+		name      string
+		saveErr   error
+		wantError bool
+	}{
+		{name: "saved", saveErr: nil},
+		{name: "save failed", saveErr: errors.New("connection lost"), wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &previewTestResource{}
+			r := WrapResources([]func() resource.Resource{func() resource.Resource { return inner }})[0]().(*resourceWrapper)
+			ctx := context.Background()
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "rtx"}, &resource.MetadataResponse{})
+			r.Configure(ctx, resource.ConfigureRequest{ProviderData: &ProviderData{Client: previewTestClient{saveErr: tt.saveErr}}}, &resource.ConfigureResponse{})
+
+			plan := tfsdk.Plan{Schema: previewTestSchema, Raw: previewTestValue("a")}
+			createResp := &resource.CreateResponse{State: tfsdk.State{Schema: previewTestSchema, Raw: previewTestValue("a")}}
+			r.Create(ctx, resource.CreateRequest{Config: tfsdk.Config{Schema: previewTestSchema, Raw: plan.Raw}, Plan: plan}, createResp)
+			assert.Equal(t, tt.wantError, createResp.Diagnostics.HasError())
+
+			state := tfsdk.State{Schema: previewTestSchema, Raw: previewTestValue("a")}
+			deleteResp := &resource.DeleteResponse{State: state}
+			r.Delete(ctx, resource.DeleteRequest{State: state}, deleteResp)
+			assert.Equal(t, tt.wantError, deleteResp.Diagnostics.HasError())
+		})
+	}
+}
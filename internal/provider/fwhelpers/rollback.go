@@ -0,0 +1,116 @@
+package fwhelpers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+// RollbackScript collects the commands that undo the changes of an apply and
+// keeps them in a file, so that an emergency rollback is one paste into the
+// router console. The file is rewritten after every change, newest change
+// first, so it is complete even if the apply is interrupted.
+type RollbackScript struct {
+	mu      sync.Mutex
+	path    string
+	entries []rollbackEntry
+}
+
+// rollbackEntry holds the commands that undo one resource change
+type rollbackEntry struct {
+	title    string
+	commands []string
+}
+
+// NewRollbackScript returns a rollback script written to path. Nothing is
+// written until the first change.
+func NewRollbackScript(path string) *RollbackScript {
+	return &RollbackScript{path: path}
+}
+
+// add records the commands that undo a change and rewrites the file
+func (s *RollbackScript) add(title string, commands []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = append(s.entries, rollbackEntry{title: title, commands: commands})
+	if dir := filepath.Dir(s.path); dir != "" {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return fmt.Errorf("failed to create rollback file directory: %w", err)
+		}
+	}
+	if err := os.WriteFile(s.path, []byte(s.render()), 0o600); err != nil {
+		return fmt.Errorf("failed to write rollback file: %w", err)
+	}
+	return nil
+}
+
+// render returns the script: the undo commands of every change, newest
+// first, with one "save" at the end instead of one per change
+func (s *RollbackScript) render() string {
+	var b strings.Builder
+	b.WriteString("# Commands that undo the changes of a Terraform apply, newest change first.\n")
+	b.WriteString("# Commands containing secrets are redacted and must be completed by hand.\n")
+	for i := len(s.entries) - 1; i >= 0; i-- {
+		entry := s.entries[i]
+		fmt.Fprintf(&b, "\n# %s\n", entry.title)
+		for _, cmd := range entry.commands {
+			if strings.TrimSpace(cmd) == "save" {
+				continue
+			}
+			b.WriteString(cmd + "\n")
+		}
+	}
+	b.WriteString("\nsave\n")
+	return b.String()
+}
+
+// recordRollback adds the commands that undo a change to the rollback
+// script. prior and current are the states before and after the change.
+func (r *resourceWrapper) recordRollback(ctx context.Context, action string, prior, current tfsdk.State, diags *diag.Diagnostics) {
+	if r.rollback == nil {
+		return
+	}
+
+	commands := r.undoCommands(ctx, action, prior, current)
+	if len(commands) == 0 {
+		return
+	}
+	if err := r.rollback.add(fmt.Sprintf("undo %s %s", action, r.typeName), commands); err != nil {
+		diags.AddWarning("Rollback Script Not Written", err.Error())
+	}
+}
+
+// undoCommands dry-runs the inverse of a change against the router: a
+// created resource is deleted, an updated resource is updated back to its
+// prior state and a deleted resource is created again
+func (r *resourceWrapper) undoCommands(ctx context.Context, action string, prior, current tfsdk.State) []string {
+	if action == "destroy" {
+		return r.createCommands(ctx, prior)
+	}
+
+	previewCtx, preview := client.WithCommandPreview(ctx)
+	previewCtx, cancel := context.WithTimeout(previewCtx, commandPreviewTimeout)
+	defer cancel()
+
+	switch action {
+	case "create":
+		r.Resource.Delete(previewCtx, resource.DeleteRequest{State: current}, &resource.DeleteResponse{State: current})
+	case "update":
+		r.Resource.Update(previewCtx, resource.UpdateRequest{
+			Config: tfsdk.Config{Schema: prior.Schema, Raw: prior.Raw.Copy()},
+			Plan:   tfsdk.Plan{Schema: prior.Schema, Raw: prior.Raw.Copy()},
+			State:  current,
+		}, &resource.UpdateResponse{State: tfsdk.State{Schema: prior.Schema, Raw: prior.Raw.Copy()}})
+	}
+	return preview.Commands()
+}
@@ -0,0 +1,70 @@
+package fwhelpers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollbackScript_Add(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rollback", "router.txt")
+	script := NewRollbackScript(path)
+
+	require.NoError(t, script.add("undo create rtx_static_route", []string{"no ip route 10.0.0.0/8 gateway 192.168.1.254", "save"}))
+	require.NoError(t, script.add("undo update rtx_dns_server", []string{"dns server 8.8.8.8", "save"}))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "# Commands that undo the changes of a Terraform apply, newest change first.\n"+
+		"# Commands containing secrets are redacted and must be completed by hand.\n"+
+		"\n# undo update rtx_dns_server\n"+
+		"dns server 8.8.8.8\n"+
+		"\n# undo create rtx_static_route\n"+
+		"no ip route 10.0.0.0/8 gateway 192.168.1.254\n"+
+		"\nsave\n", string(content))
+}
+
+func TestRecordRollback(t *testing.T) {
+	tests := []struct {
+		name   string
+		action string
+		want   []string
+	}{
+		{name: "create is undone by delete", action: "create", want: []string{"create", "delete"}},
+		{name: "update is undone by update", action: "update", want: []string{"update", "update"}},
+		{name: "destroy is undone by create", action: "destroy", want: []string{"delete", "create"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &previewTestResource{}
+			r := WrapResources([]func() resource.Resource{func() resource.Resource { return inner }})[0]().(*resourceWrapper)
+			ctx := context.Background()
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "rtx"}, &resource.MetadataResponse{})
+			r.Configure(ctx, resource.ConfigureRequest{ProviderData: &ProviderData{
+				Client:   previewTestClient{},
+				Rollback: NewRollbackScript(filepath.Join(t.TempDir(), "rollback.txt")),
+			}}, &resource.ConfigureResponse{})
+
+			prior := tfsdk.State{Schema: previewTestSchema, Raw: previewTestValue("a")}
+			plan := tfsdk.Plan{Schema: previewTestSchema, Raw: previewTestValue("b")}
+			switch tt.action {
+			case "create":
+				r.Create(ctx, resource.CreateRequest{Plan: plan}, &resource.CreateResponse{State: tfsdk.State{Schema: previewTestSchema, Raw: plan.Raw}})
+			case "update":
+				r.Update(ctx, resource.UpdateRequest{Plan: plan, State: prior}, &resource.UpdateResponse{State: tfsdk.State{Schema: previewTestSchema, Raw: plan.Raw}})
+			case "destroy":
+				r.Delete(ctx, resource.DeleteRequest{State: prior}, &resource.DeleteResponse{State: prior})
+			}
+
+			assert.Equal(t, tt.want, inner.calls)
+			assert.Equal(t, []bool{false, true}, inner.preview, "only the undo commands must be dry-run")
+		})
+	}
+}
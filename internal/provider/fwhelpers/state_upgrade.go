@@ -0,0 +1,95 @@
+package fwhelpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// RawStateUpgrader returns a state upgrader from a prior schema version that
+// rewrites the raw JSON state with upgrade, which may be nil, and decodes the
+// result with the current schema.
+//
+// Decoding the JSON state covers most shape changes on its own: lists become
+// sets and the other way round, attributes that no longer exist are dropped
+// and new attributes are null. upgrade only handles renamed or restructured
+// attributes. JSON numbers are passed to it as json.Number.
+func RawStateUpgrader(upgrade func(state map[string]any) error) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil || req.RawState.JSON == nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					"The prior resource state is not in JSON format, which this provider version cannot upgrade. "+
+						"Please report this to the provider developers.",
+				)
+				return
+			}
+
+			value, err := UpgradeRawState(req.RawState.JSON, resp.State.Schema.Type().TerraformType(ctx), upgrade)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					fmt.Sprintf("Could not upgrade the prior resource state: %v", err),
+				)
+				return
+			}
+			resp.State.Raw = value
+		},
+	}
+}
+
+// UpgradeRawState rewrites the JSON state with upgrade and decodes it as typ.
+func UpgradeRawState(raw []byte, typ tftypes.Type, upgrade func(state map[string]any) error) (tftypes.Value, error) {
+	if upgrade != nil {
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		var state map[string]any
+		if err := decoder.Decode(&state); err != nil {
+			return tftypes.Value{}, fmt.Errorf("failed to decode state: %w", err)
+		}
+		if err := upgrade(state); err != nil {
+			return tftypes.Value{}, err
+		}
+		var err error
+		if raw, err = json.Marshal(state); err != nil {
+			return tftypes.Value{}, fmt.Errorf("failed to encode upgraded state: %w", err)
+		}
+	}
+
+	value, err := tftypes.ValueFromJSONWithOpts(raw, typ, tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true})
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("failed to convert state to the current schema: %w", err)
+	}
+	return value, nil
+}
+
+// StateObjects returns the objects of the list or set attribute name of a
+// JSON state object, skipping elements that are not objects.
+func StateObjects(state map[string]any, name string) []map[string]any {
+	elements, _ := state[name].([]any)
+	objects := make([]map[string]any, 0, len(elements))
+	for _, element := range elements {
+		if object, ok := element.(map[string]any); ok {
+			objects = append(objects, object)
+		}
+	}
+	return objects
+}
+
+// RenameStateAttribute moves the attribute from to to in a JSON state object,
+// unless the object already has a value for to.
+func RenameStateAttribute(state map[string]any, from, to string) {
+	value, ok := state[from]
+	if !ok {
+		return
+	}
+	delete(state, from)
+	if state[to] == nil {
+		state[to] = value
+	}
+}
@@ -0,0 +1,108 @@
+package fwhelpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+)
+
+var upgradeTestSchema = schema.Schema{
+	Version: 1,
+	Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{Required: true},
+		"tags": schema.SetAttribute{Optional: true, ElementType: types.StringType},
+	},
+	Blocks: map[string]schema.Block{
+		"entry": schema.SetNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"number": schema.Int64Attribute{Required: true},
+					"port":   schema.Int64Attribute{Optional: true},
+				},
+			},
+		},
+	},
+}
+
+type upgradeTestModel struct {
+	Name  types.String `tfsdk:"name"`
+	Tags  types.Set    `tfsdk:"tags"`
+	Entry []struct {
+		Number types.Int64 `tfsdk:"number"`
+		Port   types.Int64 `tfsdk:"port"`
+	} `tfsdk:"entry"`
+}
+
+func upgradeState(t *testing.T, raw string, upgrade func(map[string]any) error) (upgradeTestModel, *resource.UpgradeStateResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: upgradeTestSchema}}
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(raw)}}
+	RawStateUpgrader(upgrade).StateUpgrader(ctx, req, resp)
+
+	var m upgradeTestModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, &m)...)
+	}
+	return m, resp
+}
+
+func TestRawStateUpgrader(t *testing.T) {
+	t.Run("lists become sets", func(t *testing.T) {
+		m, resp := upgradeState(t, `{"name":"a","tags":["x","y"],"entry":[{"number":2,"port":80},{"number":1,"port":null}]}`, nil)
+		assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		assert.Equal(t, "a", m.Name.ValueString())
+		assert.Len(t, m.Tags.Elements(), 2)
+		assert.Len(t, m.Entry, 2)
+	})
+
+	t.Run("removed attributes are dropped and new ones are null", func(t *testing.T) {
+		m, resp := upgradeState(t, `{"name":"a","obsolete":true}`, nil)
+		assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		assert.True(t, m.Tags.IsNull())
+		assert.Empty(t, m.Entry)
+	})
+
+	t.Run("upgrade renames attributes", func(t *testing.T) {
+		m, resp := upgradeState(t, `{"label":"a","entry":[{"id":3,"port":443}]}`, func(state map[string]any) error {
+			RenameStateAttribute(state, "label", "name")
+			for _, entry := range StateObjects(state, "entry") {
+				RenameStateAttribute(entry, "id", "number")
+			}
+			return nil
+		})
+		assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		assert.Equal(t, "a", m.Name.ValueString())
+		if assert.Len(t, m.Entry, 1) {
+			assert.Equal(t, int64(3), m.Entry[0].Number.ValueInt64())
+			assert.Equal(t, int64(443), m.Entry[0].Port.ValueInt64())
+		}
+	})
+
+	t.Run("rename keeps an existing value", func(t *testing.T) {
+		m, resp := upgradeState(t, `{"label":"old","name":"new"}`, func(state map[string]any) error {
+			RenameStateAttribute(state, "label", "name")
+			return nil
+		})
+		assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		assert.Equal(t, "new", m.Name.ValueString())
+	})
+
+	t.Run("mismatched types fail", func(t *testing.T) {
+		_, resp := upgradeState(t, `{"name":"a","entry":"invalid"}`, nil)
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+
+	t.Run("missing JSON state fails", func(t *testing.T) {
+		resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: upgradeTestSchema}}
+		RawStateUpgrader(nil).StateUpgrader(context.Background(), resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{}}, resp)
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
@@ -0,0 +1,107 @@
+package fwhelpers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+// durationPattern matches Go duration strings such as "90s" or "1h30m".
+var durationPattern = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
+
+// Resource operations that can be given a timeout.
+const (
+	TimeoutCreate = "create"
+	TimeoutRead   = "read"
+	TimeoutUpdate = "update"
+	TimeoutDelete = "delete"
+)
+
+// TimeoutsModel describes the timeouts block of a resource.
+type TimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+// TimeoutsAttrTypes returns the attribute types for TimeoutsModel.
+func TimeoutsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		TimeoutCreate: types.StringType,
+		TimeoutRead:   types.StringType,
+		TimeoutUpdate: types.StringType,
+		TimeoutDelete: types.StringType,
+	}
+}
+
+// TimeoutsBlock returns the schema of the timeouts block for resources with
+// long-running operations. Each value bounds the whole operation and replaces
+// the provider-level command timeouts while it runs.
+func TimeoutsBlock() schema.SingleNestedBlock {
+	attrs := make(map[string]schema.Attribute, 4)
+	for _, op := range []string{TimeoutCreate, TimeoutRead, TimeoutUpdate, TimeoutDelete} {
+		attrs[op] = schema.StringAttribute{
+			Description: fmt.Sprintf("Time allowed for the %s operation in Go duration format (e.g., '30s', '15m'). Defaults to the provider timeouts.", op),
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(durationPattern, "must be a Go duration (e.g., '30s', '15m')"),
+			},
+		}
+	}
+	return schema.SingleNestedBlock{
+		Description: "Timeouts for operations on this resource.",
+		Attributes:  attrs,
+	}
+}
+
+// WithOperationTimeout applies the timeout configured for operation to ctx.
+// The returned cancel function must always be called. When no timeout is
+// configured, ctx is returned unchanged.
+func WithOperationTimeout(ctx context.Context, timeouts types.Object, operation string, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	noop := func() {}
+	if timeouts.IsNull() || timeouts.IsUnknown() {
+		return ctx, noop
+	}
+
+	var m TimeoutsModel
+	diags.Append(timeouts.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return ctx, noop
+	}
+
+	var value types.String
+	switch operation {
+	case TimeoutCreate:
+		value = m.Create
+	case TimeoutRead:
+		value = m.Read
+	case TimeoutUpdate:
+		value = m.Update
+	case TimeoutDelete:
+		value = m.Delete
+	}
+	if value.IsNull() || value.IsUnknown() {
+		return ctx, noop
+	}
+
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil || d <= 0 {
+		AppendDiagError(diags, "Invalid Timeout", fmt.Sprintf("The %s timeout must be a positive Go duration, got %q.", operation, value.ValueString()))
+		return ctx, noop
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d)
+	return client.WithCommandTimeout(ctx, d), cancel
+}
@@ -0,0 +1,67 @@
+package fwhelpers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func timeoutsObject(t *testing.T, create string) types.Object {
+	t.Helper()
+	createValue := types.StringNull()
+	if create != "" {
+		createValue = types.StringValue(create)
+	}
+	obj, diags := types.ObjectValue(TimeoutsAttrTypes(), map[string]attr.Value{
+		TimeoutCreate: createValue,
+		TimeoutRead:   types.StringNull(),
+		TimeoutUpdate: types.StringNull(),
+		TimeoutDelete: types.StringNull(),
+	})
+	assert.False(t, diags.HasError())
+	return obj
+}
+
+func TestWithOperationTimeout_Null(t *testing.T) {
+	var diags diag.Diagnostics
+	ctx, cancel := WithOperationTimeout(context.Background(), types.ObjectNull(TimeoutsAttrTypes()), TimeoutCreate, &diags)
+	defer cancel()
+
+	assert.False(t, diags.HasError())
+	_, hasDeadline := ctx.Deadline()
+	assert.False(t, hasDeadline, "null timeouts should not set a deadline")
+}
+
+func TestWithOperationTimeout_OperationNotSet(t *testing.T) {
+	var diags diag.Diagnostics
+	ctx, cancel := WithOperationTimeout(context.Background(), timeoutsObject(t, "20m"), TimeoutDelete, &diags)
+	defer cancel()
+
+	assert.False(t, diags.HasError())
+	_, hasDeadline := ctx.Deadline()
+	assert.False(t, hasDeadline, "unset operation timeout should not set a deadline")
+}
+
+func TestWithOperationTimeout_Set(t *testing.T) {
+	var diags diag.Diagnostics
+	ctx, cancel := WithOperationTimeout(context.Background(), timeoutsObject(t, "20m"), TimeoutCreate, &diags)
+	defer cancel()
+
+	assert.False(t, diags.HasError())
+	deadline, hasDeadline := ctx.Deadline()
+	assert.True(t, hasDeadline)
+	assert.WithinDuration(t, time.Now().Add(20*time.Minute), deadline, time.Minute)
+}
+
+func TestWithOperationTimeout_Invalid(t *testing.T) {
+	var diags diag.Diagnostics
+	_, cancel := WithOperationTimeout(context.Background(), timeoutsObject(t, "soon"), TimeoutCreate, &diags)
+	defer cancel()
+
+	assert.True(t, diags.HasError())
+}
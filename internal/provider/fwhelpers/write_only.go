@@ -0,0 +1,73 @@
+package fwhelpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Write-only secrets come as a pair of attributes next to the stored secret
+// attribute: "<name>_wo" holds the secret, which Terraform sends to the
+// provider but never stores in state or plan, and "<name>_wo_version" is
+// stored instead, so that changing it applies a new secret.
+
+// WriteOnlySuffix and WriteOnlyVersionSuffix name the write-only variant of a
+// secret attribute.
+const (
+	WriteOnlySuffix        = "_wo"
+	WriteOnlyVersionSuffix = "_wo_version"
+)
+
+// WriteOnlySecretAttribute returns the schema of the write-only variant of
+// the secret attribute name, checked by validators. It conflicts with name
+// and requires the version attribute, whose value in state tells that the
+// secret is write-only.
+func WriteOnlySecretAttribute(name string, validators ...validator.String) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("Write-only variant of %s, which is sent to the router but never stored in the Terraform state. "+
+			"Requires Terraform 1.11 or later and %s%s, which is changed to apply a new value.", name, name, WriteOnlyVersionSuffix),
+		Optional:  true,
+		Sensitive: true,
+		WriteOnly: true,
+		Validators: append([]validator.String{
+			stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName(name)),
+			stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName(name + WriteOnlyVersionSuffix)),
+		}, validators...),
+	}
+}
+
+// WriteOnlyVersionAttribute returns the schema of the version attribute of
+// the write-only secret attribute name.
+func WriteOnlyVersionAttribute(name string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: fmt.Sprintf("Version of %s%s. Any value; change it to apply a new %s%s.", name, WriteOnlySuffix, name, WriteOnlySuffix),
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName(name + WriteOnlySuffix)),
+		},
+	}
+}
+
+// WriteOnlyValue returns the secret of the write-only attribute at p in the
+// configuration, or stored when the write-only attribute is not set.
+// Write-only values are only available in the configuration, not in the
+// plan or state.
+func WriteOnlyValue(ctx context.Context, config tfsdk.Config, p path.Path, stored types.String, diags *diag.Diagnostics) string {
+	if config.Raw.IsNull() {
+		return GetStringValue(stored)
+	}
+	var value types.String
+	diags.Append(config.GetAttribute(ctx, p, &value)...)
+	if value.IsNull() || value.IsUnknown() {
+		return GetStringValue(stored)
+	}
+	return value.ValueString()
+}
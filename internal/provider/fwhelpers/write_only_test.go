@@ -0,0 +1,53 @@
+package fwhelpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteOnlyValue(t *testing.T) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password":            schema.StringAttribute{Optional: true, Sensitive: true},
+			"password_wo":         WriteOnlySecretAttribute("password"),
+			"password_wo_version": WriteOnlyVersionAttribute("password"),
+		},
+	}
+	objectType := s.Type().TerraformType(context.Background())
+	config := func(wo interface{}) tfsdk.Config {
+		return tfsdk.Config{Schema: s, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"password":            tftypes.NewValue(tftypes.String, nil),
+			"password_wo":         tftypes.NewValue(tftypes.String, wo),
+			"password_wo_version": tftypes.NewValue(tftypes.Number, nil),
+		})}
+	}
+
+	tests := []struct {
+		name   string
+		config tfsdk.Config
+		stored types.String
+		want   string
+	}{
+		{name: "write-only value", config: config("secret"), stored: types.StringNull(), want: "secret"},
+		{name: "stored value", config: config(nil), stored: types.StringValue("stored"), want: "stored"},
+		{name: "neither", config: config(nil), stored: types.StringNull(), want: ""},
+		{name: "no configuration", config: tfsdk.Config{Schema: s, Raw: tftypes.NewValue(objectType, nil)}, stored: types.StringValue("stored"), want: "stored"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := WriteOnlyValue(context.Background(), tt.config, path.Root("password_wo"), tt.stored, &diags)
+			assert.False(t, diags.HasError(), diags)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
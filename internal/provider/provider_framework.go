@@ -5,17 +5,48 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/sh1/terraform-provider-rtx/internal/client"
 	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/bgp_peers"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/command"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/dns_cache"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/environment"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/firmware_revisions"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/interface_counters"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/interfaces"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/ipsec_sa"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/ipv6_neighbors"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/l2ms_switches"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/l2tp_sessions"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/log"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/nat_descriptors"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/netvolante_status"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/ospf_neighbors"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/ping"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/pp_status"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/qos_status"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/datasources/running_config"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/functions/cidr_to_range"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/functions/ip_filter_rule"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/functions/normalize_mac"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/functions/service_port"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/access_list_extended"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/access_list_extended_ipv6"
@@ -31,29 +62,56 @@ import (
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/admin_user"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/bgp"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/bridge"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/bulk_config"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/certificate"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/class_map"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/clock_timezone"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/config_block"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/ddns"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/dhcp_binding"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/dhcp_scope"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/dns_server"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/dns_server_select"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/dns_static_host"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/exec"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/external_memory_backup"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/firmware_update"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/flow_export"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/httpd"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/icmp_stealth"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/ikev2_tunnel"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/interface_resource"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/ip_keepalive"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/ipsec_transport"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/ipsec_tunnel"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/ipv6_filter"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/ipv6_interface"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/ipv6_neighbor_static"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/ipv6_prefix"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/ipv6_rtadv"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/kron_policy"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/kron_schedule"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/l2ms_managed_switch"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/l2tp"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/l2tp_service"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/link_aggregation"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/lldp"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/loopback_interface"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/mld_proxy"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/mobile_wan"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/nat_descriptor_pool"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/nat_masquerade"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/nat_static"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/netvolante_dns"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/ospf"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/policy_map"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/port_mirroring"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/pp_interface"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/ppp_auth_user"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/pppoe"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/pptp"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/proxy_arp"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/radius_auth"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/service_policy"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/sftpd"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/shape"
@@ -64,13 +122,20 @@ import (
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/static_route"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/syslog"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/system"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/system_settings"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/traffic_threshold"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/tunnel"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/usb_host"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/vlan"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/vpn_address_pool"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/resources/wlan"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
 )
 
 // Ensure RTXFrameworkProvider satisfies various provider interfaces.
 var (
-	_ provider.Provider = &RTXFrameworkProvider{}
+	_ provider.Provider              = &RTXFrameworkProvider{}
+	_ provider.ProviderWithFunctions = &RTXFrameworkProvider{}
 )
 
 // RTXFrameworkProvider defines the provider implementation using Plugin Framework.
@@ -89,16 +154,39 @@ type RTXProviderModel struct {
 	PrivateKey           types.String `tfsdk:"private_key"`
 	PrivateKeyFile       types.String `tfsdk:"private_key_file"`
 	PrivateKeyPassphrase types.String `tfsdk:"private_key_passphrase"`
+	Certificate          types.String `tfsdk:"certificate"`
+	CertificateFile      types.String `tfsdk:"certificate_file"`
+	UseSSHAgent          types.Bool   `tfsdk:"use_ssh_agent"`
+	SSHAgentSocket       types.String `tfsdk:"ssh_agent_socket"`
 	AdminPassword        types.String `tfsdk:"admin_password"`
 	Port                 types.Int64  `tfsdk:"port"`
 	Timeout              types.Int64  `tfsdk:"timeout"`
 	SSHHostKey           types.String `tfsdk:"ssh_host_key"`
+	HostKeyFingerprint   types.String `tfsdk:"host_key_fingerprint"`
 	KnownHostsFile       types.String `tfsdk:"known_hosts_file"`
 	SkipHostKeyCheck     types.Bool   `tfsdk:"skip_host_key_check"`
+	StrictHostKeyCheck   types.Bool   `tfsdk:"strict_host_key_checking"`
 	MaxParallelism       types.Int64  `tfsdk:"max_parallelism"`
 	UseSFTP              types.Bool   `tfsdk:"use_sftp"`
 	SFTPConfigPath       types.String `tfsdk:"sftp_config_path"`
+	LockFile             types.String `tfsdk:"lock_file"`
+	BackupDir            types.String `tfsdk:"backup_dir"`
+	RollbackFile         types.String `tfsdk:"rollback_file"`
+	SaveMode             types.String `tfsdk:"save_mode"`
+	SaveDelay            types.String `tfsdk:"save_delay"`
+	PlanCommands         types.Bool   `tfsdk:"plan_commands"`
+	VerifyApply          types.Bool   `tfsdk:"verify_apply"`
+	ConsoleEncoding      types.String `tfsdk:"console_encoding"`
 	SSHSessionPool       types.List   `tfsdk:"ssh_session_pool"`
+	JumpHost             types.List   `tfsdk:"jump_host"`
+	Retry                types.List   `tfsdk:"retry"`
+	Timeouts             types.List   `tfsdk:"timeouts"`
+	Keepalive            types.List   `tfsdk:"keepalive"`
+	Pacing               types.List   `tfsdk:"pacing"`
+	Prompts              types.List   `tfsdk:"prompts"`
+	HTTPAPI              types.List   `tfsdk:"http_api"`
+	YNO                  types.List   `tfsdk:"yno"`
+	Credentials          types.List   `tfsdk:"credentials"`
 }
 
 // SSHSessionPoolModel describes the SSH session pool configuration.
@@ -108,6 +196,81 @@ type SSHSessionPoolModel struct {
 	IdleTimeout types.String `tfsdk:"idle_timeout"`
 }
 
+// JumpHostModel describes the SSH jump host configuration.
+type JumpHostModel struct {
+	Host                 types.String `tfsdk:"host"`
+	Port                 types.Int64  `tfsdk:"port"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	PrivateKey           types.String `tfsdk:"private_key"`
+	PrivateKeyFile       types.String `tfsdk:"private_key_file"`
+	PrivateKeyPassphrase types.String `tfsdk:"private_key_passphrase"`
+	Certificate          types.String `tfsdk:"certificate"`
+	CertificateFile      types.String `tfsdk:"certificate_file"`
+	HostKey              types.String `tfsdk:"host_key"`
+	HostKeyFingerprint   types.String `tfsdk:"host_key_fingerprint"`
+	KnownHostsFile       types.String `tfsdk:"known_hosts_file"`
+}
+
+// RetryModel describes the retry configuration for transient errors.
+type RetryModel struct {
+	MaxRetries types.Int64  `tfsdk:"max_retries"`
+	BaseDelay  types.String `tfsdk:"base_delay"`
+	MaxDelay   types.String `tfsdk:"max_delay"`
+}
+
+// KeepaliveModel describes the SSH keepalive configuration.
+type KeepaliveModel struct {
+	Interval  types.String `tfsdk:"interval"`
+	MaxMissed types.Int64  `tfsdk:"max_missed"`
+}
+
+// PacingModel describes how fast commands are typed into the router console.
+type PacingModel struct {
+	CommandDelay   types.String `tfsdk:"command_delay"`
+	CharacterDelay types.String `tfsdk:"character_delay"`
+	VerifyEcho     types.Bool   `tfsdk:"verify_echo"`
+}
+
+// PromptsModel describes the console prompt pattern overrides.
+type PromptsModel struct {
+	Login        types.String `tfsdk:"login"`
+	Admin        types.String `tfsdk:"admin"`
+	Confirmation types.String `tfsdk:"confirmation"`
+}
+
+// HTTPAPIModel describes the router's web API used for status reads.
+type HTTPAPIModel struct {
+	URL                types.String `tfsdk:"url"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+}
+
+// YNOModel describes the Yamaha Network Organizer connection.
+type YNOModel struct {
+	APIURL   types.String `tfsdk:"api_url"`
+	APIToken types.String `tfsdk:"api_token"`
+	DeviceID types.String `tfsdk:"device_id"`
+}
+
+// CredentialsModel describes where to read credentials that are not set directly.
+type CredentialsModel struct {
+	PasswordEnv              types.String `tfsdk:"password_env"`
+	AdminPasswordEnv         types.String `tfsdk:"admin_password_env"`
+	PasswordFile             types.String `tfsdk:"password_file"`
+	AdminPasswordFile        types.String `tfsdk:"admin_password_file"`
+	PrivateKeyPassphraseFile types.String `tfsdk:"private_key_passphrase_file"`
+	Command                  types.List   `tfsdk:"command"`
+	CommandTimeout           types.String `tfsdk:"command_timeout"`
+}
+
+// TimeoutsModel describes the default router response timeouts.
+type TimeoutsModel struct {
+	Command       types.String `tfsdk:"command"`
+	Login         types.String `tfsdk:"login"`
+	Save          types.String `tfsdk:"save"`
+	LongOperation types.String `tfsdk:"long_operation"`
+}
+
 // NewFramework creates a new Framework provider factory function.
 func NewFramework(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -155,8 +318,24 @@ func (p *RTXFrameworkProvider) Schema(ctx context.Context, req provider.SchemaRe
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"certificate": schema.StringAttribute{
+				Description: "OpenSSH certificate signed for the private key (contents of the '-cert.pub' file). Can be set with RTX_CERTIFICATE environment variable.",
+				Optional:    true,
+			},
+			"certificate_file": schema.StringAttribute{
+				Description: "Path to the OpenSSH certificate for the private key. Defaults to '<private_key_file>-cert.pub' when that file exists. Can be set with RTX_CERTIFICATE_FILE environment variable.",
+				Optional:    true,
+			},
+			"use_ssh_agent": schema.BoolAttribute{
+				Description: "Authenticate with keys from a running ssh-agent when neither private_key nor private_key_file is set. Defaults to true. Can be set with RTX_USE_SSH_AGENT environment variable.",
+				Optional:    true,
+			},
+			"ssh_agent_socket": schema.StringAttribute{
+				Description: "Path to the ssh-agent socket. Defaults to the SSH_AUTH_SOCK environment variable. Can be set with RTX_SSH_AGENT_SOCKET environment variable.",
+				Optional:    true,
+			},
 			"admin_password": schema.StringAttribute{
-				Description: "Administrator password for RTX router configuration changes. If not set, uses the same as password. Can be set with RTX_ADMIN_PASSWORD environment variable.",
+				Description: "Administrator password for RTX router configuration changes. The provider switches to administrator level for configuration commands, or whenever the router reports that a command needs it, and leaves it when the session ends. If not set, uses the same as password. Can be set with RTX_ADMIN_PASSWORD environment variable.",
 				Optional:    true,
 				Sensitive:   true,
 			},
@@ -172,14 +351,28 @@ func (p *RTXFrameworkProvider) Schema(ctx context.Context, req provider.SchemaRe
 				Description: "SSH host public key for verification (base64 encoded). If unset, uses known_hosts_file. Can be set with RTX_SSH_HOST_KEY environment variable.",
 				Optional:    true,
 			},
+			"host_key_fingerprint": schema.StringAttribute{
+				Description: "Pinned SSH host key fingerprint as printed by 'ssh-keygen -l' (e.g., 'SHA256:...'; legacy MD5 fingerprints are also accepted). " +
+					"Used instead of known_hosts_file when set; ssh_host_key takes priority. Can be set with RTX_HOST_KEY_FINGERPRINT environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(client.HostKeyFingerprintPattern, "must be a SHA256 or MD5 host key fingerprint"),
+				},
+			},
 			"known_hosts_file": schema.StringAttribute{
-				Description: "Path to known_hosts file for SSH host key verification. Defaults to ~/.ssh/known_hosts. Can be set with RTX_KNOWN_HOSTS_FILE environment variable.",
-				Optional:    true,
+				Description: "Path to known_hosts file for SSH host key verification. Defaults to ~/.ssh/known_hosts. " +
+					"Connections are rejected when the file cannot be read, unless strict_host_key_checking is false or skip_host_key_check is set. Can be set with RTX_KNOWN_HOSTS_FILE environment variable.",
+				Optional: true,
 			},
 			"skip_host_key_check": schema.BoolAttribute{
 				Description: "Skip SSH host key verification. WARNING: This is insecure and should only be used for testing. Can be set with RTX_SKIP_HOST_KEY_CHECK environment variable.",
 				Optional:    true,
 			},
+			"strict_host_key_checking": schema.BoolAttribute{
+				Description: "Reject connections whose host key does not match ssh_host_key, host_key_fingerprint or known_hosts_file, including hosts missing from known_hosts. " +
+					"When false, verification failures are logged as warnings and the connection proceeds. Defaults to true. Can be set with RTX_STRICT_HOST_KEY_CHECKING environment variable.",
+				Optional: true,
+			},
 			"max_parallelism": schema.Int64Attribute{
 				Description: "Maximum number of concurrent operations. RTX routers support up to 8 simultaneous SSH connections, but lower values are more stable. Defaults to 4. Can be set with RTX_MAX_PARALLELISM environment variable.",
 				Optional:    true,
@@ -188,10 +381,60 @@ func (p *RTXFrameworkProvider) Schema(ctx context.Context, req provider.SchemaRe
 				Description: "Use SFTP-based configuration reading for faster bulk operations. Defaults to false. Can be set with RTX_USE_SFTP environment variable.",
 				Optional:    true,
 			},
+			"save_mode": schema.StringAttribute{
+				Description: "When configuration changes are saved to flash memory: 'immediate' saves after every change, " +
+					"'batch' saves once when the changes running at the same time have completed, as part of the last of them, so that a failed save fails that change (much faster for large applies and easier on flash), " +
+					"'manual' never saves so that the operator runs 'save' on the router. Defaults to 'immediate'. Can be set with RTX_SAVE_MODE environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(client.SaveModeImmediate), string(client.SaveModeBatch), string(client.SaveModeManual)),
+				},
+			},
+			"save_delay": schema.StringAttribute{
+				Description:        "No longer used: 'batch' save mode saves when the last running change completes instead of after a delay.",
+				DeprecationMessage: "save_delay no longer has any effect and will be removed in a future version. 'batch' save mode saves when the last running change completes.",
+				Optional:           true,
+			},
+			"console_encoding": schema.StringAttribute{
+				Description: "Character encoding of the router console ('console character' on the router). Commands and output are transcoded so that Japanese descriptions and messages are read and written correctly: " +
+					"'utf-8' for ja.utf8 and ascii, 'shift_jis' for ja.sjis, 'euc-jp' for euc-jp. 'auto' treats output that is not valid UTF-8 as Shift_JIS or EUC-JP " +
+					"and sends later commands in the detected encoding. Defaults to 'auto'. Can be set with RTX_CONSOLE_ENCODING environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(client.ConsoleEncodingAuto), string(client.ConsoleEncodingUTF8), string(client.ConsoleEncodingShiftJIS), string(client.ConsoleEncodingEUCJP)),
+				},
+			},
+			"plan_commands": schema.BoolAttribute{
+				Description: "Dry-run every planned change against the router and show the exact RTX commands it would send as a warning in the plan output, " +
+					"so that changes can be reviewed as familiar CLI. Only read-only commands reach the router while planning; commands containing secrets are redacted. " +
+					"Defaults to false. Can be set with RTX_PLAN_COMMANDS environment variable.",
+				Optional: true,
+			},
+			"verify_apply": schema.BoolAttribute{
+				Description: "Read every created or updated resource back from the router and fail the apply when the router configuration differs from what was sent, " +
+					"for example when the router silently ignored or rewrote a command. The difference is shown as RTX commands. " +
+					"Defaults to false. Can be set with RTX_VERIFY_APPLY environment variable.",
+				Optional: true,
+			},
 			"sftp_config_path": schema.StringAttribute{
 				Description: "SFTP path to the configuration file (e.g., /system/config0). If empty, the path will be auto-detected. Can be set with RTX_SFTP_CONFIG_PATH environment variable.",
 				Optional:    true,
 			},
+			"lock_file": schema.StringAttribute{
+				Description: "Path to a lock file held while resources are changed. Changes to one router are always serialized within the provider, each resource change holding the router for all of its commands; " +
+					"setting a lock file also serializes them across Terraform processes that use the same file (e.g., concurrent runs in CI). Can be set with RTX_LOCK_FILE environment variable.",
+				Optional: true,
+			},
+			"backup_dir": schema.StringAttribute{
+				Description: "Directory to save the full router configuration to before the first change of each apply. The output of `show config` is written to a timestamped file " +
+					"named after the router, which can be restored manually if an apply goes wrong. Can be set with RTX_BACKUP_DIR environment variable.",
+				Optional: true,
+			},
+			"rollback_file": schema.StringAttribute{
+				Description: "Path to a file that receives the RTX commands undoing every change of the apply, newest change first, so that an emergency rollback can be pasted into the router console. " +
+					"The file is rewritten after each change; commands containing secrets are redacted. Can be set with RTX_ROLLBACK_FILE environment variable.",
+				Optional: true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"ssh_session_pool": schema.ListNestedBlock{
@@ -213,6 +456,260 @@ func (p *RTXFrameworkProvider) Schema(ctx context.Context, req provider.SchemaRe
 					},
 				},
 			},
+			"retry": schema.ListNestedBlock{
+				Description: "Retry configuration for transient errors such as connection resets, busy responses and login races. " +
+					"Configuration commands are only resent when they failed before reaching the router, such as while connecting or logging in; read-only commands are also resent after a dropped connection. " +
+					"Authentication failures, host key mismatches and command errors reported by the router are never retried. Without this block, commands sent through the SSH session pool or YNO are retried up to twice and commands on single SSH connections are not retried.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_retries": schema.Int64Attribute{
+							Description: "Maximum number of retries per command. Set to 0 to disable retries. Defaults to 5.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"base_delay": schema.StringAttribute{
+							Description: "Delay before the first retry; the delay doubles on each subsequent retry. Uses Go duration format (e.g., '100ms', '1s'). Defaults to '100ms'.",
+							Optional:    true,
+						},
+						"max_delay": schema.StringAttribute{
+							Description: "Upper bound for the delay between retries. Uses Go duration format (e.g., '10s', '1m'). Defaults to '10s'.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"keepalive": schema.ListNestedBlock{
+				Description: "SSH keepalive configuration for pooled connections. Keepalives stop idle connections from being dropped between commands, " +
+					"and connections that stop answering are replaced transparently so that long applies do not fail halfway.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"interval": schema.StringAttribute{
+							Description: "Interval between keepalive requests. Uses Go duration format (e.g., '15s', '1m'). Set to '0s' to disable keepalives. Defaults to '30s'.",
+							Optional:    true,
+						},
+						"max_missed": schema.Int64Attribute{
+							Description: "Number of unanswered keepalives in a row after which the connection is closed and replaced. Defaults to 3.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"pacing": schema.ListNestedBlock{
+				Description: "Console input pacing for routers that drop characters when commands are sent too fast. " +
+					"Commands are sent at full speed unless this block is set.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command_delay": schema.StringAttribute{
+							Description: "Minimum pause between consecutive commands on a session. Uses Go duration format (e.g., '100ms', '1s'). Defaults to '0s'.",
+							Optional:    true,
+						},
+						"character_delay": schema.StringAttribute{
+							Description: "Pause after each character typed into the console. Uses Go duration format (e.g., '2ms'). Defaults to '0s'.",
+							Optional:    true,
+						},
+						"verify_echo": schema.BoolAttribute{
+							Description: "Wait for the router to echo each command intact before pressing Enter. " +
+								"A command whose echo does not match is discarded without being run and retried. Defaults to false.",
+							Optional: true,
+						},
+					},
+				},
+			},
+			"prompts": schema.ListNestedBlock{
+				Description: "Regular expressions for the console prompts, for routers whose prompt was changed with 'console prompt' " +
+					"in a way the built-in detection does not recognize. Each pattern is matched against the last line of output " +
+					"(e.g., '^office\\$ ?$'). Prompts not set here are detected as usual.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"login": schema.StringAttribute{
+							Description: "Pattern for the user-mode prompt. Defaults to a line ending in '>'.",
+							Optional:    true,
+						},
+						"admin": schema.StringAttribute{
+							Description: "Pattern for the administrator prompt after 'administrator'. Defaults to a line ending in '#'.",
+							Optional:    true,
+						},
+						"confirmation": schema.StringAttribute{
+							Description: "Pattern for the confirmation questions asked when saving the configuration on logout " +
+								"or regenerating the SSHD host key, in addition to the built-in wording.",
+							Optional: true,
+						},
+					},
+				},
+			},
+			"http_api": schema.ListNestedBlock{
+				Description: "Web API of routers whose firmware provides one. Status reads such as DHCP leases and interface state " +
+					"are sent to it with the provider's username and password instead of an SSH console session, " +
+					"and fall back to SSH when the API fails. Configuration changes always use SSH.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Description: "URL of the API's command endpoint (e.g., 'https://192.168.1.1/api/command').",
+							Required:    true,
+						},
+						"insecure_skip_verify": schema.BoolAttribute{
+							Description: "Accept any TLS certificate, such as the router's self-signed certificate. Defaults to false.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"yno": schema.ListNestedBlock{
+				Description: "Manage the router through Yamaha Network Organizer (YNO) instead of connecting to it directly, " +
+					"for routers without direct management access. Commands are relayed by YNO to the router, " +
+					"so SSH settings and the password are not used; host only names the router in logs and lock files. " +
+					"Password changes, SSHD host key generation and use_sftp are not available through YNO.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"api_url": schema.StringAttribute{
+							Description: "Base URL of the YNO API.",
+							Required:    true,
+						},
+						"api_token": schema.StringAttribute{
+							Description: "YNO API access token. Can be set with RTX_YNO_API_TOKEN environment variable.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"device_id": schema.StringAttribute{
+							Description: "Identifier of the router in YNO.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"credentials": schema.ListNestedBlock{
+				Description: "External sources for credentials, so that secrets do not have to be written in configuration files. " +
+					"Sources only fill credentials that are not set by the provider attributes or their RTX_* environment variables, " +
+					"and are consulted in order: environment variables, files, then the command.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"password_env": schema.StringAttribute{
+							Description: "Name of an environment variable holding the login password.",
+							Optional:    true,
+						},
+						"admin_password_env": schema.StringAttribute{
+							Description: "Name of an environment variable holding the administrator password.",
+							Optional:    true,
+						},
+						"password_file": schema.StringAttribute{
+							Description: "Path to a file containing the login password. A trailing newline is ignored.",
+							Optional:    true,
+						},
+						"admin_password_file": schema.StringAttribute{
+							Description: "Path to a file containing the administrator password. A trailing newline is ignored.",
+							Optional:    true,
+						},
+						"private_key_passphrase_file": schema.StringAttribute{
+							Description: "Path to a file containing the private key passphrase. A trailing newline is ignored.",
+							Optional:    true,
+						},
+						"command": schema.ListAttribute{
+							Description: "External command (program followed by its arguments, run without a shell) that prints credentials, e.g. a secrets manager CLI. " +
+								"Output that is a JSON object may set 'username', 'password', 'admin_password' and 'private_key_passphrase'; any other output is used as the password. " +
+								"The router host is passed in the RTX_HOST environment variable.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"command_timeout": schema.StringAttribute{
+							Description: "Time allowed for the command to finish. Uses Go duration format (e.g., '10s', '1m'). Defaults to '30s'.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"timeouts": schema.ListNestedBlock{
+				Description: "Default timeouts for router responses. Resources with a timeouts block override these for their own operations. " +
+					"All values use Go duration format (e.g., '30s', '5m').",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command": schema.StringAttribute{
+							Description: "How long to wait for the output of a command. Large outputs such as 'show config' always get at least 2 minutes. Defaults to '15s'.",
+							Optional:    true,
+						},
+						"login": schema.StringAttribute{
+							Description: "How long to wait for the initial prompt and for administrator and password prompts. Defaults to '10s'.",
+							Optional:    true,
+						},
+						"save": schema.StringAttribute{
+							Description: "How long to wait for the configuration to be saved to flash memory. Defaults to '30s'.",
+							Optional:    true,
+						},
+						"long_operation": schema.StringAttribute{
+							Description: "How long to wait for long-running operations such as SSH host key generation and firmware updates. Defaults to '10m'.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"jump_host": schema.ListNestedBlock{
+				Description: "SSH jump host (bastion) used to reach routers on isolated management networks. All SSH and SFTP connections to the router are tunnelled through it.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							Description: "The hostname or IP address of the jump host.",
+							Required:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "SSH port of the jump host. Defaults to 22.",
+							Optional:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "Username for jump host authentication.",
+							Required:    true,
+						},
+						"password": schema.StringAttribute{
+							Description: "Password for jump host authentication.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"private_key": schema.StringAttribute{
+							Description: "SSH private key content (PEM format) for jump host authentication. If neither private_key nor private_key_file is set, the SSH agent is used as configured by use_ssh_agent and ssh_agent_socket.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"private_key_file": schema.StringAttribute{
+							Description: "Path to SSH private key file for jump host authentication.",
+							Optional:    true,
+						},
+						"private_key_passphrase": schema.StringAttribute{
+							Description: "Passphrase for the encrypted jump host private key.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"certificate": schema.StringAttribute{
+							Description: "OpenSSH certificate signed for the jump host private key.",
+							Optional:    true,
+						},
+						"certificate_file": schema.StringAttribute{
+							Description: "Path to the OpenSSH certificate for the jump host private key. Defaults to '<private_key_file>-cert.pub' when that file exists.",
+							Optional:    true,
+						},
+						"host_key": schema.StringAttribute{
+							Description: "SSH host public key of the jump host for verification (base64 encoded). If unset, uses known_hosts_file.",
+							Optional:    true,
+						},
+						"host_key_fingerprint": schema.StringAttribute{
+							Description: "Pinned SSH host key fingerprint of the jump host (e.g., 'SHA256:...'). Used instead of known_hosts_file when set; host_key takes priority.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(client.HostKeyFingerprintPattern, "must be a SHA256 or MD5 host key fingerprint"),
+							},
+						},
+						"known_hosts_file": schema.StringAttribute{
+							Description: "Path to known_hosts file for jump host key verification. Defaults to the provider's known_hosts_file. skip_host_key_check also applies to the jump host.",
+							Optional:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -237,17 +734,81 @@ func (p *RTXFrameworkProvider) Configure(ctx context.Context, req provider.Confi
 	privateKey := getStringValue(config.PrivateKey, "RTX_PRIVATE_KEY", "")
 	privateKeyFile := getStringValue(config.PrivateKeyFile, "RTX_PRIVATE_KEY_FILE", "")
 	privateKeyPassphrase := getStringValue(config.PrivateKeyPassphrase, "RTX_PRIVATE_KEY_PASSPHRASE", "")
+	certificate := getStringValue(config.Certificate, "RTX_CERTIFICATE", "")
+	certificateFile := expandHomeDir(getStringValue(config.CertificateFile, "RTX_CERTIFICATE_FILE", ""))
+	sshAgentSocket := expandHomeDir(getStringValue(config.SSHAgentSocket, "RTX_SSH_AGENT_SOCKET", ""))
 	adminPassword := getStringValue(config.AdminPassword, "RTX_ADMIN_PASSWORD", "")
 	sshHostKey := getStringValue(config.SSHHostKey, "RTX_SSH_HOST_KEY", "")
 	knownHostsFile := getStringValue(config.KnownHostsFile, "RTX_KNOWN_HOSTS_FILE", "~/.ssh/known_hosts")
 	sftpConfigPath := getStringValue(config.SFTPConfigPath, "RTX_SFTP_CONFIG_PATH", "")
+	lockFile := expandHomeDir(getStringValue(config.LockFile, "RTX_LOCK_FILE", ""))
+	backupDir := expandHomeDir(getStringValue(config.BackupDir, "RTX_BACKUP_DIR", ""))
+	rollbackFile := expandHomeDir(getStringValue(config.RollbackFile, "RTX_ROLLBACK_FILE", ""))
+	saveMode := getStringValue(config.SaveMode, "RTX_SAVE_MODE", string(client.SaveModeImmediate))
+	consoleEncoding := getStringValue(config.ConsoleEncoding, "RTX_CONSOLE_ENCODING", string(client.ConsoleEncodingAuto))
 
 	port := getInt64Value(config.Port, "RTX_PORT", 22)
 	timeout := getInt64Value(config.Timeout, "RTX_TIMEOUT", 30)
 	maxParallelism := getInt64Value(config.MaxParallelism, "RTX_MAX_PARALLELISM", 4)
 
 	skipHostKeyCheck := getBoolValue(config.SkipHostKeyCheck, "RTX_SKIP_HOST_KEY_CHECK", false)
+	hostKeyFingerprint := getStringValue(config.HostKeyFingerprint, "RTX_HOST_KEY_FINGERPRINT", "")
+	strictHostKeyChecking := getBoolValue(config.StrictHostKeyCheck, "RTX_STRICT_HOST_KEY_CHECKING", true)
 	useSFTP := getBoolValue(config.UseSFTP, "RTX_USE_SFTP", false)
+	useSSHAgent := getBoolValue(config.UseSSHAgent, "RTX_USE_SSH_AGENT", true)
+	planCommands := getBoolValue(config.PlanCommands, "RTX_PLAN_COMMANDS", false)
+	verifyApply := getBoolValue(config.VerifyApply, "RTX_VERIFY_APPLY", false)
+
+	// Fill missing credentials from the credentials block if provided
+	if !config.Credentials.IsNull() && !config.Credentials.IsUnknown() {
+		var credentialConfigs []CredentialsModel
+		resp.Diagnostics.Append(config.Credentials.ElementsAs(ctx, &credentialConfigs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(credentialConfigs) > 0 {
+			credentialConfig := credentialConfigs[0]
+			sources := client.CredentialSources{
+				PasswordEnv:              credentialConfig.PasswordEnv.ValueString(),
+				AdminPasswordEnv:         credentialConfig.AdminPasswordEnv.ValueString(),
+				PasswordFile:             credentialConfig.PasswordFile.ValueString(),
+				AdminPasswordFile:        credentialConfig.AdminPasswordFile.ValueString(),
+				PrivateKeyPassphraseFile: credentialConfig.PrivateKeyPassphraseFile.ValueString(),
+			}
+			if !credentialConfig.Command.IsNull() && !credentialConfig.Command.IsUnknown() {
+				resp.Diagnostics.Append(credentialConfig.Command.ElementsAs(ctx, &sources.Command, false)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+			}
+			sources.CommandTimeout = parseTimeoutAttribute(credentialConfig.CommandTimeout, path.Root("credentials").AtListIndex(0).AtName("command_timeout"), &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			creds, err := sources.Resolve(ctx, host)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("credentials"),
+					"Unable to Read RTX Credentials",
+					"The provider could not read credentials from the configured sources: "+err.Error(),
+				)
+				return
+			}
+			if username == "" {
+				username = creds.Username
+			}
+			if password == "" {
+				password = creds.Password
+			}
+			if adminPassword == "" {
+				adminPassword = creds.AdminPassword
+			}
+			if privateKeyPassphrase == "" {
+				privateKeyPassphrase = creds.PrivateKeyPassphrase
+			}
+		}
+	}
 
 	// Validate required fields
 	if host == "" {
@@ -297,16 +858,211 @@ func (p *RTXFrameworkProvider) Configure(ctx context.Context, req provider.Confi
 		}
 	}
 
+	// Read retry block if provided. Without it each executor keeps its own
+	// default, which for direct SSH connections is not to retry.
+	var retryStrategy client.RetryStrategy
+	if !config.Retry.IsNull() && !config.Retry.IsUnknown() {
+		var retryConfigs []RetryModel
+		resp.Diagnostics.Append(config.Retry.ElementsAs(ctx, &retryConfigs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(retryConfigs) > 0 {
+			retryConfig := retryConfigs[0]
+			backoff := client.NewExponentialBackoff()
+			if !retryConfig.MaxRetries.IsNull() && !retryConfig.MaxRetries.IsUnknown() {
+				backoff.MaxRetries = int(retryConfig.MaxRetries.ValueInt64())
+			}
+			if !retryConfig.BaseDelay.IsNull() && !retryConfig.BaseDelay.IsUnknown() {
+				if parsed, err := time.ParseDuration(retryConfig.BaseDelay.ValueString()); err == nil && parsed > 0 {
+					backoff.BaseDelay = parsed
+				} else {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("retry").AtListIndex(0).AtName("base_delay"),
+						"Invalid Retry Base Delay",
+						fmt.Sprintf("base_delay must be a positive Go duration (e.g., '100ms'), got %q.", retryConfig.BaseDelay.ValueString()),
+					)
+				}
+			}
+			if !retryConfig.MaxDelay.IsNull() && !retryConfig.MaxDelay.IsUnknown() {
+				if parsed, err := time.ParseDuration(retryConfig.MaxDelay.ValueString()); err == nil && parsed > 0 {
+					backoff.MaxDelay = parsed
+				} else {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("retry").AtListIndex(0).AtName("max_delay"),
+						"Invalid Retry Max Delay",
+						fmt.Sprintf("max_delay must be a positive Go duration (e.g., '10s'), got %q.", retryConfig.MaxDelay.ValueString()),
+					)
+				}
+			}
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			retryStrategy = backoff
+		}
+	}
+
+	// Read timeouts block if provided (zero values use the client defaults)
+	var timeouts client.Timeouts
+	if !config.Timeouts.IsNull() && !config.Timeouts.IsUnknown() {
+		var timeoutsConfigs []TimeoutsModel
+		resp.Diagnostics.Append(config.Timeouts.ElementsAs(ctx, &timeoutsConfigs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(timeoutsConfigs) > 0 {
+			timeoutsConfig := timeoutsConfigs[0]
+			timeoutsPath := path.Root("timeouts").AtListIndex(0)
+			timeouts.Command = parseTimeoutAttribute(timeoutsConfig.Command, timeoutsPath.AtName("command"), &resp.Diagnostics)
+			timeouts.Login = parseTimeoutAttribute(timeoutsConfig.Login, timeoutsPath.AtName("login"), &resp.Diagnostics)
+			timeouts.Save = parseTimeoutAttribute(timeoutsConfig.Save, timeoutsPath.AtName("save"), &resp.Diagnostics)
+			timeouts.LongOperation = parseTimeoutAttribute(timeoutsConfig.LongOperation, timeoutsPath.AtName("long_operation"), &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	// SSH keepalive configuration (defaults)
+	keepalive := client.DefaultSSHKeepaliveConfig()
+
+	// Read keepalive block if provided
+	if !config.Keepalive.IsNull() && !config.Keepalive.IsUnknown() {
+		var keepaliveConfigs []KeepaliveModel
+		resp.Diagnostics.Append(config.Keepalive.ElementsAs(ctx, &keepaliveConfigs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(keepaliveConfigs) > 0 {
+			keepaliveConfig := keepaliveConfigs[0]
+			if !keepaliveConfig.Interval.IsNull() && !keepaliveConfig.Interval.IsUnknown() {
+				if parsed, err := time.ParseDuration(keepaliveConfig.Interval.ValueString()); err == nil && parsed >= 0 {
+					keepalive.Interval = parsed
+				} else {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("keepalive").AtListIndex(0).AtName("interval"),
+						"Invalid Keepalive Interval",
+						fmt.Sprintf("interval must be a Go duration (e.g., '30s'), or '0s' to disable keepalives, got %q.", keepaliveConfig.Interval.ValueString()),
+					)
+					return
+				}
+			}
+			if !keepaliveConfig.MaxMissed.IsNull() && !keepaliveConfig.MaxMissed.IsUnknown() {
+				keepalive.MaxMissed = int(keepaliveConfig.MaxMissed.ValueInt64())
+			}
+		}
+	}
+
+	// Read pacing block if provided (zero values send at full speed)
+	var pacing client.Pacing
+	if !config.Pacing.IsNull() && !config.Pacing.IsUnknown() {
+		var pacingConfigs []PacingModel
+		resp.Diagnostics.Append(config.Pacing.ElementsAs(ctx, &pacingConfigs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(pacingConfigs) > 0 {
+			pacingConfig := pacingConfigs[0]
+			pacingPath := path.Root("pacing").AtListIndex(0)
+			pacing.CommandDelay = parseDelayAttribute(pacingConfig.CommandDelay, pacingPath.AtName("command_delay"), &resp.Diagnostics)
+			pacing.CharacterDelay = parseDelayAttribute(pacingConfig.CharacterDelay, pacingPath.AtName("character_delay"), &resp.Diagnostics)
+			pacing.VerifyEcho = pacingConfig.VerifyEcho.ValueBool()
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	// Read prompts block if provided
+	var prompts *client.PromptConfig
+	if !config.Prompts.IsNull() && !config.Prompts.IsUnknown() {
+		var promptConfigs []PromptsModel
+		resp.Diagnostics.Append(config.Prompts.ElementsAs(ctx, &promptConfigs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(promptConfigs) > 0 {
+			prompts = &client.PromptConfig{
+				Login:        promptConfigs[0].Login.ValueString(),
+				Admin:        promptConfigs[0].Admin.ValueString(),
+				Confirmation: promptConfigs[0].Confirmation.ValueString(),
+			}
+		}
+	}
+
+	// Read http_api block if provided
+	var httpAPI *client.HTTPAPIConfig
+	if !config.HTTPAPI.IsNull() && !config.HTTPAPI.IsUnknown() {
+		var apiConfigs []HTTPAPIModel
+		resp.Diagnostics.Append(config.HTTPAPI.ElementsAs(ctx, &apiConfigs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(apiConfigs) > 0 {
+			httpAPI = &client.HTTPAPIConfig{
+				URL:                apiConfigs[0].URL.ValueString(),
+				InsecureSkipVerify: apiConfigs[0].InsecureSkipVerify.ValueBool(),
+			}
+		}
+	}
+
+	// Read yno block if provided
+	var yno *client.YNOConfig
+	if !config.YNO.IsNull() && !config.YNO.IsUnknown() {
+		var ynoConfigs []YNOModel
+		resp.Diagnostics.Append(config.YNO.ElementsAs(ctx, &ynoConfigs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(ynoConfigs) > 0 {
+			yno = &client.YNOConfig{
+				APIURL:   ynoConfigs[0].APIURL.ValueString(),
+				APIToken: getStringValue(ynoConfigs[0].APIToken, "RTX_YNO_API_TOKEN", ""),
+				DeviceID: ynoConfigs[0].DeviceID.ValueString(),
+			}
+		}
+	}
+
+	// Read jump_host block if provided
+	var jumpHost *client.JumpHostConfig
+	if !config.JumpHost.IsNull() && !config.JumpHost.IsUnknown() {
+		var jumpConfigs []JumpHostModel
+		resp.Diagnostics.Append(config.JumpHost.ElementsAs(ctx, &jumpConfigs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(jumpConfigs) > 0 {
+			jumpConfig := jumpConfigs[0]
+			jumpHost = &client.JumpHostConfig{
+				Host:                 jumpConfig.Host.ValueString(),
+				Port:                 int(getInt64Value(jumpConfig.Port, "", 22)),
+				Username:             jumpConfig.Username.ValueString(),
+				Password:             jumpConfig.Password.ValueString(),
+				PrivateKey:           jumpConfig.PrivateKey.ValueString(),
+				PrivateKeyFile:       jumpConfig.PrivateKeyFile.ValueString(),
+				PrivateKeyPassphrase: jumpConfig.PrivateKeyPassphrase.ValueString(),
+				Certificate:          jumpConfig.Certificate.ValueString(),
+				CertificateFile:      expandHomeDir(jumpConfig.CertificateFile.ValueString()),
+				SSHAgentSocket:       sshAgentSocket,
+				DisableSSHAgent:      !useSSHAgent,
+				HostKey:              jumpConfig.HostKey.ValueString(),
+				KnownHostsFile:       getStringValue(jumpConfig.KnownHostsFile, "", knownHostsFile),
+				HostKeyFingerprint:   jumpConfig.HostKeyFingerprint.ValueString(),
+				SkipHostKeyCheck:     skipHostKeyCheck,
+				HostKeyWarnOnly:      !strictHostKeyChecking,
+			}
+		}
+	}
+
 	// If admin_password is not set, use the same as password
 	if adminPassword == "" {
 		adminPassword = password
 	}
 
 	// Expand ~ in known_hosts_file path
-	if strings.HasPrefix(knownHostsFile, "~/") {
-		if home, err := os.UserHomeDir(); err == nil {
-			knownHostsFile = filepath.Join(home, knownHostsFile[2:])
-		}
+	knownHostsFile = expandHomeDir(knownHostsFile)
+	if jumpHost != nil {
+		jumpHost.KnownHostsFile = expandHomeDir(jumpHost.KnownHostsFile)
 	}
 
 	// Create client configuration
@@ -318,24 +1074,41 @@ func (p *RTXFrameworkProvider) Configure(ctx context.Context, req provider.Confi
 		PrivateKey:           privateKey,
 		PrivateKeyFile:       privateKeyFile,
 		PrivateKeyPassphrase: privateKeyPassphrase,
+		Certificate:          certificate,
+		CertificateFile:      certificateFile,
+		SSHAgentSocket:       sshAgentSocket,
+		DisableSSHAgent:      !useSSHAgent,
 		AdminPassword:        adminPassword,
 		Timeout:              int(timeout),
 		HostKey:              sshHostKey,
+		HostKeyFingerprint:   hostKeyFingerprint,
 		KnownHostsFile:       knownHostsFile,
 		SkipHostKeyCheck:     skipHostKeyCheck,
+		HostKeyWarnOnly:      !strictHostKeyChecking,
 		MaxParallelism:       int(maxParallelism),
 		SFTPEnabled:          useSFTP,
 		SFTPConfigPath:       sftpConfigPath,
 		SSHPoolEnabled:       sshPoolEnabled,
 		SSHPoolMaxSessions:   sshPoolMaxSessions,
 		SSHPoolIdleTimeout:   sshPoolIdleTimeout,
+		JumpHost:             jumpHost,
+		LockFile:             lockFile,
+		BackupDir:            backupDir,
+		Timeouts:             timeouts,
+		SSHKeepalive:         keepalive,
+		Pacing:               pacing,
+		Prompts:              prompts,
+		ConsoleEncoding:      client.ConsoleEncoding(consoleEncoding),
+		HTTPAPI:              httpAPI,
+		YNO:                  yno,
+		SaveMode:             client.SaveMode(saveMode),
 	}
 
 	// Create SSH client with default options
 	sshClient, err := client.NewClient(
 		clientConfig,
 		client.WithPromptDetector(client.NewDefaultPromptDetector()),
-		client.WithRetryStrategy(client.NewExponentialBackoff()),
+		client.WithRetryStrategy(retryStrategy),
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -354,14 +1127,10 @@ func (p *RTXFrameworkProvider) Configure(ctx context.Context, req provider.Confi
 		return
 	}
 
-	// Test with a simple command
-	testCmd := client.Command{
-		Key:     "show environment",
-		Payload: "show environment",
-	}
-
-	logger.Debug().Msg("Provider: Running test command")
-	if _, err := sshClient.Run(ctx, testCmd); err != nil {
+	// Identify the router; this also tests that commands can be run
+	logger.Debug().Msg("Provider: Detecting router model")
+	systemInfo, err := sshClient.DetectModel(ctx)
+	if err != nil {
 		// Close the connection if test fails
 		sshClient.Close()
 		resp.Diagnostics.AddError(
@@ -370,11 +1139,27 @@ func (p *RTXFrameworkProvider) Configure(ctx context.Context, req provider.Confi
 		)
 		return
 	}
-	logger.Debug().Msg("Provider: Test command successful")
+	logger.Debug().
+		Str("model", systemInfo.Model).
+		Str("firmware", systemInfo.FirmwareVersion).
+		Msg("Provider: Router model detected")
+	if systemInfo.Model != "" && !slices.Contains(parsers.SupportedModels, systemInfo.Model) {
+		resp.Diagnostics.AddWarning(
+			"Unrecognized Router Model",
+			fmt.Sprintf("The router at %s reports model %q, which this provider has not been tested with. "+
+				"Commands are generated for the common RTX syntax and may be rejected by the router.", host, systemInfo.Model),
+		)
+	}
 
 	// Store provider data for resources and data sources
 	providerData := &fwhelpers.ProviderData{
-		Client: sshClient,
+		Client:       sshClient,
+		PlanCommands: planCommands,
+		VerifyApply:  verifyApply,
+		Filters:      fwhelpers.NewFilterRegistry(),
+	}
+	if rollbackFile != "" {
+		providerData.Rollback = fwhelpers.NewRollbackScript(rollbackFile)
 	}
 
 	resp.DataSourceData = providerData
@@ -383,7 +1168,7 @@ func (p *RTXFrameworkProvider) Configure(ctx context.Context, req provider.Confi
 
 // Resources defines the resources implemented in the provider.
 func (p *RTXFrameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	return fwhelpers.WrapResources([]func() resource.Resource{
 		// Access Control Lists
 		access_list_extended.NewAccessListExtendedResource,
 		access_list_extended_ipv6.NewAccessListExtendedIPv6Resource,
@@ -395,13 +1180,23 @@ func (p *RTXFrameworkProvider) Resources(ctx context.Context) []func() resource.
 		access_list_ipv6_dynamic.NewAccessListIPv6DynamicResource,
 		access_list_mac.NewAccessListMACResource,
 		access_list_mac_apply.NewAccessListMACApplyResource,
+		ipv6_filter.NewIPv6FilterResource,
 
 		// Administration
 		admin.NewAdminResource,
 		admin_user.NewAdminUserResource,
+		bulk_config.NewBulkConfigResource,
+		certificate.NewCertificateResource,
+		config_block.NewConfigBlockResource,
+		exec.NewExecResource,
+		external_memory_backup.NewExternalMemoryBackupResource,
+		firmware_update.NewFirmwareUpdateResource,
+		radius_auth.NewRADIUSAuthResource,
 
 		// Routing
 		bgp.NewBGPResource,
+		ip_keepalive.NewIPKeepaliveResource,
+		mld_proxy.NewMLDProxyResource,
 		ospf.NewOSPFResource,
 		static_route.NewStaticRouteResource,
 
@@ -409,24 +1204,37 @@ func (p *RTXFrameworkProvider) Resources(ctx context.Context) []func() resource.
 		bridge.NewBridgeResource,
 		interface_resource.NewInterfaceResource,
 		ipv6_interface.NewIPv6InterfaceResource,
+		ipv6_neighbor_static.NewIPv6NeighborStaticResource,
 		ipv6_prefix.NewIPv6PrefixResource,
+		ipv6_rtadv.NewIPv6RTADVResource,
+		l2ms_managed_switch.NewL2MSManagedSwitchResource,
+		link_aggregation.NewLinkAggregationResource,
+		loopback_interface.NewLoopbackInterfaceResource,
+		mobile_wan.NewMobileWANResource,
+		port_mirroring.NewPortMirroringResource,
 		pp_interface.NewPPInterfaceResource,
+		proxy_arp.NewProxyARPResource,
 		vlan.NewVLANResource,
+		wlan.NewWLANResource,
 
 		// VPN and Tunneling
+		ikev2_tunnel.NewIKEv2TunnelResource,
 		ipsec_transport.NewIPsecTransportResource,
 		ipsec_tunnel.NewIPsecTunnelResource,
 		l2tp.NewL2TPResource,
 		l2tp_service.NewL2TPServiceResource,
+		ppp_auth_user.NewPPPAuthUserResource,
 		pppoe.NewPPPoEResource,
 		pptp.NewPPTPResource,
 		tunnel.NewTunnelResource,
+		vpn_address_pool.NewVPNAddressPoolResource,
 
 		// DHCP
 		dhcp_binding.NewDHCPBindingResource,
 		dhcp_scope.NewDHCPScopeResource,
 
 		// NAT
+		nat_descriptor_pool.NewNATDescriptorPoolResource,
 		nat_masquerade.NewNATMasqueradeResource,
 		nat_static.NewNATStaticResource,
 
@@ -437,8 +1245,14 @@ func (p *RTXFrameworkProvider) Resources(ctx context.Context) []func() resource.
 		shape.NewShapeResource,
 
 		// System Services
+		clock_timezone.NewClockTimezoneResource,
 		dns_server.NewDNSServerResource,
+		dns_server_select.NewDNSServerSelectResource,
+		dns_static_host.NewDNSStaticHostResource,
+		flow_export.NewFlowExportResource,
 		httpd.NewHTTPDResource,
+		icmp_stealth.NewICMPStealthResource,
+		lldp.NewLLDPResource,
 		sftpd.NewSFTPDResource,
 		snmp_server.NewSNMPServerResource,
 		sshd.NewSSHDResource,
@@ -446,6 +1260,9 @@ func (p *RTXFrameworkProvider) Resources(ctx context.Context) []func() resource.
 		sshd_host_key.NewSSHDHostKeyResource,
 		syslog.NewSyslogResource,
 		system.NewSystemResource,
+		system_settings.NewSystemSettingsResource,
+		traffic_threshold.NewTrafficThresholdResource,
+		usb_host.NewUSBHostResource,
 
 		// DNS
 		ddns.NewDDNSResource,
@@ -454,14 +1271,41 @@ func (p *RTXFrameworkProvider) Resources(ctx context.Context) []func() resource.
 		// Scheduling
 		kron_policy.NewKronPolicyResource,
 		kron_schedule.NewKronScheduleResource,
-	}
+	})
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *RTXFrameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	// Data sources will be added as they are migrated
 	return []func() datasource.DataSource{
-		// Data sources (will be added)
+		bgp_peers.NewBGPPeersDataSource,
+		command.NewCommandDataSource,
+		dns_cache.NewDNSCacheDataSource,
+		environment.NewEnvironmentDataSource,
+		firmware_revisions.NewFirmwareRevisionsDataSource,
+		interface_counters.NewInterfaceCountersDataSource,
+		interfaces.NewInterfacesDataSource,
+		ipsec_sa.NewIPsecSADataSource,
+		ipv6_neighbors.NewIPv6NeighborsDataSource,
+		l2ms_switches.NewL2MSSwitchesDataSource,
+		l2tp_sessions.NewL2TPSessionsDataSource,
+		log.NewLogDataSource,
+		nat_descriptors.NewNATDescriptorsDataSource,
+		netvolante_status.NewNetVolanteStatusDataSource,
+		ospf_neighbors.NewOSPFNeighborsDataSource,
+		ping.NewPingDataSource,
+		pp_status.NewPPStatusDataSource,
+		qos_status.NewQoSStatusDataSource,
+		running_config.NewConfigDataSource,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *RTXFrameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		cidr_to_range.NewCIDRToRangeFunction,
+		ip_filter_rule.NewIPFilterRuleFunction,
+		normalize_mac.NewNormalizeMACFunction,
+		service_port.NewServicePortFunction,
 	}
 }
 
@@ -499,3 +1343,49 @@ func getBoolValue(attr types.Bool, envVar string, defaultValue bool) bool {
 	}
 	return defaultValue
 }
+
+// expandHomeDir expands a leading ~/ in a path to the user's home directory
+func expandHomeDir(p string) string {
+	if strings.HasPrefix(p, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, p[2:])
+		}
+	}
+	return p
+}
+
+// parseTimeoutAttribute parses an optional duration attribute of the timeouts block.
+// It returns 0 when the attribute is not set so that the client default applies.
+func parseTimeoutAttribute(value types.String, attrPath path.Path, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return 0
+	}
+	parsed, err := time.ParseDuration(value.ValueString())
+	if err != nil || parsed <= 0 {
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid Timeout",
+			fmt.Sprintf("Timeouts must be positive Go durations (e.g., '30s', '5m'), got %q.", value.ValueString()),
+		)
+		return 0
+	}
+	return parsed
+}
+
+// parseDelayAttribute parses an optional, non-negative duration attribute.
+// It returns 0 when the attribute is not set.
+func parseDelayAttribute(value types.String, attrPath path.Path, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return 0
+	}
+	parsed, err := time.ParseDuration(value.ValueString())
+	if err != nil || parsed < 0 {
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid Delay",
+			fmt.Sprintf("Delays must be non-negative Go durations (e.g., '100ms', '0s'), got %q.", value.ValueString()),
+		)
+		return 0
+	}
+	return parsed
+}
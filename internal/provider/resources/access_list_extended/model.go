@@ -17,6 +17,9 @@ type AccessListExtendedModel struct {
 	SequenceStep  types.Int64  `tfsdk:"sequence_step"`
 	Entry         types.List   `tfsdk:"entry"`
 	Apply         types.List   `tfsdk:"apply"`
+	Force         types.Bool   `tfsdk:"force"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // EntryModel describes a single ACL entry.
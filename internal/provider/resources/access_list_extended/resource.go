@@ -23,6 +23,7 @@ import (
 	"github.com/sh1/terraform-provider-rtx/internal/client"
 	"github.com/sh1/terraform-provider-rtx/internal/logging"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -76,6 +77,8 @@ func (r *AccessListExtendedResource) Schema(ctx context.Context, req resource.Sc
 					int64validator.Between(1, MaxSequenceValue),
 				},
 			},
+			"force":            fwhelpers.ForceDeleteAttribute("IP filters"),
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"entry": schema.ListNestedBlock{
@@ -374,8 +377,24 @@ func (r *AccessListExtendedResource) Delete(ctx context.Context, req resource.De
 
 	logger.Debug().Str("resource", "rtx_access_list_extended").Msgf("Deleting access list extended: %s", name)
 
-	// Remove applies first
 	acl := data.ToClient()
+	sequences := make([]int, len(acl.Entries))
+	for i, entry := range acl.Entries {
+		sequences[i] = entry.Sequence
+	}
+	own := make(map[string]bool, len(acl.Applies))
+	for _, apply := range acl.Applies {
+		own[apply.Interface+" "+apply.Direction] = true
+	}
+	fwhelpers.CheckNotInUse(ctx, r.client, data.Force, fmt.Sprintf("access list %q", name),
+		func(pc *parsers.ParsedConfig) []parsers.InterfaceReference {
+			return fwhelpers.ExcludeReferences(pc.FindSecureFilterReferences(sequences, false), own)
+		}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove applies first
 	if len(acl.Applies) > 0 {
 		if err := r.removeFiltersFromInterfaces(ctx, acl); err != nil {
 			logger.Warn().Err(err).Msg("Failed to remove filters from interfaces before delete")
@@ -415,6 +434,7 @@ func (r *AccessListExtendedResource) ImportState(ctx context.Context, req resour
 	var data AccessListExtendedModel
 	data.Name = types.StringValue(name)
 	data.FromClient(acl)
+	data.AppliedCommands = types.ListNull(types.StringType)
 
 	// Set default sequence values for imported resources
 	data.SequenceStart = types.Int64Null()
@@ -14,6 +14,8 @@ import (
 type AccessListExtendedIPv6Model struct {
 	Name    types.String `tfsdk:"name"`
 	Entries types.List   `tfsdk:"entry"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // EntryModel describes the entry nested block data model.
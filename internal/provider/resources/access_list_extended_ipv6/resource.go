@@ -57,6 +57,7 @@ func (r *AccessListExtendedIPv6Resource) Schema(ctx context.Context, req resourc
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"entry": schema.ListNestedBlock{
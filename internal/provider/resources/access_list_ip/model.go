@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/customtypes"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
 )
 
@@ -17,19 +18,28 @@ type AccessListIPModel struct {
 	SequenceStep  types.Int64  `tfsdk:"sequence_step"`
 	Apply         types.List   `tfsdk:"apply"`
 	Entry         types.List   `tfsdk:"entry"`
+	Force         types.Bool   `tfsdk:"force"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // EntryModel describes a single IP filter entry.
 type EntryModel struct {
-	Sequence    types.Int64  `tfsdk:"sequence"`
-	Action      types.String `tfsdk:"action"`
-	Source      types.String `tfsdk:"source"`
-	Destination types.String `tfsdk:"destination"`
-	Protocol    types.String `tfsdk:"protocol"`
-	SourcePort  types.String `tfsdk:"source_port"`
-	DestPort    types.String `tfsdk:"dest_port"`
-	Established types.Bool   `tfsdk:"established"`
-	Log         types.Bool   `tfsdk:"log"`
+	Sequence    types.Int64              `tfsdk:"sequence"`
+	Action      types.String             `tfsdk:"action"`
+	Source      customtypes.AddressRange `tfsdk:"source"`
+	Destination customtypes.AddressRange `tfsdk:"destination"`
+	Protocol    types.String             `tfsdk:"protocol"`
+	SourcePort  customtypes.FilterPort   `tfsdk:"source_port"`
+	DestPort    customtypes.FilterPort   `tfsdk:"dest_port"`
+	Established types.Bool               `tfsdk:"established"`
+	ICMPType    types.Int64              `tfsdk:"icmp_type"`
+	ICMPCode    types.Int64              `tfsdk:"icmp_code"`
+	Fragment    types.Bool               `tfsdk:"fragment"`
+	Length      types.String             `tfsdk:"length"`
+	TOS         types.String             `tfsdk:"tos"`
+	DSCP        types.String             `tfsdk:"dscp"`
+	Log         types.Bool               `tfsdk:"log"`
 }
 
 // ApplyModel describes an interface binding configuration.
@@ -45,12 +55,18 @@ func EntryModelAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
 		"sequence":    types.Int64Type,
 		"action":      types.StringType,
-		"source":      types.StringType,
-		"destination": types.StringType,
+		"source":      customtypes.AddressRangeType{},
+		"destination": customtypes.AddressRangeType{},
 		"protocol":    types.StringType,
-		"source_port": types.StringType,
-		"dest_port":   types.StringType,
+		"source_port": customtypes.FilterPortType{},
+		"dest_port":   customtypes.FilterPortType{},
 		"established": types.BoolType,
+		"icmp_type":   types.Int64Type,
+		"icmp_code":   types.Int64Type,
+		"fragment":    types.BoolType,
+		"length":      types.StringType,
+		"tos":         types.StringType,
+		"dscp":        types.StringType,
 		"log":         types.BoolType,
 	}
 }
@@ -98,12 +114,18 @@ func (m *AccessListIPModel) ToClientFilters() []client.IPFilter {
 		filter := client.IPFilter{
 			Number:        seq,
 			Action:        fwhelpers.GetStringValue(entry.Action),
-			SourceAddress: fwhelpers.GetStringValue(entry.Source),
-			DestAddress:   fwhelpers.GetStringValue(entry.Destination),
+			SourceAddress: entry.Source.ValueString(),
+			DestAddress:   entry.Destination.ValueString(),
 			Protocol:      getStringWithDefault(entry.Protocol, "*"),
-			SourcePort:    getStringWithDefault(entry.SourcePort, "*"),
-			DestPort:      getStringWithDefault(entry.DestPort, "*"),
+			SourcePort:    getStringWithDefault(entry.SourcePort.StringValue, "*"),
+			DestPort:      getStringWithDefault(entry.DestPort.StringValue, "*"),
 			Established:   fwhelpers.GetBoolValue(entry.Established),
+			ICMPType:      intPointer(entry.ICMPType),
+			ICMPCode:      intPointer(entry.ICMPCode),
+			Fragment:      fwhelpers.GetBoolValue(entry.Fragment),
+			Length:        fwhelpers.GetStringValue(entry.Length),
+			TOS:           fwhelpers.GetStringValue(entry.TOS),
+			DSCP:          fwhelpers.GetStringValue(entry.DSCP),
 		}
 
 		result = append(result, filter)
@@ -164,12 +186,18 @@ func (m *AccessListIPModel) SetEntriesFromFilters(filters []client.IPFilter) {
 		entry := EntryModel{
 			Sequence:    types.Int64Value(int64(filter.Number)),
 			Action:      types.StringValue(filter.Action),
-			Source:      types.StringValue(filter.SourceAddress),
-			Destination: types.StringValue(filter.DestAddress),
+			Source:      customtypes.AddressRangeValue(filter.SourceAddress),
+			Destination: customtypes.AddressRangeValue(filter.DestAddress),
 			Protocol:    types.StringValue(normalizePort(filter.Protocol)),
-			SourcePort:  types.StringValue(normalizePort(filter.SourcePort)),
-			DestPort:    types.StringValue(normalizePort(filter.DestPort)),
+			SourcePort:  customtypes.FilterPortValue(normalizePort(filter.SourcePort)),
+			DestPort:    customtypes.FilterPortValue(normalizePort(filter.DestPort)),
 			Established: types.BoolValue(filter.Established),
+			ICMPType:    int64Value(filter.ICMPType),
+			ICMPCode:    int64Value(filter.ICMPCode),
+			Fragment:    types.BoolValue(filter.Fragment),
+			Length:      fwhelpers.StringValueOrNull(filter.Length),
+			TOS:         fwhelpers.StringValueOrNull(filter.TOS),
+			DSCP:        fwhelpers.StringValueOrNull(filter.DSCP),
 			Log:         types.BoolValue(false), // RTX doesn't return log status
 		}
 		entries = append(entries, entry)
@@ -194,6 +222,12 @@ func entryToObjectValue(e EntryModel) attr.Value {
 		"source_port": e.SourcePort,
 		"dest_port":   e.DestPort,
 		"established": e.Established,
+		"icmp_type":   e.ICMPType,
+		"icmp_code":   e.ICMPCode,
+		"fragment":    e.Fragment,
+		"length":      e.Length,
+		"tos":         e.TOS,
+		"dscp":        e.DSCP,
 		"log":         e.Log,
 	})
 }
@@ -237,3 +271,20 @@ func normalizePort(port string) string {
 	}
 	return port
 }
+
+// intPointer returns the value of an optional number, or nil if it is not set
+func intPointer(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
+}
+
+// int64Value returns an optional number, null if p is nil
+func int64Value(p *int) types.Int64 {
+	if p == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*p))
+}
@@ -23,7 +23,10 @@ import (
 
 	"github.com/sh1/terraform-provider-rtx/internal/client"
 	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/customtypes"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/validation"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -81,6 +84,8 @@ func (r *AccessListIPResource) Schema(ctx context.Context, req resource.SchemaRe
 					int64validator.Between(1, MaxSequenceValue),
 				},
 			},
+			"force":            fwhelpers.ForceDeleteAttribute("IP filters"),
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"apply": schema.ListNestedBlock{
@@ -146,30 +151,35 @@ func (r *AccessListIPResource) Schema(ctx context.Context, req resource.SchemaRe
 							},
 						},
 						"source": schema.StringAttribute{
-							Description: "Source IP address/network in CIDR notation (e.g., '10.0.0.0/8') or '*' for any",
+							Description: "Source IP address/network in CIDR notation (e.g., '10.0.0.0/8'), address range (e.g., '10.0.0.1-10.0.0.9') or '*' for any. A CIDR equals the range the router shows for it.",
+							CustomType:  customtypes.AddressRangeType{},
 							Required:    true,
 						},
 						"destination": schema.StringAttribute{
-							Description: "Destination IP address/network in CIDR notation (e.g., '192.168.1.0/24') or '*' for any",
+							Description: "Destination IP address/network in CIDR notation (e.g., '192.168.1.0/24'), address range (e.g., '192.168.1.1-192.168.1.9') or '*' for any. A CIDR equals the range the router shows for it.",
+							CustomType:  customtypes.AddressRangeType{},
 							Required:    true,
 						},
 						"protocol": schema.StringAttribute{
-							Description: "Protocol: tcp, udp, icmp, ip, gre, esp, ah, or * for any",
-							Optional:    true,
-							Computed:    true,
-							Default:     stringdefault.StaticString("*"),
+							Description: "Protocol: tcp, udp, icmp, ip, gre, esp, ah, tcpfin, tcprst, tcpsyn, established, icmp-error, icmp-info, a protocol number (e.g., '47'), " +
+								"a TCP flag match (e.g., 'tcpflag=0x0002/0x0017'), a comma-separated list (e.g., 'tcp,udp'), or * for any",
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("*"),
 							Validators: []validator.String{
-								stringvalidator.OneOfCaseInsensitive("tcp", "udp", "udp,tcp", "tcp,udp", "icmp", "ip", "gre", "esp", "ah", "tcpfin", "tcprst", "*"),
+								validation.IPFilterProtocolValidator(),
 							},
 						},
 						"source_port": schema.StringAttribute{
-							Description: "Source port number, range (e.g., '1024-65535'), or '*' for any. Only valid for TCP/UDP.",
+							CustomType:  customtypes.FilterPortType{},
+							Description: "Source port number, range (e.g., '1024-65535'), service keyword (e.g., 'www'), comma-separated list of them (e.g., 'www,smtp'), or '*' for any. Only valid for TCP/UDP.",
 							Optional:    true,
 							Computed:    true,
 							Default:     stringdefault.StaticString("*"),
 						},
 						"dest_port": schema.StringAttribute{
-							Description: "Destination port number, range (e.g., '80'), or '*' for any. Only valid for TCP/UDP.",
+							CustomType:  customtypes.FilterPortType{},
+							Description: "Destination port number, range (e.g., '1024-65535'), service keyword (e.g., 'www'), comma-separated list of them (e.g., 'www,smtp'), or '*' for any. Only valid for TCP/UDP.",
 							Optional:    true,
 							Computed:    true,
 							Default:     stringdefault.StaticString("*"),
@@ -180,6 +190,39 @@ func (r *AccessListIPResource) Schema(ctx context.Context, req resource.SchemaRe
 							Computed:    true,
 							Default:     booldefault.StaticBool(false),
 						},
+						"icmp_type": schema.Int64Attribute{
+							Description: "ICMP type to match (e.g., 8 for echo request, 3 for destination unreachable). Only valid for icmp protocol; the ports must be left as '*'.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 255),
+							},
+						},
+						"icmp_code": schema.Int64Attribute{
+							Description: "ICMP code to match within icmp_type (e.g., 4 for fragmentation needed). Requires icmp_type.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 255),
+								int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("icmp_type")),
+							},
+						},
+						"fragment": schema.BoolAttribute{
+							Description: "Match IP fragments only.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"length": schema.StringAttribute{
+							Description: "Packet length to match: a length (e.g., '1500') or a range (e.g., '100-200', '1400-' or '-64').",
+							Optional:    true,
+						},
+						"tos": schema.StringAttribute{
+							Description: "TOS byte to match, 0-255 or in hex (e.g., '0x10'). Conflicts with dscp.",
+							Optional:    true,
+						},
+						"dscp": schema.StringAttribute{
+							Description: "DSCP value to match, 0-63 or a name such as 'ef' or 'af11'. Conflicts with tos.",
+							Optional:    true,
+						},
 						"log": schema.BoolAttribute{
 							Description: "Enable logging when this entry matches traffic.",
 							Optional:    true,
@@ -444,8 +487,24 @@ func (r *AccessListIPResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	logger.Debug().Str("resource", "rtx_access_list_ip").Msgf("Deleting IP access list group: %s", name)
 
-	// First remove apply blocks to free up filter references
+	// Get sequences to delete
+	sequences := data.GetExpectedSequences()
+
+	// Bindings of the apply blocks are removed below, any other one keeps the list in use
 	applies := data.GetApplies()
+	own := make(map[string]bool, len(applies))
+	for _, a := range applies {
+		own[fwhelpers.GetStringValue(a.Interface)+" "+strings.ToLower(fwhelpers.GetStringValue(a.Direction))] = true
+	}
+	fwhelpers.CheckNotInUse(ctx, r.client, data.Force, fmt.Sprintf("IP access list %q", name),
+		func(pc *parsers.ParsedConfig) []parsers.InterfaceReference {
+			return fwhelpers.ExcludeReferences(pc.FindSecureFilterReferences(sequences, false), own)
+		}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// First remove apply blocks to free up filter references
 	for _, a := range applies {
 		iface := fwhelpers.GetStringValue(a.Interface)
 		direction := strings.ToLower(fwhelpers.GetStringValue(a.Direction))
@@ -455,9 +514,6 @@ func (r *AccessListIPResource) Delete(ctx context.Context, req resource.DeleteRe
 		}
 	}
 
-	// Get sequences to delete
-	sequences := data.GetExpectedSequences()
-
 	// Delete all entries
 	for _, seq := range sequences {
 		if err := r.client.DeleteIPFilter(ctx, seq); err != nil {
@@ -541,12 +597,18 @@ func (r *AccessListIPResource) ImportState(ctx context.Context, req resource.Imp
 			entry := EntryModel{
 				Sequence:    types.Int64Value(int64(filter.Number)),
 				Action:      types.StringValue(filter.Action),
-				Source:      types.StringValue(filter.SourceAddress),
-				Destination: types.StringValue(filter.DestAddress),
+				Source:      customtypes.AddressRangeValue(filter.SourceAddress),
+				Destination: customtypes.AddressRangeValue(filter.DestAddress),
 				Protocol:    types.StringValue(normalizePort(filter.Protocol)),
-				SourcePort:  types.StringValue(normalizePort(filter.SourcePort)),
-				DestPort:    types.StringValue(normalizePort(filter.DestPort)),
+				SourcePort:  customtypes.FilterPortValue(normalizePort(filter.SourcePort)),
+				DestPort:    customtypes.FilterPortValue(normalizePort(filter.DestPort)),
 				Established: types.BoolValue(filter.Established),
+				ICMPType:    int64Value(filter.ICMPType),
+				ICMPCode:    int64Value(filter.ICMPCode),
+				Fragment:    types.BoolValue(filter.Fragment),
+				Length:      fwhelpers.StringValueOrNull(filter.Length),
+				TOS:         fwhelpers.StringValueOrNull(filter.TOS),
+				DSCP:        fwhelpers.StringValueOrNull(filter.DSCP),
 				Log:         types.BoolValue(false),
 			}
 			entryValues[i] = entryToObjectValue(entry)
@@ -579,8 +641,8 @@ func (r *AccessListIPResource) validateConfig(ctx context.Context, data *AccessL
 		entrySeq := fwhelpers.GetInt64Value(entry.Sequence)
 		protocol := strings.ToLower(fwhelpers.GetStringValue(entry.Protocol))
 		established := fwhelpers.GetBoolValue(entry.Established)
-		sourcePort := fwhelpers.GetStringValue(entry.SourcePort)
-		destPort := fwhelpers.GetStringValue(entry.DestPort)
+		sourcePort := fwhelpers.GetStringValue(entry.SourcePort.StringValue)
+		destPort := fwhelpers.GetStringValue(entry.DestPort.StringValue)
 
 		if autoMode {
 			// Auto mode: entry-level sequence should not be specified
@@ -632,6 +694,15 @@ func (r *AccessListIPResource) validateConfig(ctx context.Context, data *AccessL
 			usedSequences[entrySeq] = i
 		}
 
+		// ICMP type and code replace the ports and are only valid for icmp
+		if !entry.ICMPType.IsNull() && !entry.Protocol.IsUnknown() && protocol != "icmp" {
+			diagnostics.AddError(
+				"Invalid configuration",
+				fmt.Sprintf("entry[%d]: icmp_type can only be specified for icmp protocol", i),
+			)
+			return
+		}
+
 		// Established is only valid for TCP
 		if established && protocol != "tcp" {
 			diagnostics.AddError(
@@ -641,8 +712,29 @@ func (r *AccessListIPResource) validateConfig(ctx context.Context, data *AccessL
 			return
 		}
 
+		if err := parsers.ValidateIPFilterOptions(parsers.IPFilter{
+			Length: fwhelpers.GetStringValue(entry.Length),
+			TOS:    fwhelpers.GetStringValue(entry.TOS),
+			DSCP:   fwhelpers.GetStringValue(entry.DSCP),
+		}); err != nil {
+			diagnostics.AddError("Invalid configuration", fmt.Sprintf("entry[%d]: %v", i, err))
+			return
+		}
+
+		for _, field := range []struct{ attr, port string }{{"source_port", sourcePort}, {"dest_port", destPort}} {
+			attr, port := field.attr, field.port
+			if port == "" {
+				continue
+			}
+			if err := parsers.ValidateIPFilterPort(port); err != nil {
+				diagnostics.AddError("Invalid configuration", fmt.Sprintf("entry[%d]: %s: %v", i, attr, err))
+				return
+			}
+		}
+
 		// Port specifications valid for TCP/UDP and TCP-based protocols
-		tcpBasedProtocols := protocol == "tcp" || protocol == "udp" || protocol == "tcp,udp" || protocol == "udp,tcp" || protocol == "tcpfin" || protocol == "tcprst"
+		tcpBasedProtocols := protocol == "tcp" || protocol == "udp" || protocol == "tcp,udp" || protocol == "udp,tcp" || protocol == "tcpfin" || protocol == "tcprst" ||
+			protocol == "tcpsyn" || strings.HasPrefix(protocol, "tcpflag")
 		if !tcpBasedProtocols {
 			if sourcePort != "*" && sourcePort != "" {
 				diagnostics.AddError(
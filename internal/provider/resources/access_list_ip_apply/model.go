@@ -7,10 +7,35 @@ import (
 
 // AccessListIPApplyModel describes the resource data model.
 type AccessListIPApplyModel struct {
-	AccessList types.String `tfsdk:"access_list"`
-	Interface  types.String `tfsdk:"interface"`
-	Direction  types.String `tfsdk:"direction"`
-	Sequences  types.List   `tfsdk:"sequences"`
+	AccessList   types.String `tfsdk:"access_list"`
+	Interface    types.String `tfsdk:"interface"`
+	Direction    types.String `tfsdk:"direction"`
+	Sequences    types.List   `tfsdk:"sequences"`
+	InsertBefore types.Int64  `tfsdk:"insert_before"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// IsPositioned reports whether the resource manages only its own sequences
+// within the interface's filter list, placed before insert_before.
+func (m *AccessListIPApplyModel) IsPositioned() bool {
+	return !m.InsertBefore.IsNull() && !m.InsertBefore.IsUnknown()
+}
+
+// OwnSequences returns the sequences of this resource found in the filter
+// list bound to the interface, in the order of the list.
+func (m *AccessListIPApplyModel) OwnSequences(bound []int) []int {
+	own := make(map[int]bool)
+	for _, seq := range m.GetSequencesAsInts() {
+		own[seq] = true
+	}
+	result := make([]int, 0, len(own))
+	for _, seq := range bound {
+		if own[seq] {
+			result = append(result, seq)
+		}
+	}
+	return result
 }
 
 // GetSequencesAsInts returns the sequences as a slice of integers.
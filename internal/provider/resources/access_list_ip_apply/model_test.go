@@ -51,3 +51,16 @@ func TestSetSequencesFromInts_NullPreservation(t *testing.T) {
 		})
 	}
 }
+
+func TestOwnSequences(t *testing.T) {
+	m := AccessListIPApplyModel{
+		Sequences: types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(300), types.Int64Value(150)}),
+	}
+	got := m.OwnSequences([]int{100, 150, 200, 300})
+	if len(got) != 2 || got[0] != 150 || got[1] != 300 {
+		t.Errorf("OwnSequences() = %v, want [150 300]", got)
+	}
+	if got := m.OwnSequences([]int{100, 200}); len(got) != 0 {
+		t.Errorf("OwnSequences() = %v, want none", got)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/sh1/terraform-provider-rtx/internal/client"
 	"github.com/sh1/terraform-provider-rtx/internal/logging"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -83,6 +84,17 @@ func (r *AccessListIPApplyResource) Schema(ctx context.Context, req resource.Sch
 					),
 				},
 			},
+			"insert_before": schema.Int64Attribute{
+				Description: "Filter number to place the sequences in front of. When set, this resource manages only its own sequences within " +
+					"the interface's filter list and leaves every other filter, including those managed by other resources, in place, " +
+					"so adding a filter to a long list changes one resource instead of rewriting the list. The sequences are appended " +
+					"when the filter is not in the list. When omitted, the sequences replace the whole list.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -161,7 +173,7 @@ func (r *AccessListIPApplyResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Apply filters to interface
-	if err := r.client.ApplyIPFiltersToInterface(ctx, iface, direction, sequences); err != nil {
+	if err := r.apply(ctx, &data, iface, direction, nil); err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to apply IP filters",
 			fmt.Sprintf("Could not apply IP filters to interface %s %s: %v", iface, direction, err),
@@ -246,6 +258,20 @@ func (r *AccessListIPApplyResource) read(ctx context.Context, data *AccessListIP
 		return
 	}
 
+	// A positioned resource only owns its sequences within the list
+	if data.IsPositioned() {
+		filterIDs = data.OwnSequences(filterIDs)
+		if len(filterIDs) == 0 {
+			logger.Warn().
+				Str("resource", "rtx_access_list_ip_apply").
+				Str("interface", iface).
+				Str("direction", direction).
+				Msg("None of the sequences are applied, removing from state")
+			data.Interface = types.StringNull()
+			return
+		}
+	}
+
 	// Update state
 	data.Interface = types.StringValue(iface)
 	data.Direction = types.StringValue(direction)
@@ -254,9 +280,10 @@ func (r *AccessListIPApplyResource) read(ctx context.Context, data *AccessListIP
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *AccessListIPApplyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data AccessListIPApplyModel
+	var data, state AccessListIPApplyModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -293,7 +320,7 @@ func (r *AccessListIPApplyResource) Update(ctx context.Context, req resource.Upd
 	plannedSequences := data.Sequences
 
 	// Apply filters to interface (this will replace existing filters)
-	if err := r.client.ApplyIPFiltersToInterface(ctx, iface, direction, sequences); err != nil {
+	if err := r.apply(ctx, &data, iface, direction, state.GetSequencesAsInts()); err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to update IP filters",
 			fmt.Sprintf("Could not update IP filters on interface %s %s: %v", iface, direction, err),
@@ -338,6 +365,17 @@ func (r *AccessListIPApplyResource) Delete(ctx context.Context, req resource.Del
 		Str("direction", direction).
 		Msg("Deleting IP access list apply")
 
+	// A positioned resource leaves the other filters of the list in place
+	if data.IsPositioned() {
+		if err := r.removeSequences(ctx, iface, direction, data.GetSequencesAsInts()); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to remove IP filters",
+				fmt.Sprintf("Could not remove IP filters from interface %s %s: %v", iface, direction, err),
+			)
+		}
+		return
+	}
+
 	// Remove filters from interface
 	if err := r.client.RemoveIPFiltersFromInterface(ctx, iface, direction); err != nil {
 		// Ignore "not found" errors
@@ -352,16 +390,61 @@ func (r *AccessListIPApplyResource) Delete(ctx context.Context, req resource.Del
 	}
 }
 
+// apply binds the planned sequences to the interface. Without insert_before
+// they replace the whole list. With it, the sequences applied before are
+// taken out of the current list and the planned ones are placed before
+// insert_before, so the rest of the list, including dynamic filters, stays.
+func (r *AccessListIPApplyResource) apply(ctx context.Context, data *AccessListIPApplyModel, iface, direction string, previous []int) error {
+	sequences := data.GetSequencesAsInts()
+	if !data.IsPositioned() {
+		return r.client.ApplyIPFiltersToInterface(ctx, iface, direction, sequences)
+	}
+
+	current, dynamic, err := r.currentFilters(ctx, iface, direction)
+	if err != nil {
+		return err
+	}
+	list := parsers.RemoveFilterNumbers(current, previous)
+	list = parsers.InsertFilterNumbers(list, sequences, int(data.InsertBefore.ValueInt64()))
+	return r.client.ApplyIPFiltersWithDynamicToInterface(ctx, iface, direction, list, dynamic)
+}
+
+// removeSequences takes sequences out of the interface's filter list and
+// removes the list when nothing else is left in it.
+func (r *AccessListIPApplyResource) removeSequences(ctx context.Context, iface, direction string, sequences []int) error {
+	current, dynamic, err := r.currentFilters(ctx, iface, direction)
+	if err != nil {
+		return err
+	}
+	rest := parsers.RemoveFilterNumbers(current, sequences)
+	if len(rest) == 0 && len(dynamic) == 0 {
+		if err := r.client.RemoveIPFiltersFromInterface(ctx, iface, direction); err != nil && !strings.Contains(err.Error(), "not found") {
+			return err
+		}
+		return nil
+	}
+	return r.client.ApplyIPFiltersWithDynamicToInterface(ctx, iface, direction, rest, dynamic)
+}
+
+// currentFilters returns the static and dynamic filters bound to the
+// interface, empty when none are.
+func (r *AccessListIPApplyResource) currentFilters(ctx context.Context, iface, direction string) ([]int, []int, error) {
+	current, err := r.client.GetIPInterfaceFilters(ctx, iface, direction)
+	if err != nil && !strings.Contains(err.Error(), "not found") {
+		return nil, nil, err
+	}
+	dynamic, err := r.client.GetIPInterfaceDynamicFilters(ctx, iface, direction)
+	if err != nil && !strings.Contains(err.Error(), "not found") {
+		return nil, nil, err
+	}
+	return current, dynamic, nil
+}
+
 // ImportState imports an existing resource into Terraform.
 func (r *AccessListIPApplyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// ID format: interface:direction
-	id := req.ID
-	parts := strings.SplitN(id, ":", 2)
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			fmt.Sprintf("Invalid import ID format: %s, expected 'interface:direction' (e.g., 'lan1:in')", id),
-		)
+	parts, ok := fwhelpers.SplitImportID(&resp.Diagnostics, req.ID, ":", 2, "'interface:direction' (e.g., 'lan1:in')")
+	if !ok {
 		return
 	}
 
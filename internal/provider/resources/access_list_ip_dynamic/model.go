@@ -4,6 +4,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/customtypes"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
 )
 
@@ -16,16 +17,19 @@ type AccessListIPDynamicModel struct {
 	SequenceStart types.Int64  `tfsdk:"sequence_start"`
 	SequenceStep  types.Int64  `tfsdk:"sequence_step"`
 	Entries       []EntryModel `tfsdk:"entry"`
+	Force         types.Bool   `tfsdk:"force"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // EntryModel describes a single dynamic filter entry.
 type EntryModel struct {
-	Sequence    types.Int64  `tfsdk:"sequence"`
-	Source      types.String `tfsdk:"source"`
-	Destination types.String `tfsdk:"destination"`
-	Protocol    types.String `tfsdk:"protocol"`
-	Syslog      types.Bool   `tfsdk:"syslog"`
-	Timeout     types.Int64  `tfsdk:"timeout"`
+	Sequence    types.Int64              `tfsdk:"sequence"`
+	Source      customtypes.AddressRange `tfsdk:"source"`
+	Destination customtypes.AddressRange `tfsdk:"destination"`
+	Protocol    types.String             `tfsdk:"protocol"`
+	Syslog      types.Bool               `tfsdk:"syslog"`
+	Timeout     types.Int64              `tfsdk:"timeout"`
 }
 
 // ToClient converts the Terraform model to a client.AccessListIPDynamic.
@@ -53,8 +57,8 @@ func (m *AccessListIPDynamicModel) ToClient() client.AccessListIPDynamic {
 
 		aclEntry := client.AccessListIPDynamicEntry{
 			Sequence:    seq,
-			Source:      fwhelpers.GetStringValue(entry.Source),
-			Destination: fwhelpers.GetStringValue(entry.Destination),
+			Source:      entry.Source.ValueString(),
+			Destination: entry.Destination.ValueString(),
 			Protocol:    fwhelpers.GetStringValue(entry.Protocol),
 			Syslog:      fwhelpers.GetBoolValue(entry.Syslog),
 		}
@@ -99,8 +103,8 @@ func (m *AccessListIPDynamicModel) FromClient(acl *client.AccessListIPDynamic, c
 		for _, entry := range acl.Entries {
 			newEntry := EntryModel{
 				Sequence:    types.Int64Value(int64(entry.Sequence)),
-				Source:      types.StringValue(entry.Source),
-				Destination: types.StringValue(entry.Destination),
+				Source:      customtypes.AddressRangeValue(entry.Source),
+				Destination: customtypes.AddressRangeValue(entry.Destination),
 				Protocol:    types.StringValue(entry.Protocol),
 				Syslog:      types.BoolValue(entry.Syslog),
 			}
@@ -136,8 +140,8 @@ func (m *AccessListIPDynamicModel) FromClient(acl *client.AccessListIPDynamic, c
 		if entry, found := entryMap[seq]; found {
 			newEntry := EntryModel{
 				Sequence:    types.Int64Value(int64(entry.Sequence)),
-				Source:      types.StringValue(entry.Source),
-				Destination: types.StringValue(entry.Destination),
+				Source:      customtypes.AddressRangeValue(entry.Source),
+				Destination: customtypes.AddressRangeValue(entry.Destination),
 				Protocol:    types.StringValue(entry.Protocol),
 				Syslog:      types.BoolValue(entry.Syslog),
 			}
@@ -20,7 +20,9 @@ import (
 
 	"github.com/sh1/terraform-provider-rtx/internal/client"
 	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/customtypes"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
 )
 
 // MaxSequenceValue is the maximum allowed sequence number.
@@ -87,6 +89,8 @@ multiple dynamic filter entries under a single name for easier management and re
 					int64validator.Between(1, MaxSequenceValue),
 				},
 			},
+			"force":            fwhelpers.ForceDeleteAttribute("dynamic IP filters"),
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"entry": schema.ListNestedBlock{
@@ -102,11 +106,13 @@ multiple dynamic filter entries under a single name for easier management and re
 							},
 						},
 						"source": schema.StringAttribute{
-							Description: "Source address or '*' for any. Can be an IP address, network in CIDR notation, or '*'.",
+							Description: "Source address or '*' for any. Can be an IP address, network in CIDR notation, address range, or '*'. A CIDR equals the range the router shows for it.",
+							CustomType:  customtypes.AddressRangeType{},
 							Required:    true,
 						},
 						"destination": schema.StringAttribute{
-							Description: "Destination address or '*' for any. Can be an IP address, network in CIDR notation, or '*'.",
+							Description: "Destination address or '*' for any. Can be an IP address, network in CIDR notation, address range, or '*'. A CIDR equals the range the router shows for it.",
+							CustomType:  customtypes.AddressRangeType{},
 							Required:    true,
 						},
 						"protocol": schema.StringAttribute{
@@ -302,6 +308,14 @@ func (r *AccessListIPDynamicResource) Delete(ctx context.Context, req resource.D
 	// Collect filter numbers to delete
 	filterNums := data.GetFilterNumbers()
 
+	fwhelpers.CheckNotInUse(ctx, r.client, data.Force, fmt.Sprintf("dynamic IP access list %q", name),
+		func(pc *parsers.ParsedConfig) []parsers.InterfaceReference {
+			return pc.FindSecureFilterReferences(filterNums, true)
+		}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.DeleteAccessListIPDynamic(ctx, name, filterNums); err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			return
@@ -329,7 +343,8 @@ func (r *AccessListIPDynamicResource) ImportState(ctx context.Context, req resou
 	// Create a model and read entries from the router
 	// This populates the state with all existing dynamic IP filters
 	data := AccessListIPDynamicModel{
-		Name: types.StringValue(name),
+		Name:            types.StringValue(name),
+		AppliedCommands: types.ListNull(types.StringType),
 	}
 
 	r.read(ctx, &data, &resp.Diagnostics)
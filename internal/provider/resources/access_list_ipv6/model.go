@@ -19,6 +19,8 @@ type AccessListIPv6Model struct {
 	SequenceStep  types.Int64  `tfsdk:"sequence_step"`
 	Entry         []EntryModel `tfsdk:"entry"`
 	Apply         []ApplyModel `tfsdk:"apply"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // EntryModel describes an IPv6 filter entry.
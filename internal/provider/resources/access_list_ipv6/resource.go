@@ -81,6 +81,7 @@ func (r *AccessListIPv6Resource) Schema(ctx context.Context, req resource.Schema
 					int64validator.Between(1, MaxSequenceValue),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"entry": schema.ListNestedBlock{
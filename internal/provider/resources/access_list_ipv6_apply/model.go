@@ -11,6 +11,8 @@ type AccessListIPv6ApplyModel struct {
 	Interface  types.String `tfsdk:"interface"`
 	Direction  types.String `tfsdk:"direction"`
 	Sequences  types.List   `tfsdk:"sequences"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // GetSequencesAsInts returns the sequences as a slice of integers.
@@ -82,6 +82,7 @@ func (r *AccessListIPv6ApplyResource) Schema(ctx context.Context, req resource.S
 					),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -319,13 +320,8 @@ func (r *AccessListIPv6ApplyResource) Delete(ctx context.Context, req resource.D
 // ImportState imports an existing resource into Terraform.
 func (r *AccessListIPv6ApplyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// ID format: interface:direction
-	id := req.ID
-	parts := strings.SplitN(id, ":", 2)
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			fmt.Sprintf("Invalid import ID format: %s, expected 'interface:direction' (e.g., 'lan1:in')", id),
-		)
+	parts, ok := fwhelpers.SplitImportID(&resp.Diagnostics, req.ID, ":", 2, "'interface:direction' (e.g., 'lan1:in')")
+	if !ok {
 		return
 	}
 
@@ -344,6 +340,8 @@ func (r *AccessListIPv6ApplyResource) ImportState(ctx context.Context, req resou
 		AccessList: types.StringValue("imported"),
 		Interface:  types.StringValue(iface),
 		Direction:  types.StringValue(direction),
+
+		AppliedCommands: types.ListNull(types.StringType),
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -16,6 +16,8 @@ type AccessListIPv6DynamicModel struct {
 	SequenceStart types.Int64  `tfsdk:"sequence_start"`
 	SequenceStep  types.Int64  `tfsdk:"sequence_step"`
 	Entries       []EntryModel `tfsdk:"entry"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // EntryModel describes a single entry in the dynamic IPv6 access list.
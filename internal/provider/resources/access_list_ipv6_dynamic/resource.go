@@ -89,6 +89,7 @@ Note: Unlike IPv4 dynamic filters, IPv6 dynamic filters do NOT support the timeo
 					int64validator.Between(1, MaxSequenceValue),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"entry": schema.ListNestedBlock{
@@ -325,7 +326,8 @@ func (r *AccessListIPv6DynamicResource) ImportState(ctx context.Context, req res
 	// Create a model and read entries from the router
 	// This populates the state with all existing dynamic IPv6 filters
 	data := AccessListIPv6DynamicModel{
-		Name: types.StringValue(name),
+		Name:            types.StringValue(name),
+		AppliedCommands: types.ListNull(types.StringType),
 	}
 
 	r.read(ctx, &data, &resp.Diagnostics)
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/customtypes"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
 )
 
@@ -18,6 +19,8 @@ type AccessListMACModel struct {
 	SequenceStep  types.Int64  `tfsdk:"sequence_step"`
 	Applies       []ApplyModel `tfsdk:"apply"`
 	Entries       []EntryModel `tfsdk:"entry"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ApplyModel describes an interface binding for MAC ACL.
@@ -29,21 +32,21 @@ type ApplyModel struct {
 
 // EntryModel describes a single entry in a MAC access list.
 type EntryModel struct {
-	Sequence               types.Int64     `tfsdk:"sequence"`
-	AceAction              types.String    `tfsdk:"ace_action"`
-	SourceAny              types.Bool      `tfsdk:"source_any"`
-	SourceAddress          types.String    `tfsdk:"source_address"`
-	SourceAddressMask      types.String    `tfsdk:"source_address_mask"`
-	DestinationAny         types.Bool      `tfsdk:"destination_any"`
-	DestinationAddress     types.String    `tfsdk:"destination_address"`
-	DestinationAddressMask types.String    `tfsdk:"destination_address_mask"`
-	EtherType              types.String    `tfsdk:"ether_type"`
-	VlanID                 types.Int64     `tfsdk:"vlan_id"`
-	Log                    types.Bool      `tfsdk:"log"`
-	FilterID               types.Int64     `tfsdk:"filter_id"`
-	DHCPMatch              *DHCPMatchModel `tfsdk:"dhcp_match"`
-	Offset                 types.Int64     `tfsdk:"offset"`
-	ByteList               types.List      `tfsdk:"byte_list"`
+	Sequence               types.Int64            `tfsdk:"sequence"`
+	AceAction              types.String           `tfsdk:"ace_action"`
+	SourceAny              types.Bool             `tfsdk:"source_any"`
+	SourceAddress          customtypes.MACAddress `tfsdk:"source_address"`
+	SourceAddressMask      types.String           `tfsdk:"source_address_mask"`
+	DestinationAny         types.Bool             `tfsdk:"destination_any"`
+	DestinationAddress     customtypes.MACAddress `tfsdk:"destination_address"`
+	DestinationAddressMask types.String           `tfsdk:"destination_address_mask"`
+	EtherType              types.String           `tfsdk:"ether_type"`
+	VlanID                 types.Int64            `tfsdk:"vlan_id"`
+	Log                    types.Bool             `tfsdk:"log"`
+	FilterID               types.Int64            `tfsdk:"filter_id"`
+	DHCPMatch              *DHCPMatchModel        `tfsdk:"dhcp_match"`
+	Offset                 types.Int64            `tfsdk:"offset"`
+	ByteList               types.List             `tfsdk:"byte_list"`
 }
 
 // DHCPMatchModel describes DHCP-based match settings.
@@ -67,10 +70,10 @@ func EntryAttrTypes() map[string]attr.Type {
 		"sequence":                 types.Int64Type,
 		"ace_action":               types.StringType,
 		"source_any":               types.BoolType,
-		"source_address":           types.StringType,
+		"source_address":           customtypes.MACAddressType{},
 		"source_address_mask":      types.StringType,
 		"destination_any":          types.BoolType,
-		"destination_address":      types.StringType,
+		"destination_address":      customtypes.MACAddressType{},
 		"destination_address_mask": types.StringType,
 		"ether_type":               types.StringType,
 		"vlan_id":                  types.Int64Type,
@@ -128,10 +131,10 @@ func (m *AccessListMACModel) ToClient(ctx context.Context, diagnostics *diag.Dia
 			Sequence:               entrySequence,
 			AceAction:              fwhelpers.GetStringValue(entry.AceAction),
 			SourceAny:              fwhelpers.GetBoolValue(entry.SourceAny),
-			SourceAddress:          fwhelpers.GetStringValue(entry.SourceAddress),
+			SourceAddress:          fwhelpers.GetStringValue(entry.SourceAddress.StringValue),
 			SourceAddressMask:      fwhelpers.GetStringValue(entry.SourceAddressMask),
 			DestinationAny:         fwhelpers.GetBoolValue(entry.DestinationAny),
-			DestinationAddress:     fwhelpers.GetStringValue(entry.DestinationAddress),
+			DestinationAddress:     fwhelpers.GetStringValue(entry.DestinationAddress.StringValue),
 			DestinationAddressMask: fwhelpers.GetStringValue(entry.DestinationAddressMask),
 			EtherType:              fwhelpers.GetStringValue(entry.EtherType),
 			VlanID:                 fwhelpers.GetInt64Value(entry.VlanID),
@@ -231,10 +234,10 @@ func (m *AccessListMACModel) FromClient(ctx context.Context, acl *client.AccessL
 				Sequence:               types.Int64Value(int64(entry.Sequence)),
 				AceAction:              types.StringValue(entry.AceAction),
 				SourceAny:              types.BoolValue(sourceAny),
-				SourceAddress:          fwhelpers.StringValueOrNull(sourceAddress),
+				SourceAddress:          customtypes.MACAddressValueOrNull(sourceAddress),
 				SourceAddressMask:      fwhelpers.StringValueOrNull(entry.SourceAddressMask),
 				DestinationAny:         types.BoolValue(destinationAny),
-				DestinationAddress:     fwhelpers.StringValueOrNull(destinationAddress),
+				DestinationAddress:     customtypes.MACAddressValueOrNull(destinationAddress),
 				DestinationAddressMask: fwhelpers.StringValueOrNull(entry.DestinationAddressMask),
 				EtherType:              fwhelpers.StringValueOrNull(entry.EtherType),
 				VlanID:                 fwhelpers.Int64ValueOrNull(entry.VlanID),
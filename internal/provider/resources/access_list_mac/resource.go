@@ -21,6 +21,7 @@ import (
 
 	"github.com/sh1/terraform-provider-rtx/internal/client"
 	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/customtypes"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
 )
 
@@ -79,6 +80,7 @@ func (r *AccessListMACResource) Schema(ctx context.Context, req resource.SchemaR
 					int64validator.Between(1, MaxSequence),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 
 		Blocks: map[string]schema.Block{
@@ -142,7 +144,8 @@ func (r *AccessListMACResource) Schema(ctx context.Context, req resource.SchemaR
 							Default:     booldefault.StaticBool(false),
 						},
 						"source_address": schema.StringAttribute{
-							Description: "Source MAC address (e.g., 00:00:00:00:00:00)",
+							Description: "Source MAC address, colon-separated (e.g., 00:11:22:33:44:55), hyphen-separated, in Cisco dot notation (e.g., 0011.2233.4455) or without separators. All forms of the same address are treated as equal.",
+							CustomType:  customtypes.MACAddressType{},
 							Optional:    true,
 						},
 						"source_address_mask": schema.StringAttribute{
@@ -156,7 +159,8 @@ func (r *AccessListMACResource) Schema(ctx context.Context, req resource.SchemaR
 							Default:     booldefault.StaticBool(false),
 						},
 						"destination_address": schema.StringAttribute{
-							Description: "Destination MAC address (e.g., 00:00:00:00:00:00)",
+							Description: "Destination MAC address, colon-separated (e.g., 00:11:22:33:44:55), hyphen-separated, in Cisco dot notation (e.g., 0011.2233.4455) or without separators. All forms of the same address are treated as equal.",
+							CustomType:  customtypes.MACAddressType{},
 							Optional:    true,
 						},
 						"destination_address_mask": schema.StringAttribute{
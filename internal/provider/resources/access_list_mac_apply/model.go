@@ -12,6 +12,8 @@ type AccessListMACApplyModel struct {
 	Interface  types.String `tfsdk:"interface"`
 	Direction  types.String `tfsdk:"direction"`
 	Sequences  types.List   `tfsdk:"sequences"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // GetSequencesAsInts extracts sequences as a slice of integers.
@@ -92,6 +92,7 @@ func (r *AccessListMACApplyResource) Schema(ctx context.Context, req resource.Sc
 					),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -422,13 +423,8 @@ func (r *AccessListMACApplyResource) Delete(ctx context.Context, req resource.De
 // ImportState imports an existing resource into Terraform.
 func (r *AccessListMACApplyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// ID format: interface:direction
-	id := req.ID
-	parts := strings.SplitN(id, ":", 2)
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Invalid import ID format: %s, expected 'interface:direction' (e.g., 'lan1:in')", id),
-		)
+	parts, ok := fwhelpers.SplitImportID(&resp.Diagnostics, req.ID, ":", 2, "'interface:direction' (e.g., 'lan1:in')")
+	if !ok {
 		return
 	}
 
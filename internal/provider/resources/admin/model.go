@@ -13,6 +13,13 @@ type AdminModel struct {
 	LoginPassword types.String `tfsdk:"login_password"`
 	AdminPassword types.String `tfsdk:"admin_password"`
 	LastUpdated   types.String `tfsdk:"last_updated"`
+
+	LoginPasswordWO        types.String `tfsdk:"login_password_wo"`
+	LoginPasswordWOVersion types.Int64  `tfsdk:"login_password_wo_version"`
+	AdminPasswordWO        types.String `tfsdk:"admin_password_wo"`
+	AdminPasswordWOVersion types.Int64  `tfsdk:"admin_password_wo_version"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.AdminConfig.
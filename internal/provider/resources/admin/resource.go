@@ -56,10 +56,15 @@ func (r *AdminResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"login_password_wo":         fwhelpers.WriteOnlySecretAttribute("login_password"),
+			"login_password_wo_version": fwhelpers.WriteOnlyVersionAttribute("login_password"),
+			"admin_password_wo":         fwhelpers.WriteOnlySecretAttribute("admin_password"),
+			"admin_password_wo_version": fwhelpers.WriteOnlyVersionAttribute("admin_password"),
 			"last_updated": schema.StringAttribute{
 				Description: "Timestamp of the last password update performed by Terraform (RFC3339 format).",
 				Computed:    true,
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -98,6 +103,8 @@ func (r *AdminResource) Create(ctx context.Context, req resource.CreateRequest,
 	logger := logging.FromContext(ctx)
 
 	config := data.ToClient()
+	config.LoginPassword = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("login_password_wo"), data.LoginPassword, &resp.Diagnostics)
+	config.AdminPassword = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("admin_password_wo"), data.AdminPassword, &resp.Diagnostics)
 
 	// Only configure if passwords are provided
 	if config.AdminPassword != "" || config.LoginPassword != "" {
@@ -171,6 +178,8 @@ func (r *AdminResource) Update(ctx context.Context, req resource.UpdateRequest,
 	logger := logging.FromContext(ctx)
 
 	config := data.ToClient()
+	config.LoginPassword = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("login_password_wo"), data.LoginPassword, &resp.Diagnostics)
+	config.AdminPassword = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("admin_password_wo"), data.AdminPassword, &resp.Diagnostics)
 
 	// Check if passwords are provided for update
 	if config.AdminPassword != "" || config.LoginPassword != "" {
@@ -214,12 +223,7 @@ func (r *AdminResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 // ImportState imports an existing resource into Terraform.
 func (r *AdminResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// For import, we just set the ID to "admin"
-	// Passwords must be provided in the Terraform configuration after import
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-
-	// Override with fixed ID for singleton
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "admin")...)
+	fwhelpers.ImportSingleton(ctx, "admin", req, resp)
 
 	logging.FromContext(ctx).Info().Str("resource", "rtx_admin").Msg("Admin configuration imported. Note: Passwords must be set in configuration as they cannot be read from the router.")
 }
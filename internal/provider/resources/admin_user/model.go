@@ -16,6 +16,13 @@ type AdminUserModel struct {
 	ConnectionMethods types.Set    `tfsdk:"connection_methods"`
 	GUIPages          types.Set    `tfsdk:"gui_pages"`
 	LoginTimer        types.Int64  `tfsdk:"login_timer"`
+
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+
+	PreventDestroyOnDevice types.Bool `tfsdk:"prevent_destroy_on_device"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.AdminUser.
@@ -30,6 +30,9 @@ var (
 	_ resource.ResourceWithImportState = &AdminUserResource{}
 )
 
+// usernamePattern matches valid login user names
+var usernamePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
 // NewAdminUserResource creates a new admin user resource.
 func NewAdminUserResource() resource.Resource {
 	return &AdminUserResource{}
@@ -58,7 +61,7 @@ func (r *AdminUserResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`),
+						usernamePattern,
 						"must start with a letter and contain only alphanumeric characters and underscores",
 					),
 				},
@@ -68,6 +71,8 @@ func (r *AdminUserResource) Schema(ctx context.Context, req resource.SchemaReque
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"password_wo":         fwhelpers.WriteOnlySecretAttribute("password"),
+			"password_wo_version": fwhelpers.WriteOnlyVersionAttribute("password"),
 			"encrypted": schema.BoolAttribute{
 				Description: "Whether the password is already encrypted. If true, the password value will be used as-is.",
 				Optional:    true,
@@ -110,6 +115,8 @@ func (r *AdminUserResource) Schema(ctx context.Context, req resource.SchemaReque
 					int64validator.AtLeast(0),
 				},
 			},
+			"prevent_destroy_on_device": fwhelpers.PreventDestroyOnDeviceAttribute("user account"),
+			"applied_commands":          fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -145,6 +152,7 @@ func (r *AdminUserResource) Create(ctx context.Context, req resource.CreateReque
 	logger := logging.FromContext(ctx)
 
 	user := data.ToClient()
+	user.Password = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("password_wo"), data.Password, &resp.Diagnostics)
 	logger.Debug().Str("resource", "rtx_admin_user").Msgf("Creating admin user: %s", user.Username)
 
 	if err := r.client.CreateAdminUser(ctx, user); err != nil {
@@ -222,6 +230,7 @@ func (r *AdminUserResource) Update(ctx context.Context, req resource.UpdateReque
 	plannedEncrypted := data.Encrypted
 
 	user := data.ToClient()
+	user.Password = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("password_wo"), data.Password, &resp.Diagnostics)
 	logger.Debug().Str("resource", "rtx_admin_user").Msgf("Updating admin user: %s", user.Username)
 
 	if err := r.client.UpdateAdminUser(ctx, user); err != nil {
@@ -263,6 +272,10 @@ func (r *AdminUserResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	logger.Debug().Str("resource", "rtx_admin_user").Msgf("Deleting admin user: %s", username)
 
+	if fwhelpers.DestroyPrevented(ctx, data.PreventDestroyOnDevice, fmt.Sprintf("Admin user %s", username), &resp.Diagnostics) {
+		return
+	}
+
 	if err := r.client.DeleteAdminUser(ctx, username); err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			return
@@ -277,5 +290,10 @@ func (r *AdminUserResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // ImportState imports an existing resource into Terraform.
 func (r *AdminUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !usernamePattern.MatchString(req.ID) {
+		fwhelpers.AddImportIDError(&resp.Diagnostics, req.ID, "the username (e.g., 'operator')")
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("username"), req, resp)
 }
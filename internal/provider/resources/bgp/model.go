@@ -21,6 +21,8 @@ type BGPModel struct {
 	Networks              types.List   `tfsdk:"network"`
 	RedistributeStatic    types.Bool   `tfsdk:"redistribute_static"`
 	RedistributeConnected types.Bool   `tfsdk:"redistribute_connected"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // NeighborModel describes the neighbor nested block data model.
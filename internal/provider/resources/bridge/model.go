@@ -13,6 +13,8 @@ type BridgeModel struct {
 	Name          types.String `tfsdk:"name"`
 	InterfaceName types.String `tfsdk:"interface_name"`
 	Members       types.List   `tfsdk:"members"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.BridgeConfig.
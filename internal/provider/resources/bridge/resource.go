@@ -80,6 +80,7 @@ func (r *BridgeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
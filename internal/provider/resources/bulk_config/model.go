@@ -0,0 +1,34 @@
+package bulk_config
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// BulkConfigModel describes the resource data model.
+type BulkConfigModel struct {
+	ID            types.String `tfsdk:"id"`
+	Content       types.String `tfsdk:"content"`
+	Transport     types.String `tfsdk:"transport"`
+	ConfigNumber  types.Int64  `tfsdk:"config_number"`
+	Restart       types.Bool   `tfsdk:"restart"`
+	RunningConfig types.String `tfsdk:"running_config"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.BulkConfig.
+func (m *BulkConfigModel) ToClient() client.BulkConfig {
+	number := -1
+	if !m.ConfigNumber.IsNull() && !m.ConfigNumber.IsUnknown() {
+		number = fwhelpers.GetInt64Value(m.ConfigNumber)
+	}
+	return client.BulkConfig{
+		Content:      fwhelpers.GetStringValue(m.Content),
+		Transport:    fwhelpers.GetStringValue(m.Transport),
+		ConfigNumber: number,
+		Restart:      fwhelpers.GetBoolValue(m.Restart),
+	}
+}
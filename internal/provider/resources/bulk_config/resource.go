@@ -0,0 +1,225 @@
+package bulk_config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &BulkConfigResource{}
+	_ resource.ResourceWithImportState = &BulkConfigResource{}
+)
+
+// NewBulkConfigResource creates a new bulk config resource.
+func NewBulkConfigResource() resource.Resource {
+	return &BulkConfigResource{}
+}
+
+// BulkConfigResource defines the resource implementation.
+type BulkConfigResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *BulkConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bulk_config"
+}
+
+// Schema defines the schema for the resource.
+func (r *BulkConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Pushes a complete router configuration as one file via SFTP or TFTP, instead of sending it as individual commands. " +
+			"Intended for large initial configurations: the file replaces the whole configuration, and the router is restarted to load it. " +
+			"Afterwards the configuration is read back with 'show config' and other resources refresh their state from it. " +
+			"Use it on its own or before other resources are created, not alongside resources managing the same settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (always 'bulk_config').",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "Complete configuration, one command per line as in 'show config' (e.g., rendered with templatefile()). " +
+					"Every change pushes the whole file again.",
+				Required: true,
+			},
+			"transport": schema.StringAttribute{
+				Description: "How the file is transferred: 'sftp' (requires 'sftpd host' on the router) or 'tftp' " +
+					"(requires 'tftp host' allowing this host; the administrator password is sent in the file name). Defaults to 'sftp'.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(client.BulkConfigTransportSFTP),
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.BulkConfigTransportSFTP, client.BulkConfigTransportTFTP),
+				},
+			},
+			"config_number": schema.Int64Attribute{
+				Description: "Configuration file to write (configN). Defaults to the file the router loads at startup.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 9),
+				},
+			},
+			"restart": schema.BoolAttribute{
+				Description: "Restart the router after the transfer so that it loads the file, and wait until it is back. " +
+					"When false, the file takes effect at the next restart, and any save before then overwrites it. Defaults to true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"running_config": schema.StringAttribute{
+				Description: "Configuration reported by 'show config' after the push, refreshed on every read. Marked sensitive as it contains passwords and keys.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *BulkConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create pushes the configuration and sets the initial Terraform state.
+func (r *BulkConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BulkConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.push(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the configuration read back from the router.
+func (r *BulkConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BulkConfigModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_bulk_config", "bulk_config")
+	logging.FromContext(ctx).Debug().Str("resource", "rtx_bulk_config").Msg("Reading router configuration")
+
+	config, err := r.client.GetRunningConfig(ctx, "")
+	if err != nil {
+		fwhelpers.AppendDiagError(&resp.Diagnostics, "Failed to read router configuration", fmt.Sprintf("Could not read router configuration: %v", err))
+		return
+	}
+	data.RunningConfig = types.StringValue(config)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update pushes the changed configuration.
+func (r *BulkConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BulkConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.push(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from state. The pushed configuration stays on
+// the router, as there is no previous configuration to return to.
+func (r *BulkConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning(
+		"Configuration Left on Router",
+		"rtx_bulk_config was removed from state, but the pushed configuration remains on the router.",
+	)
+}
+
+// ImportState adopts the configuration running on the router as content, so
+// that the next change pushes a full configuration without recreating the resource.
+func (r *BulkConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fwhelpers.ImportSingleton(ctx, "bulk_config", req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetRunningConfig(ctx, "")
+	if err != nil {
+		fwhelpers.AppendDiagError(&resp.Diagnostics, "Failed to import router configuration", fmt.Sprintf("Could not read router configuration: %v", err))
+		return
+	}
+
+	data := BulkConfigModel{
+		ID:            types.StringValue("bulk_config"),
+		Content:       types.StringValue(config),
+		Transport:     types.StringValue(client.BulkConfigTransportSFTP),
+		ConfigNumber:  types.Int64Null(),
+		Restart:       types.BoolValue(true),
+		RunningConfig: types.StringValue(config),
+
+		AppliedCommands: types.ListNull(types.StringType),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// push sends the configuration and records what the router reports afterwards
+func (r *BulkConfigResource) push(ctx context.Context, data *BulkConfigModel, diagnostics *diag.Diagnostics) {
+	ctx = logging.WithResource(ctx, "rtx_bulk_config", "bulk_config")
+	bulk := data.ToClient()
+
+	logging.FromContext(ctx).Debug().Str("resource", "rtx_bulk_config").
+		Msgf("Pushing configuration via %s (config %d, restart %t)", bulk.Transport, bulk.ConfigNumber, bulk.Restart)
+
+	config, err := r.client.PushBulkConfig(ctx, bulk)
+	if err != nil {
+		diagnostics.AddError("Failed to push configuration", fmt.Sprintf("Could not push configuration: %v", err))
+		return
+	}
+
+	data.ID = types.StringValue("bulk_config")
+	data.RunningConfig = types.StringValue(config)
+}
@@ -0,0 +1,59 @@
+package certificate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// CertificateModel describes the resource data model.
+type CertificateModel struct {
+	CertID         types.Int64  `tfsdk:"cert_id"`
+	File           types.String `tfsdk:"file"`
+	CertificatePEM types.String `tfsdk:"certificate_pem"`
+	PrivateKeyPEM  types.String `tfsdk:"private_key_pem"`
+	Fingerprint    types.String `tfsdk:"fingerprint"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// defaultCertificateFile returns the file used when none is configured.
+func defaultCertificateFile(id int) string {
+	return fmt.Sprintf("/cert%d.pem", id)
+}
+
+// ToClient converts the Terraform model to a client.Certificate.
+func (m *CertificateModel) ToClient() client.Certificate {
+	id := fwhelpers.GetInt64Value(m.CertID)
+
+	file := fwhelpers.GetStringValue(m.File)
+	if file == "" {
+		file = defaultCertificateFile(id)
+	}
+
+	return client.Certificate{
+		ID:             id,
+		File:           file,
+		CertificatePEM: fwhelpers.GetStringValue(m.CertificatePEM),
+		PrivateKeyPEM:  fwhelpers.GetStringValue(m.PrivateKeyPEM),
+	}
+}
+
+// FromClient updates the Terraform model from a client.Certificate.
+// The PEM content is write-only and kept from the configuration; the
+// fingerprint is derived from it.
+func (m *CertificateModel) FromClient(cert *client.Certificate) {
+	m.CertID = types.Int64Value(int64(cert.ID))
+	m.File = types.StringValue(cert.File)
+
+	m.Fingerprint = types.StringNull()
+	if !m.CertificatePEM.IsNull() && !m.CertificatePEM.IsUnknown() {
+		if fingerprint, err := parsers.CertificateFingerprint(m.CertificatePEM.ValueString()); err == nil {
+			m.Fingerprint = types.StringValue(fingerprint)
+		}
+	}
+}
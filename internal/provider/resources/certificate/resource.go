@@ -0,0 +1,303 @@
+package certificate
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &CertificateResource{}
+	_ resource.ResourceWithImportState    = &CertificateResource{}
+	_ resource.ResourceWithValidateConfig = &CertificateResource{}
+)
+
+// NewCertificateResource creates a new certificate resource.
+func NewCertificateResource() resource.Resource {
+	return &CertificateResource{}
+}
+
+// CertificateResource defines the resource implementation.
+type CertificateResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *CertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+// Schema defines the schema for the resource.
+func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Installs a certificate (and optionally its private key) on an RTX router for IKEv2 or the HTTPS GUI. " +
+			"The PEM content is uploaded via SFTP and registered with 'pki certificate file', so sftpd must be enabled " +
+			"(see rtx_sftpd). The PEM content is not read back from the router.",
+		Attributes: map[string]schema.Attribute{
+			"cert_id": schema.Int64Attribute{
+				Description: "Certificate ID referenced by other settings (1-99).",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 99),
+				},
+			},
+			"file": schema.StringAttribute{
+				Description: "Absolute path of the certificate file on the router. Defaults to '/cert<cert_id>.pem'.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_pem": schema.StringAttribute{
+				Description: "PEM encoded certificate, optionally followed by intermediate certificates.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"private_key_pem": schema.StringAttribute{
+				Description: "PEM encoded private key matching the certificate. Stored in the same file as the certificate.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"fingerprint": schema.StringAttribute{
+				Description: "SHA-256 fingerprint of the certificate, as colon separated hex.",
+				Computed:    true,
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// ValidateConfig performs custom validation on the resource configuration.
+func (r *CertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CertificateModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.CertificatePEM.IsUnknown() || data.CertificatePEM.IsNull() || data.PrivateKeyPEM.IsUnknown() {
+		return
+	}
+
+	if err := parsers.ValidateCertificatePEM(data.CertificatePEM.ValueString(), data.PrivateKeyPEM.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate_pem"),
+			"Invalid Certificate",
+			err.Error(),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *CertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CertificateModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert := data.ToClient()
+
+	ctx = logging.WithResource(ctx, "rtx_certificate", strconv.Itoa(cert.ID))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_certificate").Msgf("Creating certificate %d at %s", cert.ID, cert.File)
+
+	if err := r.client.CreateCertificate(ctx, cert); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create certificate",
+			fmt.Sprintf("Could not create certificate: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CertificateModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.CertID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the certificate registration from the router.
+func (r *CertificateResource) read(ctx context.Context, data *CertificateModel, diagnostics *diag.Diagnostics) {
+	id := fwhelpers.GetInt64Value(data.CertID)
+
+	ctx = logging.WithResource(ctx, "rtx_certificate", strconv.Itoa(id))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_certificate").Msgf("Reading certificate: %d", id)
+
+	var cert *client.Certificate
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			for _, parsed := range parsedConfig.ExtractCertificates() {
+				if parsed.ID == id {
+					cert = &client.Certificate{ID: parsed.ID, File: parsed.File}
+					logger.Debug().Str("resource", "rtx_certificate").Msg("Found certificate in SFTP cache")
+					break
+				}
+			}
+		}
+		if cert == nil {
+			logger.Debug().Str("resource", "rtx_certificate").Msg("Certificate not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or certificate not found in cache
+	if cert == nil {
+		var err error
+		cert, err = r.client.GetCertificate(ctx, id)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_certificate").Msgf("Certificate %d not found, removing from state", id)
+				data.CertID = types.Int64Null()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read certificate", fmt.Sprintf("Could not read certificate %d: %v", id, err))
+			return
+		}
+	}
+
+	data.FromClient(cert)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CertificateModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert := data.ToClient()
+
+	ctx = logging.WithResource(ctx, "rtx_certificate", strconv.Itoa(cert.ID))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_certificate").Msgf("Updating certificate %d at %s", cert.ID, cert.File)
+
+	if err := r.client.UpdateCertificate(ctx, cert); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update certificate",
+			fmt.Sprintf("Could not update certificate: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CertificateModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := fwhelpers.GetInt64Value(data.CertID)
+
+	ctx = logging.WithResource(ctx, "rtx_certificate", strconv.Itoa(id))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_certificate").Msgf("Deleting certificate: %d", id)
+
+	if err := r.client.DeleteCertificate(ctx, id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete certificate",
+			fmt.Sprintf("Could not delete certificate %d: %v", id, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+// certificate_pem and private_key_pem must be set in configuration after import.
+func (r *CertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	certID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "cert_id", 1, 99)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cert_id"), certID)...)
+}
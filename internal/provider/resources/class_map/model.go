@@ -15,6 +15,8 @@ type ClassMapModel struct {
 	MatchSourcePort      types.List   `tfsdk:"match_source_port"`
 	MatchDSCP            types.String `tfsdk:"match_dscp"`
 	MatchFilter          types.Int64  `tfsdk:"match_filter"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.ClassMap.
@@ -29,6 +29,9 @@ var (
 	_ resource.ResourceWithImportState = &ClassMapResource{}
 )
 
+// namePattern matches valid class map names
+var namePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+
 // NewClassMapResource creates a new class map resource.
 func NewClassMapResource() resource.Resource {
 	return &ClassMapResource{}
@@ -57,7 +60,7 @@ func (r *ClassMapResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`),
+						namePattern,
 						"must start with a letter and contain only alphanumeric characters, underscores, and hyphens",
 					),
 				},
@@ -97,6 +100,7 @@ func (r *ClassMapResource) Schema(ctx context.Context, req resource.SchemaReques
 					int64validator.Between(1, 65535),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -257,5 +261,10 @@ func (r *ClassMapResource) Delete(ctx context.Context, req resource.DeleteReques
 
 // ImportState imports an existing resource into Terraform.
 func (r *ClassMapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !namePattern.MatchString(req.ID) {
+		fwhelpers.AddImportIDError(&resp.Diagnostics, req.ID, "the class map name (e.g., 'voip')")
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
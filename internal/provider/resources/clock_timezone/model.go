@@ -0,0 +1,88 @@
+package clock_timezone
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// ClockTimezoneModel describes the resource data model.
+type ClockTimezoneModel struct {
+	ID         types.String `tfsdk:"id"`
+	Timezone   types.String `tfsdk:"timezone"`
+	SNTPServer types.Bool   `tfsdk:"sntp_server"`
+	NTPSync    types.List   `tfsdk:"ntp_sync"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// NTPSyncModel describes the ntp_sync nested block.
+type NTPSyncModel struct {
+	ScheduleID types.Int64  `tfsdk:"schedule_id"`
+	Time       types.String `tfsdk:"time"`
+	Server     types.String `tfsdk:"server"`
+}
+
+// NTPSyncAttrTypes returns the attribute types for NTPSyncModel.
+func NTPSyncAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"schedule_id": types.Int64Type,
+		"time":        types.StringType,
+		"server":      types.StringType,
+	}
+}
+
+// ToClient converts the Terraform model to a client.ClockConfig.
+func (m *ClockTimezoneModel) ToClient(ctx context.Context) (client.ClockConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := client.ClockConfig{
+		Timezone:   fwhelpers.GetStringValue(m.Timezone),
+		SNTPServer: fwhelpers.GetBoolValue(m.SNTPServer),
+	}
+
+	if !m.NTPSync.IsNull() && !m.NTPSync.IsUnknown() {
+		var syncs []NTPSyncModel
+		diags.Append(m.NTPSync.ElementsAs(ctx, &syncs, false)...)
+		if diags.HasError() {
+			return config, diags
+		}
+		if len(syncs) > 0 {
+			config.SyncScheduleID = fwhelpers.GetInt64Value(syncs[0].ScheduleID)
+			config.SyncTime = fwhelpers.GetStringValue(syncs[0].Time)
+			config.NTPServer = fwhelpers.GetStringValue(syncs[0].Server)
+		}
+	}
+
+	return config, diags
+}
+
+// FromClient updates the Terraform model from a client.ClockConfig.
+func (m *ClockTimezoneModel) FromClient(ctx context.Context, config *client.ClockConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue("clock_timezone")
+	m.Timezone = types.StringValue(config.Timezone)
+	m.SNTPServer = types.BoolValue(config.SNTPServer)
+
+	if config.SyncScheduleID > 0 {
+		syncObj, d := types.ObjectValue(NTPSyncAttrTypes(), map[string]attr.Value{
+			"schedule_id": types.Int64Value(int64(config.SyncScheduleID)),
+			"time":        types.StringValue(config.SyncTime),
+			"server":      types.StringValue(config.NTPServer),
+		})
+		diags.Append(d...)
+		list, d := types.ListValue(types.ObjectType{AttrTypes: NTPSyncAttrTypes()}, []attr.Value{syncObj})
+		diags.Append(d...)
+		m.NTPSync = list
+	} else {
+		m.NTPSync = types.ListNull(types.ObjectType{AttrTypes: NTPSyncAttrTypes()})
+	}
+
+	return diags
+}
@@ -0,0 +1,322 @@
+package clock_timezone
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ClockTimezoneResource{}
+	_ resource.ResourceWithImportState = &ClockTimezoneResource{}
+)
+
+var (
+	timezonePattern = regexp.MustCompile(`^[\+\-]\d{2}:\d{2}$`)
+	timePattern     = regexp.MustCompile(`^([01]?\d|2[0-3]):[0-5]\d$`)
+	serverPattern   = regexp.MustCompile(`^\S+$`)
+)
+
+// NewClockTimezoneResource creates a new clock timezone resource.
+func NewClockTimezoneResource() resource.Resource {
+	return &ClockTimezoneResource{}
+}
+
+// ClockTimezoneResource defines the resource implementation.
+type ClockTimezoneResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *ClockTimezoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clock_timezone"
+}
+
+// Schema defines the schema for the resource.
+func (r *ClockTimezoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages clock settings on RTX routers so log timestamps are consistent across routers: " +
+			"the timezone, a daily 'ntpdate' synchronisation and the built-in SNTP server. " +
+			"When rtx_system is also used, its timezone must be set to the same value. " +
+			"This is a singleton resource - only one instance can exist per router.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (always 'clock_timezone' for this singleton resource).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Description: "Timezone as UTC offset (e.g., '+09:00' for JST, '-05:00' for EST).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(timezonePattern, "must be a UTC offset such as '+09:00'"),
+				},
+			},
+			"sntp_server": schema.BoolAttribute{
+				Description: "Serve time to LAN clients with the built-in SNTP server ('sntpd service').",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"ntp_sync": schema.ListNestedBlock{
+				Description: "Daily clock synchronisation implemented with 'schedule at ... ntpdate <server> syslog'. " +
+					"The schedule ID must not be used by an rtx_kron_schedule resource.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"schedule_id": schema.Int64Attribute{
+							Description: "Schedule ID (1-65535).",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"time": schema.StringAttribute{
+							Description: "Time of day at which the clock is synchronised in HH:MM format (24-hour).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(timePattern, "must be a time in HH:MM format"),
+							},
+						},
+						"server": schema.StringAttribute{
+							Description: "NTP server hostname or IP address (e.g., 'ntp.nict.jp').",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(serverPattern, "must be a hostname or IP address without whitespace"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ClockTimezoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ClockTimezoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClockTimezoneModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_clock_timezone", "clock_timezone")
+	logger := logging.FromContext(ctx)
+
+	config, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger.Debug().Str("resource", "rtx_clock_timezone").Msgf("Creating clock settings: %+v", config)
+
+	if err := r.client.ConfigureClock(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create clock settings",
+			fmt.Sprintf("Could not create clock settings: %v", err),
+		)
+		return
+	}
+
+	// Set ID for singleton resource
+	data.ID = types.StringValue("clock_timezone")
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ClockTimezoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClockTimezoneModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the resource was not found, remove from state
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the clock settings from the router.
+func (r *ClockTimezoneResource) read(ctx context.Context, data *ClockTimezoneModel, diagnostics *diag.Diagnostics) {
+	ctx = logging.WithResource(ctx, "rtx_clock_timezone", "clock_timezone")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_clock_timezone").Msg("Reading clock settings")
+
+	var config *client.ClockConfig
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			if parsed := parsedConfig.ExtractClock(); parsed != nil {
+				config = &client.ClockConfig{
+					Timezone:       parsed.Timezone,
+					NTPServer:      parsed.NTPServer,
+					SyncScheduleID: parsed.SyncScheduleID,
+					SyncTime:       parsed.SyncTime,
+					SNTPServer:     parsed.SNTPServer,
+				}
+				logger.Debug().Str("resource", "rtx_clock_timezone").Msg("Found clock settings in SFTP cache")
+			}
+		}
+		if config == nil {
+			logger.Debug().Str("resource", "rtx_clock_timezone").Msg("Clock settings not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or config not found in cache
+	if config == nil {
+		var err error
+		config, err = r.client.GetClock(ctx)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_clock_timezone").Msg("Clock settings not found, removing from state")
+				data.ID = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read clock settings", fmt.Sprintf("Could not read clock settings: %v", err))
+			return
+		}
+	}
+
+	diagnostics.Append(data.FromClient(ctx, config)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ClockTimezoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ClockTimezoneModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_clock_timezone", "clock_timezone")
+	logger := logging.FromContext(ctx)
+
+	config, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger.Debug().Str("resource", "rtx_clock_timezone").Msgf("Updating clock settings: %+v", config)
+
+	if err := r.client.UpdateClock(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update clock settings",
+			fmt.Sprintf("Could not update clock settings: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ClockTimezoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClockTimezoneModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_clock_timezone", "clock_timezone")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_clock_timezone").Msg("Deleting clock settings")
+
+	if err := r.client.ResetClock(ctx); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete clock settings",
+			fmt.Sprintf("Could not delete clock settings: %v", err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *ClockTimezoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Accept "clock_timezone" as the import ID (singleton resource)
+	if req.ID != "clock_timezone" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID 'clock_timezone', got %q", req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
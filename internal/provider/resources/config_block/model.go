@@ -0,0 +1,57 @@
+package config_block
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// ConfigBlockModel describes the resource data model.
+type ConfigBlockModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Context       types.String `tfsdk:"context"`
+	Lines         types.List   `tfsdk:"lines"`
+	OwnedPrefixes types.List   `tfsdk:"owned_prefixes"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.ConfigBlock.
+func (m *ConfigBlockModel) ToClient() client.ConfigBlock {
+	return client.ConfigBlock{
+		Context:  fwhelpers.GetStringValue(m.Context),
+		Lines:    fwhelpers.ListToStringSlice(m.Lines),
+		Prefixes: fwhelpers.ListToStringSlice(m.OwnedPrefixes),
+	}
+}
+
+// FromClient updates the Terraform model from a client.ConfigBlock.
+// Lines already in the model keep their position and spelling; lines found
+// on the router but not in the model are appended in configuration order.
+func (m *ConfigBlockModel) FromClient(block *client.ConfigBlock) {
+	present := make(map[string]bool, len(block.Lines))
+	for _, line := range block.Lines {
+		present[parsers.NormalizeConfigBlockLine(line)] = true
+	}
+
+	lines := []string{}
+	known := make(map[string]bool)
+	for _, line := range fwhelpers.ListToStringSlice(m.Lines) {
+		normalized := parsers.NormalizeConfigBlockLine(line)
+		known[normalized] = true
+		if present[normalized] {
+			lines = append(lines, line)
+		}
+	}
+	for _, line := range block.Lines {
+		if !known[parsers.NormalizeConfigBlockLine(line)] {
+			lines = append(lines, line)
+		}
+	}
+
+	m.ID = m.Name
+	m.Lines = fwhelpers.StringSliceToList(lines)
+}
@@ -0,0 +1,52 @@
+package config_block
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+func TestConfigBlockModel_FromClient(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  []string
+		router   []string
+		expected []string
+	}{
+		{
+			name:     "keeps configured order and spelling",
+			current:  []string{"ip lan1  proxyarp on", "ip lan1 intrusion detection in on"},
+			router:   []string{"ip lan1 intrusion detection in on", "ip lan1 proxyarp on"},
+			expected: []string{"ip lan1  proxyarp on", "ip lan1 intrusion detection in on"},
+		},
+		{
+			name:     "drops lines missing on the router",
+			current:  []string{"ip lan1 proxyarp on", "ip lan1 intrusion detection in on"},
+			router:   []string{"ip lan1 intrusion detection in on"},
+			expected: []string{"ip lan1 intrusion detection in on"},
+		},
+		{
+			name:     "appends unmanaged owned lines",
+			current:  []string{"ip lan1 intrusion detection in on"},
+			router:   []string{"ip lan1 intrusion detection in on", "ip lan1 intrusion detection in reject on"},
+			expected: []string{"ip lan1 intrusion detection in on", "ip lan1 intrusion detection in reject on"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &ConfigBlockModel{
+				Name:  types.StringValue("block"),
+				Lines: fwhelpers.StringSliceToList(tt.current),
+			}
+			m.FromClient(&client.ConfigBlock{Lines: tt.router})
+
+			assert.Equal(t, tt.expected, fwhelpers.ListToStringSlice(m.Lines))
+			assert.Equal(t, "block", m.ID.ValueString())
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package config_block
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ConfigBlockResource{}
+	_ resource.ResourceWithImportState = &ConfigBlockResource{}
+)
+
+// NewConfigBlockResource creates a new config block resource.
+func NewConfigBlockResource() resource.Resource {
+	return &ConfigBlockResource{}
+}
+
+// ConfigBlockResource defines the resource implementation.
+type ConfigBlockResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *ConfigBlockResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_block"
+}
+
+// Schema defines the schema for the resource.
+func (r *ConfigBlockResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an arbitrary set of raw configuration lines for commands the provider does not model yet. " +
+			"Lines are applied as written and removed with 'no <line>' on delete. With owned_prefixes, the block owns every " +
+			"configuration line starting with one of the prefixes: unlisted matching lines are removed and show up as drift. " +
+			"Do not manage the same commands with both a config block and a dedicated resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (same as name).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name identifying the block within the Terraform configuration.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"context": schema.StringAttribute{
+				Description: "Context the lines belong to: 'tunnel select N', 'pp select N' or 'pp select anonymous'. Omit for global commands.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"lines": schema.ListAttribute{
+				Description: "Raw command lines, applied in order. 'no' commands, select commands and commands such as 'save' or 'restart' are not allowed.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"owned_prefixes": schema.ListAttribute{
+				Description: "Command prefixes owned by this block (e.g., 'ip lan1 intrusion'). Prefixes match whole words. " +
+					"Every line must match one of them. When omitted, the block owns exactly its lines.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ConfigBlockResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ConfigBlockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConfigBlockModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_config_block", name)
+	logger := logging.FromContext(ctx)
+
+	block := data.ToClient()
+	logger.Debug().Str("resource", "rtx_config_block").Msgf("Creating config block %s with %d lines", name, len(block.Lines))
+
+	if err := r.client.CreateConfigBlock(ctx, block); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create config block",
+			fmt.Sprintf("Could not apply config block %s: %v", name, err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ConfigBlockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConfigBlockModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the lines owned by the block from the router.
+func (r *ConfigBlockResource) read(ctx context.Context, data *ConfigBlockModel, diagnostics *diag.Diagnostics) {
+	name := data.Name.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_config_block", name)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_config_block").Msgf("Reading config block %s", name)
+
+	query := data.ToClient()
+	var block *client.ConfigBlock
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			lines, err := parsedConfig.ExtractConfigBlockLines(parsers.ConfigBlock(query))
+			if err == nil && len(lines) > 0 {
+				block = &client.ConfigBlock{Context: query.Context, Lines: lines, Prefixes: query.Prefixes}
+				logger.Debug().Str("resource", "rtx_config_block").Msg("Found config block lines in SFTP cache")
+			}
+		}
+		if block == nil {
+			logger.Debug().Str("resource", "rtx_config_block").Msg("Config block lines not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or lines not found in cache
+	if block == nil {
+		var err error
+		block, err = r.client.GetConfigBlock(ctx, query)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_config_block").Msgf("Config block %s has no lines on the router, removing from state", name)
+				data.ID = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read config block", fmt.Sprintf("Could not read config block %s: %v", name, err))
+			return
+		}
+	}
+
+	data.FromClient(block)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ConfigBlockResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ConfigBlockModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_config_block", name)
+	logger := logging.FromContext(ctx)
+
+	block := data.ToClient()
+	logger.Debug().Str("resource", "rtx_config_block").Msgf("Updating config block %s with %d lines", name, len(block.Lines))
+
+	if err := r.client.UpdateConfigBlock(ctx, state.ToClient(), block); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update config block",
+			fmt.Sprintf("Could not apply config block %s: %v", name, err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ConfigBlockResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ConfigBlockModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_config_block", name)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_config_block").Msgf("Deleting config block %s", name)
+
+	if err := r.client.DeleteConfigBlock(ctx, data.ToClient()); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete config block",
+			fmt.Sprintf("Could not remove config block %s: %v", name, err),
+		)
+		return
+	}
+}
+
+// ImportState adopts configuration lines already on the router. The lines are
+// selected by owned prefixes, so the import ID names them: 'name:context:prefixes'.
+func (r *ConfigBlockResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	const expected = "'name:context:prefix1,prefix2' with an empty context for global commands " +
+		"(e.g., 'lan1-intrusion::ip lan1 intrusion' or 'wan-lcp:pp select 1:ppp lcp,ppp ipcp')"
+
+	parts := strings.SplitN(req.ID, ":", 3)
+	if len(parts) != 3 || parts[0] == "" {
+		fwhelpers.AddImportIDError(&resp.Diagnostics, req.ID, expected)
+		return
+	}
+	name, blockContext := parts[0], parsers.NormalizeConfigBlockLine(parts[1])
+	if _, err := parsers.ParseConfigBlockContext(blockContext); err != nil {
+		fwhelpers.AddImportIDError(&resp.Diagnostics, req.ID, expected)
+		return
+	}
+
+	var prefixes []string
+	for _, prefix := range strings.Split(parts[2], ",") {
+		if prefix = parsers.NormalizeConfigBlockLine(prefix); prefix != "" {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	if len(prefixes) == 0 {
+		fwhelpers.AddImportIDError(&resp.Diagnostics, req.ID, expected)
+		return
+	}
+
+	data := ConfigBlockModel{
+		ID:            types.StringValue(name),
+		Name:          types.StringValue(name),
+		Context:       fwhelpers.StringValueOrNull(blockContext),
+		Lines:         fwhelpers.StringSliceToList([]string{}),
+		OwnedPrefixes: fwhelpers.StringSliceToList(prefixes),
+
+		AppliedCommands: types.ListNull(types.StringType),
+	}
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.ID.IsNull() {
+		resp.Diagnostics.AddError(
+			"Failed to import config block",
+			fmt.Sprintf("No configuration lines matching %s found for import ID %q", strings.Join(prefixes, ", "), req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -14,6 +14,8 @@ type DDNSModel struct {
 	Hostname types.String `tfsdk:"hostname"`
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.DDNSServerConfig.
@@ -84,6 +84,7 @@ func (r *DDNSResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -247,20 +248,8 @@ func (r *DDNSResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 // ImportState imports an existing resource into Terraform.
 func (r *DDNSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	serverID, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid DDNS server ID",
-			fmt.Sprintf("Invalid DDNS server ID: %s (must be integer 1-4)", req.ID),
-		)
-		return
-	}
-
-	if serverID < 1 || serverID > 4 {
-		resp.Diagnostics.AddError(
-			"Invalid DDNS server ID",
-			fmt.Sprintf("Invalid DDNS server ID: %d (must be 1-4)", serverID),
-		)
+	serverID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "server_id", 1, 4)
+	if !ok {
 		return
 	}
 
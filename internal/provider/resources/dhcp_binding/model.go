@@ -7,19 +7,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/customtypes"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
 )
 
 // DHCPBindingModel describes the resource data model.
 type DHCPBindingModel struct {
-	ID               types.String `tfsdk:"id"`
-	ScopeID          types.Int64  `tfsdk:"scope_id"`
-	IPAddress        types.String `tfsdk:"ip_address"`
-	MACAddress       types.String `tfsdk:"mac_address"`
-	UseMACAsClientID types.Bool   `tfsdk:"use_mac_as_client_id"`
-	ClientIdentifier types.String `tfsdk:"client_identifier"`
-	Hostname         types.String `tfsdk:"hostname"`
-	Description      types.String `tfsdk:"description"`
+	ID               types.String           `tfsdk:"id"`
+	ScopeID          types.Int64            `tfsdk:"scope_id"`
+	IPAddress        types.String           `tfsdk:"ip_address"`
+	MACAddress       customtypes.MACAddress `tfsdk:"mac_address"`
+	UseMACAsClientID types.Bool             `tfsdk:"use_mac_as_client_id"`
+	ClientIdentifier types.String           `tfsdk:"client_identifier"`
+	Hostname         types.String           `tfsdk:"hostname"`
+	Description      types.String           `tfsdk:"description"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.DHCPBinding.
@@ -31,7 +34,7 @@ func (m *DHCPBindingModel) ToClient() client.DHCPBinding {
 
 	// Handle client identification method
 	if !m.MACAddress.IsNull() && m.MACAddress.ValueString() != "" {
-		binding.MACAddress = fwhelpers.GetStringValue(m.MACAddress)
+		binding.MACAddress = fwhelpers.GetStringValue(m.MACAddress.StringValue)
 		binding.UseClientIdentifier = fwhelpers.GetBoolValue(m.UseMACAsClientID)
 	} else if !m.ClientIdentifier.IsNull() && m.ClientIdentifier.ValueString() != "" {
 		binding.ClientIdentifier = fwhelpers.GetStringValue(m.ClientIdentifier)
@@ -48,9 +51,9 @@ func (m *DHCPBindingModel) FromClient(binding *client.DHCPBinding) {
 
 	if binding.MACAddress != "" {
 		normalizedMAC, _ := normalizeMACAddress(binding.MACAddress)
-		m.MACAddress = types.StringValue(normalizedMAC)
+		m.MACAddress = customtypes.MACAddressValue(normalizedMAC)
 	} else {
-		m.MACAddress = types.StringNull()
+		m.MACAddress = customtypes.MACAddressNull()
 	}
 
 	m.UseMACAsClientID = types.BoolValue(binding.UseClientIdentifier)
@@ -72,6 +75,7 @@ func normalizeMACAddress(mac string) (string, error) {
 	cleaned := strings.ToLower(mac)
 	cleaned = strings.ReplaceAll(cleaned, ":", "")
 	cleaned = strings.ReplaceAll(cleaned, "-", "")
+	cleaned = strings.ReplaceAll(cleaned, ".", "")
 	cleaned = strings.ReplaceAll(cleaned, " ", "")
 
 	// Validate length
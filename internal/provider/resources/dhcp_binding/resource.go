@@ -20,6 +20,7 @@ import (
 
 	"github.com/sh1/terraform-provider-rtx/internal/client"
 	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/customtypes"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
 )
 
@@ -71,7 +72,8 @@ func (r *DHCPBindingResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"mac_address": schema.StringAttribute{
-				Description: "The MAC address of the device (e.g., '00:11:22:33:44:55'). Conflicts with client_identifier.",
+				Description: "The MAC address of the device, colon-separated (e.g., '00:11:22:33:44:55'), hyphen-separated, in Cisco dot notation (e.g., '0011.2233.4455') or without separators. All forms of the same address are treated as equal. Conflicts with client_identifier.",
+				CustomType:  customtypes.MACAddressType{},
 				Optional:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -114,6 +116,7 @@ func (r *DHCPBindingResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
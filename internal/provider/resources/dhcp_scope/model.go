@@ -20,6 +20,8 @@ type DHCPScopeModel struct {
 	LeaseTime     types.String        `tfsdk:"lease_time"`
 	ExcludeRanges []ExcludeRangeModel `tfsdk:"exclude_ranges"`
 	Options       *OptionsModel       `tfsdk:"options"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ExcludeRangeModel describes an IP range excluded from DHCP allocation.
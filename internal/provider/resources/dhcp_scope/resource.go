@@ -82,6 +82,7 @@ func (r *DHCPScopeResource) Schema(ctx context.Context, req resource.SchemaReque
 				Optional:    true,
 				Computed:    true,
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"exclude_ranges": schema.ListNestedBlock{
@@ -346,14 +347,10 @@ func (r *DHCPScopeResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // ImportState imports an existing resource into Terraform.
 func (r *DHCPScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	scopeID, err := strconv.Atoi(req.ID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			fmt.Sprintf("Expected scope ID as integer, got: %s", req.ID),
-		)
+	scopeID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "scope_id", 1, 0)
+	if !ok {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope_id"), int64(scopeID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope_id"), scopeID)...)
 }
@@ -20,10 +20,14 @@ type DNSServerModel struct {
 	NameServers         types.List   `tfsdk:"name_servers"`
 	ServerSelect        types.List   `tfsdk:"server_select"`
 	Hosts               types.Set    `tfsdk:"hosts"`
+	ManageHosts         types.Bool   `tfsdk:"manage_hosts"`
+	ManageServerSelect  types.Bool   `tfsdk:"manage_server_select"`
 	ServiceOn           types.Bool   `tfsdk:"service_on"`
 	PrivateAddressSpoof types.Bool   `tfsdk:"private_address_spoof"`
 	PriorityStart       types.Int64  `tfsdk:"priority_start"`
 	PriorityStep        types.Int64  `tfsdk:"priority_step"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // DNSServerSelectModel represents a domain-based DNS server selection entry.
@@ -74,8 +78,12 @@ func (m *DNSServerModel) ToClient(ctx context.Context, diags *diag.Diagnostics)
 		}
 	}
 
-	// Convert server_select list
-	if !m.ServerSelect.IsNull() && !m.ServerSelect.IsUnknown() {
+	// Convert server_select list. When selectors are not managed here,
+	// ServerSelect is nil so that selectors managed by rtx_dns_server_select
+	// are left untouched.
+	if !m.serverSelectManaged() {
+		config.ServerSelect = nil
+	} else if !m.ServerSelect.IsNull() && !m.ServerSelect.IsUnknown() {
 		priorityStart := fwhelpers.GetInt64Value(m.PriorityStart)
 		priorityStep := fwhelpers.GetInt64Value(m.PriorityStep)
 		if priorityStep == 0 {
@@ -130,8 +138,11 @@ func (m *DNSServerModel) ToClient(ctx context.Context, diags *diag.Diagnostics)
 		}
 	}
 
-	// Convert hosts list
-	if !m.Hosts.IsNull() && !m.Hosts.IsUnknown() {
+	// Convert hosts list. When hosts are not managed here, Hosts is nil so
+	// that hosts managed by rtx_dns_static_host are left untouched.
+	if !m.hostsManaged() {
+		config.Hosts = nil
+	} else if !m.Hosts.IsNull() && !m.Hosts.IsUnknown() {
 		var hosts []DNSHostModel
 		d := m.Hosts.ElementsAs(ctx, &hosts, false)
 		diags.Append(d...)
@@ -150,6 +161,19 @@ func (m *DNSServerModel) ToClient(ctx context.Context, diags *diag.Diagnostics)
 	return config
 }
 
+// hostsManaged reports whether the hosts blocks are the complete list of the
+// router's static hosts. It defaults to true when manage_hosts is not known.
+func (m *DNSServerModel) hostsManaged() bool {
+	return m.ManageHosts.IsNull() || m.ManageHosts.IsUnknown() || m.ManageHosts.ValueBool()
+}
+
+// serverSelectManaged reports whether the server_select blocks are the complete
+// list of the router's server select entries. It defaults to true when
+// manage_server_select is not known.
+func (m *DNSServerModel) serverSelectManaged() bool {
+	return m.ManageServerSelect.IsNull() || m.ManageServerSelect.IsUnknown() || m.ManageServerSelect.ValueBool()
+}
+
 // FromClient updates the Terraform model from a client.DNSConfig.
 func (m *DNSServerModel) FromClient(ctx context.Context, config *client.DNSConfig, diags *diag.Diagnostics) {
 	m.ID = types.StringValue("dns")
@@ -170,8 +194,12 @@ func (m *DNSServerModel) FromClient(ctx context.Context, config *client.DNSConfi
 		m.NameServers = types.ListValueMust(types.StringType, []attr.Value{})
 	}
 
-	// Convert server_select, preserving previous state ordering when available
-	if len(config.ServerSelect) > 0 {
+	// Convert server_select, preserving previous state ordering when available.
+	// Selectors not managed here are not pulled into this resource.
+	if m.ManageServerSelect.IsNull() || m.ManageServerSelect.IsUnknown() {
+		m.ManageServerSelect = types.BoolValue(true)
+	}
+	if len(config.ServerSelect) > 0 && m.serverSelectManaged() {
 		orderedEntries := m.orderServerSelectEntries(ctx, config.ServerSelect, diags)
 		if diags.HasError() {
 			return
@@ -193,8 +221,12 @@ func (m *DNSServerModel) FromClient(ctx context.Context, config *client.DNSConfi
 		m.ServerSelect = types.ListValueMust(types.ObjectType{AttrTypes: DNSServerSelectAttrTypes()}, []attr.Value{})
 	}
 
-	// Convert hosts, preserving previous state ordering when available
-	if len(config.Hosts) > 0 {
+	// Convert hosts, preserving previous state ordering when available.
+	// Hosts not managed here are not pulled into this resource.
+	if m.ManageHosts.IsNull() || m.ManageHosts.IsUnknown() {
+		m.ManageHosts = types.BoolValue(true)
+	}
+	if len(config.Hosts) > 0 && m.hostsManaged() {
 		orderedHosts := m.orderHostEntries(ctx, config.Hosts, diags)
 		if diags.HasError() {
 			return
@@ -2,10 +2,13 @@ package dns_server
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/sh1/terraform-provider-rtx/internal/client"
@@ -591,3 +594,189 @@ func TestFromClient_Hosts_NullPreservation(t *testing.T) {
 		})
 	}
 }
+
+func TestManageHosts(t *testing.T) {
+	ctx := context.Background()
+	routerHosts := []client.DNSHost{{Type: "a", Name: "h.local", Address: "192.0.2.1"}}
+
+	t.Run("managed without hosts removes all", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := &DNSServerModel{
+			ServerSelect: types.ListNull(types.ObjectType{AttrTypes: DNSServerSelectAttrTypes()}),
+			Hosts:        types.SetNull(types.ObjectType{AttrTypes: DNSHostAttrTypes()}),
+			ManageHosts:  types.BoolValue(true),
+			NameServers:  types.ListNull(types.StringType),
+		}
+		config := m.ToClient(ctx, &diags)
+		if diags.HasError() {
+			t.Fatalf("ToClient returned errors: %v", diags.Errors())
+		}
+		if config.Hosts == nil || len(config.Hosts) != 0 {
+			t.Errorf("Hosts = %#v, want an empty non-nil list", config.Hosts)
+		}
+	})
+
+	t.Run("unmanaged leaves hosts alone", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := &DNSServerModel{
+			ServerSelect: types.ListNull(types.ObjectType{AttrTypes: DNSServerSelectAttrTypes()}),
+			Hosts:        makePriorHosts(t, "empty"),
+			ManageHosts:  types.BoolValue(false),
+			NameServers:  types.ListNull(types.StringType),
+		}
+		if config := m.ToClient(ctx, &diags); config.Hosts != nil {
+			t.Errorf("Hosts = %#v, want nil", config.Hosts)
+		}
+		m.FromClient(ctx, &client.DNSConfig{Hosts: routerHosts}, &diags)
+		if diags.HasError() {
+			t.Fatalf("FromClient returned errors: %v", diags.Errors())
+		}
+		if len(m.Hosts.Elements()) != 0 {
+			t.Errorf("len(Hosts.Elements()) = %d, want 0", len(m.Hosts.Elements()))
+		}
+	})
+
+	t.Run("defaults to managed on import", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := &DNSServerModel{
+			ServerSelect: types.ListNull(types.ObjectType{AttrTypes: DNSServerSelectAttrTypes()}),
+			Hosts:        types.SetNull(types.ObjectType{AttrTypes: DNSHostAttrTypes()}),
+			ManageHosts:  types.BoolNull(),
+			NameServers:  types.ListNull(types.StringType),
+		}
+		m.FromClient(ctx, &client.DNSConfig{Hosts: routerHosts}, &diags)
+		if diags.HasError() {
+			t.Fatalf("FromClient returned errors: %v", diags.Errors())
+		}
+		if !m.ManageHosts.ValueBool() {
+			t.Error("ManageHosts = false, want true")
+		}
+		if len(m.Hosts.Elements()) != 1 {
+			t.Errorf("len(Hosts.Elements()) = %d, want 1", len(m.Hosts.Elements()))
+		}
+	})
+}
+
+func TestManageServerSelect(t *testing.T) {
+	ctx := context.Background()
+	routerEntries := []client.DNSServerSelect{{ID: 10, RecordType: "a", QueryPattern: "example.com"}}
+
+	t.Run("managed without entries removes all", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := &DNSServerModel{
+			ServerSelect:       types.ListNull(types.ObjectType{AttrTypes: DNSServerSelectAttrTypes()}),
+			Hosts:              types.SetNull(types.ObjectType{AttrTypes: DNSHostAttrTypes()}),
+			ManageServerSelect: types.BoolValue(true),
+			NameServers:        types.ListNull(types.StringType),
+		}
+		config := m.ToClient(ctx, &diags)
+		if diags.HasError() {
+			t.Fatalf("ToClient returned errors: %v", diags.Errors())
+		}
+		if config.ServerSelect == nil || len(config.ServerSelect) != 0 {
+			t.Errorf("ServerSelect = %#v, want an empty non-nil list", config.ServerSelect)
+		}
+	})
+
+	t.Run("unmanaged leaves entries alone", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := &DNSServerModel{
+			ServerSelect:       makePriorServerSelect(t, "empty"),
+			Hosts:              types.SetNull(types.ObjectType{AttrTypes: DNSHostAttrTypes()}),
+			ManageServerSelect: types.BoolValue(false),
+			NameServers:        types.ListNull(types.StringType),
+		}
+		if config := m.ToClient(ctx, &diags); config.ServerSelect != nil {
+			t.Errorf("ServerSelect = %#v, want nil", config.ServerSelect)
+		}
+		m.FromClient(ctx, &client.DNSConfig{ServerSelect: routerEntries}, &diags)
+		if diags.HasError() {
+			t.Fatalf("FromClient returned errors: %v", diags.Errors())
+		}
+		if len(m.ServerSelect.Elements()) != 0 {
+			t.Errorf("len(ServerSelect.Elements()) = %d, want 0", len(m.ServerSelect.Elements()))
+		}
+	})
+
+	t.Run("defaults to managed on import", func(t *testing.T) {
+		var diags diag.Diagnostics
+		m := &DNSServerModel{
+			ServerSelect:       types.ListNull(types.ObjectType{AttrTypes: DNSServerSelectAttrTypes()}),
+			Hosts:              types.SetNull(types.ObjectType{AttrTypes: DNSHostAttrTypes()}),
+			ManageServerSelect: types.BoolNull(),
+			NameServers:        types.ListNull(types.StringType),
+		}
+		m.FromClient(ctx, &client.DNSConfig{ServerSelect: routerEntries}, &diags)
+		if diags.HasError() {
+			t.Fatalf("FromClient returned errors: %v", diags.Errors())
+		}
+		if !m.ManageServerSelect.ValueBool() {
+			t.Error("ManageServerSelect = false, want true")
+		}
+		if len(m.ServerSelect.Elements()) != 1 {
+			t.Errorf("len(ServerSelect.Elements()) = %d, want 1", len(m.ServerSelect.Elements()))
+		}
+	})
+}
+
+func TestUpgradeState_ServerSelectV0(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&DNSServerResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	cases := []struct {
+		name  string
+		state string
+		want  []client.DNSServerSelect
+	}{
+		{
+			name:  "address list",
+			state: `{"id":"dns","service_on":true,"server_select":[{"id":1,"servers":["8.8.8.8","8.8.4.4"],"record_type":"any","query_pattern":".google.com","restrict_pp":0}]}`,
+			want: []client.DNSServerSelect{{ID: 1, RecordType: "any", QueryPattern: ".google.com",
+				Servers: []client.DNSServer{{Address: "8.8.8.8"}, {Address: "8.8.4.4"}}}},
+		},
+		{
+			name:  "server objects",
+			state: `{"id":"dns","server_select":[{"id":2,"servers":[{"address":"1.1.1.1","edns":true}],"query_pattern":".example.com"}]}`,
+			want: []client.DNSServerSelect{{ID: 2, RecordType: "a", QueryPattern: ".example.com",
+				Servers: []client.DNSServer{{Address: "1.1.1.1", EDNS: true}}}},
+		},
+		{
+			name:  "current shape",
+			state: `{"id":"dns","server_select":[{"priority":3,"server":[{"address":"192.168.1.1","edns":false}],"record_type":"a","query_pattern":".internal","restrict_pp":1}]}`,
+			want: []client.DNSServerSelect{{ID: 3, RecordType: "a", QueryPattern: ".internal", RestrictPP: 1,
+				Servers: []client.DNSServer{{Address: "192.168.1.1"}}}},
+		},
+		{
+			name:  "no server_select",
+			state: `{"id":"dns","name_servers":["8.8.8.8"]}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := fwhelpers.UpgradeRawState([]byte(tc.state), schemaResp.Schema.Type().TerraformType(ctx), upgradeServerSelectV0)
+			if err != nil {
+				t.Fatalf("UpgradeRawState returned error: %v", err)
+			}
+
+			var m DNSServerModel
+			if diags := (tfsdk.State{Schema: schemaResp.Schema, Raw: raw}).Get(ctx, &m); diags.HasError() {
+				t.Fatalf("State.Get returned errors: %v", diags.Errors())
+			}
+			var diags diag.Diagnostics
+			got := m.ToClient(ctx, &diags).ServerSelect
+			if diags.HasError() {
+				t.Fatalf("ToClient returned errors: %v", diags.Errors())
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %d server_select entries, want %d", len(got), len(tc.want))
+			}
+			for i := range tc.want {
+				if !reflect.DeepEqual(got[i], tc.want[i]) {
+					t.Errorf("server_select[%d] = %+v, want %+v", i, got[i], tc.want[i])
+				}
+			}
+		})
+	}
+}
@@ -26,8 +26,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DNSServerResource{}
-	_ resource.ResourceWithImportState = &DNSServerResource{}
+	_ resource.Resource                 = &DNSServerResource{}
+	_ resource.ResourceWithImportState  = &DNSServerResource{}
+	_ resource.ResourceWithUpgradeState = &DNSServerResource{}
 )
 
 // NewDNSServerResource creates a new DNS server resource.
@@ -54,6 +55,7 @@ const DefaultPriorityStep = 10
 // Schema defines the schema for the DNS server resource.
 func (r *DNSServerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1, // Incremented for server_select schema change (id/servers -> priority/server blocks)
 		Description: "Manages DNS server configuration on RTX routers. This is a singleton resource - there is only one DNS server configuration per router.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -100,10 +102,28 @@ func (r *DNSServerResource) Schema(ctx context.Context, req resource.SchemaReque
 					int64validator.Between(1, MaxPriorityValue),
 				},
 			},
+			"manage_hosts": schema.BoolAttribute{
+				Description: "Whether the hosts blocks are the complete list of static DNS hosts. When true (the default), hosts not listed are removed from the router, " +
+					"so a configuration without hosts blocks removes all of them. Set to false to leave static hosts untouched so they can be managed with rtx_dns_static_host; " +
+					"hosts blocks cannot be used then.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"manage_server_select": schema.BoolAttribute{
+				Description: "Whether the server_select blocks are the complete list of DNS server select entries. When true (the default), entries not listed are removed from the router, " +
+					"so a configuration without server_select blocks removes all of them. Set to false to leave the entries untouched so they can be managed with rtx_dns_server_select; " +
+					"server_select blocks cannot be used then.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"server_select": schema.ListNestedBlock{
-				Description: "Domain-based DNS server selection entries",
+				Description:        "Domain-based DNS server selection entries. Selectors on the router that are not listed are removed, unless manage_server_select is false.",
+				DeprecationMessage: "Use the rtx_dns_server_select resource to manage DNS server select entries individually.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"priority": schema.Int64Attribute{
@@ -169,7 +189,9 @@ func (r *DNSServerResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 			"hosts": schema.SetNestedBlock{
-				Description: "Static DNS host entries (dns static <type> <name> <value> [ttl=<ttl>]). Set semantics: order-independent so adding an entry does not shift indices of existing entries.",
+				Description: "Static DNS host entries (dns static <type> <name> <value> [ttl=<ttl>]). Set semantics: order-independent so adding an entry does not shift indices of existing entries. " +
+					"Hosts on the router that are not listed are removed, unless manage_hosts is false.",
+				DeprecationMessage: "Use the rtx_dns_static_host resource to manage static DNS hosts individually.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"type": schema.StringAttribute{
@@ -399,6 +421,17 @@ func (r *DNSServerResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	logger.Debug().Str("resource", "rtx_dns_server").Msg("Deleting (resetting) DNS server configuration")
 
+	// Reset leaves server select entries and hosts alone, so remove the ones managed here
+	if data.serverSelectManaged() {
+		r.deleteServerSelects(ctx, data.ServerSelect, &resp.Diagnostics)
+	}
+	if data.hostsManaged() {
+		r.deleteHosts(ctx, data.Hosts, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.ResetDNS(ctx); err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to reset DNS server configuration",
@@ -408,6 +441,61 @@ func (r *DNSServerResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+// deleteServerSelects removes the server select entries recorded in the given state list.
+func (r *DNSServerResource) deleteServerSelects(ctx context.Context, list types.List, diagnostics *diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return
+	}
+
+	var serverSelects []DNSServerSelectModel
+	diagnostics.Append(list.ElementsAs(ctx, &serverSelects, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	for _, sel := range serverSelects {
+		id := int(sel.Priority.ValueInt64())
+		if err := r.client.DeleteDNSServerSelect(ctx, id); err != nil {
+			diagnostics.AddError(
+				"Failed to delete DNS server select",
+				fmt.Sprintf("Could not delete DNS server select %d: %v", id, err),
+			)
+			return
+		}
+	}
+}
+
+// deleteHosts removes the static host groups recorded in the given state set.
+func (r *DNSServerResource) deleteHosts(ctx context.Context, set types.Set, diagnostics *diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return
+	}
+
+	var hosts []DNSHostModel
+	diagnostics.Append(set.ElementsAs(ctx, &hosts, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	deleted := make(map[string]bool)
+	for _, host := range hosts {
+		recordType := host.Type.ValueString()
+		name := host.Name.ValueString()
+		if deleted[recordType+" "+name] {
+			continue
+		}
+		deleted[recordType+" "+name] = true
+
+		if err := r.client.DeleteDNSStaticHost(ctx, recordType, name); err != nil {
+			diagnostics.AddError(
+				"Failed to delete DNS static host",
+				fmt.Sprintf("Could not delete DNS static host %s: %v", name, err),
+			)
+			return
+		}
+	}
+}
+
 // ImportState imports an existing resource into Terraform.
 func (r *DNSServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Only accept "dns" as valid import ID (singleton resource)
@@ -422,6 +510,49 @@ func (r *DNSServerResource) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// UpgradeState handles state upgrades from previous schema versions.
+func (r *DNSServerResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 -> 1: Convert server_select id and servers to priority and server blocks
+		0: fwhelpers.RawStateUpgrader(upgradeServerSelectV0),
+	}
+}
+
+// upgradeServerSelectV0 converts server_select entries of the earliest state
+// shape, which identified entries by id and listed servers as addresses or
+// address and edns objects, to priority and server blocks. Entries already
+// in the current shape are left alone.
+func upgradeServerSelectV0(state map[string]any) error {
+	for _, sel := range fwhelpers.StateObjects(state, "server_select") {
+		fwhelpers.RenameStateAttribute(sel, "id", "priority")
+
+		servers, ok := sel["servers"].([]any)
+		delete(sel, "servers")
+		edns, _ := sel["edns"].(bool)
+		delete(sel, "edns")
+		if !ok || sel["server"] != nil {
+			continue
+		}
+
+		blocks := make([]any, 0, len(servers))
+		for _, srv := range servers {
+			switch v := srv.(type) {
+			case string:
+				blocks = append(blocks, map[string]any{"address": v, "edns": edns})
+			case map[string]any:
+				if _, ok := v["edns"].(bool); !ok {
+					v["edns"] = edns
+				}
+				blocks = append(blocks, v)
+			default:
+				return fmt.Errorf("unexpected server_select server %v", srv)
+			}
+		}
+		sel["server"] = blocks
+	}
+	return nil
+}
+
 // convertParsedDNSConfig converts a parser DNSConfig to a client DNSConfig.
 func convertParsedDNSConfig(parsed *parsers.DNSConfig) *client.DNSConfig {
 	config := &client.DNSConfig{
@@ -476,6 +607,22 @@ func (r *DNSServerResource) validateConfig(ctx context.Context, data *DNSServerM
 		priorityStep = DefaultPriorityStep
 	}
 
+	if !data.hostsManaged() && !data.Hosts.IsUnknown() && len(data.Hosts.Elements()) > 0 {
+		diagnostics.AddError(
+			"Invalid configuration",
+			"hosts cannot be specified when manage_hosts is false. Manage the hosts with rtx_dns_static_host or set manage_hosts to true",
+		)
+		return
+	}
+
+	if !data.serverSelectManaged() && !data.ServerSelect.IsUnknown() && len(data.ServerSelect.Elements()) > 0 {
+		diagnostics.AddError(
+			"Invalid configuration",
+			"server_select cannot be specified when manage_server_select is false. Manage the entries with rtx_dns_server_select or set manage_server_select to true",
+		)
+		return
+	}
+
 	if data.ServerSelect.IsNull() || data.ServerSelect.IsUnknown() {
 		return
 	}
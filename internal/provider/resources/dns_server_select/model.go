@@ -0,0 +1,88 @@
+package dns_server_select
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// DNSServerSelectModel describes the resource data model.
+type DNSServerSelectModel struct {
+	SelectorID     types.Int64  `tfsdk:"selector_id"`
+	Server         types.List   `tfsdk:"server"`
+	RecordType     types.String `tfsdk:"record_type"`
+	QueryPattern   types.String `tfsdk:"query_pattern"`
+	OriginalSender types.String `tfsdk:"original_sender"`
+	RestrictPP     types.Int64  `tfsdk:"restrict_pp"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// DNSServerEntryModel represents a DNS server entry with EDNS setting.
+type DNSServerEntryModel struct {
+	Address types.String `tfsdk:"address"`
+	EDNS    types.Bool   `tfsdk:"edns"`
+}
+
+// DNSServerEntryAttrTypes returns the attribute types for DNSServerEntryModel.
+func DNSServerEntryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"address": types.StringType,
+		"edns":    types.BoolType,
+	}
+}
+
+// ToClient converts the Terraform model to a client.DNSServerSelect.
+func (m *DNSServerSelectModel) ToClient(ctx context.Context, diags *diag.Diagnostics) client.DNSServerSelect {
+	sel := client.DNSServerSelect{
+		ID:             fwhelpers.GetInt64Value(m.SelectorID),
+		RecordType:     fwhelpers.GetStringValueWithDefault(m.RecordType, "a"),
+		QueryPattern:   fwhelpers.GetStringValue(m.QueryPattern),
+		OriginalSender: fwhelpers.GetStringValue(m.OriginalSender),
+		RestrictPP:     fwhelpers.GetInt64Value(m.RestrictPP),
+		Servers:        []client.DNSServer{},
+	}
+
+	if !m.Server.IsNull() && !m.Server.IsUnknown() {
+		var servers []DNSServerEntryModel
+		diags.Append(m.Server.ElementsAs(ctx, &servers, false)...)
+		for _, srv := range servers {
+			sel.Servers = append(sel.Servers, client.DNSServer{
+				Address: fwhelpers.GetStringValue(srv.Address),
+				EDNS:    fwhelpers.GetBoolValue(srv.EDNS),
+			})
+		}
+	}
+
+	return sel
+}
+
+// FromClient updates the Terraform model from a client.DNSServerSelect.
+func (m *DNSServerSelectModel) FromClient(sel *client.DNSServerSelect, diags *diag.Diagnostics) {
+	m.SelectorID = types.Int64Value(int64(sel.ID))
+	m.RecordType = types.StringValue(sel.RecordType)
+	if sel.RecordType == "" {
+		m.RecordType = types.StringValue("a")
+	}
+	m.QueryPattern = types.StringValue(sel.QueryPattern)
+	m.OriginalSender = fwhelpers.StringValueOrNull(sel.OriginalSender)
+	m.RestrictPP = types.Int64Value(int64(sel.RestrictPP))
+
+	serverValues := make([]attr.Value, len(sel.Servers))
+	for i, srv := range sel.Servers {
+		obj, d := types.ObjectValue(DNSServerEntryAttrTypes(), map[string]attr.Value{
+			"address": types.StringValue(srv.Address),
+			"edns":    types.BoolValue(srv.EDNS),
+		})
+		diags.Append(d...)
+		serverValues[i] = obj
+	}
+	list, d := types.ListValue(types.ObjectType{AttrTypes: DNSServerEntryAttrTypes()}, serverValues)
+	diags.Append(d...)
+	m.Server = list
+}
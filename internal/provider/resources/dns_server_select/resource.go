@@ -0,0 +1,311 @@
+package dns_server_select
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &DNSServerSelectResource{}
+	_ resource.ResourceWithImportState = &DNSServerSelectResource{}
+)
+
+// NewDNSServerSelectResource creates a new DNS server select resource.
+func NewDNSServerSelectResource() resource.Resource {
+	return &DNSServerSelectResource{}
+}
+
+// DNSServerSelectResource defines the resource implementation.
+type DNSServerSelectResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *DNSServerSelectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_server_select"
+}
+
+// Schema defines the schema for the resource.
+func (r *DNSServerSelectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single domain-based DNS server selection entry (dns server select). " +
+			"Each selector is a separate resource so large split-DNS setups can be managed per rule. " +
+			"Global DNS settings remain in rtx_dns_server; set manage_server_select = false there so that it leaves these entries alone.",
+		Attributes: map[string]schema.Attribute{
+			"selector_id": schema.Int64Attribute{
+				Description: "Selector ID (1-65535). Lower numbers are evaluated first.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"record_type": schema.StringAttribute{
+				Description: "DNS record type to match: a, aaaa, ptr, mx, ns, cname, any. Defaults to 'a'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("a"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("a", "aaaa", "ptr", "mx", "ns", "cname", "any"),
+				},
+			},
+			"query_pattern": schema.StringAttribute{
+				Description: "Domain pattern to match (e.g., '.', '*.example.com', 'internal.net').",
+				Required:    true,
+			},
+			"original_sender": schema.StringAttribute{
+				Description: "Source IP/CIDR restriction for DNS queries.",
+				Optional:    true,
+			},
+			"restrict_pp": schema.Int64Attribute{
+				Description: "PP session restriction (0 = no restriction).",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"server": schema.ListNestedBlock{
+				Description: "DNS servers for this selector (1-2 servers with per-server EDNS settings).",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 2),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Description: "DNS server IP address (IPv4 or IPv6).",
+							Required:    true,
+						},
+						"edns": schema.BoolAttribute{
+							Description: "Enable EDNS (Extension mechanisms for DNS) for this server.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DNSServerSelectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *DNSServerSelectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSServerSelectModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, "Creating", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *DNSServerSelectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSServerSelectModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.SelectorID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the DNS server select entry from the router.
+func (r *DNSServerSelectResource) read(ctx context.Context, data *DNSServerSelectModel, diagnostics *diag.Diagnostics) {
+	id := int(data.SelectorID.ValueInt64())
+
+	ctx = logging.WithResource(ctx, "rtx_dns_server_select", strconv.Itoa(id))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_dns_server_select").Msgf("Reading DNS server select %d", id)
+
+	var sel *client.DNSServerSelect
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			if parsed := parsedConfig.ExtractDNSServer(); parsed != nil {
+				for _, entry := range parsed.ServerSelect {
+					if entry.ID != id {
+						continue
+					}
+					servers := make([]client.DNSServer, len(entry.Servers))
+					for i, srv := range entry.Servers {
+						servers[i] = client.DNSServer{Address: srv.Address, EDNS: srv.EDNS}
+					}
+					sel = &client.DNSServerSelect{
+						ID:             entry.ID,
+						Servers:        servers,
+						RecordType:     entry.RecordType,
+						QueryPattern:   entry.QueryPattern,
+						OriginalSender: entry.OriginalSender,
+						RestrictPP:     entry.RestrictPP,
+					}
+					logger.Debug().Str("resource", "rtx_dns_server_select").Msg("Found DNS server select in SFTP cache")
+					break
+				}
+			}
+		}
+		if sel == nil {
+			logger.Debug().Str("resource", "rtx_dns_server_select").Msg("DNS server select not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or entry not found in cache
+	if sel == nil {
+		var err error
+		sel, err = r.client.GetDNSServerSelect(ctx, id)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_dns_server_select").Msgf("DNS server select %d not found, removing from state", id)
+				data.SelectorID = types.Int64Null()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read DNS server select", fmt.Sprintf("Could not read DNS server select %d: %v", id, err))
+			return
+		}
+	}
+
+	data.FromClient(sel, diagnostics)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DNSServerSelectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DNSServerSelectModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, "Updating", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply writes the entry to the router and refreshes the model. The command
+// replaces any existing entry with the same ID, so create and update share it.
+func (r *DNSServerSelectResource) apply(ctx context.Context, data *DNSServerSelectModel, action string, diagnostics *diag.Diagnostics) {
+	sel := data.ToClient(ctx, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_dns_server_select", strconv.Itoa(sel.ID))
+	logging.FromContext(ctx).Debug().Str("resource", "rtx_dns_server_select").Msgf("%s DNS server select: %+v", action, sel)
+
+	if err := r.client.SetDNSServerSelect(ctx, sel); err != nil {
+		diagnostics.AddError(
+			"Failed to configure DNS server select",
+			fmt.Sprintf("Could not configure DNS server select %d: %v", sel.ID, err),
+		)
+		return
+	}
+
+	r.read(ctx, data, diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *DNSServerSelectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSServerSelectModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(data.SelectorID.ValueInt64())
+
+	ctx = logging.WithResource(ctx, "rtx_dns_server_select", strconv.Itoa(id))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_dns_server_select").Msgf("Deleting DNS server select %d", id)
+
+	if err := r.client.DeleteDNSServerSelect(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete DNS server select",
+			fmt.Sprintf("Could not delete DNS server select %d: %v", id, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *DNSServerSelectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	selectorID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "selector_id", 1, 65535)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("selector_id"), selectorID)...)
+}
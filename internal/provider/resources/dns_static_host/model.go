@@ -0,0 +1,36 @@
+package dns_static_host
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// DNSStaticHostModel describes the resource data model.
+type DNSStaticHostModel struct {
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Addresses types.List   `tfsdk:"addresses"`
+	TTL       types.Int64  `tfsdk:"ttl"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.DNSStaticHost.
+func (m *DNSStaticHostModel) ToClient() client.DNSStaticHost {
+	return client.DNSStaticHost{
+		Type:      fwhelpers.GetStringValueWithDefault(m.Type, "a"),
+		Name:      fwhelpers.GetStringValue(m.Name),
+		Addresses: fwhelpers.ListToStringSlice(m.Addresses),
+		TTL:       fwhelpers.GetInt64Value(m.TTL),
+	}
+}
+
+// FromClient updates the Terraform model from a client.DNSStaticHost.
+func (m *DNSStaticHostModel) FromClient(host *client.DNSStaticHost) {
+	m.Name = types.StringValue(host.Name)
+	m.Type = types.StringValue(host.Type)
+	m.Addresses = fwhelpers.StringSliceToList(host.Addresses)
+	m.TTL = types.Int64Value(int64(host.TTL))
+}
@@ -0,0 +1,294 @@
+package dns_static_host
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &DNSStaticHostResource{}
+	_ resource.ResourceWithImportState = &DNSStaticHostResource{}
+)
+
+// validStaticTypes lists the record types accepted by dns static.
+var validStaticTypes = []string{"a", "aaaa", "ptr", "mx", "ns", "cname"}
+
+// NewDNSStaticHostResource creates a new DNS static host resource.
+func NewDNSStaticHostResource() resource.Resource {
+	return &DNSStaticHostResource{}
+}
+
+// DNSStaticHostResource defines the resource implementation.
+type DNSStaticHostResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *DNSStaticHostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_static_host"
+}
+
+// Schema defines the schema for the resource.
+func (r *DNSStaticHostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the static DNS entries (dns static) for one record type and name. " +
+			"Each host is a separate resource so hosts can be managed with for_each without rewriting the whole DNS configuration. " +
+			"When rtx_dns_server is also used, set manage_hosts = false in it so that it leaves these hosts alone.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Hostname or domain name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "DNS record type: a, aaaa, ptr, mx, ns, cname. Defaults to 'a'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("a"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(validStaticTypes...),
+				},
+			},
+			"addresses": schema.ListAttribute{
+				Description: "IP addresses or target values. Each value becomes its own dns static entry, so a name can resolve to several addresses.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Description: "TTL in seconds (0 means use router default).",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DNSStaticHostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *DNSStaticHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSStaticHostModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, "Creating", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *DNSStaticHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSStaticHostModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.Name.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the static host from the router.
+func (r *DNSStaticHostResource) read(ctx context.Context, data *DNSStaticHostModel, diagnostics *diag.Diagnostics) {
+	recordType := fwhelpers.GetStringValueWithDefault(data.Type, "a")
+	name := data.Name.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_dns_static_host", name)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_dns_static_host").Msgf("Reading DNS static host %s %s", recordType, name)
+
+	var host *client.DNSStaticHost
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			if parsed := parsedConfig.ExtractDNSServer(); parsed != nil {
+				for _, h := range parsed.Hosts {
+					if h.Type != recordType || h.Name != name {
+						continue
+					}
+					if host == nil {
+						host = &client.DNSStaticHost{Type: h.Type, Name: h.Name, TTL: h.TTL}
+					}
+					host.Addresses = append(host.Addresses, h.Address)
+				}
+			}
+		}
+		if host != nil {
+			logger.Debug().Str("resource", "rtx_dns_static_host").Msg("Found DNS static host in SFTP cache")
+		} else {
+			logger.Debug().Str("resource", "rtx_dns_static_host").Msg("DNS static host not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or host not found in cache
+	if host == nil {
+		var err error
+		host, err = r.client.GetDNSStaticHost(ctx, recordType, name)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_dns_static_host").Msgf("DNS static host %s not found, removing from state", name)
+				data.Name = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read DNS static host", fmt.Sprintf("Could not read DNS static host %s: %v", name, err))
+			return
+		}
+	}
+
+	data.FromClient(host)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DNSStaticHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DNSStaticHostModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, "Updating", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply writes the host entries to the router and refreshes the model.
+func (r *DNSStaticHostResource) apply(ctx context.Context, data *DNSStaticHostModel, action string, diagnostics *diag.Diagnostics) {
+	host := data.ToClient()
+
+	ctx = logging.WithResource(ctx, "rtx_dns_static_host", host.Name)
+	logging.FromContext(ctx).Debug().Str("resource", "rtx_dns_static_host").Msgf("%s DNS static host: %+v", action, host)
+
+	if err := r.client.SetDNSStaticHost(ctx, host); err != nil {
+		diagnostics.AddError(
+			"Failed to configure DNS static host",
+			fmt.Sprintf("Could not configure DNS static host %s: %v", host.Name, err),
+		)
+		return
+	}
+
+	r.read(ctx, data, diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *DNSStaticHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSStaticHostModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordType := fwhelpers.GetStringValueWithDefault(data.Type, "a")
+	name := data.Name.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_dns_static_host", name)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_dns_static_host").Msgf("Deleting DNS static host %s %s", recordType, name)
+
+	if err := r.client.DeleteDNSStaticHost(ctx, recordType, name); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete DNS static host",
+			fmt.Sprintf("Could not delete DNS static host %s: %v", name, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *DNSStaticHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: type:name, or just name for an A record
+	recordType, name := "a", req.ID
+	if parts := strings.SplitN(req.ID, ":", 2); len(parts) == 2 {
+		recordType, name = parts[0], parts[1]
+	}
+
+	if !slices.Contains(validStaticTypes, recordType) || name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Invalid import ID format: %s, expected 'type:name' or 'name' (e.g., 'a:nas.home.local')", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), recordType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
@@ -0,0 +1,67 @@
+package exec
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+)
+
+// ExecModel describes the resource data model.
+type ExecModel struct {
+	ID              types.String `tfsdk:"id"`
+	Commands        types.List   `tfsdk:"commands"`
+	DestroyCommands types.List   `tfsdk:"destroy_commands"`
+	Triggers        types.Map    `tfsdk:"triggers"`
+	Guard           types.List   `tfsdk:"guard"`
+	Executed        types.Bool   `tfsdk:"executed"`
+	Output          types.String `tfsdk:"output"`
+	Timeouts        types.Object `tfsdk:"timeouts"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// GuardModel describes the idempotency guard nested block.
+type GuardModel struct {
+	Command     types.String `tfsdk:"command"`
+	SkipPattern types.String `tfsdk:"skip_pattern"`
+}
+
+// GuardAttrTypes returns the attribute types for GuardModel.
+func GuardAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"command":      types.StringType,
+		"skip_pattern": types.StringType,
+	}
+}
+
+// Skips reports whether the output of the guard command shows that the
+// commands already took effect.
+func (g *GuardModel) Skips(output string) (bool, error) {
+	pattern, err := regexp.Compile(g.SkipPattern.ValueString())
+	if err != nil {
+		return false, fmt.Errorf("invalid skip_pattern: %w", err)
+	}
+	return pattern.MatchString(output), nil
+}
+
+// FormatOutput joins the output of executed commands, each preceded by the
+// command as the router console shows it. Secrets in commands are redacted.
+func FormatOutput(results []client.CommandResult) string {
+	var sb strings.Builder
+	for i, result := range results {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("# " + logging.RedactCommand(result.Command))
+		if result.Output != "" {
+			sb.WriteString("\n" + result.Output)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,38 @@
+package exec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+func TestGuardModel_Skips(t *testing.T) {
+	guard := GuardModel{Command: types.StringValue("show status pp 1"), SkipPattern: types.StringValue(`(?m)^Disconnected`)}
+
+	skip, err := guard.Skips("PP[01]:\nDisconnected\n")
+	if err != nil || !skip {
+		t.Errorf("Skips() = %v, %v, want true, nil", skip, err)
+	}
+	skip, err = guard.Skips("PP[01]:\nConnected\n")
+	if err != nil || skip {
+		t.Errorf("Skips() = %v, %v, want false, nil", skip, err)
+	}
+
+	guard.SkipPattern = types.StringValue("(")
+	if _, err := guard.Skips(""); err == nil {
+		t.Errorf("Skips() with invalid pattern returned no error")
+	}
+}
+
+func TestFormatOutput(t *testing.T) {
+	got := FormatOutput([]client.CommandResult{
+		{Command: "disconnect pp 1"},
+		{Command: "clear nat descriptor dynamic 1000", Output: "Cleared 12 sessions"},
+	})
+	want := "# disconnect pp 1\n# clear nat descriptor dynamic 1000\nCleared 12 sessions"
+	if got != want {
+		t.Errorf("FormatOutput() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,322 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ExecResource{}
+	_ resource.ResourceWithValidateConfig = &ExecResource{}
+)
+
+// NewExecResource creates a new exec resource.
+func NewExecResource() resource.Resource {
+	return &ExecResource{}
+}
+
+// ExecResource defines the resource implementation.
+type ExecResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *ExecResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_exec"
+}
+
+// Schema defines the schema for the resource.
+func (r *ExecResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Executes operational commands on the router, such as 'disconnect pp 1' or 'clear nat descriptor dynamic 1000' " +
+			"during a cutover. The commands run once when the resource is created and again whenever it is replaced; " +
+			"destroy_commands run when it is destroyed. The router state is not read back, so the resource never shows drift. " +
+			"Do not use it for configuration commands: they are neither saved nor tracked.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (the time the resource was created, in RFC 3339 format).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"commands": schema.ListAttribute{
+				Description: "Commands executed in order on create. Execution stops at the first command the router rejects. Changing them runs them again.",
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"destroy_commands": schema.ListAttribute{
+				Description: "Commands executed in order on destroy, including the destroy of a replacement. Can be changed without running commands.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that replace the resource, and so run the commands again, when they change.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"executed": schema.BoolAttribute{
+				Description: "Whether the commands ran on create; false when the guard skipped them.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"output": schema.StringAttribute{
+				Description: "Output of the commands run on create, each preceded by '# <command>'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": fwhelpers.TimeoutsBlock(),
+			"guard": schema.ListNestedBlock{
+				Description: "Idempotency guard: a read-only command run before the commands on create. " +
+					"When its output matches skip_pattern, the commands already took effect and are skipped.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command": schema.StringAttribute{
+							Description: "Read-only command whose output is checked (e.g., 'show status pp 1'). Must start with 'show'.",
+							Required:    true,
+						},
+						"skip_pattern": schema.StringAttribute{
+							Description: "Regular expression (RE2 syntax) matched against the output of command. Use (?m) to anchor on lines.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig performs custom validation on the resource configuration.
+func (r *ExecResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ExecModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attribute, list := range map[string]types.List{"commands": data.Commands, "destroy_commands": data.DestroyCommands} {
+		for i, command := range fwhelpers.ListToStringSlice(list) {
+			if err := parsers.ValidateExecCommand(command); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root(attribute).AtListIndex(i), "Invalid Command", err.Error())
+			}
+		}
+	}
+
+	if data.Guard.IsNull() || data.Guard.IsUnknown() {
+		return
+	}
+	var guards []GuardModel
+	resp.Diagnostics.Append(data.Guard.ElementsAs(ctx, &guards, false)...)
+	for i, guard := range guards {
+		if !guard.Command.IsUnknown() && !guard.Command.IsNull() {
+			if err := parsers.ValidateReadOnlyCommand(guard.Command.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("guard").AtListIndex(i).AtName("command"), "Invalid Guard Command", err.Error())
+			}
+		}
+		if !guard.SkipPattern.IsUnknown() && !guard.SkipPattern.IsNull() {
+			if _, err := regexp.Compile(guard.SkipPattern.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("guard").AtListIndex(i).AtName("skip_pattern"), "Invalid Skip Pattern", err.Error())
+			}
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ExecResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create runs the commands, unless the guard shows they already took effect.
+func (r *ExecResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExecModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := fwhelpers.WithOperationTimeout(ctx, data.Timeouts, fwhelpers.TimeoutCreate, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(time.Now().UTC().Format(time.RFC3339Nano))
+
+	ctx = logging.WithResource(ctx, "rtx_exec", data.ID.ValueString())
+	logger := logging.FromContext(ctx)
+
+	data.Executed = types.BoolValue(false)
+	data.Output = types.StringValue("")
+
+	var guards []GuardModel
+	resp.Diagnostics.Append(data.Guard.ElementsAs(ctx, &guards, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, guard := range guards {
+		command := guard.Command.ValueString()
+		result, err := r.client.RunReadOnlyCommand(ctx, command)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to run guard command",
+				fmt.Sprintf("Could not run %q on the router: %v", command, err),
+			)
+			return
+		}
+		skip, err := guard.Skips(result.Output)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to evaluate guard", err.Error())
+			return
+		}
+		if skip {
+			logger.Info().Str("resource", "rtx_exec").Msgf("Skipping commands: output of %q matches the guard", command)
+			resp.Diagnostics.AddWarning(
+				"Commands Skipped",
+				fmt.Sprintf("The output of %q matches skip_pattern, so the commands were not executed.", command),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	commands := fwhelpers.ListToStringSlice(data.Commands)
+	logger.Debug().Str("resource", "rtx_exec").Msgf("Executing %d commands", len(commands))
+
+	results, err := r.client.ExecCommands(ctx, commands)
+	data.Output = types.StringValue(FormatOutput(results))
+	if err != nil {
+		// Keep the output of the commands that ran in the state Terraform
+		// marks as tainted, so it can be inspected before the retry
+		data.Executed = types.BoolValue(len(results) > 0)
+		resp.Diagnostics.AddError(
+			"Failed to execute commands",
+			fmt.Sprintf("Could not execute the commands: %v", err),
+		)
+		if len(results) > 0 {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
+		return
+	}
+	data.Executed = types.BoolValue(true)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps the state as is: the effect of operational commands cannot be
+// read back from the router.
+func (r *ExecResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExecModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update stores the changed destroy commands; every other change replaces the resource.
+func (r *ExecResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ExecModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete runs the destroy commands.
+func (r *ExecResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ExecModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commands := fwhelpers.ListToStringSlice(data.DestroyCommands)
+	if len(commands) == 0 {
+		return
+	}
+
+	ctx, cancel := fwhelpers.WithOperationTimeout(ctx, data.Timeouts, fwhelpers.TimeoutDelete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_exec", data.ID.ValueString())
+	logging.FromContext(ctx).Debug().Str("resource", "rtx_exec").Msgf("Executing %d destroy commands", len(commands))
+
+	if _, err := r.client.ExecCommands(ctx, commands); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to execute destroy commands",
+			fmt.Sprintf("Could not execute the destroy commands: %v", err),
+		)
+		return
+	}
+}
@@ -0,0 +1,88 @@
+package external_memory_backup
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// ExternalMemoryBackupModel describes the resource data model.
+type ExternalMemoryBackupModel struct {
+	ID             types.String `tfsdk:"id"`
+	AutoBackup     types.Bool   `tfsdk:"auto_backup"`
+	ConfigFilename types.String `tfsdk:"config_filename"`
+	Schedule       types.List   `tfsdk:"schedule"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ScheduleModel describes the scheduled copy nested block.
+type ScheduleModel struct {
+	ScheduleID  types.Int64  `tfsdk:"schedule_id"`
+	Time        types.String `tfsdk:"time"`
+	Destination types.String `tfsdk:"destination"`
+}
+
+// ScheduleAttrTypes returns the attribute types for ScheduleModel.
+func ScheduleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"schedule_id": types.Int64Type,
+		"time":        types.StringType,
+		"destination": types.StringType,
+	}
+}
+
+// ToClient converts the Terraform model to a client.ExternalMemoryBackup.
+func (m *ExternalMemoryBackupModel) ToClient(ctx context.Context) (client.ExternalMemoryBackup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	backup := client.ExternalMemoryBackup{
+		AutoBackup:     fwhelpers.GetBoolValue(m.AutoBackup),
+		ConfigFilename: fwhelpers.GetStringValue(m.ConfigFilename),
+	}
+
+	if !m.Schedule.IsNull() && !m.Schedule.IsUnknown() {
+		var schedules []ScheduleModel
+		diags.Append(m.Schedule.ElementsAs(ctx, &schedules, false)...)
+		if diags.HasError() {
+			return backup, diags
+		}
+		if len(schedules) > 0 {
+			backup.ScheduleID = fwhelpers.GetInt64Value(schedules[0].ScheduleID)
+			backup.ScheduleTime = fwhelpers.GetStringValue(schedules[0].Time)
+			backup.Destination = fwhelpers.GetStringValue(schedules[0].Destination)
+		}
+	}
+
+	return backup, diags
+}
+
+// FromClient updates the Terraform model from a client.ExternalMemoryBackup.
+func (m *ExternalMemoryBackupModel) FromClient(ctx context.Context, backup *client.ExternalMemoryBackup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue("external_memory_backup")
+	m.AutoBackup = types.BoolValue(backup.AutoBackup)
+	m.ConfigFilename = fwhelpers.StringValueOrNull(backup.ConfigFilename)
+
+	if backup.ScheduleID > 0 {
+		scheduleObj, d := types.ObjectValue(ScheduleAttrTypes(), map[string]attr.Value{
+			"schedule_id": types.Int64Value(int64(backup.ScheduleID)),
+			"time":        types.StringValue(backup.ScheduleTime),
+			"destination": types.StringValue(backup.Destination),
+		})
+		diags.Append(d...)
+		list, d := types.ListValue(types.ObjectType{AttrTypes: ScheduleAttrTypes()}, []attr.Value{scheduleObj})
+		diags.Append(d...)
+		m.Schedule = list
+	} else {
+		m.Schedule = types.ListNull(types.ObjectType{AttrTypes: ScheduleAttrTypes()})
+	}
+
+	return diags
+}
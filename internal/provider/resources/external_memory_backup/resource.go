@@ -0,0 +1,345 @@
+package external_memory_backup
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ExternalMemoryBackupResource{}
+	_ resource.ResourceWithImportState    = &ExternalMemoryBackupResource{}
+	_ resource.ResourceWithValidateConfig = &ExternalMemoryBackupResource{}
+)
+
+var (
+	externalMemoryPathPattern = regexp.MustCompile(`^(usb|sd)\d+:/\S+$`)
+	timePattern               = regexp.MustCompile(`^([01]?\d|2[0-3]):[0-5]\d$`)
+)
+
+const externalMemoryPathMessage = "must be an external memory path such as 'usb1:/config.txt' or 'sd1:/config.txt'"
+
+// NewExternalMemoryBackupResource creates a new external memory backup resource.
+func NewExternalMemoryBackupResource() resource.Resource {
+	return &ExternalMemoryBackupResource{}
+}
+
+// ExternalMemoryBackupResource defines the resource implementation.
+type ExternalMemoryBackupResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *ExternalMemoryBackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_memory_backup"
+}
+
+// Schema defines the schema for the resource.
+func (r *ExternalMemoryBackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages on-device configuration backups to external memory (USB or microSD) on RTX routers: " +
+			"automatic backup on save and an optional daily 'copy config' schedule. " +
+			"This is a singleton resource - only one instance can exist per router.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (always 'external_memory_backup' for this singleton resource).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_backup": schema.BoolAttribute{
+				Description: "Copy the configuration to config_filename every time it is saved. Requires config_filename.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"config_filename": schema.StringAttribute{
+				Description: "Backup file on external memory (e.g., 'usb1:/config.txt').",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(externalMemoryPathPattern, externalMemoryPathMessage),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"schedule": schema.ListNestedBlock{
+				Description: "Daily 'copy config' job implemented with 'schedule at'. " +
+					"The schedule ID must not be used by an rtx_kron_schedule resource.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"schedule_id": schema.Int64Attribute{
+							Description: "Schedule ID (1-65535).",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"time": schema.StringAttribute{
+							Description: "Time of day to run the copy in HH:MM format (24-hour).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(timePattern, "must be a time in HH:MM format"),
+							},
+						},
+						"destination": schema.StringAttribute{
+							Description: "Destination file on external memory (e.g., 'usb1:/backup.txt').",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(externalMemoryPathPattern, externalMemoryPathMessage),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig performs custom validation on the resource configuration.
+func (r *ExternalMemoryBackupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ExternalMemoryBackupModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AutoBackup.IsUnknown() || data.ConfigFilename.IsUnknown() {
+		return
+	}
+
+	if data.AutoBackup.ValueBool() && data.ConfigFilename.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("config_filename"),
+			"Missing Config Filename",
+			"config_filename is required when auto_backup is enabled",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ExternalMemoryBackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ExternalMemoryBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExternalMemoryBackupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_external_memory_backup", "external_memory_backup")
+	logger := logging.FromContext(ctx)
+
+	backup, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger.Debug().Str("resource", "rtx_external_memory_backup").Msgf("Creating external memory backup configuration: %+v", backup)
+
+	if err := r.client.ConfigureExternalMemoryBackup(ctx, backup); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create external memory backup configuration",
+			fmt.Sprintf("Could not create external memory backup configuration: %v", err),
+		)
+		return
+	}
+
+	// Set ID for singleton resource
+	data.ID = types.StringValue("external_memory_backup")
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ExternalMemoryBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExternalMemoryBackupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the resource was not found, remove from state
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the backup config from the router.
+func (r *ExternalMemoryBackupResource) read(ctx context.Context, data *ExternalMemoryBackupModel, diagnostics *diag.Diagnostics) {
+	ctx = logging.WithResource(ctx, "rtx_external_memory_backup", "external_memory_backup")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_external_memory_backup").Msg("Reading external memory backup configuration")
+
+	var backup *client.ExternalMemoryBackup
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			if parsed := parsedConfig.ExtractExternalMemoryBackup(); parsed != nil {
+				backup = &client.ExternalMemoryBackup{
+					AutoBackup:     parsed.AutoBackup,
+					ConfigFilename: parsed.ConfigFilename,
+					ScheduleID:     parsed.ScheduleID,
+					ScheduleTime:   parsed.ScheduleTime,
+					Destination:    parsed.Destination,
+				}
+				logger.Debug().Str("resource", "rtx_external_memory_backup").Msg("Found external memory backup config in SFTP cache")
+			}
+		}
+		if backup == nil {
+			logger.Debug().Str("resource", "rtx_external_memory_backup").Msg("External memory backup config not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or config not found in cache
+	if backup == nil {
+		var err error
+		backup, err = r.client.GetExternalMemoryBackup(ctx)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_external_memory_backup").Msg("External memory backup configuration not found, removing from state")
+				data.ID = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read external memory backup configuration", fmt.Sprintf("Could not read external memory backup configuration: %v", err))
+			return
+		}
+	}
+
+	diagnostics.Append(data.FromClient(ctx, backup)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ExternalMemoryBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ExternalMemoryBackupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_external_memory_backup", "external_memory_backup")
+	logger := logging.FromContext(ctx)
+
+	backup, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger.Debug().Str("resource", "rtx_external_memory_backup").Msgf("Updating external memory backup configuration: %+v", backup)
+
+	if err := r.client.UpdateExternalMemoryBackup(ctx, backup); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update external memory backup configuration",
+			fmt.Sprintf("Could not update external memory backup configuration: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ExternalMemoryBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ExternalMemoryBackupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_external_memory_backup", "external_memory_backup")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_external_memory_backup").Msg("Deleting external memory backup configuration")
+
+	if err := r.client.ResetExternalMemoryBackup(ctx); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete external memory backup configuration",
+			fmt.Sprintf("Could not delete external memory backup configuration: %v", err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *ExternalMemoryBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Accept "external_memory_backup" as the import ID (singleton resource)
+	if req.ID != "external_memory_backup" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID 'external_memory_backup', got %q", req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
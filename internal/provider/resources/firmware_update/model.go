@@ -0,0 +1,91 @@
+package firmware_update
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// FirmwareUpdateModel describes the resource data model.
+type FirmwareUpdateModel struct {
+	ID             types.String `tfsdk:"id"`
+	URL            types.String `tfsdk:"url"`
+	AllowUpdate    types.Bool   `tfsdk:"allow_update"`
+	AllowDowngrade types.Bool   `tfsdk:"allow_downgrade"`
+	Timeout        types.Int64  `tfsdk:"timeout"`
+	AutoUpdate     types.List   `tfsdk:"auto_update"`
+	Timeouts       types.Object `tfsdk:"timeouts"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// AutoUpdateModel describes the automatic update window nested block.
+type AutoUpdateModel struct {
+	ScheduleID types.Int64  `tfsdk:"schedule_id"`
+	Time       types.String `tfsdk:"time"`
+}
+
+// AutoUpdateAttrTypes returns the attribute types for AutoUpdateModel.
+func AutoUpdateAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"schedule_id": types.Int64Type,
+		"time":        types.StringType,
+	}
+}
+
+// ToClient converts the Terraform model to a client.FirmwareUpdate.
+func (m *FirmwareUpdateModel) ToClient(ctx context.Context) (client.FirmwareUpdate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	update := client.FirmwareUpdate{
+		URL:            fwhelpers.GetStringValue(m.URL),
+		AllowUpdate:    fwhelpers.GetBoolValue(m.AllowUpdate),
+		AllowDowngrade: fwhelpers.GetBoolValue(m.AllowDowngrade),
+		Timeout:        fwhelpers.GetInt64Value(m.Timeout),
+	}
+
+	if !m.AutoUpdate.IsNull() && !m.AutoUpdate.IsUnknown() {
+		var windows []AutoUpdateModel
+		diags.Append(m.AutoUpdate.ElementsAs(ctx, &windows, false)...)
+		if diags.HasError() {
+			return update, diags
+		}
+		if len(windows) > 0 {
+			update.ScheduleID = fwhelpers.GetInt64Value(windows[0].ScheduleID)
+			update.ScheduleTime = fwhelpers.GetStringValue(windows[0].Time)
+		}
+	}
+
+	return update, diags
+}
+
+// FromClient updates the Terraform model from a client.FirmwareUpdate.
+func (m *FirmwareUpdateModel) FromClient(ctx context.Context, update *client.FirmwareUpdate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue("firmware_update")
+	m.URL = fwhelpers.StringValueOrNull(update.URL)
+	m.AllowUpdate = types.BoolValue(update.AllowUpdate)
+	m.AllowDowngrade = types.BoolValue(update.AllowDowngrade)
+	m.Timeout = fwhelpers.Int64ValueOrNull(update.Timeout)
+
+	if update.ScheduleID > 0 {
+		windowObj, d := types.ObjectValue(AutoUpdateAttrTypes(), map[string]attr.Value{
+			"schedule_id": types.Int64Value(int64(update.ScheduleID)),
+			"time":        types.StringValue(update.ScheduleTime),
+		})
+		diags.Append(d...)
+		list, d := types.ListValue(types.ObjectType{AttrTypes: AutoUpdateAttrTypes()}, []attr.Value{windowObj})
+		diags.Append(d...)
+		m.AutoUpdate = list
+	} else {
+		m.AutoUpdate = types.ListNull(types.ObjectType{AttrTypes: AutoUpdateAttrTypes()})
+	}
+
+	return diags
+}
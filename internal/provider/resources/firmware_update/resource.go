@@ -0,0 +1,376 @@
+package firmware_update
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &FirmwareUpdateResource{}
+	_ resource.ResourceWithImportState    = &FirmwareUpdateResource{}
+	_ resource.ResourceWithValidateConfig = &FirmwareUpdateResource{}
+)
+
+var (
+	urlPattern  = regexp.MustCompile(`^https?://\S+$`)
+	timePattern = regexp.MustCompile(`^([01]?\d|2[0-3]):[0-5]\d$`)
+)
+
+// NewFirmwareUpdateResource creates a new firmware update resource.
+func NewFirmwareUpdateResource() resource.Resource {
+	return &FirmwareUpdateResource{}
+}
+
+// FirmwareUpdateResource defines the resource implementation.
+type FirmwareUpdateResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *FirmwareUpdateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firmware_update"
+}
+
+// Schema defines the schema for the resource.
+func (r *FirmwareUpdateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages 'http revision-up' firmware update settings on RTX routers: download URL, " +
+			"update/downgrade permissions and an optional daily automatic update window. " +
+			"This is a singleton resource - only one instance can exist per router.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (always 'firmware_update' for this singleton resource).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "URL of the firmware image. When omitted the router uses the vendor distribution site.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(urlPattern, "must be an http or https URL"),
+				},
+			},
+			"allow_update": schema.BoolAttribute{
+				Description: "Allow firmware updates over HTTP ('http revision-up permit').",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"allow_downgrade": schema.BoolAttribute{
+				Description: "Allow installing firmware older than the running revision ('http revision-down permit').",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "Download timeout in seconds (1-180). When omitted the firmware default is used.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 180),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": fwhelpers.TimeoutsBlock(),
+			"auto_update": schema.ListNestedBlock{
+				Description: "Daily automatic update window implemented with 'schedule at ... http revision-up go no-confirm'. " +
+					"The router reboots when a newer revision is installed. " +
+					"The schedule ID must not be used by an rtx_kron_schedule resource.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"schedule_id": schema.Int64Attribute{
+							Description: "Schedule ID (1-65535).",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"time": schema.StringAttribute{
+							Description: "Time of day at which the update check runs in HH:MM format (24-hour).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(timePattern, "must be a time in HH:MM format"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig performs custom validation on the resource configuration.
+func (r *FirmwareUpdateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FirmwareUpdateModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AllowUpdate.IsUnknown() || data.AutoUpdate.IsUnknown() {
+		return
+	}
+
+	if !data.AutoUpdate.IsNull() && len(data.AutoUpdate.Elements()) > 0 && !data.AllowUpdate.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allow_update"),
+			"Firmware Updates Not Allowed",
+			"allow_update must be true when an auto_update window is configured",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *FirmwareUpdateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *FirmwareUpdateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirmwareUpdateModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := fwhelpers.WithOperationTimeout(ctx, data.Timeouts, fwhelpers.TimeoutCreate, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_firmware_update", "firmware_update")
+	logger := logging.FromContext(ctx)
+
+	update, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger.Debug().Str("resource", "rtx_firmware_update").Msgf("Creating firmware update settings: %+v", update)
+
+	if err := r.client.ConfigureFirmwareUpdate(ctx, update); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create firmware update settings",
+			fmt.Sprintf("Could not create firmware update settings: %v", err),
+		)
+		return
+	}
+
+	// Set ID for singleton resource
+	data.ID = types.StringValue("firmware_update")
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *FirmwareUpdateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirmwareUpdateModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := fwhelpers.WithOperationTimeout(ctx, data.Timeouts, fwhelpers.TimeoutRead, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the resource was not found, remove from state
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the firmware update settings from the router.
+func (r *FirmwareUpdateResource) read(ctx context.Context, data *FirmwareUpdateModel, diagnostics *diag.Diagnostics) {
+	ctx = logging.WithResource(ctx, "rtx_firmware_update", "firmware_update")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_firmware_update").Msg("Reading firmware update settings")
+
+	var update *client.FirmwareUpdate
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			if parsed := parsedConfig.ExtractFirmwareUpdate(); parsed != nil {
+				update = &client.FirmwareUpdate{
+					URL:            parsed.URL,
+					AllowUpdate:    parsed.AllowUpdate,
+					AllowDowngrade: parsed.AllowDowngrade,
+					Timeout:        parsed.Timeout,
+					ScheduleID:     parsed.ScheduleID,
+					ScheduleTime:   parsed.ScheduleTime,
+				}
+				logger.Debug().Str("resource", "rtx_firmware_update").Msg("Found firmware update settings in SFTP cache")
+			}
+		}
+		if update == nil {
+			logger.Debug().Str("resource", "rtx_firmware_update").Msg("Firmware update settings not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or config not found in cache
+	if update == nil {
+		var err error
+		update, err = r.client.GetFirmwareUpdate(ctx)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_firmware_update").Msg("Firmware update settings not found, removing from state")
+				data.ID = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read firmware update settings", fmt.Sprintf("Could not read firmware update settings: %v", err))
+			return
+		}
+	}
+
+	diagnostics.Append(data.FromClient(ctx, update)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *FirmwareUpdateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FirmwareUpdateModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := fwhelpers.WithOperationTimeout(ctx, data.Timeouts, fwhelpers.TimeoutUpdate, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_firmware_update", "firmware_update")
+	logger := logging.FromContext(ctx)
+
+	update, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger.Debug().Str("resource", "rtx_firmware_update").Msgf("Updating firmware update settings: %+v", update)
+
+	if err := r.client.UpdateFirmwareUpdate(ctx, update); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update firmware update settings",
+			fmt.Sprintf("Could not update firmware update settings: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *FirmwareUpdateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FirmwareUpdateModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := fwhelpers.WithOperationTimeout(ctx, data.Timeouts, fwhelpers.TimeoutDelete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_firmware_update", "firmware_update")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_firmware_update").Msg("Deleting firmware update settings")
+
+	if err := r.client.ResetFirmwareUpdate(ctx); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete firmware update settings",
+			fmt.Sprintf("Could not delete firmware update settings: %v", err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *FirmwareUpdateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Accept "firmware_update" as the import ID (singleton resource)
+	if req.ID != "firmware_update" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID 'firmware_update', got %q", req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,60 @@
+package flow_export
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// FlowExportModel describes the resource data model.
+type FlowExportModel struct {
+	ID               types.String `tfsdk:"id"`
+	CollectorAddress types.String `tfsdk:"collector_address"`
+	CollectorPort    types.Int64  `tfsdk:"collector_port"`
+	SamplingRate     types.Int64  `tfsdk:"sampling_rate"`
+	Interfaces       types.Set    `tfsdk:"interfaces"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.FlowExportConfig.
+func (m *FlowExportModel) ToClient(ctx context.Context) (client.FlowExportConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := client.FlowExportConfig{
+		CollectorAddress: fwhelpers.GetStringValue(m.CollectorAddress),
+		CollectorPort:    fwhelpers.GetInt64Value(m.CollectorPort),
+		SamplingRate:     fwhelpers.GetInt64Value(m.SamplingRate),
+		Interfaces:       []string{},
+	}
+
+	if !m.Interfaces.IsNull() && !m.Interfaces.IsUnknown() {
+		diags.Append(m.Interfaces.ElementsAs(ctx, &config.Interfaces, false)...)
+	}
+
+	return config, diags
+}
+
+// FromClient updates the Terraform model from a client.FlowExportConfig.
+func (m *FlowExportModel) FromClient(ctx context.Context, config *client.FlowExportConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue("flow_export")
+	m.CollectorAddress = types.StringValue(config.CollectorAddress)
+	m.CollectorPort = types.Int64Value(int64(config.CollectorPort))
+	m.SamplingRate = types.Int64Value(int64(config.SamplingRate))
+
+	interfaces := config.Interfaces
+	if interfaces == nil {
+		interfaces = []string{}
+	}
+	set, setDiags := types.SetValueFrom(ctx, types.StringType, interfaces)
+	diags.Append(setDiags...)
+	m.Interfaces = set
+
+	return diags
+}
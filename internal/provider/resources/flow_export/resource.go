@@ -0,0 +1,339 @@
+package flow_export
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &FlowExportResource{}
+	_ resource.ResourceWithImportState = &FlowExportResource{}
+)
+
+var interfaceNamePattern = regexp.MustCompile(`^(lan|bridge|pp|tunnel)\d+$`)
+
+// NewFlowExportResource creates a new flow export resource.
+func NewFlowExportResource() resource.Resource {
+	return &FlowExportResource{}
+}
+
+// FlowExportResource defines the resource implementation.
+type FlowExportResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *FlowExportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flow_export"
+}
+
+// Schema defines the schema for the resource.
+func (r *FlowExportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages traffic flow export (external statistics) on RTX routers. Flow records for the selected " +
+			"interfaces are sent to a NetFlow/sFlow style collector. Requires firmware with flow export support. " +
+			"This is a singleton resource - only one instance can exist per router.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (always 'flow_export' for this singleton resource).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collector_address": schema.StringAttribute{
+				Description: "IP address of the flow collector.",
+				Required:    true,
+				Validators: []validator.String{
+					ipAddressValidator{},
+				},
+			},
+			"collector_port": schema.Int64Attribute{
+				Description: "UDP port of the flow collector. Defaults to 2055.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(parsers.DefaultFlowExportPort),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"sampling_rate": schema.Int64Attribute{
+				Description: "Export one out of every N packets. Defaults to 1 (no sampling).",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(parsers.DefaultFlowExportSamplingRate),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"interfaces": schema.SetAttribute{
+				Description: "Interfaces on which flow export is enabled (e.g., 'lan1', 'pp1').",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(
+							interfaceNamePattern,
+							"must be a valid interface name (e.g., 'lan1', 'lan2', 'bridge1', 'pp1', 'tunnel1')",
+						),
+					),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *FlowExportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *FlowExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FlowExportModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_flow_export", "flow_export")
+	logger := logging.FromContext(ctx)
+
+	config, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger.Debug().Str("resource", "rtx_flow_export").Msgf("Creating flow export configuration: %+v", config)
+
+	if err := r.client.ConfigureFlowExport(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create flow export configuration",
+			fmt.Sprintf("Could not create flow export configuration: %v", err),
+		)
+		return
+	}
+
+	// Set ID for singleton resource
+	data.ID = types.StringValue("flow_export")
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *FlowExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FlowExportModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the resource was not found, remove from state
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the flow export config from the router.
+func (r *FlowExportResource) read(ctx context.Context, data *FlowExportModel, diagnostics *diag.Diagnostics) {
+	ctx = logging.WithResource(ctx, "rtx_flow_export", "flow_export")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_flow_export").Msg("Reading flow export configuration")
+
+	var config *client.FlowExportConfig
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			if parsed := parsedConfig.ExtractFlowExport(); parsed != nil {
+				config = &client.FlowExportConfig{
+					CollectorAddress: parsed.CollectorAddress,
+					CollectorPort:    parsed.CollectorPort,
+					SamplingRate:     parsed.SamplingRate,
+					Interfaces:       parsed.Interfaces,
+				}
+				logger.Debug().Str("resource", "rtx_flow_export").Msg("Found flow export config in SFTP cache")
+			}
+		}
+		if config == nil {
+			logger.Debug().Str("resource", "rtx_flow_export").Msg("Flow export config not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or config not found in cache
+	if config == nil {
+		var err error
+		config, err = r.client.GetFlowExport(ctx)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_flow_export").Msg("Flow export configuration not found, removing from state")
+				data.ID = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read flow export configuration", fmt.Sprintf("Could not read flow export configuration: %v", err))
+			return
+		}
+	}
+
+	diagnostics.Append(data.FromClient(ctx, config)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *FlowExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FlowExportModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_flow_export", "flow_export")
+	logger := logging.FromContext(ctx)
+
+	config, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger.Debug().Str("resource", "rtx_flow_export").Msgf("Updating flow export configuration: %+v", config)
+
+	if err := r.client.UpdateFlowExport(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update flow export configuration",
+			fmt.Sprintf("Could not update flow export configuration: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *FlowExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FlowExportModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_flow_export", "flow_export")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_flow_export").Msg("Deleting flow export configuration")
+
+	if err := r.client.ResetFlowExport(ctx); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete flow export configuration",
+			fmt.Sprintf("Could not delete flow export configuration: %v", err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *FlowExportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Accept "flow_export" as the import ID (singleton resource)
+	if req.ID != "flow_export" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID 'flow_export', got %q", req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ipAddressValidator validates IPv4 and IPv6 addresses.
+type ipAddressValidator struct{}
+
+func (v ipAddressValidator) Description(ctx context.Context) string {
+	return "must be a valid IPv4 or IPv6 address"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if net.ParseIP(value) == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("Value %q is not a valid IPv4 or IPv6 address.", value),
+		)
+	}
+}
@@ -12,6 +12,8 @@ type HTTPDModel struct {
 	ID          types.String `tfsdk:"id"`
 	Host        types.String `tfsdk:"host"`
 	ProxyAccess types.Bool   `tfsdk:"proxy_access"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.HTTPDConfig.
@@ -8,7 +8,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -73,6 +72,7 @@ func (r *HTTPDResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -270,6 +270,5 @@ func (r *HTTPDResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 // ImportState imports an existing resource into Terraform.
 func (r *HTTPDResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// For singleton resources, we ignore the import ID and use "httpd"
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	fwhelpers.ImportSingleton(ctx, "httpd", req, resp)
 }
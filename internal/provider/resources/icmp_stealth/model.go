@@ -0,0 +1,58 @@
+package icmp_stealth
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// ICMPStealthModel describes the resource data model.
+type ICMPStealthModel struct {
+	ID                  types.String `tfsdk:"id"`
+	StealthInterfaces   types.Set    `tfsdk:"stealth_interfaces"`
+	EchoReply           types.Bool   `tfsdk:"echo_reply"`
+	EchoReplyOnlyLinkUp types.Bool   `tfsdk:"echo_reply_only_link_up"`
+	Unreachable         types.Bool   `tfsdk:"unreachable"`
+	Redirect            types.Bool   `tfsdk:"redirect"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.ICMPStealthConfig.
+func (m *ICMPStealthModel) ToClient(ctx context.Context) (client.ICMPStealthConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config := client.ICMPStealthConfig{
+		EchoReply:           fwhelpers.GetBoolValueWithDefault(m.EchoReply, true),
+		EchoReplyOnlyLinkUp: fwhelpers.GetBoolValueWithDefault(m.EchoReplyOnlyLinkUp, false),
+		Unreachable:         fwhelpers.GetBoolValueWithDefault(m.Unreachable, true),
+		Redirect:            fwhelpers.GetBoolValueWithDefault(m.Redirect, true),
+	}
+
+	if !m.StealthInterfaces.IsNull() && !m.StealthInterfaces.IsUnknown() {
+		diags.Append(m.StealthInterfaces.ElementsAs(ctx, &config.StealthInterfaces, false)...)
+	}
+
+	return config, diags
+}
+
+// FromClient updates the Terraform model from a client.ICMPStealthConfig.
+func (m *ICMPStealthModel) FromClient(ctx context.Context, config *client.ICMPStealthConfig) diag.Diagnostics {
+	m.ID = types.StringValue("icmp_stealth")
+	m.EchoReply = types.BoolValue(config.EchoReply)
+	m.EchoReplyOnlyLinkUp = types.BoolValue(config.EchoReplyOnlyLinkUp)
+	m.Unreachable = types.BoolValue(config.Unreachable)
+	m.Redirect = types.BoolValue(config.Redirect)
+
+	interfaces := config.StealthInterfaces
+	if interfaces == nil {
+		interfaces = []string{}
+	}
+	stealth, diags := types.SetValueFrom(ctx, types.StringType, interfaces)
+	m.StealthInterfaces = stealth
+
+	return diags
+}
@@ -0,0 +1,265 @@
+package icmp_stealth
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ICMPStealthResource{}
+	_ resource.ResourceWithImportState = &ICMPStealthResource{}
+)
+
+var stealthInterfacePattern = regexp.MustCompile(`^(all|lan\d+(/\d+)?|pp\d+|tunnel\d+|bridge\d+)$`)
+
+// NewICMPStealthResource creates a new ICMP stealth resource.
+func NewICMPStealthResource() resource.Resource {
+	return &ICMPStealthResource{}
+}
+
+// ICMPStealthResource defines the resource implementation.
+type ICMPStealthResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *ICMPStealthResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_icmp_stealth"
+}
+
+// Schema defines the schema for the resource.
+func (r *ICMPStealthResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages ICMP hardening settings: stealth mode ('ip stealth'), which silently drops packets to closed ports " +
+			"instead of answering with TCP RST or ICMP unreachable, and whether echo replies, unreachable and redirect messages are sent " +
+			"('ip icmp ... send'). The ICMP send settings apply to all interfaces; use stealth_interfaces to hide the router on " +
+			"specific interfaces. This is a singleton resource. Deleting it restores the router defaults.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (always 'icmp_stealth' for this singleton resource).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stealth_interfaces": schema.SetAttribute{
+				Description: "Interfaces on which packets to closed ports are silently dropped ('ip stealth'), e.g. 'pp1', 'lan2', 'tunnel1', " +
+					"or ['all'] for every interface. Defaults to none.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(stealthInterfacePattern, "must be 'all' or an interface name (e.g., 'pp1', 'lan2', 'tunnel1')"),
+					),
+				},
+			},
+			"echo_reply": schema.BoolAttribute{
+				Description: "Answer ICMP echo requests ('ip icmp echo-reply send'). Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"echo_reply_only_link_up": schema.BoolAttribute{
+				Description: "Answer echo requests for an interface address only while that interface is up ('ip icmp echo-reply send-only-linkup'). Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"unreachable": schema.BoolAttribute{
+				Description: "Send ICMP destination unreachable messages ('ip icmp unreachable send'). Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"redirect": schema.BoolAttribute{
+				Description: "Send ICMP redirect messages ('ip icmp redirect send'). Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ICMPStealthResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ICMPStealthResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ICMPStealthModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_icmp_stealth", "icmp_stealth")
+	logger := logging.FromContext(ctx)
+
+	config, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	logger.Debug().Str("resource", "rtx_icmp_stealth").Msgf("Creating ICMP settings: %+v", config)
+
+	if err := r.client.ConfigureICMPStealth(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to configure ICMP settings",
+			fmt.Sprintf("Could not configure ICMP settings: %v", err),
+		)
+		return
+	}
+
+	// Set the ID for singleton resource
+	data.ID = types.StringValue("icmp_stealth")
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ICMPStealthResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ICMPStealthModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the ICMP settings from the router.
+func (r *ICMPStealthResource) read(ctx context.Context, data *ICMPStealthModel, diagnostics *diag.Diagnostics) {
+	ctx = logging.WithResource(ctx, "rtx_icmp_stealth", "icmp_stealth")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_icmp_stealth").Msg("Reading ICMP settings")
+
+	var config *client.ICMPStealthConfig
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			converted := client.ICMPStealthConfig(*parsedConfig.ExtractICMPStealth())
+			config = &converted
+			logger.Debug().Str("resource", "rtx_icmp_stealth").Msg("Found ICMP settings in SFTP cache")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or cache unavailable
+	if config == nil {
+		var err error
+		config, err = r.client.GetICMPStealth(ctx)
+		if err != nil {
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read ICMP settings", fmt.Sprintf("Could not read ICMP settings: %v", err))
+			return
+		}
+	}
+
+	diagnostics.Append(data.FromClient(ctx, config)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ICMPStealthResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ICMPStealthModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_icmp_stealth", "icmp_stealth")
+	logger := logging.FromContext(ctx)
+
+	config, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	logger.Debug().Str("resource", "rtx_icmp_stealth").Msgf("Updating ICMP settings: %+v", config)
+
+	if err := r.client.UpdateICMPStealth(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update ICMP settings",
+			fmt.Sprintf("Could not update ICMP settings: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ICMPStealthResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithResource(ctx, "rtx_icmp_stealth", "icmp_stealth")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_icmp_stealth").Msg("Resetting ICMP settings")
+
+	if err := r.client.ResetICMPStealth(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to reset ICMP settings",
+			fmt.Sprintf("Could not reset ICMP settings: %v", err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *ICMPStealthResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fwhelpers.ImportSingleton(ctx, "icmp_stealth", req, resp)
+}
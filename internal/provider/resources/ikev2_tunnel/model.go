@@ -0,0 +1,115 @@
+package ikev2_tunnel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// defaultESPIntegrity is used when GCM is selected and the router reports no ESP hash.
+const defaultESPIntegrity = "sha256-hmac"
+
+// IKEv2TunnelModel describes the resource data model.
+type IKEv2TunnelModel struct {
+	TunnelID          types.Int64  `tfsdk:"tunnel_id"`
+	LocalAddress      types.String `tfsdk:"local_address"`
+	RemoteAddress     types.String `tfsdk:"remote_address"`
+	LocalID           types.String `tfsdk:"local_id"`
+	LocalIDType       types.String `tfsdk:"local_id_type"`
+	RemoteID          types.String `tfsdk:"remote_id"`
+	RemoteIDType      types.String `tfsdk:"remote_id_type"`
+	LocalAuth         types.String `tfsdk:"local_auth"`
+	RemoteAuth        types.String `tfsdk:"remote_auth"`
+	PreSharedKey      types.String `tfsdk:"pre_shared_key"`
+	CertificateID     types.Int64  `tfsdk:"certificate_id"`
+	EAPUsername       types.String `tfsdk:"eap_username"`
+	EAPPassword       types.String `tfsdk:"eap_password"`
+	IKEEncryption     types.List   `tfsdk:"ike_encryption"`
+	IKEIntegrity      types.List   `tfsdk:"ike_integrity"`
+	IKEGroups         types.List   `tfsdk:"ike_groups"`
+	ESPEncryption     types.String `tfsdk:"esp_encryption"`
+	ESPIntegrity      types.String `tfsdk:"esp_integrity"`
+	IKESALifetime     types.Int64  `tfsdk:"ike_sa_lifetime"`
+	ChildSALifetime   types.Int64  `tfsdk:"child_sa_lifetime"`
+	KeepaliveInterval types.Int64  `tfsdk:"keepalive_interval"`
+	KeepaliveRetry    types.Int64  `tfsdk:"keepalive_retry"`
+	NATTraversal      types.Bool   `tfsdk:"nat_traversal"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+
+	PreSharedKeyWO        types.String `tfsdk:"pre_shared_key_wo"`
+	PreSharedKeyWOVersion types.Int64  `tfsdk:"pre_shared_key_wo_version"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.IKEv2Tunnel.
+func (m *IKEv2TunnelModel) ToClient() client.IKEv2Tunnel {
+	return client.IKEv2Tunnel{
+		ID:                fwhelpers.GetInt64Value(m.TunnelID),
+		LocalAddress:      fwhelpers.GetStringValue(m.LocalAddress),
+		RemoteAddress:     fwhelpers.GetStringValue(m.RemoteAddress),
+		LocalName:         fwhelpers.GetStringValue(m.LocalID),
+		LocalNameType:     fwhelpers.GetStringValue(m.LocalIDType),
+		RemoteName:        fwhelpers.GetStringValue(m.RemoteID),
+		RemoteNameType:    fwhelpers.GetStringValue(m.RemoteIDType),
+		LocalAuthMethod:   fwhelpers.GetStringValue(m.LocalAuth),
+		RemoteAuthMethod:  fwhelpers.GetStringValue(m.RemoteAuth),
+		PreSharedKey:      fwhelpers.GetStringValue(m.PreSharedKey),
+		CertificateID:     fwhelpers.GetInt64Value(m.CertificateID),
+		EAPUsername:       fwhelpers.GetStringValue(m.EAPUsername),
+		EAPPassword:       fwhelpers.GetStringValue(m.EAPPassword),
+		Encryption:        fwhelpers.ListToStringSlice(m.IKEEncryption),
+		Integrity:         fwhelpers.ListToStringSlice(m.IKEIntegrity),
+		Groups:            fwhelpers.ListToStringSlice(m.IKEGroups),
+		ESPEncryption:     fwhelpers.GetStringValue(m.ESPEncryption),
+		ESPIntegrity:      fwhelpers.GetStringValue(m.ESPIntegrity),
+		IKESALifetime:     fwhelpers.GetInt64Value(m.IKESALifetime),
+		ChildSALifetime:   fwhelpers.GetInt64Value(m.ChildSALifetime),
+		KeepaliveInterval: fwhelpers.GetInt64Value(m.KeepaliveInterval),
+		KeepaliveRetry:    fwhelpers.GetInt64Value(m.KeepaliveRetry),
+		NATTraversal:      m.NATTraversal.ValueBool(),
+		Enabled:           m.Enabled.ValueBool(),
+	}
+}
+
+// FromClient updates the Terraform model from a client.IKEv2Tunnel.
+func (m *IKEv2TunnelModel) FromClient(tunnel *client.IKEv2Tunnel) {
+	m.TunnelID = types.Int64Value(int64(tunnel.ID))
+	m.LocalAddress = fwhelpers.StringValueOrNull(tunnel.LocalAddress)
+	m.RemoteAddress = types.StringValue(tunnel.RemoteAddress)
+	m.LocalID = fwhelpers.StringValueOrNull(tunnel.LocalName)
+	m.LocalIDType = fwhelpers.StringValueOrNull(tunnel.LocalNameType)
+	m.RemoteID = fwhelpers.StringValueOrNull(tunnel.RemoteName)
+	m.RemoteIDType = fwhelpers.StringValueOrNull(tunnel.RemoteNameType)
+	m.LocalAuth = types.StringValue(tunnel.LocalAuthMethod)
+	m.RemoteAuth = types.StringValue(tunnel.RemoteAuthMethod)
+	m.CertificateID = fwhelpers.Int64ValueOrNull(tunnel.CertificateID)
+	m.EAPUsername = fwhelpers.StringValueOrNull(tunnel.EAPUsername)
+	m.IKEEncryption = fwhelpers.StringSliceToList(tunnel.Encryption)
+	m.IKEIntegrity = fwhelpers.StringSliceToList(tunnel.Integrity)
+	m.IKEGroups = fwhelpers.StringSliceToList(tunnel.Groups)
+	m.ESPEncryption = types.StringValue(tunnel.ESPEncryption)
+	m.IKESALifetime = types.Int64Value(int64(tunnel.IKESALifetime))
+	m.ChildSALifetime = types.Int64Value(int64(tunnel.ChildSALifetime))
+	m.KeepaliveInterval = fwhelpers.Int64ValueOrNull(tunnel.KeepaliveInterval)
+	m.KeepaliveRetry = fwhelpers.Int64ValueOrNull(tunnel.KeepaliveRetry)
+	m.NATTraversal = types.BoolValue(tunnel.NATTraversal)
+	m.Enabled = types.BoolValue(tunnel.Enabled)
+
+	// GCM ciphers carry no ESP hash on the router, so keep the configured value
+	if tunnel.ESPIntegrity != "" {
+		m.ESPIntegrity = types.StringValue(tunnel.ESPIntegrity)
+	} else if m.ESPIntegrity.IsNull() || m.ESPIntegrity.IsUnknown() {
+		m.ESPIntegrity = types.StringValue(defaultESPIntegrity)
+	}
+
+	// Note: Secrets are write-only - keep the configured values and only
+	// populate them from the router when importing
+	if (m.PreSharedKey.IsNull() || m.PreSharedKey.IsUnknown()) && m.PreSharedKeyWOVersion.IsNull() {
+		m.PreSharedKey = fwhelpers.StringValueOrNull(tunnel.PreSharedKey)
+	}
+	if m.EAPPassword.IsNull() || m.EAPPassword.IsUnknown() {
+		m.EAPPassword = fwhelpers.StringValueOrNull(tunnel.EAPPassword)
+	}
+}
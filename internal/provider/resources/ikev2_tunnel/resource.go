@@ -0,0 +1,478 @@
+package ikev2_tunnel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &IKEv2TunnelResource{}
+	_ resource.ResourceWithImportState    = &IKEv2TunnelResource{}
+	_ resource.ResourceWithValidateConfig = &IKEv2TunnelResource{}
+)
+
+var credentialPattern = regexp.MustCompile(`^[^\s"]+$`)
+
+// NewIKEv2TunnelResource creates a new IKEv2 tunnel resource.
+func NewIKEv2TunnelResource() resource.Resource {
+	return &IKEv2TunnelResource{}
+}
+
+// IKEv2TunnelResource defines the resource implementation.
+type IKEv2TunnelResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *IKEv2TunnelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ikev2_tunnel"
+}
+
+// Schema defines the schema for the resource.
+func (r *IKEv2TunnelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an IPsec tunnel negotiated with IKEv2 (ipsec ike version N 2). " +
+			"IKEv2 uses its own authentication (pre-shared key, certificate or EAP) and proposal commands, " +
+			"so it is managed separately from the IKEv1 based rtx_ipsec_tunnel resource. " +
+			"The tunnel ID is also used as the IPsec tunnel, SA policy and IKE gateway ID.",
+		Attributes: map[string]schema.Attribute{
+			"tunnel_id": schema.Int64Attribute{
+				Description: "Tunnel ID (tunnel select N, 1-6000).",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 6000),
+				},
+			},
+			"local_address": schema.StringAttribute{
+				Description: "Local IKE endpoint address. If omitted, the router selects it from the outgoing interface.",
+				Optional:    true,
+			},
+			"remote_address": schema.StringAttribute{
+				Description: "Remote IKE endpoint: IP address, FQDN, or 'any' to accept connections from any peer.",
+				Required:    true,
+			},
+			"local_id": schema.StringAttribute{
+				Description: "Local IKE identity sent to the peer.",
+				Optional:    true,
+			},
+			"local_id_type": schema.StringAttribute{
+				Description: "Type of local_id: 'ipv4-addr', 'ipv6-addr', 'fqdn', 'rfc822-addr' or 'key-id'. Required with local_id.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidIKEv2IDTypes...),
+					stringvalidator.AlsoRequires(path.MatchRoot("local_id")),
+				},
+			},
+			"remote_id": schema.StringAttribute{
+				Description: "Expected IKE identity of the peer.",
+				Optional:    true,
+			},
+			"remote_id_type": schema.StringAttribute{
+				Description: "Type of remote_id: 'ipv4-addr', 'ipv6-addr', 'fqdn', 'rfc822-addr' or 'key-id'. Required with remote_id.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidIKEv2IDTypes...),
+					stringvalidator.AlsoRequires(path.MatchRoot("remote_id")),
+				},
+			},
+			"local_auth": schema.StringAttribute{
+				Description: "Method used to authenticate this router to the peer: 'psk', 'certificate' or 'eap-md5'.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidIKEv2AuthMethods...),
+				},
+			},
+			"remote_auth": schema.StringAttribute{
+				Description: "Method the peer must use to authenticate: 'psk', 'certificate' or 'eap-md5'. Defaults to 'psk'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("psk"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidIKEv2AuthMethods...),
+				},
+			},
+			"pre_shared_key": schema.StringAttribute{
+				Description: "Pre-shared key. Required when either side uses 'psk'. This value is write-only and is not read back from the router.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"pre_shared_key_wo":         fwhelpers.WriteOnlySecretAttribute("pre_shared_key"),
+			"pre_shared_key_wo_version": fwhelpers.WriteOnlyVersionAttribute("pre_shared_key"),
+			"certificate_id": schema.Int64Attribute{
+				Description: "Certificate slot used when local_auth is 'certificate' (see rtx_certificate.cert_id).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 99),
+				},
+			},
+			"eap_username": schema.StringAttribute{
+				Description: "EAP identity used when local_auth is 'eap-md5'.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(credentialPattern, "must not contain whitespace or quotes"),
+				},
+			},
+			"eap_password": schema.StringAttribute{
+				Description: "EAP password used when local_auth is 'eap-md5'. This value is write-only and is not read back from the router.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(credentialPattern, "must not contain whitespace or quotes"),
+				},
+			},
+			"ike_encryption": schema.ListAttribute{
+				Description: "IKE SA encryption proposals in order of preference. If omitted, the router defaults are offered.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(parsers.ValidIKEv2Encryptions...)),
+				},
+			},
+			"ike_integrity": schema.ListAttribute{
+				Description: "IKE SA integrity proposals in order of preference. If omitted, the router defaults are offered.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(parsers.ValidIKEv2Integrities...)),
+				},
+			},
+			"ike_groups": schema.ListAttribute{
+				Description: "IKE SA Diffie-Hellman group proposals in order of preference. If omitted, the router defaults are offered.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(parsers.ValidIKEv2Groups...)),
+				},
+			},
+			"esp_encryption": schema.StringAttribute{
+				Description: "Child SA (ESP) encryption. Defaults to 'aes-cbc-256'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("aes-cbc-256"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidESPEncryptions...),
+				},
+			},
+			"esp_integrity": schema.StringAttribute{
+				Description: "Child SA (ESP) integrity. Ignored for GCM ciphers, which provide their own integrity. Defaults to 'sha256-hmac'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultESPIntegrity),
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidESPIntegrities...),
+				},
+			},
+			"ike_sa_lifetime": schema.Int64Attribute{
+				Description: "IKE SA lifetime in seconds. Defaults to 28800.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(parsers.DefaultIKEv2IKESALifetime),
+				Validators: []validator.Int64{
+					int64validator.Between(60, 691200),
+				},
+			},
+			"child_sa_lifetime": schema.Int64Attribute{
+				Description: "Child SA lifetime in seconds. Defaults to 28800.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(parsers.DefaultIKEv2ChildSALifetime),
+				Validators: []validator.Int64{
+					int64validator.Between(60, 691200),
+				},
+			},
+			"keepalive_interval": schema.Int64Attribute{
+				Description: "Interval in seconds between IKEv2 liveness checks (RFC 4306). If omitted, keepalive is disabled.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 600),
+					int64validator.AlsoRequires(path.MatchRoot("keepalive_retry")),
+				},
+			},
+			"keepalive_retry": schema.Int64Attribute{
+				Description: "Number of failed liveness checks before the SA is torn down. Required with keepalive_interval.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+					int64validator.AlsoRequires(path.MatchRoot("keepalive_interval")),
+				},
+			},
+			"nat_traversal": schema.BoolAttribute{
+				Description: "Enable NAT traversal. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Enable the tunnel. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// ValidateConfig checks that the credentials required by the selected authentication methods are set.
+func (r *IKEv2TunnelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IKEv2TunnelModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.LocalAuth.IsUnknown() || data.RemoteAuth.IsUnknown() {
+		return
+	}
+
+	localAuth := data.LocalAuth.ValueString()
+	remoteAuth := data.RemoteAuth.ValueString()
+	if data.RemoteAuth.IsNull() {
+		remoteAuth = "psk"
+	}
+
+	if (localAuth == "psk" || remoteAuth == "psk") && data.PreSharedKey.IsNull() && data.PreSharedKeyWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pre_shared_key"),
+			"Missing Pre-Shared Key",
+			"pre_shared_key or pre_shared_key_wo is required when local_auth or remote_auth is 'psk'.",
+		)
+	}
+	if localAuth == "certificate" && data.CertificateID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate_id"),
+			"Missing Certificate",
+			"certificate_id is required when local_auth is 'certificate'.",
+		)
+	}
+	if localAuth == "eap-md5" && (data.EAPUsername.IsNull() || data.EAPPassword.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("eap_username"),
+			"Missing EAP Credentials",
+			"eap_username and eap_password are required when local_auth is 'eap-md5'.",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *IKEv2TunnelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *IKEv2TunnelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IKEv2TunnelModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunnelID := data.TunnelID.ValueInt64()
+	ctx = logging.WithResource(ctx, "rtx_ikev2_tunnel", strconv.FormatInt(tunnelID, 10))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_ikev2_tunnel").Msgf("Creating IKEv2 tunnel %d", tunnelID)
+
+	tunnel := data.ToClient()
+	tunnel.PreSharedKey = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("pre_shared_key_wo"), data.PreSharedKey, &resp.Diagnostics)
+	if err := r.client.CreateIKEv2Tunnel(ctx, tunnel); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create IKEv2 tunnel",
+			fmt.Sprintf("Could not create IKEv2 tunnel: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *IKEv2TunnelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IKEv2TunnelModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.TunnelID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the IKEv2 tunnel from the router.
+func (r *IKEv2TunnelResource) read(ctx context.Context, data *IKEv2TunnelModel, diagnostics *diag.Diagnostics) {
+	tunnelID := int(data.TunnelID.ValueInt64())
+
+	ctx = logging.WithResource(ctx, "rtx_ikev2_tunnel", strconv.Itoa(tunnelID))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_ikev2_tunnel").Msgf("Reading IKEv2 tunnel %d", tunnelID)
+
+	var tunnel *client.IKEv2Tunnel
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			for _, parsed := range parsedConfig.ExtractIKEv2Tunnels() {
+				if parsed.ID == tunnelID {
+					converted := client.IKEv2Tunnel(parsed)
+					tunnel = &converted
+					logger.Debug().Str("resource", "rtx_ikev2_tunnel").Msg("Found IKEv2 tunnel in SFTP cache")
+					break
+				}
+			}
+		}
+		if tunnel == nil {
+			logger.Debug().Str("resource", "rtx_ikev2_tunnel").Msg("IKEv2 tunnel not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or tunnel not found in cache
+	if tunnel == nil {
+		var err error
+		tunnel, err = r.client.GetIKEv2Tunnel(ctx, tunnelID)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_ikev2_tunnel").Msgf("IKEv2 tunnel %d not found, removing from state", tunnelID)
+				data.TunnelID = types.Int64Null()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read IKEv2 tunnel", fmt.Sprintf("Could not read IKEv2 tunnel %d: %v", tunnelID, err))
+			return
+		}
+	}
+
+	data.FromClient(tunnel)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *IKEv2TunnelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IKEv2TunnelModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunnelID := data.TunnelID.ValueInt64()
+	ctx = logging.WithResource(ctx, "rtx_ikev2_tunnel", strconv.FormatInt(tunnelID, 10))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_ikev2_tunnel").Msgf("Updating IKEv2 tunnel %d", tunnelID)
+
+	tunnel := data.ToClient()
+	tunnel.PreSharedKey = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("pre_shared_key_wo"), data.PreSharedKey, &resp.Diagnostics)
+	if err := r.client.UpdateIKEv2Tunnel(ctx, tunnel); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update IKEv2 tunnel",
+			fmt.Sprintf("Could not update IKEv2 tunnel: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *IKEv2TunnelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IKEv2TunnelModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunnelID := int(data.TunnelID.ValueInt64())
+
+	ctx = logging.WithResource(ctx, "rtx_ikev2_tunnel", strconv.Itoa(tunnelID))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_ikev2_tunnel").Msgf("Deleting IKEv2 tunnel %d", tunnelID)
+
+	if err := r.client.DeleteIKEv2Tunnel(ctx, tunnelID); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete IKEv2 tunnel",
+			fmt.Sprintf("Could not delete IKEv2 tunnel %d: %v", tunnelID, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *IKEv2TunnelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tunnelID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "tunnel_id", 1, 6000)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tunnel_id"), tunnelID)...)
+}
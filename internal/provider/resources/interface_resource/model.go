@@ -16,6 +16,8 @@ type InterfaceModel struct {
 	NATDescriptor types.Int64     `tfsdk:"nat_descriptor"`
 	ProxyARP      types.Bool      `tfsdk:"proxyarp"`
 	MTU           types.Int64     `tfsdk:"mtu"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // IPAddressModel describes the IP address nested block.
@@ -93,6 +93,7 @@ func (r *InterfaceResource) Schema(ctx context.Context, req resource.SchemaReque
 					int64validator.Between(0, 65535),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"ip_address": schema.SingleNestedBlock{
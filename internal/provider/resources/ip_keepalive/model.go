@@ -0,0 +1,48 @@
+package ip_keepalive
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// IPKeepaliveModel describes the resource data model.
+type IPKeepaliveModel struct {
+	KeepaliveID types.Int64  `tfsdk:"keepalive_id"`
+	Kind        types.String `tfsdk:"kind"`
+	Interval    types.Int64  `tfsdk:"interval"`
+	Count       types.Int64  `tfsdk:"count"`
+	Target      types.String `tfsdk:"target"`
+	Upwait      types.Int64  `tfsdk:"upwait"`
+	Downwait    types.Int64  `tfsdk:"downwait"`
+	Syslog      types.Bool   `tfsdk:"syslog"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.IPKeepalive.
+func (m *IPKeepaliveModel) ToClient() client.IPKeepalive {
+	return client.IPKeepalive{
+		ID:       fwhelpers.GetInt64Value(m.KeepaliveID),
+		Kind:     fwhelpers.GetStringValue(m.Kind),
+		Interval: fwhelpers.GetInt64Value(m.Interval),
+		Count:    fwhelpers.GetInt64Value(m.Count),
+		Target:   fwhelpers.GetStringValue(m.Target),
+		Upwait:   fwhelpers.GetInt64Value(m.Upwait),
+		Downwait: fwhelpers.GetInt64Value(m.Downwait),
+		Syslog:   fwhelpers.GetBoolValue(m.Syslog),
+	}
+}
+
+// FromClient updates the Terraform model from a client.IPKeepalive.
+func (m *IPKeepaliveModel) FromClient(keepalive *client.IPKeepalive) {
+	m.KeepaliveID = types.Int64Value(int64(keepalive.ID))
+	m.Kind = types.StringValue(keepalive.Kind)
+	m.Interval = types.Int64Value(int64(keepalive.Interval))
+	m.Count = types.Int64Value(int64(keepalive.Count))
+	m.Target = types.StringValue(keepalive.Target)
+	m.Upwait = fwhelpers.Int64ValueOrNull(keepalive.Upwait)
+	m.Downwait = fwhelpers.Int64ValueOrNull(keepalive.Downwait)
+	m.Syslog = types.BoolValue(keepalive.Syslog)
+}
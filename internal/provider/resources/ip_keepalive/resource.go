@@ -0,0 +1,346 @@
+package ip_keepalive
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &IPKeepaliveResource{}
+	_ resource.ResourceWithImportState = &IPKeepaliveResource{}
+)
+
+// NewIPKeepaliveResource creates a new IP keepalive resource.
+func NewIPKeepaliveResource() resource.Resource {
+	return &IPKeepaliveResource{}
+}
+
+// IPKeepaliveResource defines the resource implementation.
+type IPKeepaliveResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *IPKeepaliveResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_keepalive"
+}
+
+// Schema defines the schema for the resource.
+func (r *IPKeepaliveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an 'ip keepalive' reachability monitor on RTX routers. " +
+			"Routes can reference the monitor by its keepalive_id to withdraw a gateway when the target stops responding.",
+		Attributes: map[string]schema.Attribute{
+			"keepalive_id": schema.Int64Attribute{
+				Description: "Keepalive ID (1-6000, upper bound is model dependent). Referenced by routes.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 6000),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Description: "Probe kind. Currently only 'icmp-echo' is supported.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("icmp-echo"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidIPKeepaliveKinds...),
+				},
+			},
+			"interval": schema.Int64Attribute{
+				Description: "Probe interval in seconds (1-600).",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 600),
+				},
+			},
+			"count": schema.Int64Attribute{
+				Description: "Number of consecutive probe failures before the target is considered down (1-100).",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"target": schema.StringAttribute{
+				Description: "IPv4 address to probe.",
+				Required:    true,
+				Validators: []validator.String{
+					ipv4AddressValidator{},
+				},
+			},
+			"upwait": schema.Int64Attribute{
+				Description: "Seconds the target must keep responding before it is considered up again.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"downwait": schema.Int64Attribute{
+				Description: "Seconds the target must stay unresponsive before it is considered down.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"syslog": schema.BoolAttribute{
+				Description: "Log state transitions to syslog.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *IPKeepaliveResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *IPKeepaliveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IPKeepaliveModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_ip_keepalive", strconv.Itoa(fwhelpers.GetInt64Value(data.KeepaliveID)))
+	logger := logging.FromContext(ctx)
+
+	keepalive := data.ToClient()
+	logger.Debug().Str("resource", "rtx_ip_keepalive").Msgf("Creating IP keepalive: %+v", keepalive)
+
+	if err := r.client.CreateIPKeepalive(ctx, keepalive); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create IP keepalive",
+			fmt.Sprintf("Could not create IP keepalive: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *IPKeepaliveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IPKeepaliveModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.KeepaliveID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the keepalive from the router.
+func (r *IPKeepaliveResource) read(ctx context.Context, data *IPKeepaliveModel, diagnostics *diag.Diagnostics) {
+	id := fwhelpers.GetInt64Value(data.KeepaliveID)
+
+	ctx = logging.WithResource(ctx, "rtx_ip_keepalive", strconv.Itoa(id))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_ip_keepalive").Msgf("Reading IP keepalive: %d", id)
+
+	var keepalive *client.IPKeepalive
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			for _, parsed := range parsedConfig.ExtractIPKeepalives() {
+				if parsed.ID == id {
+					keepalive = convertParsedIPKeepalive(&parsed)
+					logger.Debug().Str("resource", "rtx_ip_keepalive").Msg("Found IP keepalive in SFTP cache")
+					break
+				}
+			}
+		}
+		if keepalive == nil {
+			logger.Debug().Str("resource", "rtx_ip_keepalive").Msg("IP keepalive not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or keepalive not found in cache
+	if keepalive == nil {
+		var err error
+		keepalive, err = r.client.GetIPKeepalive(ctx, id)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_ip_keepalive").Msgf("IP keepalive %d not found, removing from state", id)
+				data.KeepaliveID = types.Int64Null()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read IP keepalive", fmt.Sprintf("Could not read IP keepalive %d: %v", id, err))
+			return
+		}
+	}
+
+	data.FromClient(keepalive)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *IPKeepaliveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IPKeepaliveModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_ip_keepalive", strconv.Itoa(fwhelpers.GetInt64Value(data.KeepaliveID)))
+	logger := logging.FromContext(ctx)
+
+	keepalive := data.ToClient()
+	logger.Debug().Str("resource", "rtx_ip_keepalive").Msgf("Updating IP keepalive: %+v", keepalive)
+
+	if err := r.client.UpdateIPKeepalive(ctx, keepalive); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update IP keepalive",
+			fmt.Sprintf("Could not update IP keepalive: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *IPKeepaliveResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IPKeepaliveModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := fwhelpers.GetInt64Value(data.KeepaliveID)
+
+	ctx = logging.WithResource(ctx, "rtx_ip_keepalive", strconv.Itoa(id))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_ip_keepalive").Msgf("Deleting IP keepalive: %d", id)
+
+	if err := r.client.DeleteIPKeepalive(ctx, id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete IP keepalive",
+			fmt.Sprintf("Could not delete IP keepalive %d: %v", id, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *IPKeepaliveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	keepaliveID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "keepalive_id", 1, 6000)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("keepalive_id"), keepaliveID)...)
+}
+
+// convertParsedIPKeepalive converts a parser IPKeepalive to a client IPKeepalive.
+func convertParsedIPKeepalive(parsed *parsers.IPKeepalive) *client.IPKeepalive {
+	return &client.IPKeepalive{
+		ID:       parsed.ID,
+		Kind:     parsed.Kind,
+		Interval: parsed.Interval,
+		Count:    parsed.Count,
+		Target:   parsed.Target,
+		Upwait:   parsed.Upwait,
+		Downwait: parsed.Downwait,
+		Syslog:   parsed.Syslog,
+	}
+}
+
+// ipv4AddressValidator validates that a string is an IPv4 address.
+type ipv4AddressValidator struct{}
+
+func (v ipv4AddressValidator) Description(ctx context.Context) string {
+	return "must be a valid IPv4 address"
+}
+
+func (v ipv4AddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipv4AddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	ip := net.ParseIP(req.ConfigValue.ValueString())
+	if ip == nil || ip.To4() == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv4 Address",
+			fmt.Sprintf("%q is not a valid IPv4 address", req.ConfigValue.ValueString()),
+		)
+	}
+}
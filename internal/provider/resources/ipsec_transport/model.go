@@ -13,6 +13,8 @@ type IPsecTransportModel struct {
 	TunnelID    types.Int64  `tfsdk:"tunnel_id"`
 	Protocol    types.String `tfsdk:"protocol"`
 	Port        types.Int64  `tfsdk:"port"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.IPsecTransportConfig.
@@ -80,6 +80,7 @@ func (r *IPsecTransportResource) Schema(ctx context.Context, req resource.Schema
 					int64validator.Between(1, 65535),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -245,14 +246,10 @@ func (r *IPsecTransportResource) Delete(ctx context.Context, req resource.Delete
 
 // ImportState imports an existing resource into Terraform.
 func (r *IPsecTransportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	transportID, err := strconv.Atoi(req.ID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			fmt.Sprintf("Expected transport ID as integer, got: %s", req.ID),
-		)
+	transportID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "transport_id", 1, 6000)
+	if !ok {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("transport_id"), int64(transportID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("transport_id"), transportID)...)
 }
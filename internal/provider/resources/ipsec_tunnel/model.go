@@ -30,6 +30,11 @@ type IPsecTunnelModel struct {
 	TCPMSSLimit     types.String         `tfsdk:"tcp_mss_limit"`
 	IKEv2Proposal   *IKEv2ProposalModel  `tfsdk:"ikev2_proposal"`
 	IPsecTransform  *IPsecTransformModel `tfsdk:"ipsec_transform"`
+
+	PreSharedKeyWO        types.String `tfsdk:"pre_shared_key_wo"`
+	PreSharedKeyWOVersion types.Int64  `tfsdk:"pre_shared_key_wo_version"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // IKEv2ProposalModel describes the IKEv2 proposal nested block.
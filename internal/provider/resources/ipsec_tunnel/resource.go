@@ -97,6 +97,8 @@ func (r *IPsecTunnelResource) Schema(ctx context.Context, req resource.SchemaReq
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"pre_shared_key_wo":         fwhelpers.WriteOnlySecretAttribute("pre_shared_key"),
+			"pre_shared_key_wo_version": fwhelpers.WriteOnlyVersionAttribute("pre_shared_key"),
 			"local_network": schema.StringAttribute{
 				Description: "Local network in CIDR notation (e.g., '192.168.1.0/24').",
 				Optional:    true,
@@ -165,6 +167,7 @@ func (r *IPsecTunnelResource) Schema(ctx context.Context, req resource.SchemaReq
 				Description: "TCP MSS limit for this tunnel: 'auto' or a numeric value (ip tunnel tcp mss limit).",
 				Optional:    true,
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"ikev2_proposal": schema.SingleNestedBlock{
@@ -346,6 +349,8 @@ func (r *IPsecTunnelResource) Create(ctx context.Context, req resource.CreateReq
 
 	tunnel := data.ToClient()
 	logger.Debug().Str("resource", "rtx_ipsec_tunnel").Msgf("Creating IPsec tunnel: %+v", tunnel)
+	// Write-only secrets are never logged
+	tunnel.PreSharedKey = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("pre_shared_key_wo"), data.PreSharedKey, &resp.Diagnostics)
 
 	if err := r.client.CreateIPsecTunnel(ctx, tunnel); err != nil {
 		resp.Diagnostics.AddError(
@@ -430,6 +435,8 @@ func (r *IPsecTunnelResource) Update(ctx context.Context, req resource.UpdateReq
 
 	tunnel := data.ToClient()
 	logger.Debug().Str("resource", "rtx_ipsec_tunnel").Msgf("Updating IPsec tunnel: %+v", tunnel)
+	// Write-only secrets are never logged
+	tunnel.PreSharedKey = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("pre_shared_key_wo"), data.PreSharedKey, &resp.Diagnostics)
 
 	if err := r.client.UpdateIPsecTunnel(ctx, tunnel); err != nil {
 		resp.Diagnostics.AddError(
@@ -497,14 +504,10 @@ func (r *IPsecTunnelResource) Delete(ctx context.Context, req resource.DeleteReq
 
 // ImportState imports an existing resource into Terraform.
 func (r *IPsecTunnelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	tunnelID, err := strconv.Atoi(req.ID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			fmt.Sprintf("Expected tunnel ID as integer, got: %s", req.ID),
-		)
+	tunnelID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "tunnel_id", 1, 6000)
+	if !ok {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tunnel_id"), int64(tunnelID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tunnel_id"), tunnelID)...)
 }
@@ -0,0 +1,63 @@
+package ipv6_filter
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// IPv6FilterModel describes the resource data model.
+type IPv6FilterModel struct {
+	FilterID    types.Int64  `tfsdk:"filter_id"`
+	Action      types.String `tfsdk:"action"`
+	Source      types.String `tfsdk:"source"`
+	Destination types.String `tfsdk:"destination"`
+	Protocol    types.String `tfsdk:"protocol"`
+	SourcePort  types.String `tfsdk:"source_port"`
+	DestPort    types.String `tfsdk:"dest_port"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.IPFilter.
+func (m *IPv6FilterModel) ToClient() client.IPFilter {
+	filter := client.IPFilter{
+		Number:        fwhelpers.GetInt64Value(m.FilterID),
+		Action:        strings.ToLower(fwhelpers.GetStringValue(m.Action)),
+		SourceAddress: fwhelpers.GetStringValue(m.Source),
+		DestAddress:   fwhelpers.GetStringValue(m.Destination),
+		Protocol:      strings.ToLower(fwhelpers.GetStringValue(m.Protocol)),
+	}
+
+	// Ports are only emitted when at least one side is restricted; "* *" is the router default
+	sourcePort := fwhelpers.GetStringValue(m.SourcePort)
+	destPort := fwhelpers.GetStringValue(m.DestPort)
+	if sourcePort != "*" || destPort != "*" {
+		filter.SourcePort = sourcePort
+		filter.DestPort = destPort
+	}
+
+	return filter
+}
+
+// FromClient updates the Terraform model from a client.IPFilter.
+func (m *IPv6FilterModel) FromClient(filter *client.IPFilter) {
+	m.FilterID = types.Int64Value(int64(filter.Number))
+	m.Action = types.StringValue(filter.Action)
+	m.Source = types.StringValue(filter.SourceAddress)
+	m.Destination = types.StringValue(filter.DestAddress)
+	m.Protocol = types.StringValue(filter.Protocol)
+	m.SourcePort = types.StringValue(normalizePort(filter.SourcePort))
+	m.DestPort = types.StringValue(normalizePort(filter.DestPort))
+}
+
+// normalizePort maps an omitted port to the "*" wildcard.
+func normalizePort(port string) string {
+	if port == "" {
+		return "*"
+	}
+	return port
+}
@@ -0,0 +1,349 @@
+package ipv6_filter
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &IPv6FilterResource{}
+	_ resource.ResourceWithImportState    = &IPv6FilterResource{}
+	_ resource.ResourceWithValidateConfig = &IPv6FilterResource{}
+)
+
+// NewIPv6FilterResource creates a new IPv6 filter resource.
+func NewIPv6FilterResource() resource.Resource {
+	return &IPv6FilterResource{}
+}
+
+// IPv6FilterResource defines the resource implementation.
+type IPv6FilterResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *IPv6FilterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipv6_filter"
+}
+
+// Schema defines the schema for the resource.
+func (r *IPv6FilterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single static IPv6 filter rule ('ipv6 filter') on RTX routers. " +
+			"Bind the filter to an interface with rtx_access_list_ipv6_apply.",
+		Attributes: map[string]schema.Attribute{
+			"filter_id": schema.Int64Attribute{
+				Description: "Filter number (1-65535).",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "Filter action: pass, pass-log, pass-nolog, reject, reject-log, reject-nolog, restrict, restrict-log, or restrict-nolog.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(parsers.ValidIPFilterActions...),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "Source IPv6 address, prefix (e.g., '2001:db8::/32'), range, or '*' for any.",
+				Required:    true,
+			},
+			"destination": schema.StringAttribute{
+				Description: "Destination IPv6 address, prefix (e.g., '2001:db8::1/128'), range, or '*' for any.",
+				Required:    true,
+			},
+			"protocol": schema.StringAttribute{
+				Description: "Protocol: tcp, udp, icmp6, ip, gre, esp, ah, tcpfin, tcprst, tcpsyn, established, or * for any. Comma-separated combinations such as 'tcp,udp' are accepted.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("*"),
+			},
+			"source_port": schema.StringAttribute{
+				Description: "Source port number, range (e.g., '1024-65535'), or '*' for any. Only valid for TCP/UDP.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("*"),
+			},
+			"dest_port": schema.StringAttribute{
+				Description: "Destination port number, range, or '*' for any. Only valid for TCP/UDP.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("*"),
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// ValidateConfig performs custom validation on the resource configuration.
+func (r *IPv6FilterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IPv6FilterModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attrName, value := range map[string]types.String{"source": data.Source, "destination": data.Destination} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if err := parsers.ValidateIPv6FilterAddress(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attrName), "Invalid IPv6 Address", err.Error())
+		}
+	}
+
+	if !data.Protocol.IsNull() && !data.Protocol.IsUnknown() {
+		if err := parsers.ValidateIPFilterProtocol(data.Protocol.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("protocol"), "Invalid Protocol", err.Error())
+			return
+		}
+	}
+
+	if data.Protocol.IsUnknown() || data.SourcePort.IsUnknown() || data.DestPort.IsUnknown() {
+		return
+	}
+
+	hasPorts := (!data.SourcePort.IsNull() && data.SourcePort.ValueString() != "*") ||
+		(!data.DestPort.IsNull() && data.DestPort.ValueString() != "*")
+	if hasPorts {
+		for _, proto := range strings.Split(strings.ToLower(data.Protocol.ValueString()), ",") {
+			if proto != "tcp" && proto != "udp" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("protocol"),
+					"Invalid Attribute Combination",
+					"'source_port' and 'dest_port' can only be set when protocol is tcp or udp",
+				)
+				return
+			}
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *IPv6FilterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *IPv6FilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IPv6FilterModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filterID := strconv.Itoa(fwhelpers.GetInt64Value(data.FilterID))
+	ctx = logging.WithResource(ctx, "rtx_ipv6_filter", filterID)
+	logger := logging.FromContext(ctx)
+
+	filter := data.ToClient()
+	logger.Debug().Str("resource", "rtx_ipv6_filter").Msgf("Creating IPv6 filter: %+v", filter)
+
+	if err := r.client.CreateIPv6Filter(ctx, filter); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create IPv6 filter",
+			fmt.Sprintf("Could not create IPv6 filter: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *IPv6FilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IPv6FilterModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.FilterID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the filter from the router.
+func (r *IPv6FilterResource) read(ctx context.Context, data *IPv6FilterModel, diagnostics *diag.Diagnostics) {
+	filterID := fwhelpers.GetInt64Value(data.FilterID)
+
+	ctx = logging.WithResource(ctx, "rtx_ipv6_filter", strconv.Itoa(filterID))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_ipv6_filter").Msgf("Reading IPv6 filter: %d", filterID)
+
+	var filter *client.IPFilter
+	var err error
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, cacheErr := r.client.GetCachedConfig(ctx)
+		if cacheErr == nil && parsedConfig != nil {
+			filters := parsedConfig.ExtractAccessListIPv6()
+			for i := range filters {
+				if filters[i].Number == filterID {
+					filter = convertParsedIPv6Filter(&filters[i])
+					logger.Debug().Str("resource", "rtx_ipv6_filter").Msg("Found IPv6 filter in SFTP cache")
+					break
+				}
+			}
+		}
+		if filter == nil {
+			logger.Debug().Str("resource", "rtx_ipv6_filter").Msg("IPv6 filter not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or filter not found in cache
+	if filter == nil {
+		filter, err = r.client.GetIPv6Filter(ctx, filterID)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_ipv6_filter").Msgf("IPv6 filter %d not found, removing from state", filterID)
+				data.FilterID = types.Int64Null()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read IPv6 filter", fmt.Sprintf("Could not read IPv6 filter %d: %v", filterID, err))
+			return
+		}
+	}
+
+	data.FromClient(filter)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *IPv6FilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IPv6FilterModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filterID := strconv.Itoa(fwhelpers.GetInt64Value(data.FilterID))
+	ctx = logging.WithResource(ctx, "rtx_ipv6_filter", filterID)
+	logger := logging.FromContext(ctx)
+
+	filter := data.ToClient()
+	logger.Debug().Str("resource", "rtx_ipv6_filter").Msgf("Updating IPv6 filter: %+v", filter)
+
+	if err := r.client.UpdateIPv6Filter(ctx, filter); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update IPv6 filter",
+			fmt.Sprintf("Could not update IPv6 filter: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *IPv6FilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IPv6FilterModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filterID := fwhelpers.GetInt64Value(data.FilterID)
+
+	ctx = logging.WithResource(ctx, "rtx_ipv6_filter", strconv.Itoa(filterID))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_ipv6_filter").Msgf("Deleting IPv6 filter: %d", filterID)
+
+	if err := r.client.DeleteIPv6Filter(ctx, filterID); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete IPv6 filter",
+			fmt.Sprintf("Could not delete IPv6 filter %d: %v", filterID, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *IPv6FilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	filterID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "filter_id", 1, 65535)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("filter_id"), filterID)...)
+}
+
+// convertParsedIPv6Filter converts a parser IPFilter to a client IPFilter.
+func convertParsedIPv6Filter(parsed *parsers.IPFilter) *client.IPFilter {
+	return &client.IPFilter{
+		Number:        parsed.Number,
+		Action:        parsed.Action,
+		SourceAddress: parsed.SourceAddress,
+		DestAddress:   parsed.DestAddress,
+		Protocol:      parsed.Protocol,
+		SourcePort:    parsed.SourcePort,
+		DestPort:      parsed.DestPort,
+	}
+}
@@ -17,6 +17,8 @@ type IPv6InterfaceModel struct {
 	RTADV         *RTADVModel        `tfsdk:"rtadv"`
 	DHCPv6Service types.String       `tfsdk:"dhcpv6_service"`
 	MTU           types.Int64        `tfsdk:"mtu"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // IPv6AddressModel describes an IPv6 address block.
@@ -90,11 +92,9 @@ func (m *IPv6InterfaceModel) FromClient(ctx context.Context, config *client.IPv6
 	}
 
 	// Convert RTADV
-	// Preserve the rtadv block structure if it was configured, even if not enabled
-	if config.RTADV != nil {
-		if m.RTADV == nil {
-			m.RTADV = &RTADVModel{}
-		}
+	// Only a configured rtadv block is refreshed; without it Router
+	// Advertisement is left to rtx_ipv6_rtadv
+	if config.RTADV != nil && m.RTADV != nil {
 		m.RTADV.Enabled = types.BoolValue(config.RTADV.Enabled)
 		// Only update PrefixID if router returned a non-zero value
 		// Router may not return this consistently, so preserve existing if zero
@@ -80,6 +80,7 @@ func (r *IPv6InterfaceResource) Schema(ctx context.Context, req resource.SchemaR
 					int64validator.Between(0, 65535),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"address": schema.ListNestedBlock{
@@ -102,7 +103,8 @@ func (r *IPv6InterfaceResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"rtadv": schema.SingleNestedBlock{
-				Description: "Router Advertisement (RTADV) configuration for this interface.",
+				Description: "Router Advertisement (RTADV) configuration for this interface. " +
+					"When omitted, Router Advertisement is not managed by this resource; use rtx_ipv6_rtadv for RA timing, MTU and DNS options.",
 				Attributes: map[string]schema.Attribute{
 					"enabled": schema.BoolAttribute{
 						Description: "Enable Router Advertisement on this interface.",
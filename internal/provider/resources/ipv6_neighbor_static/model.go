@@ -0,0 +1,44 @@
+package ipv6_neighbor_static
+
+import (
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// IPv6NeighborStaticModel describes the resource data model.
+type IPv6NeighborStaticModel struct {
+	Interface  types.String `tfsdk:"interface"`
+	Address    types.String `tfsdk:"address"`
+	MACAddress types.String `tfsdk:"mac_address"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.IPv6NeighborStatic.
+func (m *IPv6NeighborStaticModel) ToClient() client.IPv6NeighborStatic {
+	return client.IPv6NeighborStatic{
+		Interface:  fwhelpers.GetStringValue(m.Interface),
+		Address:    fwhelpers.GetStringValue(m.Address),
+		MACAddress: strings.ToLower(fwhelpers.GetStringValue(m.MACAddress)),
+	}
+}
+
+// FromClient updates the Terraform model from a client.IPv6NeighborStatic.
+// The configured spelling of the address and MAC address is kept when it
+// denotes the same value the router reports.
+func (m *IPv6NeighborStaticModel) FromClient(entry *client.IPv6NeighborStatic) {
+	m.Interface = types.StringValue(entry.Interface)
+
+	if current := net.ParseIP(m.Address.ValueString()); current == nil || !current.Equal(net.ParseIP(entry.Address)) {
+		m.Address = types.StringValue(entry.Address)
+	}
+
+	if !strings.EqualFold(m.MACAddress.ValueString(), entry.MACAddress) {
+		m.MACAddress = types.StringValue(entry.MACAddress)
+	}
+}
@@ -0,0 +1,38 @@
+package ipv6_neighbor_static
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+func TestIPv6NeighborStaticModel_FromClient(t *testing.T) {
+	entry := &client.IPv6NeighborStatic{Interface: "lan1", Address: "2001:db8::a", MACAddress: "00:a0:de:01:02:03"}
+
+	// Configured spelling is kept when it denotes the same values
+	m := IPv6NeighborStaticModel{
+		Interface:  types.StringValue("lan1"),
+		Address:    types.StringValue("2001:DB8::A"),
+		MACAddress: types.StringValue("00:A0:DE:01:02:03"),
+	}
+	m.FromClient(entry)
+	if m.Address.ValueString() != "2001:DB8::A" || m.MACAddress.ValueString() != "00:A0:DE:01:02:03" {
+		t.Errorf("configured spelling not kept: %s %s", m.Address.ValueString(), m.MACAddress.ValueString())
+	}
+
+	// A changed MAC address on the router is reported as drift
+	m.MACAddress = types.StringValue("00:a0:de:09:09:09")
+	m.FromClient(entry)
+	if m.MACAddress.ValueString() != "00:a0:de:01:02:03" {
+		t.Errorf("mac_address = %s, want router value", m.MACAddress.ValueString())
+	}
+
+	// Import populates the values from the router
+	imported := IPv6NeighborStaticModel{Interface: types.StringValue("lan1"), Address: types.StringValue("2001:db8::a"), MACAddress: types.StringNull()}
+	imported.FromClient(entry)
+	if imported.MACAddress.ValueString() != "00:a0:de:01:02:03" {
+		t.Errorf("mac_address = %s, want router value", imported.MACAddress.ValueString())
+	}
+}
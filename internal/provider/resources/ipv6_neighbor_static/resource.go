@@ -0,0 +1,286 @@
+package ipv6_neighbor_static
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/validation"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &IPv6NeighborStaticResource{}
+	_ resource.ResourceWithImportState = &IPv6NeighborStaticResource{}
+)
+
+var (
+	interfacePattern  = regexp.MustCompile(`^(lan|bridge)\d+$`)
+	macAddressPattern = regexp.MustCompile(`^[0-9A-Fa-f]{2}(:[0-9A-Fa-f]{2}){5}$`)
+)
+
+// NewIPv6NeighborStaticResource creates a new IPv6 neighbor static resource.
+func NewIPv6NeighborStaticResource() resource.Resource {
+	return &IPv6NeighborStaticResource{}
+}
+
+// IPv6NeighborStaticResource defines the resource implementation.
+type IPv6NeighborStaticResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *IPv6NeighborStaticResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipv6_neighbor_static"
+}
+
+// Schema defines the schema for the resource.
+func (r *IPv6NeighborStaticResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a static IPv6 neighbor cache entry ('ipv6 neighbor static'). " +
+			"Use it for hosts that need a fixed IPv6-to-MAC mapping instead of Neighbor Discovery.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description: "Interface the neighbor is attached to (e.g., 'lan1', 'bridge1').",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(interfacePattern, "must be a LAN or bridge interface (e.g., 'lan1', 'bridge1')"),
+				},
+			},
+			"address": schema.StringAttribute{
+				Description: "IPv6 address of the neighbor. Link-local addresses are allowed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validation.IPv6AddressValidator(),
+				},
+			},
+			"mac_address": schema.StringAttribute{
+				Description: "MAC address of the neighbor in colon-separated form (e.g., '00:a0:de:01:02:03').",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(macAddressPattern, "must be a colon-separated MAC address (e.g., '00:a0:de:01:02:03')"),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *IPv6NeighborStaticResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *IPv6NeighborStaticResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IPv6NeighborStaticModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_ipv6_neighbor_static", data.Address.ValueString())
+	logger := logging.FromContext(ctx)
+
+	entry := data.ToClient()
+	logger.Debug().Str("resource", "rtx_ipv6_neighbor_static").Msgf("Creating IPv6 neighbor static: %+v", entry)
+
+	if err := r.client.CreateIPv6NeighborStatic(ctx, entry); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create IPv6 neighbor static",
+			fmt.Sprintf("Could not create IPv6 neighbor static: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *IPv6NeighborStaticResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IPv6NeighborStaticModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.Address.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the IPv6 neighbor static from the router.
+func (r *IPv6NeighborStaticResource) read(ctx context.Context, data *IPv6NeighborStaticModel, diagnostics *diag.Diagnostics) {
+	iface := data.Interface.ValueString()
+	address := data.Address.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_ipv6_neighbor_static", address)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_ipv6_neighbor_static").Msgf("Reading IPv6 neighbor static %s on %s", address, iface)
+
+	var entry *client.IPv6NeighborStatic
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			want := net.ParseIP(address)
+			for _, parsed := range parsedConfig.ExtractIPv6NeighborStatics() {
+				if parsed.Interface == iface && want != nil && want.Equal(net.ParseIP(parsed.Address)) {
+					converted := client.IPv6NeighborStatic(parsed)
+					entry = &converted
+					logger.Debug().Str("resource", "rtx_ipv6_neighbor_static").Msg("Found IPv6 neighbor static in SFTP cache")
+					break
+				}
+			}
+		}
+		if entry == nil {
+			logger.Debug().Str("resource", "rtx_ipv6_neighbor_static").Msg("IPv6 neighbor static not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or entry not found in cache
+	if entry == nil {
+		var err error
+		entry, err = r.client.GetIPv6NeighborStatic(ctx, iface, address)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_ipv6_neighbor_static").Msgf("IPv6 neighbor static %s not found, removing from state", address)
+				data.Address = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read IPv6 neighbor static", fmt.Sprintf("Could not read IPv6 neighbor static %s: %v", address, err))
+			return
+		}
+	}
+
+	data.FromClient(entry)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *IPv6NeighborStaticResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IPv6NeighborStaticModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_ipv6_neighbor_static", data.Address.ValueString())
+	logger := logging.FromContext(ctx)
+
+	entry := data.ToClient()
+	logger.Debug().Str("resource", "rtx_ipv6_neighbor_static").Msgf("Updating IPv6 neighbor static: %+v", entry)
+
+	if err := r.client.UpdateIPv6NeighborStatic(ctx, entry); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update IPv6 neighbor static",
+			fmt.Sprintf("Could not update IPv6 neighbor static: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *IPv6NeighborStaticResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IPv6NeighborStaticModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := data.Interface.ValueString()
+	address := data.Address.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_ipv6_neighbor_static", address)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_ipv6_neighbor_static").Msgf("Deleting IPv6 neighbor static %s on %s", address, iface)
+
+	if err := r.client.DeleteIPv6NeighborStatic(ctx, iface, address); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete IPv6 neighbor static",
+			fmt.Sprintf("Could not delete IPv6 neighbor static %s: %v", address, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *IPv6NeighborStaticResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: interface:address. Interface names never contain a colon,
+	// so the first colon separates the two parts.
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 || !interfacePattern.MatchString(parts[0]) || net.ParseIP(parts[1]) == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Invalid import ID format: %s, expected 'interface:address' (e.g., 'lan1:2001:db8::10')", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("address"), parts[1])...)
+}
@@ -14,6 +14,8 @@ type IPv6PrefixModel struct {
 	PrefixLength types.Int64  `tfsdk:"prefix_length"`
 	Source       types.String `tfsdk:"source"`
 	Interface    types.String `tfsdk:"interface"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.IPv6Prefix.
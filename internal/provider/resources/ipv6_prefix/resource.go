@@ -92,6 +92,7 @@ func (r *IPv6PrefixResource) Schema(ctx context.Context, req resource.SchemaRequ
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -372,13 +373,8 @@ func (r *IPv6PrefixResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 // ImportState imports an existing resource into Terraform.
 func (r *IPv6PrefixResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Parse import ID as prefix_id
-	prefixID, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Invalid import ID format, expected prefix_id (integer): %v", err),
-		)
+	prefixID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "prefix_id", 1, 255)
+	if !ok {
 		return
 	}
 
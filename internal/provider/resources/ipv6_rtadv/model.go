@@ -0,0 +1,54 @@
+package ipv6_rtadv
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// IPv6RTADVModel describes the resource data model.
+type IPv6RTADVModel struct {
+	Interface      types.String `tfsdk:"interface"`
+	PrefixIDs      types.List   `tfsdk:"prefix_ids"`
+	OFlag          types.Bool   `tfsdk:"o_flag"`
+	MFlag          types.Bool   `tfsdk:"m_flag"`
+	MaxInterval    types.Int64  `tfsdk:"max_interval"`
+	MinInterval    types.Int64  `tfsdk:"min_interval"`
+	RouterLifetime types.Int64  `tfsdk:"router_lifetime"`
+	MTU            types.Int64  `tfsdk:"mtu"`
+	RDNSS          types.List   `tfsdk:"rdnss"`
+	DNSSL          types.List   `tfsdk:"dnssl"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.IPv6RTADV.
+func (m *IPv6RTADVModel) ToClient() client.IPv6RTADV {
+	return client.IPv6RTADV{
+		Interface:      fwhelpers.GetStringValue(m.Interface),
+		PrefixIDs:      fwhelpers.ListToIntSlice(m.PrefixIDs),
+		OFlag:          fwhelpers.GetBoolValue(m.OFlag),
+		MFlag:          fwhelpers.GetBoolValue(m.MFlag),
+		MaxInterval:    fwhelpers.GetInt64Value(m.MaxInterval),
+		MinInterval:    fwhelpers.GetInt64Value(m.MinInterval),
+		RouterLifetime: fwhelpers.GetInt64Value(m.RouterLifetime),
+		MTU:            fwhelpers.GetInt64Value(m.MTU),
+		RDNSS:          fwhelpers.ListToStringSlice(m.RDNSS),
+		DNSSL:          fwhelpers.ListToStringSlice(m.DNSSL),
+	}
+}
+
+// FromClient updates the Terraform model from a client.IPv6RTADV.
+func (m *IPv6RTADVModel) FromClient(rtadv *client.IPv6RTADV) {
+	m.Interface = types.StringValue(rtadv.Interface)
+	m.PrefixIDs = fwhelpers.IntSliceToList(rtadv.PrefixIDs)
+	m.OFlag = types.BoolValue(rtadv.OFlag)
+	m.MFlag = types.BoolValue(rtadv.MFlag)
+	m.MaxInterval = fwhelpers.Int64ValueOrNull(rtadv.MaxInterval)
+	m.MinInterval = fwhelpers.Int64ValueOrNull(rtadv.MinInterval)
+	m.RouterLifetime = fwhelpers.Int64ValueOrNull(rtadv.RouterLifetime)
+	m.MTU = fwhelpers.Int64ValueOrNull(rtadv.MTU)
+	m.RDNSS = fwhelpers.StringSliceToList(rtadv.RDNSS)
+	m.DNSSL = fwhelpers.StringSliceToList(rtadv.DNSSL)
+}
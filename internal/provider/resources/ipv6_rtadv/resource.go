@@ -0,0 +1,344 @@
+package ipv6_rtadv
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/validation"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &IPv6RTADVResource{}
+	_ resource.ResourceWithImportState    = &IPv6RTADVResource{}
+	_ resource.ResourceWithValidateConfig = &IPv6RTADVResource{}
+)
+
+var (
+	interfacePattern = regexp.MustCompile(`^(lan|bridge)\d+$`)
+	domainPattern    = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?)*$`)
+)
+
+// NewIPv6RTADVResource creates a new IPv6 RTADV resource.
+func NewIPv6RTADVResource() resource.Resource {
+	return &IPv6RTADVResource{}
+}
+
+// IPv6RTADVResource defines the resource implementation.
+type IPv6RTADVResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *IPv6RTADVResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipv6_rtadv"
+}
+
+// Schema defines the schema for the resource.
+func (r *IPv6RTADVResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages IPv6 Router Advertisement ('ipv6 <interface> rtadv send') on an interface, including the RA timing, " +
+			"MTU option and the RDNSS/DNSSL options so that clients learn DNS servers from RAs. " +
+			"Do not combine with the rtadv block of rtx_ipv6_interface for the same interface.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description: "Interface sending Router Advertisements (e.g., 'lan1', 'bridge1').",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(interfacePattern, "must be a LAN or bridge interface (e.g., 'lan1', 'bridge1')"),
+				},
+			},
+			"prefix_ids": schema.ListAttribute{
+				Description: "IDs of the rtx_ipv6_prefix entries to advertise.",
+				ElementType: types.Int64Type,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+			"o_flag": schema.BoolAttribute{
+				Description: "Set the Other Configuration flag so clients fetch additional settings via stateless DHCPv6. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"m_flag": schema.BoolAttribute{
+				Description: "Set the Managed Address Configuration flag so clients obtain addresses via DHCPv6. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"max_interval": schema.Int64Attribute{
+				Description: "Maximum interval between unsolicited RAs in seconds (4-1800). Uses the router default if omitted.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(4, 1800),
+				},
+			},
+			"min_interval": schema.Int64Attribute{
+				Description: "Minimum interval between unsolicited RAs in seconds. Must not exceed 0.75 times max_interval. Uses the router default if omitted.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(3, 1350),
+				},
+			},
+			"router_lifetime": schema.Int64Attribute{
+				Description: "Router lifetime advertised to clients in seconds (1-9000). Uses the router default if omitted.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 9000),
+				},
+			},
+			"mtu": schema.Int64Attribute{
+				Description: "Link MTU advertised in the MTU option (1280-65535). Useful on PPPoE or tunneled uplinks. Not advertised if omitted.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1280, 65535),
+				},
+			},
+			"rdnss": schema.ListAttribute{
+				Description: "IPv6 addresses of recursive DNS servers advertised in the RDNSS option (RFC 8106).",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 3),
+					listvalidator.ValueStringsAre(validation.IPv6AddressValidator()),
+				},
+			},
+			"dnssl": schema.ListAttribute{
+				Description: "Domain search list advertised in the DNSSL option (RFC 8106).",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(domainPattern, "must be a valid domain name")),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// ValidateConfig checks the relation between the RA intervals.
+func (r *IPv6RTADVResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IPv6RTADVModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.MinInterval.IsNull() || data.MinInterval.IsUnknown() || data.MaxInterval.IsNull() || data.MaxInterval.IsUnknown() {
+		return
+	}
+
+	if data.MinInterval.ValueInt64()*4 > data.MaxInterval.ValueInt64()*3 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_interval"),
+			"Invalid RA Interval",
+			fmt.Sprintf("min_interval (%d) must not exceed 0.75 times max_interval (%d).", data.MinInterval.ValueInt64(), data.MaxInterval.ValueInt64()),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *IPv6RTADVResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *IPv6RTADVResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IPv6RTADVModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *IPv6RTADVResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IPv6RTADVModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.Interface.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the Router Advertisement settings from the router.
+func (r *IPv6RTADVResource) read(ctx context.Context, data *IPv6RTADVModel, diagnostics *diag.Diagnostics) {
+	iface := data.Interface.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_ipv6_rtadv", iface)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_ipv6_rtadv").Msgf("Reading IPv6 RTADV on %s", iface)
+
+	var rtadv *client.IPv6RTADV
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			for _, parsed := range parsedConfig.ExtractIPv6RTADVs() {
+				if parsed.Interface == iface {
+					converted := client.IPv6RTADV(parsed)
+					rtadv = &converted
+					logger.Debug().Str("resource", "rtx_ipv6_rtadv").Msg("Found IPv6 RTADV in SFTP cache")
+					break
+				}
+			}
+		}
+		if rtadv == nil {
+			logger.Debug().Str("resource", "rtx_ipv6_rtadv").Msg("IPv6 RTADV not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or settings not found in cache
+	if rtadv == nil {
+		var err error
+		rtadv, err = r.client.GetIPv6RTADV(ctx, iface)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_ipv6_rtadv").Msgf("IPv6 RTADV on %s not found, removing from state", iface)
+				data.Interface = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read IPv6 RTADV", fmt.Sprintf("Could not read IPv6 RTADV on %s: %v", iface, err))
+			return
+		}
+	}
+
+	data.FromClient(rtadv)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *IPv6RTADVResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IPv6RTADVModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply writes the Router Advertisement settings and reads them back.
+func (r *IPv6RTADVResource) apply(ctx context.Context, data *IPv6RTADVModel, diagnostics *diag.Diagnostics) {
+	ctx = logging.WithResource(ctx, "rtx_ipv6_rtadv", data.Interface.ValueString())
+	logger := logging.FromContext(ctx)
+
+	rtadv := data.ToClient()
+	logger.Debug().Str("resource", "rtx_ipv6_rtadv").Msgf("Configuring IPv6 RTADV: %+v", rtadv)
+
+	if err := r.client.ConfigureIPv6RTADV(ctx, rtadv); err != nil {
+		fwhelpers.AppendDiagError(diagnostics, "Failed to configure IPv6 RTADV", fmt.Sprintf("Could not configure IPv6 RTADV on %s: %v", rtadv.Interface, err))
+		return
+	}
+
+	r.read(ctx, data, diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *IPv6RTADVResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IPv6RTADVModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := data.Interface.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_ipv6_rtadv", iface)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_ipv6_rtadv").Msgf("Deleting IPv6 RTADV on %s", iface)
+
+	if err := r.client.DeleteIPv6RTADV(ctx, iface); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete IPv6 RTADV",
+			fmt.Sprintf("Could not delete IPv6 RTADV on %s: %v", iface, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *IPv6RTADVResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !interfacePattern.MatchString(req.ID) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Invalid import ID format: %s, expected an interface name (e.g., 'lan1')", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface"), req.ID)...)
+}
@@ -12,6 +12,8 @@ import (
 type KronPolicyModel struct {
 	Name         types.String `tfsdk:"name"`
 	CommandLines types.List   `tfsdk:"command_lines"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.KronPolicy.
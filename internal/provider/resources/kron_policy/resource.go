@@ -71,6 +71,7 @@ func (r *KronPolicyResource) Schema(ctx context.Context, req resource.SchemaRequ
 					),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
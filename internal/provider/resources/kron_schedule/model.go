@@ -18,6 +18,8 @@ type KronScheduleModel struct {
 	OnStartup    types.Bool   `tfsdk:"on_startup"`
 	PolicyList   types.String `tfsdk:"policy_list"`
 	CommandLines types.List   `tfsdk:"command_lines"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.Schedule.
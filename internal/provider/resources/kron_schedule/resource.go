@@ -128,6 +128,7 @@ func (r *KronScheduleResource) Schema(ctx context.Context, req resource.SchemaRe
 					listvalidator.ConflictsWith(path.MatchRoot("policy_list")),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -323,19 +324,12 @@ func (r *KronScheduleResource) Delete(ctx context.Context, req resource.DeleteRe
 
 // ImportState imports an existing resource into Terraform.
 func (r *KronScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importID := req.ID
-
-	// Parse import ID as schedule ID
-	id, err := strconv.ParseInt(importID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Invalid import ID format, expected schedule ID (integer): %v", err),
-		)
+	scheduleID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "schedule_id", 1, 65535)
+	if !ok {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule_id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule_id"), scheduleID)...)
 }
 
 // Custom validators
@@ -0,0 +1,89 @@
+package l2ms_managed_switch
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// L2MSManagedSwitchModel describes the resource data model.
+type L2MSManagedSwitchModel struct {
+	Interface  types.String `tfsdk:"interface"`
+	Switch     types.String `tfsdk:"switch"`
+	SystemName types.String `tfsdk:"system_name"`
+	Ports      []PortModel  `tfsdk:"port"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// PortModel describes a port block within the managed switch resource.
+type PortModel struct {
+	Port       types.Int64 `tfsdk:"port"`
+	Enabled    types.Bool  `tfsdk:"enabled"`
+	AccessVLAN types.Int64 `tfsdk:"access_vlan"`
+}
+
+// ToClient converts the Terraform model to a client.L2MSManagedSwitch.
+func (m *L2MSManagedSwitchModel) ToClient() client.L2MSManagedSwitch {
+	sw := client.L2MSManagedSwitch{
+		Interface:  fwhelpers.GetStringValue(m.Interface),
+		Switch:     strings.ToLower(fwhelpers.GetStringValue(m.Switch)),
+		SystemName: fwhelpers.GetStringValue(m.SystemName),
+	}
+
+	for _, p := range m.Ports {
+		sw.Ports = append(sw.Ports, client.L2MSSwitchPort{
+			Port:       fwhelpers.GetInt64Value(p.Port),
+			Enabled:    fwhelpers.GetBoolValueWithDefault(p.Enabled, true),
+			AccessVLAN: fwhelpers.GetInt64Value(p.AccessVLAN),
+		})
+	}
+
+	return sw
+}
+
+// FromClient updates the Terraform model from a client.L2MSManagedSwitch.
+// The router only stores non-default port settings, so configured ports it
+// does not report are kept with their defaults, in the configured order.
+func (m *L2MSManagedSwitchModel) FromClient(sw *client.L2MSManagedSwitch) {
+	m.Interface = types.StringValue(sw.Interface)
+	if !strings.EqualFold(m.Switch.ValueString(), sw.Switch) {
+		m.Switch = types.StringValue(sw.Switch)
+	}
+	m.SystemName = fwhelpers.StringValueOrNull(sw.SystemName)
+
+	reported := make(map[int]client.L2MSSwitchPort, len(sw.Ports))
+	for _, p := range sw.Ports {
+		reported[p.Port] = p
+	}
+
+	var ports []PortModel
+	for _, p := range m.Ports {
+		port := fwhelpers.GetInt64Value(p.Port)
+		current, ok := reported[port]
+		if !ok {
+			current = client.L2MSSwitchPort{Port: port, Enabled: true}
+		}
+		delete(reported, port)
+		ports = append(ports, portFromClient(current))
+	}
+	for _, p := range sw.Ports {
+		if _, ok := reported[p.Port]; ok {
+			ports = append(ports, portFromClient(p))
+		}
+	}
+
+	m.Ports = ports
+}
+
+// portFromClient converts a client.L2MSSwitchPort to a PortModel.
+func portFromClient(p client.L2MSSwitchPort) PortModel {
+	return PortModel{
+		Port:       types.Int64Value(int64(p.Port)),
+		Enabled:    types.BoolValue(p.Enabled),
+		AccessVLAN: fwhelpers.Int64ValueOrNull(p.AccessVLAN),
+	}
+}
@@ -0,0 +1,45 @@
+package l2ms_managed_switch
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+func TestL2MSManagedSwitchModel_FromClient(t *testing.T) {
+	sw := &client.L2MSManagedSwitch{
+		Interface: "lan1",
+		Switch:    "00:a0:de:01:02:03",
+		Ports: []client.L2MSSwitchPort{
+			{Port: 2, Enabled: true, AccessVLAN: 10},
+			{Port: 5, Enabled: false},
+		},
+	}
+
+	m := L2MSManagedSwitchModel{
+		Interface: types.StringValue("lan1"),
+		Switch:    types.StringValue("00:A0:DE:01:02:03"),
+		Ports: []PortModel{
+			{Port: types.Int64Value(1), Enabled: types.BoolValue(true), AccessVLAN: types.Int64Null()},
+			{Port: types.Int64Value(2), Enabled: types.BoolValue(true), AccessVLAN: types.Int64Value(20)},
+		},
+	}
+	m.FromClient(sw)
+
+	if m.Switch.ValueString() != "00:A0:DE:01:02:03" {
+		t.Errorf("switch = %s, want configured spelling", m.Switch.ValueString())
+	}
+
+	// Port 1 keeps its defaults, port 2 reports drift and port 5 is appended
+	want := []PortModel{
+		{Port: types.Int64Value(1), Enabled: types.BoolValue(true), AccessVLAN: types.Int64Null()},
+		{Port: types.Int64Value(2), Enabled: types.BoolValue(true), AccessVLAN: types.Int64Value(10)},
+		{Port: types.Int64Value(5), Enabled: types.BoolValue(false), AccessVLAN: types.Int64Null()},
+	}
+	if !reflect.DeepEqual(m.Ports, want) {
+		t.Errorf("ports = %+v, want %+v", m.Ports, want)
+	}
+}
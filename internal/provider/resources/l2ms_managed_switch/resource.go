@@ -0,0 +1,324 @@
+package l2ms_managed_switch
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &L2MSManagedSwitchResource{}
+	_ resource.ResourceWithImportState = &L2MSManagedSwitchResource{}
+)
+
+var (
+	interfacePattern  = regexp.MustCompile(`^(lan|bridge)\d+$`)
+	switchPattern     = regexp.MustCompile(`^([0-9A-Fa-f]{2}(:[0-9A-Fa-f]{2}){5}|(lan|bridge)\d+(:\d+)+)$`)
+	systemNamePattern = regexp.MustCompile(`^[^\s"]+$`)
+)
+
+// NewL2MSManagedSwitchResource creates a new L2MS managed switch resource.
+func NewL2MSManagedSwitchResource() resource.Resource {
+	return &L2MSManagedSwitchResource{}
+}
+
+// L2MSManagedSwitchResource defines the resource implementation.
+type L2MSManagedSwitchResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *L2MSManagedSwitchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_l2ms_managed_switch"
+}
+
+// Schema defines the schema for the resource.
+func (r *L2MSManagedSwitchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Yamaha switch through L2MS ('switch control use'). Enables the L2MS manager on the router interface " +
+			"and pushes the system name and basic port/VLAN settings to the switch. Use the rtx_l2ms_switches data source to discover switches. " +
+			"On destroy the switch settings are reset to their defaults; the L2MS manager stays enabled on the interface.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description: "Router interface acting as L2MS manager (e.g., 'lan1', 'bridge1').",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(interfacePattern, "must be a LAN or bridge interface (e.g., 'lan1', 'bridge1')"),
+				},
+			},
+			"switch": schema.StringAttribute{
+				Description: "Switch to manage, identified by its MAC address (e.g., '00:a0:de:01:02:03') or route from the router (e.g., 'lan1:1').",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(switchPattern, "must be a MAC address (e.g., '00:a0:de:01:02:03') or a route (e.g., 'lan1:1')"),
+				},
+			},
+			"system_name": schema.StringAttribute{
+				Description: "System name of the switch. The switch default is kept if omitted.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(systemNamePattern, "must not contain whitespace or quotes"),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"port": schema.ListNestedBlock{
+				Description: "Per-port settings. Ports that are not listed keep the switch defaults.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"port": schema.Int64Attribute{
+							Description: "Port number on the switch.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the port is enabled ('port-use'). Defaults to true.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(true),
+						},
+						"access_vlan": schema.Int64Attribute{
+							Description: "Access VLAN ID of the port (1-4094). The switch default is kept if omitted.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 4094),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *L2MSManagedSwitchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *L2MSManagedSwitchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data L2MSManagedSwitchModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_l2ms_managed_switch", data.Switch.ValueString())
+	logger := logging.FromContext(ctx)
+
+	sw := data.ToClient()
+	logger.Debug().Str("resource", "rtx_l2ms_managed_switch").Msgf("Creating L2MS managed switch: %+v", sw)
+
+	if err := r.client.CreateL2MSManagedSwitch(ctx, sw); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create L2MS managed switch",
+			fmt.Sprintf("Could not configure switch %s: %v", sw.Switch, err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *L2MSManagedSwitchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data L2MSManagedSwitchModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.Switch.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the switch settings from the router.
+func (r *L2MSManagedSwitchResource) read(ctx context.Context, data *L2MSManagedSwitchModel, diagnostics *diag.Diagnostics) {
+	iface := data.Interface.ValueString()
+	switchID := data.Switch.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_l2ms_managed_switch", switchID)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_l2ms_managed_switch").Msgf("Reading L2MS managed switch %s on %s", switchID, iface)
+
+	var sw *client.L2MSManagedSwitch
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			if parsed := parsedConfig.ExtractL2MSManagedSwitch(iface, switchID); parsed != nil {
+				converted := convertParsedL2MSManagedSwitch(parsed)
+				sw = &converted
+				logger.Debug().Str("resource", "rtx_l2ms_managed_switch").Msg("Found L2MS managed switch in SFTP cache")
+			}
+		}
+		if sw == nil {
+			logger.Debug().Str("resource", "rtx_l2ms_managed_switch").Msg("L2MS managed switch not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or switch not found in cache
+	if sw == nil {
+		var err error
+		sw, err = r.client.GetL2MSManagedSwitch(ctx, iface, switchID)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_l2ms_managed_switch").Msgf("L2MS manager on %s not found, removing from state", iface)
+				data.Switch = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read L2MS managed switch", fmt.Sprintf("Could not read switch %s: %v", switchID, err))
+			return
+		}
+	}
+
+	data.FromClient(sw)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *L2MSManagedSwitchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data L2MSManagedSwitchModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_l2ms_managed_switch", data.Switch.ValueString())
+	logger := logging.FromContext(ctx)
+
+	sw := data.ToClient()
+	logger.Debug().Str("resource", "rtx_l2ms_managed_switch").Msgf("Updating L2MS managed switch: %+v", sw)
+
+	if err := r.client.UpdateL2MSManagedSwitch(ctx, sw); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update L2MS managed switch",
+			fmt.Sprintf("Could not update switch %s: %v", sw.Switch, err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *L2MSManagedSwitchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data L2MSManagedSwitchModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := data.Interface.ValueString()
+	switchID := strings.ToLower(data.Switch.ValueString())
+
+	ctx = logging.WithResource(ctx, "rtx_l2ms_managed_switch", switchID)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_l2ms_managed_switch").Msgf("Deleting L2MS managed switch %s on %s", switchID, iface)
+
+	if err := r.client.DeleteL2MSManagedSwitch(ctx, iface, switchID); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete L2MS managed switch",
+			fmt.Sprintf("Could not reset switch %s: %v", switchID, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *L2MSManagedSwitchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: interface/switch. The switch may contain colons (MAC
+	// address or route), so a slash separates the two parts.
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || !interfacePattern.MatchString(parts[0]) || !switchPattern.MatchString(parts[1]) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Invalid import ID format: %s, expected 'interface/switch' (e.g., 'lan1/00:a0:de:01:02:03')", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("switch"), parts[1])...)
+}
+
+// convertParsedL2MSManagedSwitch converts a parser L2MSManagedSwitch to a client L2MSManagedSwitch.
+func convertParsedL2MSManagedSwitch(parsed *parsers.L2MSManagedSwitch) client.L2MSManagedSwitch {
+	sw := client.L2MSManagedSwitch{
+		Interface:  parsed.Interface,
+		Switch:     parsed.Switch,
+		SystemName: parsed.SystemName,
+	}
+	for _, p := range parsed.Ports {
+		sw.Ports = append(sw.Ports, client.L2MSSwitchPort(p))
+	}
+	return sw
+}
@@ -31,6 +31,8 @@ type L2TPModel struct {
 	IPPool            *IPPoolModel         `tfsdk:"ip_pool"`
 	IPsecProfile      *IPsecProfileModel   `tfsdk:"ipsec_profile"`
 	L2TPv3Config      *L2TPv3ConfigModel   `tfsdk:"l2tpv3_config"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // AuthenticationModel describes the authentication nested block.
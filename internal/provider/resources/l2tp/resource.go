@@ -162,6 +162,7 @@ func (r *L2TPResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:    true,
 				Default:     booldefault.StaticBool(true),
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"authentication": schema.SingleNestedBlock{
@@ -193,7 +194,8 @@ func (r *L2TPResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"ip_pool": schema.SingleNestedBlock{
-				Description: "IP pool for L2TP LNS clients. Required for LNS mode, not needed for L2TPv3.",
+				Description: "IP pool for L2TP LNS clients. Not needed for L2TPv3. " +
+					"Omit this block when the pool is managed with rtx_vpn_address_pool, so resizing the pool does not touch the tunnel.",
 				Attributes: map[string]schema.Attribute{
 					"start": schema.StringAttribute{
 						Description: "Start IP address of the pool.",
@@ -445,14 +447,10 @@ func (r *L2TPResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 // ImportState imports an existing resource into Terraform.
 func (r *L2TPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	tunnelID, err := strconv.Atoi(req.ID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			fmt.Sprintf("Expected tunnel ID as integer, got: %s", req.ID),
-		)
+	tunnelID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "tunnel_id", 1, 6000)
+	if !ok {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tunnel_id"), int64(tunnelID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tunnel_id"), tunnelID)...)
 }
@@ -11,6 +11,8 @@ type L2TPServiceModel struct {
 	ID        types.String   `tfsdk:"id"`
 	Enabled   types.Bool     `tfsdk:"enabled"`
 	Protocols []types.String `tfsdk:"protocols"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to client parameters.
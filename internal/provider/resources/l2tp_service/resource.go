@@ -67,6 +67,7 @@ func (r *L2TPServiceResource) Schema(ctx context.Context, req resource.SchemaReq
 					listStringValidator{allowedValues: []string{"l2tp", "l2tpv3"}},
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -0,0 +1,33 @@
+package link_aggregation
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// LinkAggregationModel describes the resource data model.
+type LinkAggregationModel struct {
+	GroupID types.Int64  `tfsdk:"group_id"`
+	Mode    types.String `tfsdk:"mode"`
+	Members types.List   `tfsdk:"members"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.LinkAggregation.
+func (m *LinkAggregationModel) ToClient() client.LinkAggregation {
+	return client.LinkAggregation{
+		ID:      fwhelpers.GetInt64Value(m.GroupID),
+		Mode:    fwhelpers.GetStringValue(m.Mode),
+		Members: fwhelpers.ListToStringSlice(m.Members),
+	}
+}
+
+// FromClient updates the Terraform model from a client.LinkAggregation.
+func (m *LinkAggregationModel) FromClient(lag *client.LinkAggregation) {
+	m.GroupID = types.Int64Value(int64(lag.ID))
+	m.Mode = types.StringValue(lag.Mode)
+	m.Members = fwhelpers.StringSliceToList(lag.Members)
+}
@@ -0,0 +1,276 @@
+package link_aggregation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &LinkAggregationResource{}
+	_ resource.ResourceWithImportState = &LinkAggregationResource{}
+)
+
+var memberPattern = regexp.MustCompile(`^lan\d+$`)
+
+// NewLinkAggregationResource creates a new link aggregation resource.
+func NewLinkAggregationResource() resource.Resource {
+	return &LinkAggregationResource{}
+}
+
+// LinkAggregationResource defines the resource implementation.
+type LinkAggregationResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *LinkAggregationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_link_aggregation"
+}
+
+// Schema defines the schema for the resource.
+func (r *LinkAggregationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a LAN link aggregation group ('lan link-aggregation') bundling physical LAN ports with static LAG or LACP. " +
+			"Only available on models with multiple independent LAN ports (vRX, RTX5000, RTX3510, RTX3500, RTX1300); " +
+			"member ports are validated against the detected router model.",
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.Int64Attribute{
+				Description: "Link aggregation group ID (1-8).",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 8),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Description: "Aggregation mode: 'static' for a static LAG or 'lacp' for IEEE 802.3ad LACP.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidLinkAggregationModes...),
+				},
+			},
+			"members": schema.ListAttribute{
+				Description: "Member LAN interfaces (e.g., ['lan2', 'lan3']). At least two ports are required and each must exist on the router model.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(2),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(memberPattern, "must be a LAN interface (e.g., 'lan2')")),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *LinkAggregationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *LinkAggregationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LinkAggregationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_link_aggregation", strconv.FormatInt(data.GroupID.ValueInt64(), 10))
+	logger := logging.FromContext(ctx)
+
+	lag := data.ToClient()
+	logger.Debug().Str("resource", "rtx_link_aggregation").Msgf("Creating link aggregation: %+v", lag)
+
+	if err := r.client.CreateLinkAggregation(ctx, lag); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create link aggregation",
+			fmt.Sprintf("Could not create link aggregation %d: %v", lag.ID, err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *LinkAggregationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LinkAggregationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.GroupID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the link aggregation group from the router.
+func (r *LinkAggregationResource) read(ctx context.Context, data *LinkAggregationModel, diagnostics *diag.Diagnostics) {
+	id := int(data.GroupID.ValueInt64())
+
+	ctx = logging.WithResource(ctx, "rtx_link_aggregation", strconv.Itoa(id))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_link_aggregation").Msgf("Reading link aggregation %d", id)
+
+	var lag *client.LinkAggregation
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			for _, parsed := range parsedConfig.ExtractLinkAggregations() {
+				if parsed.ID == id {
+					converted := client.LinkAggregation(parsed)
+					lag = &converted
+					logger.Debug().Str("resource", "rtx_link_aggregation").Msg("Found link aggregation in SFTP cache")
+					break
+				}
+			}
+		}
+		if lag == nil {
+			logger.Debug().Str("resource", "rtx_link_aggregation").Msg("Link aggregation not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or group not found in cache
+	if lag == nil {
+		var err error
+		lag, err = r.client.GetLinkAggregation(ctx, id)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_link_aggregation").Msgf("Link aggregation %d not found, removing from state", id)
+				data.GroupID = types.Int64Null()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read link aggregation", fmt.Sprintf("Could not read link aggregation %d: %v", id, err))
+			return
+		}
+	}
+
+	data.FromClient(lag)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *LinkAggregationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LinkAggregationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_link_aggregation", strconv.FormatInt(data.GroupID.ValueInt64(), 10))
+	logger := logging.FromContext(ctx)
+
+	lag := data.ToClient()
+	logger.Debug().Str("resource", "rtx_link_aggregation").Msgf("Updating link aggregation: %+v", lag)
+
+	if err := r.client.UpdateLinkAggregation(ctx, lag); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update link aggregation",
+			fmt.Sprintf("Could not update link aggregation %d: %v", lag.ID, err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *LinkAggregationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LinkAggregationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(data.GroupID.ValueInt64())
+
+	ctx = logging.WithResource(ctx, "rtx_link_aggregation", strconv.Itoa(id))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_link_aggregation").Msgf("Deleting link aggregation %d", id)
+
+	if err := r.client.DeleteLinkAggregation(ctx, id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete link aggregation",
+			fmt.Sprintf("Could not delete link aggregation %d: %v", id, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *LinkAggregationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "group_id", 1, 8)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupID)...)
+}
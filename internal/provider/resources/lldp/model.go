@@ -0,0 +1,67 @@
+package lldp
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// LLDPModel describes the resource data model.
+type LLDPModel struct {
+	ID      types.String `tfsdk:"id"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Ports   []PortModel  `tfsdk:"port"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// PortModel describes a port block within the LLDP resource.
+type PortModel struct {
+	Interface types.String `tfsdk:"interface"`
+	Mode      types.String `tfsdk:"mode"`
+}
+
+// ToClient converts the Terraform model to a client.LLDPConfig.
+func (m *LLDPModel) ToClient() client.LLDPConfig {
+	config := client.LLDPConfig{
+		Enabled: fwhelpers.GetBoolValueWithDefault(m.Enabled, true),
+	}
+
+	for _, p := range m.Ports {
+		config.Ports = append(config.Ports, client.LLDPPort{
+			Interface: fwhelpers.GetStringValue(p.Interface),
+			Mode:      fwhelpers.GetStringValue(p.Mode),
+		})
+	}
+
+	return config
+}
+
+// FromClient updates the Terraform model from a client.LLDPConfig.
+// Ports already in the model keep their order; ports only found on the
+// router are appended.
+func (m *LLDPModel) FromClient(config *client.LLDPConfig) {
+	m.ID = types.StringValue("lldp")
+	m.Enabled = types.BoolValue(config.Enabled)
+
+	modes := make(map[string]string, len(config.Ports))
+	for _, p := range config.Ports {
+		modes[p.Interface] = p.Mode
+	}
+
+	var ports []PortModel
+	for _, p := range m.Ports {
+		iface := fwhelpers.GetStringValue(p.Interface)
+		if mode, ok := modes[iface]; ok {
+			ports = append(ports, PortModel{Interface: types.StringValue(iface), Mode: types.StringValue(mode)})
+			delete(modes, iface)
+		}
+	}
+	for _, p := range config.Ports {
+		if _, ok := modes[p.Interface]; ok {
+			ports = append(ports, PortModel{Interface: types.StringValue(p.Interface), Mode: types.StringValue(p.Mode)})
+		}
+	}
+	m.Ports = ports
+}
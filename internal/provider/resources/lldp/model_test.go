@@ -0,0 +1,36 @@
+package lldp
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+func TestLLDPModel_FromClientKeepsPortOrder(t *testing.T) {
+	m := &LLDPModel{
+		Ports: []PortModel{
+			{Interface: types.StringValue("lan2"), Mode: types.StringValue("txrx")},
+			{Interface: types.StringValue("lan1"), Mode: types.StringValue("txrx")},
+		},
+	}
+
+	m.FromClient(&client.LLDPConfig{
+		Enabled: true,
+		Ports: []client.LLDPPort{
+			{Interface: "lan1", Mode: "tx"},
+			{Interface: "lan2", Mode: "txrx"},
+			{Interface: "lan3", Mode: "rx"},
+		},
+	})
+
+	assert.Equal(t, "lldp", m.ID.ValueString())
+	assert.True(t, m.Enabled.ValueBool())
+	assert.Equal(t, []PortModel{
+		{Interface: types.StringValue("lan2"), Mode: types.StringValue("txrx")},
+		{Interface: types.StringValue("lan1"), Mode: types.StringValue("tx")},
+		{Interface: types.StringValue("lan3"), Mode: types.StringValue("rx")},
+	}, m.Ports)
+}
@@ -0,0 +1,285 @@
+package lldp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &LLDPResource{}
+	_ resource.ResourceWithImportState    = &LLDPResource{}
+	_ resource.ResourceWithValidateConfig = &LLDPResource{}
+)
+
+var interfacePattern = regexp.MustCompile(`^lan\d+$`)
+
+// NewLLDPResource creates a new LLDP resource.
+func NewLLDPResource() resource.Resource {
+	return &LLDPResource{}
+}
+
+// LLDPResource defines the resource implementation.
+type LLDPResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *LLDPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lldp"
+}
+
+// Schema defines the schema for the resource.
+func (r *LLDPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the LLDP settings of the router, controlling whether LLDP frames are transmitted and received on each LAN interface " +
+			"so that NMS tools can discover the network topology. " +
+			"This is a singleton resource. Deleting it disables LLDP and restores the default interface modes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (always 'lldp' for this singleton resource).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Enable the LLDP function ('lldp use'). Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"port": schema.ListNestedBlock{
+				Description: "LLDP agent mode of a LAN interface ('lldp agent'). Interfaces without a port block keep the router default.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"interface": schema.StringAttribute{
+							Description: "LAN interface name (e.g., 'lan1').",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(interfacePattern, "must be a LAN interface (e.g., 'lan1')"),
+							},
+						},
+						"mode": schema.StringAttribute{
+							Description: "LLDP agent mode: 'txrx' (transmit and receive), 'tx', 'rx', or 'disable'.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(parsers.ValidLLDPModes...),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *LLDPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// ValidateConfig rejects configurations that set the mode of an interface more than once.
+func (r *LLDPResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data LLDPModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool, len(data.Ports))
+	for i, p := range data.Ports {
+		if p.Interface.IsNull() || p.Interface.IsUnknown() {
+			continue
+		}
+		iface := p.Interface.ValueString()
+		if seen[iface] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("port").AtListIndex(i).AtName("interface"),
+				"Duplicate LLDP port",
+				fmt.Sprintf("Interface %s is configured in more than one port block.", iface),
+			)
+		}
+		seen[iface] = true
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *LLDPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LLDPModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_lldp", "lldp")
+	logger := logging.FromContext(ctx)
+
+	config := data.ToClient()
+	logger.Debug().Str("resource", "rtx_lldp").Msgf("Creating LLDP configuration: %+v", config)
+
+	if err := r.client.ConfigureLLDP(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to configure LLDP",
+			fmt.Sprintf("Could not configure LLDP: %v", err),
+		)
+		return
+	}
+
+	// Set the ID for singleton resource
+	data.ID = types.StringValue("lldp")
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *LLDPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LLDPModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the LLDP settings from the router.
+func (r *LLDPResource) read(ctx context.Context, data *LLDPModel, diagnostics *diag.Diagnostics) {
+	ctx = logging.WithResource(ctx, "rtx_lldp", "lldp")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_lldp").Msg("Reading LLDP configuration")
+
+	var config *client.LLDPConfig
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			converted := convertParsedLLDPConfig(parsedConfig.ExtractLLDP())
+			config = &converted
+			logger.Debug().Str("resource", "rtx_lldp").Msg("Found LLDP configuration in SFTP cache")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or cache unavailable
+	if config == nil {
+		var err error
+		config, err = r.client.GetLLDP(ctx)
+		if err != nil {
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read LLDP configuration", fmt.Sprintf("Could not read LLDP configuration: %v", err))
+			return
+		}
+	}
+
+	data.FromClient(config)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *LLDPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LLDPModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_lldp", "lldp")
+	logger := logging.FromContext(ctx)
+
+	config := data.ToClient()
+	logger.Debug().Str("resource", "rtx_lldp").Msgf("Updating LLDP configuration: %+v", config)
+
+	if err := r.client.UpdateLLDP(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update LLDP configuration",
+			fmt.Sprintf("Could not update LLDP configuration: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *LLDPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithResource(ctx, "rtx_lldp", "lldp")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_lldp").Msg("Resetting LLDP configuration")
+
+	if err := r.client.ResetLLDP(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to reset LLDP configuration",
+			fmt.Sprintf("Could not reset LLDP configuration: %v", err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *LLDPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fwhelpers.ImportSingleton(ctx, "lldp", req, resp)
+}
+
+// convertParsedLLDPConfig converts the parser representation to the client representation
+func convertParsedLLDPConfig(parsed *parsers.LLDPConfig) client.LLDPConfig {
+	config := client.LLDPConfig{
+		Enabled: parsed.Enabled,
+	}
+	for _, p := range parsed.Ports {
+		config.Ports = append(config.Ports, client.LLDPPort(p))
+	}
+	return config
+}
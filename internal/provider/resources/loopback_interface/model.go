@@ -0,0 +1,34 @@
+package loopback_interface
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// LoopbackInterfaceModel describes the resource data model.
+type LoopbackInterfaceModel struct {
+	Name      types.String `tfsdk:"name"`
+	Address   types.String `tfsdk:"address"`
+	IPAddress types.String `tfsdk:"ip_address"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.LoopbackInterface.
+func (m *LoopbackInterfaceModel) ToClient() client.LoopbackInterface {
+	return client.LoopbackInterface{
+		Name:    fwhelpers.GetStringValue(m.Name),
+		Address: fwhelpers.GetStringValue(m.Address),
+	}
+}
+
+// FromClient updates the Terraform model from a client.LoopbackInterface.
+func (m *LoopbackInterfaceModel) FromClient(loopback *client.LoopbackInterface) {
+	m.Name = types.StringValue(loopback.Name)
+	m.Address = types.StringValue(loopback.Address)
+	m.IPAddress = types.StringValue(strings.SplitN(loopback.Address, "/", 2)[0])
+}
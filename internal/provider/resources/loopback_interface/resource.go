@@ -0,0 +1,270 @@
+package loopback_interface
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/validation"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &LoopbackInterfaceResource{}
+	_ resource.ResourceWithImportState = &LoopbackInterfaceResource{}
+)
+
+var namePattern = regexp.MustCompile(`^loopback[1-9]$`)
+
+// NewLoopbackInterfaceResource creates a new loopback interface resource.
+func NewLoopbackInterfaceResource() resource.Resource {
+	return &LoopbackInterfaceResource{}
+}
+
+// LoopbackInterfaceResource defines the resource implementation.
+type LoopbackInterfaceResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *LoopbackInterfaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_loopback_interface"
+}
+
+// Schema defines the schema for the resource.
+func (r *LoopbackInterfaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the IPv4 address of a loopback interface ('ip loopbackN address'). " +
+			"Loopback addresses stay reachable regardless of physical link state, which makes them suitable " +
+			"as OSPF/BGP router IDs and as management addresses.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Loopback interface name (loopback1 - loopback9).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(namePattern, "must be loopback1 - loopback9"),
+				},
+			},
+			"address": schema.StringAttribute{
+				Description: "IPv4 address in CIDR notation (e.g., '10.255.0.1/32').",
+				Required:    true,
+				Validators: []validator.String{
+					validation.CIDRValidator(),
+				},
+			},
+			"ip_address": schema.StringAttribute{
+				Description: "The address without prefix length, for use as router_id in rtx_ospf or rtx_bgp.",
+				Computed:    true,
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *LoopbackInterfaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *LoopbackInterfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LoopbackInterfaceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_loopback_interface", data.Name.ValueString())
+	logger := logging.FromContext(ctx)
+
+	loopback := data.ToClient()
+	logger.Debug().Str("resource", "rtx_loopback_interface").Msgf("Creating loopback interface: %+v", loopback)
+
+	if err := r.client.CreateLoopbackInterface(ctx, loopback); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create loopback interface",
+			fmt.Sprintf("Could not configure %s: %v", loopback.Name, err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *LoopbackInterfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LoopbackInterfaceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.Name.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the loopback address from the router.
+func (r *LoopbackInterfaceResource) read(ctx context.Context, data *LoopbackInterfaceModel, diagnostics *diag.Diagnostics) {
+	name := data.Name.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_loopback_interface", name)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_loopback_interface").Msgf("Reading loopback interface %s", name)
+
+	var loopback *client.LoopbackInterface
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			for _, parsed := range parsedConfig.ExtractLoopbackInterfaces() {
+				if parsed.Name == name {
+					converted := client.LoopbackInterface(parsed)
+					loopback = &converted
+					logger.Debug().Str("resource", "rtx_loopback_interface").Msg("Found loopback interface in SFTP cache")
+					break
+				}
+			}
+		}
+		if loopback == nil {
+			logger.Debug().Str("resource", "rtx_loopback_interface").Msg("Loopback interface not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or interface not found in cache
+	if loopback == nil {
+		var err error
+		loopback, err = r.client.GetLoopbackInterface(ctx, name)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_loopback_interface").Msgf("Loopback interface %s not found, removing from state", name)
+				data.Name = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read loopback interface", fmt.Sprintf("Could not read %s: %v", name, err))
+			return
+		}
+	}
+
+	data.FromClient(loopback)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *LoopbackInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LoopbackInterfaceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_loopback_interface", data.Name.ValueString())
+	logger := logging.FromContext(ctx)
+
+	loopback := data.ToClient()
+	logger.Debug().Str("resource", "rtx_loopback_interface").Msgf("Updating loopback interface: %+v", loopback)
+
+	if err := r.client.UpdateLoopbackInterface(ctx, loopback); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update loopback interface",
+			fmt.Sprintf("Could not update %s: %v", loopback.Name, err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *LoopbackInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LoopbackInterfaceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_loopback_interface", name)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_loopback_interface").Msgf("Deleting loopback interface %s", name)
+
+	if err := r.client.DeleteLoopbackInterface(ctx, name); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete loopback interface",
+			fmt.Sprintf("Could not remove the address of %s: %v", name, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *LoopbackInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !namePattern.MatchString(req.ID) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Invalid import ID format: %s, expected a loopback interface name (e.g., 'loopback1')", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
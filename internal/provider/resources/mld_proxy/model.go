@@ -0,0 +1,110 @@
+package mld_proxy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// MLDProxyModel describes the resource data model.
+type MLDProxyModel struct {
+	UpstreamInterface    types.String `tfsdk:"upstream_interface"`
+	DownstreamInterfaces types.Set    `tfsdk:"downstream_interfaces"`
+	Version              types.String `tfsdk:"version"`
+	Syslog               types.Bool   `tfsdk:"syslog"`
+	StaticJoin           types.List   `tfsdk:"static_join"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// StaticJoinModel describes the static join nested block model.
+type StaticJoinModel struct {
+	Interface types.String `tfsdk:"interface"`
+	Group     types.String `tfsdk:"group"`
+	Source    types.String `tfsdk:"source"`
+}
+
+// StaticJoinAttrTypes returns the attribute types for StaticJoinModel.
+func StaticJoinAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"interface": types.StringType,
+		"group":     types.StringType,
+		"source":    types.StringType,
+	}
+}
+
+// ToClient converts the Terraform model to a client.MLDProxy.
+func (m *MLDProxyModel) ToClient(ctx context.Context) (client.MLDProxy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	proxy := client.MLDProxy{
+		UpstreamInterface: fwhelpers.GetStringValue(m.UpstreamInterface),
+		Version:           fwhelpers.GetStringValue(m.Version),
+		Syslog:            fwhelpers.GetBoolValue(m.Syslog),
+	}
+
+	if !m.DownstreamInterfaces.IsNull() && !m.DownstreamInterfaces.IsUnknown() {
+		diags.Append(m.DownstreamInterfaces.ElementsAs(ctx, &proxy.DownstreamInterfaces, false)...)
+		if diags.HasError() {
+			return proxy, diags
+		}
+	}
+
+	if !m.StaticJoin.IsNull() && !m.StaticJoin.IsUnknown() {
+		var joins []StaticJoinModel
+		diags.Append(m.StaticJoin.ElementsAs(ctx, &joins, false)...)
+		if diags.HasError() {
+			return proxy, diags
+		}
+
+		proxy.StaticJoins = make([]client.MLDStaticJoin, len(joins))
+		for i, join := range joins {
+			proxy.StaticJoins[i] = client.MLDStaticJoin{
+				Interface: fwhelpers.GetStringValue(join.Interface),
+				Group:     fwhelpers.GetStringValue(join.Group),
+				Source:    fwhelpers.GetStringValue(join.Source),
+			}
+		}
+	}
+
+	return proxy, diags
+}
+
+// FromClient updates the Terraform model from a client.MLDProxy.
+func (m *MLDProxyModel) FromClient(ctx context.Context, proxy *client.MLDProxy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.UpstreamInterface = types.StringValue(proxy.UpstreamInterface)
+	m.Version = types.StringValue(proxy.Version)
+	m.Syslog = types.BoolValue(proxy.Syslog)
+
+	downstream, setDiags := types.SetValueFrom(ctx, types.StringType, proxy.DownstreamInterfaces)
+	diags.Append(setDiags...)
+	m.DownstreamInterfaces = downstream
+
+	if len(proxy.StaticJoins) > 0 {
+		joins := make([]attr.Value, len(proxy.StaticJoins))
+		for i, join := range proxy.StaticJoins {
+			objVal, objDiags := types.ObjectValue(StaticJoinAttrTypes(), map[string]attr.Value{
+				"interface": types.StringValue(join.Interface),
+				"group":     types.StringValue(join.Group),
+				"source":    fwhelpers.StringValueOrNull(join.Source),
+			})
+			diags.Append(objDiags...)
+			joins[i] = objVal
+		}
+
+		listVal, listDiags := types.ListValue(types.ObjectType{AttrTypes: StaticJoinAttrTypes()}, joins)
+		diags.Append(listDiags...)
+		m.StaticJoin = listVal
+	} else {
+		m.StaticJoin = types.ListNull(types.ObjectType{AttrTypes: StaticJoinAttrTypes()})
+	}
+
+	return diags
+}
@@ -0,0 +1,403 @@
+package mld_proxy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &MLDProxyResource{}
+	_ resource.ResourceWithImportState    = &MLDProxyResource{}
+	_ resource.ResourceWithValidateConfig = &MLDProxyResource{}
+)
+
+var interfaceNamePattern = regexp.MustCompile(`^(lan|bridge|pp|tunnel)\d+$`)
+
+// NewMLDProxyResource creates a new MLD proxy resource.
+func NewMLDProxyResource() resource.Resource {
+	return &MLDProxyResource{}
+}
+
+// MLDProxyResource defines the resource implementation.
+type MLDProxyResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *MLDProxyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mld_proxy"
+}
+
+// Schema defines the schema for the resource.
+func (r *MLDProxyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an MLD proxy on RTX routers. The upstream interface runs in MLD host mode and relays " +
+			"multicast listener reports from downstream interfaces running in MLD router mode, as used for IPv6 " +
+			"multicast services such as FLET'S TV. Every interface in MLD router mode is treated as a downstream " +
+			"of this proxy, so only one rtx_mld_proxy should exist per router.",
+		Attributes: map[string]schema.Attribute{
+			"upstream_interface": schema.StringAttribute{
+				Description: "Upstream (WAN side) interface configured with 'ipv6 <interface> mld host' (e.g., 'lan2').",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						interfaceNamePattern,
+						"must be a valid interface name (e.g., 'lan1', 'lan2', 'bridge1', 'pp1', 'tunnel1')",
+					),
+				},
+			},
+			"downstream_interfaces": schema.SetAttribute{
+				Description: "Downstream (LAN side) interfaces configured with 'ipv6 <interface> mld router'.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(
+							interfaceNamePattern,
+							"must be a valid interface name (e.g., 'lan1', 'lan2', 'bridge1', 'pp1', 'tunnel1')",
+						),
+					),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "MLD version used on all proxy interfaces: '1' or '2'. Defaults to '2'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(parsers.DefaultMLDVersion),
+				Validators: []validator.String{
+					stringvalidator.OneOf("1", "2"),
+				},
+			},
+			"syslog": schema.BoolAttribute{
+				Description: "Log MLD events to syslog.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"static_join": schema.ListNestedBlock{
+				Description: "Statically joined IPv6 multicast groups ('ipv6 <interface> mld static').",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"interface": schema.StringAttribute{
+							Description: "Interface to join the group on. Must be the upstream or one of the downstream interfaces.",
+							Required:    true,
+						},
+						"group": schema.StringAttribute{
+							Description: "IPv6 multicast group address (e.g., 'ff3e::1234').",
+							Required:    true,
+						},
+						"source": schema.StringAttribute{
+							Description: "Source address for source-specific multicast.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig performs custom validation on the resource configuration.
+func (r *MLDProxyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data MLDProxyModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.StaticJoin.IsNull() || data.StaticJoin.IsUnknown() {
+		return
+	}
+
+	var joins []StaticJoinModel
+	resp.Diagnostics.Append(data.StaticJoin.ElementsAs(ctx, &joins, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var members []string
+	membersKnown := !data.UpstreamInterface.IsUnknown() && !data.DownstreamInterfaces.IsUnknown()
+	if membersKnown {
+		members = append(members, data.UpstreamInterface.ValueString())
+		if !data.DownstreamInterfaces.IsNull() {
+			var downstream []string
+			resp.Diagnostics.Append(data.DownstreamInterfaces.ElementsAs(ctx, &downstream, false)...)
+			members = append(members, downstream...)
+		}
+	}
+
+	for i, join := range joins {
+		joinPath := path.Root("static_join").AtListIndex(i)
+
+		if !join.Group.IsUnknown() && !join.Group.IsNull() {
+			if err := parsers.ValidateMLDGroup(join.Group.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(joinPath.AtName("group"), "Invalid Multicast Group", err.Error())
+			}
+		}
+
+		if membersKnown && !join.Interface.IsUnknown() && !join.Interface.IsNull() {
+			iface := join.Interface.ValueString()
+			found := false
+			for _, member := range members {
+				if member == iface {
+					found = true
+					break
+				}
+			}
+			if !found {
+				resp.Diagnostics.AddAttributeError(
+					joinPath.AtName("interface"),
+					"Invalid Static Join Interface",
+					fmt.Sprintf("interface %q must be the upstream interface or one of the downstream interfaces", iface),
+				)
+			}
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *MLDProxyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *MLDProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MLDProxyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_mld_proxy", data.UpstreamInterface.ValueString())
+	logger := logging.FromContext(ctx)
+
+	proxy, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger.Debug().Str("resource", "rtx_mld_proxy").Msgf("Creating MLD proxy: %+v", proxy)
+
+	if err := r.client.CreateMLDProxy(ctx, proxy); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create MLD proxy",
+			fmt.Sprintf("Could not create MLD proxy: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *MLDProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MLDProxyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.UpstreamInterface.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the MLD proxy from the router.
+func (r *MLDProxyResource) read(ctx context.Context, data *MLDProxyModel, diagnostics *diag.Diagnostics) {
+	upstream := data.UpstreamInterface.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_mld_proxy", upstream)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_mld_proxy").Msgf("Reading MLD proxy: %s", upstream)
+
+	var proxy *client.MLDProxy
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			if mldConfig := parsedConfig.ExtractMLD(); mldConfig != nil {
+				if parsed, err := parsers.MLDProxyFromConfig(mldConfig, upstream); err == nil {
+					proxy = convertParsedMLDProxy(parsed)
+					logger.Debug().Str("resource", "rtx_mld_proxy").Msg("Found MLD proxy in SFTP cache")
+				}
+			}
+		}
+		if proxy == nil {
+			logger.Debug().Str("resource", "rtx_mld_proxy").Msg("MLD proxy not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or proxy not found in cache
+	if proxy == nil {
+		var err error
+		proxy, err = r.client.GetMLDProxy(ctx, upstream)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_mld_proxy").Msgf("MLD proxy %s not found, removing from state", upstream)
+				data.UpstreamInterface = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read MLD proxy", fmt.Sprintf("Could not read MLD proxy %s: %v", upstream, err))
+			return
+		}
+	}
+
+	diagnostics.Append(data.FromClient(ctx, proxy)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *MLDProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MLDProxyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_mld_proxy", data.UpstreamInterface.ValueString())
+	logger := logging.FromContext(ctx)
+
+	proxy, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger.Debug().Str("resource", "rtx_mld_proxy").Msgf("Updating MLD proxy: %+v", proxy)
+
+	if err := r.client.UpdateMLDProxy(ctx, proxy); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update MLD proxy",
+			fmt.Sprintf("Could not update MLD proxy: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *MLDProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MLDProxyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upstream := data.UpstreamInterface.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_mld_proxy", upstream)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_mld_proxy").Msgf("Deleting MLD proxy: %s", upstream)
+
+	if err := r.client.DeleteMLDProxy(ctx, upstream); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete MLD proxy",
+			fmt.Sprintf("Could not delete MLD proxy %s: %v", upstream, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *MLDProxyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !interfaceNamePattern.MatchString(req.ID) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected the upstream interface name (e.g., 'lan2'), got %q", req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("upstream_interface"), req, resp)
+}
+
+// convertParsedMLDProxy converts a parser MLDProxy to a client MLDProxy.
+func convertParsedMLDProxy(parsed *parsers.MLDProxy) *client.MLDProxy {
+	proxy := &client.MLDProxy{
+		UpstreamInterface:    parsed.UpstreamInterface,
+		DownstreamInterfaces: parsed.DownstreamInterfaces,
+		Version:              parsed.Version,
+		Syslog:               parsed.Syslog,
+		StaticJoins:          make([]client.MLDStaticJoin, len(parsed.StaticJoins)),
+	}
+	for i, join := range parsed.StaticJoins {
+		proxy.StaticJoins[i] = client.MLDStaticJoin{
+			Interface: join.Interface,
+			Group:     join.Group,
+			Source:    join.Source,
+		}
+	}
+	return proxy
+}
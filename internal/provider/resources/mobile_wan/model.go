@@ -0,0 +1,69 @@
+package mobile_wan
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// MobileWANModel describes the resource data model.
+type MobileWANModel struct {
+	ID             types.String `tfsdk:"id"`
+	PPNumber       types.Int64  `tfsdk:"pp_number"`
+	Interface      types.String `tfsdk:"interface"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	APN            types.String `tfsdk:"apn"`
+	CID            types.Int64  `tfsdk:"cid"`
+	PDPType        types.String `tfsdk:"pdp_type"`
+	AuthMethod     types.String `tfsdk:"auth_method"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	PIN            types.String `tfsdk:"pin"`
+	AlwaysOn       types.Bool   `tfsdk:"always_on"`
+	AutoConnect    types.Bool   `tfsdk:"auto_connect"`
+	DisconnectTime types.Int64  `tfsdk:"disconnect_time"`
+	PPInterface    types.String `tfsdk:"pp_interface"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.MobileWAN.
+func (m *MobileWANModel) ToClient() client.MobileWAN {
+	return client.MobileWAN{
+		PPNumber:       fwhelpers.GetInt64Value(m.PPNumber),
+		Interface:      fwhelpers.GetStringValue(m.Interface),
+		Enabled:        fwhelpers.GetBoolValueWithDefault(m.Enabled, true),
+		APN:            fwhelpers.GetStringValue(m.APN),
+		CID:            fwhelpers.GetInt64Value(m.CID),
+		PDPType:        fwhelpers.GetStringValueWithDefault(m.PDPType, "ip"),
+		AuthMethod:     fwhelpers.GetStringValue(m.AuthMethod),
+		Username:       fwhelpers.GetStringValue(m.Username),
+		Password:       fwhelpers.GetStringValue(m.Password),
+		PIN:            fwhelpers.GetStringValue(m.PIN),
+		AlwaysOn:       fwhelpers.GetBoolValue(m.AlwaysOn),
+		AutoConnect:    fwhelpers.GetBoolValue(m.AutoConnect),
+		DisconnectTime: fwhelpers.GetInt64Value(m.DisconnectTime),
+	}
+}
+
+// FromClient updates the Terraform model from a client.MobileWAN.
+func (m *MobileWANModel) FromClient(wan *client.MobileWAN) {
+	m.ID = types.StringValue(strconv.Itoa(wan.PPNumber))
+	m.PPNumber = types.Int64Value(int64(wan.PPNumber))
+	m.Interface = types.StringValue(wan.Interface)
+	m.Enabled = types.BoolValue(wan.Enabled)
+	m.APN = types.StringValue(wan.APN)
+	m.CID = types.Int64Value(int64(wan.CID))
+	m.PDPType = types.StringValue(wan.PDPType)
+	m.AuthMethod = fwhelpers.StringValueOrNull(wan.AuthMethod)
+	m.Username = fwhelpers.StringValueOrNull(wan.Username)
+	m.AlwaysOn = types.BoolValue(wan.AlwaysOn)
+	m.AutoConnect = types.BoolValue(wan.AutoConnect)
+	m.DisconnectTime = types.Int64Value(int64(wan.DisconnectTime))
+	m.PPInterface = types.StringValue(fmt.Sprintf("pp%d", wan.PPNumber))
+	// Note: password and pin are WriteOnly - we don't read them back from router
+}
@@ -0,0 +1,382 @@
+package mobile_wan
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &MobileWANResource{}
+	_ resource.ResourceWithImportState    = &MobileWANResource{}
+	_ resource.ResourceWithValidateConfig = &MobileWANResource{}
+)
+
+// NewMobileWANResource creates a new mobile WAN resource.
+func NewMobileWANResource() resource.Resource {
+	return &MobileWANResource{}
+}
+
+// MobileWANResource defines the resource implementation.
+type MobileWANResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *MobileWANResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mobile_wan"
+}
+
+// Schema defines the schema for the resource.
+func (r *MobileWANResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an LTE (mobile) WAN connection on a PP interface using a USB dongle or built-in modem. " +
+			"Commonly used as a backup uplink together with weighted or keepalive-tracked static routes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (PP number).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pp_number": schema.Int64Attribute{
+				Description: "PP interface number (1-based) used for the mobile connection.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Description: "Mobile interface the PP interface is bound to: 'usbN' for USB dongles or 'wanN' for built-in modems.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(usb|wan)\d+$`), "must be a mobile interface such as 'usb1' or 'wan1'"),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Enable the mobile interface ('mobile use'). Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"apn": schema.StringAttribute{
+				Description: "Access point name provided by the carrier.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^\s"]+$`), "must not contain spaces or quotes"),
+				},
+			},
+			"cid": schema.Int64Attribute{
+				Description: "PDP context ID. Defaults to 1.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"pdp_type": schema.StringAttribute{
+				Description: "PDP type: 'ip', 'ipv6' or 'ipv4v6'. Defaults to 'ip'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("ip"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidMobileWANPDPTypes...),
+				},
+			},
+			"auth_method": schema.StringAttribute{
+				Description: "Authentication method required by the carrier: 'pap' or 'chap'.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidMobileWANAuthMethods...),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "Authentication username.",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Authentication password. Required when username is set. Not read back from the router.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"pin": schema.StringAttribute{
+				Description: "SIM PIN code (4-8 digits). Not read back from the router.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4,8}$`), "must be 4-8 digits"),
+				},
+			},
+			"always_on": schema.BoolAttribute{
+				Description: "Keep the connection up permanently ('pp always-on'). Defaults to false, so the connection is dialed on demand.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"auto_connect": schema.BoolAttribute{
+				Description: "Dial automatically when traffic is routed to the PP interface ('mobile auto connect'). Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"disconnect_time": schema.Int64Attribute{
+				Description: "Idle time in seconds after which the connection is dropped ('mobile disconnect time'). 0 disables the timer. Defaults to 0.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"pp_interface": schema.StringAttribute{
+				Description: "The PP interface name (e.g., 'pp2'). Computed from pp_number.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// ValidateConfig checks that credentials are configured together.
+func (r *MobileWANResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data MobileWANModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Username.IsUnknown() || data.Password.IsUnknown() {
+		return
+	}
+
+	if !data.Username.IsNull() && data.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing Password",
+			"password is required when username is set.",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *MobileWANResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *MobileWANResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MobileWANModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wan := data.ToClient()
+
+	ctx = logging.WithResource(ctx, "rtx_mobile_wan", strconv.Itoa(wan.PPNumber))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_mobile_wan").Msgf("Creating mobile WAN on pp %d via %s (apn %s)", wan.PPNumber, wan.Interface, wan.APN)
+
+	if err := r.client.CreateMobileWAN(ctx, wan); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create mobile WAN",
+			fmt.Sprintf("Could not create mobile WAN on PP %d: %v", wan.PPNumber, err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *MobileWANResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MobileWANModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the mobile WAN connection from the router.
+func (r *MobileWANResource) read(ctx context.Context, data *MobileWANModel, diagnostics *diag.Diagnostics) {
+	ppNum := fwhelpers.GetInt64Value(data.PPNumber)
+
+	ctx = logging.WithResource(ctx, "rtx_mobile_wan", strconv.Itoa(ppNum))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_mobile_wan").Msgf("Reading mobile WAN on pp %d", ppNum)
+
+	var wan *client.MobileWAN
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			for _, parsed := range parsedConfig.ExtractMobileWANs() {
+				if parsed.PPNumber == ppNum {
+					converted := client.MobileWAN(parsed)
+					wan = &converted
+					logger.Debug().Str("resource", "rtx_mobile_wan").Msg("Found mobile WAN in SFTP cache")
+					break
+				}
+			}
+		}
+		if wan == nil {
+			logger.Debug().Str("resource", "rtx_mobile_wan").Msg("Mobile WAN not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or connection not found in cache
+	if wan == nil {
+		var err error
+		wan, err = r.client.GetMobileWAN(ctx, ppNum)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_mobile_wan").Msgf("Mobile WAN on pp %d not found, removing from state", ppNum)
+				data.ID = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read mobile WAN", fmt.Sprintf("Could not read mobile WAN on PP %d: %v", ppNum, err))
+			return
+		}
+	}
+
+	data.FromClient(wan)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *MobileWANResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MobileWANModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wan := data.ToClient()
+
+	ctx = logging.WithResource(ctx, "rtx_mobile_wan", strconv.Itoa(wan.PPNumber))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_mobile_wan").Msgf("Updating mobile WAN on pp %d via %s (apn %s)", wan.PPNumber, wan.Interface, wan.APN)
+
+	if err := r.client.UpdateMobileWAN(ctx, wan); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update mobile WAN",
+			fmt.Sprintf("Could not update mobile WAN on PP %d: %v", wan.PPNumber, err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *MobileWANResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MobileWANModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ppNum := fwhelpers.GetInt64Value(data.PPNumber)
+
+	ctx = logging.WithResource(ctx, "rtx_mobile_wan", strconv.Itoa(ppNum))
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_mobile_wan").Msgf("Deleting mobile WAN on pp %d", ppNum)
+
+	if err := r.client.DeleteMobileWAN(ctx, ppNum); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete mobile WAN",
+			fmt.Sprintf("Could not delete mobile WAN on PP %d: %v", ppNum, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *MobileWANResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ppNum, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "pp_number", 1, 0)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pp_number"), ppNum)...)
+}
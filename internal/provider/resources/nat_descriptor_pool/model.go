@@ -0,0 +1,56 @@
+package nat_descriptor_pool
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NATDescriptorPoolModel describes the resource data model.
+type NATDescriptorPoolModel struct {
+	RangeStart types.Int64 `tfsdk:"range_start"`
+	RangeEnd   types.Int64 `tfsdk:"range_end"`
+	Names      types.Set   `tfsdk:"names"`
+	IDs        types.Map   `tfsdk:"ids"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// allocateIDs assigns a descriptor ID to every name. Names that already have
+// an ID keep it, so dependent resources are not replaced; every other name
+// gets the lowest ID between start and end that is neither used on the router
+// nor assigned to another name. Names are handled in sorted order, so the
+// result does not depend on the order of the set.
+func allocateIDs(assigned map[string]int64, names []string, used map[int64]bool, start, end int64) (map[string]int64, error) {
+	sorted := append([]string(nil), names...)
+	sort.Strings(sorted)
+
+	result := make(map[string]int64, len(sorted))
+	taken := make(map[int64]bool, len(used)+len(assigned))
+	for id := range used {
+		taken[id] = true
+	}
+	for _, name := range sorted {
+		if id, ok := assigned[name]; ok {
+			result[name] = id
+			taken[id] = true
+		}
+	}
+
+	next := start
+	for _, name := range sorted {
+		if _, ok := result[name]; ok {
+			continue
+		}
+		for next <= end && taken[next] {
+			next++
+		}
+		if next > end {
+			return nil, fmt.Errorf("no free NAT descriptor ID left between %d and %d for %q", start, end, name)
+		}
+		result[name] = next
+		taken[next] = true
+	}
+	return result, nil
+}
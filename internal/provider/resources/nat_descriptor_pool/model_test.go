@@ -0,0 +1,63 @@
+package nat_descriptor_pool
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAllocateIDs(t *testing.T) {
+	tests := []struct {
+		name     string
+		assigned map[string]int64
+		names    []string
+		used     map[int64]bool
+		start    int64
+		end      int64
+		want     map[string]int64
+		wantErr  bool
+	}{
+		{
+			name:  "lowest free IDs in name order",
+			names: []string{"office", "guest"},
+			used:  map[int64]bool{1000: true},
+			start: 1000,
+			end:   1010,
+			want:  map[string]int64{"guest": 1001, "office": 1002},
+		},
+		{
+			name:     "assigned IDs are kept although in use by dependents",
+			assigned: map[string]int64{"office": 1000, "removed": 1001},
+			names:    []string{"office", "lab"},
+			used:     map[int64]bool{1000: true, 1001: true},
+			start:    1000,
+			end:      1010,
+			want:     map[string]int64{"office": 1000, "lab": 1002},
+		},
+		{
+			name:    "pool exhausted",
+			names:   []string{"a", "b"},
+			used:    map[int64]bool{2: true},
+			start:   1,
+			end:     2,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := allocateIDs(tt.assigned, tt.names, tt.used, tt.start, tt.end)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("allocateIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
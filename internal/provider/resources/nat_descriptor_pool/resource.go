@@ -0,0 +1,285 @@
+package nat_descriptor_pool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &NATDescriptorPoolResource{}
+	_ resource.ResourceWithModifyPlan     = &NATDescriptorPoolResource{}
+	_ resource.ResourceWithValidateConfig = &NATDescriptorPoolResource{}
+)
+
+const (
+	// defaultRangeStart and defaultRangeEnd cover every valid descriptor ID
+	defaultRangeStart = 1
+	defaultRangeEnd   = 65535
+)
+
+// NewNATDescriptorPoolResource creates a new NAT descriptor pool resource.
+func NewNATDescriptorPoolResource() resource.Resource {
+	return &NATDescriptorPoolResource{}
+}
+
+// NATDescriptorPoolResource defines the resource implementation.
+type NATDescriptorPoolResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *NATDescriptorPoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nat_descriptor_pool"
+}
+
+// Schema defines the schema for the resource.
+func (r *NATDescriptorPoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Hands out unused NAT descriptor IDs by name, for rtx_nat_masquerade and rtx_nat_static resources " +
+			"(e.g., descriptor_id = rtx_nat_descriptor_pool.main.ids[\"office\"]). IDs are picked when a name is added, " +
+			"skipping every descriptor the router already configures, and stay with the name until it is removed. " +
+			"The pool itself sends no commands to the router. Give pools disjoint ranges.",
+		Attributes: map[string]schema.Attribute{
+			"range_start": schema.Int64Attribute{
+				Description: fmt.Sprintf("Lowest descriptor ID handed out. Defaults to %d.", defaultRangeStart),
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultRangeStart),
+				Validators: []validator.Int64{
+					int64validator.Between(defaultRangeStart, defaultRangeEnd),
+				},
+			},
+			"range_end": schema.Int64Attribute{
+				Description: fmt.Sprintf("Highest descriptor ID handed out. Defaults to %d. Changing the range only affects IDs handed out afterwards.", defaultRangeEnd),
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultRangeEnd),
+				Validators: []validator.Int64{
+					int64validator.Between(defaultRangeStart, defaultRangeEnd),
+				},
+			},
+			"names": schema.SetAttribute{
+				Description: "Names to allocate a descriptor ID for.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"ids": schema.MapAttribute{
+				Description: "Descriptor ID allocated to each name.",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *NATDescriptorPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// ValidateConfig checks that the range is not empty.
+func (r *NATDescriptorPoolResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NATDescriptorPoolModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start := int64(defaultRangeStart)
+	if !data.RangeStart.IsNull() && !data.RangeStart.IsUnknown() {
+		start = data.RangeStart.ValueInt64()
+	}
+	end := int64(defaultRangeEnd)
+	if !data.RangeEnd.IsNull() && !data.RangeEnd.IsUnknown() {
+		end = data.RangeEnd.ValueInt64()
+	}
+	if start > end {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("range_end"),
+			"Invalid Range",
+			fmt.Sprintf("range_end (%d) must not be lower than range_start (%d).", end, start),
+		)
+	}
+}
+
+// ModifyPlan keeps the IDs of names that are already allocated, so that only
+// newly added names show an unknown ID in the plan.
+func (r *NATDescriptorPoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan NATDescriptorPoolModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Names.IsUnknown() {
+		return
+	}
+
+	assigned := map[string]int64{}
+	if !req.State.Raw.IsNull() {
+		var state NATDescriptorPoolModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &assigned, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	elements := make(map[string]attr.Value)
+	for _, element := range plan.Names.Elements() {
+		name, ok := element.(types.String)
+		if !ok || name.IsUnknown() {
+			return
+		}
+		if id, ok := assigned[name.ValueString()]; ok {
+			elements[name.ValueString()] = types.Int64Value(id)
+		} else {
+			elements[name.ValueString()] = types.Int64Unknown()
+		}
+	}
+
+	ids, diags := types.MapValue(types.Int64Type, elements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ids"), ids)...)
+}
+
+// Create allocates an ID for every name.
+func (r *NATDescriptorPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NATDescriptorPoolModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.allocate(ctx, &data, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps the allocation: it only exists in Terraform state.
+func (r *NATDescriptorPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NATDescriptorPoolModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update keeps the IDs of remaining names and allocates IDs for added names.
+func (r *NATDescriptorPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state NATDescriptorPoolModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assigned := map[string]int64{}
+	resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &assigned, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.allocate(ctx, &data, assigned, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete releases the allocated IDs. Nothing is sent to the router.
+func (r *NATDescriptorPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// allocate sets ids for the names of data, keeping the assigned IDs and
+// picking new ones among the descriptor IDs the router does not use.
+func (r *NATDescriptorPoolResource) allocate(ctx context.Context, data *NATDescriptorPoolModel, assigned map[string]int64, diagnostics *diag.Diagnostics) {
+	logger := logging.FromContext(ctx)
+
+	var names []string
+	diagnostics.Append(data.Names.ElementsAs(ctx, &names, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	used := make(map[int64]bool)
+	pending := false
+	for _, name := range names {
+		if _, ok := assigned[name]; !ok {
+			pending = true
+			break
+		}
+	}
+	if pending {
+		descriptors, err := r.client.ListNATDescriptors(ctx)
+		if err != nil {
+			diagnostics.AddError(
+				"Failed to read NAT descriptors",
+				fmt.Sprintf("Could not read the NAT descriptors configured on the router: %v", err),
+			)
+			return
+		}
+		for _, desc := range descriptors {
+			used[int64(desc.ID)] = true
+		}
+	}
+
+	ids, err := allocateIDs(assigned, names, used, data.RangeStart.ValueInt64(), data.RangeEnd.ValueInt64())
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("names"), "NAT descriptor pool exhausted", err.Error())
+		return
+	}
+	logger.Debug().Str("resource", "rtx_nat_descriptor_pool").Msgf("Allocated NAT descriptor IDs: %v", ids)
+
+	value, diags := types.MapValueFrom(ctx, types.Int64Type, ids)
+	diagnostics.Append(diags...)
+	data.IDs = value
+}
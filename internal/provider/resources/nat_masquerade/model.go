@@ -2,24 +2,53 @@ package nat_masquerade
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/customtypes"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
 )
 
 // NATMasqueradeModel describes the resource data model.
 type NATMasqueradeModel struct {
-	ID           types.String `tfsdk:"id"`
-	DescriptorID types.Int64  `tfsdk:"descriptor_id"`
-	OuterAddress types.String `tfsdk:"outer_address"`
-	InnerNetwork types.String `tfsdk:"inner_network"`
-	StaticEntry  types.List   `tfsdk:"static_entry"`
+	ID           types.String             `tfsdk:"id"`
+	DescriptorID types.Int64              `tfsdk:"descriptor_id"`
+	OuterAddress types.String             `tfsdk:"outer_address"`
+	InnerNetwork customtypes.AddressRange `tfsdk:"inner_network"`
+	StaticEntry  types.Set                `tfsdk:"static_entry"`
+
+	SIP                     types.String `tfsdk:"sip"`
+	FTPPorts                types.List   `tfsdk:"ftp_ports"`
+	UnconvertiblePort       types.List   `tfsdk:"unconvertible_port"`
+	UnconvertibleIfPossible types.Bool   `tfsdk:"unconvertible_if_possible"`
+	Rlogin                  types.Bool   `tfsdk:"rlogin"`
+	Force                   types.Bool   `tfsdk:"force"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
+// UnconvertiblePortModel describes the unconvertible port nested block model.
+type UnconvertiblePortModel struct {
+	Protocol types.String `tfsdk:"protocol"`
+	Port     types.String `tfsdk:"port"`
+}
+
+// UnconvertiblePortAttrTypes returns the attribute types for UnconvertiblePortModel.
+func UnconvertiblePortAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"protocol": types.StringType,
+		"port":     types.StringType,
+	}
+}
+
+// sipDefault is the router default for "nat descriptor sip", which is not
+// shown in the running config.
+const sipDefault = "auto"
+
 // StaticEntryModel describes the static entry nested block model.
 type StaticEntryModel struct {
 	EntryNumber       types.Int64  `tfsdk:"entry_number"`
@@ -42,6 +71,30 @@ func StaticEntryAttrTypes() map[string]attr.Type {
 	}
 }
 
+// toClient converts a static entry to a client.MasqueradeStaticEntry. An
+// entry without entry number has number 0, which is assigned when applied.
+func (e StaticEntryModel) toClient() client.MasqueradeStaticEntry {
+	entry := client.MasqueradeStaticEntry{
+		EntryNumber:   fwhelpers.GetInt64Value(e.EntryNumber),
+		InsideLocal:   fwhelpers.GetStringValue(e.InsideLocal),
+		OutsideGlobal: fwhelpers.GetStringValue(e.OutsideGlobal),
+		Protocol:      fwhelpers.GetStringValue(e.Protocol),
+	}
+
+	// Handle optional port fields
+	if !e.InsideLocalPort.IsNull() && !e.InsideLocalPort.IsUnknown() {
+		port := int(e.InsideLocalPort.ValueInt64())
+		entry.InsideLocalPort = &port
+	}
+
+	if !e.OutsideGlobalPort.IsNull() && !e.OutsideGlobalPort.IsUnknown() {
+		port := int(e.OutsideGlobalPort.ValueInt64())
+		entry.OutsideGlobalPort = &port
+	}
+
+	return entry
+}
+
 // ToClient converts the Terraform model to a client.NATMasquerade.
 func (m *NATMasqueradeModel) ToClient(ctx context.Context) (client.NATMasquerade, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -49,7 +102,7 @@ func (m *NATMasqueradeModel) ToClient(ctx context.Context) (client.NATMasquerade
 	nat := client.NATMasquerade{
 		DescriptorID: fwhelpers.GetInt64Value(m.DescriptorID),
 		OuterAddress: fwhelpers.GetStringValue(m.OuterAddress),
-		InnerNetwork: fwhelpers.GetStringValue(m.InnerNetwork),
+		InnerNetwork: fwhelpers.GetStringValue(m.InnerNetwork.StringValue),
 	}
 
 	// Convert static entries
@@ -62,22 +115,30 @@ func (m *NATMasqueradeModel) ToClient(ctx context.Context) (client.NATMasquerade
 
 		nat.StaticEntries = make([]client.MasqueradeStaticEntry, len(entries))
 		for i, entry := range entries {
-			nat.StaticEntries[i] = client.MasqueradeStaticEntry{
-				EntryNumber:   fwhelpers.GetInt64Value(entry.EntryNumber),
-				InsideLocal:   fwhelpers.GetStringValue(entry.InsideLocal),
-				OutsideGlobal: fwhelpers.GetStringValue(entry.OutsideGlobal),
-				Protocol:      fwhelpers.GetStringValue(entry.Protocol),
-			}
+			nat.StaticEntries[i] = entry.toClient()
+		}
+	}
 
-			// Handle optional port fields
-			if !entry.InsideLocalPort.IsNull() && !entry.InsideLocalPort.IsUnknown() {
-				port := int(entry.InsideLocalPort.ValueInt64())
-				nat.StaticEntries[i].InsideLocalPort = &port
-			}
+	// "auto" is the router default, so it is represented by omitting the command
+	if sip := fwhelpers.GetStringValue(m.SIP); sip != sipDefault {
+		nat.SIP = sip
+	}
+	nat.FTPPorts = fwhelpers.ListToIntSlice(m.FTPPorts)
+	nat.UnconvertibleIfPossible = fwhelpers.GetBoolValue(m.UnconvertibleIfPossible)
+	nat.Rlogin = fwhelpers.GetBoolValue(m.Rlogin)
 
-			if !entry.OutsideGlobalPort.IsNull() && !entry.OutsideGlobalPort.IsUnknown() {
-				port := int(entry.OutsideGlobalPort.ValueInt64())
-				nat.StaticEntries[i].OutsideGlobalPort = &port
+	if !m.UnconvertiblePort.IsNull() && !m.UnconvertiblePort.IsUnknown() {
+		var ports []UnconvertiblePortModel
+		diags.Append(m.UnconvertiblePort.ElementsAs(ctx, &ports, false)...)
+		if diags.HasError() {
+			return nat, diags
+		}
+
+		nat.UnconvertiblePorts = make([]client.MasqueradeUnconvertiblePort, len(ports))
+		for i, port := range ports {
+			nat.UnconvertiblePorts[i] = client.MasqueradeUnconvertiblePort{
+				Protocol: strings.ToLower(fwhelpers.GetStringValue(port.Protocol)),
+				Port:     fwhelpers.GetStringValue(port.Port),
 			}
 		}
 	}
@@ -91,14 +152,21 @@ func (m *NATMasqueradeModel) FromClient(ctx context.Context, nat *client.NATMasq
 
 	m.DescriptorID = types.Int64Value(int64(nat.DescriptorID))
 	m.OuterAddress = types.StringValue(nat.OuterAddress)
-	m.InnerNetwork = fwhelpers.StringValueOrNull(nat.InnerNetwork)
+	m.InnerNetwork = customtypes.AddressRangeValueOrNull(nat.InnerNetwork)
 
 	// Convert static entries
 	if len(nat.StaticEntries) > 0 {
+		unnumbered := m.unnumberedStaticEntries(ctx, &diags)
 		entries := make([]attr.Value, len(nat.StaticEntries))
 		for i, entry := range nat.StaticEntries {
+			entryNumber := types.Int64Value(int64(entry.EntryNumber))
+			if key := client.MasqueradeStaticEntryKey(entry); unnumbered[key] {
+				// The entry number was assigned by the provider, not configured
+				entryNumber = types.Int64Null()
+				delete(unnumbered, key)
+			}
 			entryMap := map[string]attr.Value{
-				"entry_number":        types.Int64Value(int64(entry.EntryNumber)),
+				"entry_number":        entryNumber,
 				"inside_local":        types.StringValue(entry.InsideLocal),
 				"inside_local_port":   types.Int64Null(),
 				"outside_global":      types.StringValue(entry.OutsideGlobal),
@@ -119,12 +187,61 @@ func (m *NATMasqueradeModel) FromClient(ctx context.Context, nat *client.NATMasq
 			entries[i] = objVal
 		}
 
-		listVal, listDiags := types.ListValue(types.ObjectType{AttrTypes: StaticEntryAttrTypes()}, entries)
+		setVal, setDiags := types.SetValue(types.ObjectType{AttrTypes: StaticEntryAttrTypes()}, entries)
+		diags.Append(setDiags...)
+		m.StaticEntry = setVal
+	} else {
+		m.StaticEntry = types.SetNull(types.ObjectType{AttrTypes: StaticEntryAttrTypes()})
+	}
+
+	if nat.SIP != "" {
+		m.SIP = types.StringValue(nat.SIP)
+	} else {
+		m.SIP = types.StringValue(sipDefault)
+	}
+	if len(nat.FTPPorts) > 0 {
+		m.FTPPorts = fwhelpers.IntSliceToList(nat.FTPPorts)
+	} else {
+		m.FTPPorts = types.ListNull(types.Int64Type)
+	}
+	m.UnconvertibleIfPossible = types.BoolValue(nat.UnconvertibleIfPossible)
+	m.Rlogin = types.BoolValue(nat.Rlogin)
+
+	if len(nat.UnconvertiblePorts) > 0 {
+		ports := make([]attr.Value, len(nat.UnconvertiblePorts))
+		for i, port := range nat.UnconvertiblePorts {
+			objVal, objDiags := types.ObjectValue(UnconvertiblePortAttrTypes(), map[string]attr.Value{
+				"protocol": types.StringValue(port.Protocol),
+				"port":     types.StringValue(port.Port),
+			})
+			diags.Append(objDiags...)
+			ports[i] = objVal
+		}
+
+		listVal, listDiags := types.ListValue(types.ObjectType{AttrTypes: UnconvertiblePortAttrTypes()}, ports)
 		diags.Append(listDiags...)
-		m.StaticEntry = listVal
+		m.UnconvertiblePort = listVal
 	} else {
-		m.StaticEntry = types.ListNull(types.ObjectType{AttrTypes: StaticEntryAttrTypes()})
+		m.UnconvertiblePort = types.ListNull(types.ObjectType{AttrTypes: UnconvertiblePortAttrTypes()})
 	}
 
 	return diags
 }
+
+// unnumberedStaticEntries returns the content keys of the static entries of
+// the model that have no entry number.
+func (m *NATMasqueradeModel) unnumberedStaticEntries(ctx context.Context, diags *diag.Diagnostics) map[string]bool {
+	unnumbered := make(map[string]bool)
+	if m.StaticEntry.IsNull() || m.StaticEntry.IsUnknown() {
+		return unnumbered
+	}
+
+	var entries []StaticEntryModel
+	diags.Append(m.StaticEntry.ElementsAs(ctx, &entries, false)...)
+	for _, entry := range entries {
+		if entry.EntryNumber.IsNull() {
+			unnumbered[client.MasqueradeStaticEntryKey(entry.toClient())] = true
+		}
+	}
+	return unnumbered
+}
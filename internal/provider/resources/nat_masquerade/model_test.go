@@ -0,0 +1,80 @@
+package nat_masquerade
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func staticEntryValue(t *testing.T, number *int64, insideLocal string, port int64) attr.Value {
+	t.Helper()
+	entryNumber := types.Int64Null()
+	if number != nil {
+		entryNumber = types.Int64Value(*number)
+	}
+	obj, diags := types.ObjectValue(StaticEntryAttrTypes(), map[string]attr.Value{
+		"entry_number":        entryNumber,
+		"inside_local":        types.StringValue(insideLocal),
+		"inside_local_port":   types.Int64Value(port),
+		"outside_global":      types.StringValue("ipcp"),
+		"outside_global_port": types.Int64Value(port),
+		"protocol":            types.StringValue("tcp"),
+	})
+	if diags.HasError() {
+		t.Fatalf("ObjectValue returned errors: %v", diags.Errors())
+	}
+	return obj
+}
+
+func TestFromClient_StaticEntryNumbers(t *testing.T) {
+	ctx := context.Background()
+	explicit := int64(10)
+
+	prior, diags := types.SetValue(types.ObjectType{AttrTypes: StaticEntryAttrTypes()}, []attr.Value{
+		staticEntryValue(t, nil, "192.168.1.10", 80),
+		staticEntryValue(t, &explicit, "192.168.1.11", 443),
+	})
+	if diags.HasError() {
+		t.Fatalf("SetValue returned errors: %v", diags.Errors())
+	}
+	m := &NATMasqueradeModel{StaticEntry: prior}
+
+	diags = m.FromClient(ctx, &client.NATMasquerade{
+		DescriptorID: 1,
+		OuterAddress: "ipcp",
+		StaticEntries: []client.MasqueradeStaticEntry{
+			{EntryNumber: 1, InsideLocal: "192.168.1.10", InsideLocalPort: intPtr(80), OutsideGlobal: "ipcp", OutsideGlobalPort: intPtr(80), Protocol: "tcp"},
+			{EntryNumber: 10, InsideLocal: "192.168.1.11", InsideLocalPort: intPtr(443), OutsideGlobal: "ipcp", OutsideGlobalPort: intPtr(443), Protocol: "tcp"},
+			{EntryNumber: 2, InsideLocal: "192.168.1.12", InsideLocalPort: intPtr(25), OutsideGlobal: "ipcp", OutsideGlobalPort: intPtr(25), Protocol: "tcp"},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("FromClient returned errors: %v", diags.Errors())
+	}
+
+	var entries []StaticEntryModel
+	if diags := m.StaticEntry.ElementsAs(ctx, &entries, false); diags.HasError() {
+		t.Fatalf("ElementsAs returned errors: %v", diags.Errors())
+	}
+	want := map[string]types.Int64{
+		"192.168.1.10": types.Int64Null(),    // configured without number
+		"192.168.1.11": types.Int64Value(10), // configured with number
+		"192.168.1.12": types.Int64Value(2),  // only on the router
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d static entries, want %d", len(entries), len(want))
+	}
+	for _, entry := range entries {
+		if got := entry.EntryNumber; !got.Equal(want[entry.InsideLocal.ValueString()]) {
+			t.Errorf("entry %s: entry_number = %s, want %s", entry.InsideLocal.ValueString(), got, want[entry.InsideLocal.ValueString()])
+		}
+	}
+}
@@ -3,32 +3,38 @@ package nat_masquerade
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/sh1/terraform-provider-rtx/internal/client"
 	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/customtypes"
 	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
 	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NATMasqueradeResource{}
-	_ resource.ResourceWithImportState = &NATMasqueradeResource{}
+	_ resource.Resource                 = &NATMasqueradeResource{}
+	_ resource.ResourceWithImportState  = &NATMasqueradeResource{}
+	_ resource.ResourceWithUpgradeState = &NATMasqueradeResource{}
 )
 
 // NewNATMasqueradeResource creates a new NAT masquerade resource.
@@ -49,6 +55,7 @@ func (r *NATMasqueradeResource) Metadata(ctx context.Context, req resource.Metad
 // Schema defines the schema for the resource.
 func (r *NATMasqueradeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1, // Incremented for static_entry schema change (list -> set)
 		Description: "Manages NAT masquerade (PAT/NAPT) configurations on RTX routers. NAT masquerade allows multiple internal hosts to share a single external IP address using port address translation.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -73,21 +80,54 @@ func (r *NATMasqueradeResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"inner_network": schema.StringAttribute{
-				Description: "Inner (internal) network range in format 'start_ip-end_ip' (e.g., '192.168.1.0-192.168.1.255').",
+				Description: "Inner (internal) network range in format 'start_ip-end_ip' (e.g., '192.168.1.0-192.168.1.255') or CIDR notation (e.g., '192.168.1.0/24'). Both forms of the same network are treated as equal.",
+				CustomType:  customtypes.AddressRangeType{},
 				Optional:    true,
 			},
+			"sip": schema.StringAttribute{
+				Description: "Rewrite IP addresses inside SIP messages: 'on', 'off', or 'auto' (follows the 'sip use' setting). Defaults to 'auto'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(sipDefault),
+				Validators: []validator.String{
+					stringvalidator.OneOf("on", "off", "auto"),
+				},
+			},
+			"ftp_ports": schema.ListAttribute{
+				Description: "TCP ports recognized as FTP control connections. The router uses port 21 when omitted.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueInt64sAre(int64validator.Between(1, 65535)),
+				},
+			},
+			"unconvertible_if_possible": schema.BoolAttribute{
+				Description: "Keep the original source port when it is not already in use by another session.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"rlogin": schema.BoolAttribute{
+				Description: "Allow rlogin, rcp, and ssh to pass through the masquerade.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"force":            fwhelpers.ForceDeleteAttribute("NAT descriptor"),
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
-			"static_entry": schema.ListNestedBlock{
-				Description: "Static port mapping entries for port forwarding.",
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(100),
+			"static_entry": schema.SetNestedBlock{
+				Description: "Static port mapping entries for port forwarding. Entries are identified by their content, so reordering them changes nothing on the router.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(100),
 				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"entry_number": schema.Int64Attribute{
-							Description: "Entry number for identification.",
-							Required:    true,
+							Description: "Entry number on the router. When omitted, the entry is identified by its content: it keeps the number of an identical entry on the router, or takes the lowest free number.",
+							Optional:    true,
 							Validators: []validator.Int64{
 								int64validator.AtLeast(1),
 							},
@@ -126,6 +166,27 @@ func (r *NATMasqueradeResource) Schema(ctx context.Context, req resource.SchemaR
 					},
 				},
 			},
+			"unconvertible_port": schema.ListNestedBlock{
+				Description: "Port ranges that the masquerade must not convert.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"protocol": schema.StringAttribute{
+							Description: "Protocol: 'tcp' or 'udp'.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("tcp", "udp"),
+							},
+						},
+						"port": schema.StringAttribute{
+							Description: "Port number or range (e.g., '1024' or '1024-65535').",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^\d+(-\d+)?$`), "must be a port number or range like '1024-65535'"),
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -170,6 +231,11 @@ func (r *NATMasqueradeResource) Create(ctx context.Context, req resource.CreateR
 	logger.Debug().Str("resource", "rtx_nat_masquerade").Msgf("Creating NAT Masquerade: %+v", nat)
 
 	if err := r.client.CreateNATMasquerade(ctx, nat); err != nil {
+		if fwhelpers.RecordPartialApply(ctx, resp.Private, "NAT masquerade "+descriptorID, true, err, &resp.Diagnostics) {
+			data.ID = types.StringValue(descriptorID)
+			r.readPartial(ctx, &data, &resp.State, &resp.Diagnostics)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Failed to create NAT masquerade",
 			fmt.Sprintf("Could not create NAT masquerade: %v", err),
@@ -270,6 +336,19 @@ func (r *NATMasqueradeResource) read(ctx context.Context, data *NATMasqueradeMod
 	data.ID = types.StringValue(strconv.Itoa(nat.DescriptorID))
 }
 
+// readPartial stores the state of a partially applied NAT masquerade as read
+// back from the router, so that it records the static entries that were
+// written and not the planned ones.
+func (r *NATMasqueradeResource) readPartial(ctx context.Context, data *NATMasqueradeModel, state *tfsdk.State, diagnostics *diag.Diagnostics) {
+	var readDiags diag.Diagnostics
+	r.read(ctx, data, &readDiags)
+	diagnostics.Append(readDiags...)
+	if readDiags.HasError() || data.ID.IsNull() {
+		return
+	}
+	diagnostics.Append(state.Set(ctx, data)...)
+}
+
 // convertParsedNATMasquerade converts a parser NATMasquerade to a client NATMasquerade.
 func convertParsedNATMasquerade(parsed *parsers.NATMasquerade) *client.NATMasquerade {
 	nat := &client.NATMasquerade{
@@ -277,6 +356,11 @@ func convertParsedNATMasquerade(parsed *parsers.NATMasquerade) *client.NATMasque
 		OuterAddress:  parsed.OuterAddress,
 		InnerNetwork:  parsed.InnerNetwork,
 		StaticEntries: make([]client.MasqueradeStaticEntry, len(parsed.StaticEntries)),
+
+		SIP:                     parsed.SIP,
+		FTPPorts:                parsed.FTPPorts,
+		UnconvertibleIfPossible: parsed.UnconvertibleIfPossible,
+		Rlogin:                  parsed.Rlogin,
 	}
 	for i, entry := range parsed.StaticEntries {
 		nat.StaticEntries[i] = client.MasqueradeStaticEntry{
@@ -288,6 +372,12 @@ func convertParsedNATMasquerade(parsed *parsers.NATMasquerade) *client.NATMasque
 			Protocol:          entry.Protocol,
 		}
 	}
+	for _, port := range parsed.UnconvertiblePorts {
+		nat.UnconvertiblePorts = append(nat.UnconvertiblePorts, client.MasqueradeUnconvertiblePort{
+			Protocol: port.Protocol,
+			Port:     port.Port,
+		})
+	}
 	return nat
 }
 
@@ -313,12 +403,17 @@ func (r *NATMasqueradeResource) Update(ctx context.Context, req resource.UpdateR
 	logger.Debug().Str("resource", "rtx_nat_masquerade").Msgf("Updating NAT Masquerade: %+v", nat)
 
 	if err := r.client.UpdateNATMasquerade(ctx, nat); err != nil {
+		if fwhelpers.RecordPartialApply(ctx, resp.Private, "NAT masquerade "+descriptorID, false, err, &resp.Diagnostics) {
+			r.readPartial(ctx, &data, &resp.State, &resp.Diagnostics)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Failed to update NAT masquerade",
 			fmt.Sprintf("Could not update NAT masquerade: %v", err),
 		)
 		return
 	}
+	fwhelpers.ClearPendingEntries(ctx, resp.Private, &resp.Diagnostics)
 
 	r.read(ctx, &data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -343,6 +438,14 @@ func (r *NATMasqueradeResource) Delete(ctx context.Context, req resource.DeleteR
 
 	logger.Debug().Str("resource", "rtx_nat_masquerade").Msgf("Deleting NAT Masquerade: %d", descriptorID)
 
+	fwhelpers.CheckNotInUse(ctx, r.client, data.Force, fmt.Sprintf("NAT descriptor %d", descriptorID),
+		func(pc *parsers.ParsedConfig) []parsers.InterfaceReference {
+			return pc.FindNATDescriptorReferences(descriptorID)
+		}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.DeleteNATMasquerade(ctx, descriptorID); err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			return
@@ -360,29 +463,25 @@ func (r *NATMasqueradeResource) ImportState(ctx context.Context, req resource.Im
 	importID := req.ID
 
 	// Parse import ID as descriptor_id
-	descriptorID, err := strconv.Atoi(importID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			fmt.Sprintf("Invalid import ID format, expected descriptor_id (e.g., '1'): %v", err),
-		)
-		return
-	}
-
-	if descriptorID < 1 || descriptorID > 65535 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			fmt.Sprintf("descriptor_id must be between 1 and 65535, got %d", descriptorID),
-		)
+	descriptorID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, importID, "descriptor_id", 1, 65535)
+	if !ok {
 		return
 	}
 
-	ctx = logging.WithResource(ctx, "rtx_nat_masquerade", strconv.Itoa(descriptorID))
+	ctx = logging.WithResource(ctx, "rtx_nat_masquerade", importID)
 	logger := logging.FromContext(ctx)
 
 	logger.Debug().Str("resource", "rtx_nat_masquerade").Msgf("Importing NAT Masquerade: %d", descriptorID)
 
 	// Set both id and descriptor_id
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("descriptor_id"), int64(descriptorID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("descriptor_id"), descriptorID)...)
+}
+
+// UpgradeState handles state upgrades from previous schema versions.
+func (r *NATMasqueradeResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 -> 1: static_entry changed from a list to a set of the same entries
+		0: fwhelpers.RawStateUpgrader(nil),
+	}
 }
@@ -12,6 +12,9 @@ import (
 type NATStaticModel struct {
 	DescriptorID types.Int64 `tfsdk:"descriptor_id"`
 	Entry        types.List  `tfsdk:"entry"`
+	Force        types.Bool  `tfsdk:"force"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // NATStaticEntryModel describes a single static NAT entry.
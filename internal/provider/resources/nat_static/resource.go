@@ -61,6 +61,8 @@ func (r *NATStaticResource) Schema(ctx context.Context, req resource.SchemaReque
 					int64validator.Between(1, 65535),
 				},
 			},
+			"force":            fwhelpers.ForceDeleteAttribute("NAT descriptor"),
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"entry": schema.ListNestedBlock{
@@ -338,6 +340,14 @@ func (r *NATStaticResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	logger.Debug().Str("resource", "rtx_nat_static").Msgf("Deleting NAT static: %d", descriptorID)
 
+	fwhelpers.CheckNotInUse(ctx, r.client, data.Force, fmt.Sprintf("NAT descriptor %d", descriptorID),
+		func(pc *parsers.ParsedConfig) []parsers.InterfaceReference {
+			return pc.FindNATDescriptorReferences(descriptorID)
+		}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.DeleteNATStatic(ctx, descriptorID); err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			return
@@ -354,22 +364,11 @@ func (r *NATStaticResource) Delete(ctx context.Context, req resource.DeleteReque
 func (r *NATStaticResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	importID := req.ID
 
-	descriptorID, err := strconv.Atoi(importID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			fmt.Sprintf("Invalid import ID format, expected descriptor_id (integer), got %q: %v", importID, err),
-		)
-		return
-	}
-
-	if descriptorID < 1 || descriptorID > 65535 {
-		resp.Diagnostics.AddError(
-			"Invalid descriptor_id",
-			fmt.Sprintf("descriptor_id must be between 1 and 65535, got %d", descriptorID),
-		)
+	id, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, importID, "descriptor_id", 1, 65535)
+	if !ok {
 		return
 	}
+	descriptorID := int(id)
 
 	logging.FromContext(ctx).Debug().Str("resource", "rtx_nat_static").Msgf("Importing NAT static: %d", descriptorID)
 
@@ -385,6 +384,7 @@ func (r *NATStaticResource) ImportState(ctx context.Context, req resource.Import
 
 	var data NATStaticModel
 	data.FromClient(nat)
+	data.AppliedCommands = types.ListNull(types.StringType)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("descriptor_id"), types.Int64Value(int64(descriptorID)))...)
@@ -15,6 +15,8 @@ type NetVolanteDNSModel struct {
 	Timeout      types.Int64  `tfsdk:"timeout"`
 	IPv6Enabled  types.Bool   `tfsdk:"ipv6_enabled"`
 	AutoHostname types.Bool   `tfsdk:"auto_hostname"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.NetVolanteConfig.
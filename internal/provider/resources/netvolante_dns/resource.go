@@ -89,6 +89,7 @@ func (r *NetVolanteDNSResource) Schema(ctx context.Context, req resource.SchemaR
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
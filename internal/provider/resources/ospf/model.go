@@ -22,6 +22,8 @@ type OSPFModel struct {
 	Neighbors                   types.List   `tfsdk:"neighbor"`
 	RedistributeStatic          types.Bool   `tfsdk:"redistribute_static"`
 	RedistributeConnected       types.Bool   `tfsdk:"redistribute_connected"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // NetworkModel describes a network block within the OSPF resource.
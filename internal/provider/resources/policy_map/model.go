@@ -12,6 +12,8 @@ import (
 type PolicyMapModel struct {
 	Name    types.String `tfsdk:"name"`
 	Classes types.List   `tfsdk:"class"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // PolicyMapClassModel describes a class within a policy map.
@@ -30,6 +30,9 @@ var (
 	_ resource.ResourceWithModifyPlan  = &PolicyMapResource{}
 )
 
+// namePattern matches valid policy map names
+var namePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+
 // NewPolicyMapResource creates a new policy map resource.
 func NewPolicyMapResource() resource.Resource {
 	return &PolicyMapResource{}
@@ -58,11 +61,12 @@ func (r *PolicyMapResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`),
+						namePattern,
 						"must start with a letter and contain only alphanumeric characters, underscores, and hyphens",
 					),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"class": schema.ListNestedBlock{
@@ -313,5 +317,10 @@ func (r *PolicyMapResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // ImportState imports an existing resource into Terraform.
 func (r *PolicyMapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !namePattern.MatchString(req.ID) {
+		fwhelpers.AddImportIDError(&resp.Diagnostics, req.ID, "the policy map name (e.g., 'wan-qos')")
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
@@ -0,0 +1,57 @@
+package port_mirroring
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// PortMirroringModel describes the resource data model.
+type PortMirroringModel struct {
+	Interface       types.String `tfsdk:"interface"`
+	DestinationPort types.Int64  `tfsdk:"destination_port"`
+	SourcePorts     types.Set    `tfsdk:"source_ports"`
+	Direction       types.String `tfsdk:"direction"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.PortMirroring.
+func (m *PortMirroringModel) ToClient(ctx context.Context) (client.PortMirroring, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	session := client.PortMirroring{
+		Interface:       fwhelpers.GetStringValue(m.Interface),
+		DestinationPort: fwhelpers.GetInt64Value(m.DestinationPort),
+		Direction:       fwhelpers.GetStringValue(m.Direction),
+	}
+
+	if !m.SourcePorts.IsNull() && !m.SourcePorts.IsUnknown() {
+		var ports []int64
+		diags.Append(m.SourcePorts.ElementsAs(ctx, &ports, false)...)
+		for _, p := range ports {
+			session.SourcePorts = append(session.SourcePorts, int(p))
+		}
+	}
+
+	return session, diags
+}
+
+// FromClient updates the Terraform model from a client.PortMirroring.
+func (m *PortMirroringModel) FromClient(ctx context.Context, session *client.PortMirroring) diag.Diagnostics {
+	m.Interface = types.StringValue(session.Interface)
+	m.DestinationPort = types.Int64Value(int64(session.DestinationPort))
+	m.Direction = types.StringValue(session.Direction)
+
+	ports := make([]int64, len(session.SourcePorts))
+	for i, p := range session.SourcePorts {
+		ports[i] = int64(p)
+	}
+	sourcePorts, diags := types.SetValueFrom(ctx, types.Int64Type, ports)
+	m.SourcePorts = sourcePorts
+
+	return diags
+}
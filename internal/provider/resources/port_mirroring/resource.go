@@ -0,0 +1,295 @@
+package port_mirroring
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &PortMirroringResource{}
+	_ resource.ResourceWithImportState = &PortMirroringResource{}
+)
+
+var interfacePattern = regexp.MustCompile(`^lan\d+$`)
+
+// NewPortMirroringResource creates a new port mirroring resource.
+func NewPortMirroringResource() resource.Resource {
+	return &PortMirroringResource{}
+}
+
+// PortMirroringResource defines the resource implementation.
+type PortMirroringResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *PortMirroringResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_port_mirroring"
+}
+
+// Schema defines the schema for the resource.
+func (r *PortMirroringResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a port mirroring session on the switching hub of a LAN interface ('lan port-mirroring'). " +
+			"Frames of the source ports are copied to the destination port, where a packet capture host can be attached. " +
+			"Destroy the resource when the capture is finished to stop mirroring.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description: "LAN interface whose switching hub ports are mirrored (e.g., 'lan1').",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(interfacePattern, "must be a LAN interface (e.g., 'lan1')"),
+				},
+			},
+			"destination_port": schema.Int64Attribute{
+				Description: "Switch port number that receives the mirrored frames.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"source_ports": schema.SetAttribute{
+				Description: "Switch port numbers whose traffic is mirrored. Must not include destination_port.",
+				ElementType: types.Int64Type,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+			"direction": schema.StringAttribute{
+				Description: "Traffic direction to mirror: 'in' (received), 'out' (transmitted), or 'both'. Defaults to 'both'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("both"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidPortMirroringDirections...),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *PortMirroringResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *PortMirroringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PortMirroringModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_port_mirroring", data.Interface.ValueString())
+	logger := logging.FromContext(ctx)
+
+	session, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	logger.Debug().Str("resource", "rtx_port_mirroring").Msgf("Creating port mirroring: %+v", session)
+
+	if err := r.client.CreatePortMirroring(ctx, session); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create port mirroring",
+			fmt.Sprintf("Could not configure port mirroring on %s: %v", session.Interface, err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *PortMirroringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PortMirroringModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.Interface.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the mirroring session from the router.
+func (r *PortMirroringResource) read(ctx context.Context, data *PortMirroringModel, diagnostics *diag.Diagnostics) {
+	iface := data.Interface.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_port_mirroring", iface)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_port_mirroring").Msgf("Reading port mirroring on %s", iface)
+
+	var session *client.PortMirroring
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			for _, parsed := range parsedConfig.ExtractPortMirrorings() {
+				if parsed.Interface == iface {
+					converted := client.PortMirroring(parsed)
+					session = &converted
+					logger.Debug().Str("resource", "rtx_port_mirroring").Msg("Found port mirroring in SFTP cache")
+					break
+				}
+			}
+		}
+		if session == nil {
+			logger.Debug().Str("resource", "rtx_port_mirroring").Msg("Port mirroring not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or session not found in cache
+	if session == nil {
+		var err error
+		session, err = r.client.GetPortMirroring(ctx, iface)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_port_mirroring").Msgf("Port mirroring on %s not found, removing from state", iface)
+				data.Interface = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read port mirroring", fmt.Sprintf("Could not read port mirroring on %s: %v", iface, err))
+			return
+		}
+	}
+
+	diagnostics.Append(data.FromClient(ctx, session)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *PortMirroringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PortMirroringModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_port_mirroring", data.Interface.ValueString())
+	logger := logging.FromContext(ctx)
+
+	session, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	logger.Debug().Str("resource", "rtx_port_mirroring").Msgf("Updating port mirroring: %+v", session)
+
+	if err := r.client.UpdatePortMirroring(ctx, session); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update port mirroring",
+			fmt.Sprintf("Could not update port mirroring on %s: %v", session.Interface, err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *PortMirroringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PortMirroringModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := data.Interface.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_port_mirroring", iface)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_port_mirroring").Msgf("Deleting port mirroring on %s", iface)
+
+	if err := r.client.DeletePortMirroring(ctx, iface); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete port mirroring",
+			fmt.Sprintf("Could not stop port mirroring on %s: %v", iface, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *PortMirroringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !interfacePattern.MatchString(req.ID) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Invalid import ID format: %s, expected a LAN interface name (e.g., 'lan1')", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface"), req.ID)...)
+}
@@ -18,6 +18,8 @@ type PPInterfaceModel struct {
 	TCPMSS        types.Int64  `tfsdk:"tcp_mss"`
 	NATDescriptor types.Int64  `tfsdk:"nat_descriptor"`
 	PPInterface   types.String `tfsdk:"pp_interface"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.PPIPConfig.
@@ -105,6 +105,7 @@ func (r *PPInterfaceResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -268,6 +269,10 @@ func (r *PPInterfaceResource) Delete(ctx context.Context, req resource.DeleteReq
 
 // ImportState imports an existing resource into Terraform.
 func (r *PPInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Passthrough the ID to the "id" attribute - Read will populate the rest
+	// The ID is the PP number - Read will populate the rest
+	if _, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "PP number", 1, 0); !ok {
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
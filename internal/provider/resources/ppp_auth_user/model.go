@@ -0,0 +1,44 @@
+package ppp_auth_user
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// PPPAuthUserModel describes the resource data model.
+type PPPAuthUserModel struct {
+	PP        types.String `tfsdk:"pp"`
+	Username  types.String `tfsdk:"username"`
+	Password  types.String `tfsdk:"password"`
+	IPAddress types.String `tfsdk:"ip_address"`
+
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.PPPAuthUser.
+func (m *PPPAuthUserModel) ToClient() client.PPPAuthUser {
+	return client.PPPAuthUser{
+		PP:        fwhelpers.GetStringValue(m.PP),
+		Username:  fwhelpers.GetStringValue(m.Username),
+		Password:  fwhelpers.GetStringValue(m.Password),
+		IPAddress: fwhelpers.GetStringValue(m.IPAddress),
+	}
+}
+
+// FromClient updates the Terraform model from a client.PPPAuthUser.
+func (m *PPPAuthUserModel) FromClient(user *client.PPPAuthUser) {
+	m.PP = types.StringValue(user.PP)
+	m.Username = types.StringValue(user.Username)
+	m.IPAddress = fwhelpers.StringValueOrNull(user.IPAddress)
+
+	// Note: Password is write-only - keep the configured value and only
+	// populate it from the router when importing
+	if (m.Password.IsNull() || m.Password.IsUnknown()) && m.PasswordWOVersion.IsNull() {
+		m.Password = types.StringValue(user.Password)
+	}
+}
@@ -0,0 +1,303 @@
+package ppp_auth_user
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/validation"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &PPPAuthUserResource{}
+	_ resource.ResourceWithImportState = &PPPAuthUserResource{}
+)
+
+var (
+	ppSelectorPattern = regexp.MustCompile(`^(anonymous|[1-9]\d*)$`)
+	credentialPattern = regexp.MustCompile(`^[^\s"]+$`)
+)
+
+// NewPPPAuthUserResource creates a new PP auth user resource.
+func NewPPPAuthUserResource() resource.Resource {
+	return &PPPAuthUserResource{}
+}
+
+// PPPAuthUserResource defines the resource implementation.
+type PPPAuthUserResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *PPPAuthUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ppp_auth_user"
+}
+
+// Schema defines the schema for the resource.
+func (r *PPPAuthUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single 'pp auth username' entry used to authenticate dial-in, PPTP and L2TP users. " +
+			"Each user is a separate resource so VPN users can be added and removed individually.",
+		Attributes: map[string]schema.Attribute{
+			"pp": schema.StringAttribute{
+				Description: "PP context holding the user: 'anonymous' (remote access VPN) or a PP number. Defaults to 'anonymous'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("anonymous"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(ppSelectorPattern, "must be 'anonymous' or a PP number"),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "Login username.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(credentialPattern, "must not contain whitespace or quotes"),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "Login password. This value is write-only and is not read back from the router. Exactly one of password and password_wo is required.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+					stringvalidator.RegexMatches(credentialPattern, "must not contain whitespace or quotes"),
+				},
+			},
+			"password_wo":         fwhelpers.WriteOnlySecretAttribute("password", stringvalidator.RegexMatches(credentialPattern, "must not contain whitespace or quotes")),
+			"password_wo_version": fwhelpers.WriteOnlyVersionAttribute("password"),
+			"ip_address": schema.StringAttribute{
+				Description: "Fixed IPv4 address assigned to the user when connected. If omitted, an address is taken from the remote address pool.",
+				Optional:    true,
+				Validators: []validator.String{
+					validation.IPv4AddressValidator(),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *PPPAuthUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *PPPAuthUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PPPAuthUserModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_ppp_auth_user", data.Username.ValueString())
+	logger := logging.FromContext(ctx)
+
+	user := data.ToClient()
+	user.Password = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("password_wo"), data.Password, &resp.Diagnostics)
+	logger.Debug().Str("resource", "rtx_ppp_auth_user").Msgf("Creating PP auth user %s in pp %s", user.Username, user.PP)
+
+	if err := r.client.CreatePPPAuthUser(ctx, user); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create PP auth user",
+			fmt.Sprintf("Could not create PP auth user: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *PPPAuthUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PPPAuthUserModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.Username.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the PP auth user from the router.
+func (r *PPPAuthUserResource) read(ctx context.Context, data *PPPAuthUserModel, diagnostics *diag.Diagnostics) {
+	pp := data.PP.ValueString()
+	username := data.Username.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_ppp_auth_user", username)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_ppp_auth_user").Msgf("Reading PP auth user %s in pp %s", username, pp)
+
+	var user *client.PPPAuthUser
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			for _, parsed := range parsedConfig.ExtractPPAuthUsers() {
+				if parsed.PP == pp && parsed.Username == username {
+					user = &client.PPPAuthUser{
+						PP:        parsed.PP,
+						Username:  parsed.Username,
+						Password:  parsed.Password,
+						IPAddress: parsed.IPAddress,
+					}
+					logger.Debug().Str("resource", "rtx_ppp_auth_user").Msg("Found PP auth user in SFTP cache")
+					break
+				}
+			}
+		}
+		if user == nil {
+			logger.Debug().Str("resource", "rtx_ppp_auth_user").Msg("PP auth user not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or user not found in cache
+	if user == nil {
+		var err error
+		user, err = r.client.GetPPPAuthUser(ctx, pp, username)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_ppp_auth_user").Msgf("PP auth user %s not found, removing from state", username)
+				data.Username = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read PP auth user", fmt.Sprintf("Could not read PP auth user %s: %v", username, err))
+			return
+		}
+	}
+
+	data.FromClient(user)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *PPPAuthUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PPPAuthUserModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_ppp_auth_user", data.Username.ValueString())
+	logger := logging.FromContext(ctx)
+
+	user := data.ToClient()
+	user.Password = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("password_wo"), data.Password, &resp.Diagnostics)
+	logger.Debug().Str("resource", "rtx_ppp_auth_user").Msgf("Updating PP auth user %s in pp %s", user.Username, user.PP)
+
+	if err := r.client.UpdatePPPAuthUser(ctx, user); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update PP auth user",
+			fmt.Sprintf("Could not update PP auth user: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *PPPAuthUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PPPAuthUserModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pp := data.PP.ValueString()
+	username := data.Username.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_ppp_auth_user", username)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_ppp_auth_user").Msgf("Deleting PP auth user %s in pp %s", username, pp)
+
+	if err := r.client.DeletePPPAuthUser(ctx, pp, username); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete PP auth user",
+			fmt.Sprintf("Could not delete PP auth user %s: %v", username, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *PPPAuthUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: pp:username
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 || !ppSelectorPattern.MatchString(parts[0]) || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Invalid import ID format: %s, expected 'pp:username' (e.g., 'anonymous:alice')", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pp"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), parts[1])...)
+}
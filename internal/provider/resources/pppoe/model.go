@@ -26,6 +26,11 @@ type PPPoEModel struct {
 	ReconnectAttempts types.Int64  `tfsdk:"reconnect_attempts"`
 	Enabled           types.Bool   `tfsdk:"enabled"`
 	PPInterface       types.String `tfsdk:"pp_interface"`
+
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.PPPoEConfig.
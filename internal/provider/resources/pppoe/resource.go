@@ -81,10 +81,15 @@ func (r *PPPoEResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:    true,
 			},
 			"password": schema.StringAttribute{
-				Description: "PPPoE authentication password.",
-				Required:    true,
+				Description: "PPPoE authentication password. Exactly one of password and password_wo is required.",
+				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				},
 			},
+			"password_wo":         fwhelpers.WriteOnlySecretAttribute("password"),
+			"password_wo_version": fwhelpers.WriteOnlyVersionAttribute("password"),
 			"service_name": schema.StringAttribute{
 				Description: "PPPoE service name (optional). Used to specify a particular service when multiple services are available.",
 				Optional:    true,
@@ -143,6 +148,7 @@ func (r *PPPoEResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -179,6 +185,7 @@ func (r *PPPoEResource) Create(ctx context.Context, req resource.CreateRequest,
 	logger := logging.FromContext(ctx)
 
 	config := data.ToClient()
+	config.Authentication.Password = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("password_wo"), data.Password, &resp.Diagnostics)
 	logger.Debug().Str("resource", "rtx_pppoe").Msgf("Creating PPPoE configuration for PP %d", config.Number)
 
 	if err := r.client.CreatePPPoE(ctx, config); err != nil {
@@ -260,6 +267,7 @@ func (r *PPPoEResource) Update(ctx context.Context, req resource.UpdateRequest,
 	logger := logging.FromContext(ctx)
 
 	config := data.ToClient()
+	config.Authentication.Password = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("password_wo"), data.Password, &resp.Diagnostics)
 	logger.Debug().Str("resource", "rtx_pppoe").Msgf("Updating PPPoE configuration for PP %d", config.Number)
 
 	if err := r.client.UpdatePPPoE(ctx, config); err != nil {
@@ -310,26 +318,14 @@ func (r *PPPoEResource) ImportState(ctx context.Context, req resource.ImportStat
 	importID := req.ID
 
 	// Parse PP number from import ID
-	ppNum, err := strconv.Atoi(importID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Invalid import ID format: expected PP number (e.g., '1'), got '%s'", importID),
-		)
-		return
-	}
-
-	if ppNum < 1 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			"Invalid PP number: must be >= 1",
-		)
+	ppNum, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, importID, "pp_number", 1, 0)
+	if !ok {
 		return
 	}
 
 	logging.FromContext(ctx).Debug().Str("resource", "rtx_pppoe").Msgf("Importing PPPoE configuration for PP %d", ppNum)
 
 	// Set the ID and pp_number attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.Itoa(ppNum))...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pp_number"), int64(ppNum))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pp_number"), ppNum)...)
 }
@@ -19,6 +19,8 @@ type PPTPModel struct {
 	DisconnectTime   types.Int64          `tfsdk:"disconnect_time"`
 	KeepaliveEnabled types.Bool           `tfsdk:"keepalive_enabled"`
 	Enabled          types.Bool           `tfsdk:"enabled"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // AuthenticationModel describes the authentication block.
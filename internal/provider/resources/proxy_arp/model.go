@@ -0,0 +1,40 @@
+package proxy_arp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+// ProxyARPModel describes the resource data model.
+type ProxyARPModel struct {
+	ID         types.String `tfsdk:"id"`
+	Interfaces types.Set    `tfsdk:"interfaces"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.ProxyARPConfig.
+func (m *ProxyARPModel) ToClient(ctx context.Context) (client.ProxyARPConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config := client.ProxyARPConfig{}
+
+	if !m.Interfaces.IsNull() && !m.Interfaces.IsUnknown() {
+		diags.Append(m.Interfaces.ElementsAs(ctx, &config.Interfaces, false)...)
+	}
+
+	return config, diags
+}
+
+// FromClient updates the Terraform model from a client.ProxyARPConfig.
+func (m *ProxyARPModel) FromClient(ctx context.Context, config *client.ProxyARPConfig) diag.Diagnostics {
+	m.ID = types.StringValue("proxy_arp")
+
+	interfaces, diags := types.SetValueFrom(ctx, types.StringType, config.Interfaces)
+	m.Interfaces = interfaces
+
+	return diags
+}
@@ -0,0 +1,252 @@
+package proxy_arp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ProxyARPResource{}
+	_ resource.ResourceWithImportState = &ProxyARPResource{}
+)
+
+var interfaceNamePattern = regexp.MustCompile(`^(lan\d+(/\d+)?|bridge\d+)$`)
+
+// NewProxyARPResource creates a new proxy ARP resource.
+func NewProxyARPResource() resource.Resource {
+	return &ProxyARPResource{}
+}
+
+// ProxyARPResource defines the resource implementation.
+type ProxyARPResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *ProxyARPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_proxy_arp"
+}
+
+// Schema defines the schema for the resource.
+func (r *ProxyARPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the set of interfaces that answer ARP requests on behalf of hosts reachable through other interfaces " +
+			"('ip <interface> proxyarp on'), for setups that share one address space across interfaces. This is a singleton resource: " +
+			"proxy ARP is disabled on every interface not listed. Do not combine with the proxyarp attribute of rtx_interface.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (always 'proxy_arp' for this singleton resource).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interfaces": schema.SetAttribute{
+				Description: "Interfaces with proxy ARP enabled (e.g., 'lan1', 'lan1/2', 'bridge1').",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(
+							interfaceNamePattern,
+							"must be a LAN or bridge interface (e.g., 'lan1', 'lan1/2', 'bridge1')",
+						),
+					),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProxyARPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ProxyARPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProxyARPModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_proxy_arp", "proxy_arp")
+	logger := logging.FromContext(ctx)
+
+	config, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger.Debug().Str("resource", "rtx_proxy_arp").Msgf("Enabling proxy ARP on %v", config.Interfaces)
+
+	if err := r.client.ConfigureProxyARP(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to configure proxy ARP",
+			fmt.Sprintf("Could not configure proxy ARP: %v", err),
+		)
+		return
+	}
+
+	// Set the ID for singleton resource
+	data.ID = types.StringValue("proxy_arp")
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProxyARPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProxyARPModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the proxy ARP interfaces from the router.
+func (r *ProxyARPResource) read(ctx context.Context, data *ProxyARPModel, diagnostics *diag.Diagnostics) {
+	ctx = logging.WithResource(ctx, "rtx_proxy_arp", "proxy_arp")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_proxy_arp").Msg("Reading proxy ARP configuration")
+
+	var config *client.ProxyARPConfig
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			converted := client.ProxyARPConfig(*parsedConfig.ExtractProxyARP())
+			config = &converted
+			logger.Debug().Str("resource", "rtx_proxy_arp").Msg("Found proxy ARP configuration in SFTP cache")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or cache unavailable
+	if config == nil {
+		var err error
+		config, err = r.client.GetProxyARP(ctx)
+		if err != nil {
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read proxy ARP configuration", fmt.Sprintf("Could not read proxy ARP configuration: %v", err))
+			return
+		}
+	}
+
+	if len(config.Interfaces) == 0 {
+		logger.Debug().Str("resource", "rtx_proxy_arp").Msg("Proxy ARP not enabled on any interface, removing from state")
+		data.ID = types.StringNull()
+		return
+	}
+
+	diagnostics.Append(data.FromClient(ctx, config)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProxyARPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProxyARPModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_proxy_arp", "proxy_arp")
+	logger := logging.FromContext(ctx)
+
+	config, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger.Debug().Str("resource", "rtx_proxy_arp").Msgf("Updating proxy ARP interfaces to %v", config.Interfaces)
+
+	if err := r.client.UpdateProxyARP(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update proxy ARP configuration",
+			fmt.Sprintf("Could not update proxy ARP configuration: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProxyARPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithResource(ctx, "rtx_proxy_arp", "proxy_arp")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_proxy_arp").Msg("Disabling proxy ARP on all interfaces")
+
+	if err := r.client.ResetProxyARP(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to reset proxy ARP configuration",
+			fmt.Sprintf("Could not reset proxy ARP configuration: %v", err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *ProxyARPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fwhelpers.ImportSingleton(ctx, "proxy_arp", req, resp)
+}
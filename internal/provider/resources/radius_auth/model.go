@@ -0,0 +1,69 @@
+package radius_auth
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// RADIUSAuthModel describes the resource data model.
+type RADIUSAuthModel struct {
+	ID          types.String `tfsdk:"id"`
+	AuthEnabled types.Bool   `tfsdk:"auth_enabled"`
+	Accounting  types.Bool   `tfsdk:"accounting"`
+	Servers     types.List   `tfsdk:"servers"`
+	Secret      types.String `tfsdk:"secret"`
+	Port        types.Int64  `tfsdk:"port"`
+	Retry       types.Int64  `tfsdk:"retry"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.RADIUSConfig.
+func (m *RADIUSAuthModel) ToClient(ctx context.Context) (client.RADIUSConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := client.RADIUSConfig{
+		Auth:       fwhelpers.GetBoolValue(m.AuthEnabled),
+		Accounting: fwhelpers.GetBoolValue(m.Accounting),
+		Secret:     fwhelpers.GetStringValue(m.Secret),
+		AuthPort:   fwhelpers.GetInt64Value(m.Port),
+		Retry:      fwhelpers.GetInt64Value(m.Retry),
+		Wait:       fwhelpers.GetInt64Value(m.Timeout),
+	}
+
+	if !m.Servers.IsNull() && !m.Servers.IsUnknown() {
+		diags.Append(m.Servers.ElementsAs(ctx, &config.Servers, false)...)
+	}
+
+	return config, diags
+}
+
+// FromClient updates the Terraform model from a client.RADIUSConfig.
+func (m *RADIUSAuthModel) FromClient(ctx context.Context, config *client.RADIUSConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue("radius_auth")
+	m.AuthEnabled = types.BoolValue(config.Auth)
+	m.Accounting = types.BoolValue(config.Accounting)
+	m.Port = types.Int64Value(int64(config.AuthPort))
+	m.Retry = types.Int64Value(int64(config.Retry))
+	m.Timeout = types.Int64Value(int64(config.Wait))
+
+	servers, d := types.ListValueFrom(ctx, types.StringType, config.Servers)
+	diags.Append(d...)
+	m.Servers = servers
+
+	// Note: Secret is write-only - keep the configured value and only
+	// populate it from the router when importing
+	if m.Secret.IsNull() || m.Secret.IsUnknown() {
+		m.Secret = types.StringValue(config.Secret)
+	}
+
+	return diags
+}
@@ -0,0 +1,330 @@
+package radius_auth
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/validation"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &RADIUSAuthResource{}
+	_ resource.ResourceWithImportState = &RADIUSAuthResource{}
+)
+
+var secretPattern = regexp.MustCompile(`^[^\s"]+$`)
+
+// NewRADIUSAuthResource creates a new RADIUS auth resource.
+func NewRADIUSAuthResource() resource.Resource {
+	return &RADIUSAuthResource{}
+}
+
+// RADIUSAuthResource defines the resource implementation.
+type RADIUSAuthResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *RADIUSAuthResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_radius_auth"
+}
+
+// Schema defines the schema for the resource.
+func (r *RADIUSAuthResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the RADIUS client configuration on RTX routers, used to authenticate PPP (dial-in, L2TP) and login users " +
+			"against external RADIUS servers. " +
+			"This is a singleton resource - only one instance can exist per router.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (always 'radius_auth' for this singleton resource).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auth_enabled": schema.BoolAttribute{
+				Description: "Enable RADIUS authentication ('radius auth on'). Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"accounting": schema.BoolAttribute{
+				Description: "Enable RADIUS accounting ('radius account on'). Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"servers": schema.ListAttribute{
+				Description: "RADIUS server IPv4 addresses. The first entry is the primary server; an optional second entry is used as backup.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 2),
+					listvalidator.ValueStringsAre(validation.IPv4AddressValidator()),
+				},
+			},
+			"secret": schema.StringAttribute{
+				Description: "Shared secret used with the RADIUS servers. This value is write-only and is not read back from the router.",
+				Required:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(secretPattern, "must not contain whitespace or quotes"),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description: "UDP port used for RADIUS authentication. Defaults to 1812.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1812),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"retry": schema.Int64Attribute{
+				Description: "Number of retransmissions before giving up on a server (1-10). Defaults to 4.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(4),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "Seconds to wait for a reply from a server ('radius wait', 1-30). Defaults to 9.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(9),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 30),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *RADIUSAuthResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *RADIUSAuthResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RADIUSAuthModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_radius_auth", "radius_auth")
+	logger := logging.FromContext(ctx)
+
+	config, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger.Debug().Str("resource", "rtx_radius_auth").Msgf("Creating RADIUS configuration with servers: %v", config.Servers)
+
+	if err := r.client.ConfigureRADIUS(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create RADIUS configuration",
+			fmt.Sprintf("Could not create RADIUS configuration: %v", err),
+		)
+		return
+	}
+
+	// Set ID for singleton resource
+	data.ID = types.StringValue("radius_auth")
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *RADIUSAuthResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RADIUSAuthModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the resource was not found, remove from state
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the RADIUS configuration from the router.
+func (r *RADIUSAuthResource) read(ctx context.Context, data *RADIUSAuthModel, diagnostics *diag.Diagnostics) {
+	ctx = logging.WithResource(ctx, "rtx_radius_auth", "radius_auth")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_radius_auth").Msg("Reading RADIUS configuration")
+
+	var config *client.RADIUSConfig
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			if parsed := parsedConfig.ExtractRADIUS(); parsed != nil {
+				config = &client.RADIUSConfig{
+					Auth:       parsed.Auth,
+					Accounting: parsed.Accounting,
+					Servers:    parsed.Servers,
+					Secret:     parsed.Secret,
+					AuthPort:   parsed.AuthPort,
+					Retry:      parsed.Retry,
+					Wait:       parsed.Wait,
+				}
+				logger.Debug().Str("resource", "rtx_radius_auth").Msg("Found RADIUS configuration in SFTP cache")
+			}
+		}
+		if config == nil {
+			logger.Debug().Str("resource", "rtx_radius_auth").Msg("RADIUS configuration not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or config not found in cache
+	if config == nil {
+		var err error
+		config, err = r.client.GetRADIUS(ctx)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_radius_auth").Msg("RADIUS configuration not found, removing from state")
+				data.ID = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read RADIUS configuration", fmt.Sprintf("Could not read RADIUS configuration: %v", err))
+			return
+		}
+	}
+
+	diagnostics.Append(data.FromClient(ctx, config)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *RADIUSAuthResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RADIUSAuthModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_radius_auth", "radius_auth")
+	logger := logging.FromContext(ctx)
+
+	config, diags := data.ToClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logger.Debug().Str("resource", "rtx_radius_auth").Msgf("Updating RADIUS configuration with servers: %v", config.Servers)
+
+	if err := r.client.UpdateRADIUS(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update RADIUS configuration",
+			fmt.Sprintf("Could not update RADIUS configuration: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *RADIUSAuthResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RADIUSAuthModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_radius_auth", "radius_auth")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_radius_auth").Msg("Deleting RADIUS configuration")
+
+	if err := r.client.ResetRADIUS(ctx); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete RADIUS configuration",
+			fmt.Sprintf("Could not delete RADIUS configuration: %v", err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *RADIUSAuthResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Accept "radius_auth" as the import ID (singleton resource)
+	if req.ID != "radius_auth" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID 'radius_auth', got %q", req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -13,6 +13,8 @@ type ServicePolicyModel struct {
 	Interface types.String `tfsdk:"interface"`
 	Direction types.String `tfsdk:"direction"`
 	PolicyMap types.String `tfsdk:"policy_map"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.ServicePolicy.
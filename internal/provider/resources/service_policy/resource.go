@@ -74,6 +74,7 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 				Description: "The policy-map name or queue type to apply (e.g., 'priority', 'cbq', or a policy-map name).",
 				Required:    true,
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
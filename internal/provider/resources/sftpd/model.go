@@ -11,6 +11,8 @@ import (
 type SFTPDModel struct {
 	ID    types.String `tfsdk:"id"`
 	Hosts types.List   `tfsdk:"hosts"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.SFTPDConfig.
@@ -14,6 +14,8 @@ type ShapeModel struct {
 	Direction    types.String `tfsdk:"direction"`
 	ShapeAverage types.Int64  `tfsdk:"shape_average"`
 	ShapeBurst   types.Int64  `tfsdk:"shape_burst"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.ShapeConfig.
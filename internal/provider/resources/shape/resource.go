@@ -82,6 +82,7 @@ func (r *ShapeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description: "Burst size in bytes (optional).",
 				Optional:    true,
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -20,6 +20,8 @@ type SNMPServerModel struct {
 	Communities types.List   `tfsdk:"community"`
 	Hosts       types.List   `tfsdk:"host"`
 	EnableTraps types.List   `tfsdk:"enable_traps"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // CommunityModel describes a single SNMP community.
@@ -27,6 +29,9 @@ type CommunityModel struct {
 	Name       types.String `tfsdk:"name"`
 	Permission types.String `tfsdk:"permission"`
 	ACL        types.String `tfsdk:"acl"`
+
+	NameWO        types.String `tfsdk:"name_wo"`
+	NameWOVersion types.Int64  `tfsdk:"name_wo_version"`
 }
 
 // HostModel describes a single SNMP trap host.
@@ -39,9 +44,11 @@ type HostModel struct {
 // CommunityAttrTypes returns the attribute types for CommunityModel.
 func CommunityAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"name":       types.StringType,
-		"permission": types.StringType,
-		"acl":        types.StringType,
+		"name":            types.StringType,
+		"permission":      types.StringType,
+		"acl":             types.StringType,
+		"name_wo":         types.StringType,
+		"name_wo_version": types.Int64Type,
 	}
 }
 
@@ -116,12 +123,25 @@ func (m *SNMPServerModel) FromClient(config *client.SNMPConfig) {
 	// block of zero entries is preserved.
 	communityType := types.ObjectType{AttrTypes: CommunityAttrTypes()}
 	if len(config.Communities) > 0 {
+		// Write-only names stay out of state, matched to the prior state by position
+		var prior []CommunityModel
+		if !m.Communities.IsNull() && !m.Communities.IsUnknown() {
+			m.Communities.ElementsAs(context.TODO(), &prior, false)
+		}
 		communityValues := make([]attr.Value, len(config.Communities))
 		for i, c := range config.Communities {
+			name := types.StringValue(c.Name)
+			version := types.Int64Null()
+			if i < len(prior) && !prior[i].NameWOVersion.IsNull() {
+				name = types.StringNull()
+				version = prior[i].NameWOVersion
+			}
 			communityValues[i] = types.ObjectValueMust(CommunityAttrTypes(), map[string]attr.Value{
-				"name":       types.StringValue(c.Name),
-				"permission": types.StringValue(c.Permission),
-				"acl":        fwhelpers.StringValueOrNull(c.ACL),
+				"name":            name,
+				"permission":      types.StringValue(c.Permission),
+				"acl":             fwhelpers.StringValueOrNull(c.ACL),
+				"name_wo":         types.StringNull(),
+				"name_wo_version": version,
 			})
 		}
 		m.Communities = types.ListValueMust(communityType, communityValues)
@@ -1,6 +1,7 @@
 package snmp_server
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -30,9 +31,11 @@ func makePriorCommunities(t *testing.T, s priorState) types.List {
 	case priorPopulated:
 		return types.ListValueMust(objType, []attr.Value{
 			types.ObjectValueMust(CommunityAttrTypes(), map[string]attr.Value{
-				"name":       types.StringValue("public"),
-				"permission": types.StringValue("ro"),
-				"acl":        types.StringNull(),
+				"name":            types.StringValue("public"),
+				"permission":      types.StringValue("ro"),
+				"acl":             types.StringNull(),
+				"name_wo":         types.StringNull(),
+				"name_wo_version": types.Int64Null(),
 			}),
 		})
 	}
@@ -119,6 +122,35 @@ func TestFromClient_Communities_NullPreservation(t *testing.T) {
 	}
 }
 
+func TestFromClient_Communities_WriteOnlyName(t *testing.T) {
+	prior := types.ListValueMust(types.ObjectType{AttrTypes: CommunityAttrTypes()}, []attr.Value{
+		types.ObjectValueMust(CommunityAttrTypes(), map[string]attr.Value{
+			"name":            types.StringNull(),
+			"permission":      types.StringValue("ro"),
+			"acl":             types.StringNull(),
+			"name_wo":         types.StringNull(),
+			"name_wo_version": types.Int64Value(2),
+		}),
+	})
+	m := &SNMPServerModel{Communities: prior}
+	m.FromClient(&client.SNMPConfig{Communities: []client.SNMPCommunity{
+		{Name: "secret", Permission: "ro"},
+		{Name: "public", Permission: "rw"},
+	}})
+
+	var communities []CommunityModel
+	m.Communities.ElementsAs(context.Background(), &communities, false)
+	if len(communities) != 2 {
+		t.Fatalf("len(communities) = %d, want 2", len(communities))
+	}
+	if !communities[0].Name.IsNull() || communities[0].NameWOVersion.ValueInt64() != 2 {
+		t.Errorf("write-only community = %v, %v, want null name and version 2", communities[0].Name, communities[0].NameWOVersion)
+	}
+	if communities[1].Name.ValueString() != "public" || !communities[1].NameWOVersion.IsNull() {
+		t.Errorf("stored community = %v, %v, want name public without version", communities[1].Name, communities[1].NameWOVersion)
+	}
+}
+
 func TestFromClient_Hosts_NullPreservation(t *testing.T) {
 	cases := []struct {
 		name       string
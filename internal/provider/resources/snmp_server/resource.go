@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/sh1/terraform-provider-rtx/internal/client"
@@ -64,6 +65,7 @@ func (r *SNMPServerResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"community": schema.ListNestedBlock{
@@ -71,10 +73,15 @@ func (r *SNMPServerResource) Schema(ctx context.Context, req resource.SchemaRequ
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
-							Description: "Community string name. This is sensitive as it acts as a password for SNMP access.",
-							Required:    true,
+							Description: "Community string name. This is sensitive as it acts as a password for SNMP access. Exactly one of name and name_wo is required.",
+							Optional:    true,
 							Sensitive:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("name_wo")),
+							},
 						},
+						"name_wo":         fwhelpers.WriteOnlySecretAttribute("name"),
+						"name_wo_version": fwhelpers.WriteOnlyVersionAttribute("name"),
 						"permission": schema.StringAttribute{
 							Description: "Access permission: 'ro' (read-only) or 'rw' (read-write)",
 							Required:    true,
@@ -148,6 +155,8 @@ func (r *SNMPServerResource) Create(ctx context.Context, req resource.CreateRequ
 
 	config := data.ToClient()
 	logger.Debug().Str("resource", "rtx_snmp_server").Msgf("Creating SNMP configuration: %+v", config)
+	// Write-only secrets are never logged
+	r.applyWriteOnlyNames(ctx, req.Config, &data, &config, &resp.Diagnostics)
 
 	if err := r.client.CreateSNMP(ctx, config); err != nil {
 		resp.Diagnostics.AddError(
@@ -244,6 +253,8 @@ func (r *SNMPServerResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	config := data.ToClient()
 	logger.Debug().Str("resource", "rtx_snmp_server").Msgf("Updating SNMP configuration: %+v", config)
+	// Write-only secrets are never logged
+	r.applyWriteOnlyNames(ctx, req.Config, &data, &config, &resp.Diagnostics)
 
 	if err := r.client.UpdateSNMP(ctx, config); err != nil {
 		resp.Diagnostics.AddError(
@@ -307,3 +318,17 @@ func (r *SNMPServerResource) ImportState(ctx context.Context, req resource.Impor
 
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// applyWriteOnlyNames sets the names of the communities configured with
+// name_wo, which are only available in the configuration
+func (r *SNMPServerResource) applyWriteOnlyNames(ctx context.Context, cfg tfsdk.Config, data *SNMPServerModel, config *client.SNMPConfig, diags *diag.Diagnostics) {
+	var communities []CommunityModel
+	if !data.Communities.IsNull() && !data.Communities.IsUnknown() {
+		diags.Append(data.Communities.ElementsAs(ctx, &communities, false)...)
+	}
+	for i := range config.Communities {
+		if i < len(communities) {
+			config.Communities[i].Name = fwhelpers.WriteOnlyValue(ctx, cfg, path.Root("community").AtListIndex(i).AtName("name_wo"), communities[i].Name, diags)
+		}
+	}
+}
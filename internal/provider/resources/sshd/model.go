@@ -15,6 +15,10 @@ type SSHDModel struct {
 	Hosts      types.List   `tfsdk:"hosts"`
 	HostKey    types.String `tfsdk:"host_key"`
 	AuthMethod types.String `tfsdk:"auth_method"`
+
+	PreventDestroyOnDevice types.Bool `tfsdk:"prevent_destroy_on_device"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.SSHDConfig.
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -92,6 +91,8 @@ func (r *SSHDResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringvalidator.OneOf("password", "publickey", "any"),
 				},
 			},
+			"prevent_destroy_on_device": fwhelpers.PreventDestroyOnDeviceAttribute("SSH service running"),
+			"applied_commands":          fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -276,6 +277,10 @@ func (r *SSHDResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	logger := logging.FromContext(ctx)
 
 	logger.Debug().Str("resource", "rtx_sshd").Msg("Deleting SSHD configuration (disabling service)")
+
+	if fwhelpers.DestroyPrevented(ctx, data.PreventDestroyOnDevice, "SSHD configuration", &resp.Diagnostics) {
+		return
+	}
 	logger.Warn().Str("resource", "rtx_sshd").Msg("Disabling SSH service - ensure you have alternative access to the router")
 
 	if err := r.client.ResetSSHD(ctx); err != nil {
@@ -293,9 +298,5 @@ func (r *SSHDResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 // ImportState imports an existing resource into Terraform.
 func (r *SSHDResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// For singleton resources, accept any import ID and use fixed "sshd" ID
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-
-	// Override the ID to always be "sshd"
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "sshd")...)
+	fwhelpers.ImportSingleton(ctx, "sshd", req, resp)
 }
@@ -33,6 +33,8 @@ type SSHDAuthorizedKeysModel struct {
 	Username types.String `tfsdk:"username"`
 	Keys     types.List   `tfsdk:"keys"` // List of KeyModel objects
 	KeyCount types.Int64  `tfsdk:"key_count"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToKeyStrings converts the Keys list to []string for the client.
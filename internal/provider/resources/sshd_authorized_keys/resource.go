@@ -31,6 +31,9 @@ var (
 	_ resource.ResourceWithUpgradeState = &SSHDAuthorizedKeysResource{}
 )
 
+// usernamePattern matches valid login user names
+var usernamePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
 // NewSSHDAuthorizedKeysResource creates a new SSHD authorized keys resource.
 func NewSSHDAuthorizedKeysResource() resource.Resource {
 	return &SSHDAuthorizedKeysResource{}
@@ -62,7 +65,7 @@ func (r *SSHDAuthorizedKeysResource) Schema(ctx context.Context, req resource.Sc
 				},
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`),
+						usernamePattern,
 						"must start with a letter and contain only alphanumeric characters and underscores",
 					),
 				},
@@ -98,6 +101,7 @@ func (r *SSHDAuthorizedKeysResource) Schema(ctx context.Context, req resource.Sc
 				Description: "Number of authorized keys registered for this user.",
 				Computed:    true,
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
@@ -369,6 +373,10 @@ func (r *SSHDAuthorizedKeysResource) Delete(ctx context.Context, req resource.De
 // ImportState imports an existing resource into Terraform.
 func (r *SSHDAuthorizedKeysResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	username := req.ID
+	if !usernamePattern.MatchString(username) {
+		fwhelpers.AddImportIDError(&resp.Diagnostics, username, "the username whose keys are managed (e.g., 'admin')")
+		return
+	}
 
 	logger := logging.FromContext(ctx)
 	logger.Debug().
@@ -451,6 +459,7 @@ func (r *SSHDAuthorizedKeysResource) UpgradeState(ctx context.Context) map[int64
 				var newData SSHDAuthorizedKeysModel
 				newData.Username = types.StringValue(username)
 				newData.KeyCount = types.Int64Value(keyCount)
+				newData.AppliedCommands = types.ListNull(types.StringType)
 
 				// Convert KeyModel slice to types.List
 				keyObjects := make([]types.Object, len(newKeys))
@@ -11,6 +11,9 @@ type SSHDHostKeyModel struct {
 	ID          types.String `tfsdk:"id"`
 	Fingerprint types.String `tfsdk:"fingerprint"`
 	Algorithm   types.String `tfsdk:"algorithm"`
+	Timeouts    types.Object `tfsdk:"timeouts"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // FromClient updates the Terraform model from a client.SSHHostKeyInfo.
@@ -61,6 +61,10 @@ func (r *SSHDHostKeyResource) Schema(ctx context.Context, req resource.SchemaReq
 				Description: "Host key algorithm (e.g., ssh-rsa).",
 				Computed:    true,
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": fwhelpers.TimeoutsBlock(),
 		},
 	}
 }
@@ -92,6 +96,12 @@ func (r *SSHDHostKeyResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel := fwhelpers.WithOperationTimeout(ctx, data.Timeouts, fwhelpers.TimeoutCreate, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ctx = logging.WithResource(ctx, "rtx_sshd_host_key", "sshd_host_key")
 	logger := logging.FromContext(ctx)
 
@@ -166,6 +176,12 @@ func (r *SSHDHostKeyResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx, cancel := fwhelpers.WithOperationTimeout(ctx, data.Timeouts, fwhelpers.TimeoutRead, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ctx = logging.WithResource(ctx, "rtx_sshd_host_key", data.ID.ValueString())
 	logger := logging.FromContext(ctx)
 
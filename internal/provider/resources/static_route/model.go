@@ -13,17 +13,26 @@ type StaticRouteModel struct {
 	Prefix   types.String   `tfsdk:"prefix"`
 	Mask     types.String   `tfsdk:"mask"`
 	NextHops []NextHopModel `tfsdk:"next_hop"`
+
+	PreventDestroyOnDevice types.Bool `tfsdk:"prevent_destroy_on_device"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // NextHopModel describes the next hop nested block.
 type NextHopModel struct {
-	Gateway   types.String `tfsdk:"gateway"`
-	Interface types.String `tfsdk:"interface"`
-	Distance  types.Int64  `tfsdk:"distance"`
-	Permanent types.Bool   `tfsdk:"permanent"`
-	Filter    types.Int64  `tfsdk:"filter"`
+	Gateway     types.String `tfsdk:"gateway"`
+	Interface   types.String `tfsdk:"interface"`
+	Distance    types.Int64  `tfsdk:"distance"`
+	Permanent   types.Bool   `tfsdk:"permanent"`
+	Filter      types.Int64  `tfsdk:"filter"`
+	KeepaliveID types.Int64  `tfsdk:"keepalive_id"`
 }
 
+// defaultWeight is the router default gateway weight, which is not shown in
+// the running config.
+const defaultWeight = 1
+
 // ToClient converts the Terraform model to a client.StaticRoute.
 func (m *StaticRouteModel) ToClient() client.StaticRoute {
 	route := client.StaticRoute{
@@ -35,12 +44,19 @@ func (m *StaticRouteModel) ToClient() client.StaticRoute {
 	if len(m.NextHops) > 0 {
 		nextHops := make([]client.StaticRouteHop, len(m.NextHops))
 		for i, hop := range m.NextHops {
+			// An unset distance must not be sent as weight 0, which would
+			// turn the gateway into a backup.
+			distance := defaultWeight
+			if !hop.Distance.IsNull() && !hop.Distance.IsUnknown() {
+				distance = int(hop.Distance.ValueInt64())
+			}
 			nextHops[i] = client.StaticRouteHop{
-				NextHop:   fwhelpers.GetStringValue(hop.Gateway),
-				Interface: fwhelpers.GetStringValue(hop.Interface),
-				Distance:  fwhelpers.GetInt64Value(hop.Distance),
-				Permanent: fwhelpers.GetBoolValue(hop.Permanent),
-				Filter:    fwhelpers.GetInt64Value(hop.Filter),
+				NextHop:     fwhelpers.GetStringValue(hop.Gateway),
+				Interface:   fwhelpers.GetStringValue(hop.Interface),
+				Distance:    distance,
+				Permanent:   fwhelpers.GetBoolValue(hop.Permanent),
+				Filter:      fwhelpers.GetInt64Value(hop.Filter),
+				KeepaliveID: fwhelpers.GetInt64Value(hop.KeepaliveID),
 			}
 		}
 		route.NextHops = nextHops
@@ -55,18 +71,56 @@ func (m *StaticRouteModel) FromClient(route *client.StaticRoute) {
 	m.Mask = types.StringValue(route.Mask)
 	m.ID = types.StringValue(route.Prefix + "/" + route.Mask)
 
-	// Update next hops
+	// Update next hops, keeping the order of the prior state so that a
+	// weight change is reported against the gateway it belongs to
 	if len(route.NextHops) > 0 {
-		nextHops := make([]NextHopModel, len(route.NextHops))
-		for i, hop := range route.NextHops {
+		hops := orderNextHops(m.NextHops, route.NextHops)
+		nextHops := make([]NextHopModel, len(hops))
+		for i, hop := range hops {
 			nextHops[i] = NextHopModel{
-				Gateway:   fwhelpers.StringValueOrNull(hop.NextHop),
-				Interface: fwhelpers.StringValueOrNull(hop.Interface),
-				Distance:  fwhelpers.Int64ValueOrNull(hop.Distance),
-				Permanent: types.BoolValue(hop.Permanent),
-				Filter:    fwhelpers.Int64ValueOrNull(hop.Filter),
+				Gateway:     fwhelpers.StringValueOrNull(hop.NextHop),
+				Interface:   fwhelpers.StringValueOrNull(hop.Interface),
+				Distance:    types.Int64Value(int64(hop.Distance)),
+				Permanent:   types.BoolValue(hop.Permanent),
+				Filter:      fwhelpers.Int64ValueOrNull(hop.Filter),
+				KeepaliveID: fwhelpers.Int64ValueOrNull(hop.KeepaliveID),
 			}
 		}
 		m.NextHops = nextHops
 	}
 }
+
+// orderNextHops returns the router's next hops in the order of the prior
+// next_hop blocks. Hops unknown to the prior state are appended in router
+// order, and prior hops missing on the router are dropped.
+func orderNextHops(prior []NextHopModel, hops []client.StaticRouteHop) []client.StaticRouteHop {
+	remaining := make(map[string]client.StaticRouteHop, len(hops))
+	for _, hop := range hops {
+		remaining[nextHopKey(hop.NextHop, hop.Interface)] = hop
+	}
+
+	ordered := make([]client.StaticRouteHop, 0, len(hops))
+	for _, p := range prior {
+		key := nextHopKey(fwhelpers.GetStringValue(p.Gateway), fwhelpers.GetStringValue(p.Interface))
+		if hop, ok := remaining[key]; ok {
+			ordered = append(ordered, hop)
+			delete(remaining, key)
+		}
+	}
+	for _, hop := range hops {
+		key := nextHopKey(hop.NextHop, hop.Interface)
+		if _, ok := remaining[key]; ok {
+			ordered = append(ordered, hop)
+			delete(remaining, key)
+		}
+	}
+	return ordered
+}
+
+// nextHopKey identifies a next hop by its gateway address or interface.
+func nextHopKey(gateway, iface string) string {
+	if iface != "" {
+		return iface
+	}
+	return gateway
+}
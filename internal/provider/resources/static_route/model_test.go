@@ -0,0 +1,79 @@
+package static_route
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+)
+
+func TestStaticRouteModel_FromClient_NextHopOrder(t *testing.T) {
+	route := &client.StaticRoute{
+		Prefix: "0.0.0.0",
+		Mask:   "0.0.0.0",
+		NextHops: []client.StaticRouteHop{
+			{NextHop: "192.168.0.2", Distance: 1},
+			{Interface: "pp 1", Distance: 0},
+			{NextHop: "192.168.0.1", Distance: 5},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		prior []NextHopModel
+		want  []string
+	}{
+		{
+			name:  "no prior state keeps router order",
+			prior: nil,
+			want:  []string{"192.168.0.2", "pp 1", "192.168.0.1"},
+		},
+		{
+			name: "prior order is kept",
+			prior: []NextHopModel{
+				{Gateway: types.StringValue("192.168.0.1"), Interface: types.StringNull()},
+				{Gateway: types.StringValue("192.168.0.2"), Interface: types.StringNull()},
+				{Gateway: types.StringNull(), Interface: types.StringValue("pp 1")},
+			},
+			want: []string{"192.168.0.1", "192.168.0.2", "pp 1"},
+		},
+		{
+			name: "unknown hops are appended and missing hops dropped",
+			prior: []NextHopModel{
+				{Gateway: types.StringValue("192.168.0.9"), Interface: types.StringNull()},
+				{Gateway: types.StringValue("192.168.0.1"), Interface: types.StringNull()},
+			},
+			want: []string{"192.168.0.1", "192.168.0.2", "pp 1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := StaticRouteModel{NextHops: tt.prior}
+			m.FromClient(route)
+
+			if len(m.NextHops) != len(tt.want) {
+				t.Fatalf("got %d next hops, want %d", len(m.NextHops), len(tt.want))
+			}
+			for i, want := range tt.want {
+				got := m.NextHops[i].Gateway.ValueString()
+				if !m.NextHops[i].Interface.IsNull() {
+					got = m.NextHops[i].Interface.ValueString()
+				}
+				if got != want {
+					t.Errorf("next_hop[%d] = %q, want %q", i, got, want)
+				}
+			}
+		})
+	}
+
+	// The weight stays attached to its gateway after reordering
+	m := StaticRouteModel{NextHops: []NextHopModel{
+		{Gateway: types.StringValue("192.168.0.1"), Interface: types.StringNull()},
+	}}
+	m.FromClient(route)
+	if got := m.NextHops[0].Distance.ValueInt64(); got != 5 {
+		t.Errorf("next_hop[0].distance = %d, want 5", got)
+	}
+}
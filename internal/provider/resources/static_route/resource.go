@@ -75,10 +75,12 @@ func (r *StaticRouteResource) Schema(ctx context.Context, req resource.SchemaReq
 					subnetMaskValidator{},
 				},
 			},
+			"prevent_destroy_on_device": fwhelpers.PreventDestroyOnDeviceAttribute("static route"),
+			"applied_commands":          fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"next_hop": schema.ListNestedBlock{
-				Description: "Next hop configuration for this route. Multiple next hops enable load balancing or failover.",
+				Description: "Next hop configuration for this route. Multiple next hops enable load balancing or failover and are written as a single weighted multi-gateway command in block order.",
 				Validators: []validator.List{
 					listvalidator.SizeAtLeast(1),
 				},
@@ -96,11 +98,13 @@ func (r *StaticRouteResource) Schema(ctx context.Context, req resource.SchemaReq
 							Optional:    true,
 						},
 						"distance": schema.Int64Attribute{
-							Description: "Administrative distance (weight). Lower values are preferred. Range: 1-100. Defaults to router default if not specified.",
-							Optional:    true,
-							Computed:    true,
+							Description: "Gateway weight ('weight' option). Traffic is balanced across gateways in proportion to their weight; " +
+								"a weight of 0 marks a backup gateway that is used only while all other gateways are unavailable. " +
+								"Range: 0-100. Defaults to 1 (router default).",
+							Optional: true,
+							Computed: true,
 							Validators: []validator.Int64{
-								int64validator.Between(1, 100),
+								int64validator.Between(0, 100),
 							},
 						},
 						"permanent": schema.BoolAttribute{
@@ -108,6 +112,15 @@ func (r *StaticRouteResource) Schema(ctx context.Context, req resource.SchemaReq
 							Optional:    true,
 							Computed:    true,
 						},
+						"keepalive_id": schema.Int64Attribute{
+							Description: "ID of an rtx_ip_keepalive monitor. The gateway is withdrawn while the monitored target is unreachable, " +
+								"allowing failover to the remaining gateways. Conflicts with permanent.",
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 6000),
+								int64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("permanent")),
+							},
+						},
 						"filter": schema.Int64Attribute{
 							Description: "IP filter number to apply to this route. 0 means no filter. Defaults to router default if not specified.",
 							Optional:    true,
@@ -289,6 +302,10 @@ func (r *StaticRouteResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	logger.Debug().Str("resource", "rtx_static_route").Msgf("Deleting static route: %s/%s", prefix, mask)
 
+	if fwhelpers.DestroyPrevented(ctx, data.PreventDestroyOnDevice, fmt.Sprintf("Static route %s/%s", prefix, mask), &resp.Diagnostics) {
+		return
+	}
+
 	if err := r.client.DeleteStaticRoute(ctx, prefix, mask); err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			return
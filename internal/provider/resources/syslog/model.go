@@ -21,6 +21,8 @@ type SyslogModel struct {
 	Notice       types.Bool   `tfsdk:"notice"`
 	Info         types.Bool   `tfsdk:"info"`
 	Debug        types.Bool   `tfsdk:"debug"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // HostModel describes a single syslog host.
@@ -17,6 +17,8 @@ type SystemModel struct {
 	Console      types.List   `tfsdk:"console"`
 	PacketBuffer types.List   `tfsdk:"packet_buffer"`
 	Statistics   types.List   `tfsdk:"statistics"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ConsoleModel describes the console nested block.
@@ -59,12 +59,13 @@ func (r *SystemResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"timezone": schema.StringAttribute{
-				Description: "Timezone as UTC offset (e.g., '+09:00' for JST, '-05:00' for EST).",
+				Description: "Timezone as UTC offset (e.g., '+09:00' for JST, '-05:00' for EST). When rtx_clock_timezone is also used, set the same value on both resources.",
 				Optional:    true,
 				Validators: []validator.String{
 					timezoneValidator{},
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"console": schema.ListNestedBlock{
@@ -0,0 +1,41 @@
+package system_settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// SystemSettingsModel describes the resource data model.
+type SystemSettingsModel struct {
+	ID                  types.String `tfsdk:"id"`
+	IPRouting           types.Bool   `tfsdk:"ip_routing"`
+	IPv6Routing         types.Bool   `tfsdk:"ipv6_routing"`
+	IPRoutingProcess    types.String `tfsdk:"ip_routing_process"`
+	IPv6RoutingProcess  types.String `tfsdk:"ipv6_routing_process"`
+	IPFilterSourceRoute types.Bool   `tfsdk:"ip_filter_source_route"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.SystemSettings.
+func (m *SystemSettingsModel) ToClient() client.SystemSettings {
+	return client.SystemSettings{
+		IPRouting:           fwhelpers.GetBoolValueWithDefault(m.IPRouting, true),
+		IPv6Routing:         fwhelpers.GetBoolValueWithDefault(m.IPv6Routing, true),
+		IPRoutingProcess:    fwhelpers.GetStringValueWithDefault(m.IPRoutingProcess, "fast"),
+		IPv6RoutingProcess:  fwhelpers.GetStringValueWithDefault(m.IPv6RoutingProcess, "fast"),
+		IPFilterSourceRoute: fwhelpers.GetBoolValueWithDefault(m.IPFilterSourceRoute, true),
+	}
+}
+
+// FromClient updates the Terraform model from a client.SystemSettings.
+func (m *SystemSettingsModel) FromClient(settings *client.SystemSettings) {
+	m.ID = types.StringValue("system_settings")
+	m.IPRouting = types.BoolValue(settings.IPRouting)
+	m.IPv6Routing = types.BoolValue(settings.IPv6Routing)
+	m.IPRoutingProcess = types.StringValue(settings.IPRoutingProcess)
+	m.IPv6RoutingProcess = types.StringValue(settings.IPv6RoutingProcess)
+	m.IPFilterSourceRoute = types.BoolValue(settings.IPFilterSourceRoute)
+}
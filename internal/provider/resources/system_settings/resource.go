@@ -0,0 +1,250 @@
+package system_settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &SystemSettingsResource{}
+	_ resource.ResourceWithImportState = &SystemSettingsResource{}
+)
+
+// NewSystemSettingsResource creates a new system settings resource.
+func NewSystemSettingsResource() resource.Resource {
+	return &SystemSettingsResource{}
+}
+
+// SystemSettingsResource defines the resource implementation.
+type SystemSettingsResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *SystemSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *SystemSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages global forwarding toggles: IPv4/IPv6 routing, the packet forwarding process (fast path or normal) " +
+			"and source-route filtering. This is a singleton resource. Deleting it restores the router defaults.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (always 'system_settings' for this singleton resource).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_routing": schema.BoolAttribute{
+				Description: "Forward IPv4 packets ('ip routing'). Disabling turns the router into an IPv4 host. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"ipv6_routing": schema.BoolAttribute{
+				Description: "Forward IPv6 packets ('ipv6 routing'). Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"ip_routing_process": schema.StringAttribute{
+				Description: "IPv4 forwarding process ('ip routing process'): 'fast' for the fast path (flow switching) or 'normal' to process every packet in software. Defaults to 'fast'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("fast"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidRoutingProcesses...),
+				},
+			},
+			"ipv6_routing_process": schema.StringAttribute{
+				Description: "IPv6 forwarding process ('ipv6 routing process'): 'fast' or 'normal'. Defaults to 'fast'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("fast"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidRoutingProcesses...),
+				},
+			},
+			"ip_filter_source_route": schema.BoolAttribute{
+				Description: "Discard IPv4 packets carrying a source-route option ('ip filter source-route'). Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SystemSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *SystemSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemSettingsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_system_settings", "system_settings")
+	logger := logging.FromContext(ctx)
+
+	config := data.ToClient()
+	logger.Debug().Str("resource", "rtx_system_settings").Msgf("Creating system settings: %+v", config)
+
+	if err := r.client.ConfigureSystemSettings(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to configure system settings",
+			fmt.Sprintf("Could not configure system settings: %v", err),
+		)
+		return
+	}
+
+	// Set the ID for singleton resource
+	data.ID = types.StringValue("system_settings")
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SystemSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemSettingsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the system settings from the router.
+func (r *SystemSettingsResource) read(ctx context.Context, data *SystemSettingsModel, diagnostics *diag.Diagnostics) {
+	ctx = logging.WithResource(ctx, "rtx_system_settings", "system_settings")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_system_settings").Msg("Reading system settings")
+
+	var config *client.SystemSettings
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			converted := client.SystemSettings(*parsedConfig.ExtractSystemSettings())
+			config = &converted
+			logger.Debug().Str("resource", "rtx_system_settings").Msg("Found system settings in SFTP cache")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or cache unavailable
+	if config == nil {
+		var err error
+		config, err = r.client.GetSystemSettings(ctx)
+		if err != nil {
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read system settings", fmt.Sprintf("Could not read system settings: %v", err))
+			return
+		}
+	}
+
+	data.FromClient(config)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *SystemSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SystemSettingsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_system_settings", "system_settings")
+	logger := logging.FromContext(ctx)
+
+	config := data.ToClient()
+	logger.Debug().Str("resource", "rtx_system_settings").Msgf("Updating system settings: %+v", config)
+
+	if err := r.client.UpdateSystemSettings(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update system settings",
+			fmt.Sprintf("Could not update system settings: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *SystemSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithResource(ctx, "rtx_system_settings", "system_settings")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_system_settings").Msg("Resetting system settings")
+
+	if err := r.client.ResetSystemSettings(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to reset system settings",
+			fmt.Sprintf("Could not reset system settings: %v", err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *SystemSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fwhelpers.ImportSingleton(ctx, "system_settings", req, resp)
+}
@@ -0,0 +1,36 @@
+package traffic_threshold
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// TrafficThresholdModel describes the resource data model.
+type TrafficThresholdModel struct {
+	Interface types.String `tfsdk:"interface"`
+	Direction types.String `tfsdk:"direction"`
+	Megabytes types.Int64  `tfsdk:"megabytes"`
+	Action    types.String `tfsdk:"action"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.TrafficThreshold.
+func (m *TrafficThresholdModel) ToClient() client.TrafficThreshold {
+	return client.TrafficThreshold{
+		Interface: fwhelpers.GetStringValue(m.Interface),
+		Direction: fwhelpers.GetStringValue(m.Direction),
+		Megabytes: fwhelpers.GetInt64Value(m.Megabytes),
+		Action:    fwhelpers.GetStringValue(m.Action),
+	}
+}
+
+// FromClient updates the Terraform model from a client.TrafficThreshold.
+func (m *TrafficThresholdModel) FromClient(threshold *client.TrafficThreshold) {
+	m.Interface = types.StringValue(threshold.Interface)
+	m.Direction = types.StringValue(threshold.Direction)
+	m.Megabytes = types.Int64Value(int64(threshold.Megabytes))
+	m.Action = types.StringValue(threshold.Action)
+}
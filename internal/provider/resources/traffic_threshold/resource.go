@@ -0,0 +1,318 @@
+package traffic_threshold
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &TrafficThresholdResource{}
+	_ resource.ResourceWithImportState    = &TrafficThresholdResource{}
+	_ resource.ResourceWithValidateConfig = &TrafficThresholdResource{}
+)
+
+var interfaceNamePattern = regexp.MustCompile(`^(lan|bridge|pp|tunnel)\d+$`)
+
+// NewTrafficThresholdResource creates a new traffic threshold resource.
+func NewTrafficThresholdResource() resource.Resource {
+	return &TrafficThresholdResource{}
+}
+
+// TrafficThresholdResource defines the resource implementation.
+type TrafficThresholdResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *TrafficThresholdResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_traffic_threshold"
+}
+
+// Schema defines the schema for the resource.
+func (r *TrafficThresholdResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an 'account threshold' traffic volume alarm on an RTX router interface. " +
+			"When the counted traffic exceeds the threshold the router logs a notice or, on pp interfaces, " +
+			"disconnects the session. Useful for capping usage on metered LTE backup links.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description: "Interface whose traffic is counted (e.g., 'lan2', 'pp1').",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						interfaceNamePattern,
+						"must be a valid interface name (e.g., 'lan1', 'lan2', 'bridge1', 'pp1', 'tunnel1')",
+					),
+				},
+			},
+			"direction": schema.StringAttribute{
+				Description: "Traffic direction to count: 'in', 'out' or 'both'. Defaults to 'both'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(parsers.TrafficThresholdDirectionBoth),
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidTrafficThresholdDirections...),
+				},
+			},
+			"megabytes": schema.Int64Attribute{
+				Description: "Traffic volume in megabytes that triggers the action.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "Action taken when the threshold is exceeded: 'syslog' logs a notice, " +
+					"'disconnect' also tears down the session (pp interfaces only). Defaults to 'syslog'.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(parsers.TrafficThresholdActionSyslog),
+				Validators: []validator.String{
+					stringvalidator.OneOf(parsers.ValidTrafficThresholdActions...),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// ValidateConfig performs custom validation on the resource configuration.
+func (r *TrafficThresholdResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TrafficThresholdModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Interface.IsUnknown() || data.Action.IsUnknown() {
+		return
+	}
+
+	if data.Action.ValueString() == parsers.TrafficThresholdActionDisconnect &&
+		!strings.HasPrefix(data.Interface.ValueString(), "pp") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("action"),
+			"Invalid Action",
+			"action 'disconnect' is only supported on pp interfaces",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *TrafficThresholdResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *TrafficThresholdResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TrafficThresholdModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_traffic_threshold", data.Interface.ValueString())
+	logger := logging.FromContext(ctx)
+
+	threshold := data.ToClient()
+	logger.Debug().Str("resource", "rtx_traffic_threshold").Msgf("Creating traffic threshold: %+v", threshold)
+
+	if err := r.client.CreateTrafficThreshold(ctx, threshold); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create traffic threshold",
+			fmt.Sprintf("Could not create traffic threshold: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *TrafficThresholdResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TrafficThresholdModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.Interface.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the traffic threshold from the router.
+func (r *TrafficThresholdResource) read(ctx context.Context, data *TrafficThresholdModel, diagnostics *diag.Diagnostics) {
+	iface := data.Interface.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_traffic_threshold", iface)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_traffic_threshold").Msgf("Reading traffic threshold: %s", iface)
+
+	var threshold *client.TrafficThreshold
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			for _, parsed := range parsedConfig.ExtractTrafficThresholds() {
+				if parsed.Interface == iface {
+					threshold = &client.TrafficThreshold{
+						Interface: parsed.Interface,
+						Direction: parsed.Direction,
+						Megabytes: parsed.Megabytes,
+						Action:    parsed.Action,
+					}
+					logger.Debug().Str("resource", "rtx_traffic_threshold").Msg("Found traffic threshold in SFTP cache")
+					break
+				}
+			}
+		}
+		if threshold == nil {
+			logger.Debug().Str("resource", "rtx_traffic_threshold").Msg("Traffic threshold not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or threshold not found in cache
+	if threshold == nil {
+		var err error
+		threshold, err = r.client.GetTrafficThreshold(ctx, iface)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_traffic_threshold").Msgf("Traffic threshold for %s not found, removing from state", iface)
+				data.Interface = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read traffic threshold", fmt.Sprintf("Could not read traffic threshold for %s: %v", iface, err))
+			return
+		}
+	}
+
+	data.FromClient(threshold)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *TrafficThresholdResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TrafficThresholdModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_traffic_threshold", data.Interface.ValueString())
+	logger := logging.FromContext(ctx)
+
+	threshold := data.ToClient()
+	logger.Debug().Str("resource", "rtx_traffic_threshold").Msgf("Updating traffic threshold: %+v", threshold)
+
+	if err := r.client.UpdateTrafficThreshold(ctx, threshold); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update traffic threshold",
+			fmt.Sprintf("Could not update traffic threshold: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *TrafficThresholdResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TrafficThresholdModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := data.Interface.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_traffic_threshold", iface)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_traffic_threshold").Msgf("Deleting traffic threshold: %s", iface)
+
+	if err := r.client.DeleteTrafficThreshold(ctx, iface); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete traffic threshold",
+			fmt.Sprintf("Could not delete traffic threshold for %s: %v", iface, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *TrafficThresholdResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if err := parsers.ValidateInterfaceName(req.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Invalid import ID format, expected interface name (e.g., 'pp1'): %v", err),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("interface"), req, resp)
+}
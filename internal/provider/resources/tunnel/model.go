@@ -20,6 +20,8 @@ type TunnelModel struct {
 	TunnelInterface  types.String      `tfsdk:"tunnel_interface"`
 	IPsec            *TunnelIPsecModel `tfsdk:"ipsec"`
 	L2TP             *TunnelL2TPModel  `tfsdk:"l2tp"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // TunnelIPsecModel describes the IPsec nested block.
@@ -38,6 +40,9 @@ type TunnelIPsecModel struct {
 	TCPMSSLimit       types.String               `tfsdk:"tcp_mss_limit"`
 	IPsecTransform    *IPsecTransformModel       `tfsdk:"ipsec_transform"`
 	Keepalive         *TunnelIPsecKeepaliveModel `tfsdk:"keepalive"`
+
+	PreSharedKeyWO        types.String `tfsdk:"pre_shared_key_wo"`
+	PreSharedKeyWOVersion types.Int64  `tfsdk:"pre_shared_key_wo_version"`
 }
 
 // TunnelIPsecKeepaliveModel describes the IPsec keepalive nested block.
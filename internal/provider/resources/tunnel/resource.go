@@ -99,6 +99,7 @@ func (r *TunnelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringvalidator.OneOf("fqdn"),
 				},
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"ipsec": schema.SingleNestedBlock{
@@ -129,10 +130,15 @@ func (r *TunnelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						},
 					},
 					"pre_shared_key": schema.StringAttribute{
-						Description: "IKE pre-shared key.",
-						Required:    true,
+						Description: "IKE pre-shared key. Exactly one of pre_shared_key and pre_shared_key_wo is required.",
+						Optional:    true,
 						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("pre_shared_key_wo")),
+						},
 					},
+					"pre_shared_key_wo":         fwhelpers.WriteOnlySecretAttribute("pre_shared_key"),
+					"pre_shared_key_wo_version": fwhelpers.WriteOnlyVersionAttribute("pre_shared_key"),
 					"secure_filter_in": schema.ListAttribute{
 						Description: "Inbound security filter IDs.",
 						Optional:    true,
@@ -401,6 +407,10 @@ func (r *TunnelResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	tunnel := data.ToClient()
 	logger.Debug().Str("resource", "rtx_tunnel").Msgf("Creating tunnel: %+v", tunnel)
+	// Write-only secrets are never logged
+	if tunnel.IPsec != nil {
+		tunnel.IPsec.PreSharedKey = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("ipsec").AtName("pre_shared_key_wo"), data.IPsec.PreSharedKey, &resp.Diagnostics)
+	}
 
 	if err := r.client.CreateTunnel(ctx, tunnel); err != nil {
 		resp.Diagnostics.AddError(
@@ -482,6 +492,10 @@ func (r *TunnelResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	tunnel := data.ToClient()
 	logger.Debug().Str("resource", "rtx_tunnel").Msgf("Updating tunnel: %+v", tunnel)
+	// Write-only secrets are never logged
+	if tunnel.IPsec != nil {
+		tunnel.IPsec.PreSharedKey = fwhelpers.WriteOnlyValue(ctx, req.Config, path.Root("ipsec").AtName("pre_shared_key_wo"), data.IPsec.PreSharedKey, &resp.Diagnostics)
+	}
 
 	if err := r.client.UpdateTunnel(ctx, tunnel); err != nil {
 		resp.Diagnostics.AddError(
@@ -527,12 +541,8 @@ func (r *TunnelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 // ImportState imports an existing resource by ID.
 func (r *TunnelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	tunnelID, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			fmt.Sprintf("Expected numeric tunnel_id, got: %s", req.ID),
-		)
+	tunnelID, ok := fwhelpers.ParseImportInt(&resp.Diagnostics, req.ID, "tunnel_id", 1, 6000)
+	if !ok {
 		return
 	}
 
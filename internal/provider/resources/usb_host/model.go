@@ -0,0 +1,35 @@
+package usb_host
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// USBHostModel describes the resource data model.
+type USBHostModel struct {
+	ID             types.String `tfsdk:"id"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	ModemEnabled   types.Bool   `tfsdk:"modem_enabled"`
+	StorageEnabled types.Bool   `tfsdk:"storage_enabled"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.USBHostConfig.
+func (m *USBHostModel) ToClient() client.USBHostConfig {
+	return client.USBHostConfig{
+		Enabled:        fwhelpers.GetBoolValueWithDefault(m.Enabled, true),
+		ModemEnabled:   fwhelpers.GetBoolValueWithDefault(m.ModemEnabled, true),
+		StorageEnabled: fwhelpers.GetBoolValueWithDefault(m.StorageEnabled, true),
+	}
+}
+
+// FromClient updates the Terraform model from a client.USBHostConfig.
+func (m *USBHostModel) FromClient(config *client.USBHostConfig) {
+	m.ID = types.StringValue("usb_host")
+	m.Enabled = types.BoolValue(config.Enabled)
+	m.ModemEnabled = types.BoolValue(config.ModemEnabled)
+	m.StorageEnabled = types.BoolValue(config.StorageEnabled)
+}
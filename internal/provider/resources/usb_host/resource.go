@@ -0,0 +1,228 @@
+package usb_host
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &USBHostResource{}
+	_ resource.ResourceWithImportState = &USBHostResource{}
+)
+
+// NewUSBHostResource creates a new USB host resource.
+func NewUSBHostResource() resource.Resource {
+	return &USBHostResource{}
+}
+
+// USBHostResource defines the resource implementation.
+type USBHostResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *USBHostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usb_host"
+}
+
+// Schema defines the schema for the resource.
+func (r *USBHostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the USB host settings of the router, controlling whether USB modems (LTE dongles) and USB storage are used. " +
+			"This is a singleton resource. Deleting it restores the router defaults (all enabled).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (always 'usb_host' for this singleton resource).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Enable the USB host function ('usbhost use'). Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"modem_enabled": schema.BoolAttribute{
+				Description: "Allow USB modems such as LTE dongles ('usbhost modem use'). Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"storage_enabled": schema.BoolAttribute{
+				Description: "Allow USB storage devices ('usbhost storage use'). Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *USBHostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *USBHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data USBHostModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_usb_host", "usb_host")
+	logger := logging.FromContext(ctx)
+
+	config := data.ToClient()
+	logger.Debug().Str("resource", "rtx_usb_host").Msgf("Creating USB host configuration: %+v", config)
+
+	if err := r.client.ConfigureUSBHost(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to configure USB host",
+			fmt.Sprintf("Could not configure USB host: %v", err),
+		)
+		return
+	}
+
+	// Set the ID for singleton resource
+	data.ID = types.StringValue("usb_host")
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *USBHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data USBHostModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the USB host settings from the router.
+func (r *USBHostResource) read(ctx context.Context, data *USBHostModel, diagnostics *diag.Diagnostics) {
+	ctx = logging.WithResource(ctx, "rtx_usb_host", "usb_host")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_usb_host").Msg("Reading USB host configuration")
+
+	var config *client.USBHostConfig
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			converted := client.USBHostConfig(*parsedConfig.ExtractUSBHost())
+			config = &converted
+			logger.Debug().Str("resource", "rtx_usb_host").Msg("Found USB host configuration in SFTP cache")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or cache unavailable
+	if config == nil {
+		var err error
+		config, err = r.client.GetUSBHost(ctx)
+		if err != nil {
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read USB host configuration", fmt.Sprintf("Could not read USB host configuration: %v", err))
+			return
+		}
+	}
+
+	data.FromClient(config)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *USBHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data USBHostModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_usb_host", "usb_host")
+	logger := logging.FromContext(ctx)
+
+	config := data.ToClient()
+	logger.Debug().Str("resource", "rtx_usb_host").Msgf("Updating USB host configuration: %+v", config)
+
+	if err := r.client.UpdateUSBHost(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update USB host configuration",
+			fmt.Sprintf("Could not update USB host configuration: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *USBHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithResource(ctx, "rtx_usb_host", "usb_host")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_usb_host").Msg("Resetting USB host configuration")
+
+	if err := r.client.ResetUSBHost(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to reset USB host configuration",
+			fmt.Sprintf("Could not reset USB host configuration: %v", err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *USBHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fwhelpers.ImportSingleton(ctx, "usb_host", req, resp)
+}
@@ -16,6 +16,8 @@ type VLANModel struct {
 	IPMask        types.String `tfsdk:"ip_mask"`
 	Shutdown      types.Bool   `tfsdk:"shutdown"`
 	VlanInterface types.String `tfsdk:"vlan_interface"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
 }
 
 // ToClient converts the Terraform model to a client.VLAN.
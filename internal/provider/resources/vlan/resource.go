@@ -105,6 +105,7 @@ func (r *VLANResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Description: "The computed VLAN interface name (e.g., 'lan1/1')",
 				Computed:    true,
 			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
 		},
 	}
 }
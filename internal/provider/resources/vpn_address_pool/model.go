@@ -0,0 +1,36 @@
+package vpn_address_pool
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// VPNAddressPoolModel describes the resource data model.
+type VPNAddressPoolModel struct {
+	PP           types.String `tfsdk:"pp"`
+	Start        types.String `tfsdk:"start"`
+	End          types.String `tfsdk:"end"`
+	DNSToClients types.Bool   `tfsdk:"dns_to_clients"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// ToClient converts the Terraform model to a client.VPNAddressPool.
+func (m *VPNAddressPoolModel) ToClient() client.VPNAddressPool {
+	return client.VPNAddressPool{
+		PP:           fwhelpers.GetStringValue(m.PP),
+		Start:        fwhelpers.GetStringValue(m.Start),
+		End:          fwhelpers.GetStringValue(m.End),
+		DNSToClients: fwhelpers.GetBoolValue(m.DNSToClients),
+	}
+}
+
+// FromClient updates the Terraform model from a client.VPNAddressPool.
+func (m *VPNAddressPoolModel) FromClient(pool *client.VPNAddressPool) {
+	m.PP = types.StringValue(pool.PP)
+	m.Start = types.StringValue(pool.Start)
+	m.End = types.StringValue(pool.End)
+	m.DNSToClients = types.BoolValue(pool.DNSToClients)
+}
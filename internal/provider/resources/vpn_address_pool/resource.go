@@ -0,0 +1,287 @@
+package vpn_address_pool
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/validation"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &VPNAddressPoolResource{}
+	_ resource.ResourceWithImportState = &VPNAddressPoolResource{}
+)
+
+var ppSelectorPattern = regexp.MustCompile(`^(anonymous|[1-9]\d*)$`)
+
+// NewVPNAddressPoolResource creates a new VPN address pool resource.
+func NewVPNAddressPoolResource() resource.Resource {
+	return &VPNAddressPoolResource{}
+}
+
+// VPNAddressPoolResource defines the resource implementation.
+type VPNAddressPoolResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *VPNAddressPoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpn_address_pool"
+}
+
+// Schema defines the schema for the resource.
+func (r *VPNAddressPoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the address pool handed to remote access VPN (L2TP, PPTP) clients of a PP context, " +
+			"and whether the router's DNS servers are passed to them. Kept separate from rtx_l2tp so resizing " +
+			"the pool is an in-place update that does not recreate the VPN service.",
+		Attributes: map[string]schema.Attribute{
+			"pp": schema.StringAttribute{
+				Description: "PP context using the pool: 'anonymous' (remote access VPN) or a PP number. Defaults to 'anonymous'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("anonymous"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(ppSelectorPattern, "must be 'anonymous' or a PP number"),
+				},
+			},
+			"start": schema.StringAttribute{
+				Description: "First IPv4 address of the pool.",
+				Required:    true,
+				Validators: []validator.String{
+					validation.IPv4AddressValidator(),
+				},
+			},
+			"end": schema.StringAttribute{
+				Description: "Last IPv4 address of the pool.",
+				Required:    true,
+				Validators: []validator.String{
+					validation.IPv4AddressValidator(),
+				},
+			},
+			"dns_to_clients": schema.BoolAttribute{
+				Description: "Hand the router's DNS servers to clients ('ppp ipcp msext on'). Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *VPNAddressPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *VPNAddressPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VPNAddressPoolModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_vpn_address_pool", data.PP.ValueString())
+	logger := logging.FromContext(ctx)
+
+	pool := data.ToClient()
+	logger.Debug().Str("resource", "rtx_vpn_address_pool").Msgf("Creating VPN address pool: %+v", pool)
+
+	if err := r.client.CreateVPNAddressPool(ctx, pool); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create VPN address pool",
+			fmt.Sprintf("Could not create VPN address pool: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *VPNAddressPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VPNAddressPoolModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.PP.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the address pool from the router.
+func (r *VPNAddressPoolResource) read(ctx context.Context, data *VPNAddressPoolModel, diagnostics *diag.Diagnostics) {
+	pp := data.PP.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_vpn_address_pool", pp)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_vpn_address_pool").Msgf("Reading VPN address pool for pp %s", pp)
+
+	var pool *client.VPNAddressPool
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			for _, parsed := range parsedConfig.ExtractVPNAddressPools() {
+				if parsed.PP == pp {
+					pool = &client.VPNAddressPool{
+						PP:           parsed.PP,
+						Start:        parsed.Start,
+						End:          parsed.End,
+						DNSToClients: parsed.DNSToClients,
+					}
+					logger.Debug().Str("resource", "rtx_vpn_address_pool").Msg("Found VPN address pool in SFTP cache")
+					break
+				}
+			}
+		}
+		if pool == nil {
+			logger.Debug().Str("resource", "rtx_vpn_address_pool").Msg("VPN address pool not in cache, falling back to SSH")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or pool not found in cache
+	if pool == nil {
+		var err error
+		pool, err = r.client.GetVPNAddressPool(ctx, pp)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				logger.Debug().Str("resource", "rtx_vpn_address_pool").Msgf("VPN address pool for pp %s not found, removing from state", pp)
+				data.PP = types.StringNull()
+				return
+			}
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read VPN address pool", fmt.Sprintf("Could not read VPN address pool for pp %s: %v", pp, err))
+			return
+		}
+	}
+
+	data.FromClient(pool)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *VPNAddressPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VPNAddressPoolModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_vpn_address_pool", data.PP.ValueString())
+	logger := logging.FromContext(ctx)
+
+	pool := data.ToClient()
+	logger.Debug().Str("resource", "rtx_vpn_address_pool").Msgf("Updating VPN address pool: %+v", pool)
+
+	if err := r.client.UpdateVPNAddressPool(ctx, pool); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update VPN address pool",
+			fmt.Sprintf("Could not update VPN address pool: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *VPNAddressPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VPNAddressPoolModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pp := data.PP.ValueString()
+
+	ctx = logging.WithResource(ctx, "rtx_vpn_address_pool", pp)
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_vpn_address_pool").Msgf("Deleting VPN address pool for pp %s", pp)
+
+	if err := r.client.DeleteVPNAddressPool(ctx, pp); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to delete VPN address pool",
+			fmt.Sprintf("Could not delete VPN address pool for pp %s: %v", pp, err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *VPNAddressPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !ppSelectorPattern.MatchString(req.ID) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Invalid import ID format: %s, expected 'anonymous' or a PP number", req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("pp"), req, resp)
+}
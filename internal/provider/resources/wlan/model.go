@@ -0,0 +1,74 @@
+package wlan
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+)
+
+// WLANModel describes the resource data model.
+type WLANModel struct {
+	ID      types.String `tfsdk:"id"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Channel types.Int64  `tfsdk:"channel"`
+	SSIDs   []SSIDModel  `tfsdk:"ssid"`
+
+	AppliedCommands types.List `tfsdk:"applied_commands"`
+}
+
+// SSIDModel describes an ssid block within the wireless LAN resource.
+type SSIDModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Security types.String `tfsdk:"security"`
+	PSK      types.String `tfsdk:"psk"`
+}
+
+// ToClient converts the Terraform model to a client.WLANConfig.
+func (m *WLANModel) ToClient() client.WLANConfig {
+	config := client.WLANConfig{
+		Enabled: fwhelpers.GetBoolValueWithDefault(m.Enabled, true),
+		Channel: fwhelpers.GetInt64Value(m.Channel),
+	}
+
+	for _, s := range m.SSIDs {
+		config.SSIDs = append(config.SSIDs, client.WLANSSID{
+			ID:       fwhelpers.GetInt64Value(s.ID),
+			Name:     fwhelpers.GetStringValue(s.Name),
+			Security: fwhelpers.GetStringValue(s.Security),
+			PSK:      fwhelpers.GetStringValue(s.PSK),
+		})
+	}
+
+	return config
+}
+
+// FromClient updates the Terraform model from a client.WLANConfig.
+// The router does not return pre-shared keys, so they are kept from the
+// current model.
+func (m *WLANModel) FromClient(config *client.WLANConfig) {
+	m.ID = types.StringValue("wlan")
+	m.Enabled = types.BoolValue(config.Enabled)
+	m.Channel = types.Int64Value(int64(config.Channel))
+
+	psks := make(map[int]types.String, len(m.SSIDs))
+	for _, s := range m.SSIDs {
+		psks[fwhelpers.GetInt64Value(s.ID)] = s.PSK
+	}
+
+	var ssids []SSIDModel
+	for _, s := range config.SSIDs {
+		psk, ok := psks[s.ID]
+		if !ok {
+			psk = types.StringNull()
+		}
+		ssids = append(ssids, SSIDModel{
+			ID:       types.Int64Value(int64(s.ID)),
+			Name:     types.StringValue(s.Name),
+			Security: types.StringValue(s.Security),
+			PSK:      psk,
+		})
+	}
+	m.SSIDs = ssids
+}
@@ -0,0 +1,328 @@
+package wlan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sh1/terraform-provider-rtx/internal/client"
+	"github.com/sh1/terraform-provider-rtx/internal/logging"
+	"github.com/sh1/terraform-provider-rtx/internal/provider/fwhelpers"
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &WLANResource{}
+	_ resource.ResourceWithImportState    = &WLANResource{}
+	_ resource.ResourceWithValidateConfig = &WLANResource{}
+)
+
+// NewWLANResource creates a new wireless LAN resource.
+func NewWLANResource() resource.Resource {
+	return &WLANResource{}
+}
+
+// WLANResource defines the resource implementation.
+type WLANResource struct {
+	client client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *WLANResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wlan"
+}
+
+// Schema defines the schema for the resource.
+func (r *WLANResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the built-in wireless LAN of models with Wi-Fi: radio channel, SSIDs, security mode and pre-shared keys. " +
+			"This is a singleton resource. Wireless support is detected on apply and routers without Wi-Fi are rejected.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (always 'wlan' for this singleton resource).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Enable the wireless LAN ('wlan use'). Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"channel": schema.Int64Attribute{
+				Description: "Radio channel: 0 for automatic selection, 1-13 (2.4 GHz) or 36-140 (5 GHz). Defaults to 0.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 140),
+				},
+			},
+			"applied_commands": fwhelpers.AppliedCommandsAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"ssid": schema.ListNestedBlock{
+				Description: "SSIDs broadcast by the wireless LAN.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "SSID number (1-4).",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 4),
+							},
+						},
+						"name": schema.StringAttribute{
+							Description: "Network name (1-32 characters).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 32),
+							},
+						},
+						"security": schema.StringAttribute{
+							Description: "Security mode: 'wpa2-psk', 'wpa3-sae', 'wpa2-wpa3-psk', or 'none' for an open network.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(parsers.ValidWLANSecurityModes...),
+							},
+						},
+						"psk": schema.StringAttribute{
+							Description: "Pre-shared key (8-63 characters). Required unless security is 'none'. Not read back from the router.",
+							Optional:    true,
+							Sensitive:   true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(8, 63),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the relation between the security mode and the pre-shared key.
+func (r *WLANResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data WLANModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, s := range data.SSIDs {
+		if s.Security.IsUnknown() || s.PSK.IsUnknown() {
+			continue
+		}
+		if s.Security.ValueString() != "none" && s.PSK.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ssid").AtListIndex(i).AtName("psk"),
+				"Missing Pre-Shared Key",
+				fmt.Sprintf("psk is required when security is %q.", s.Security.ValueString()),
+			)
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *WLANResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*fwhelpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *fwhelpers.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *WLANResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WLANModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_wlan", "wlan")
+	logger := logging.FromContext(ctx)
+
+	config := data.ToClient()
+	logger.Debug().Str("resource", "rtx_wlan").Msgf("Creating wireless LAN configuration: enabled=%v channel=%d ssids=%d", config.Enabled, config.Channel, len(config.SSIDs))
+
+	if err := r.client.ConfigureWLAN(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to configure wireless LAN",
+			fmt.Sprintf("Could not configure wireless LAN: %v", err),
+		)
+		return
+	}
+
+	// Set the ID for singleton resource
+	data.ID = types.StringValue("wlan")
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *WLANResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WLANModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if resource was removed
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read is a helper function that reads the wireless LAN configuration from the router.
+func (r *WLANResource) read(ctx context.Context, data *WLANModel, diagnostics *diag.Diagnostics) {
+	ctx = logging.WithResource(ctx, "rtx_wlan", "wlan")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_wlan").Msg("Reading wireless LAN configuration")
+
+	var config *client.WLANConfig
+
+	// Try to use SFTP cache if enabled
+	if r.client.SFTPEnabled() {
+		parsedConfig, err := r.client.GetCachedConfig(ctx)
+		if err == nil && parsedConfig != nil {
+			converted := convertParsedWLANConfig(parsedConfig.ExtractWLAN())
+			config = &converted
+			logger.Debug().Str("resource", "rtx_wlan").Msg("Found wireless LAN configuration in SFTP cache")
+		}
+	}
+
+	// Fallback to SSH if SFTP disabled or cache unavailable
+	if config == nil {
+		var err error
+		config, err = r.client.GetWLAN(ctx)
+		if err != nil {
+			fwhelpers.AppendDiagError(diagnostics, "Failed to read wireless LAN configuration", fmt.Sprintf("Could not read wireless LAN configuration: %v", err))
+			return
+		}
+	}
+
+	if !config.Enabled && len(config.SSIDs) == 0 {
+		logger.Debug().Str("resource", "rtx_wlan").Msg("Wireless LAN not configured, removing from state")
+		data.ID = types.StringNull()
+		return
+	}
+
+	data.FromClient(config)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *WLANResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WLANModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_wlan", "wlan")
+	logger := logging.FromContext(ctx)
+
+	config := data.ToClient()
+	logger.Debug().Str("resource", "rtx_wlan").Msgf("Updating wireless LAN configuration: enabled=%v channel=%d ssids=%d", config.Enabled, config.Channel, len(config.SSIDs))
+
+	if err := r.client.UpdateWLAN(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update wireless LAN configuration",
+			fmt.Sprintf("Could not update wireless LAN configuration: %v", err),
+		)
+		return
+	}
+
+	r.read(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *WLANResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WLANModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logging.WithResource(ctx, "rtx_wlan", "wlan")
+	logger := logging.FromContext(ctx)
+
+	logger.Debug().Str("resource", "rtx_wlan").Msg("Deleting wireless LAN configuration")
+
+	if err := r.client.ResetWLAN(ctx); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to remove wireless LAN configuration",
+			fmt.Sprintf("Could not remove wireless LAN configuration: %v", err),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *WLANResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fwhelpers.ImportSingleton(ctx, "wlan", req, resp)
+}
+
+// convertParsedWLANConfig converts a parser WLANConfig to a client WLANConfig.
+func convertParsedWLANConfig(parsed *parsers.WLANConfig) client.WLANConfig {
+	config := client.WLANConfig{
+		Enabled: parsed.Enabled,
+		Channel: parsed.Channel,
+	}
+	for _, s := range parsed.SSIDs {
+		config.SSIDs = append(config.SSIDs, client.WLANSSID(s))
+	}
+	return config
+}
@@ -6,6 +6,8 @@ import (
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/sh1/terraform-provider-rtx/internal/rtx/parsers"
 )
 
 // IPv4AddressValidator returns a validator that checks if the string is a valid IPv4 address.
@@ -162,3 +164,32 @@ func (v interfaceNameValidator) ValidateString(ctx context.Context, req validato
 		)
 	}
 }
+
+// IPFilterProtocolValidator returns a validator that checks if the string is a protocol accepted by "ip filter".
+func IPFilterProtocolValidator() validator.String {
+	return &ipFilterProtocolValidator{}
+}
+
+type ipFilterProtocolValidator struct{}
+
+func (v ipFilterProtocolValidator) Description(ctx context.Context) string {
+	return "value must be an IP filter protocol"
+}
+
+func (v ipFilterProtocolValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an IP filter protocol"
+}
+
+func (v ipFilterProtocolValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := parsers.ValidateIPFilterProtocol(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Filter Protocol",
+			err.Error(),
+		)
+	}
+}
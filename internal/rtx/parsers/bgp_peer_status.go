@@ -0,0 +1,81 @@
+package parsers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// BGPPeerStatus represents the session state of a BGP peer as reported by "show status bgp neighbor"
+type BGPPeerStatus struct {
+	Address          string `json:"address"`             // Peer IP address
+	RemoteAS         string `json:"remote_as"`           // Remote AS number
+	RouterID         string `json:"router_id,omitempty"` // BGP identifier of the peer
+	State            string `json:"state"`               // Session state (idle, connect, active, opensent, openconfirm, established)
+	Uptime           string `json:"uptime,omitempty"`    // Time since the session was established
+	PrefixesReceived int    `json:"prefixes_received"`   // Number of prefixes received from the peer
+	PrefixesAccepted int    `json:"prefixes_accepted"`   // Number of received prefixes accepted into the table
+	PrefixesSent     int    `json:"prefixes_sent"`       // Number of prefixes advertised to the peer
+}
+
+var (
+	bgpPeerHeaderPattern   = regexp.MustCompile(`(?i)^\s*BGP neighbor is\s+([0-9A-Fa-f.:]+),\s*remote AS\s+(\d+(?:\.\d+)?)`)
+	bgpPeerRouterIDPattern = regexp.MustCompile(`(?i)remote router ID\s+(\d+\.\d+\.\d+\.\d+)`)
+	bgpPeerStatePattern    = regexp.MustCompile(`(?i)BGP state\s*=\s*(\w+)(?:,\s*up for\s+(\S+))?`)
+	bgpPeerReceivedPattern = regexp.MustCompile(`(?i)prefixes received\s+(\d+)`)
+	bgpPeerAcceptedPattern = regexp.MustCompile(`(?i)(\d+)\s+accepted prefixes|accepted\s+(\d+)`)
+	bgpPeerSentPattern     = regexp.MustCompile(`(?i)(?:prefixes (?:sent|advertised)|advertised)\s+(\d+)`)
+)
+
+// ParseBGPPeerStatus parses the per-neighbor blocks of "show status bgp neighbor"
+func ParseBGPPeerStatus(raw string) []BGPPeerStatus {
+	peers := []BGPPeerStatus{}
+	var current *BGPPeerStatus
+
+	flush := func() {
+		if current != nil {
+			peers = append(peers, *current)
+			current = nil
+		}
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n") {
+		if m := bgpPeerHeaderPattern.FindStringSubmatch(line); m != nil {
+			flush()
+			current = &BGPPeerStatus{Address: m[1], RemoteAS: m[2]}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		if m := bgpPeerRouterIDPattern.FindStringSubmatch(line); m != nil {
+			current.RouterID = m[1]
+		}
+		if m := bgpPeerStatePattern.FindStringSubmatch(line); m != nil {
+			current.State = strings.ToLower(m[1])
+			current.Uptime = m[2]
+		}
+		if m := bgpPeerReceivedPattern.FindStringSubmatch(line); m != nil {
+			current.PrefixesReceived, _ = strconv.Atoi(m[1])
+		}
+		if m := bgpPeerAcceptedPattern.FindStringSubmatch(line); m != nil {
+			value := m[1]
+			if value == "" {
+				value = m[2]
+			}
+			current.PrefixesAccepted, _ = strconv.Atoi(value)
+		}
+		if m := bgpPeerSentPattern.FindStringSubmatch(line); m != nil {
+			current.PrefixesSent, _ = strconv.Atoi(m[1])
+		}
+	}
+	flush()
+
+	return peers
+}
+
+// BuildShowBGPPeerStatusCommand builds the command to show BGP neighbor status
+func BuildShowBGPPeerStatusCommand() string {
+	return "show status bgp neighbor"
+}
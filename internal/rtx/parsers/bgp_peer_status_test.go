@@ -0,0 +1,59 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseBGPPeerStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want []BGPPeerStatus
+	}{
+		{
+			name: "established and idle peers",
+			raw: `BGP neighbor is 203.0.113.1, remote AS 65001, local AS 65000, external link
+  BGP version 4, remote router ID 203.0.113.1
+  BGP state = Established, up for 2d03h15m
+  Last read 00:00:12, hold time is 90, keepalive interval is 30 seconds
+  Prefixes received 120, accepted 118, advertised 4
+
+BGP neighbor is 198.51.100.1, remote AS 65002, local AS 65000, external link
+  BGP version 4, remote router ID 0.0.0.0
+  BGP state = Active
+  Prefixes received 0, accepted 0, advertised 0
+`,
+			want: []BGPPeerStatus{
+				{Address: "203.0.113.1", RemoteAS: "65001", RouterID: "203.0.113.1", State: "established", Uptime: "2d03h15m", PrefixesReceived: 120, PrefixesAccepted: 118, PrefixesSent: 4},
+				{Address: "198.51.100.1", RemoteAS: "65002", RouterID: "0.0.0.0", State: "active"},
+			},
+		},
+		{
+			name: "accepted prefixes line",
+			raw: `BGP neighbor is 10.0.0.2, remote AS 65010, local AS 65000, internal link
+  BGP state = Established, up for 00:10:05
+  12 accepted prefixes
+  Prefixes received 12
+  Prefixes sent 3
+`,
+			want: []BGPPeerStatus{
+				{Address: "10.0.0.2", RemoteAS: "65010", State: "established", Uptime: "00:10:05", PrefixesReceived: 12, PrefixesAccepted: 12, PrefixesSent: 3},
+			},
+		},
+		{
+			name: "bgp not running",
+			raw:  "",
+			want: []BGPPeerStatus{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseBGPPeerStatus(tt.raw)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseBGPPeerStatus() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package parsers
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CertificateConfig represents a "pki certificate file" entry that registers a
+// certificate file stored on the router for IKEv2 or the HTTPS GUI
+type CertificateConfig struct {
+	ID   int    `json:"id"`   // Certificate ID
+	File string `json:"file"` // Path of the certificate file on the router
+}
+
+var certificateFilePattern = regexp.MustCompile(`^\s*pki\s+certificate\s+file\s+(\d+)\s+(\S+)\s+(\S+)(?:\s+\S+)?\s*$`)
+
+// ParseCertificates parses "pki certificate file" lines from the router configuration
+func ParseCertificates(raw string) []CertificateConfig {
+	var certs []CertificateConfig
+
+	for _, line := range strings.Split(raw, "\n") {
+		matches := certificateFilePattern.FindStringSubmatch(strings.TrimSpace(line))
+		if len(matches) < 3 {
+			continue
+		}
+		id, err := strconv.Atoi(matches[1])
+		if err != nil {
+			continue
+		}
+		certs = append(certs, CertificateConfig{ID: id, File: matches[2]})
+	}
+
+	return certs
+}
+
+// BuildCertificateFileCommand builds the command to register a PEM certificate file
+// Command format: pki certificate file <id> <file> pem
+func BuildCertificateFileCommand(id int, file string) string {
+	return fmt.Sprintf("pki certificate file %d %s pem", id, file)
+}
+
+// BuildDeleteCertificateFileCommand builds the command to unregister a certificate file
+// Command format: no pki certificate file <id>
+func BuildDeleteCertificateFileCommand(id int) string {
+	return fmt.Sprintf("no pki certificate file %d", id)
+}
+
+// BuildShowCertificatesCommand builds the command to show registered certificates
+func BuildShowCertificatesCommand() string {
+	return `show config | grep "pki certificate"`
+}
+
+// ValidateCertificatePEM checks that the content holds at least one PEM certificate,
+// and that the private key, if given, is a PEM private key
+func ValidateCertificatePEM(certificatePEM, privateKeyPEM string) error {
+	if _, err := firstCertificate(certificatePEM); err != nil {
+		return err
+	}
+	if privateKeyPEM != "" {
+		block, _ := pem.Decode([]byte(privateKeyPEM))
+		if block == nil || !strings.HasSuffix(block.Type, "PRIVATE KEY") {
+			return fmt.Errorf("private key must be a PEM encoded private key")
+		}
+	}
+	return nil
+}
+
+// CertificateFingerprint returns the SHA-256 fingerprint of the first certificate
+// in the PEM content, formatted as colon separated upper-case hex
+func CertificateFingerprint(certificatePEM string) (string, error) {
+	cert, err := firstCertificate(certificatePEM)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(cert.Raw)
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(parts, ":"), nil
+}
+
+// firstCertificate decodes the first CERTIFICATE block of the PEM content
+func firstCertificate(certificatePEM string) (*x509.Certificate, error) {
+	rest := []byte(certificatePEM)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("no PEM encoded certificate found")
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate: %w", err)
+		}
+		return cert, nil
+	}
+}
+
+// ValidateCertificateConfig validates a certificate registration
+func ValidateCertificateConfig(config CertificateConfig) error {
+	if config.ID < 1 || config.ID > 99 {
+		return fmt.Errorf("certificate ID must be between 1 and 99, got %d", config.ID)
+	}
+	if !strings.HasPrefix(config.File, "/") || strings.ContainsAny(config.File, " \t\"") {
+		return fmt.Errorf("certificate file must be an absolute path without whitespace, got %q", config.File)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package parsers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// generateTestCertificate returns a self-signed certificate and its key in PEM form
+func generateTestCertificate(t *testing.T) (string, string, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "rtx.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return certPEM, keyPEM, der
+}
+
+func TestParseCertificates(t *testing.T) {
+	raw := `pki certificate file 1 /pki/cert1.pem pem
+pki certificate file 2 /pki/client.p12 pkcs12 secret
+pki crl file 1 /pki/crl.pem`
+
+	want := []CertificateConfig{
+		{ID: 1, File: "/pki/cert1.pem"},
+		{ID: 2, File: "/pki/client.p12"},
+	}
+	if got := ParseCertificates(raw); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseCertificates() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildCertificateCommands(t *testing.T) {
+	if got := BuildCertificateFileCommand(1, "/pki/cert1.pem"); got != "pki certificate file 1 /pki/cert1.pem pem" {
+		t.Errorf("BuildCertificateFileCommand() = %q", got)
+	}
+	if got := BuildDeleteCertificateFileCommand(1); got != "no pki certificate file 1" {
+		t.Errorf("BuildDeleteCertificateFileCommand() = %q", got)
+	}
+}
+
+func TestCertificateFingerprint(t *testing.T) {
+	certPEM, keyPEM, der := generateTestCertificate(t)
+
+	sum := sha256.Sum256(der)
+	var parts []string
+	for _, b := range sum {
+		parts = append(parts, fmt.Sprintf("%02X", b))
+	}
+	want := strings.Join(parts, ":")
+
+	// Key before certificate must be skipped
+	got, err := CertificateFingerprint(keyPEM + certPEM)
+	if err != nil {
+		t.Fatalf("CertificateFingerprint() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("CertificateFingerprint() = %q, want %q", got, want)
+	}
+
+	if _, err := CertificateFingerprint(keyPEM); err == nil {
+		t.Error("CertificateFingerprint() expected error for content without certificate")
+	}
+}
+
+func TestValidateCertificatePEM(t *testing.T) {
+	certPEM, keyPEM, _ := generateTestCertificate(t)
+
+	tests := []struct {
+		name    string
+		cert    string
+		key     string
+		wantErr bool
+	}{
+		{name: "certificate only", cert: certPEM},
+		{name: "certificate and key", cert: certPEM, key: keyPEM},
+		{name: "not pem", cert: "not a certificate", wantErr: true},
+		{name: "key is a certificate", cert: certPEM, key: certPEM, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCertificatePEM(tt.cert, tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCertificatePEM() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCertificateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  CertificateConfig
+		wantErr bool
+	}{
+		{name: "valid", config: CertificateConfig{ID: 1, File: "/pki/cert1.pem"}},
+		{name: "id zero", config: CertificateConfig{ID: 0, File: "/pki/cert1.pem"}, wantErr: true},
+		{name: "relative path", config: CertificateConfig{ID: 1, File: "cert1.pem"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCertificateConfig(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCertificateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ClockConfig represents clock settings of an RTX router: timezone, periodic
+// NTP synchronisation and the built-in SNTP server
+type ClockConfig struct {
+	Timezone       string `json:"timezone"`                   // UTC offset (e.g., "+09:00")
+	NTPServer      string `json:"ntp_server,omitempty"`       // Upstream NTP server queried by ntpdate
+	SyncScheduleID int    `json:"sync_schedule_id,omitempty"` // "schedule at" ID of the periodic ntpdate
+	SyncTime       string `json:"sync_time,omitempty"`        // Daily time of the periodic ntpdate (HH:MM)
+	SNTPServer     bool   `json:"sntp_server"`                // Serve time to LAN clients (sntpd service)
+}
+
+var (
+	clockTimezonePattern = regexp.MustCompile(`^\s*timezone\s+([\+\-]?\d{2}:\d{2})\s*$`)
+	clockNTPDatePattern  = regexp.MustCompile(`^\s*schedule\s+at\s+(\d+)\s+(\d{1,2}:\d{2})\s+ntpdate\s+(\S+)(?:\s+syslog)?\s*$`)
+	clockSNTPDPattern    = regexp.MustCompile(`^\s*sntpd\s+service\s+(on|off)\s*$`)
+	clockOffsetPattern   = regexp.MustCompile(`^[\+\-](\d{2}):(\d{2})$`)
+)
+
+// ParseClockConfig parses clock related lines from the router configuration.
+// Returns nil when no timezone is configured.
+func ParseClockConfig(raw string) *ClockConfig {
+	config := &ClockConfig{}
+
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if matches := clockTimezonePattern.FindStringSubmatch(line); len(matches) >= 2 {
+			config.Timezone = matches[1]
+			continue
+		}
+
+		// Only the first scheduled ntpdate is managed
+		if matches := clockNTPDatePattern.FindStringSubmatch(line); len(matches) >= 4 && config.SyncScheduleID == 0 {
+			config.SyncScheduleID, _ = strconv.Atoi(matches[1])
+			config.SyncTime = matches[2]
+			config.NTPServer = matches[3]
+			continue
+		}
+
+		if matches := clockSNTPDPattern.FindStringSubmatch(line); len(matches) >= 2 {
+			config.SNTPServer = matches[1] == "on"
+		}
+	}
+
+	if config.Timezone == "" {
+		return nil
+	}
+	return config
+}
+
+// BuildNTPDateScheduleCommand builds the scheduled clock synchronisation job
+// Command format: schedule at <id> <time> ntpdate <server> syslog
+func BuildNTPDateScheduleCommand(id int, time, server string) string {
+	return BuildScheduleAtCommand(id, time, fmt.Sprintf("ntpdate %s syslog", server))
+}
+
+// BuildSNTPDServiceCommand builds the command to enable or disable the SNTP server
+// Command format: sntpd service on|off
+func BuildSNTPDServiceCommand(enabled bool) string {
+	if enabled {
+		return "sntpd service on"
+	}
+	return "sntpd service off"
+}
+
+// BuildClockCommands builds all commands needed to apply clock settings
+func BuildClockCommands(config ClockConfig) []string {
+	commands := []string{BuildTimezoneCommand(config.Timezone)}
+	if config.SyncScheduleID > 0 {
+		commands = append(commands, BuildNTPDateScheduleCommand(config.SyncScheduleID, config.SyncTime, config.NTPServer))
+	}
+	commands = append(commands, BuildSNTPDServiceCommand(config.SNTPServer))
+	return commands
+}
+
+// BuildDeleteClockCommands builds the commands needed to remove clock settings
+func BuildDeleteClockCommands(config ClockConfig) []string {
+	var commands []string
+	if config.SyncScheduleID > 0 {
+		commands = append(commands, BuildDeleteScheduleCommand(config.SyncScheduleID))
+	}
+	commands = append(commands, "no sntpd service", BuildDeleteTimezoneCommand())
+	return commands
+}
+
+// BuildShowClockConfigCommands builds the commands to show clock related settings
+func BuildShowClockConfigCommands() []string {
+	return []string{
+		"show config | grep timezone",
+		"show config | grep ntpdate",
+		"show config | grep sntpd",
+	}
+}
+
+// ValidateTimezoneOffset validates a UTC offset such as "+09:00"
+func ValidateTimezoneOffset(tz string) error {
+	matches := clockOffsetPattern.FindStringSubmatch(tz)
+	if matches == nil {
+		return fmt.Errorf("invalid timezone %q, expected a UTC offset such as +09:00", tz)
+	}
+	hours, _ := strconv.Atoi(matches[1])
+	minutes, _ := strconv.Atoi(matches[2])
+	if hours > 14 || minutes > 59 || (minutes != 0 && minutes != 30 && minutes != 45) {
+		return fmt.Errorf("invalid timezone %q, offset out of range", tz)
+	}
+	return nil
+}
+
+// ValidateClockConfig validates clock settings
+func ValidateClockConfig(config ClockConfig) error {
+	if err := ValidateTimezoneOffset(config.Timezone); err != nil {
+		return err
+	}
+
+	if config.SyncScheduleID == 0 {
+		if config.NTPServer != "" || config.SyncTime != "" {
+			return fmt.Errorf("sync schedule id is required for NTP synchronisation")
+		}
+		return nil
+	}
+	if config.SyncScheduleID < 1 || config.SyncScheduleID > 65535 {
+		return fmt.Errorf("schedule id must be between 1 and 65535, got %d", config.SyncScheduleID)
+	}
+	if config.NTPServer == "" || strings.ContainsAny(config.NTPServer, " \t") {
+		return fmt.Errorf("invalid NTP server %q", config.NTPServer)
+	}
+	return ValidateTimeFormat(config.SyncTime)
+}
@@ -0,0 +1,97 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseClockConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want *ClockConfig
+	}{
+		{
+			name: "no timezone",
+			raw:  "sntpd service on",
+			want: nil,
+		},
+		{
+			name: "timezone only",
+			raw:  "timezone +09:00",
+			want: &ClockConfig{Timezone: "+09:00"},
+		},
+		{
+			name: "full configuration",
+			raw: `timezone +09:00
+schedule at 30 03:00 ntpdate ntp.nict.jp syslog
+schedule at 1 04:00 copy config 0 usb1:/backup.txt
+sntpd service on`,
+			want: &ClockConfig{
+				Timezone:       "+09:00",
+				NTPServer:      "ntp.nict.jp",
+				SyncScheduleID: 30,
+				SyncTime:       "03:00",
+				SNTPServer:     true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseClockConfig(tt.raw); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseClockConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildClockCommands(t *testing.T) {
+	config := ClockConfig{
+		Timezone:       "+09:00",
+		NTPServer:      "ntp.nict.jp",
+		SyncScheduleID: 30,
+		SyncTime:       "03:00",
+		SNTPServer:     true,
+	}
+
+	want := []string{
+		"timezone +09:00",
+		"schedule at 30 03:00 ntpdate ntp.nict.jp syslog",
+		"sntpd service on",
+	}
+	if got := BuildClockCommands(config); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildClockCommands() = %v, want %v", got, want)
+	}
+
+	wantDelete := []string{"no schedule at 30", "no sntpd service", "no timezone"}
+	if got := BuildDeleteClockCommands(config); !reflect.DeepEqual(got, wantDelete) {
+		t.Errorf("BuildDeleteClockCommands() = %v, want %v", got, wantDelete)
+	}
+}
+
+func TestValidateClockConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  ClockConfig
+		wantErr bool
+	}{
+		{name: "timezone only", config: ClockConfig{Timezone: "+09:00"}},
+		{name: "negative offset", config: ClockConfig{Timezone: "-05:00"}},
+		{name: "half hour offset", config: ClockConfig{Timezone: "+05:30"}},
+		{name: "missing sign", config: ClockConfig{Timezone: "09:00"}, wantErr: true},
+		{name: "offset out of range", config: ClockConfig{Timezone: "+15:00"}, wantErr: true},
+		{name: "ntp sync", config: ClockConfig{Timezone: "+09:00", SyncScheduleID: 1, SyncTime: "03:00", NTPServer: "192.0.2.123"}},
+		{name: "ntp sync without server", config: ClockConfig{Timezone: "+09:00", SyncScheduleID: 1, SyncTime: "03:00"}, wantErr: true},
+		{name: "ntp server without schedule", config: ClockConfig{Timezone: "+09:00", NTPServer: "ntp.nict.jp"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateClockConfig(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateClockConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package parsers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// readOnlyCommandPrefixes lists the command families that may be run through
+// the generic command data source. Only "show" commands are allowed, so the
+// data source can never change the router state.
+var readOnlyCommandPrefixes = []string{
+	"show",
+}
+
+// sensitiveCommandPrefixes lists read-only commands whose output may contain
+// credentials. They are rejected so that secrets do not end up in the state;
+// rtx_config returns the configuration as a sensitive value instead. "show
+// techinfo" dumps the full configuration along with the diagnostics.
+var sensitiveCommandPrefixes = []string{
+	"show config",
+	"show file",
+	"show techinfo",
+}
+
+// sessionCommandPrefixes lists commands that end or change the session the
+// provider runs commands in, so they cannot be executed through rtx_exec
+var sessionCommandPrefixes = []string{
+	"administrator",
+	"exit",
+	"quit",
+	"login",
+}
+
+// NormalizeCommand collapses runs of whitespace and trims the command
+func NormalizeCommand(command string) string {
+	return strings.Join(strings.Fields(command), " ")
+}
+
+// ValidateReadOnlyCommand checks that a command is on the read-only whitelist.
+// Pipes, command separators and control characters are rejected so that a
+// single whitelisted command cannot be chained with another one.
+func ValidateReadOnlyCommand(command string) error {
+	for _, r := range command {
+		if r < 0x20 || r == 0x7f {
+			return fmt.Errorf("command must not contain control characters, got %q", command)
+		}
+	}
+	if strings.ContainsAny(command, "|;>") {
+		return fmt.Errorf("command must not contain pipes, redirections or separators, got %q", command)
+	}
+
+	normalized := strings.ToLower(NormalizeCommand(command))
+	if normalized == "" {
+		return fmt.Errorf("command must not be empty")
+	}
+
+	for _, prefix := range sensitiveCommandPrefixes {
+		if hasCommandPrefix(normalized, prefix) {
+			return fmt.Errorf("command %q may expose credentials and is not allowed; use the rtx_config data source instead", command)
+		}
+	}
+	for _, prefix := range readOnlyCommandPrefixes {
+		if hasCommandPrefix(normalized, prefix) {
+			return nil
+		}
+	}
+	return fmt.Errorf("command %q is not a read-only command; allowed commands start with: %s", command, strings.Join(readOnlyCommandPrefixes, ", "))
+}
+
+// ValidateExecCommand checks that a command can be executed through the
+// rtx_exec resource: a single non-empty line that keeps the session in
+// administrator mode.
+func ValidateExecCommand(command string) error {
+	for _, r := range command {
+		if r < 0x20 || r == 0x7f {
+			return fmt.Errorf("command must not contain control characters, got %q", command)
+		}
+	}
+
+	normalized := strings.ToLower(NormalizeCommand(command))
+	if normalized == "" {
+		return fmt.Errorf("command must not be empty")
+	}
+	for _, prefix := range sessionCommandPrefixes {
+		if hasCommandPrefix(normalized, prefix) {
+			return fmt.Errorf("command %q ends or changes the session and cannot be executed", command)
+		}
+	}
+	return nil
+}
+
+// hasCommandPrefix reports whether command starts with prefix on a word boundary
+func hasCommandPrefix(command, prefix string) bool {
+	return command == prefix || strings.HasPrefix(command, prefix+" ")
+}
+
+// ParseCommandExitStatus derives an exit status from command output. RTX
+// routers do not report exit codes, so a command is considered failed (1)
+// when the router prints an error line, and successful (0) otherwise.
+func ParseCommandExitStatus(output string) int {
+	for _, line := range strings.Split(strings.ReplaceAll(output, "\r\n", "\n"), "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimPrefix(line, "% ")
+		if strings.HasPrefix(line, "Error:") || strings.HasPrefix(line, "エラー:") || strings.HasPrefix(line, "エラー：") {
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,83 @@
+package parsers
+
+import "testing"
+
+func TestValidateReadOnlyCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		wantErr bool
+	}{
+		{name: "show command", command: "show status lan1", wantErr: false},
+		{name: "extra whitespace", command: "  show   environment ", wantErr: false},
+		{name: "upper case", command: "SHOW ip route", wantErr: false},
+		{name: "empty", command: "   ", wantErr: true},
+		{name: "configuration command", command: "ip route default gateway pp 1", wantErr: true},
+		{name: "show prefix without boundary", command: "showx", wantErr: true},
+		{name: "save", command: "save", wantErr: true},
+		{name: "pipe", command: "show log | grep PPP", wantErr: true},
+		{name: "separator", command: "show status lan1; save", wantErr: true},
+		{name: "newline", command: "show status lan1\nsave", wantErr: true},
+		{name: "show config", command: "show config", wantErr: true},
+		{name: "show config with argument", command: "show  config 1", wantErr: true},
+		{name: "show file", command: "show file list /", wantErr: true},
+		{name: "show techinfo", command: "show techinfo", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateReadOnlyCommand(tt.command)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateReadOnlyCommand(%q) error = %v, wantErr %v", tt.command, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateExecCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		wantErr bool
+	}{
+		{name: "operational command", command: "disconnect pp 1", wantErr: false},
+		{name: "clear command", command: "clear nat descriptor dynamic 1000", wantErr: false},
+		{name: "show command", command: "show status pp 1", wantErr: false},
+		{name: "empty", command: " ", wantErr: true},
+		{name: "newline", command: "disconnect pp 1\nsave", wantErr: true},
+		{name: "exit", command: "exit", wantErr: true},
+		{name: "administrator", command: "Administrator", wantErr: true},
+		{name: "administrator password", command: "administrator password", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateExecCommand(tt.command)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateExecCommand(%q) error = %v, wantErr %v", tt.command, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseCommandExitStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   int
+	}{
+		{name: "normal output", output: "LAN1\nReceived buffer error: 0\n", want: 0},
+		{name: "empty output", output: "", want: 0},
+		{name: "english error", output: "Error: Invalid command name\n", want: 1},
+		{name: "prefixed error", output: "% Error: Invalid parameter\r\n", want: 1},
+		{name: "japanese error", output: "エラー: コマンドが見つかりません\n", want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseCommandExitStatus(tt.output); got != tt.want {
+				t.Errorf("ParseCommandExitStatus() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
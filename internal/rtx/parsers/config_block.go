@@ -0,0 +1,175 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ConfigBlock represents a set of raw configuration lines owned as a unit
+type ConfigBlock struct {
+	Context  string   `json:"context,omitempty"`  // Context select command (e.g., "tunnel select 1"), empty for global
+	Lines    []string `json:"lines"`              // Raw command lines
+	Prefixes []string `json:"prefixes,omitempty"` // Command prefixes owned by the block
+}
+
+var configBlockSelectPattern = regexp.MustCompile(`^(pp|tunnel)\s+select\s+`)
+
+// configBlockForbiddenPrefixes lists commands that must not be managed through a config block
+var configBlockForbiddenPrefixes = []string{"no ", "save", "restart", "clear ", "administrator", "console ", "login "}
+
+// NormalizeConfigBlockLine collapses whitespace in a configuration line
+func NormalizeConfigBlockLine(line string) string {
+	return strings.Join(strings.Fields(line), " ")
+}
+
+// ParseConfigBlockContext parses the context select command of a config block.
+// An empty string selects the global context and returns nil.
+func ParseConfigBlockContext(context string) (*ParseContext, error) {
+	if context == "" {
+		return nil, nil
+	}
+
+	ctx := NewConfigFileParser().detectContext(NormalizeConfigBlockLine(context), 0)
+	if ctx == nil || ctx.Type == ContextIPsecTunnel {
+		return nil, fmt.Errorf("context must be 'tunnel select N', 'pp select N' or 'pp select anonymous', got %q", context)
+	}
+	return ctx, nil
+}
+
+// IsConfigBlockLineOwned reports whether a line is owned by a config block.
+// Without prefixes, a block owns exactly its own lines.
+func IsConfigBlockLineOwned(block ConfigBlock, line string) bool {
+	line = NormalizeConfigBlockLine(line)
+	if len(block.Prefixes) == 0 {
+		for _, l := range block.Lines {
+			if NormalizeConfigBlockLine(l) == line {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, prefix := range block.Prefixes {
+		prefix = NormalizeConfigBlockLine(prefix)
+		if line == prefix || strings.HasPrefix(line, prefix+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+// ExtractConfigBlockLines returns the configuration lines owned by a block,
+// in configuration order
+func (pc *ParsedConfig) ExtractConfigBlockLines(block ConfigBlock) ([]string, error) {
+	ctx, err := ParseConfigBlockContext(block.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	var commands []ParsedCommand
+	if ctx == nil {
+		commands = pc.GetGlobalCommands()
+	} else {
+		commands = pc.GetCommandsInContext(*ctx)
+	}
+
+	lines := []string{}
+	for _, cmd := range commands {
+		if IsConfigBlockLineOwned(block, cmd.Line) {
+			lines = append(lines, NormalizeConfigBlockLine(cmd.Line))
+		}
+	}
+	return lines, nil
+}
+
+// BuildConfigBlockCommands builds the commands to apply the lines of a block
+func BuildConfigBlockCommands(block ConfigBlock) []string {
+	return wrapConfigBlockContext(block.Context, normalizeConfigBlockLines(block.Lines))
+}
+
+// BuildDeleteConfigBlockCommands builds the commands to remove the given lines
+// of a block. Lines are removed in reverse order with "no <line>".
+func BuildDeleteConfigBlockCommands(context string, lines []string) []string {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	commands := make([]string, 0, len(lines))
+	for i := len(lines) - 1; i >= 0; i-- {
+		commands = append(commands, "no "+NormalizeConfigBlockLine(lines[i]))
+	}
+	return wrapConfigBlockContext(context, commands)
+}
+
+// BuildShowConfigBlockCommand builds the command to read the configuration for config blocks
+func BuildShowConfigBlockCommand() string {
+	return "show config"
+}
+
+// ValidateConfigBlock validates a config block
+func ValidateConfigBlock(block ConfigBlock) error {
+	if _, err := ParseConfigBlockContext(block.Context); err != nil {
+		return err
+	}
+
+	if len(block.Lines) == 0 {
+		return fmt.Errorf("at least one line is required")
+	}
+
+	seen := make(map[string]bool, len(block.Lines))
+	for _, line := range block.Lines {
+		normalized := NormalizeConfigBlockLine(line)
+		if normalized == "" {
+			return fmt.Errorf("lines must not be empty")
+		}
+		if strings.ContainsAny(line, "\r\n") {
+			return fmt.Errorf("line %q must not contain line breaks", line)
+		}
+		if configBlockSelectPattern.MatchString(normalized) {
+			return fmt.Errorf("line %q: use context instead of select commands", line)
+		}
+		for _, forbidden := range configBlockForbiddenPrefixes {
+			if strings.HasPrefix(normalized, forbidden) {
+				return fmt.Errorf("line %q: %q commands cannot be managed by a config block", line, strings.TrimSpace(forbidden))
+			}
+		}
+		if seen[normalized] {
+			return fmt.Errorf("duplicate line %q", line)
+		}
+		seen[normalized] = true
+
+		if len(block.Prefixes) > 0 && !IsConfigBlockLineOwned(block, normalized) {
+			return fmt.Errorf("line %q does not match any owned prefix", line)
+		}
+	}
+
+	for _, prefix := range block.Prefixes {
+		if NormalizeConfigBlockLine(prefix) == "" {
+			return fmt.Errorf("prefixes must not be empty")
+		}
+	}
+
+	return nil
+}
+
+// normalizeConfigBlockLines normalizes all lines of a block
+func normalizeConfigBlockLines(lines []string) []string {
+	normalized := make([]string, len(lines))
+	for i, line := range lines {
+		normalized[i] = NormalizeConfigBlockLine(line)
+	}
+	return normalized
+}
+
+// wrapConfigBlockContext surrounds commands with the context select commands
+func wrapConfigBlockContext(context string, commands []string) []string {
+	if context == "" {
+		return commands
+	}
+
+	context = NormalizeConfigBlockLine(context)
+	selectNone := strings.SplitN(context, " ", 2)[0] + " select none"
+	return slices.Concat([]string{context}, commands, []string{selectNone})
+}
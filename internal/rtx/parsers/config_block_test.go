@@ -0,0 +1,118 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testConfigBlockConfig = `ip lan1 address 192.168.1.1/24
+ip lan1 intrusion detection in on
+ip lan1 intrusion detection in reject on
+ip lan1 proxyarp on
+tunnel select 1
+ tunnel encapsulation l2tpv3
+ tunnel backward-compatibility on
+ ip tunnel mtu 1280
+ tunnel enable 1
+pp select 1
+ pp always-on on
+ pp enable 1
+nat descriptor type 1 masquerade
+`
+
+func TestExtractConfigBlockLines(t *testing.T) {
+	parsed, err := NewConfigFileParser().Parse(testConfigBlockConfig)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		block    ConfigBlock
+		expected []string
+	}{
+		{
+			name:     "global prefix ownership",
+			block:    ConfigBlock{Prefixes: []string{"ip lan1 intrusion"}},
+			expected: []string{"ip lan1 intrusion detection in on", "ip lan1 intrusion detection in reject on"},
+		},
+		{
+			name:     "exact line ownership",
+			block:    ConfigBlock{Lines: []string{"ip lan1 proxyarp on", "ip lan1 proxyarp off"}},
+			expected: []string{"ip lan1 proxyarp on"},
+		},
+		{
+			name:     "prefix matches whole words only",
+			block:    ConfigBlock{Prefixes: []string{"ip lan1 intrusion detection in re"}},
+			expected: []string{},
+		},
+		{
+			name:     "tunnel context",
+			block:    ConfigBlock{Context: "tunnel select 1", Prefixes: []string{"tunnel backward-compatibility", "ip tunnel mtu"}},
+			expected: []string{"tunnel backward-compatibility on", "ip tunnel mtu 1280"},
+		},
+		{
+			name:     "context lines are not global",
+			block:    ConfigBlock{Prefixes: []string{"pp always-on"}},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := parsed.ExtractConfigBlockLines(tt.block)
+			if err != nil {
+				t.Fatalf("ExtractConfigBlockLines() error = %v", err)
+			}
+			if !reflect.DeepEqual(lines, tt.expected) {
+				t.Errorf("ExtractConfigBlockLines() = %v, want %v", lines, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuildConfigBlockCommands(t *testing.T) {
+	block := ConfigBlock{Context: "tunnel  select 1", Lines: []string{"tunnel backward-compatibility  on", "ip tunnel mtu 1280"}}
+
+	expected := []string{"tunnel select 1", "tunnel backward-compatibility on", "ip tunnel mtu 1280", "tunnel select none"}
+	if commands := BuildConfigBlockCommands(block); !reflect.DeepEqual(commands, expected) {
+		t.Errorf("BuildConfigBlockCommands() = %v, want %v", commands, expected)
+	}
+
+	expectedDelete := []string{"tunnel select 1", "no ip tunnel mtu 1280", "no tunnel backward-compatibility on", "tunnel select none"}
+	if commands := BuildDeleteConfigBlockCommands(block.Context, block.Lines); !reflect.DeepEqual(commands, expectedDelete) {
+		t.Errorf("BuildDeleteConfigBlockCommands() = %v, want %v", commands, expectedDelete)
+	}
+
+	if commands := BuildDeleteConfigBlockCommands("", nil); commands != nil {
+		t.Errorf("BuildDeleteConfigBlockCommands() with no lines = %v, want nil", commands)
+	}
+}
+
+func TestValidateConfigBlock(t *testing.T) {
+	tests := []struct {
+		name    string
+		block   ConfigBlock
+		wantErr bool
+	}{
+		{name: "valid global", block: ConfigBlock{Lines: []string{"ip lan1 proxyarp on"}}},
+		{name: "valid context", block: ConfigBlock{Context: "pp select anonymous", Lines: []string{"pp auth request chap"}}},
+		{name: "valid prefixes", block: ConfigBlock{Lines: []string{"ip lan1 proxyarp on"}, Prefixes: []string{"ip lan1 proxyarp"}}},
+		{name: "no lines", block: ConfigBlock{}, wantErr: true},
+		{name: "invalid context", block: ConfigBlock{Context: "ipsec tunnel 1", Lines: []string{"ipsec sa policy 1 1 esp"}}, wantErr: true},
+		{name: "select command in lines", block: ConfigBlock{Lines: []string{"tunnel select 2"}}, wantErr: true},
+		{name: "no command", block: ConfigBlock{Lines: []string{"no ip lan1 proxyarp"}}, wantErr: true},
+		{name: "save command", block: ConfigBlock{Lines: []string{"save"}}, wantErr: true},
+		{name: "duplicate line", block: ConfigBlock{Lines: []string{"ip lan1 proxyarp on", "ip lan1  proxyarp on"}}, wantErr: true},
+		{name: "line outside prefixes", block: ConfigBlock{Lines: []string{"ip lan2 proxyarp on"}, Prefixes: []string{"ip lan1"}}, wantErr: true},
+		{name: "multi-line value", block: ConfigBlock{Lines: []string{"ip lan1 proxyarp on\nsave"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateConfigBlock(tt.block); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateConfigBlock() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
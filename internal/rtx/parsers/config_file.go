@@ -633,6 +633,308 @@ func (pc *ParsedConfig) ExtractSyslog() *SyslogConfig {
 	return config
 }
 
+// ExtractMLD extracts MLD configuration from parsed config
+func (pc *ParsedConfig) ExtractMLD() *MLDConfig {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "ipv6 ") && strings.Contains(cmd.Line, " mld ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	config, _ := ParseMLDConfig(strings.Join(lines, "\n"))
+	return config
+}
+
+// ExtractIPKeepalives extracts "ip keepalive" monitors from parsed config
+func (pc *ParsedConfig) ExtractIPKeepalives() []IPKeepalive {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "ip keepalive ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	keepalives, _ := ParseIPKeepaliveConfig(strings.Join(lines, "\n"))
+	return keepalives
+}
+
+// ExtractFlowExport extracts flow export configuration from parsed config
+func (pc *ParsedConfig) ExtractFlowExport() *FlowExportConfig {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "ip ") && strings.Contains(cmd.Line, " flow export ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseFlowExportConfig(strings.Join(lines, "\n"))
+}
+
+// ExtractTrafficThresholds extracts "account threshold" settings from parsed config
+func (pc *ParsedConfig) ExtractTrafficThresholds() []TrafficThreshold {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "account threshold ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	thresholds, _ := ParseTrafficThresholdConfig(strings.Join(lines, "\n"))
+	return thresholds
+}
+
+// ExtractExternalMemoryBackup extracts external memory backup settings from parsed config
+func (pc *ParsedConfig) ExtractExternalMemoryBackup() *ExternalMemoryBackup {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "external-memory config ") ||
+			(strings.HasPrefix(cmd.Line, "schedule at ") && strings.Contains(cmd.Line, " copy config ")) {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseExternalMemoryBackup(strings.Join(lines, "\n"))
+}
+
+// ExtractFirmwareUpdate extracts firmware update settings from parsed config
+func (pc *ParsedConfig) ExtractFirmwareUpdate() *FirmwareUpdate {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "http revision-") ||
+			(strings.HasPrefix(cmd.Line, "schedule at ") && strings.Contains(cmd.Line, " http revision-up go")) {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseFirmwareUpdate(strings.Join(lines, "\n"))
+}
+
+// ExtractClock extracts clock settings from parsed config
+func (pc *ParsedConfig) ExtractClock() *ClockConfig {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "timezone ") || strings.HasPrefix(cmd.Line, "sntpd ") ||
+			(strings.HasPrefix(cmd.Line, "schedule at ") && strings.Contains(cmd.Line, " ntpdate ")) {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseClockConfig(strings.Join(lines, "\n"))
+}
+
+// ExtractRADIUS extracts RADIUS client configuration from parsed config
+func (pc *ParsedConfig) ExtractRADIUS() *RADIUSConfig {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "radius ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseRADIUSConfig(strings.Join(lines, "\n"))
+}
+
+// ExtractPPAuthUsers extracts "pp auth username" entries from parsed config
+func (pc *ParsedConfig) ExtractPPAuthUsers() []PPAuthUser {
+	return ParsePPAuthUsers(strings.Join(pc.ppContextLines(), "\n"))
+}
+
+// ExtractVPNAddressPools extracts remote access address pools from parsed config
+func (pc *ParsedConfig) ExtractVPNAddressPools() []VPNAddressPool {
+	return ParseVPNAddressPools(strings.Join(pc.ppContextLines(), "\n"))
+}
+
+// ppContextLines returns the commands of all PP contexts, each preceded by its
+// "pp select" line so context-aware parsers can attribute them
+func (pc *ParsedConfig) ppContextLines() []string {
+	var lines []string
+	for _, ctx := range pc.Contexts {
+		if ctx.Type != ContextPP {
+			continue
+		}
+		if ctx.Name == "anonymous" {
+			lines = append(lines, BuildPPSelectByNameCommand("anonymous"))
+		} else {
+			lines = append(lines, BuildPPSelectCommand(ctx.ID))
+		}
+		for _, cmd := range pc.GetCommandsInContext(ctx) {
+			lines = append(lines, cmd.Line)
+		}
+	}
+	return lines
+}
+
+// ExtractCertificates extracts "pki certificate file" entries from parsed config
+func (pc *ParsedConfig) ExtractCertificates() []CertificateConfig {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "pki certificate file ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseCertificates(strings.Join(lines, "\n"))
+}
+
+// ExtractIKEv2Tunnels extracts IKEv2 tunnels from parsed config.
+// IKE gateway settings may appear inside or outside the tunnel context, so the raw config is parsed.
+func (pc *ParsedConfig) ExtractIKEv2Tunnels() []IKEv2Tunnel {
+	return ParseIKEv2Tunnels(pc.Raw)
+}
+
+// ExtractIPv6NeighborStatics extracts "ipv6 neighbor static" entries from parsed config
+func (pc *ParsedConfig) ExtractIPv6NeighborStatics() []IPv6NeighborStatic {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "ipv6 neighbor static ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseIPv6NeighborStatics(strings.Join(lines, "\n"))
+}
+
+// ExtractIPv6RTADVs extracts "ipv6 <interface> rtadv send" settings from parsed config
+func (pc *ParsedConfig) ExtractIPv6RTADVs() []IPv6RTADV {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "ipv6 ") && strings.Contains(cmd.Line, " rtadv send ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseIPv6RTADVs(strings.Join(lines, "\n"))
+}
+
+// ExtractLinkAggregations extracts "lan link-aggregation" entries from parsed config
+func (pc *ParsedConfig) ExtractLinkAggregations() []LinkAggregation {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "lan link-aggregation ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseLinkAggregations(strings.Join(lines, "\n"))
+}
+
+// ExtractLoopbackInterfaces extracts "ip loopbackN address" entries from parsed config
+func (pc *ParsedConfig) ExtractLoopbackInterfaces() []LoopbackInterface {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "ip loopback") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseLoopbackInterfaces(strings.Join(lines, "\n"))
+}
+
+// ExtractL2MSManagedSwitch extracts the settings of a switch managed through an interface.
+// "switch select" contexts are not tracked by the config parser, so the raw config is parsed.
+func (pc *ParsedConfig) ExtractL2MSManagedSwitch(iface, sw string) *L2MSManagedSwitch {
+	return FindL2MSManagedSwitch(pc.Raw, iface, sw)
+}
+
+// ExtractWLAN extracts the wireless LAN configuration from parsed config
+func (pc *ParsedConfig) ExtractWLAN() *WLANConfig {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "wlan ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseWLANConfig(strings.Join(lines, "\n"))
+}
+
+// ExtractUSBHost extracts the USB host settings from parsed config
+func (pc *ParsedConfig) ExtractUSBHost() *USBHostConfig {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "usbhost ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseUSBHostConfig(strings.Join(lines, "\n"))
+}
+
+// ExtractMobileWANs extracts mobile (LTE) WAN connections from parsed config
+func (pc *ParsedConfig) ExtractMobileWANs() []MobileWAN {
+	lines := pc.ppContextLines()
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "mobile ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseMobileWANs(strings.Join(lines, "\n"))
+}
+
+// ExtractSystemSettings extracts the global forwarding toggles from parsed config
+func (pc *ParsedConfig) ExtractSystemSettings() *SystemSettings {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		lines = append(lines, cmd.Line)
+	}
+
+	return ParseSystemSettings(strings.Join(lines, "\n"))
+}
+
+// ExtractProxyARP extracts the proxy ARP interfaces from parsed config
+func (pc *ParsedConfig) ExtractProxyARP() *ProxyARPConfig {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.Contains(cmd.Line, " proxyarp ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseProxyARPConfig(strings.Join(lines, "\n"))
+}
+
+// ExtractPortMirrorings extracts "lan port-mirroring" entries from parsed config
+func (pc *ParsedConfig) ExtractPortMirrorings() []PortMirroring {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "lan port-mirroring ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParsePortMirrorings(strings.Join(lines, "\n"))
+}
+
+// ExtractLLDP extracts the LLDP settings from parsed config
+func (pc *ParsedConfig) ExtractLLDP() *LLDPConfig {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "lldp ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseLLDPConfig(strings.Join(lines, "\n"))
+}
+
+// ExtractICMPStealth extracts the "ip stealth" and "ip icmp" settings from parsed config
+func (pc *ParsedConfig) ExtractICMPStealth() *ICMPStealthConfig {
+	var lines []string
+	for _, cmd := range pc.GetGlobalCommands() {
+		if strings.HasPrefix(cmd.Line, "ip stealth ") || strings.HasPrefix(cmd.Line, "ip icmp ") {
+			lines = append(lines, cmd.Line)
+		}
+	}
+
+	return ParseICMPStealthConfig(strings.Join(lines, "\n"))
+}
+
 // ExtractPasswords extracts all password and secret values from parsed config
 func (pc *ParsedConfig) ExtractPasswords() ExtractedPasswords {
 	result := ExtractedPasswords{
@@ -0,0 +1,74 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Configuration sections that can be read on their own with "show config <section> <n>"
+const (
+	ConfigSectionPP     = "pp"
+	ConfigSectionTunnel = "tunnel"
+)
+
+// BuildShowConfigSectionCommand builds the command to show the configuration
+// of a single PP or tunnel interface
+func BuildShowConfigSectionCommand(section string, number int) string {
+	return fmt.Sprintf("show config %s %d", section, number)
+}
+
+// ValidateConfigSection validates a section read request
+func ValidateConfigSection(section string, number int) error {
+	switch section {
+	case ConfigSectionPP, ConfigSectionTunnel:
+	default:
+		return fmt.Errorf("config section must be %q or %q, got %q", ConfigSectionPP, ConfigSectionTunnel, section)
+	}
+	if number < 1 {
+		return fmt.Errorf("%s number must be positive, got %d", section, number)
+	}
+	return nil
+}
+
+// ExtractConfigSection returns the lines of one PP or tunnel section from the
+// full configuration, as "show config <section> <n>" prints them: the select
+// line followed by the indented lines of the section, up to and including
+// its enable/disable line. Indentation is kept so that parsers relying on it
+// treat the result like the full configuration.
+func ExtractConfigSection(raw, section string, number int) string {
+	selectPattern := regexp.MustCompile(fmt.Sprintf(`^%s\s+select\s+%d\s*$`, regexp.QuoteMeta(section), number))
+	anySelectPattern := regexp.MustCompile(`^(pp|tunnel)\s+select\s+`)
+	exitPattern := regexp.MustCompile(fmt.Sprintf(`^%s\s+(enable|disable)\s+`, regexp.QuoteMeta(section)))
+
+	var lines []string
+	inSection := false
+	for _, line := range strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+
+		if !inSection {
+			if selectPattern.MatchString(trimmed) {
+				inSection = true
+				lines = append(lines, trimmed)
+			}
+			continue
+		}
+
+		indented := line[0] == ' ' || line[0] == '\t'
+		if anySelectPattern.MatchString(trimmed) {
+			break
+		}
+		if exitPattern.MatchString(trimmed) {
+			lines = append(lines, line)
+			break
+		}
+		if !indented {
+			break
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
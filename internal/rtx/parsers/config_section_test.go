@@ -0,0 +1,78 @@
+package parsers
+
+import "testing"
+
+func TestValidateConfigSection(t *testing.T) {
+	tests := []struct {
+		section string
+		number  int
+		wantErr bool
+	}{
+		{section: ConfigSectionPP, number: 1},
+		{section: ConfigSectionTunnel, number: 10},
+		{section: "lan", number: 1, wantErr: true},
+		{section: ConfigSectionPP, number: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := ValidateConfigSection(tt.section, tt.number)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateConfigSection(%q, %d) error = %v, wantErr %v", tt.section, tt.number, err, tt.wantErr)
+		}
+	}
+}
+
+func TestExtractConfigSection(t *testing.T) {
+	raw := "ip route default gateway pp 1\r\n" +
+		"pp select 1\r\n" +
+		" description pp PRV/PPPoE\r\n" +
+		" ip pp mtu 1454\r\n" +
+		" pp enable 1\r\n" +
+		"tunnel select 1\r\n" +
+		" ipsec tunnel 101\r\n" +
+		"  ipsec sa policy 101 1 esp aes-cbc sha-hmac\r\n" +
+		" tunnel enable 1\r\n" +
+		"tunnel select 2\r\n" +
+		" tunnel encapsulation l2tpv3\r\n" +
+		"ip filter 100 pass * * * * *\r\n"
+
+	tests := []struct {
+		name    string
+		section string
+		number  int
+		want    string
+	}{
+		{
+			name:    "pp",
+			section: ConfigSectionPP,
+			number:  1,
+			want:    "pp select 1\n description pp PRV/PPPoE\n ip pp mtu 1454\n pp enable 1",
+		},
+		{
+			name:    "tunnel with nested ipsec tunnel",
+			section: ConfigSectionTunnel,
+			number:  1,
+			want:    "tunnel select 1\n ipsec tunnel 101\n  ipsec sa policy 101 1 esp aes-cbc sha-hmac\n tunnel enable 1",
+		},
+		{
+			name:    "ends at the next global line",
+			section: ConfigSectionTunnel,
+			number:  2,
+			want:    "tunnel select 2\n tunnel encapsulation l2tpv3",
+		},
+		{
+			name:    "not configured",
+			section: ConfigSectionPP,
+			number:  2,
+			want:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractConfigSection(raw, tt.section, tt.number); got != tt.want {
+				t.Errorf("ExtractConfigSection() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -242,12 +242,14 @@ func (p *dhcpBindingsParser) ParseBindings(raw string, scopeID int) ([]DHCPBindi
 	return bindings, nil
 }
 
-// NormalizeMACAddress converts various MAC address formats to standard colon-separated lowercase
+// NormalizeMACAddress converts various MAC address formats (colon, hyphen,
+// Cisco dot and bare) to standard colon-separated lowercase
 func NormalizeMACAddress(mac string) (string, error) {
 	// Remove all separators
 	cleaned := strings.ToLower(mac)
 	cleaned = strings.ReplaceAll(cleaned, ":", "")
 	cleaned = strings.ReplaceAll(cleaned, "-", "")
+	cleaned = strings.ReplaceAll(cleaned, ".", "")
 	cleaned = strings.ReplaceAll(cleaned, " ", "")
 
 	// Validate length
@@ -302,16 +304,22 @@ func BuildDHCPBindCommand(binding DHCPBinding) string {
 			binding.ScopeID, binding.IPAddress, normalizedClientID)
 	}
 
+	// The router only accepts colon-separated MAC addresses
+	macAddress := binding.MACAddress
+	if normalized, err := NormalizeMACAddress(macAddress); err == nil {
+		macAddress = normalized
+	}
+
 	// Handle MAC address with UseClientIdentifier flag
-	if binding.UseClientIdentifier && binding.MACAddress != "" {
+	if binding.UseClientIdentifier && macAddress != "" {
 		// Legacy: ethernet MAC format
 		return fmt.Sprintf("dhcp scope bind %d %s ethernet %s",
-			binding.ScopeID, binding.IPAddress, binding.MACAddress)
+			binding.ScopeID, binding.IPAddress, macAddress)
 	}
 
 	// Plain MAC address binding
 	return fmt.Sprintf("dhcp scope bind %d %s %s",
-		binding.ScopeID, binding.IPAddress, binding.MACAddress)
+		binding.ScopeID, binding.IPAddress, macAddress)
 }
 
 // BuildDHCPUnbindCommand builds a command to remove a DHCP binding
@@ -214,6 +214,12 @@ func TestNormalizeMACAddress(t *testing.T) {
 			expected: "00:11:22:33:44:55",
 			wantErr:  false,
 		},
+		{
+			name:     "Cisco dot notation",
+			input:    "0011.2233.4455",
+			expected: "00:11:22:33:44:55",
+			wantErr:  false,
+		},
 		{
 			name:     "Mixed case",
 			input:    "00:AA:bb:CC:dd:EE",
@@ -40,6 +40,15 @@ func TestBuildDHCPBindCommand(t *testing.T) {
 			},
 			expected: "dhcp scope bind 2 10.0.0.50 11:22:33:44:55:66",
 		},
+		{
+			name: "Cisco dot MAC is sent colon-separated",
+			binding: DHCPBinding{
+				ScopeID:    1,
+				IPAddress:  "192.168.1.105",
+				MACAddress: "0011.2233.44AA",
+			},
+			expected: "dhcp scope bind 1 192.168.1.105 00:11:22:33:44:aa",
+		},
 		// New client identifier test cases
 		{
 			name: "MAC-based client identifier (01 prefix)",
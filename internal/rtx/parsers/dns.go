@@ -461,49 +461,66 @@ func ValidateDNSConfig(config DNSConfig) error {
 
 	// Validate server select entries
 	for _, sel := range config.ServerSelect {
-		if sel.ID < 1 || sel.ID > 65535 {
-			return fmt.Errorf("dns server select ID must be between 1 and 65535, got %d", sel.ID)
+		if err := ValidateDNSServerSelect(sel); err != nil {
+			return err
 		}
-		if len(sel.Servers) == 0 {
-			return fmt.Errorf("dns server select %d must have at least one server", sel.ID)
-		}
-		if len(sel.Servers) > 2 {
-			return fmt.Errorf("dns server select %d: maximum 2 servers allowed, got %d", sel.ID, len(sel.Servers))
-		}
-		if sel.QueryPattern == "" {
-			return fmt.Errorf("dns server select %d must have a query pattern", sel.ID)
-		}
-		// Validate record type if specified
-		if sel.RecordType != "" && !validRecordTypes[sel.RecordType] {
-			return fmt.Errorf("dns server select %d: invalid record type %q, must be one of: a, aaaa, ptr, mx, ns, cname, any", sel.ID, sel.RecordType)
-		}
-		for _, server := range sel.Servers {
-			if !isValidIPForDNS(server.Address) {
-				return fmt.Errorf("dns server select %d: invalid server IP address: %s", sel.ID, server.Address)
-			}
-		}
-	}
-
-	// Valid dns static record types
-	validStaticTypes := map[string]bool{
-		"a": true, "aaaa": true, "ptr": true, "mx": true, "ns": true, "cname": true,
 	}
 
 	// Validate static hosts
 	for _, host := range config.Hosts {
-		if host.Type == "" {
-			return fmt.Errorf("dns static record type is required")
+		if err := ValidateDNSHost(host); err != nil {
+			return err
 		}
-		if !validStaticTypes[host.Type] {
-			return fmt.Errorf("dns static: invalid record type %q, must be one of: a, aaaa, ptr, mx, ns, cname", host.Type)
-		}
-		if host.Name == "" {
-			return fmt.Errorf("dns static host name cannot be empty")
-		}
-		if host.Address == "" {
-			return fmt.Errorf("dns static host %s: value/address cannot be empty", host.Name)
+	}
+
+	return nil
+}
+
+// ValidateDNSServerSelect validates a single DNS server select entry
+func ValidateDNSServerSelect(sel DNSServerSelect) error {
+	if sel.ID < 1 || sel.ID > 65535 {
+		return fmt.Errorf("dns server select ID must be between 1 and 65535, got %d", sel.ID)
+	}
+	if len(sel.Servers) == 0 {
+		return fmt.Errorf("dns server select %d must have at least one server", sel.ID)
+	}
+	if len(sel.Servers) > 2 {
+		return fmt.Errorf("dns server select %d: maximum 2 servers allowed, got %d", sel.ID, len(sel.Servers))
+	}
+	if sel.QueryPattern == "" {
+		return fmt.Errorf("dns server select %d must have a query pattern", sel.ID)
+	}
+	// Validate record type if specified
+	if sel.RecordType != "" && !validRecordTypes[sel.RecordType] {
+		return fmt.Errorf("dns server select %d: invalid record type %q, must be one of: a, aaaa, ptr, mx, ns, cname, any", sel.ID, sel.RecordType)
+	}
+	for _, server := range sel.Servers {
+		if !isValidIPForDNS(server.Address) {
+			return fmt.Errorf("dns server select %d: invalid server IP address: %s", sel.ID, server.Address)
 		}
 	}
 
 	return nil
 }
+
+// validStaticTypes lists the record types accepted by dns static
+var validStaticTypes = map[string]bool{
+	"a": true, "aaaa": true, "ptr": true, "mx": true, "ns": true, "cname": true,
+}
+
+// ValidateDNSHost validates a single dns static entry
+func ValidateDNSHost(host DNSHost) error {
+	if host.Type == "" {
+		return fmt.Errorf("dns static record type is required")
+	}
+	if !validStaticTypes[host.Type] {
+		return fmt.Errorf("dns static: invalid record type %q, must be one of: a, aaaa, ptr, mx, ns, cname", host.Type)
+	}
+	if host.Name == "" {
+		return fmt.Errorf("dns static host name cannot be empty")
+	}
+	if host.Address == "" {
+		return fmt.Errorf("dns static host %s: value/address cannot be empty", host.Name)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package parsers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DNSCache represents the DNS cache statistics and entries reported by "show dns cache"
+type DNSCache struct {
+	MaxEntries int             `json:"max_entries"` // Configured cache size ("dns cache max entry")
+	EntryCount int             `json:"entry_count"` // Number of cached records
+	Entries    []DNSCacheEntry `json:"entries"`     // Cached records
+}
+
+// DNSCacheEntry represents a cached DNS record
+type DNSCacheEntry struct {
+	Name string `json:"name"` // Query name without the trailing dot
+	Type string `json:"type"` // Record type (A, AAAA, CNAME, etc.)
+	TTL  int    `json:"ttl"`  // Remaining TTL in seconds
+	Data string `json:"data"` // Record data (address, canonical name, etc.)
+}
+
+var (
+	dnsCacheMaxPattern   = regexp.MustCompile(`(?i)^\s*(?:max(?:imum)?\s+entr(?:y|ies)|最大エントリ数)\s*[:=]\s*(\d+)`)
+	dnsCacheCountPattern = regexp.MustCompile(`(?i)^\s*(?:(?:current|total)\s+entr(?:y|ies)|entries|エントリ数|現在のエントリ数)\s*[:=]\s*(\d+)`)
+	dnsCacheEntryPattern = regexp.MustCompile(`(?i)^\s*(\S+?)\.?\s+(A|AAAA|CNAME|PTR|MX|NS|SOA|TXT|SRV|HTTPS|SVCB)\s+(\d+)\s+(.+?)\s*$`)
+)
+
+// ParseDNSCache parses the output of "show dns cache"
+func ParseDNSCache(raw string) *DNSCache {
+	cache := &DNSCache{Entries: []DNSCacheEntry{}}
+	countFound := false
+
+	for _, line := range strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n") {
+		if matches := dnsCacheMaxPattern.FindStringSubmatch(line); len(matches) == 2 {
+			cache.MaxEntries, _ = strconv.Atoi(matches[1])
+			continue
+		}
+		if matches := dnsCacheCountPattern.FindStringSubmatch(line); len(matches) == 2 {
+			cache.EntryCount, _ = strconv.Atoi(matches[1])
+			countFound = true
+			continue
+		}
+		if matches := dnsCacheEntryPattern.FindStringSubmatch(line); len(matches) == 5 {
+			entry := DNSCacheEntry{
+				Name: strings.ToLower(matches[1]),
+				Type: strings.ToUpper(matches[2]),
+				Data: matches[4],
+			}
+			entry.TTL, _ = strconv.Atoi(matches[3])
+			cache.Entries = append(cache.Entries, entry)
+		}
+	}
+
+	if !countFound {
+		cache.EntryCount = len(cache.Entries)
+	}
+
+	return cache
+}
+
+// BuildShowDNSCacheCommand builds the command to show the DNS cache
+func BuildShowDNSCacheCommand() string {
+	return "show dns cache"
+}
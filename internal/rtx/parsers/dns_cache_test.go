@@ -0,0 +1,49 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDNSCache(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want *DNSCache
+	}{
+		{
+			name: "statistics and entries",
+			raw: `Max Entry: 256
+Current Entry: 3
+
+Name                           Type   TTL    Data
+www.example.com.               A      297    93.184.216.34
+www.example.com                AAAA   297    2606:2800:220:1:248:1893:25c8:1946
+intranet.corp.example          CNAME  58     portal.corp.example
+`,
+			want: &DNSCache{
+				MaxEntries: 256,
+				EntryCount: 3,
+				Entries: []DNSCacheEntry{
+					{Name: "www.example.com", Type: "A", TTL: 297, Data: "93.184.216.34"},
+					{Name: "www.example.com", Type: "AAAA", TTL: 297, Data: "2606:2800:220:1:248:1893:25c8:1946"},
+					{Name: "intranet.corp.example", Type: "CNAME", TTL: 58, Data: "portal.corp.example"},
+				},
+			},
+		},
+		{
+			name: "empty cache without statistics",
+			raw:  "\n",
+			want: &DNSCache{Entries: []DNSCacheEntry{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseDNSCache(tt.raw)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseDNSCache() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
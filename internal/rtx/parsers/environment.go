@@ -0,0 +1,62 @@
+package parsers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Environment represents the hardware state reported by "show environment"
+type Environment struct {
+	Model              string `json:"model,omitempty"`               // Router model (e.g., RTX1210)
+	FirmwareVersion    string `json:"firmware_version,omitempty"`    // Firmware revision (e.g., 14.01.42)
+	CPU5Sec            int    `json:"cpu_5sec"`                      // CPU load over the last 5 seconds (percent)
+	CPU1Min            int    `json:"cpu_1min"`                      // CPU load over the last minute (percent)
+	CPU5Min            int    `json:"cpu_5min"`                      // CPU load over the last 5 minutes (percent)
+	MemoryUsedPercent  int    `json:"memory_used_percent"`           // Memory usage (percent)
+	TemperatureCelsius *int   `json:"temperature_celsius,omitempty"` // Inside temperature, nil if the model has no sensor
+	FanStatus          string `json:"fan_status,omitempty"`          // Fan status, empty if the model has no fan
+	Uptime             string `json:"uptime,omitempty"`              // Elapsed time from boot as printed by the router
+}
+
+var (
+	environmentModelPattern       = regexp.MustCompile(`(RTX\d+|NVR\d+|FWX\d+)\s+Rev\.([\d.]+)`)
+	environmentCPUPattern         = regexp.MustCompile(`CPU:\s*(\d+)%\(5sec\)\s*(\d+)%\(1min\)\s*(\d+)%\(5min\)`)
+	environmentMemoryPattern      = regexp.MustCompile(`(?i)(?:Memory|メモリ)\s*:\s*(\d+)%`)
+	environmentTemperaturePattern = regexp.MustCompile(`(?i)(?:Temperature|温度)\s*\([^)]*\)\s*:\s*(-?\d+)`)
+	environmentFanPattern         = regexp.MustCompile(`(?i)^\s*(?:fan|ファン)[^:]*:\s*(.+?)\s*$`)
+	environmentUptimePattern      = regexp.MustCompile(`(?:Elapsed time from boot|起動からの経過時間)\s*:\s*(.+?)\s*$`)
+)
+
+// ParseEnvironment parses the output of "show environment"
+func ParseEnvironment(raw string) *Environment {
+	env := &Environment{}
+
+	for _, line := range strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n") {
+		if matches := environmentModelPattern.FindStringSubmatch(line); len(matches) == 3 && env.Model == "" {
+			env.Model = matches[1]
+			env.FirmwareVersion = matches[2]
+		}
+		if matches := environmentCPUPattern.FindStringSubmatch(line); len(matches) == 4 {
+			env.CPU5Sec, _ = strconv.Atoi(matches[1])
+			env.CPU1Min, _ = strconv.Atoi(matches[2])
+			env.CPU5Min, _ = strconv.Atoi(matches[3])
+		}
+		if matches := environmentMemoryPattern.FindStringSubmatch(line); len(matches) == 2 {
+			env.MemoryUsedPercent, _ = strconv.Atoi(matches[1])
+		}
+		if matches := environmentTemperaturePattern.FindStringSubmatch(line); len(matches) == 2 {
+			if t, err := strconv.Atoi(matches[1]); err == nil {
+				env.TemperatureCelsius = &t
+			}
+		}
+		if matches := environmentFanPattern.FindStringSubmatch(line); len(matches) == 2 {
+			env.FanStatus = matches[1]
+		}
+		if matches := environmentUptimePattern.FindStringSubmatch(line); len(matches) == 2 {
+			env.Uptime = matches[1]
+		}
+	}
+
+	return env
+}
@@ -0,0 +1,78 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseEnvironment(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name string
+		raw  string
+		want *Environment
+	}{
+		{
+			name: "English output",
+			raw: `RTX1210 BootROM Ver. 1.04
+RTX1210 FlashROM Table Ver. 1.02
+RTX1210 Rev.14.01.42 (Fri Jan 15 15:45:26 2021)
+  main:  RTX1210 ver=00 serial=S4H104289 MAC-Address=ac:44:f2:3a:2a:fd
+CPU:   2%(5sec)   1%(1min)   1%(5min)    Memory:  24% used
+Packet Buffer:   0%(small)   0%(middle)   5%(large)   0%(huge) used
+Inside Temperature(C): 43
+Boot time: 2026/10/01 10:00:00 +09:00
+Elapsed time from boot: 14days 12:18:49
+`,
+			want: &Environment{
+				Model:              "RTX1210",
+				FirmwareVersion:    "14.01.42",
+				CPU5Sec:            2,
+				CPU1Min:            1,
+				CPU5Min:            1,
+				MemoryUsedPercent:  24,
+				TemperatureCelsius: intPtr(43),
+				Uptime:             "14days 12:18:49",
+			},
+		},
+		{
+			name: "Japanese output with fan",
+			raw: `RTX3510 Rev.23.00.05 (Mon Jul  1 12:00:00 2024)
+CPU:  12%(5sec)  10%(1min)   9%(5min)    メモリ:  31% used
+筐体内温度(℃): 38
+ファン: 正常
+起動からの経過時間: 3日 01:02:03
+`,
+			want: &Environment{
+				Model:              "RTX3510",
+				FirmwareVersion:    "23.00.05",
+				CPU5Sec:            12,
+				CPU1Min:            10,
+				CPU5Min:            9,
+				MemoryUsedPercent:  31,
+				TemperatureCelsius: intPtr(38),
+				FanStatus:          "正常",
+				Uptime:             "3日 01:02:03",
+			},
+		},
+		{
+			name: "no temperature sensor",
+			raw:  "RTX830 Rev.15.02.30 (Tue Apr  1 00:00:00 2025)\nCPU:   0%(5sec)   0%(1min)   0%(5min)    Memory:  18% used\n",
+			want: &Environment{
+				Model:             "RTX830",
+				FirmwareVersion:   "15.02.30",
+				MemoryUsedPercent: 18,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseEnvironment(tt.raw)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseEnvironment() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
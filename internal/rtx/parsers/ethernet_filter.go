@@ -74,6 +74,13 @@ func NormalizeMAC(mac string) string {
 		clean[6:8], clean[8:10], clean[10:12])
 }
 
+// MACAddressesEqual reports whether two MAC addresses are the same address
+// in any of the formats accepted by NormalizeMAC, e.g. "0011.2233.4455" and
+// "00:11:22:33:44:55"
+func MACAddressesEqual(a, b string) bool {
+	return a == b || NormalizeMAC(a) == NormalizeMAC(b)
+}
+
 // ConvertMACToCisco converts a MAC address to Cisco dot notation (0011.2233.4455)
 func ConvertMACToCisco(mac string) string {
 	mac = strings.TrimSpace(mac)
@@ -592,11 +599,11 @@ func BuildAccessListMACEntryCommand(entry AccessListMACEntry) string {
 	}
 
 	if !entry.SourceAny && entry.SourceAddress != "" {
-		filter.SourceMAC = entry.SourceAddress
+		filter.SourceMAC = NormalizeMAC(entry.SourceAddress)
 	}
 	if !entry.DestinationAny && entry.DestinationAddress != "" {
-		filter.DestinationMAC = entry.DestinationAddress
-		filter.DestMAC = entry.DestinationAddress
+		filter.DestinationMAC = NormalizeMAC(entry.DestinationAddress)
+		filter.DestMAC = filter.DestinationMAC
 	}
 
 	return BuildEthernetFilterCommand(filter)
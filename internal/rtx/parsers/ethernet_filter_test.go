@@ -73,6 +73,27 @@ func TestNormalizeMAC(t *testing.T) {
 	}
 }
 
+func TestMACAddressesEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"0011.2233.4455", "00:11:22:33:44:55", true},
+		{"00-11-22-33-44-55", "00:11:22:33:44:55", true},
+		{"001122334455", "00:11:22:33:44:55", true},
+		{"AA:BB:CC:DD:EE:FF", "aa:bb:cc:dd:ee:ff", true},
+		{"0011.2233.4455", "00:11:22:33:44:56", false},
+		{"*", "*", true},
+		{"*", "00:11:22:33:44:55", false},
+	}
+
+	for _, tt := range tests {
+		if got := MACAddressesEqual(tt.a, tt.b); got != tt.want {
+			t.Errorf("MACAddressesEqual(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
 func TestConvertMACToCisco(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1818,6 +1839,16 @@ func TestBuildAccessListMACEntryCommand(t *testing.T) {
 			},
 			expected: "ethernet filter 7 reject-log * ff:ff:ff:ff:ff:ff 0x0806",
 		},
+		{
+			name: "Cisco and hyphen addresses are sent colon-separated",
+			entry: AccessListMACEntry{
+				Sequence:           8,
+				AceAction:          "deny",
+				SourceAddress:      "0011.2233.4455",
+				DestinationAddress: "AA-BB-CC-DD-EE-FF",
+			},
+			expected: "ethernet filter 8 reject 00:11:22:33:44:55 aa:bb:cc:dd:ee:ff",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,160 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ExternalMemoryBackup represents on-device configuration backups to external memory (USB or microSD)
+type ExternalMemoryBackup struct {
+	AutoBackup     bool   `json:"auto_backup"`               // Copy the config to external memory whenever it is saved
+	ConfigFilename string `json:"config_filename,omitempty"` // Backup file path, e.g. usb1:/config.txt
+	ScheduleID     int    `json:"schedule_id,omitempty"`     // "schedule at" ID of the periodic copy
+	ScheduleTime   string `json:"schedule_time,omitempty"`   // Daily time of the periodic copy (HH:MM)
+	Destination    string `json:"destination,omitempty"`     // Destination path of the periodic copy
+}
+
+var (
+	externalMemoryAutoBackupPattern = regexp.MustCompile(`^\s*external-memory\s+config\s+auto-backup\s+(on|off)\s*$`)
+	externalMemoryFilenamePattern   = regexp.MustCompile(`^\s*external-memory\s+config\s+filename\s+(\S+)\s*$`)
+	externalMemoryCopyPattern       = regexp.MustCompile(`^\s*schedule\s+at\s+(\d+)\s+(\d{1,2}:\d{2})\s+copy\s+config\s+0\s+(\S+)\s*$`)
+	externalMemoryPathPattern       = regexp.MustCompile(`^(usb|sd)\d+:/\S+$`)
+)
+
+// ParseExternalMemoryBackup parses external memory backup settings and the
+// scheduled "copy config" job from the router configuration.
+// Returns nil when neither is configured.
+func ParseExternalMemoryBackup(raw string) *ExternalMemoryBackup {
+	backup := &ExternalMemoryBackup{}
+	found := false
+
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if matches := externalMemoryAutoBackupPattern.FindStringSubmatch(line); len(matches) >= 2 {
+			backup.AutoBackup = matches[1] == "on"
+			found = true
+			continue
+		}
+
+		if matches := externalMemoryFilenamePattern.FindStringSubmatch(line); len(matches) >= 2 {
+			backup.ConfigFilename = matches[1]
+			found = true
+			continue
+		}
+
+		// Only the first scheduled copy is managed
+		if matches := externalMemoryCopyPattern.FindStringSubmatch(line); len(matches) >= 4 && backup.ScheduleID == 0 {
+			backup.ScheduleID, _ = strconv.Atoi(matches[1])
+			backup.ScheduleTime = matches[2]
+			backup.Destination = matches[3]
+			found = true
+		}
+	}
+
+	if !found {
+		return nil
+	}
+	return backup
+}
+
+// BuildExternalMemoryAutoBackupCommand builds the command to toggle automatic config backup
+// Command format: external-memory config auto-backup on|off
+func BuildExternalMemoryAutoBackupCommand(enabled bool) string {
+	if enabled {
+		return "external-memory config auto-backup on"
+	}
+	return "external-memory config auto-backup off"
+}
+
+// BuildExternalMemoryFilenameCommand builds the command to set the backup file path
+// Command format: external-memory config filename <path>
+func BuildExternalMemoryFilenameCommand(filename string) string {
+	return fmt.Sprintf("external-memory config filename %s", filename)
+}
+
+// BuildDeleteExternalMemoryFilenameCommand builds the command to remove the backup file path
+func BuildDeleteExternalMemoryFilenameCommand() string {
+	return "no external-memory config filename"
+}
+
+// BuildExternalMemoryCopyScheduleCommand builds the scheduled "copy config" job
+// Command format: schedule at <id> <time> copy config 0 <destination>
+func BuildExternalMemoryCopyScheduleCommand(id int, time, destination string) string {
+	return BuildScheduleAtCommand(id, time, fmt.Sprintf("copy config 0 %s", destination))
+}
+
+// BuildExternalMemoryBackupCommands builds all commands needed to apply the backup settings
+func BuildExternalMemoryBackupCommands(backup ExternalMemoryBackup) []string {
+	var commands []string
+	if backup.ConfigFilename != "" {
+		commands = append(commands, BuildExternalMemoryFilenameCommand(backup.ConfigFilename))
+	}
+	commands = append(commands, BuildExternalMemoryAutoBackupCommand(backup.AutoBackup))
+	if backup.ScheduleID > 0 {
+		commands = append(commands, BuildExternalMemoryCopyScheduleCommand(backup.ScheduleID, backup.ScheduleTime, backup.Destination))
+	}
+	return commands
+}
+
+// BuildDeleteExternalMemoryBackupCommands builds the commands needed to remove the backup settings
+func BuildDeleteExternalMemoryBackupCommands(backup ExternalMemoryBackup) []string {
+	var commands []string
+	if backup.ScheduleID > 0 {
+		commands = append(commands, BuildDeleteScheduleCommand(backup.ScheduleID))
+	}
+	commands = append(commands, "no external-memory config auto-backup")
+	if backup.ConfigFilename != "" {
+		commands = append(commands, BuildDeleteExternalMemoryFilenameCommand())
+	}
+	return commands
+}
+
+// BuildShowExternalMemoryConfigCommand builds the command to show external memory settings
+func BuildShowExternalMemoryConfigCommand() string {
+	return "show config | grep \"external-memory config\""
+}
+
+// BuildShowExternalMemoryScheduleCommand builds the command to show scheduled config copies
+func BuildShowExternalMemoryScheduleCommand() string {
+	return "show config | grep \"copy config\""
+}
+
+// ValidateExternalMemoryPath validates an external memory file path (usb1:/..., sd1:/...)
+func ValidateExternalMemoryPath(path string) error {
+	if !externalMemoryPathPattern.MatchString(path) {
+		return fmt.Errorf("invalid external memory path %q, expected usbN:/<file> or sdN:/<file>", path)
+	}
+	return nil
+}
+
+// ValidateExternalMemoryBackup validates the backup settings
+func ValidateExternalMemoryBackup(backup ExternalMemoryBackup) error {
+	if backup.ConfigFilename != "" {
+		if err := ValidateExternalMemoryPath(backup.ConfigFilename); err != nil {
+			return err
+		}
+	}
+	if backup.AutoBackup && backup.ConfigFilename == "" {
+		return fmt.Errorf("config filename is required when auto backup is enabled")
+	}
+
+	if backup.ScheduleID == 0 {
+		if backup.ScheduleTime != "" || backup.Destination != "" {
+			return fmt.Errorf("schedule id is required for a scheduled copy")
+		}
+		return nil
+	}
+	if backup.ScheduleID < 1 || backup.ScheduleID > 65535 {
+		return fmt.Errorf("schedule id must be between 1 and 65535, got %d", backup.ScheduleID)
+	}
+	if err := ValidateTimeFormat(backup.ScheduleTime); err != nil {
+		return err
+	}
+	return ValidateExternalMemoryPath(backup.Destination)
+}
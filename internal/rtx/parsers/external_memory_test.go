@@ -0,0 +1,99 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseExternalMemoryBackup(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want *ExternalMemoryBackup
+	}{
+		{
+			name: "not configured",
+			raw:  "schedule at 1 03:00 syslog debug off",
+			want: nil,
+		},
+		{
+			name: "auto backup and schedule",
+			raw: `external-memory config filename usb1:/config.txt
+external-memory config auto-backup on
+schedule at 10 03:30 copy config 0 usb1:/backup.txt`,
+			want: &ExternalMemoryBackup{
+				AutoBackup:     true,
+				ConfigFilename: "usb1:/config.txt",
+				ScheduleID:     10,
+				ScheduleTime:   "03:30",
+				Destination:    "usb1:/backup.txt",
+			},
+		},
+		{
+			name: "schedule only",
+			raw:  "schedule at 5 1:00 copy config 0 sd1:/rtx.txt",
+			want: &ExternalMemoryBackup{ScheduleID: 5, ScheduleTime: "1:00", Destination: "sd1:/rtx.txt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseExternalMemoryBackup(tt.raw); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseExternalMemoryBackup() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildExternalMemoryBackupCommands(t *testing.T) {
+	backup := ExternalMemoryBackup{
+		AutoBackup:     true,
+		ConfigFilename: "usb1:/config.txt",
+		ScheduleID:     10,
+		ScheduleTime:   "03:30",
+		Destination:    "usb1:/backup.txt",
+	}
+
+	want := []string{
+		"external-memory config filename usb1:/config.txt",
+		"external-memory config auto-backup on",
+		"schedule at 10 03:30 copy config 0 usb1:/backup.txt",
+	}
+	if got := BuildExternalMemoryBackupCommands(backup); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildExternalMemoryBackupCommands() = %v, want %v", got, want)
+	}
+
+	wantDelete := []string{
+		"no schedule at 10",
+		"no external-memory config auto-backup",
+		"no external-memory config filename",
+	}
+	if got := BuildDeleteExternalMemoryBackupCommands(backup); !reflect.DeepEqual(got, wantDelete) {
+		t.Errorf("BuildDeleteExternalMemoryBackupCommands() = %v, want %v", got, wantDelete)
+	}
+}
+
+func TestValidateExternalMemoryBackup(t *testing.T) {
+	tests := []struct {
+		name    string
+		backup  ExternalMemoryBackup
+		wantErr bool
+	}{
+		{name: "auto backup", backup: ExternalMemoryBackup{AutoBackup: true, ConfigFilename: "usb1:/config.txt"}},
+		{name: "auto backup without filename", backup: ExternalMemoryBackup{AutoBackup: true}, wantErr: true},
+		{name: "invalid filename", backup: ExternalMemoryBackup{ConfigFilename: "/config.txt"}, wantErr: true},
+		{name: "schedule", backup: ExternalMemoryBackup{ScheduleID: 1, ScheduleTime: "23:59", Destination: "sd1:/backup.txt"}},
+		{name: "schedule with bad time", backup: ExternalMemoryBackup{ScheduleID: 1, ScheduleTime: "24:00", Destination: "sd1:/backup.txt"}, wantErr: true},
+		{name: "schedule without id", backup: ExternalMemoryBackup{ScheduleTime: "03:00", Destination: "sd1:/backup.txt"}, wantErr: true},
+		{name: "schedule with bad destination", backup: ExternalMemoryBackup{ScheduleID: 1, ScheduleTime: "03:00", Destination: "tftp:/x"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateExternalMemoryBackup(tt.backup)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateExternalMemoryBackup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
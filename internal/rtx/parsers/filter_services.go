@@ -0,0 +1,68 @@
+package parsers
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// FilterServicePorts maps the service keywords the router accepts in place
+// of a port number in IP filters to the ports they stand for.
+// Reference: RTX Command Reference, "ip filter"
+var FilterServicePorts = map[string][]int{
+	"ftp":        {20, 21},
+	"ftpdata":    {20},
+	"telnet":     {23},
+	"smtp":       {25},
+	"domain":     {53},
+	"gopher":     {70},
+	"finger":     {79},
+	"www":        {80},
+	"pop3":       {110},
+	"sunrpc":     {111},
+	"ident":      {113},
+	"nntp":       {119},
+	"ntp":        {123},
+	"snmp":       {161},
+	"syslog":     {514},
+	"printer":    {515},
+	"talk":       {517},
+	"route":      {520},
+	"uucp":       {540},
+	"submission": {587},
+}
+
+// ServicePorts returns the ports of a service keyword as the router writes
+// them in a filter, e.g. "80" for "www" and "20,21" for "ftp".
+func ServicePorts(service string) (string, bool) {
+	ports, ok := FilterServicePorts[strings.ToLower(strings.TrimSpace(service))]
+	if !ok {
+		return "", false
+	}
+	parts := make([]string, len(ports))
+	for i, port := range ports {
+		parts[i] = strconv.Itoa(port)
+	}
+	return strings.Join(parts, ","), true
+}
+
+// PortService returns the service keyword that stands for ports, a port
+// number or a comma-separated list of them, e.g. "submission" for "587".
+func PortService(ports string) (string, bool) {
+	var numbers []int
+	for _, part := range strings.Split(ports, ",") {
+		port, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return "", false
+		}
+		numbers = append(numbers, port)
+	}
+	slices.Sort(numbers)
+
+	for service, servicePorts := range FilterServicePorts {
+		if slices.Equal(numbers, servicePorts) {
+			return service, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,55 @@
+package parsers
+
+import "testing"
+
+func TestServicePorts(t *testing.T) {
+	tests := []struct {
+		service string
+		want    string
+		wantOK  bool
+	}{
+		{"www", "80", true},
+		{"ftp", "20,21", true},
+		{" Submission ", "587", true},
+		{"https", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := ServicePorts(tt.service)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("ServicePorts(%q) = %q, %v, want %q, %v", tt.service, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestPortService(t *testing.T) {
+	tests := []struct {
+		ports  string
+		want   string
+		wantOK bool
+	}{
+		{"53", "domain", true},
+		{"21,20", "ftp", true},
+		{"20", "ftpdata", true},
+		{"21", "", false},
+		{"443", "", false},
+		{"www", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := PortService(tt.ports)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("PortService(%q) = %q, %v, want %q, %v", tt.ports, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestFilterServicePorts_RoundTrip(t *testing.T) {
+	for service := range FilterServicePorts {
+		ports, ok := ServicePorts(service)
+		if !ok {
+			t.Fatalf("ServicePorts(%q) not found", service)
+		}
+		if got, ok := PortService(ports); !ok || got != service {
+			t.Errorf("PortService(%q) = %q, %v, want %q", ports, got, ok, service)
+		}
+	}
+}
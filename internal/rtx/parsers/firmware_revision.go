@@ -0,0 +1,65 @@
+package parsers
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var firmwareRevisionPattern = regexp.MustCompile(`Rev\.(\d+\.\d+\.\d+)`)
+
+// ParseFirmwareRevisions extracts the "Rev.x.y.z" revisions mentioned in a
+// revision list (e.g., a release notes page) that belong to the same series
+// as the installed revision (same first two components). Revisions are
+// returned in ascending order without duplicates.
+func ParseFirmwareRevisions(raw, installed string) []string {
+	series := FirmwareRevisionSeries(installed)
+	seen := make(map[string]bool)
+	revisions := []string{}
+
+	for _, matches := range firmwareRevisionPattern.FindAllStringSubmatch(raw, -1) {
+		rev := matches[1]
+		if seen[rev] || (series != "" && FirmwareRevisionSeries(rev) != series) {
+			continue
+		}
+		seen[rev] = true
+		revisions = append(revisions, rev)
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return CompareFirmwareRevisions(revisions[i], revisions[j]) < 0
+	})
+	return revisions
+}
+
+// FirmwareRevisionSeries returns the series ("14.01") of a revision ("14.01.42")
+func FirmwareRevisionSeries(rev string) string {
+	parts := strings.Split(rev, ".")
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// CompareFirmwareRevisions compares two dotted revisions numerically and
+// returns -1, 0 or 1
+func CompareFirmwareRevisions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,62 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFirmwareRevisions(t *testing.T) {
+	page := `<h2>RTX1210 Rev.14.01.42</h2>
+<p>Changes since Rev.14.01.41 ...</p>
+<h2>RTX1210 Rev.14.01.9</h2>
+<h2>RTX830 Rev.15.02.30</h2>
+<h2>RTX1210 Rev.14.01.41</h2>`
+
+	tests := []struct {
+		name      string
+		installed string
+		want      []string
+	}{
+		{
+			name:      "same series only, numerically sorted",
+			installed: "14.01.38",
+			want:      []string{"14.01.9", "14.01.41", "14.01.42"},
+		},
+		{
+			name:      "unknown installed revision returns all",
+			installed: "",
+			want:      []string{"14.01.9", "14.01.41", "14.01.42", "15.02.30"},
+		},
+		{
+			name:      "no revisions of the series",
+			installed: "23.00.05",
+			want:      []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseFirmwareRevisions(page, tt.installed)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFirmwareRevisions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareFirmwareRevisions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"14.01.42", "14.01.42", 0},
+		{"14.01.9", "14.01.41", -1},
+		{"15.02.30", "14.01.42", 1},
+	}
+
+	for _, tt := range tests {
+		if got := CompareFirmwareRevisions(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareFirmwareRevisions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package parsers
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FirmwareUpdateCommand is the command run by the scheduled automatic update
+const FirmwareUpdateCommand = "http revision-up go no-confirm"
+
+// FirmwareUpdate represents "http revision-up" firmware update settings
+type FirmwareUpdate struct {
+	URL            string `json:"url,omitempty"`           // Firmware download URL
+	AllowUpdate    bool   `json:"allow_update"`            // http revision-up permit
+	AllowDowngrade bool   `json:"allow_downgrade"`         // http revision-down permit
+	Timeout        int    `json:"timeout,omitempty"`       // Download timeout in seconds
+	ScheduleID     int    `json:"schedule_id,omitempty"`   // "schedule at" ID of the automatic update
+	ScheduleTime   string `json:"schedule_time,omitempty"` // Daily time of the automatic update (HH:MM)
+}
+
+var (
+	firmwareURLPattern       = regexp.MustCompile(`^\s*http\s+revision-up\s+url\s+(\S+)\s*$`)
+	firmwarePermitPattern    = regexp.MustCompile(`^\s*http\s+revision-up\s+permit\s+(on|off)\s*$`)
+	firmwareDowngradePattern = regexp.MustCompile(`^\s*http\s+revision-down\s+permit\s+(on|off)\s*$`)
+	firmwareTimeoutPattern   = regexp.MustCompile(`^\s*http\s+revision-up\s+timeout\s+(\d+)\s*$`)
+	firmwareSchedulePattern  = regexp.MustCompile(`^\s*schedule\s+at\s+(\d+)\s+(\d{1,2}:\d{2})\s+http\s+revision-up\s+go(?:\s+no-confirm)?\s*$`)
+)
+
+// ParseFirmwareUpdate parses firmware update settings and the scheduled
+// "http revision-up go" job from the router configuration.
+// Returns nil when nothing is configured.
+func ParseFirmwareUpdate(raw string) *FirmwareUpdate {
+	update := &FirmwareUpdate{}
+	found := false
+
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		switch {
+		case firmwareURLPattern.MatchString(line):
+			update.URL = firmwareURLPattern.FindStringSubmatch(line)[1]
+		case firmwarePermitPattern.MatchString(line):
+			update.AllowUpdate = firmwarePermitPattern.FindStringSubmatch(line)[1] == "on"
+		case firmwareDowngradePattern.MatchString(line):
+			update.AllowDowngrade = firmwareDowngradePattern.FindStringSubmatch(line)[1] == "on"
+		case firmwareTimeoutPattern.MatchString(line):
+			update.Timeout, _ = strconv.Atoi(firmwareTimeoutPattern.FindStringSubmatch(line)[1])
+		case firmwareSchedulePattern.MatchString(line):
+			// Only the first scheduled update is managed
+			if update.ScheduleID != 0 {
+				continue
+			}
+			matches := firmwareSchedulePattern.FindStringSubmatch(line)
+			update.ScheduleID, _ = strconv.Atoi(matches[1])
+			update.ScheduleTime = matches[2]
+		default:
+			continue
+		}
+		found = true
+	}
+
+	if !found {
+		return nil
+	}
+	return update
+}
+
+// BuildFirmwareURLCommand builds the command to set the firmware download URL
+// Command format: http revision-up url <url>
+func BuildFirmwareURLCommand(rawURL string) string {
+	return fmt.Sprintf("http revision-up url %s", rawURL)
+}
+
+// BuildDeleteFirmwareURLCommand builds the command to remove the firmware download URL
+func BuildDeleteFirmwareURLCommand() string {
+	return "no http revision-up url"
+}
+
+// BuildFirmwarePermitCommand builds the command to allow or forbid firmware updates
+// Command format: http revision-up permit on|off
+func BuildFirmwarePermitCommand(allow bool) string {
+	if allow {
+		return "http revision-up permit on"
+	}
+	return "http revision-up permit off"
+}
+
+// BuildFirmwareDowngradePermitCommand builds the command to allow or forbid firmware downgrades
+// Command format: http revision-down permit on|off
+func BuildFirmwareDowngradePermitCommand(allow bool) string {
+	if allow {
+		return "http revision-down permit on"
+	}
+	return "http revision-down permit off"
+}
+
+// BuildFirmwareTimeoutCommand builds the command to set the download timeout
+// Command format: http revision-up timeout <seconds>
+func BuildFirmwareTimeoutCommand(seconds int) string {
+	return fmt.Sprintf("http revision-up timeout %d", seconds)
+}
+
+// BuildDeleteFirmwareTimeoutCommand builds the command to reset the download timeout
+func BuildDeleteFirmwareTimeoutCommand() string {
+	return "no http revision-up timeout"
+}
+
+// BuildFirmwareUpdateScheduleCommand builds the scheduled automatic update job
+// Command format: schedule at <id> <time> http revision-up go no-confirm
+func BuildFirmwareUpdateScheduleCommand(id int, time string) string {
+	return BuildScheduleAtCommand(id, time, FirmwareUpdateCommand)
+}
+
+// BuildFirmwareUpdateCommands builds all commands needed to apply firmware update settings
+func BuildFirmwareUpdateCommands(update FirmwareUpdate) []string {
+	var commands []string
+	if update.URL != "" {
+		commands = append(commands, BuildFirmwareURLCommand(update.URL))
+	}
+	commands = append(commands,
+		BuildFirmwarePermitCommand(update.AllowUpdate),
+		BuildFirmwareDowngradePermitCommand(update.AllowDowngrade),
+	)
+	if update.Timeout > 0 {
+		commands = append(commands, BuildFirmwareTimeoutCommand(update.Timeout))
+	}
+	if update.ScheduleID > 0 {
+		commands = append(commands, BuildFirmwareUpdateScheduleCommand(update.ScheduleID, update.ScheduleTime))
+	}
+	return commands
+}
+
+// BuildDeleteFirmwareUpdateCommands builds the commands needed to remove firmware update settings
+func BuildDeleteFirmwareUpdateCommands(update FirmwareUpdate) []string {
+	var commands []string
+	if update.ScheduleID > 0 {
+		commands = append(commands, BuildDeleteScheduleCommand(update.ScheduleID))
+	}
+	if update.Timeout > 0 {
+		commands = append(commands, BuildDeleteFirmwareTimeoutCommand())
+	}
+	commands = append(commands, "no http revision-down permit", "no http revision-up permit")
+	if update.URL != "" {
+		commands = append(commands, BuildDeleteFirmwareURLCommand())
+	}
+	return commands
+}
+
+// BuildShowFirmwareUpdateConfigCommand builds the command to show firmware update settings.
+// The pattern also matches the scheduled "http revision-up go" job.
+func BuildShowFirmwareUpdateConfigCommand() string {
+	return "show config | grep \"http revision-\""
+}
+
+// ValidateFirmwareUpdate validates firmware update settings
+func ValidateFirmwareUpdate(update FirmwareUpdate) error {
+	if update.URL != "" {
+		u, err := url.Parse(update.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid firmware URL %q, expected an http or https URL", update.URL)
+		}
+	}
+	// A zero timeout leaves the firmware default in place
+	if update.Timeout < 0 || update.Timeout > 180 {
+		return fmt.Errorf("timeout must be between 1 and 180 seconds, got %d", update.Timeout)
+	}
+
+	if update.ScheduleID == 0 {
+		if update.ScheduleTime != "" {
+			return fmt.Errorf("schedule id is required for an automatic update")
+		}
+		return nil
+	}
+	if !update.AllowUpdate {
+		return fmt.Errorf("automatic update requires firmware updates to be allowed")
+	}
+	if update.ScheduleID < 1 || update.ScheduleID > 65535 {
+		return fmt.Errorf("schedule id must be between 1 and 65535, got %d", update.ScheduleID)
+	}
+	return ValidateTimeFormat(update.ScheduleTime)
+}
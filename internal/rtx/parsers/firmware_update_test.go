@@ -0,0 +1,106 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFirmwareUpdate(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want *FirmwareUpdate
+	}{
+		{
+			name: "not configured",
+			raw:  "httpd host lan1",
+			want: nil,
+		},
+		{
+			name: "full configuration",
+			raw: `http revision-up url https://firmware.example.com/rtx1210.bin
+http revision-up permit on
+http revision-down permit off
+http revision-up timeout 60
+schedule at 20 04:15 http revision-up go no-confirm`,
+			want: &FirmwareUpdate{
+				URL:          "https://firmware.example.com/rtx1210.bin",
+				AllowUpdate:  true,
+				Timeout:      60,
+				ScheduleID:   20,
+				ScheduleTime: "04:15",
+			},
+		},
+		{
+			name: "unrelated schedules are ignored",
+			raw: `http revision-up permit on
+schedule at 1 03:00 copy config 0 usb1:/backup.txt`,
+			want: &FirmwareUpdate{AllowUpdate: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseFirmwareUpdate(tt.raw); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFirmwareUpdate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildFirmwareUpdateCommands(t *testing.T) {
+	update := FirmwareUpdate{
+		URL:          "https://firmware.example.com/rtx1210.bin",
+		AllowUpdate:  true,
+		Timeout:      60,
+		ScheduleID:   20,
+		ScheduleTime: "04:15",
+	}
+
+	want := []string{
+		"http revision-up url https://firmware.example.com/rtx1210.bin",
+		"http revision-up permit on",
+		"http revision-down permit off",
+		"http revision-up timeout 60",
+		"schedule at 20 04:15 http revision-up go no-confirm",
+	}
+	if got := BuildFirmwareUpdateCommands(update); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildFirmwareUpdateCommands() = %v, want %v", got, want)
+	}
+
+	wantDelete := []string{
+		"no schedule at 20",
+		"no http revision-up timeout",
+		"no http revision-down permit",
+		"no http revision-up permit",
+		"no http revision-up url",
+	}
+	if got := BuildDeleteFirmwareUpdateCommands(update); !reflect.DeepEqual(got, wantDelete) {
+		t.Errorf("BuildDeleteFirmwareUpdateCommands() = %v, want %v", got, wantDelete)
+	}
+}
+
+func TestValidateFirmwareUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  FirmwareUpdate
+		wantErr bool
+	}{
+		{name: "permissions only", update: FirmwareUpdate{AllowUpdate: true}},
+		{name: "https url", update: FirmwareUpdate{URL: "https://example.com/fw.bin"}},
+		{name: "ftp url", update: FirmwareUpdate{URL: "ftp://example.com/fw.bin"}, wantErr: true},
+		{name: "timeout too long", update: FirmwareUpdate{Timeout: 500}, wantErr: true},
+		{name: "auto update", update: FirmwareUpdate{AllowUpdate: true, ScheduleID: 1, ScheduleTime: "04:00"}},
+		{name: "auto update without permit", update: FirmwareUpdate{ScheduleID: 1, ScheduleTime: "04:00"}, wantErr: true},
+		{name: "auto update with bad time", update: FirmwareUpdate{AllowUpdate: true, ScheduleID: 1, ScheduleTime: "4am"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFirmwareUpdate(tt.update)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateFirmwareUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package parsers
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Flow export defaults applied by the router when not configured
+const (
+	DefaultFlowExportPort         = 2055
+	DefaultFlowExportSamplingRate = 1
+)
+
+// FlowExportConfig represents traffic flow export (external statistics) configuration
+type FlowExportConfig struct {
+	CollectorAddress string   `json:"collector_address"`    // Collector IP address
+	CollectorPort    int      `json:"collector_port"`       // Collector UDP port
+	SamplingRate     int      `json:"sampling_rate"`        // Export 1 out of N packets
+	Interfaces       []string `json:"interfaces,omitempty"` // Interfaces with flow export enabled
+}
+
+var (
+	flowExportDestinationPattern = regexp.MustCompile(`^\s*ip\s+flow\s+export\s+destination\s+(\S+)(?:\s+(\d+))?\s*$`)
+	flowExportSamplingPattern    = regexp.MustCompile(`^\s*ip\s+flow\s+export\s+sampling-rate\s+(\d+)\s*$`)
+	flowExportInterfacePattern   = regexp.MustCompile(`^\s*ip\s+(\S+)\s+flow\s+export\s+(on|off)\s*$`)
+)
+
+// ParseFlowExportConfig parses flow export lines from the router configuration.
+// Returns nil when no collector is configured.
+func ParseFlowExportConfig(raw string) *FlowExportConfig {
+	config := &FlowExportConfig{
+		CollectorPort: DefaultFlowExportPort,
+		SamplingRate:  DefaultFlowExportSamplingRate,
+		Interfaces:    []string{},
+	}
+	found := false
+
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if matches := flowExportDestinationPattern.FindStringSubmatch(line); len(matches) >= 2 {
+			config.CollectorAddress = matches[1]
+			if matches[2] != "" {
+				config.CollectorPort, _ = strconv.Atoi(matches[2])
+			}
+			found = true
+			continue
+		}
+
+		if matches := flowExportSamplingPattern.FindStringSubmatch(line); len(matches) >= 2 {
+			config.SamplingRate, _ = strconv.Atoi(matches[1])
+			continue
+		}
+
+		if matches := flowExportInterfacePattern.FindStringSubmatch(line); len(matches) >= 3 {
+			if matches[2] == "on" {
+				config.Interfaces = append(config.Interfaces, matches[1])
+			}
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	sort.Strings(config.Interfaces)
+	return config
+}
+
+// BuildFlowExportDestinationCommand builds the command to set the flow collector
+// Command format: ip flow export destination <address> [<port>]
+func BuildFlowExportDestinationCommand(address string, port int) string {
+	if port > 0 && port != DefaultFlowExportPort {
+		return fmt.Sprintf("ip flow export destination %s %d", address, port)
+	}
+	return fmt.Sprintf("ip flow export destination %s", address)
+}
+
+// BuildDeleteFlowExportDestinationCommand builds the command to remove the flow collector
+func BuildDeleteFlowExportDestinationCommand() string {
+	return "no ip flow export destination"
+}
+
+// BuildFlowExportSamplingRateCommand builds the command to set the sampling rate
+// Command format: ip flow export sampling-rate <n>
+func BuildFlowExportSamplingRateCommand(rate int) string {
+	return fmt.Sprintf("ip flow export sampling-rate %d", rate)
+}
+
+// BuildDeleteFlowExportSamplingRateCommand builds the command to reset the sampling rate
+func BuildDeleteFlowExportSamplingRateCommand() string {
+	return "no ip flow export sampling-rate"
+}
+
+// BuildFlowExportInterfaceCommand builds the command to enable or disable flow export on an interface
+// Command format: ip <interface> flow export on|off
+func BuildFlowExportInterfaceCommand(iface string, enabled bool) string {
+	if enabled {
+		return fmt.Sprintf("ip %s flow export on", iface)
+	}
+	return fmt.Sprintf("ip %s flow export off", iface)
+}
+
+// BuildDeleteFlowExportInterfaceCommand builds the command to remove flow export from an interface
+func BuildDeleteFlowExportInterfaceCommand(iface string) string {
+	return fmt.Sprintf("no ip %s flow export", iface)
+}
+
+// BuildFlowExportCommands builds all commands needed to apply a flow export configuration
+func BuildFlowExportCommands(config FlowExportConfig) []string {
+	commands := []string{
+		BuildFlowExportDestinationCommand(config.CollectorAddress, config.CollectorPort),
+	}
+	if config.SamplingRate > DefaultFlowExportSamplingRate {
+		commands = append(commands, BuildFlowExportSamplingRateCommand(config.SamplingRate))
+	}
+	for _, iface := range config.Interfaces {
+		commands = append(commands, BuildFlowExportInterfaceCommand(iface, true))
+	}
+	return commands
+}
+
+// BuildDeleteFlowExportCommands builds the commands needed to remove a flow export configuration
+func BuildDeleteFlowExportCommands(config FlowExportConfig) []string {
+	var commands []string
+	for _, iface := range config.Interfaces {
+		commands = append(commands, BuildDeleteFlowExportInterfaceCommand(iface))
+	}
+	if config.SamplingRate > DefaultFlowExportSamplingRate {
+		commands = append(commands, BuildDeleteFlowExportSamplingRateCommand())
+	}
+	commands = append(commands, BuildDeleteFlowExportDestinationCommand())
+	return commands
+}
+
+// BuildShowFlowExportConfigCommand builds the command to show flow export configuration
+func BuildShowFlowExportConfigCommand() string {
+	return "show config | grep \"flow export\""
+}
+
+// ValidateFlowExportConfig validates a flow export configuration
+func ValidateFlowExportConfig(config FlowExportConfig) error {
+	if net.ParseIP(config.CollectorAddress) == nil {
+		return fmt.Errorf("invalid collector address: %s", config.CollectorAddress)
+	}
+	if config.CollectorPort < 1 || config.CollectorPort > 65535 {
+		return fmt.Errorf("collector port must be between 1 and 65535, got %d", config.CollectorPort)
+	}
+	if config.SamplingRate < 1 || config.SamplingRate > 65535 {
+		return fmt.Errorf("sampling rate must be between 1 and 65535, got %d", config.SamplingRate)
+	}
+	for _, iface := range config.Interfaces {
+		if err := ValidateInterfaceName(iface); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFlowExportConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want *FlowExportConfig
+	}{
+		{
+			name: "not configured",
+			raw:  "ip lan1 address 192.168.1.1/24",
+			want: nil,
+		},
+		{
+			name: "defaults",
+			raw:  "ip flow export destination 192.0.2.10",
+			want: &FlowExportConfig{
+				CollectorAddress: "192.0.2.10",
+				CollectorPort:    DefaultFlowExportPort,
+				SamplingRate:     DefaultFlowExportSamplingRate,
+				Interfaces:       []string{},
+			},
+		},
+		{
+			name: "full configuration",
+			raw: `ip flow export destination 192.0.2.10 9995
+ip flow export sampling-rate 100
+ip pp1 flow export on
+ip lan1 flow export on
+ip lan3 flow export off`,
+			want: &FlowExportConfig{
+				CollectorAddress: "192.0.2.10",
+				CollectorPort:    9995,
+				SamplingRate:     100,
+				Interfaces:       []string{"lan1", "pp1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseFlowExportConfig(tt.raw)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFlowExportConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildFlowExportCommands(t *testing.T) {
+	config := FlowExportConfig{
+		CollectorAddress: "192.0.2.10",
+		CollectorPort:    9995,
+		SamplingRate:     100,
+		Interfaces:       []string{"lan1", "pp1"},
+	}
+
+	want := []string{
+		"ip flow export destination 192.0.2.10 9995",
+		"ip flow export sampling-rate 100",
+		"ip lan1 flow export on",
+		"ip pp1 flow export on",
+	}
+	if got := BuildFlowExportCommands(config); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildFlowExportCommands() = %v, want %v", got, want)
+	}
+
+	wantDelete := []string{
+		"no ip lan1 flow export",
+		"no ip pp1 flow export",
+		"no ip flow export sampling-rate",
+		"no ip flow export destination",
+	}
+	if got := BuildDeleteFlowExportCommands(config); !reflect.DeepEqual(got, wantDelete) {
+		t.Errorf("BuildDeleteFlowExportCommands() = %v, want %v", got, wantDelete)
+	}
+
+	if got := BuildFlowExportDestinationCommand("192.0.2.10", DefaultFlowExportPort); got != "ip flow export destination 192.0.2.10" {
+		t.Errorf("BuildFlowExportDestinationCommand() with default port = %q", got)
+	}
+}
+
+func TestValidateFlowExportConfig(t *testing.T) {
+	valid := FlowExportConfig{
+		CollectorAddress: "192.0.2.10",
+		CollectorPort:    2055,
+		SamplingRate:     1,
+		Interfaces:       []string{"lan1"},
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(*FlowExportConfig)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(c *FlowExportConfig) {}},
+		{name: "ipv6 collector", modify: func(c *FlowExportConfig) { c.CollectorAddress = "2001:db8::10" }},
+		{name: "invalid collector", modify: func(c *FlowExportConfig) { c.CollectorAddress = "collector" }, wantErr: true},
+		{name: "port out of range", modify: func(c *FlowExportConfig) { c.CollectorPort = 70000 }, wantErr: true},
+		{name: "zero sampling rate", modify: func(c *FlowExportConfig) { c.SamplingRate = 0 }, wantErr: true},
+		{name: "invalid interface", modify: func(c *FlowExportConfig) { c.Interfaces = []string{"eth0"} }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+			err := ValidateFlowExportConfig(config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateFlowExportConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ICMPStealthConfig represents the ICMP hardening settings of the router
+type ICMPStealthConfig struct {
+	StealthInterfaces   []string `json:"stealth_interfaces"`      // ip stealth <interface>... or all
+	EchoReply           bool     `json:"echo_reply"`              // ip icmp echo-reply send on|off
+	EchoReplyOnlyLinkUp bool     `json:"echo_reply_only_link_up"` // ip icmp echo-reply send-only-linkup on|off
+	Unreachable         bool     `json:"unreachable"`             // ip icmp unreachable send on|off
+	Redirect            bool     `json:"redirect"`                // ip icmp redirect send on|off
+}
+
+// StealthAllInterfaces is the "ip stealth" keyword that covers every interface
+const StealthAllInterfaces = "all"
+
+var (
+	ipStealthPattern        = regexp.MustCompile(`^\s*ip\s+stealth\s+(.+?)\s*$`)
+	ipICMPSendPattern       = regexp.MustCompile(`^\s*ip\s+icmp\s+(echo-reply\s+send-only-linkup|echo-reply\s+send|unreachable\s+send|redirect\s+send)\s+(on|off)\s*$`)
+	stealthInterfacePattern = regexp.MustCompile(`^(lan\d+(/\d+)?|pp\d+|tunnel\d+|bridge\d+)$`)
+)
+
+// DefaultICMPStealthConfig returns the router defaults for the ICMP settings
+func DefaultICMPStealthConfig() ICMPStealthConfig {
+	return ICMPStealthConfig{
+		EchoReply:   true,
+		Unreachable: true,
+		Redirect:    true,
+	}
+}
+
+// ParseICMPStealthConfig parses "ip stealth" and "ip icmp" settings from the
+// router configuration. Settings that are not present keep the router default.
+func ParseICMPStealthConfig(raw string) *ICMPStealthConfig {
+	config := DefaultICMPStealthConfig()
+
+	for _, line := range strings.Split(raw, "\n") {
+		if matches := ipStealthPattern.FindStringSubmatch(line); len(matches) == 2 {
+			config.StealthInterfaces = strings.Fields(matches[1])
+			continue
+		}
+		matches := ipICMPSendPattern.FindStringSubmatch(line)
+		if len(matches) != 3 {
+			continue
+		}
+
+		on := matches[2] == "on"
+		switch strings.Join(strings.Fields(matches[1]), " ") {
+		case "echo-reply send":
+			config.EchoReply = on
+		case "echo-reply send-only-linkup":
+			config.EchoReplyOnlyLinkUp = on
+		case "unreachable send":
+			config.Unreachable = on
+		case "redirect send":
+			config.Redirect = on
+		}
+	}
+
+	return &config
+}
+
+// BuildICMPStealthCommands builds the commands to apply the ICMP settings
+func BuildICMPStealthCommands(config ICMPStealthConfig) []string {
+	stealth := "no ip stealth"
+	if len(config.StealthInterfaces) > 0 {
+		stealth = fmt.Sprintf("ip stealth %s", strings.Join(config.StealthInterfaces, " "))
+	}
+
+	return []string{
+		stealth,
+		fmt.Sprintf("ip icmp echo-reply send %s", icmpSwitch(config.EchoReply)),
+		fmt.Sprintf("ip icmp echo-reply send-only-linkup %s", icmpSwitch(config.EchoReplyOnlyLinkUp)),
+		fmt.Sprintf("ip icmp unreachable send %s", icmpSwitch(config.Unreachable)),
+		fmt.Sprintf("ip icmp redirect send %s", icmpSwitch(config.Redirect)),
+	}
+}
+
+// BuildDeleteICMPStealthCommands builds the commands to restore the default ICMP settings
+func BuildDeleteICMPStealthCommands() []string {
+	return []string{
+		"no ip stealth",
+		"no ip icmp echo-reply send",
+		"no ip icmp echo-reply send-only-linkup",
+		"no ip icmp unreachable send",
+		"no ip icmp redirect send",
+	}
+}
+
+// BuildShowICMPStealthConfigCommand builds the command to show the ICMP settings.
+// "ip stealth" and "ip icmp" share no prefix narrower than "ip ", so the whole
+// configuration is read.
+func BuildShowICMPStealthConfigCommand() string {
+	return "show config"
+}
+
+// ValidateICMPStealthConfig validates the ICMP settings
+func ValidateICMPStealthConfig(config ICMPStealthConfig) error {
+	if slices.Contains(config.StealthInterfaces, StealthAllInterfaces) {
+		if len(config.StealthInterfaces) > 1 {
+			return fmt.Errorf("stealth interface %q cannot be combined with other interfaces", StealthAllInterfaces)
+		}
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for _, iface := range config.StealthInterfaces {
+		if !stealthInterfacePattern.MatchString(iface) {
+			return fmt.Errorf("invalid stealth interface %q", iface)
+		}
+		if seen[iface] {
+			return fmt.Errorf("duplicate stealth interface %s", iface)
+		}
+		seen[iface] = true
+	}
+	return nil
+}
+
+// icmpSwitch returns the on/off keyword for an ICMP setting
+func icmpSwitch(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+	return "off"
+}
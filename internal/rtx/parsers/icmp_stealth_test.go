@@ -0,0 +1,88 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseICMPStealthConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want *ICMPStealthConfig
+	}{
+		{
+			name: "defaults",
+			raw:  "ip lan1 address 192.168.1.1/24",
+			want: &ICMPStealthConfig{EchoReply: true, Unreachable: true, Redirect: true},
+		},
+		{
+			name: "hardened",
+			raw: `ip stealth pp1 lan2
+ip icmp echo-reply send off
+ip icmp echo-reply send-only-linkup on
+ip icmp unreachable send off
+ip icmp redirect send off`,
+			want: &ICMPStealthConfig{
+				StealthInterfaces:   []string{"pp1", "lan2"},
+				EchoReplyOnlyLinkUp: true,
+			},
+		},
+		{
+			name: "all interfaces",
+			raw:  "ip stealth all",
+			want: &ICMPStealthConfig{StealthInterfaces: []string{"all"}, EchoReply: true, Unreachable: true, Redirect: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseICMPStealthConfig(tt.raw); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseICMPStealthConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildICMPStealthCommands(t *testing.T) {
+	config := ICMPStealthConfig{StealthInterfaces: []string{"pp1", "lan2"}, Unreachable: true}
+
+	want := []string{
+		"ip stealth pp1 lan2",
+		"ip icmp echo-reply send off",
+		"ip icmp echo-reply send-only-linkup off",
+		"ip icmp unreachable send on",
+		"ip icmp redirect send off",
+	}
+	if got := BuildICMPStealthCommands(config); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildICMPStealthCommands() = %v, want %v", got, want)
+	}
+
+	if got := BuildICMPStealthCommands(DefaultICMPStealthConfig()); got[0] != "no ip stealth" {
+		t.Errorf("BuildICMPStealthCommands() without stealth interfaces = %q, want %q", got[0], "no ip stealth")
+	}
+}
+
+func TestValidateICMPStealthConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		interfaces []string
+		wantErr    bool
+	}{
+		{name: "none"},
+		{name: "interfaces", interfaces: []string{"pp1", "lan2", "tunnel1"}},
+		{name: "all", interfaces: []string{"all"}},
+		{name: "all with others", interfaces: []string{"all", "pp1"}, wantErr: true},
+		{name: "invalid", interfaces: []string{"wan1x"}, wantErr: true},
+		{name: "duplicate", interfaces: []string{"pp1", "pp1"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateICMPStealthConfig(ICMPStealthConfig{StealthInterfaces: tt.interfaces})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateICMPStealthConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,411 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// IKEv2 defaults applied by the router when not configured
+const (
+	DefaultIKEv2IKESALifetime   = 28800
+	DefaultIKEv2ChildSALifetime = 28800
+)
+
+// Valid IKEv2 authentication methods, ID types and proposal algorithms
+var (
+	ValidIKEv2AuthMethods  = []string{"psk", "certificate", "eap-md5"}
+	ValidIKEv2IDTypes      = []string{"ipv4-addr", "ipv6-addr", "fqdn", "rfc822-addr", "key-id"}
+	ValidIKEv2Encryptions  = []string{"aes-gcm-256", "aes-gcm", "aes-cbc-256", "aes-cbc", "3des-cbc"}
+	ValidIKEv2Integrities  = []string{"sha512", "sha384", "sha256", "sha"}
+	ValidIKEv2Groups       = []string{"modp4096", "modp3072", "modp2048", "modp1536", "modp1024"}
+	ValidESPEncryptions    = []string{"aes-gcm-256", "aes-gcm", "aes-cbc-256", "aes-cbc", "3des-cbc"}
+	ValidESPIntegrities    = []string{"sha512-hmac", "sha384-hmac", "sha256-hmac", "sha-hmac"}
+	defaultESPEncryption   = "aes-cbc-256"
+	defaultESPIntegrity    = "sha256-hmac"
+	ikev2GCMESPEncryptions = map[string]bool{"aes-gcm-256": true, "aes-gcm": true}
+)
+
+// IKEv2Tunnel represents an IPsec tunnel negotiated with IKEv2.
+// The tunnel ID is used as tunnel select, ipsec tunnel, SA policy and IKE gateway ID.
+type IKEv2Tunnel struct {
+	ID                int      `json:"id"`                           // tunnel select N
+	LocalAddress      string   `json:"local_address,omitempty"`      // ipsec ike local address
+	RemoteAddress     string   `json:"remote_address"`               // ipsec ike remote address
+	LocalName         string   `json:"local_name,omitempty"`         // ipsec ike local name value
+	LocalNameType     string   `json:"local_name_type,omitempty"`    // ipsec ike local name type
+	RemoteName        string   `json:"remote_name,omitempty"`        // ipsec ike remote name value
+	RemoteNameType    string   `json:"remote_name_type,omitempty"`   // ipsec ike remote name type
+	LocalAuthMethod   string   `json:"local_auth_method"`            // ipsec ike local auth method
+	RemoteAuthMethod  string   `json:"remote_auth_method"`           // ipsec ike remote auth method
+	PreSharedKey      string   `json:"pre_shared_key,omitempty"`     // ipsec ike pre-shared-key
+	CertificateID     int      `json:"certificate_id,omitempty"`     // ipsec ike pki file certificate=<id>
+	EAPUsername       string   `json:"eap_username,omitempty"`       // ipsec ike eap myname <user>
+	EAPPassword       string   `json:"eap_password,omitempty"`       // ipsec ike eap myname <user> <password>
+	Encryption        []string `json:"encryption,omitempty"`         // ipsec ike encryption (proposal list)
+	Integrity         []string `json:"integrity,omitempty"`          // ipsec ike hash (proposal list)
+	Groups            []string `json:"groups,omitempty"`             // ipsec ike group (proposal list)
+	ESPEncryption     string   `json:"esp_encryption"`               // ipsec sa policy encryption
+	ESPIntegrity      string   `json:"esp_integrity,omitempty"`      // ipsec sa policy integrity (not used with GCM)
+	IKESALifetime     int      `json:"ike_sa_lifetime"`              // ipsec ike duration ike-sa
+	ChildSALifetime   int      `json:"child_sa_lifetime"`            // ipsec ike duration child-sa
+	KeepaliveInterval int      `json:"keepalive_interval,omitempty"` // ipsec ike keepalive use on rfc4306 (0 = off)
+	KeepaliveRetry    int      `json:"keepalive_retry,omitempty"`    // ipsec ike keepalive retry count
+	NATTraversal      bool     `json:"nat_traversal"`                // ipsec ike nat-traversal
+	Enabled           bool     `json:"enabled"`                      // tunnel enable N
+}
+
+var (
+	ikev2TunnelSelectPattern    = regexp.MustCompile(`^\s*tunnel\s+select\s+(\d+|none)\s*$`)
+	ikev2VersionPattern         = regexp.MustCompile(`^\s*ipsec\s+ike\s+version\s+(\d+)\s+2\s*$`)
+	ikev2SAPolicyPattern        = regexp.MustCompile(`^\s*ipsec\s+sa\s+policy\s+(\d+)\s+(\d+)\s+esp\s+(\S+)(?:\s+(\S+))?\s*$`)
+	ikev2LocalAddressPattern    = regexp.MustCompile(`^\s*ipsec\s+ike\s+local\s+address\s+(\d+)\s+(\S+)\s*$`)
+	ikev2RemoteAddressPattern   = regexp.MustCompile(`^\s*ipsec\s+ike\s+remote\s+address\s+(\d+)\s+(\S+)\s*$`)
+	ikev2LocalNamePattern       = regexp.MustCompile(`^\s*ipsec\s+ike\s+local\s+name\s+(\d+)\s+(\S+)\s+(\S+)\s*$`)
+	ikev2RemoteNamePattern      = regexp.MustCompile(`^\s*ipsec\s+ike\s+remote\s+name\s+(\d+)\s+(\S+)\s+(\S+)\s*$`)
+	ikev2LocalAuthPattern       = regexp.MustCompile(`^\s*ipsec\s+ike\s+local\s+auth\s+method\s+(\d+)\s+(\S+)\s*$`)
+	ikev2RemoteAuthPattern      = regexp.MustCompile(`^\s*ipsec\s+ike\s+remote\s+auth\s+method\s+(\d+)\s+(\S+)\s*$`)
+	ikev2PreSharedKeyPattern    = regexp.MustCompile(`^\s*ipsec\s+ike\s+pre-shared-key\s+(\d+)\s+text\s+(\S+)\s*$`)
+	ikev2PKIFilePattern         = regexp.MustCompile(`^\s*ipsec\s+ike\s+pki\s+file\s+(\d+)\s+certificate=(\d+)`)
+	ikev2EAPMynamePattern       = regexp.MustCompile(`^\s*ipsec\s+ike\s+eap\s+myname\s+(\d+)\s+(\S+)\s+(\S+)\s*$`)
+	ikev2EncryptionPattern      = regexp.MustCompile(`^\s*ipsec\s+ike\s+encryption\s+(\d+)\s+(\S+)\s*$`)
+	ikev2HashPattern            = regexp.MustCompile(`^\s*ipsec\s+ike\s+hash\s+(\d+)\s+(\S+)\s*$`)
+	ikev2GroupPattern           = regexp.MustCompile(`^\s*ipsec\s+ike\s+group\s+(\d+)\s+(\S+)\s*$`)
+	ikev2DurationPattern        = regexp.MustCompile(`^\s*ipsec\s+ike\s+duration\s+(ike-sa|child-sa)\s+(\d+)\s+(\d+)\s*$`)
+	ikev2KeepalivePattern       = regexp.MustCompile(`^\s*ipsec\s+ike\s+keepalive\s+use\s+(\d+)\s+on\s+rfc4306\s+(\d+)\s+(\d+)\s*$`)
+	ikev2NATTraversalPattern    = regexp.MustCompile(`^\s*ipsec\s+ike\s+nat-traversal\s+(\d+)\s+(on|off)\s*$`)
+	ikev2TunnelEnablePattern    = regexp.MustCompile(`^\s*tunnel\s+enable\s+(\d+)\s*$`)
+	ikev2IPsecTunnelLinePattern = regexp.MustCompile(`^\s*ipsec\s+tunnel\s+(\d+)\s*$`)
+)
+
+// ParseIKEv2Tunnels parses IKEv2 tunnels from the router configuration.
+// Only gateways declared with "ipsec ike version <n> 2" are returned; IKEv1
+// tunnels are handled by the IPsec tunnel parser.
+func ParseIKEv2Tunnels(raw string) []IKEv2Tunnel {
+	tunnels := make(map[int]*IKEv2Tunnel)
+	isV2 := make(map[int]bool)
+	var order []int
+
+	get := func(id int) *IKEv2Tunnel {
+		if t, ok := tunnels[id]; ok {
+			return t
+		}
+		t := &IKEv2Tunnel{
+			ID:              id,
+			IKESALifetime:   DefaultIKEv2IKESALifetime,
+			ChildSALifetime: DefaultIKEv2ChildSALifetime,
+		}
+		tunnels[id] = t
+		order = append(order, id)
+		return t
+	}
+	atoi := func(s string) int {
+		n, _ := strconv.Atoi(s)
+		return n
+	}
+
+	currentTunnelID := 0
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if matches := ikev2TunnelSelectPattern.FindStringSubmatch(line); len(matches) >= 2 {
+			currentTunnelID = atoi(matches[1])
+			continue
+		}
+
+		switch {
+		case ikev2VersionPattern.MatchString(line):
+			isV2[atoi(ikev2VersionPattern.FindStringSubmatch(line)[1])] = true
+		case ikev2IPsecTunnelLinePattern.MatchString(line):
+			if currentTunnelID > 0 {
+				get(currentTunnelID)
+			}
+		case ikev2SAPolicyPattern.MatchString(line):
+			m := ikev2SAPolicyPattern.FindStringSubmatch(line)
+			t := get(atoi(m[1]))
+			t.ESPEncryption = m[3]
+			t.ESPIntegrity = m[4]
+		case ikev2LocalAddressPattern.MatchString(line):
+			m := ikev2LocalAddressPattern.FindStringSubmatch(line)
+			get(atoi(m[1])).LocalAddress = m[2]
+		case ikev2RemoteAddressPattern.MatchString(line):
+			m := ikev2RemoteAddressPattern.FindStringSubmatch(line)
+			get(atoi(m[1])).RemoteAddress = m[2]
+		case ikev2LocalNamePattern.MatchString(line):
+			m := ikev2LocalNamePattern.FindStringSubmatch(line)
+			t := get(atoi(m[1]))
+			t.LocalName, t.LocalNameType = m[2], m[3]
+		case ikev2RemoteNamePattern.MatchString(line):
+			m := ikev2RemoteNamePattern.FindStringSubmatch(line)
+			t := get(atoi(m[1]))
+			t.RemoteName, t.RemoteNameType = m[2], m[3]
+		case ikev2LocalAuthPattern.MatchString(line):
+			m := ikev2LocalAuthPattern.FindStringSubmatch(line)
+			get(atoi(m[1])).LocalAuthMethod = m[2]
+		case ikev2RemoteAuthPattern.MatchString(line):
+			m := ikev2RemoteAuthPattern.FindStringSubmatch(line)
+			get(atoi(m[1])).RemoteAuthMethod = m[2]
+		case ikev2PreSharedKeyPattern.MatchString(line):
+			m := ikev2PreSharedKeyPattern.FindStringSubmatch(line)
+			get(atoi(m[1])).PreSharedKey = m[2]
+		case ikev2PKIFilePattern.MatchString(line):
+			m := ikev2PKIFilePattern.FindStringSubmatch(line)
+			get(atoi(m[1])).CertificateID = atoi(m[2])
+		case ikev2EAPMynamePattern.MatchString(line):
+			m := ikev2EAPMynamePattern.FindStringSubmatch(line)
+			t := get(atoi(m[1]))
+			t.EAPUsername, t.EAPPassword = m[2], m[3]
+		case ikev2EncryptionPattern.MatchString(line):
+			m := ikev2EncryptionPattern.FindStringSubmatch(line)
+			get(atoi(m[1])).Encryption = strings.Split(m[2], ",")
+		case ikev2HashPattern.MatchString(line):
+			m := ikev2HashPattern.FindStringSubmatch(line)
+			get(atoi(m[1])).Integrity = strings.Split(m[2], ",")
+		case ikev2GroupPattern.MatchString(line):
+			m := ikev2GroupPattern.FindStringSubmatch(line)
+			get(atoi(m[1])).Groups = strings.Split(m[2], ",")
+		case ikev2DurationPattern.MatchString(line):
+			m := ikev2DurationPattern.FindStringSubmatch(line)
+			t := get(atoi(m[2]))
+			if m[1] == "ike-sa" {
+				t.IKESALifetime = atoi(m[3])
+			} else {
+				t.ChildSALifetime = atoi(m[3])
+			}
+		case ikev2KeepalivePattern.MatchString(line):
+			m := ikev2KeepalivePattern.FindStringSubmatch(line)
+			t := get(atoi(m[1]))
+			t.KeepaliveInterval, t.KeepaliveRetry = atoi(m[2]), atoi(m[3])
+		case ikev2NATTraversalPattern.MatchString(line):
+			m := ikev2NATTraversalPattern.FindStringSubmatch(line)
+			get(atoi(m[1])).NATTraversal = m[2] == "on"
+		case ikev2TunnelEnablePattern.MatchString(line):
+			get(atoi(ikev2TunnelEnablePattern.FindStringSubmatch(line)[1])).Enabled = true
+		}
+	}
+
+	var result []IKEv2Tunnel
+	for _, id := range order {
+		if isV2[id] {
+			result = append(result, *tunnels[id])
+		}
+	}
+	return result
+}
+
+// BuildShowIKEv2TunnelsCommand builds the command to show IKEv2 tunnel configuration
+func BuildShowIKEv2TunnelsCommand() string {
+	return "show config"
+}
+
+// BuildIKEv2TunnelCommands builds the commands to configure an IKEv2 tunnel
+func BuildIKEv2TunnelCommands(t IKEv2Tunnel) []string {
+	id := t.ID
+	commands := []string{
+		BuildTunnelSelectCommand(id),
+		BuildIPsecTunnelCommand(id),
+		buildIKEv2SAPolicyCommand(t),
+		fmt.Sprintf("ipsec ike version %d 2", id),
+	}
+
+	if t.LocalAddress != "" {
+		commands = append(commands, BuildIPsecIKELocalAddressCommand(id, t.LocalAddress))
+	}
+	commands = append(commands, BuildIPsecIKERemoteAddressCommand(id, t.RemoteAddress))
+	if t.LocalName != "" {
+		commands = append(commands, fmt.Sprintf("ipsec ike local name %d %s %s", id, t.LocalName, t.LocalNameType))
+	}
+	if t.RemoteName != "" {
+		commands = append(commands, BuildIPsecIKERemoteNameCommand(id, t.RemoteName, t.RemoteNameType))
+	}
+
+	commands = append(commands,
+		fmt.Sprintf("ipsec ike local auth method %d %s", id, t.LocalAuthMethod),
+		fmt.Sprintf("ipsec ike remote auth method %d %s", id, t.RemoteAuthMethod),
+	)
+	if t.PreSharedKey != "" {
+		commands = append(commands, BuildIPsecIKEPreSharedKeyCommand(id, t.PreSharedKey))
+	}
+	if t.CertificateID > 0 {
+		commands = append(commands, fmt.Sprintf("ipsec ike pki file %d certificate=%d", id, t.CertificateID))
+	}
+	if t.EAPUsername != "" {
+		commands = append(commands, fmt.Sprintf("ipsec ike eap myname %d %s %s", id, t.EAPUsername, t.EAPPassword))
+	}
+
+	if len(t.Encryption) > 0 {
+		commands = append(commands, fmt.Sprintf("ipsec ike encryption %d %s", id, strings.Join(t.Encryption, ",")))
+	}
+	if len(t.Integrity) > 0 {
+		commands = append(commands, fmt.Sprintf("ipsec ike hash %d %s", id, strings.Join(t.Integrity, ",")))
+	}
+	if len(t.Groups) > 0 {
+		commands = append(commands, fmt.Sprintf("ipsec ike group %d %s", id, strings.Join(t.Groups, ",")))
+	}
+
+	commands = append(commands,
+		fmt.Sprintf("ipsec ike duration ike-sa %d %d", id, t.IKESALifetime),
+		fmt.Sprintf("ipsec ike duration child-sa %d %d", id, t.ChildSALifetime),
+		BuildIPsecIKENATTraversalCommand(id, t.NATTraversal),
+	)
+
+	if t.KeepaliveInterval > 0 {
+		commands = append(commands, fmt.Sprintf("ipsec ike keepalive use %d on rfc4306 %d %d", id, t.KeepaliveInterval, t.KeepaliveRetry))
+	} else {
+		commands = append(commands, BuildIPsecIKEKeepaliveOffCommand(id))
+	}
+
+	if t.Enabled {
+		commands = append(commands, BuildTunnelEnableCommand(id))
+	} else {
+		commands = append(commands, BuildTunnelDisableCommand(id))
+	}
+
+	return commands
+}
+
+// buildIKEv2SAPolicyCommand builds the ESP SA policy of the tunnel.
+// GCM ciphers provide integrity themselves, so no hash is given for them.
+func buildIKEv2SAPolicyCommand(t IKEv2Tunnel) string {
+	enc := t.ESPEncryption
+	if enc == "" {
+		enc = defaultESPEncryption
+	}
+	if ikev2GCMESPEncryptions[enc] {
+		return fmt.Sprintf("ipsec sa policy %d %d esp %s", t.ID, t.ID, enc)
+	}
+	hash := t.ESPIntegrity
+	if hash == "" {
+		hash = defaultESPIntegrity
+	}
+	return fmt.Sprintf("ipsec sa policy %d %d esp %s %s", t.ID, t.ID, enc, hash)
+}
+
+// BuildIKEv2TunnelCleanupCommands builds the commands removing optional settings
+// present in current but no longer wanted in desired
+func BuildIKEv2TunnelCleanupCommands(current, desired IKEv2Tunnel) []string {
+	id := desired.ID
+	var commands []string
+
+	if current.LocalAddress != "" && desired.LocalAddress == "" {
+		commands = append(commands, fmt.Sprintf("no ipsec ike local address %d", id))
+	}
+	if current.LocalName != "" && desired.LocalName == "" {
+		commands = append(commands, fmt.Sprintf("no ipsec ike local name %d", id))
+	}
+	if current.RemoteName != "" && desired.RemoteName == "" {
+		commands = append(commands, fmt.Sprintf("no ipsec ike remote name %d", id))
+	}
+	if current.PreSharedKey != "" && desired.PreSharedKey == "" {
+		commands = append(commands, fmt.Sprintf("no ipsec ike pre-shared-key %d", id))
+	}
+	if current.CertificateID > 0 && desired.CertificateID == 0 {
+		commands = append(commands, fmt.Sprintf("no ipsec ike pki file %d", id))
+	}
+	if current.EAPUsername != "" && desired.EAPUsername == "" {
+		commands = append(commands, fmt.Sprintf("no ipsec ike eap myname %d", id))
+	}
+	if len(current.Encryption) > 0 && len(desired.Encryption) == 0 {
+		commands = append(commands, BuildDeleteIPsecIKEEncryptionCommand(id))
+	}
+	if len(current.Integrity) > 0 && len(desired.Integrity) == 0 {
+		commands = append(commands, BuildDeleteIPsecIKEHashCommand(id))
+	}
+	if len(current.Groups) > 0 && len(desired.Groups) == 0 {
+		commands = append(commands, BuildDeleteIPsecIKEGroupCommand(id))
+	}
+
+	return commands
+}
+
+// BuildDeleteIKEv2TunnelCommands builds the commands to remove an IKEv2 tunnel
+// together with its gateway-level IKE settings
+func BuildDeleteIKEv2TunnelCommands(id int) []string {
+	commands := BuildDeleteTunnelCommands(id)
+	for _, setting := range []string{
+		"version", "local address", "remote address", "local name", "remote name",
+		"local auth method", "remote auth method", "pre-shared-key", "pki file", "eap myname",
+		"encryption", "hash", "group", "duration ike-sa", "duration child-sa",
+		"nat-traversal", "keepalive use",
+	} {
+		commands = append(commands, fmt.Sprintf("no ipsec ike %s %d", setting, id))
+	}
+	return append(commands, fmt.Sprintf("no ipsec sa policy %d", id))
+}
+
+// ValidateIKEv2Tunnel validates an IKEv2 tunnel configuration
+func ValidateIKEv2Tunnel(t IKEv2Tunnel) error {
+	if t.ID <= 0 {
+		return fmt.Errorf("tunnel id must be positive")
+	}
+	if t.LocalAddress != "" && !isValidIPOrFQDN(t.LocalAddress) {
+		return fmt.Errorf("invalid local_address: %s", t.LocalAddress)
+	}
+	if !isValidIPOrFQDNOrAny(t.RemoteAddress) {
+		return fmt.Errorf("invalid remote_address: %q", t.RemoteAddress)
+	}
+	if t.LocalName != "" && !slices.Contains(ValidIKEv2IDTypes, t.LocalNameType) {
+		return fmt.Errorf("local name type must be one of %v", ValidIKEv2IDTypes)
+	}
+	if t.RemoteName != "" && !slices.Contains(ValidIKEv2IDTypes, t.RemoteNameType) {
+		return fmt.Errorf("remote name type must be one of %v", ValidIKEv2IDTypes)
+	}
+
+	if !slices.Contains(ValidIKEv2AuthMethods, t.LocalAuthMethod) {
+		return fmt.Errorf("local auth method must be one of %v", ValidIKEv2AuthMethods)
+	}
+	if !slices.Contains(ValidIKEv2AuthMethods, t.RemoteAuthMethod) {
+		return fmt.Errorf("remote auth method must be one of %v", ValidIKEv2AuthMethods)
+	}
+	if (t.LocalAuthMethod == "psk" || t.RemoteAuthMethod == "psk") && t.PreSharedKey == "" {
+		return fmt.Errorf("pre-shared key is required for psk authentication")
+	}
+	if t.LocalAuthMethod == "certificate" && t.CertificateID <= 0 {
+		return fmt.Errorf("certificate id is required for certificate authentication")
+	}
+	if t.LocalAuthMethod == "eap-md5" && (t.EAPUsername == "" || t.EAPPassword == "") {
+		return fmt.Errorf("EAP username and password are required for eap-md5 authentication")
+	}
+
+	for _, v := range t.Encryption {
+		if !slices.Contains(ValidIKEv2Encryptions, v) {
+			return fmt.Errorf("invalid IKE encryption %q, must be one of %v", v, ValidIKEv2Encryptions)
+		}
+	}
+	for _, v := range t.Integrity {
+		if !slices.Contains(ValidIKEv2Integrities, v) {
+			return fmt.Errorf("invalid IKE integrity %q, must be one of %v", v, ValidIKEv2Integrities)
+		}
+	}
+	for _, v := range t.Groups {
+		if !slices.Contains(ValidIKEv2Groups, v) {
+			return fmt.Errorf("invalid IKE group %q, must be one of %v", v, ValidIKEv2Groups)
+		}
+	}
+	if t.ESPEncryption != "" && !slices.Contains(ValidESPEncryptions, t.ESPEncryption) {
+		return fmt.Errorf("invalid ESP encryption %q, must be one of %v", t.ESPEncryption, ValidESPEncryptions)
+	}
+	if t.ESPIntegrity != "" && !slices.Contains(ValidESPIntegrities, t.ESPIntegrity) {
+		return fmt.Errorf("invalid ESP integrity %q, must be one of %v", t.ESPIntegrity, ValidESPIntegrities)
+	}
+
+	if t.IKESALifetime < 60 || t.IKESALifetime > 691200 {
+		return fmt.Errorf("IKE SA lifetime must be between 60 and 691200 seconds, got %d", t.IKESALifetime)
+	}
+	if t.ChildSALifetime < 60 || t.ChildSALifetime > 691200 {
+		return fmt.Errorf("child SA lifetime must be between 60 and 691200 seconds, got %d", t.ChildSALifetime)
+	}
+	if t.KeepaliveInterval < 0 || t.KeepaliveInterval > 600 {
+		return fmt.Errorf("keepalive interval must be between 0 and 600 seconds, got %d", t.KeepaliveInterval)
+	}
+	if t.KeepaliveInterval > 0 && (t.KeepaliveRetry < 1 || t.KeepaliveRetry > 50) {
+		return fmt.Errorf("keepalive retry must be between 1 and 50, got %d", t.KeepaliveRetry)
+	}
+
+	return nil
+}
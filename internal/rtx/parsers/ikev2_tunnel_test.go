@@ -0,0 +1,195 @@
+package parsers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseIKEv2Tunnels(t *testing.T) {
+	raw := `tunnel select 1
+ ipsec tunnel 1
+  ipsec sa policy 1 1 esp aes-cbc-256 sha256-hmac
+  ipsec ike version 1 2
+  ipsec ike local address 1 192.168.1.1
+  ipsec ike remote address 1 vpn.example.com
+  ipsec ike local name 1 rtx.example.com fqdn
+  ipsec ike remote name 1 peer.example.com fqdn
+  ipsec ike local auth method 1 certificate
+  ipsec ike remote auth method 1 psk
+  ipsec ike pre-shared-key 1 text secret
+  ipsec ike pki file 1 certificate=2
+  ipsec ike encryption 1 aes-cbc-256,aes-cbc
+  ipsec ike hash 1 sha256
+  ipsec ike group 1 modp2048,modp1024
+  ipsec ike duration ike-sa 1 3600
+  ipsec ike keepalive use 1 on rfc4306 10 3
+  ipsec ike nat-traversal 1 on
+ tunnel enable 1
+tunnel select 2
+ ipsec tunnel 2
+  ipsec sa policy 2 2 esp aes-cbc sha-hmac
+  ipsec ike remote address 2 192.168.2.1
+  ipsec ike pre-shared-key 2 text legacy
+ tunnel enable 2
+tunnel select 3
+ ipsec tunnel 3
+  ipsec sa policy 3 3 esp aes-gcm-256
+  ipsec ike version 3 2
+  ipsec ike remote address 3 any
+  ipsec ike local auth method 3 eap-md5
+  ipsec ike remote auth method 3 certificate
+  ipsec ike eap myname 3 user1 pass1
+tunnel select none`
+
+	want := []IKEv2Tunnel{
+		{
+			ID:                1,
+			LocalAddress:      "192.168.1.1",
+			RemoteAddress:     "vpn.example.com",
+			LocalName:         "rtx.example.com",
+			LocalNameType:     "fqdn",
+			RemoteName:        "peer.example.com",
+			RemoteNameType:    "fqdn",
+			LocalAuthMethod:   "certificate",
+			RemoteAuthMethod:  "psk",
+			PreSharedKey:      "secret",
+			CertificateID:     2,
+			Encryption:        []string{"aes-cbc-256", "aes-cbc"},
+			Integrity:         []string{"sha256"},
+			Groups:            []string{"modp2048", "modp1024"},
+			ESPEncryption:     "aes-cbc-256",
+			ESPIntegrity:      "sha256-hmac",
+			IKESALifetime:     3600,
+			ChildSALifetime:   DefaultIKEv2ChildSALifetime,
+			KeepaliveInterval: 10,
+			KeepaliveRetry:    3,
+			NATTraversal:      true,
+			Enabled:           true,
+		},
+		{
+			ID:               3,
+			RemoteAddress:    "any",
+			LocalAuthMethod:  "eap-md5",
+			RemoteAuthMethod: "certificate",
+			EAPUsername:      "user1",
+			EAPPassword:      "pass1",
+			ESPEncryption:    "aes-gcm-256",
+			IKESALifetime:    DefaultIKEv2IKESALifetime,
+			ChildSALifetime:  DefaultIKEv2ChildSALifetime,
+		},
+	}
+
+	if got := ParseIKEv2Tunnels(raw); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseIKEv2Tunnels() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestBuildIKEv2TunnelCommands(t *testing.T) {
+	tunnel := IKEv2Tunnel{
+		ID:               1,
+		RemoteAddress:    "vpn.example.com",
+		LocalName:        "rtx.example.com",
+		LocalNameType:    "fqdn",
+		LocalAuthMethod:  "certificate",
+		RemoteAuthMethod: "certificate",
+		CertificateID:    2,
+		Encryption:       []string{"aes-cbc-256"},
+		Groups:           []string{"modp2048"},
+		ESPEncryption:    "aes-gcm-256",
+		IKESALifetime:    28800,
+		ChildSALifetime:  3600,
+		Enabled:          true,
+	}
+
+	want := []string{
+		"tunnel select 1",
+		"ipsec tunnel 1",
+		"ipsec sa policy 1 1 esp aes-gcm-256",
+		"ipsec ike version 1 2",
+		"ipsec ike remote address 1 vpn.example.com",
+		"ipsec ike local name 1 rtx.example.com fqdn",
+		"ipsec ike local auth method 1 certificate",
+		"ipsec ike remote auth method 1 certificate",
+		"ipsec ike pki file 1 certificate=2",
+		"ipsec ike encryption 1 aes-cbc-256",
+		"ipsec ike group 1 modp2048",
+		"ipsec ike duration ike-sa 1 28800",
+		"ipsec ike duration child-sa 1 3600",
+		"ipsec ike nat-traversal 1 off",
+		"ipsec ike keepalive use 1 off",
+		"tunnel enable 1",
+	}
+	if got := BuildIKEv2TunnelCommands(tunnel); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildIKEv2TunnelCommands() =\n%v\nwant\n%v", got, want)
+	}
+
+	parsed := ParseIKEv2Tunnels(strings.Join(BuildIKEv2TunnelCommands(tunnel), "\n"))
+	if len(parsed) != 1 || !reflect.DeepEqual(parsed[0], tunnel) {
+		t.Errorf("round trip = %+v, want %+v", parsed, tunnel)
+	}
+}
+
+func TestBuildIKEv2TunnelCleanupCommands(t *testing.T) {
+	current := IKEv2Tunnel{
+		ID:            1,
+		LocalAddress:  "192.168.1.1",
+		PreSharedKey:  "secret",
+		EAPUsername:   "user1",
+		Encryption:    []string{"aes-cbc"},
+		Groups:        []string{"modp2048"},
+		CertificateID: 2,
+	}
+	desired := IKEv2Tunnel{ID: 1, CertificateID: 3, Groups: []string{"modp1024"}}
+
+	want := []string{
+		"no ipsec ike local address 1",
+		"no ipsec ike pre-shared-key 1",
+		"no ipsec ike eap myname 1",
+		"no ipsec ike encryption 1",
+	}
+	if got := BuildIKEv2TunnelCleanupCommands(current, desired); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildIKEv2TunnelCleanupCommands() = %v, want %v", got, want)
+	}
+}
+
+func TestValidateIKEv2Tunnel(t *testing.T) {
+	valid := IKEv2Tunnel{
+		ID:               1,
+		RemoteAddress:    "192.168.2.1",
+		LocalAuthMethod:  "psk",
+		RemoteAuthMethod: "psk",
+		PreSharedKey:     "secret",
+		IKESALifetime:    28800,
+		ChildSALifetime:  28800,
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(*IKEv2Tunnel)
+		wantErr bool
+	}{
+		{name: "valid psk", modify: func(*IKEv2Tunnel) {}},
+		{name: "missing psk", modify: func(t *IKEv2Tunnel) { t.PreSharedKey = "" }, wantErr: true},
+		{name: "certificate without id", modify: func(t *IKEv2Tunnel) { t.LocalAuthMethod = "certificate" }, wantErr: true},
+		{name: "certificate with id", modify: func(t *IKEv2Tunnel) { t.LocalAuthMethod = "certificate"; t.CertificateID = 1 }},
+		{name: "eap without password", modify: func(t *IKEv2Tunnel) { t.LocalAuthMethod = "eap-md5"; t.EAPUsername = "u" }, wantErr: true},
+		{name: "invalid auth method", modify: func(t *IKEv2Tunnel) { t.RemoteAuthMethod = "rsa" }, wantErr: true},
+		{name: "invalid encryption", modify: func(t *IKEv2Tunnel) { t.Encryption = []string{"des"} }, wantErr: true},
+		{name: "invalid group", modify: func(t *IKEv2Tunnel) { t.Groups = []string{"modp768"} }, wantErr: true},
+		{name: "invalid name type", modify: func(t *IKEv2Tunnel) { t.LocalName = "x"; t.LocalNameType = "dn" }, wantErr: true},
+		{name: "missing remote address", modify: func(t *IKEv2Tunnel) { t.RemoteAddress = "" }, wantErr: true},
+		{name: "lifetime too short", modify: func(t *IKEv2Tunnel) { t.ChildSALifetime = 10 }, wantErr: true},
+		{name: "keepalive without retry", modify: func(t *IKEv2Tunnel) { t.KeepaliveInterval = 10 }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tunnel := valid
+			tt.modify(&tunnel)
+			if err := ValidateIKEv2Tunnel(tunnel); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateIKEv2Tunnel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// InterfaceCounters represents the traffic counters of a LAN interface as
+// reported by "show status lanN"
+type InterfaceCounters struct {
+	Name           string `json:"name"`             // Interface name (lan1, lan2, ...)
+	TxPackets      int64  `json:"tx_packets"`       // Transmitted packets
+	TxOctets       int64  `json:"tx_octets"`        // Transmitted octets
+	RxPackets      int64  `json:"rx_packets"`       // Received packets
+	RxOctets       int64  `json:"rx_octets"`        // Received octets
+	RxOverruns     int64  `json:"rx_overruns"`      // Packets dropped by receive overrun
+	RxBufferErrors int64  `json:"rx_buffer_errors"` // Packets dropped for lack of receive buffers
+}
+
+var (
+	interfaceCountersTxPattern      = regexp.MustCompile(`(?i)^\s*(?:transmitted(?:\s+packets?)?|送信パケット)\s*:\s*(\d+)\s*(?:packets?|パケット)\s*\(\s*(\d+)\s*(?:octets?|オクテット)\s*\)`)
+	interfaceCountersRxPattern      = regexp.MustCompile(`(?i)^\s*(?:received(?:\s+packets?)?|受信パケット)\s*:\s*(\d+)\s*(?:packets?|パケット)\s*\(\s*(\d+)\s*(?:octets?|オクテット)\s*\)`)
+	interfaceCountersOverrunPattern = regexp.MustCompile(`(?i)^\s*(?:received\s+overrun|受信オーバーラン)\s*:\s*(\d+)`)
+	interfaceCountersBufferPattern  = regexp.MustCompile(`(?i)^\s*(?:received\s+buffer\s+error|受信バッファエラー)\s*:\s*(\d+)`)
+)
+
+// ParseInterfaceCounters parses the packet, octet and error counters of "show status lanN"
+func ParseInterfaceCounters(name, raw string) (*InterfaceCounters, error) {
+	counters := &InterfaceCounters{Name: name}
+	found := false
+
+	for _, line := range strings.Split(raw, "\n") {
+		if matches := interfaceStatusNamePattern.FindStringSubmatch(line); len(matches) == 2 {
+			found = strings.EqualFold(matches[1], name)
+			continue
+		}
+		if !found {
+			continue
+		}
+		if matches := interfaceCountersTxPattern.FindStringSubmatch(line); len(matches) == 3 {
+			counters.TxPackets, _ = strconv.ParseInt(matches[1], 10, 64)
+			counters.TxOctets, _ = strconv.ParseInt(matches[2], 10, 64)
+			continue
+		}
+		if matches := interfaceCountersRxPattern.FindStringSubmatch(line); len(matches) == 3 {
+			counters.RxPackets, _ = strconv.ParseInt(matches[1], 10, 64)
+			counters.RxOctets, _ = strconv.ParseInt(matches[2], 10, 64)
+			continue
+		}
+		if matches := interfaceCountersOverrunPattern.FindStringSubmatch(line); len(matches) == 2 {
+			counters.RxOverruns, _ = strconv.ParseInt(matches[1], 10, 64)
+			continue
+		}
+		if matches := interfaceCountersBufferPattern.FindStringSubmatch(line); len(matches) == 2 {
+			counters.RxBufferErrors, _ = strconv.ParseInt(matches[1], 10, 64)
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("status of %s not found", name)
+	}
+	return counters, nil
+}
@@ -0,0 +1,80 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseInterfaceCounters(t *testing.T) {
+	tests := []struct {
+		name    string
+		iface   string
+		raw     string
+		want    *InterfaceCounters
+		wantErr bool
+	}{
+		{
+			name:  "English output",
+			iface: "lan1",
+			raw: `LAN1
+Description:
+IP Address:                     192.168.100.1/24
+Ethernet address:               00:a0:de:01:02:03
+Operation mode setting:         Auto Negotiation (1000BASE-T Full Duplex)
+Maximum Transmission Unit(MTU): 1500 octets
+Promiscuous mode:               OFF
+Transmitted:                    2305434 packets (1170577446 octets)
+  IPv4(all/fastpath):           2302342 packets / 2295883 packets
+Received:                       3052934 packets (2953183937 octets)
+  IPv4(all/fastpath):           3049212 packets / 3040010 packets
+Received overrun:               2 packets
+Received buffer error:          5 packets
+`,
+			want: &InterfaceCounters{
+				Name:           "lan1",
+				TxPackets:      2305434,
+				TxOctets:       1170577446,
+				RxPackets:      3052934,
+				RxOctets:       2953183937,
+				RxOverruns:     2,
+				RxBufferErrors: 5,
+			},
+		},
+		{
+			name:  "Japanese output",
+			iface: "lan2",
+			raw: `LAN2
+説明:
+IPアドレス:
+イーサネットアドレス:           00:a0:de:01:02:04
+送信パケット:                   10 パケット(1280 オクテット)
+受信パケット:                   0 パケット(0 オクテット)
+受信オーバーラン:               0 パケット
+受信バッファエラー:             0 パケット
+`,
+			want: &InterfaceCounters{
+				Name:      "lan2",
+				TxPackets: 10,
+				TxOctets:  1280,
+			},
+		},
+		{
+			name:    "interface not found",
+			iface:   "lan3",
+			raw:     "Error: Invalid interface name\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInterfaceCounters(tt.iface, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseInterfaceCounters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseInterfaceCounters() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
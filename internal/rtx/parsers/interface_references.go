@@ -0,0 +1,109 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// InterfaceReference is a configuration line that binds filters or NAT
+// descriptors to an interface
+type InterfaceReference struct {
+	Interface string // Interface name (e.g., "lan1", "tunnel1", "pp1")
+	Direction string // "in" or "out" for secure filters, empty for NAT descriptors
+	Line      string // The configuration line as shown in the config
+}
+
+var (
+	// secureFilterLinePattern matches "ip <interface> secure filter in|out <list> [dynamic <list>]"
+	secureFilterLinePattern = regexp.MustCompile(`^ip (\S+) secure filter (in|out)\s+(.*)$`)
+	// natDescriptorLinePattern matches "ip <interface> nat descriptor <list> [reverse <list>]"
+	natDescriptorLinePattern = regexp.MustCompile(`^ip (\S+) nat descriptor\s+(.*)$`)
+)
+
+// FindSecureFilterReferences returns the secure filter bindings that use any
+// of numbers, as static filters or, when dynamic is true, as dynamic filters
+func (pc *ParsedConfig) FindSecureFilterReferences(numbers []int, dynamic bool) []InterfaceReference {
+	wanted := intSet(numbers)
+
+	var refs []InterfaceReference
+	for _, cmd := range pc.Commands {
+		matches := secureFilterLinePattern.FindStringSubmatch(cmd.Line)
+		if matches == nil {
+			continue
+		}
+		static, dynamicList, _ := strings.Cut(matches[3], "dynamic")
+		list := static
+		if dynamic {
+			list = dynamicList
+		}
+		if listContainsAny(list, wanted) {
+			refs = append(refs, InterfaceReference{
+				Interface: referenceInterface(matches[1], cmd.Context),
+				Direction: matches[2],
+				Line:      cmd.Line,
+			})
+		}
+	}
+	return refs
+}
+
+// FindNATDescriptorReferences returns the interface bindings that use NAT
+// descriptor id
+func (pc *ParsedConfig) FindNATDescriptorReferences(id int) []InterfaceReference {
+	wanted := intSet([]int{id})
+
+	var refs []InterfaceReference
+	for _, cmd := range pc.Commands {
+		matches := natDescriptorLinePattern.FindStringSubmatch(cmd.Line)
+		if matches == nil || !listContainsAny(matches[2], wanted) {
+			continue
+		}
+		refs = append(refs, InterfaceReference{
+			Interface: referenceInterface(matches[1], cmd.Context),
+			Line:      cmd.Line,
+		})
+	}
+	return refs
+}
+
+// String describes the reference for error messages, naming the tunnel or
+// pp of lines inside "tunnel select" and "pp select"
+func (r InterfaceReference) String() string {
+	if strings.HasPrefix(r.Line, "ip "+r.Interface+" ") {
+		return r.Line
+	}
+	return fmt.Sprintf("%s (%s)", r.Line, r.Interface)
+}
+
+// referenceInterface returns the interface name of a binding, resolving
+// "ip tunnel" and "ip pp" to the selected tunnel or pp
+func referenceInterface(name string, ctx *ParseContext) string {
+	if ctx == nil || (name != "tunnel" && name != "pp") {
+		return name
+	}
+	if ctx.Name != "" {
+		return name + " " + ctx.Name
+	}
+	return name + strconv.Itoa(ctx.ID)
+}
+
+func intSet(numbers []int) map[int]bool {
+	set := make(map[int]bool, len(numbers))
+	for _, n := range numbers {
+		set[n] = true
+	}
+	return set
+}
+
+// listContainsAny reports whether a space-separated list has a number in set.
+// Keywords such as "reverse" are skipped.
+func listContainsAny(list string, set map[int]bool) bool {
+	for _, field := range strings.Fields(list) {
+		if n, err := strconv.Atoi(field); err == nil && set[n] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+const interfaceReferencesConfig = `ip lan1 secure filter in 200 201 dynamic 300
+ip lan2 secure filter out 201 dynamic 301 302
+ip lan2 nat descriptor 1000 1001
+tunnel select 1
+ ip tunnel secure filter in 201
+ ip tunnel nat descriptor 1001
+pp select 1
+ ip pp nat descriptor 2000 reverse 1000
+`
+
+func TestFindSecureFilterReferences(t *testing.T) {
+	pc, err := NewConfigFileParser().Parse(interfaceReferencesConfig)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		numbers []int
+		dynamic bool
+		want    []string
+	}{
+		{name: "static on one interface", numbers: []int{200}, want: []string{"lan1 in"}},
+		{name: "static on several interfaces", numbers: []int{201}, want: []string{"lan1 in", "lan2 out", "tunnel1 in"}},
+		{name: "dynamic", numbers: []int{302}, dynamic: true, want: []string{"lan2 out"}},
+		{name: "dynamic number is not a static filter", numbers: []int{300}},
+		{name: "unused", numbers: []int{999}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, ref := range pc.FindSecureFilterReferences(tt.numbers, tt.dynamic) {
+				got = append(got, ref.Interface+" "+ref.Direction)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindSecureFilterReferences(%v, %v) = %v, want %v", tt.numbers, tt.dynamic, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindNATDescriptorReferences(t *testing.T) {
+	pc, err := NewConfigFileParser().Parse(interfaceReferencesConfig)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	var got []string
+	for _, ref := range pc.FindNATDescriptorReferences(1000) {
+		got = append(got, ref.String())
+	}
+	want := []string{"ip lan2 nat descriptor 1000 1001", "ip pp nat descriptor 2000 reverse 1000 (pp1)"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindNATDescriptorReferences(1000) = %v, want %v", got, want)
+	}
+
+	if refs := pc.FindNATDescriptorReferences(100); len(refs) != 0 {
+		t.Errorf("FindNATDescriptorReferences(100) = %v, want none", refs)
+	}
+}
@@ -0,0 +1,100 @@
+package parsers
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// InterfaceStatus represents the runtime status of a LAN interface as reported
+// by "show status lanN"
+type InterfaceStatus struct {
+	Name        string   `json:"name"`                  // Interface name (lan1, lan2, ...)
+	Description string   `json:"description,omitempty"` // Interface description
+	MACAddress  string   `json:"mac_address,omitempty"` // Ethernet address
+	LinkUp      bool     `json:"link_up"`               // True if at least one port has link
+	Speed       string   `json:"speed,omitempty"`       // Negotiated speed (e.g., 1000BASE-T)
+	Duplex      string   `json:"duplex,omitempty"`      // full or half
+	IPAddresses []string `json:"ip_addresses"`          // IPv4 addresses in CIDR notation
+	MTU         int      `json:"mtu,omitempty"`         // Maximum transmission unit
+}
+
+var (
+	interfaceStatusNamePattern = regexp.MustCompile(`(?i)^\s*(lan\d+)\s*$`)
+	interfaceStatusDescPattern = regexp.MustCompile(`(?i)^\s*(?:description|説明)\s*:\s*(.*?)\s*$`)
+	interfaceStatusIPPattern   = regexp.MustCompile(`(?i)^\s*(?:ip\s*address|ipアドレス)\s*:\s*(.*?)\s*$`)
+	interfaceStatusMACPattern  = regexp.MustCompile(`(?i)^\s*(?:ethernet\s+address|イーサネットアドレス)\s*:\s*([0-9a-f]{2}(?::[0-9a-f]{2}){5})\s*$`)
+	interfaceStatusMTUPattern  = regexp.MustCompile(`(?i)^\s*(?:maximum\s+transmission\s+unit\s*\(mtu\)|最大パケット長\s*\(mtu\))\s*:\s*(\d+)`)
+	interfaceStatusLinkPattern = regexp.MustCompile(`(?i)\((\d+BASE-\S+)\s+(full|half)\s+duplex\)|\((link\s+down)\)`)
+	lanStatusNamePattern       = regexp.MustCompile(`^lan\d+$`)
+)
+
+// ParseInterfaceStatus parses the output of "show status lanN".
+// Switching hub models report one line per port; the interface is up when
+// any port has link, and the speed of the first linked port is reported.
+func ParseInterfaceStatus(name, raw string) (*InterfaceStatus, error) {
+	status := &InterfaceStatus{Name: name, IPAddresses: []string{}}
+	found := false
+
+	for _, line := range strings.Split(raw, "\n") {
+		if matches := interfaceStatusNamePattern.FindStringSubmatch(line); len(matches) == 2 {
+			found = strings.EqualFold(matches[1], name)
+			continue
+		}
+		if matches := interfaceStatusDescPattern.FindStringSubmatch(line); len(matches) == 2 {
+			status.Description = matches[1]
+			continue
+		}
+		if matches := interfaceStatusIPPattern.FindStringSubmatch(line); len(matches) == 2 {
+			status.IPAddresses = append(status.IPAddresses, parseInterfaceStatusAddresses(matches[1])...)
+			continue
+		}
+		if matches := interfaceStatusMACPattern.FindStringSubmatch(line); len(matches) == 2 {
+			status.MACAddress = strings.ToLower(matches[1])
+			continue
+		}
+		if matches := interfaceStatusMTUPattern.FindStringSubmatch(line); len(matches) == 2 {
+			status.MTU, _ = strconv.Atoi(matches[1])
+			continue
+		}
+		for _, matches := range interfaceStatusLinkPattern.FindAllStringSubmatch(line, -1) {
+			if matches[3] != "" || status.LinkUp {
+				continue
+			}
+			status.LinkUp = true
+			status.Speed = strings.ToUpper(matches[1])
+			status.Duplex = strings.ToLower(matches[2])
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("status of %s not found", name)
+	}
+	return status, nil
+}
+
+// parseInterfaceStatusAddresses extracts the IPv4 addresses of an "IP Address" line
+func parseInterfaceStatusAddresses(s string) []string {
+	var addresses []string
+	for _, field := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if ip, _, err := net.ParseCIDR(field); err == nil && ip.To4() != nil {
+			addresses = append(addresses, field)
+		}
+	}
+	return addresses
+}
+
+// BuildShowInterfaceStatusCommand builds the command to show the status of a LAN interface
+func BuildShowInterfaceStatusCommand(name string) string {
+	return fmt.Sprintf("show status %s", name)
+}
+
+// ValidateInterfaceStatusName validates the name of a LAN interface whose status is read
+func ValidateInterfaceStatusName(name string) error {
+	if !lanStatusNamePattern.MatchString(name) {
+		return fmt.Errorf("interface must be a LAN interface (e.g., lan1), got %q", name)
+	}
+	return nil
+}
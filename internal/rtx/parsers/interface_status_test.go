@@ -0,0 +1,104 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseInterfaceStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		iface   string
+		raw     string
+		want    *InterfaceStatus
+		wantErr bool
+	}{
+		{
+			name:  "single port link up",
+			iface: "lan2",
+			raw: `LAN2
+Description:                    WAN uplink
+IP Address:                     203.0.113.2/30
+Ethernet address:               00:A0:DE:01:02:03
+Operation mode setting:         Auto Negotiation (1000BASE-T Full Duplex)
+Maximum Transmission Unit(MTU): 1500 octets
+Promiscuous mode:               OFF
+Transmitted:                    1024 packets (98304 octets)`,
+			want: &InterfaceStatus{
+				Name:        "lan2",
+				Description: "WAN uplink",
+				MACAddress:  "00:a0:de:01:02:03",
+				LinkUp:      true,
+				Speed:       "1000BASE-T",
+				Duplex:      "full",
+				IPAddresses: []string{"203.0.113.2/30"},
+				MTU:         1500,
+			},
+		},
+		{
+			name:  "switching hub ports",
+			iface: "lan1",
+			raw: `LAN1
+Description:
+IP Address:                     192.168.100.1/24, 192.168.101.1/24
+Ethernet address:               00:a0:de:01:02:04
+Operation mode setting:         Type (Link status)
+                    PORT1:      Auto Negotiation (Link Down)
+                    PORT2:      Auto Negotiation (100BASE-TX Half Duplex)
+                    PORT3:      Auto Negotiation (1000BASE-T Full Duplex)
+Maximum Transmission Unit(MTU): 1500 octets`,
+			want: &InterfaceStatus{
+				Name:        "lan1",
+				MACAddress:  "00:a0:de:01:02:04",
+				LinkUp:      true,
+				Speed:       "100BASE-TX",
+				Duplex:      "half",
+				IPAddresses: []string{"192.168.100.1/24", "192.168.101.1/24"},
+				MTU:         1500,
+			},
+		},
+		{
+			name:  "link down without address",
+			iface: "lan3",
+			raw: `LAN3
+Description:
+IP Address:
+Ethernet address:               00:a0:de:01:02:05
+Operation mode setting:         Auto Negotiation (Link Down)
+Maximum Transmission Unit(MTU): 1500 octets`,
+			want: &InterfaceStatus{
+				Name:        "lan3",
+				MACAddress:  "00:a0:de:01:02:05",
+				IPAddresses: []string{},
+				MTU:         1500,
+			},
+		},
+		{
+			name:    "unknown interface",
+			iface:   "lan9",
+			raw:     "Error: Invalid interface name",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInterfaceStatus(tt.iface, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseInterfaceStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseInterfaceStatus() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateInterfaceStatusName(t *testing.T) {
+	if err := ValidateInterfaceStatusName("lan1"); err != nil {
+		t.Errorf("ValidateInterfaceStatusName(lan1) error = %v", err)
+	}
+	if err := ValidateInterfaceStatusName("pp1"); err == nil {
+		t.Error("ValidateInterfaceStatusName(pp1) expected error")
+	}
+}
@@ -1,8 +1,11 @@
 package parsers
 
 import (
+	"errors"
 	"fmt"
+	"net"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -19,6 +22,12 @@ type IPFilter struct {
 	SourcePort    string `json:"source_port,omitempty"` // Source port(s) or "*"
 	DestPort      string `json:"dest_port,omitempty"`   // Destination port(s) or "*"
 	Established   bool   `json:"established,omitempty"` // Match established TCP connections
+	ICMPType      *int   `json:"icmp_type,omitempty"`   // ICMP type (icmp only, in place of the source port)
+	ICMPCode      *int   `json:"icmp_code,omitempty"`   // ICMP code (icmp only, in place of the destination port)
+	Fragment      bool   `json:"fragment,omitempty"`    // Match IP fragments only
+	Length        string `json:"length,omitempty"`      // Packet length or range (e.g., "100-200")
+	TOS           string `json:"tos,omitempty"`         // TOS byte to match (e.g., "0x10")
+	DSCP          string `json:"dscp,omitempty"`        // DSCP value to match (e.g., "46" or "ef")
 }
 
 // IPFilterDynamic represents a dynamic (stateful) IP filter on an RTX router
@@ -45,7 +54,11 @@ type IPFilterSet struct {
 var ValidIPFilterActions = []string{"pass", "pass-log", "pass-nolog", "reject", "reject-log", "reject-nolog", "restrict", "restrict-log", "restrict-nolog"}
 
 // ValidIPFilterProtocols defines the valid protocols for IP filters
-var ValidIPFilterProtocols = []string{"tcp", "udp", "icmp", "ip", "*", "gre", "esp", "ah", "icmp6", "tcpfin", "tcprst", "tcpsyn", "established"}
+var ValidIPFilterProtocols = []string{"tcp", "udp", "icmp", "ip", "*", "gre", "esp", "ah", "icmp6", "tcpfin", "tcprst", "tcpsyn", "established", "icmp-error", "icmp-info"}
+
+// tcpFlagProtocolPattern matches TCP flag matches in the protocol field, e.g.
+// "tcpflag=0x0002/0x0017" or "tcpflag!=0x0010"
+var tcpFlagProtocolPattern = regexp.MustCompile(`^tcpflag!?=0x[0-9a-f]{1,4}(?:/0x[0-9a-f]{1,4})?$`)
 
 // ValidDynamicProtocols defines the valid protocols for dynamic filters
 var ValidDynamicProtocols = []string{
@@ -57,17 +70,18 @@ var ValidDynamicProtocols = []string{
 	"rtsp", "h323", "pptp", "l2tp", "ike", "esp",
 }
 
-// ParseIPFilterConfig parses the output of "show config" command for IP filter lines
+// ParseIPFilterConfig parses the output of "show config" command for IP filter lines.
+// Filters with fields the provider does not know are left out and reported
+// in the returned error, together with the filters that could be parsed.
 func ParseIPFilterConfig(raw string) ([]IPFilter, error) {
 	filters := []IPFilter{}
+	var errs []error
 	lines := strings.Split(raw, "\n")
 
 	// Pattern for static IP filter:
-	// ip filter <n> <action> <src> <dst> <protocol> [<src_port>] [<dst_port>] [established]
-	// The pattern matches required fields and captures optional ones
-	filterPattern := regexp.MustCompile(`^\s*ip\s+filter\s+(\d+)\s+(\S+)\s+(\S+)\s+(\S+)\s+(\S+)(?:\s+(\S+))?(?:\s+(\S+))?(?:\s+(\S+))?\s*$`)
-	// Pattern to detect established keyword
-	establishedPattern := regexp.MustCompile(`\bestablished\b`)
+	// ip filter <n> <action> <src> <dst> <protocol> [<src_port> [<dst_port>]] [established] [fragment] [length=<len>] [tos=<tos>|dscp=<dscp>]
+	// The pattern matches the fixed fields and captures the rest of the line
+	filterPattern := regexp.MustCompile(`^\s*ip\s+filter\s+(\d+)\s+(\S+)\s+(\S+)\s+(\S+)\s+(\S+)(?:\s+(.+?))?\s*$`)
 
 	for _, line := range lines {
 		line = strings.TrimSpace(line)
@@ -94,26 +108,89 @@ func ParseIPFilterConfig(raw string) ([]IPFilter, error) {
 				DestAddress:   matches[4],
 				Protocol:      matches[5],
 			}
-
-			// Check for established keyword in the line first
-			hasEstablished := establishedPattern.MatchString(line)
-			if hasEstablished {
-				filter.Established = true
+			if err := parseIPFilterTail(&filter, strings.Fields(matches[6])); err != nil {
+				errs = append(errs, &IPFilterParseError{Number: number, Err: err})
+				continue
 			}
 
-			// Handle optional ports (skip "established" keyword)
-			if len(matches) > 6 && matches[6] != "" && matches[6] != "established" {
-				filter.SourcePort = matches[6]
-			}
-			if len(matches) > 7 && matches[7] != "" && matches[7] != "established" {
-				filter.DestPort = matches[7]
+			filters = append(filters, filter)
+		}
+	}
+
+	return filters, errors.Join(errs...)
+}
+
+// IPFilterParseError reports a filter whose fields could not be parsed
+type IPFilterParseError struct {
+	Number int
+	Err    error
+}
+
+func (e *IPFilterParseError) Error() string {
+	return fmt.Sprintf("ip filter %d: %v", e.Number, e.Err)
+}
+
+func (e *IPFilterParseError) Unwrap() error {
+	return e.Err
+}
+
+// parseIPFilterTail parses the fields after the protocol: up to two ports,
+// or for icmp the ICMP type and code, followed by the options. Options are
+// keywords or key=value pairs, which ports never are, so a field is told
+// apart by its form; ports that follow an option and unknown options are
+// rejected.
+func parseIPFilterTail(filter *IPFilter, fields []string) error {
+	var ports []string
+	optionSeen := false
+	for _, field := range fields {
+		key, value, hasValue := strings.Cut(field, "=")
+		switch {
+		case field == "established":
+			filter.Established = true
+		case field == "fragment":
+			filter.Fragment = true
+		case hasValue && key == "length":
+			filter.Length = value
+		case hasValue && key == "tos":
+			filter.TOS = value
+		case hasValue && key == "dscp":
+			filter.DSCP = value
+		case hasValue:
+			return fmt.Errorf("unsupported option %q", field)
+		case optionSeen || len(ports) == 2:
+			return fmt.Errorf("unexpected field %q after the ports", field)
+		default:
+			if err := ValidateIPFilterPort(field); err != nil {
+				return err
 			}
+			ports = append(ports, field)
+			continue
+		}
+		optionSeen = true
+	}
+	if err := ValidateIPFilterOptions(*filter); err != nil {
+		return err
+	}
 
-			filters = append(filters, filter)
+	if strings.EqualFold(filter.Protocol, "icmp") && len(ports) > 0 {
+		if icmpType, err := strconv.Atoi(ports[0]); err == nil {
+			filter.ICMPType = &icmpType
+			if len(ports) > 1 {
+				if icmpCode, err := strconv.Atoi(ports[1]); err == nil {
+					filter.ICMPCode = &icmpCode
+				}
+			}
+			return nil
 		}
 	}
 
-	return filters, nil
+	if len(ports) > 0 {
+		filter.SourcePort = ports[0]
+	}
+	if len(ports) > 1 {
+		filter.DestPort = ports[1]
+	}
+	return nil
 }
 
 // ParseIPFilterDynamicConfig parses the output of "show config" for dynamic IP filter lines
@@ -266,7 +343,9 @@ func ParseInterfaceSecureFilterWithDynamic(raw string) (map[string]map[string]In
 }
 
 // BuildIPFilterCommand builds the command to create an IP filter
-// Command format: ip filter <n> <action> <src> <dst> <protocol> [<src_port>] [<dst_port>]
+// Command format: ip filter <n> <action> <src> <dst> <protocol> [<src_port>] [<dst_port>] [established] [<options>]
+// For icmp: ip filter <n> <action> <src> <dst> icmp [<type> [<code>]] [<options>]
+// Options: [fragment] [length=<len>] [tos=<tos>|dscp=<dscp>]
 func BuildIPFilterCommand(filter IPFilter) string {
 	parts := []string{
 		"ip", "filter",
@@ -277,17 +356,34 @@ func BuildIPFilterCommand(filter IPFilter) string {
 		filter.Protocol,
 	}
 
+	// Options follow the ports, so the port fields cannot be omitted before them
+	options := ipFilterOptionFields(filter)
+	hasOptions := len(options) > 0
+
+	if filter.ICMPType != nil {
+		parts = append(parts, strconv.Itoa(*filter.ICMPType))
+		if filter.ICMPCode != nil {
+			parts = append(parts, strconv.Itoa(*filter.ICMPCode))
+		} else if hasOptions {
+			parts = append(parts, "*")
+		}
+		parts = append(parts, options...)
+		return strings.Join(parts, " ")
+	}
+
 	// Add source port if specified
 	if filter.SourcePort != "" {
-		parts = append(parts, filter.SourcePort)
-	} else if filter.DestPort != "" {
+		parts = append(parts, NormalizeIPFilterPort(filter.SourcePort))
+	} else if filter.DestPort != "" || hasOptions {
 		// If only dest port is specified, we need a placeholder for source port
 		parts = append(parts, "*")
 	}
 
 	// Add destination port if specified
 	if filter.DestPort != "" {
-		parts = append(parts, filter.DestPort)
+		parts = append(parts, NormalizeIPFilterPort(filter.DestPort))
+	} else if hasOptions {
+		parts = append(parts, "*")
 	}
 
 	// Add established keyword for TCP
@@ -295,9 +391,29 @@ func BuildIPFilterCommand(filter IPFilter) string {
 		parts = append(parts, "established")
 	}
 
+	parts = append(parts, options...)
 	return strings.Join(parts, " ")
 }
 
+// ipFilterOptionFields returns the option fields of a filter in the order the
+// router writes them
+func ipFilterOptionFields(filter IPFilter) []string {
+	var fields []string
+	if filter.Fragment {
+		fields = append(fields, "fragment")
+	}
+	if filter.Length != "" {
+		fields = append(fields, "length="+filter.Length)
+	}
+	if filter.TOS != "" {
+		fields = append(fields, "tos="+filter.TOS)
+	}
+	if filter.DSCP != "" {
+		fields = append(fields, "dscp="+strings.ToLower(filter.DSCP))
+	}
+	return fields
+}
+
 // BuildIPFilterDynamicCommand builds the command to create a dynamic IP filter
 // Command format: ip filter dynamic <n> <src> <dst> <protocol> [syslog=on]
 func BuildIPFilterDynamicCommand(filter IPFilterDynamic) string {
@@ -397,6 +513,43 @@ func BuildDeleteInterfaceSecureFilterCommand(iface string, direction string) str
 	return withSelectContext(selectCmd, fmt.Sprintf("no ip %s secure filter %s", applyIface, direction))
 }
 
+// InsertFilterNumbers returns the secure filter list with numbers placed
+// immediately before the filter before, keeping every other filter where it
+// is. Numbers already in the list are moved rather than repeated. When before
+// is not in the list, numbers are appended.
+func InsertFilterNumbers(list, numbers []int, before int) []int {
+	rest := RemoveFilterNumbers(list, numbers)
+	result := make([]int, 0, len(rest)+len(numbers))
+	inserted := false
+	for _, num := range rest {
+		if num == before && !inserted {
+			result = append(result, numbers...)
+			inserted = true
+		}
+		result = append(result, num)
+	}
+	if !inserted {
+		result = append(result, numbers...)
+	}
+	return result
+}
+
+// RemoveFilterNumbers returns the secure filter list without numbers, keeping
+// the order of the remaining filters
+func RemoveFilterNumbers(list, numbers []int) []int {
+	remove := make(map[int]bool, len(numbers))
+	for _, num := range numbers {
+		remove[num] = true
+	}
+	result := make([]int, 0, len(list))
+	for _, num := range list {
+		if !remove[num] {
+			result = append(result, num)
+		}
+	}
+	return result
+}
+
 // BuildShowIPFilterCommand builds the command to show IP filter configuration
 // Command format: show config | grep "ip filter"
 func BuildShowIPFilterCommand() string {
@@ -436,13 +589,20 @@ func ValidateIPFilterProtocol(proto string) error {
 		for _, validProto := range ValidIPFilterProtocols {
 			if p == validProto {
 				valid = true
-				validCount++
 				break
 			}
 		}
+		// Protocols are also given by number or as TCP flag matches
+		if n, err := strconv.Atoi(p); err == nil && n >= 0 && n <= 255 {
+			valid = true
+		}
+		if tcpFlagProtocolPattern.MatchString(p) {
+			valid = true
+		}
 		if !valid {
-			return fmt.Errorf("invalid protocol: %s, must be one of: %s", p, strings.Join(ValidIPFilterProtocols, ", "))
+			return fmt.Errorf("invalid protocol: %s, must be one of: %s, a protocol number (0-255) or tcpflag=<flags>/<mask>", p, strings.Join(ValidIPFilterProtocols, ", "))
 		}
+		validCount++
 	}
 
 	// Ensure at least one valid protocol was found
@@ -486,6 +646,18 @@ func ValidateIPFilter(filter IPFilter) error {
 		return err
 	}
 
+	if err := validateIPFilterPorts(filter); err != nil {
+		return err
+	}
+
+	if err := validateIPFilterICMP(filter); err != nil {
+		return err
+	}
+
+	if err := ValidateIPFilterOptions(filter); err != nil {
+		return err
+	}
+
 	// Validate established is only used with TCP
 	if filter.Established && strings.ToLower(filter.Protocol) != "tcp" {
 		return fmt.Errorf("established keyword can only be used with TCP protocol")
@@ -537,6 +709,168 @@ func ValidateIPFilterDirection(direction string) error {
 	return nil
 }
 
+// ValidateIPFilterPort validates the port field of an IP filter: "*", a port
+// number, a range such as "1024-65535" or "6000-", a service keyword such as
+// "www", or a comma-separated list of them.
+func ValidateIPFilterPort(port string) error {
+	if port == "" {
+		return fmt.Errorf("port must not be empty")
+	}
+	for _, part := range strings.Split(port, ",") {
+		part = strings.TrimSpace(part)
+		if part == "*" {
+			continue
+		}
+		if _, ok := FilterServicePorts[strings.ToLower(part)]; ok {
+			continue
+		}
+		bounds := strings.SplitN(part, "-", 2)
+		if part == "-" {
+			return fmt.Errorf("invalid port %q in %q: a range needs a start or an end", part, port)
+		}
+		for i, bound := range bounds {
+			if bound == "" && len(bounds) == 2 {
+				continue
+			}
+			n, err := strconv.Atoi(bound)
+			if err != nil || n < 0 || n > 65535 {
+				return fmt.Errorf("invalid port %q in %q: must be a port number 0-65535, a range, a service keyword or *", part, port)
+			}
+			if i == 1 && bounds[0] != "" {
+				if start, _ := strconv.Atoi(bounds[0]); start > n {
+					return fmt.Errorf("invalid port range %q: start is greater than end", part)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// NormalizeIPFilterPort returns a port field as the router writes it, with
+// the spaces around list separators removed ("www, smtp" becomes "www,smtp")
+// and service keywords in lower case.
+func NormalizeIPFilterPort(port string) string {
+	parts := strings.Split(port, ",")
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if _, ok := FilterServicePorts[strings.ToLower(part)]; ok {
+			part = strings.ToLower(part)
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, ",")
+}
+
+// IsIPFilterPortRange reports whether a port field is a single range such as
+// "1024-65535" or "6000-", as opposed to a port, a keyword or a list.
+func IsIPFilterPortRange(port string) bool {
+	port = strings.TrimSpace(port)
+	return strings.Contains(port, "-") && !strings.Contains(port, ",")
+}
+
+// validateIPFilterPorts validates the source and destination port fields of
+// a filter, if set
+func validateIPFilterPorts(filter IPFilter) error {
+	if filter.SourcePort != "" {
+		if err := ValidateIPFilterPort(filter.SourcePort); err != nil {
+			return fmt.Errorf("source port: %w", err)
+		}
+	}
+	if filter.DestPort != "" {
+		if err := ValidateIPFilterPort(filter.DestPort); err != nil {
+			return fmt.Errorf("destination port: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateIPFilterICMP validates the ICMP type and code of a filter, which
+// replace its ports and are only allowed for icmp
+func validateIPFilterICMP(filter IPFilter) error {
+	if filter.ICMPType == nil {
+		if filter.ICMPCode != nil {
+			return fmt.Errorf("icmp code requires an icmp type")
+		}
+		return nil
+	}
+	if !strings.EqualFold(filter.Protocol, "icmp") {
+		return fmt.Errorf("icmp type can only be used with icmp protocol, got protocol %s", filter.Protocol)
+	}
+	if *filter.ICMPType < 0 || *filter.ICMPType > 255 {
+		return fmt.Errorf("icmp type must be between 0 and 255, got %d", *filter.ICMPType)
+	}
+	if filter.ICMPCode != nil && (*filter.ICMPCode < 0 || *filter.ICMPCode > 255) {
+		return fmt.Errorf("icmp code must be between 0 and 255, got %d", *filter.ICMPCode)
+	}
+	if (filter.SourcePort != "" && filter.SourcePort != "*") || (filter.DestPort != "" && filter.DestPort != "*") {
+		return fmt.Errorf("ports cannot be combined with an icmp type")
+	}
+	return nil
+}
+
+// ipFilterDSCPNames are the per-hop behaviour names accepted for dscp= in
+// place of a number
+var ipFilterDSCPNames = []string{
+	"default", "ef",
+	"af11", "af12", "af13", "af21", "af22", "af23",
+	"af31", "af32", "af33", "af41", "af42", "af43",
+	"cs0", "cs1", "cs2", "cs3", "cs4", "cs5", "cs6", "cs7",
+}
+
+// ValidateIPFilterOptions validates the packet length, TOS and DSCP matches
+// of a filter, if set
+func ValidateIPFilterOptions(filter IPFilter) error {
+	if filter.Length != "" {
+		if err := validateIPFilterLength(filter.Length); err != nil {
+			return err
+		}
+	}
+	if filter.TOS != "" && filter.DSCP != "" {
+		return fmt.Errorf("tos and dscp cannot both be matched")
+	}
+	if filter.TOS != "" {
+		n, err := strconv.ParseInt(filter.TOS, 0, 0)
+		if err != nil || n < 0 || n > 255 {
+			return fmt.Errorf("invalid tos %q: must be 0-255 or 0x00-0xff", filter.TOS)
+		}
+	}
+	if filter.DSCP != "" {
+		dscp := strings.ToLower(filter.DSCP)
+		if n, err := strconv.Atoi(dscp); err == nil {
+			if n < 0 || n > 63 {
+				return fmt.Errorf("invalid dscp %q: must be 0-63", filter.DSCP)
+			}
+		} else if !slices.Contains(ipFilterDSCPNames, dscp) {
+			return fmt.Errorf("invalid dscp %q: must be 0-63 or one of: %s", filter.DSCP, strings.Join(ipFilterDSCPNames, ", "))
+		}
+	}
+	return nil
+}
+
+// validateIPFilterLength validates a packet length match: a length such as
+// "1500" or a range such as "100-200", "1400-" or "-64"
+func validateIPFilterLength(length string) error {
+	bounds := strings.SplitN(length, "-", 2)
+	if length == "-" {
+		return fmt.Errorf("invalid length %q: a range needs a start or an end", length)
+	}
+	for i, bound := range bounds {
+		if bound == "" && len(bounds) == 2 {
+			continue
+		}
+		n, err := strconv.Atoi(bound)
+		if err != nil || n < 0 || n > 65535 {
+			return fmt.Errorf("invalid length %q: must be a packet length 0-65535 or a range", length)
+		}
+		if i == 1 && bounds[0] != "" {
+			if start, _ := strconv.Atoi(bounds[0]); start > n {
+				return fmt.Errorf("invalid length range %q: start is greater than end", length)
+			}
+		}
+	}
+	return nil
+}
+
 // AccessListExtendedEntry represents a single entry in an IPv4 extended access list
 type AccessListExtendedEntry struct {
 	Sequence              int
@@ -738,7 +1072,7 @@ func BuildIPv6FilterCommand(filter IPFilter) string {
 
 	// Add source port if specified
 	if filter.SourcePort != "" {
-		parts = append(parts, filter.SourcePort)
+		parts = append(parts, NormalizeIPFilterPort(filter.SourcePort))
 	} else if filter.DestPort != "" {
 		// If only dest port is specified, we need a placeholder for source port
 		parts = append(parts, "*")
@@ -746,7 +1080,7 @@ func BuildIPv6FilterCommand(filter IPFilter) string {
 
 	// Add destination port if specified
 	if filter.DestPort != "" {
-		parts = append(parts, filter.DestPort)
+		parts = append(parts, NormalizeIPFilterPort(filter.DestPort))
 	}
 
 	return strings.Join(parts, " ")
@@ -763,6 +1097,71 @@ func BuildShowIPv6FilterCommand() string {
 	return "show config | grep \"ipv6 filter\""
 }
 
+// ValidateIPv6FilterAddress validates an IPv6 filter source or destination.
+// Accepts "*", a single IPv6 address, an IPv6 prefix (2001:db8::/32), or an
+// address range (2001:db8::1-2001:db8::ff).
+func ValidateIPv6FilterAddress(address string) error {
+	if address == "*" {
+		return nil
+	}
+
+	if strings.Contains(address, "/") {
+		ip, _, err := net.ParseCIDR(address)
+		if err != nil || ip.To4() != nil {
+			return fmt.Errorf("invalid IPv6 prefix: %s", address)
+		}
+		return nil
+	}
+
+	for _, part := range strings.SplitN(address, "-", 2) {
+		ip := net.ParseIP(part)
+		if ip == nil || ip.To4() != nil {
+			return fmt.Errorf("invalid IPv6 address: %s", address)
+		}
+	}
+	return nil
+}
+
+// ValidateIPv6Filter validates a complete static IPv6 filter configuration
+func ValidateIPv6Filter(filter IPFilter) error {
+	if err := ValidateIPFilterNumber(filter.Number); err != nil {
+		return err
+	}
+
+	if err := ValidateIPFilterAction(filter.Action); err != nil {
+		return err
+	}
+
+	if err := ValidateIPv6FilterAddress(filter.SourceAddress); err != nil {
+		return fmt.Errorf("source address: %w", err)
+	}
+
+	if err := ValidateIPv6FilterAddress(filter.DestAddress); err != nil {
+		return fmt.Errorf("destination address: %w", err)
+	}
+
+	if err := ValidateIPFilterProtocol(filter.Protocol); err != nil {
+		return err
+	}
+
+	if err := validateIPFilterPorts(filter); err != nil {
+		return err
+	}
+
+	// Ports are only meaningful for TCP and UDP
+	hasPorts := (filter.SourcePort != "" && filter.SourcePort != "*") ||
+		(filter.DestPort != "" && filter.DestPort != "*")
+	if hasPorts {
+		for _, p := range strings.Split(strings.ToLower(filter.Protocol), ",") {
+			if p != "tcp" && p != "udp" {
+				return fmt.Errorf("ports can only be specified for tcp or udp, got protocol %s", filter.Protocol)
+			}
+		}
+	}
+
+	return nil
+}
+
 // ParseIPv6FilterConfig parses the output of "show config" for IPv6 filter lines
 func ParseIPv6FilterConfig(raw string) ([]IPFilter, error) {
 	filters := []IPFilter{}
@@ -1,6 +1,7 @@
 package parsers
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -832,6 +833,68 @@ func TestBuildDeleteInterfaceSecureFilterCommand(t *testing.T) {
 	}
 }
 
+func TestInsertFilterNumbers(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     []int
+		numbers  []int
+		before   int
+		expected []int
+	}{
+		{
+			name:     "insert before existing filter",
+			list:     []int{100, 200, 300},
+			numbers:  []int{150},
+			before:   200,
+			expected: []int{100, 150, 200, 300},
+		},
+		{
+			name:     "insert several at the front",
+			list:     []int{100, 200},
+			numbers:  []int{10, 20},
+			before:   100,
+			expected: []int{10, 20, 100, 200},
+		},
+		{
+			name:     "append when before is not bound",
+			list:     []int{100, 200},
+			numbers:  []int{300},
+			before:   999,
+			expected: []int{100, 200, 300},
+		},
+		{
+			name:     "move numbers already bound",
+			list:     []int{100, 150, 200, 300},
+			numbers:  []int{150},
+			before:   300,
+			expected: []int{100, 200, 150, 300},
+		},
+		{
+			name:     "empty list",
+			list:     nil,
+			numbers:  []int{1},
+			before:   100,
+			expected: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := InsertFilterNumbers(tt.list, tt.numbers, tt.before)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("InsertFilterNumbers() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRemoveFilterNumbers(t *testing.T) {
+	result := RemoveFilterNumbers([]int{100, 150, 200, 250}, []int{150, 250, 999})
+	if expected := []int{100, 200}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("RemoveFilterNumbers() = %v, want %v", result, expected)
+	}
+}
+
 func TestBuildShowIPFilterCommand(t *testing.T) {
 	result := BuildShowIPFilterCommand()
 	expected := `show config | grep "ip filter"`
@@ -962,6 +1025,110 @@ func TestValidateIPFilterProtocol(t *testing.T) {
 	}
 }
 
+func TestValidateIPv6FilterAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		wantErr bool
+	}{
+		{name: "any", address: "*", wantErr: false},
+		{name: "single address", address: "2001:db8::1", wantErr: false},
+		{name: "prefix", address: "2001:db8::/32", wantErr: false},
+		{name: "range", address: "2001:db8::1-2001:db8::ff", wantErr: false},
+		{name: "ipv4 address", address: "192.168.1.1", wantErr: true},
+		{name: "ipv4 prefix", address: "192.168.1.0/24", wantErr: true},
+		{name: "invalid range end", address: "2001:db8::1-invalid", wantErr: true},
+		{name: "garbage", address: "not-an-address", wantErr: true},
+		{name: "empty", address: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIPv6FilterAddress(tt.address)
+			if tt.wantErr && err == nil {
+				t.Errorf("expected error for address %q, got nil", tt.address)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error for address %q: %v", tt.address, err)
+			}
+		})
+	}
+}
+
+func TestValidateIPv6Filter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  IPFilter
+		wantErr bool
+	}{
+		{
+			name: "number out of range",
+			filter: IPFilter{
+				Number: 101000, Action: "pass", SourceAddress: "*", DestAddress: "2001:db8::/32",
+				Protocol: "tcp", SourcePort: "*", DestPort: "443",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid tcp with destination port",
+			filter: IPFilter{
+				Number: 1010, Action: "pass", SourceAddress: "*", DestAddress: "2001:db8::/32",
+				Protocol: "tcp", SourcePort: "*", DestPort: "443",
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid icmp6 without ports",
+			filter: IPFilter{
+				Number: 1, Action: "reject-log", SourceAddress: "*", DestAddress: "*",
+				Protocol: "icmp6", SourcePort: "*", DestPort: "*",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid action",
+			filter: IPFilter{
+				Number: 1, Action: "allow", SourceAddress: "*", DestAddress: "*", Protocol: "*",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ipv4 source rejected",
+			filter: IPFilter{
+				Number: 1, Action: "pass", SourceAddress: "10.0.0.0/8", DestAddress: "*", Protocol: "*",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ports with icmp6",
+			filter: IPFilter{
+				Number: 1, Action: "pass", SourceAddress: "*", DestAddress: "*",
+				Protocol: "icmp6", DestPort: "80",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid protocol",
+			filter: IPFilter{
+				Number: 1, Action: "pass", SourceAddress: "*", DestAddress: "*", Protocol: "bogus",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIPv6Filter(tt.filter)
+			if tt.wantErr && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
 func TestValidateIPFilterAction(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -2410,3 +2577,328 @@ func TestParseAndBuildIPv6DynamicRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateIPFilterPort(t *testing.T) {
+	valid := []string{"*", "80", "www", "FTP", "1024-65535", "6000-", "-1023", "80,443", "smtp, submission", "www,smtp", "www,8000-8080"}
+	for _, port := range valid {
+		if err := ValidateIPFilterPort(port); err != nil {
+			t.Errorf("ValidateIPFilterPort(%q) returned error: %v", port, err)
+		}
+	}
+	invalid := []string{"", "-", "http", "70000", "443-80", "80,,443"}
+	for _, port := range invalid {
+		if err := ValidateIPFilterPort(port); err == nil {
+			t.Errorf("ValidateIPFilterPort(%q) returned no error", port)
+		}
+	}
+}
+
+func TestNormalizeIPFilterPort(t *testing.T) {
+	tests := []struct {
+		port string
+		want string
+	}{
+		{"*", "*"},
+		{"1024-65535", "1024-65535"},
+		{"www, smtp", "www,smtp"},
+		{"WWW,8080", "www,8080"},
+	}
+	for _, tt := range tests {
+		if got := NormalizeIPFilterPort(tt.port); got != tt.want {
+			t.Errorf("NormalizeIPFilterPort(%q) = %q, want %q", tt.port, got, tt.want)
+		}
+	}
+}
+
+func TestIsIPFilterPortRange(t *testing.T) {
+	tests := []struct {
+		port string
+		want bool
+	}{
+		{"1024-65535", true},
+		{"6000-", true},
+		{"80", false},
+		{"www,smtp", false},
+		{"www,1024-65535", false},
+	}
+	for _, tt := range tests {
+		if got := IsIPFilterPortRange(tt.port); got != tt.want {
+			t.Errorf("IsIPFilterPortRange(%q) = %v, want %v", tt.port, got, tt.want)
+		}
+	}
+}
+
+func TestIPFilterPortRangesAndLists_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter IPFilter
+		want   string
+	}{
+		{
+			name:   "range",
+			filter: IPFilter{Number: 100, Action: "pass", SourceAddress: "*", DestAddress: "192.168.1.0/24", Protocol: "tcp", SourcePort: "1024-65535", DestPort: "www"},
+			want:   "ip filter 100 pass * 192.168.1.0/24 tcp 1024-65535 www",
+		},
+		{
+			name:   "keyword list",
+			filter: IPFilter{Number: 101, Action: "pass", SourceAddress: "*", DestAddress: "*", Protocol: "tcp", SourcePort: "*", DestPort: "www, smtp"},
+			want:   "ip filter 101 pass * * tcp * www,smtp",
+		},
+		{
+			name:   "mixed list",
+			filter: IPFilter{Number: 102, Action: "reject", SourceAddress: "*", DestAddress: "*", Protocol: "udp", SourcePort: "*", DestPort: "domain,137-139,8080"},
+			want:   "ip filter 102 reject * * udp * domain,137-139,8080",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateIPFilter(tt.filter); err != nil {
+				t.Fatalf("ValidateIPFilter() error = %v", err)
+			}
+			cmd := BuildIPFilterCommand(tt.filter)
+			if cmd != tt.want {
+				t.Fatalf("BuildIPFilterCommand() = %q, want %q", cmd, tt.want)
+			}
+			filters, err := ParseIPFilterConfig(cmd)
+			if err != nil || len(filters) != 1 {
+				t.Fatalf("ParseIPFilterConfig(%q) = %v, %v", cmd, filters, err)
+			}
+			if got := BuildIPFilterCommand(filters[0]); got != cmd {
+				t.Errorf("rebuilt command = %q, want %q", got, cmd)
+			}
+		})
+	}
+}
+
+func TestValidateIPFilter_Ports(t *testing.T) {
+	filter := IPFilter{Number: 100, Action: "pass", SourceAddress: "*", DestAddress: "*", Protocol: "tcp", SourcePort: "*", DestPort: "http"}
+	if err := ValidateIPFilter(filter); err == nil || !strings.Contains(err.Error(), "destination port") {
+		t.Errorf("ValidateIPFilter() error = %v, want destination port error", err)
+	}
+}
+
+func TestIPFilterICMP_RoundTrip(t *testing.T) {
+	echoRequest, unreachable, fragmentationNeeded := 8, 3, 4
+	tests := []struct {
+		name   string
+		filter IPFilter
+		want   string
+	}{
+		{
+			name:   "type only",
+			filter: IPFilter{Number: 100, Action: "pass", SourceAddress: "*", DestAddress: "192.168.1.1", Protocol: "icmp", ICMPType: &echoRequest},
+			want:   "ip filter 100 pass * 192.168.1.1 icmp 8",
+		},
+		{
+			name:   "type and code",
+			filter: IPFilter{Number: 101, Action: "pass", SourceAddress: "*", DestAddress: "*", Protocol: "icmp", SourcePort: "*", DestPort: "*", ICMPType: &unreachable, ICMPCode: &fragmentationNeeded},
+			want:   "ip filter 101 pass * * icmp 3 4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateIPFilter(tt.filter); err != nil {
+				t.Fatalf("ValidateIPFilter() error = %v", err)
+			}
+			cmd := BuildIPFilterCommand(tt.filter)
+			if cmd != tt.want {
+				t.Fatalf("BuildIPFilterCommand() = %q, want %q", cmd, tt.want)
+			}
+			filters, err := ParseIPFilterConfig(cmd)
+			if err != nil || len(filters) != 1 {
+				t.Fatalf("ParseIPFilterConfig(%q) = %v, %v", cmd, filters, err)
+			}
+			got := filters[0]
+			if got.SourcePort != "" || got.DestPort != "" {
+				t.Errorf("ports = %q, %q, want none", got.SourcePort, got.DestPort)
+			}
+			if !reflect.DeepEqual(got.ICMPType, tt.filter.ICMPType) || !reflect.DeepEqual(got.ICMPCode, tt.filter.ICMPCode) {
+				t.Errorf("icmp type/code = %v/%v, want %v/%v", got.ICMPType, got.ICMPCode, tt.filter.ICMPType, tt.filter.ICMPCode)
+			}
+		})
+	}
+}
+
+func TestValidateIPFilter_ICMP(t *testing.T) {
+	echoRequest, outOfRange := 8, 256
+	tests := []struct {
+		name   string
+		filter IPFilter
+	}{
+		{name: "type with tcp", filter: IPFilter{Protocol: "tcp", ICMPType: &echoRequest}},
+		{name: "code without type", filter: IPFilter{Protocol: "icmp", ICMPCode: &echoRequest}},
+		{name: "type out of range", filter: IPFilter{Protocol: "icmp", ICMPType: &outOfRange}},
+		{name: "code out of range", filter: IPFilter{Protocol: "icmp", ICMPType: &echoRequest, ICMPCode: &outOfRange}},
+		{name: "type with ports", filter: IPFilter{Protocol: "icmp", ICMPType: &echoRequest, DestPort: "80"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.filter.Number, tt.filter.Action, tt.filter.SourceAddress, tt.filter.DestAddress = 100, "pass", "*", "*"
+			if err := ValidateIPFilter(tt.filter); err == nil {
+				t.Error("ValidateIPFilter() returned no error")
+			}
+		})
+	}
+}
+
+func TestParseIPFilterConfig_ExtendedFields(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want IPFilter
+		// rebuilt is the command built from the parsed filter, if not the line itself
+		rebuilt string
+	}{
+		{
+			name: "tcp flag match",
+			line: "ip filter 100 reject * * tcpflag=0x0002/0x0017 * telnet",
+			want: IPFilter{Number: 100, Action: "reject", SourceAddress: "*", DestAddress: "*", Protocol: "tcpflag=0x0002/0x0017", SourcePort: "*", DestPort: "telnet"},
+		},
+		{
+			name: "protocol number",
+			line: "ip filter 101 pass * 192.168.1.1 47",
+			want: IPFilter{Number: 101, Action: "pass", SourceAddress: "*", DestAddress: "192.168.1.1", Protocol: "47"},
+		},
+		{
+			name: "fragment",
+			line: "ip filter 102 reject * * udp * * fragment",
+			want: IPFilter{Number: 102, Action: "reject", SourceAddress: "*", DestAddress: "*", Protocol: "udp", SourcePort: "*", DestPort: "*", Fragment: true},
+		},
+		{
+			name: "packet length range",
+			line: "ip filter 103 pass * * udp * 500 length=100-200",
+			want: IPFilter{Number: 103, Action: "pass", SourceAddress: "*", DestAddress: "*", Protocol: "udp", SourcePort: "*", DestPort: "500", Length: "100-200"},
+		},
+		{
+			name: "packet length",
+			line: "ip filter 104 reject * * icmp 8 * length=1500-",
+			want: IPFilter{Number: 104, Action: "reject", SourceAddress: "*", DestAddress: "*", Protocol: "icmp", ICMPType: intPtr(8), Length: "1500-"},
+		},
+		{
+			name: "tos",
+			line: "ip filter 105 pass * * tcp * 22 tos=0x10",
+			want: IPFilter{Number: 105, Action: "pass", SourceAddress: "*", DestAddress: "*", Protocol: "tcp", SourcePort: "*", DestPort: "22", TOS: "0x10"},
+		},
+		{
+			name: "dscp",
+			line: "ip filter 106 pass * * udp * 5060 dscp=ef",
+			want: IPFilter{Number: 106, Action: "pass", SourceAddress: "*", DestAddress: "*", Protocol: "udp", SourcePort: "*", DestPort: "5060", DSCP: "ef"},
+		},
+		{
+			name: "all options",
+			line: "ip filter 107 pass * * tcp * * established fragment length=40-1500 dscp=46",
+			want: IPFilter{Number: 107, Action: "pass", SourceAddress: "*", DestAddress: "*", Protocol: "tcp", SourcePort: "*", DestPort: "*", Established: true, Fragment: true, Length: "40-1500", DSCP: "46"},
+		},
+		{
+			name:    "options without ports",
+			line:    "ip filter 108 pass * * tcp established tos=16",
+			want:    IPFilter{Number: 108, Action: "pass", SourceAddress: "*", DestAddress: "*", Protocol: "tcp", Established: true, TOS: "16"},
+			rebuilt: "ip filter 108 pass * * tcp * * established tos=16",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters, err := ParseIPFilterConfig(tt.line)
+			if err != nil || len(filters) != 1 {
+				t.Fatalf("ParseIPFilterConfig(%q) = %v, %v", tt.line, filters, err)
+			}
+			if !reflect.DeepEqual(filters[0], tt.want) {
+				t.Errorf("ParseIPFilterConfig() = %+v, want %+v", filters[0], tt.want)
+			}
+			if err := ValidateIPFilter(filters[0]); err != nil {
+				t.Errorf("ValidateIPFilter() error = %v", err)
+			}
+			want := tt.rebuilt
+			if want == "" {
+				want = tt.line
+			}
+			if got := BuildIPFilterCommand(filters[0]); got != want {
+				t.Errorf("BuildIPFilterCommand() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestParseIPFilterConfig_RejectedFields(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+	}{
+		{name: "unknown option", line: "ip filter 100 pass * * udp * 500 ttl=1"},
+		{name: "unknown keyword", line: "ip filter 101 pass * * udp * 500 nofragment"},
+		{name: "port after an option", line: "ip filter 102 pass * * tcp * fragment 80"},
+		{name: "invalid length", line: "ip filter 103 pass * * udp * * length=200-100"},
+		{name: "invalid tos", line: "ip filter 104 pass * * udp * * tos=0x100"},
+		{name: "invalid dscp", line: "ip filter 105 pass * * udp * * dscp=af99"},
+		{name: "tos and dscp", line: "ip filter 106 pass * * udp * * tos=0x10 dscp=ef"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tt.line + "\nip filter 200 pass * * tcp * www"
+			filters, err := ParseIPFilterConfig(config)
+			if err == nil {
+				t.Errorf("ParseIPFilterConfig(%q) returned no error", tt.line)
+			}
+			if len(filters) != 1 || filters[0].Number != 200 {
+				t.Errorf("ParseIPFilterConfig() = %+v, want only filter 200", filters)
+			}
+		})
+	}
+}
+
+func TestParseIPFilterConfig_RequiresDestinationAndProtocol(t *testing.T) {
+	for _, line := range []string{"ip filter 104 reject 10.0.0.0/8", "ip filter 105 reject 10.0.0.0/8 *"} {
+		filters, err := ParseIPFilterConfig(line)
+		if err != nil || len(filters) != 0 {
+			t.Errorf("ParseIPFilterConfig(%q) = %+v, %v, want no filters", line, filters, err)
+		}
+	}
+}
+
+func TestValidateIPFilter_Options(t *testing.T) {
+	base := IPFilter{Number: 100, Action: "pass", SourceAddress: "*", DestAddress: "*", Protocol: "udp"}
+	valid := []func(f *IPFilter){
+		func(f *IPFilter) { f.Fragment = true },
+		func(f *IPFilter) { f.Length = "1500" },
+		func(f *IPFilter) { f.Length = "-64" },
+		func(f *IPFilter) { f.TOS = "0xb8" },
+		func(f *IPFilter) { f.DSCP = "AF41" },
+		func(f *IPFilter) { f.DSCP = "63" },
+	}
+	for i, modify := range valid {
+		f := base
+		modify(&f)
+		if err := ValidateIPFilter(f); err != nil {
+			t.Errorf("valid[%d]: ValidateIPFilter(%+v) returned error: %v", i, f, err)
+		}
+	}
+	invalid := []func(f *IPFilter){
+		func(f *IPFilter) { f.Length = "65536" },
+		func(f *IPFilter) { f.Length = "-" },
+		func(f *IPFilter) { f.TOS = "256" },
+		func(f *IPFilter) { f.DSCP = "64" },
+		func(f *IPFilter) { f.TOS, f.DSCP = "0x10", "ef" },
+	}
+	for i, modify := range invalid {
+		f := base
+		modify(&f)
+		if err := ValidateIPFilter(f); err == nil {
+			t.Errorf("invalid[%d]: ValidateIPFilter(%+v) returned no error", i, f)
+		}
+	}
+}
+
+func TestValidateIPFilterProtocol_Extended(t *testing.T) {
+	valid := []string{"icmp-error", "icmp-info", "47", "tcpflag=0x0002/0x0017", "tcpflag!=0x0010", "tcp,udp"}
+	for _, proto := range valid {
+		if err := ValidateIPFilterProtocol(proto); err != nil {
+			t.Errorf("ValidateIPFilterProtocol(%q) returned error: %v", proto, err)
+		}
+	}
+	invalid := []string{"256", "tcpflag=2", "sctp"}
+	for _, proto := range invalid {
+		if err := ValidateIPFilterProtocol(proto); err == nil {
+			t.Errorf("ValidateIPFilterProtocol(%q) returned no error", proto)
+		}
+	}
+}
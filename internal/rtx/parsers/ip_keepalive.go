@@ -0,0 +1,151 @@
+package parsers
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// IPKeepalive represents an "ip keepalive" reachability monitor on an RTX router
+// Command format: ip keepalive <id> <kind> <interval> <count> <target> [upwait=<n>] [downwait=<n>] [syslog=on]
+type IPKeepalive struct {
+	ID       int    `json:"id"`                 // Keepalive ID referenced by routes
+	Kind     string `json:"kind"`               // Probe kind (icmp-echo)
+	Interval int    `json:"interval"`           // Probe interval in seconds
+	Count    int    `json:"count"`              // Consecutive failures before the target is considered down
+	Target   string `json:"target"`             // Probe destination address
+	Upwait   int    `json:"upwait,omitempty"`   // Seconds of success before declaring the target up
+	Downwait int    `json:"downwait,omitempty"` // Seconds of failure before declaring the target down
+	Syslog   bool   `json:"syslog,omitempty"`   // Log state transitions
+}
+
+// ValidIPKeepaliveKinds lists the probe kinds supported by the provider
+var ValidIPKeepaliveKinds = []string{"icmp-echo"}
+
+var ipKeepalivePattern = regexp.MustCompile(`^\s*ip\s+keepalive\s+(\d+)\s+(\S+)\s+(\d+)\s+(\d+)\s+(\S+)((?:\s+\S+=\S+)*)\s*$`)
+
+// ParseIPKeepaliveConfig parses "ip keepalive" lines from the router configuration
+func ParseIPKeepaliveConfig(raw string) ([]IPKeepalive, error) {
+	var keepalives []IPKeepalive
+
+	for _, line := range strings.Split(raw, "\n") {
+		matches := ipKeepalivePattern.FindStringSubmatch(strings.TrimSpace(line))
+		if len(matches) < 6 {
+			continue
+		}
+
+		id, _ := strconv.Atoi(matches[1])
+		interval, _ := strconv.Atoi(matches[3])
+		count, _ := strconv.Atoi(matches[4])
+
+		keepalive := IPKeepalive{
+			ID:       id,
+			Kind:     matches[2],
+			Interval: interval,
+			Count:    count,
+			Target:   matches[5],
+		}
+
+		for _, opt := range strings.Fields(matches[6]) {
+			key, value, _ := strings.Cut(opt, "=")
+			switch key {
+			case "upwait":
+				keepalive.Upwait, _ = strconv.Atoi(value)
+			case "downwait":
+				keepalive.Downwait, _ = strconv.Atoi(value)
+			case "syslog":
+				keepalive.Syslog = value == "on"
+			}
+		}
+
+		keepalives = append(keepalives, keepalive)
+	}
+
+	sort.Slice(keepalives, func(i, j int) bool {
+		return keepalives[i].ID < keepalives[j].ID
+	})
+
+	return keepalives, nil
+}
+
+// ParseSingleIPKeepalive parses the configuration and returns the keepalive with the given ID
+func ParseSingleIPKeepalive(raw string, id int) (*IPKeepalive, error) {
+	keepalives, err := ParseIPKeepaliveConfig(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range keepalives {
+		if keepalives[i].ID == id {
+			return &keepalives[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("ip keepalive %d not found", id)
+}
+
+// BuildIPKeepaliveCommand builds the command to define a keepalive monitor
+// Command format: ip keepalive <id> <kind> <interval> <count> <target> [upwait=<n>] [downwait=<n>] [syslog=on]
+func BuildIPKeepaliveCommand(keepalive IPKeepalive) string {
+	cmd := fmt.Sprintf("ip keepalive %d %s %d %d %s",
+		keepalive.ID, keepalive.Kind, keepalive.Interval, keepalive.Count, keepalive.Target)
+	if keepalive.Upwait > 0 {
+		cmd += fmt.Sprintf(" upwait=%d", keepalive.Upwait)
+	}
+	if keepalive.Downwait > 0 {
+		cmd += fmt.Sprintf(" downwait=%d", keepalive.Downwait)
+	}
+	if keepalive.Syslog {
+		cmd += " syslog=on"
+	}
+	return cmd
+}
+
+// BuildDeleteIPKeepaliveCommand builds the command to remove a keepalive monitor
+// Command format: no ip keepalive <id>
+func BuildDeleteIPKeepaliveCommand(id int) string {
+	return fmt.Sprintf("no ip keepalive %d", id)
+}
+
+// BuildShowIPKeepaliveCommand builds the command to show keepalive configuration
+func BuildShowIPKeepaliveCommand() string {
+	return "show config | grep \"ip keepalive\""
+}
+
+// ValidateIPKeepalive validates a keepalive monitor definition
+func ValidateIPKeepalive(keepalive IPKeepalive) error {
+	if keepalive.ID < 1 || keepalive.ID > 6000 {
+		return fmt.Errorf("keepalive id must be between 1 and 6000, got %d", keepalive.ID)
+	}
+
+	validKind := false
+	for _, kind := range ValidIPKeepaliveKinds {
+		if keepalive.Kind == kind {
+			validKind = true
+			break
+		}
+	}
+	if !validKind {
+		return fmt.Errorf("invalid keepalive kind %q, must be one of: %s", keepalive.Kind, strings.Join(ValidIPKeepaliveKinds, ", "))
+	}
+
+	if keepalive.Interval < 1 || keepalive.Interval > 600 {
+		return fmt.Errorf("keepalive interval must be between 1 and 600 seconds, got %d", keepalive.Interval)
+	}
+	if keepalive.Count < 1 || keepalive.Count > 100 {
+		return fmt.Errorf("keepalive count must be between 1 and 100, got %d", keepalive.Count)
+	}
+
+	if ip := net.ParseIP(keepalive.Target); ip == nil || ip.To4() == nil {
+		return fmt.Errorf("invalid keepalive target IPv4 address: %s", keepalive.Target)
+	}
+
+	if keepalive.Upwait < 0 || keepalive.Downwait < 0 {
+		return fmt.Errorf("upwait and downwait must not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseIPKeepaliveConfig(t *testing.T) {
+	raw := `ip keepalive 2 icmp-echo 5 3 192.0.2.1 upwait=10 downwait=5 syslog=on
+ip keepalive 1 icmp-echo 10 3 8.8.8.8
+ip route default gateway pp 1 keepalive 1`
+
+	got, err := ParseIPKeepaliveConfig(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []IPKeepalive{
+		{ID: 1, Kind: "icmp-echo", Interval: 10, Count: 3, Target: "8.8.8.8"},
+		{ID: 2, Kind: "icmp-echo", Interval: 5, Count: 3, Target: "192.0.2.1", Upwait: 10, Downwait: 5, Syslog: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseIPKeepaliveConfig() = %+v, want %+v", got, want)
+	}
+
+	if _, err := ParseSingleIPKeepalive(raw, 3); err == nil {
+		t.Error("expected not found error for missing keepalive")
+	}
+}
+
+func TestBuildIPKeepaliveCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		keepalive IPKeepalive
+		want      string
+	}{
+		{
+			name:      "minimal",
+			keepalive: IPKeepalive{ID: 1, Kind: "icmp-echo", Interval: 10, Count: 3, Target: "8.8.8.8"},
+			want:      "ip keepalive 1 icmp-echo 10 3 8.8.8.8",
+		},
+		{
+			name: "with options",
+			keepalive: IPKeepalive{
+				ID: 2, Kind: "icmp-echo", Interval: 5, Count: 3, Target: "192.0.2.1",
+				Upwait: 10, Downwait: 5, Syslog: true,
+			},
+			want: "ip keepalive 2 icmp-echo 5 3 192.0.2.1 upwait=10 downwait=5 syslog=on",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BuildIPKeepaliveCommand(tt.keepalive); got != tt.want {
+				t.Errorf("BuildIPKeepaliveCommand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got := BuildDeleteIPKeepaliveCommand(1); got != "no ip keepalive 1" {
+		t.Errorf("BuildDeleteIPKeepaliveCommand() = %q", got)
+	}
+}
+
+func TestValidateIPKeepalive(t *testing.T) {
+	valid := IPKeepalive{ID: 1, Kind: "icmp-echo", Interval: 10, Count: 3, Target: "8.8.8.8"}
+
+	tests := []struct {
+		name    string
+		modify  func(k *IPKeepalive)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(k *IPKeepalive) {}, wantErr: false},
+		{name: "id zero", modify: func(k *IPKeepalive) { k.ID = 0 }, wantErr: true},
+		{name: "unknown kind", modify: func(k *IPKeepalive) { k.Kind = "tcp" }, wantErr: true},
+		{name: "interval zero", modify: func(k *IPKeepalive) { k.Interval = 0 }, wantErr: true},
+		{name: "count too large", modify: func(k *IPKeepalive) { k.Count = 101 }, wantErr: true},
+		{name: "ipv6 target", modify: func(k *IPKeepalive) { k.Target = "2001:db8::1" }, wantErr: true},
+		{name: "hostname target", modify: func(k *IPKeepalive) { k.Target = "example.com" }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keepalive := valid
+			tt.modify(&keepalive)
+			err := ValidateIPKeepalive(keepalive)
+			if tt.wantErr && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package parsers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// IPsecSA represents an established security association reported by "show ipsec sa"
+type IPsecSA struct {
+	ID         int    `json:"id"`                    // SA number
+	Gateway    int    `json:"gateway"`               // Security gateway (ipsec tunnel) number
+	ISAKMPSA   int    `json:"isakmp_sa,omitempty"`   // ISAKMP SA the IPsec SA was negotiated on (0 for ISAKMP SAs)
+	Type       string `json:"type"`                  // isakmp, esp, or ah
+	Tunnel     int    `json:"tunnel,omitempty"`      // Tunnel interface number (tun[001] -> 1)
+	Direction  string `json:"direction,omitempty"`   // send or recv (empty for ISAKMP SAs)
+	Lifetime   int    `json:"lifetime"`              // Remaining lifetime in seconds
+	RemoteID   string `json:"remote_id"`             // Peer identifier
+	SPI        string `json:"spi,omitempty"`         // SPI as hex string (from the detail output)
+	LocalHost  string `json:"local_host,omitempty"`  // Local endpoint (from the detail output)
+	RemoteHost string `json:"remote_host,omitempty"` // Remote endpoint (from the detail output)
+}
+
+var (
+	ipsecSARowPattern        = regexp.MustCompile(`^\s*(\d+)\s+(\d+)\s+(\d+|-)\s+(\S+)\s+(send|recv|-)\s+(\d+)\s+(\S+)\s*$`)
+	ipsecSAConnectionPattern = regexp.MustCompile(`^(?:tun\[(\d+)\])?(isakmp|esp|ah)$`)
+	ipsecSADetailPattern     = regexp.MustCompile(`^\s*SA\[(\d+)\]`)
+	ipsecSASPIPattern        = regexp.MustCompile(`(?i)^\s*SPI\s*:\s*((?:[0-9a-f]{2}\s*)+)$`)
+	ipsecSALocalPattern      = regexp.MustCompile(`(?i)^\s*Local\s+Host\s*:\s*(\S+)`)
+	ipsecSARemotePattern     = regexp.MustCompile(`(?i)^\s*Remote\s+Host\s*:\s*(\S+)`)
+)
+
+// ParseIPsecSAs parses the SA table of "show ipsec sa"
+func ParseIPsecSAs(raw string) []IPsecSA {
+	var sas []IPsecSA
+
+	for _, line := range strings.Split(raw, "\n") {
+		matches := ipsecSARowPattern.FindStringSubmatch(line)
+		if len(matches) != 8 {
+			continue
+		}
+		connection := ipsecSAConnectionPattern.FindStringSubmatch(matches[4])
+		if len(connection) != 3 {
+			continue
+		}
+
+		sa := IPsecSA{
+			Type:     connection[2],
+			RemoteID: matches[7],
+		}
+		sa.ID, _ = strconv.Atoi(matches[1])
+		sa.Gateway, _ = strconv.Atoi(matches[2])
+		sa.ISAKMPSA, _ = strconv.Atoi(matches[3]) // "-" leaves 0
+		sa.Tunnel, _ = strconv.Atoi(connection[1])
+		sa.Lifetime, _ = strconv.Atoi(matches[6])
+		if matches[5] != "-" {
+			sa.Direction = matches[5]
+		}
+		sas = append(sas, sa)
+	}
+
+	return sas
+}
+
+// MergeIPsecSADetails adds the SPI and endpoints from "show ipsec sa detail"
+// to the SAs parsed from the SA table
+func MergeIPsecSADetails(sas []IPsecSA, detail string) []IPsecSA {
+	index := make(map[int]int, len(sas))
+	for i, sa := range sas {
+		index[sa.ID] = i
+	}
+
+	current := -1
+	for _, line := range strings.Split(detail, "\n") {
+		if matches := ipsecSADetailPattern.FindStringSubmatch(line); len(matches) == 2 {
+			id, _ := strconv.Atoi(matches[1])
+			if i, ok := index[id]; ok {
+				current = i
+			} else {
+				current = -1
+			}
+			continue
+		}
+		if current < 0 {
+			continue
+		}
+		if matches := ipsecSASPIPattern.FindStringSubmatch(line); len(matches) == 2 {
+			sas[current].SPI = strings.ToLower(strings.Join(strings.Fields(matches[1]), ""))
+			continue
+		}
+		if matches := ipsecSALocalPattern.FindStringSubmatch(line); len(matches) == 2 {
+			sas[current].LocalHost = matches[1]
+			continue
+		}
+		if matches := ipsecSARemotePattern.FindStringSubmatch(line); len(matches) == 2 {
+			sas[current].RemoteHost = matches[1]
+		}
+	}
+
+	return sas
+}
+
+// BuildShowIPsecSACommand builds the command to show the SA table
+func BuildShowIPsecSACommand() string {
+	return "show ipsec sa"
+}
+
+// BuildShowIPsecSADetailCommand builds the command to show SA details including SPIs
+func BuildShowIPsecSADetailCommand() string {
+	return "show ipsec sa detail"
+}
@@ -0,0 +1,68 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testIPsecSATable = `Total: isakmp:1 send:1 recv:1
+
+sa   sgw isakmp connection   dir  life[s] remote-id
+-----------------------------------------------------------------------------
+1     1    -     isakmp       -    28787   203.0.113.1
+2     1    1     tun[001]esp  send 28788   203.0.113.1
+3     1    1     tun[001]esp  recv 28788   203.0.113.1
+`
+
+const testIPsecSADetail = `SA[1] Duration: 12s
+ Local Host: 192.0.2.1
+ Remote Host: 203.0.113.1
+ Direction: -
+ Protocol: IKE
+
+SA[2] Duration: 11s
+ Local Host: 192.0.2.1
+ Remote Host: 203.0.113.1
+ Direction: send
+ Protocol: ESP (Mode: tunnel)
+ Algorithm: AES-CBC (for Authentication: HMAC-SHA)
+ SPI: 6e 20 53 a9
+ Key: ** ** ** ** **  (confidential)  ** ** ** ** **
+
+SA[3] Duration: 11s
+ Local Host: 192.0.2.1
+ Remote Host: 203.0.113.1
+ Direction: receive
+ Protocol: ESP (Mode: tunnel)
+ SPI: C1 0A 44 02
+`
+
+func TestParseIPsecSAs(t *testing.T) {
+	want := []IPsecSA{
+		{ID: 1, Gateway: 1, Type: "isakmp", Lifetime: 28787, RemoteID: "203.0.113.1"},
+		{ID: 2, Gateway: 1, ISAKMPSA: 1, Type: "esp", Tunnel: 1, Direction: "send", Lifetime: 28788, RemoteID: "203.0.113.1"},
+		{ID: 3, Gateway: 1, ISAKMPSA: 1, Type: "esp", Tunnel: 1, Direction: "recv", Lifetime: 28788, RemoteID: "203.0.113.1"},
+	}
+
+	if got := ParseIPsecSAs(testIPsecSATable); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseIPsecSAs() = %+v, want %+v", got, want)
+	}
+
+	if got := ParseIPsecSAs("Total: isakmp:0 send:0 recv:0\n"); len(got) != 0 {
+		t.Errorf("ParseIPsecSAs() without SAs = %+v, want none", got)
+	}
+}
+
+func TestMergeIPsecSADetails(t *testing.T) {
+	sas := MergeIPsecSADetails(ParseIPsecSAs(testIPsecSATable), testIPsecSADetail)
+
+	wantSPIs := []string{"", "6e2053a9", "c10a4402"}
+	for i, sa := range sas {
+		if sa.SPI != wantSPIs[i] {
+			t.Errorf("SA %d SPI = %q, want %q", sa.ID, sa.SPI, wantSPIs[i])
+		}
+		if sa.LocalHost != "192.0.2.1" || sa.RemoteHost != "203.0.113.1" {
+			t.Errorf("SA %d endpoints = %s -> %s", sa.ID, sa.LocalHost, sa.RemoteHost)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package parsers
+
+import (
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// IPv6Neighbor represents an entry of the IPv6 neighbor cache reported by "show ipv6 neighbor cache"
+type IPv6Neighbor struct {
+	Address    string `json:"address"`         // IPv6 address of the neighbor
+	Interface  string `json:"interface"`       // Interface the neighbor was learned on (lan1, bridge1, etc.)
+	MACAddress string `json:"mac_address"`     // Link-layer address (empty while resolution is incomplete)
+	State      string `json:"state,omitempty"` // Neighbor Unreachability Detection state (reachable, stale, etc.)
+}
+
+var (
+	ipv6NeighborCacheInterfacePattern = regexp.MustCompile(`(?i)^(lan|bridge|pp|tunnel|vlan)\d+(?:/\d+)?:?$`)
+	ipv6NeighborCacheMACPattern       = regexp.MustCompile(`^(?:[0-9a-fA-F]{2}[:-]){5}[0-9a-fA-F]{2}$`)
+	ipv6NeighborCacheStates           = []string{"incomplete", "reachable", "stale", "delay", "probe", "static", "permanent"}
+)
+
+// ParseIPv6Neighbors parses the output of "show ipv6 neighbor cache".
+// Rows carry the address, interface, MAC address and state in any order; an
+// interface printed on a line of its own applies to the rows that follow it.
+func ParseIPv6Neighbors(raw string) []IPv6Neighbor {
+	neighbors := []IPv6Neighbor{}
+	section := ""
+
+	for _, line := range strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n") {
+		fields := strings.Fields(strings.NewReplacer("(", " ", ")", " ", ",", " ").Replace(line))
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) == 1 && ipv6NeighborCacheInterfacePattern.MatchString(fields[0]) {
+			section = strings.ToLower(strings.TrimSuffix(fields[0], ":"))
+			continue
+		}
+
+		neighbor := IPv6Neighbor{Interface: section}
+		for _, field := range fields {
+			lower := strings.ToLower(field)
+			switch {
+			case neighbor.Address == "" && strings.Contains(field, ":") && isIPv6Address(field):
+				neighbor.Address = strings.ToLower(field)
+			case neighbor.MACAddress == "" && ipv6NeighborCacheMACPattern.MatchString(field):
+				neighbor.MACAddress = strings.ToLower(strings.ReplaceAll(field, "-", ":"))
+			case ipv6NeighborCacheInterfacePattern.MatchString(field):
+				neighbor.Interface = strings.TrimSuffix(lower, ":")
+			case neighbor.State == "" && slices.Contains(ipv6NeighborCacheStates, lower):
+				neighbor.State = lower
+			}
+		}
+		if neighbor.Address == "" || neighbor.Interface == "" {
+			continue
+		}
+		neighbors = append(neighbors, neighbor)
+	}
+
+	return neighbors
+}
+
+// BuildShowIPv6NeighborCacheCommand builds the command to show the IPv6 neighbor cache
+func BuildShowIPv6NeighborCacheCommand() string {
+	return "show ipv6 neighbor cache"
+}
+
+func isIPv6Address(s string) bool {
+	ip := net.ParseIP(s)
+	return ip != nil && ip.To4() == nil
+}
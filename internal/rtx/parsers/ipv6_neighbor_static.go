@@ -0,0 +1,74 @@
+package parsers
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+)
+
+// IPv6NeighborStatic represents a static IPv6 neighbor cache entry that pins
+// an IPv6 address to a MAC address on an interface
+type IPv6NeighborStatic struct {
+	Interface  string `json:"interface"`   // LAN-side interface (lan1, bridge1, etc.)
+	Address    string `json:"address"`     // IPv6 address of the neighbor
+	MACAddress string `json:"mac_address"` // Link-layer address of the neighbor
+}
+
+var (
+	ipv6NeighborStaticPattern    = regexp.MustCompile(`^\s*ipv6\s+neighbor\s+static\s+(\S+)\s+(\S+)\s+(\S+)\s*$`)
+	ipv6NeighborInterfacePattern = regexp.MustCompile(`^(lan|bridge)\d+$`)
+)
+
+// ParseIPv6NeighborStatics parses "ipv6 neighbor static" entries from the router configuration
+func ParseIPv6NeighborStatics(raw string) []IPv6NeighborStatic {
+	var entries []IPv6NeighborStatic
+
+	for _, line := range strings.Split(raw, "\n") {
+		matches := ipv6NeighborStaticPattern.FindStringSubmatch(line)
+		if len(matches) < 4 {
+			continue
+		}
+		if net.ParseIP(matches[2]) == nil {
+			continue
+		}
+		entries = append(entries, IPv6NeighborStatic{
+			Interface:  matches[1],
+			Address:    matches[2],
+			MACAddress: strings.ToLower(matches[3]),
+		})
+	}
+
+	return entries
+}
+
+// BuildIPv6NeighborStaticCommand builds the command to add or replace a static neighbor entry
+// Command format: ipv6 neighbor static <interface> <ipv6_address> <mac_address>
+func BuildIPv6NeighborStaticCommand(entry IPv6NeighborStatic) string {
+	return fmt.Sprintf("ipv6 neighbor static %s %s %s", entry.Interface, entry.Address, strings.ToLower(entry.MACAddress))
+}
+
+// BuildDeleteIPv6NeighborStaticCommand builds the command to remove a static neighbor entry
+// Command format: no ipv6 neighbor static <interface> <ipv6_address>
+func BuildDeleteIPv6NeighborStaticCommand(iface, address string) string {
+	return fmt.Sprintf("no ipv6 neighbor static %s %s", iface, address)
+}
+
+// BuildShowIPv6NeighborStaticsCommand builds the command to show static neighbor entries
+func BuildShowIPv6NeighborStaticsCommand() string {
+	return `show config | grep "ipv6 neighbor static"`
+}
+
+// ValidateIPv6NeighborStatic validates a static neighbor entry
+func ValidateIPv6NeighborStatic(entry IPv6NeighborStatic) error {
+	if !ipv6NeighborInterfacePattern.MatchString(entry.Interface) {
+		return fmt.Errorf("interface must be a LAN or bridge interface (e.g., lan1, bridge1), got %q", entry.Interface)
+	}
+	if ip := net.ParseIP(entry.Address); ip == nil || ip.To4() != nil {
+		return fmt.Errorf("invalid IPv6 address: %s", entry.Address)
+	}
+	if _, err := net.ParseMAC(entry.MACAddress); err != nil || !strings.Contains(entry.MACAddress, ":") {
+		return fmt.Errorf("invalid MAC address %q: must be colon-separated (e.g., 00:a0:de:01:02:03)", entry.MACAddress)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseIPv6NeighborStatics(t *testing.T) {
+	raw := `ipv6 lan1 address 2001:db8::1/64
+ipv6 neighbor static lan1 2001:db8::10 00:A0:DE:01:02:03
+ipv6 neighbor static bridge1 fe80::20 00:a0:de:04:05:06
+ipv6 neighbor static lan2 not-an-address 00:a0:de:07:08:09`
+
+	want := []IPv6NeighborStatic{
+		{Interface: "lan1", Address: "2001:db8::10", MACAddress: "00:a0:de:01:02:03"},
+		{Interface: "bridge1", Address: "fe80::20", MACAddress: "00:a0:de:04:05:06"},
+	}
+
+	if got := ParseIPv6NeighborStatics(raw); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseIPv6NeighborStatics() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildIPv6NeighborStaticCommands(t *testing.T) {
+	entry := IPv6NeighborStatic{Interface: "lan1", Address: "2001:db8::10", MACAddress: "00:A0:DE:01:02:03"}
+
+	if got, want := BuildIPv6NeighborStaticCommand(entry), "ipv6 neighbor static lan1 2001:db8::10 00:a0:de:01:02:03"; got != want {
+		t.Errorf("BuildIPv6NeighborStaticCommand() = %q, want %q", got, want)
+	}
+	if got, want := BuildDeleteIPv6NeighborStaticCommand("lan1", "2001:db8::10"), "no ipv6 neighbor static lan1 2001:db8::10"; got != want {
+		t.Errorf("BuildDeleteIPv6NeighborStaticCommand() = %q, want %q", got, want)
+	}
+}
+
+func TestValidateIPv6NeighborStatic(t *testing.T) {
+	tests := []struct {
+		name    string
+		entry   IPv6NeighborStatic
+		wantErr bool
+	}{
+		{name: "global address", entry: IPv6NeighborStatic{Interface: "lan1", Address: "2001:db8::10", MACAddress: "00:a0:de:01:02:03"}},
+		{name: "link-local on bridge", entry: IPv6NeighborStatic{Interface: "bridge1", Address: "fe80::1", MACAddress: "00:a0:de:01:02:03"}},
+		{name: "pp interface", entry: IPv6NeighborStatic{Interface: "pp1", Address: "2001:db8::10", MACAddress: "00:a0:de:01:02:03"}, wantErr: true},
+		{name: "IPv4 address", entry: IPv6NeighborStatic{Interface: "lan1", Address: "192.168.1.10", MACAddress: "00:a0:de:01:02:03"}, wantErr: true},
+		{name: "dashed MAC", entry: IPv6NeighborStatic{Interface: "lan1", Address: "2001:db8::10", MACAddress: "00-a0-de-01-02-03"}, wantErr: true},
+		{name: "invalid MAC", entry: IPv6NeighborStatic{Interface: "lan1", Address: "2001:db8::10", MACAddress: "00:a0:de"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIPv6NeighborStatic(tt.entry)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateIPv6NeighborStatic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
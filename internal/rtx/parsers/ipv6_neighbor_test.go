@@ -0,0 +1,54 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseIPv6Neighbors(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want []IPv6Neighbor
+	}{
+		{
+			name: "table output",
+			raw: `IPv6 Address                             Interface  MAC Address        State      TTL
+fe80::2a0:deff:fe01:203                  LAN1       00:A0:DE:01:02:03  REACHABLE  28
+2001:db8::10                             lan1       00:a0:de:01:02:04  STALE      -
+2001:db8:1::1                            lan2                          INCOMPLETE 3
+`,
+			want: []IPv6Neighbor{
+				{Address: "fe80::2a0:deff:fe01:203", Interface: "lan1", MACAddress: "00:a0:de:01:02:03", State: "reachable"},
+				{Address: "2001:db8::10", Interface: "lan1", MACAddress: "00:a0:de:01:02:04", State: "stale"},
+				{Address: "2001:db8:1::1", Interface: "lan2", State: "incomplete"},
+			},
+		},
+		{
+			name: "grouped by interface",
+			raw: `LAN1:
+  fe80::1 00:a0:de:01:02:03 (REACHABLE, 10)
+BRIDGE1:
+  2001:db8::20 00-a0-de-01-02-05 (STATIC)
+`,
+			want: []IPv6Neighbor{
+				{Address: "fe80::1", Interface: "lan1", MACAddress: "00:a0:de:01:02:03", State: "reachable"},
+				{Address: "2001:db8::20", Interface: "bridge1", MACAddress: "00:a0:de:01:02:05", State: "static"},
+			},
+		},
+		{
+			name: "empty cache",
+			raw:  "\n",
+			want: []IPv6Neighbor{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseIPv6Neighbors(tt.raw)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseIPv6Neighbors() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
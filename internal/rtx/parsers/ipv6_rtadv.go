@@ -0,0 +1,181 @@
+package parsers
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// IPv6RTADV represents the full Router Advertisement settings of an interface,
+// including the timing, MTU and DNS (RDNSS/DNSSL) options
+type IPv6RTADV struct {
+	Interface      string   `json:"interface"`                 // Interface sending RAs (lan1, bridge1, etc.)
+	PrefixIDs      []int    `json:"prefix_ids"`                // Prefix IDs to advertise
+	OFlag          bool     `json:"o_flag"`                    // Other Configuration Flag (O flag)
+	MFlag          bool     `json:"m_flag"`                    // Managed Address Configuration Flag (M flag)
+	MaxInterval    int      `json:"max_interval,omitempty"`    // Maximum RA interval in seconds (0 = router default)
+	MinInterval    int      `json:"min_interval,omitempty"`    // Minimum RA interval in seconds (0 = router default)
+	RouterLifetime int      `json:"router_lifetime,omitempty"` // Router lifetime in seconds (0 = router default)
+	MTU            int      `json:"mtu,omitempty"`             // Advertised link MTU (0 = not advertised)
+	RDNSS          []string `json:"rdnss,omitempty"`           // Recursive DNS servers (RFC 8106)
+	DNSSL          []string `json:"dnssl,omitempty"`           // DNS search list (RFC 8106)
+}
+
+var (
+	ipv6RTADVLinePattern      = regexp.MustCompile(`^\s*ipv6\s+(\S+)\s+rtadv\s+send\s+(.+?)\s*$`)
+	ipv6RTADVInterfacePattern = regexp.MustCompile(`^(lan|bridge)\d+$`)
+	ipv6RTADVDomainPattern    = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?)*$`)
+)
+
+// ParseIPv6RTADVs parses "ipv6 <interface> rtadv send" lines from the router configuration
+func ParseIPv6RTADVs(raw string) []IPv6RTADV {
+	var result []IPv6RTADV
+
+	for _, line := range strings.Split(preprocessWrappedLines(raw), "\n") {
+		matches := ipv6RTADVLinePattern.FindStringSubmatch(line)
+		if len(matches) < 3 {
+			continue
+		}
+
+		rtadv := IPv6RTADV{Interface: matches[1]}
+		for _, token := range strings.Fields(matches[2]) {
+			key, value, hasValue := strings.Cut(token, "=")
+			if !hasValue {
+				if id, err := strconv.Atoi(token); err == nil {
+					rtadv.PrefixIDs = append(rtadv.PrefixIDs, id)
+				}
+				continue
+			}
+
+			switch strings.ToLower(key) {
+			case "o_flag":
+				rtadv.OFlag = strings.ToLower(value) == "on"
+			case "m_flag":
+				rtadv.MFlag = strings.ToLower(value) == "on"
+			case "max-rtr-adv-interval":
+				rtadv.MaxInterval, _ = strconv.Atoi(value)
+			case "min-rtr-adv-interval":
+				rtadv.MinInterval, _ = strconv.Atoi(value)
+			case "lifetime":
+				rtadv.RouterLifetime, _ = strconv.Atoi(value)
+			case "mtu":
+				rtadv.MTU, _ = strconv.Atoi(value)
+			case "rdnss":
+				rtadv.RDNSS = strings.Split(value, ",")
+			case "dnssl":
+				rtadv.DNSSL = strings.Split(value, ",")
+			}
+		}
+
+		if len(rtadv.PrefixIDs) > 0 {
+			result = append(result, rtadv)
+		}
+	}
+
+	return result
+}
+
+// ParseIPv6RTADV parses the Router Advertisement settings of a single interface
+func ParseIPv6RTADV(raw, iface string) (*IPv6RTADV, error) {
+	for _, rtadv := range ParseIPv6RTADVs(raw) {
+		if rtadv.Interface == iface {
+			return &rtadv, nil
+		}
+	}
+	return nil, fmt.Errorf("router advertisement on %s not found", iface)
+}
+
+// BuildIPv6RTADVSendCommand builds the command to send Router Advertisements
+// Command format: ipv6 <interface> rtadv send <prefix_id>... o_flag=on|off m_flag=on|off
+// [max-rtr-adv-interval=<sec>] [min-rtr-adv-interval=<sec>] [lifetime=<sec>] [mtu=<size>]
+// [rdnss=<addr>[,<addr>...]] [dnssl=<domain>[,<domain>...]]
+func BuildIPv6RTADVSendCommand(rtadv IPv6RTADV) string {
+	parts := []string{"ipv6", rtadv.Interface, "rtadv", "send"}
+	for _, id := range rtadv.PrefixIDs {
+		parts = append(parts, strconv.Itoa(id))
+	}
+
+	oFlag, mFlag := "off", "off"
+	if rtadv.OFlag {
+		oFlag = "on"
+	}
+	if rtadv.MFlag {
+		mFlag = "on"
+	}
+	parts = append(parts, "o_flag="+oFlag, "m_flag="+mFlag)
+
+	if rtadv.MaxInterval > 0 {
+		parts = append(parts, fmt.Sprintf("max-rtr-adv-interval=%d", rtadv.MaxInterval))
+	}
+	if rtadv.MinInterval > 0 {
+		parts = append(parts, fmt.Sprintf("min-rtr-adv-interval=%d", rtadv.MinInterval))
+	}
+	if rtadv.RouterLifetime > 0 {
+		parts = append(parts, fmt.Sprintf("lifetime=%d", rtadv.RouterLifetime))
+	}
+	if rtadv.MTU > 0 {
+		parts = append(parts, fmt.Sprintf("mtu=%d", rtadv.MTU))
+	}
+	if len(rtadv.RDNSS) > 0 {
+		parts = append(parts, "rdnss="+strings.Join(rtadv.RDNSS, ","))
+	}
+	if len(rtadv.DNSSL) > 0 {
+		parts = append(parts, "dnssl="+strings.Join(rtadv.DNSSL, ","))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// BuildShowIPv6RTADVCommand builds the command to show the Router Advertisement settings
+func BuildShowIPv6RTADVCommand(iface string) string {
+	return fmt.Sprintf(`show config | grep "ipv6 %s rtadv"`, iface)
+}
+
+// ValidateIPv6RTADV validates Router Advertisement settings
+func ValidateIPv6RTADV(rtadv IPv6RTADV) error {
+	if !ipv6RTADVInterfacePattern.MatchString(rtadv.Interface) {
+		return fmt.Errorf("interface must be a LAN or bridge interface (e.g., lan1, bridge1), got %q", rtadv.Interface)
+	}
+	if len(rtadv.PrefixIDs) == 0 {
+		return fmt.Errorf("at least one prefix ID is required")
+	}
+	for _, id := range rtadv.PrefixIDs {
+		if id <= 0 {
+			return fmt.Errorf("prefix IDs must be positive, got %d", id)
+		}
+	}
+
+	// RFC 4861 limits
+	if rtadv.MaxInterval != 0 && (rtadv.MaxInterval < 4 || rtadv.MaxInterval > 1800) {
+		return fmt.Errorf("max interval must be between 4 and 1800 seconds")
+	}
+	if rtadv.MinInterval != 0 {
+		if rtadv.MinInterval < 3 {
+			return fmt.Errorf("min interval must be at least 3 seconds")
+		}
+		if rtadv.MaxInterval != 0 && rtadv.MinInterval*4 > rtadv.MaxInterval*3 {
+			return fmt.Errorf("min interval must not exceed 0.75 times the max interval")
+		}
+	}
+	if rtadv.RouterLifetime < 0 || rtadv.RouterLifetime > 9000 {
+		return fmt.Errorf("router lifetime must be between 0 and 9000 seconds")
+	}
+	if rtadv.MTU != 0 && (rtadv.MTU < 1280 || rtadv.MTU > 65535) {
+		return fmt.Errorf("MTU must be between 1280 and 65535")
+	}
+
+	for _, addr := range rtadv.RDNSS {
+		if ip := net.ParseIP(addr); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("invalid RDNSS address: %s", addr)
+		}
+	}
+	for _, domain := range rtadv.DNSSL {
+		if !ipv6RTADVDomainPattern.MatchString(domain) {
+			return fmt.Errorf("invalid DNSSL domain: %s", domain)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseIPv6RTADVs(t *testing.T) {
+	raw := `ipv6 lan1 address ra-prefix@lan2::1/64
+ipv6 lan1 rtadv send 1 2 o_flag=on m_flag=off max-rtr-adv-interval=600 min-rtr-adv-interval=200 lifetime=1800 mtu=1454 rdnss=2001:db8::53,2001:db8::54 dnssl=example.com,corp.example.com
+ipv6 bridge1 rtadv send 3 o_flag=off m_flag=off
+ipv6 lan2 dhcp service client`
+
+	want := []IPv6RTADV{
+		{
+			Interface:      "lan1",
+			PrefixIDs:      []int{1, 2},
+			OFlag:          true,
+			MaxInterval:    600,
+			MinInterval:    200,
+			RouterLifetime: 1800,
+			MTU:            1454,
+			RDNSS:          []string{"2001:db8::53", "2001:db8::54"},
+			DNSSL:          []string{"example.com", "corp.example.com"},
+		},
+		{Interface: "bridge1", PrefixIDs: []int{3}},
+	}
+
+	if got := ParseIPv6RTADVs(raw); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseIPv6RTADVs() = %+v, want %+v", got, want)
+	}
+
+	if _, err := ParseIPv6RTADV(raw, "lan2"); err == nil {
+		t.Error("ParseIPv6RTADV() expected not found error for lan2")
+	}
+}
+
+func TestBuildIPv6RTADVSendCommand(t *testing.T) {
+	tests := []struct {
+		name  string
+		rtadv IPv6RTADV
+		want  string
+	}{
+		{
+			name:  "flags only",
+			rtadv: IPv6RTADV{Interface: "lan1", PrefixIDs: []int{1}},
+			want:  "ipv6 lan1 rtadv send 1 o_flag=off m_flag=off",
+		},
+		{
+			name: "all options",
+			rtadv: IPv6RTADV{
+				Interface:      "lan1",
+				PrefixIDs:      []int{1, 2},
+				OFlag:          true,
+				MaxInterval:    600,
+				MinInterval:    200,
+				RouterLifetime: 1800,
+				MTU:            1454,
+				RDNSS:          []string{"2001:db8::53"},
+				DNSSL:          []string{"example.com", "example.net"},
+			},
+			want: "ipv6 lan1 rtadv send 1 2 o_flag=on m_flag=off max-rtr-adv-interval=600 min-rtr-adv-interval=200 lifetime=1800 mtu=1454 rdnss=2001:db8::53 dnssl=example.com,example.net",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildIPv6RTADVSendCommand(tt.rtadv)
+			if got != tt.want {
+				t.Errorf("BuildIPv6RTADVSendCommand() = %q, want %q", got, tt.want)
+			}
+
+			// The built command parses back to the same settings
+			parsed := ParseIPv6RTADVs(got)
+			if len(parsed) != 1 || !reflect.DeepEqual(parsed[0], tt.rtadv) {
+				t.Errorf("round trip = %+v, want %+v", parsed, tt.rtadv)
+			}
+		})
+	}
+}
+
+func TestValidateIPv6RTADV(t *testing.T) {
+	base := func() IPv6RTADV {
+		return IPv6RTADV{Interface: "lan1", PrefixIDs: []int{1}}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(*IPv6RTADV)
+		wantErr bool
+	}{
+		{name: "minimal", modify: func(r *IPv6RTADV) {}},
+		{name: "full", modify: func(r *IPv6RTADV) {
+			r.MaxInterval, r.MinInterval, r.RouterLifetime, r.MTU = 600, 200, 1800, 1500
+			r.RDNSS = []string{"2001:db8::53"}
+			r.DNSSL = []string{"example.com"}
+		}},
+		{name: "pp interface", modify: func(r *IPv6RTADV) { r.Interface = "pp1" }, wantErr: true},
+		{name: "no prefix", modify: func(r *IPv6RTADV) { r.PrefixIDs = nil }, wantErr: true},
+		{name: "max interval too small", modify: func(r *IPv6RTADV) { r.MaxInterval = 3 }, wantErr: true},
+		{name: "min interval above 0.75 max", modify: func(r *IPv6RTADV) { r.MaxInterval, r.MinInterval = 100, 80 }, wantErr: true},
+		{name: "lifetime too large", modify: func(r *IPv6RTADV) { r.RouterLifetime = 9001 }, wantErr: true},
+		{name: "mtu too small", modify: func(r *IPv6RTADV) { r.MTU = 1000 }, wantErr: true},
+		{name: "IPv4 RDNSS", modify: func(r *IPv6RTADV) { r.RDNSS = []string{"192.0.2.53"} }, wantErr: true},
+		{name: "invalid DNSSL", modify: func(r *IPv6RTADV) { r.DNSSL = []string{"bad domain"} }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rtadv := base()
+			tt.modify(&rtadv)
+			err := ValidateIPv6RTADV(rtadv)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateIPv6RTADV() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
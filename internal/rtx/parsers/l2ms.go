@@ -0,0 +1,259 @@
+package parsers
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// L2MSManagedSwitch represents the settings pushed to a Yamaha switch managed
+// through L2MS (Layer 2 Management Service)
+type L2MSManagedSwitch struct {
+	Interface  string           `json:"interface"`             // Router interface acting as L2MS manager
+	Switch     string           `json:"switch"`                // Switch MAC address or route (e.g., lan1:1)
+	SystemName string           `json:"system_name,omitempty"` // Switch system name
+	Ports      []L2MSSwitchPort `json:"ports,omitempty"`       // Per-port settings differing from defaults
+}
+
+// L2MSSwitchPort represents the settings of one port of a managed switch
+type L2MSSwitchPort struct {
+	Port       int  `json:"port"`                  // Port number on the switch
+	Enabled    bool `json:"enabled"`               // port-use on/off
+	AccessVLAN int  `json:"access_vlan,omitempty"` // Access VLAN ID (0 = switch default)
+}
+
+// L2MSSwitchStatus represents a switch discovered by the L2MS manager
+type L2MSSwitchStatus struct {
+	MACAddress string `json:"mac_address"` // Switch MAC address
+	Model      string `json:"model"`       // Switch model (e.g., SWX2200-8G)
+	Route      string `json:"route"`       // Route from the router (e.g., lan1:1)
+	Name       string `json:"name"`        // Switch system name
+}
+
+var (
+	l2msControlUsePattern      = regexp.MustCompile(`^\s*switch\s+control\s+use\s+(\S+)\s+on\b`)
+	l2msSelectPattern          = regexp.MustCompile(`^\s*switch\s+select\s+(\S+)\s*$`)
+	l2msFunctionPattern        = regexp.MustCompile(`^\s*switch\s+control\s+function\s+set\s+(\S+)\s+(.+?)\s*$`)
+	l2msInterfacePattern       = regexp.MustCompile(`^(lan|bridge)\d+$`)
+	l2msRoutePattern           = regexp.MustCompile(`^(lan|bridge)\d+(:\d+)+$`)
+	l2msStatusSwitchPattern    = regexp.MustCompile(`(?i)^\s*(?:switch|mac\s*address)\s*:\s*([0-9a-f]{2}(?::[0-9a-f]{2}){5})\s*$`)
+	l2msStatusAttributePattern = regexp.MustCompile(`^\s*([A-Za-z ]+?)\s*:\s*(.*?)\s*$`)
+)
+
+// ParseL2MSControlInterfaces parses the interfaces with "switch control use <interface> on"
+func ParseL2MSControlInterfaces(raw string) []string {
+	var interfaces []string
+	for _, line := range strings.Split(raw, "\n") {
+		if matches := l2msControlUsePattern.FindStringSubmatch(line); len(matches) == 2 {
+			interfaces = append(interfaces, matches[1])
+		}
+	}
+	return interfaces
+}
+
+// ParseL2MSSwitchSettings parses the "switch control function set" settings
+// configured under each "switch select" context, keyed by switch identifier.
+// Interface is not part of the switch context and is left empty.
+func ParseL2MSSwitchSettings(raw string) map[string]*L2MSManagedSwitch {
+	switches := make(map[string]*L2MSManagedSwitch)
+	var current *L2MSManagedSwitch
+
+	for _, line := range strings.Split(raw, "\n") {
+		if matches := l2msSelectPattern.FindStringSubmatch(line); len(matches) == 2 {
+			if matches[1] == "none" {
+				current = nil
+				continue
+			}
+			id := strings.ToLower(matches[1])
+			if switches[id] == nil {
+				switches[id] = &L2MSManagedSwitch{Switch: id}
+			}
+			current = switches[id]
+			continue
+		}
+
+		matches := l2msFunctionPattern.FindStringSubmatch(line)
+		if current == nil || len(matches) < 3 {
+			continue
+		}
+		args := strings.Fields(matches[2])
+
+		switch matches[1] {
+		case "system-name":
+			current.SystemName = strings.Trim(matches[2], `"`)
+		case "port-use":
+			if len(args) == 2 {
+				if port, err := strconv.Atoi(args[0]); err == nil {
+					l2msPort(current, port).Enabled = args[1] == "on"
+				}
+			}
+		case "port-access-vlan":
+			if len(args) == 2 {
+				port, err := strconv.Atoi(args[0])
+				vlan, vlanErr := strconv.Atoi(args[1])
+				if err == nil && vlanErr == nil {
+					l2msPort(current, port).AccessVLAN = vlan
+				}
+			}
+		}
+	}
+
+	for _, sw := range switches {
+		sort.Slice(sw.Ports, func(i, j int) bool { return sw.Ports[i].Port < sw.Ports[j].Port })
+	}
+
+	return switches
+}
+
+// FindL2MSManagedSwitch returns the settings of a switch managed through the
+// given interface, or nil if the L2MS manager is not enabled on the interface.
+// A switch without settings is returned with defaults only.
+func FindL2MSManagedSwitch(raw, iface, sw string) *L2MSManagedSwitch {
+	if !slices.Contains(ParseL2MSControlInterfaces(raw), iface) {
+		return nil
+	}
+
+	id := strings.ToLower(sw)
+	result := &L2MSManagedSwitch{Switch: id}
+	if settings, ok := ParseL2MSSwitchSettings(raw)[id]; ok {
+		result = settings
+	}
+	result.Interface = iface
+	return result
+}
+
+// l2msPort returns the settings of a port, adding it with defaults if missing
+func l2msPort(sw *L2MSManagedSwitch, port int) *L2MSSwitchPort {
+	for i := range sw.Ports {
+		if sw.Ports[i].Port == port {
+			return &sw.Ports[i]
+		}
+	}
+	sw.Ports = append(sw.Ports, L2MSSwitchPort{Port: port, Enabled: true})
+	return &sw.Ports[len(sw.Ports)-1]
+}
+
+// ParseL2MSSwitchStatus parses the switches listed by "show status switch control"
+func ParseL2MSSwitchStatus(raw string) []L2MSSwitchStatus {
+	var switches []L2MSSwitchStatus
+	var current *L2MSSwitchStatus
+
+	for _, line := range strings.Split(raw, "\n") {
+		if matches := l2msStatusSwitchPattern.FindStringSubmatch(line); len(matches) == 2 {
+			switches = append(switches, L2MSSwitchStatus{MACAddress: strings.ToLower(matches[1])})
+			current = &switches[len(switches)-1]
+			continue
+		}
+
+		matches := l2msStatusAttributePattern.FindStringSubmatch(line)
+		if current == nil || len(matches) < 3 {
+			continue
+		}
+
+		switch strings.ToLower(matches[1]) {
+		case "model":
+			current.Model = matches[2]
+		case "route":
+			current.Route = matches[2]
+		case "name", "system name":
+			current.Name = matches[2]
+		}
+	}
+
+	return switches
+}
+
+// BuildL2MSControlUseCommand builds the command to enable the L2MS manager on an interface
+// Command format: switch control use <interface> on
+func BuildL2MSControlUseCommand(iface string) string {
+	return fmt.Sprintf("switch control use %s on", iface)
+}
+
+// BuildL2MSSelectCommand builds the command to select a managed switch
+// Command format: switch select <mac_address|route|none>
+func BuildL2MSSelectCommand(sw string) string {
+	return fmt.Sprintf("switch select %s", sw)
+}
+
+// BuildL2MSSwitchCommands builds the commands to push settings to a managed switch.
+// Ports are only emitted for non-default settings.
+func BuildL2MSSwitchCommands(sw L2MSManagedSwitch) []string {
+	commands := []string{BuildL2MSSelectCommand(sw.Switch)}
+
+	if sw.SystemName != "" {
+		commands = append(commands, fmt.Sprintf("switch control function set system-name %s", sw.SystemName))
+	}
+	for _, port := range sw.Ports {
+		if !port.Enabled {
+			commands = append(commands, fmt.Sprintf("switch control function set port-use %d off", port.Port))
+		}
+		if port.AccessVLAN > 0 {
+			commands = append(commands, fmt.Sprintf("switch control function set port-access-vlan %d %d", port.Port, port.AccessVLAN))
+		}
+	}
+
+	return append(commands, BuildL2MSSelectCommand("none"))
+}
+
+// BuildDeleteL2MSSwitchCommands builds the commands to reset the given settings
+// of a managed switch to their defaults
+func BuildDeleteL2MSSwitchCommands(sw L2MSManagedSwitch) []string {
+	commands := []string{BuildL2MSSelectCommand(sw.Switch)}
+
+	if sw.SystemName != "" {
+		commands = append(commands, "no switch control function set system-name")
+	}
+	for _, port := range sw.Ports {
+		if !port.Enabled {
+			commands = append(commands, fmt.Sprintf("no switch control function set port-use %d", port.Port))
+		}
+		if port.AccessVLAN > 0 {
+			commands = append(commands, fmt.Sprintf("no switch control function set port-access-vlan %d", port.Port))
+		}
+	}
+
+	return append(commands, BuildL2MSSelectCommand("none"))
+}
+
+// BuildShowL2MSConfigCommand builds the command to show the L2MS configuration
+func BuildShowL2MSConfigCommand() string {
+	return `show config | grep "switch "`
+}
+
+// BuildShowL2MSStatusCommand builds the command to list switches discovered by L2MS
+func BuildShowL2MSStatusCommand() string {
+	return "show status switch control"
+}
+
+// ValidateL2MSManagedSwitch validates the settings of a managed switch
+func ValidateL2MSManagedSwitch(sw L2MSManagedSwitch) error {
+	if !l2msInterfacePattern.MatchString(sw.Interface) {
+		return fmt.Errorf("interface must be a LAN or bridge interface (e.g., lan1, bridge1), got %q", sw.Interface)
+	}
+	if _, err := net.ParseMAC(sw.Switch); err != nil && !l2msRoutePattern.MatchString(sw.Switch) {
+		return fmt.Errorf("switch must be a MAC address (e.g., 00:a0:de:01:02:03) or a route (e.g., lan1:1), got %q", sw.Switch)
+	}
+	if strings.ContainsAny(sw.SystemName, " \t\"") {
+		return fmt.Errorf("system_name must not contain whitespace or quotes, got %q", sw.SystemName)
+	}
+
+	seen := make(map[int]bool, len(sw.Ports))
+	for _, port := range sw.Ports {
+		if port.Port < 1 {
+			return fmt.Errorf("port must be 1 or greater, got %d", port.Port)
+		}
+		if seen[port.Port] {
+			return fmt.Errorf("duplicate settings for port %d", port.Port)
+		}
+		seen[port.Port] = true
+		if port.AccessVLAN != 0 && (port.AccessVLAN < 1 || port.AccessVLAN > 4094) {
+			return fmt.Errorf("access VLAN of port %d must be between 1 and 4094, got %d", port.Port, port.AccessVLAN)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testL2MSConfig = `switch control use lan1 on
+switch control use bridge1 on terminal=on
+switch control watch interval 2 5
+switch select 00:A0:DE:01:02:03
+ switch control function set system-name floor1
+ switch control function set port-use 3 off
+ switch control function set port-access-vlan 2 10
+switch select lan1:2
+ switch control function set port-access-vlan 5 20
+switch select none
+switch control function set system-name ignored`
+
+func TestParseL2MSControlInterfaces(t *testing.T) {
+	want := []string{"lan1", "bridge1"}
+	if got := ParseL2MSControlInterfaces(testL2MSConfig); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseL2MSControlInterfaces() = %v, want %v", got, want)
+	}
+}
+
+func TestParseL2MSSwitchSettings(t *testing.T) {
+	want := map[string]*L2MSManagedSwitch{
+		"00:a0:de:01:02:03": {
+			Switch:     "00:a0:de:01:02:03",
+			SystemName: "floor1",
+			Ports: []L2MSSwitchPort{
+				{Port: 2, Enabled: true, AccessVLAN: 10},
+				{Port: 3, Enabled: false},
+			},
+		},
+		"lan1:2": {
+			Switch: "lan1:2",
+			Ports:  []L2MSSwitchPort{{Port: 5, Enabled: true, AccessVLAN: 20}},
+		},
+	}
+
+	if got := ParseL2MSSwitchSettings(testL2MSConfig); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseL2MSSwitchSettings() = %+v, want %+v", got, want)
+	}
+}
+
+func TestFindL2MSManagedSwitch(t *testing.T) {
+	got := FindL2MSManagedSwitch(testL2MSConfig, "lan1", "00:A0:DE:01:02:03")
+	if got == nil || got.Interface != "lan1" || got.SystemName != "floor1" || len(got.Ports) != 2 {
+		t.Errorf("FindL2MSManagedSwitch() = %+v, want floor1 settings on lan1", got)
+	}
+
+	// A switch without settings is still managed while the manager is enabled
+	want := &L2MSManagedSwitch{Interface: "bridge1", Switch: "lan1:1"}
+	if got := FindL2MSManagedSwitch(testL2MSConfig, "bridge1", "lan1:1"); !reflect.DeepEqual(got, want) {
+		t.Errorf("FindL2MSManagedSwitch() = %+v, want %+v", got, want)
+	}
+
+	if got := FindL2MSManagedSwitch(testL2MSConfig, "lan2", "lan1:1"); got != nil {
+		t.Errorf("FindL2MSManagedSwitch() = %+v, want nil when the manager is disabled", got)
+	}
+}
+
+func TestParseL2MSSwitchStatus(t *testing.T) {
+	raw := `Switch control: enable
+Switch: 00:a0:de:01:02:03
+  Model: SWX2200-8G
+  Route: lan1:1
+  Name: floor1
+Switch: 00:a0:de:04:05:06
+  Model: SWX2210-16G
+  Route: lan1:1:3
+`
+
+	want := []L2MSSwitchStatus{
+		{MACAddress: "00:a0:de:01:02:03", Model: "SWX2200-8G", Route: "lan1:1", Name: "floor1"},
+		{MACAddress: "00:a0:de:04:05:06", Model: "SWX2210-16G", Route: "lan1:1:3"},
+	}
+
+	if got := ParseL2MSSwitchStatus(raw); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseL2MSSwitchStatus() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildL2MSSwitchCommands(t *testing.T) {
+	sw := L2MSManagedSwitch{
+		Interface:  "lan1",
+		Switch:     "00:a0:de:01:02:03",
+		SystemName: "floor1",
+		Ports: []L2MSSwitchPort{
+			{Port: 1, Enabled: true},
+			{Port: 2, Enabled: true, AccessVLAN: 10},
+			{Port: 3, Enabled: false},
+		},
+	}
+
+	want := []string{
+		"switch select 00:a0:de:01:02:03",
+		"switch control function set system-name floor1",
+		"switch control function set port-access-vlan 2 10",
+		"switch control function set port-use 3 off",
+		"switch select none",
+	}
+	if got := BuildL2MSSwitchCommands(sw); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildL2MSSwitchCommands() = %v, want %v", got, want)
+	}
+
+	wantDelete := []string{
+		"switch select 00:a0:de:01:02:03",
+		"no switch control function set system-name",
+		"no switch control function set port-access-vlan 2",
+		"no switch control function set port-use 3",
+		"switch select none",
+	}
+	if got := BuildDeleteL2MSSwitchCommands(sw); !reflect.DeepEqual(got, wantDelete) {
+		t.Errorf("BuildDeleteL2MSSwitchCommands() = %v, want %v", got, wantDelete)
+	}
+}
+
+func TestValidateL2MSManagedSwitch(t *testing.T) {
+	tests := []struct {
+		name    string
+		sw      L2MSManagedSwitch
+		wantErr bool
+	}{
+		{name: "by MAC address", sw: L2MSManagedSwitch{Interface: "lan1", Switch: "00:a0:de:01:02:03", SystemName: "floor1"}},
+		{name: "by route", sw: L2MSManagedSwitch{Interface: "bridge1", Switch: "lan1:1:3", Ports: []L2MSSwitchPort{{Port: 1, Enabled: true, AccessVLAN: 10}}}},
+		{name: "pp interface", sw: L2MSManagedSwitch{Interface: "pp1", Switch: "00:a0:de:01:02:03"}, wantErr: true},
+		{name: "invalid switch", sw: L2MSManagedSwitch{Interface: "lan1", Switch: "switch1"}, wantErr: true},
+		{name: "name with space", sw: L2MSManagedSwitch{Interface: "lan1", Switch: "lan1:1", SystemName: "floor 1"}, wantErr: true},
+		{name: "duplicate port", sw: L2MSManagedSwitch{Interface: "lan1", Switch: "lan1:1", Ports: []L2MSSwitchPort{{Port: 1}, {Port: 1}}}, wantErr: true},
+		{name: "VLAN out of range", sw: L2MSManagedSwitch{Interface: "lan1", Switch: "lan1:1", Ports: []L2MSSwitchPort{{Port: 1, AccessVLAN: 4095}}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateL2MSManagedSwitch(tt.sw)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateL2MSManagedSwitch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
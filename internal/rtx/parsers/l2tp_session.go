@@ -0,0 +1,82 @@
+package parsers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// L2TPSession represents a connected L2TP session as reported by "show status l2tp"
+type L2TPSession struct {
+	TunnelID        int    `json:"tunnel_id"`                  // TUNNEL[n] index
+	SessionID       int    `json:"session_id"`                 // Local session ID
+	State           string `json:"state"`                      // Session state (established, etc.)
+	Username        string `json:"username,omitempty"`         // Authenticated user name
+	AssignedAddress string `json:"assigned_address,omitempty"` // Address assigned to the client
+	RemoteAddress   string `json:"remote_address,omitempty"`   // Public address of the client (tunnel peer)
+	Version         string `json:"version,omitempty"`          // L2TPv2 or L2TPv3
+	UptimeSeconds   int    `json:"uptime_seconds"`             // Time connected in seconds
+}
+
+var (
+	l2tpStatusTunnelPattern    = regexp.MustCompile(`^\s*TUNNEL\[(\d+)\]`)
+	l2tpStatusSessionPattern   = regexp.MustCompile(`^\s*SESSION\[(\d+)\]`)
+	l2tpStatusVersionPattern   = regexp.MustCompile(`(?i)^\s*(?:version|バージョン)\s*:\s*(\S+)`)
+	l2tpStatusRemotePattern    = regexp.MustCompile(`(?i)^\s*(?:remote\s+ip\s+address|相手側IPアドレス)\s*:\s*(\S+)`)
+	l2tpStatusStatePattern     = regexp.MustCompile(`(?i)^\s*(?:session\s+state|セッションの状態)\s*:\s*(\S+)`)
+	l2tpStatusSessionIDPattern = regexp.MustCompile(`(?i)^\s*(?:local\s+session\s+id|自機側セッションID)\s*:\s*(\d+)`)
+	l2tpStatusUserPattern      = regexp.MustCompile(`(?i)^\s*(?:user\s*name|username|認証ユーザ名|ユーザ名)\s*:\s*(\S+)`)
+	l2tpStatusAssignedPattern  = regexp.MustCompile(`(?i)^\s*(?:assigned\s+ip\s+address|割り当てIPアドレス|割り当てたIPアドレス)\s*:\s*(\S+)`)
+	l2tpStatusUptimePattern    = regexp.MustCompile(`(?i)^\s*(?:time\s+connected|通信時間)\s*:\s*(.+?)\s*$`)
+)
+
+// ParseL2TPSessions parses the sessions of "show status l2tp". Tunnel-level
+// values (version, remote address) are copied to each session of the tunnel.
+func ParseL2TPSessions(raw string) []L2TPSession {
+	sessions := []L2TPSession{}
+	var tunnel L2TPSession
+	var current *L2TPSession
+
+	for _, line := range strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n") {
+		if matches := l2tpStatusTunnelPattern.FindStringSubmatch(line); len(matches) == 2 {
+			tunnel = L2TPSession{}
+			tunnel.TunnelID, _ = strconv.Atoi(matches[1])
+			current = nil
+			continue
+		}
+		if matches := l2tpStatusSessionPattern.FindStringSubmatch(line); len(matches) == 2 {
+			sessions = append(sessions, tunnel)
+			current = &sessions[len(sessions)-1]
+			continue
+		}
+
+		if current == nil {
+			if matches := l2tpStatusVersionPattern.FindStringSubmatch(line); len(matches) == 2 {
+				tunnel.Version = matches[1]
+			} else if matches := l2tpStatusRemotePattern.FindStringSubmatch(line); len(matches) == 2 {
+				tunnel.RemoteAddress = matches[1]
+			}
+			continue
+		}
+
+		switch {
+		case l2tpStatusStatePattern.MatchString(line):
+			current.State = strings.ToLower(l2tpStatusStatePattern.FindStringSubmatch(line)[1])
+		case l2tpStatusSessionIDPattern.MatchString(line):
+			current.SessionID, _ = strconv.Atoi(l2tpStatusSessionIDPattern.FindStringSubmatch(line)[1])
+		case l2tpStatusUserPattern.MatchString(line):
+			current.Username = l2tpStatusUserPattern.FindStringSubmatch(line)[1]
+		case l2tpStatusAssignedPattern.MatchString(line):
+			current.AssignedAddress = l2tpStatusAssignedPattern.FindStringSubmatch(line)[1]
+		case l2tpStatusUptimePattern.MatchString(line):
+			current.UptimeSeconds = parsePPStatusDuration(l2tpStatusUptimePattern.FindStringSubmatch(line)[1])
+		}
+	}
+
+	return sessions
+}
+
+// BuildShowL2TPStatusCommand builds the command to show L2TP tunnels and sessions
+func BuildShowL2TPStatusCommand() string {
+	return "show status l2tp"
+}
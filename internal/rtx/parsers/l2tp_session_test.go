@@ -0,0 +1,81 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseL2TPSessions(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want []L2TPSession
+	}{
+		{
+			name: "English output",
+			raw: `L2TP tunnel count: 2, L2TP session count: 2
+
+TUNNEL[1]:
+  Tunnel state: established
+  Version: L2TPv2
+  Local tunnel ID: 12345
+  Remote tunnel ID: 1
+  Local IP address: 203.0.113.1
+  Remote IP address: 198.51.100.10
+  Session count: 1
+  SESSION[1]:
+    Session state: established
+    Local session ID: 345
+    Remote session ID: 1
+    Username: alice
+    Assigned IP address: 192.168.100.200
+    Time connected: 1:23:45
+
+TUNNEL[2]:
+  Version: L2TPv2
+  Remote IP address: 198.51.100.20
+  SESSION[1]:
+    Session state: established
+    Local session ID: 346
+    Username: bob
+    Assigned IP address: 192.168.100.201
+    Time connected: 2days 00:00:10
+`,
+			want: []L2TPSession{
+				{TunnelID: 1, SessionID: 345, State: "established", Username: "alice", AssignedAddress: "192.168.100.200", RemoteAddress: "198.51.100.10", Version: "L2TPv2", UptimeSeconds: 5025},
+				{TunnelID: 2, SessionID: 346, State: "established", Username: "bob", AssignedAddress: "192.168.100.201", RemoteAddress: "198.51.100.20", Version: "L2TPv2", UptimeSeconds: 172810},
+			},
+		},
+		{
+			name: "Japanese output",
+			raw: `TUNNEL[1]:
+トンネルの状態: established
+バージョン: L2TPv2
+相手側IPアドレス: 198.51.100.10
+ SESSION[1]:
+  セッションの状態: established
+  自機側セッションID: 345
+  認証ユーザ名: alice
+  割り当てIPアドレス: 192.168.100.200
+  通信時間: 5分10秒
+`,
+			want: []L2TPSession{
+				{TunnelID: 1, SessionID: 345, State: "established", Username: "alice", AssignedAddress: "192.168.100.200", RemoteAddress: "198.51.100.10", Version: "L2TPv2", UptimeSeconds: 310},
+			},
+		},
+		{
+			name: "no sessions",
+			raw:  "L2TP tunnel count: 0, L2TP session count: 0\n",
+			want: []L2TPSession{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseL2TPSessions(tt.raw)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseL2TPSessions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
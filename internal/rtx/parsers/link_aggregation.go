@@ -0,0 +1,114 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// LinkAggregation represents a LAN link aggregation group bundling several
+// physical LAN interfaces into one logical link
+type LinkAggregation struct {
+	ID      int      `json:"id"`      // Link aggregation group ID
+	Mode    string   `json:"mode"`    // "static" or "lacp"
+	Members []string `json:"members"` // Member LAN interfaces (lan1, lan2, ...)
+}
+
+// ValidLinkAggregationModes lists the supported link aggregation modes
+var ValidLinkAggregationModes = []string{"static", "lacp"}
+
+var (
+	linkAggregationPattern       = regexp.MustCompile(`^\s*lan\s+link-aggregation\s+(static|lacp)\s+(\d+)\s+(.+?)\s*$`)
+	linkAggregationMemberPattern = regexp.MustCompile(`^lan(\d+)$`)
+)
+
+// ParseLinkAggregations parses "lan link-aggregation" entries from the router configuration
+func ParseLinkAggregations(raw string) []LinkAggregation {
+	var groups []LinkAggregation
+
+	for _, line := range strings.Split(raw, "\n") {
+		matches := linkAggregationPattern.FindStringSubmatch(line)
+		if len(matches) < 4 {
+			continue
+		}
+
+		id, err := strconv.Atoi(matches[2])
+		if err != nil {
+			continue
+		}
+
+		groups = append(groups, LinkAggregation{
+			ID:      id,
+			Mode:    matches[1],
+			Members: strings.Fields(matches[3]),
+		})
+	}
+
+	return groups
+}
+
+// BuildLinkAggregationCommand builds the command to create a link aggregation group
+// Command format: lan link-aggregation <static|lacp> <id> <interface> <interface> [...]
+func BuildLinkAggregationCommand(lag LinkAggregation) string {
+	return fmt.Sprintf("lan link-aggregation %s %d %s", lag.Mode, lag.ID, strings.Join(lag.Members, " "))
+}
+
+// BuildDeleteLinkAggregationCommand builds the command to remove a link aggregation group
+// Command format: no lan link-aggregation <static|lacp> <id>
+func BuildDeleteLinkAggregationCommand(mode string, id int) string {
+	return fmt.Sprintf("no lan link-aggregation %s %d", mode, id)
+}
+
+// BuildShowLinkAggregationsCommand builds the command to show link aggregation groups
+func BuildShowLinkAggregationsCommand() string {
+	return `show config | grep "lan link-aggregation"`
+}
+
+// ValidateLinkAggregation validates a link aggregation group independently of the router model
+func ValidateLinkAggregation(lag LinkAggregation) error {
+	if lag.ID < 1 || lag.ID > 8 {
+		return fmt.Errorf("link aggregation ID must be between 1 and 8, got %d", lag.ID)
+	}
+	if !slices.Contains(ValidLinkAggregationModes, lag.Mode) {
+		return fmt.Errorf("mode must be one of %v, got %q", ValidLinkAggregationModes, lag.Mode)
+	}
+	if len(lag.Members) < 2 {
+		return fmt.Errorf("at least two member interfaces are required")
+	}
+
+	seen := make(map[string]bool, len(lag.Members))
+	for _, member := range lag.Members {
+		if !linkAggregationMemberPattern.MatchString(member) {
+			return fmt.Errorf("member must be a LAN interface (e.g., lan2), got %q", member)
+		}
+		if seen[member] {
+			return fmt.Errorf("duplicate member interface %s", member)
+		}
+		seen[member] = true
+	}
+
+	return nil
+}
+
+// ValidateLinkAggregationForModel validates that the router model supports link
+// aggregation and that all member interfaces exist on it
+func ValidateLinkAggregationForModel(lag LinkAggregation, model string) error {
+	if !IsModelSupported("link_aggregation", model) {
+		return fmt.Errorf("link aggregation is not supported on %s (supported models: %v)", model, GetSupportedModels("link_aggregation"))
+	}
+
+	count := LANInterfaceCount(model)
+	for _, member := range lag.Members {
+		matches := linkAggregationMemberPattern.FindStringSubmatch(member)
+		if len(matches) < 2 {
+			return fmt.Errorf("member must be a LAN interface (e.g., lan2), got %q", member)
+		}
+		if num, _ := strconv.Atoi(matches[1]); num < 1 || num > count {
+			return fmt.Errorf("%s does not exist on %s (lan1-lan%d)", member, model, count)
+		}
+	}
+
+	return nil
+}
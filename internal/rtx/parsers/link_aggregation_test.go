@@ -0,0 +1,81 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLinkAggregations(t *testing.T) {
+	raw := `ip lan1 address 192.168.1.1/24
+lan link-aggregation static 1 lan2 lan3
+lan link-aggregation lacp 2 lan4 lan5 lan6`
+
+	want := []LinkAggregation{
+		{ID: 1, Mode: "static", Members: []string{"lan2", "lan3"}},
+		{ID: 2, Mode: "lacp", Members: []string{"lan4", "lan5", "lan6"}},
+	}
+
+	if got := ParseLinkAggregations(raw); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseLinkAggregations() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildLinkAggregationCommands(t *testing.T) {
+	lag := LinkAggregation{ID: 2, Mode: "lacp", Members: []string{"lan4", "lan5"}}
+
+	if got, want := BuildLinkAggregationCommand(lag), "lan link-aggregation lacp 2 lan4 lan5"; got != want {
+		t.Errorf("BuildLinkAggregationCommand() = %q, want %q", got, want)
+	}
+	if got, want := BuildDeleteLinkAggregationCommand("lacp", 2), "no lan link-aggregation lacp 2"; got != want {
+		t.Errorf("BuildDeleteLinkAggregationCommand() = %q, want %q", got, want)
+	}
+}
+
+func TestValidateLinkAggregation(t *testing.T) {
+	tests := []struct {
+		name    string
+		lag     LinkAggregation
+		wantErr bool
+	}{
+		{name: "static", lag: LinkAggregation{ID: 1, Mode: "static", Members: []string{"lan2", "lan3"}}},
+		{name: "lacp", lag: LinkAggregation{ID: 8, Mode: "lacp", Members: []string{"lan2", "lan3", "lan4"}}},
+		{name: "id out of range", lag: LinkAggregation{ID: 9, Mode: "static", Members: []string{"lan2", "lan3"}}, wantErr: true},
+		{name: "invalid mode", lag: LinkAggregation{ID: 1, Mode: "dynamic", Members: []string{"lan2", "lan3"}}, wantErr: true},
+		{name: "single member", lag: LinkAggregation{ID: 1, Mode: "static", Members: []string{"lan2"}}, wantErr: true},
+		{name: "duplicate member", lag: LinkAggregation{ID: 1, Mode: "static", Members: []string{"lan2", "lan2"}}, wantErr: true},
+		{name: "non-LAN member", lag: LinkAggregation{ID: 1, Mode: "static", Members: []string{"lan2", "pp1"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLinkAggregation(tt.lag)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLinkAggregation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateLinkAggregationForModel(t *testing.T) {
+	tests := []struct {
+		name    string
+		model   string
+		members []string
+		wantErr bool
+	}{
+		{name: "RTX1300 within range", model: "RTX1300", members: []string{"lan2", "lan3"}},
+		{name: "RTX1300 missing port", model: "RTX1300", members: []string{"lan3", "lan4"}, wantErr: true},
+		{name: "RTX5000 high port", model: "RTX5000", members: []string{"lan13", "lan14"}},
+		{name: "unsupported model", model: "RTX830", members: []string{"lan1", "lan2"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lag := LinkAggregation{ID: 1, Mode: "static", Members: tt.members}
+			err := ValidateLinkAggregationForModel(lag, tt.model)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLinkAggregationForModel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
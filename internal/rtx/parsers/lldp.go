@@ -0,0 +1,103 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// LLDPConfig represents the LLDP settings of the router
+type LLDPConfig struct {
+	Enabled bool       `json:"enabled"` // lldp use on/off
+	Ports   []LLDPPort `json:"ports"`   // Per-interface transmit/receive modes
+}
+
+// LLDPPort represents the LLDP agent mode of an interface
+type LLDPPort struct {
+	Interface string `json:"interface"` // LAN interface (lan1, lan2, ...)
+	Mode      string `json:"mode"`      // txrx, tx, rx, or disable
+}
+
+// ValidLLDPModes lists the accepted LLDP agent modes
+var ValidLLDPModes = []string{"txrx", "tx", "rx", "disable"}
+
+var (
+	lldpUsePattern       = regexp.MustCompile(`^\s*lldp\s+use\s+(on|off)\s*$`)
+	lldpAgentPattern     = regexp.MustCompile(`^\s*lldp\s+agent\s+(\S+)\s+mode\s+(\S+)\s*$`)
+	lldpInterfacePattern = regexp.MustCompile(`^lan\d+$`)
+)
+
+// ParseLLDPConfig parses "lldp" settings from the router configuration.
+// LLDP is disabled unless "lldp use on" is present.
+func ParseLLDPConfig(raw string) *LLDPConfig {
+	config := &LLDPConfig{}
+
+	for _, line := range strings.Split(raw, "\n") {
+		if matches := lldpUsePattern.FindStringSubmatch(line); len(matches) == 2 {
+			config.Enabled = matches[1] == "on"
+			continue
+		}
+		if matches := lldpAgentPattern.FindStringSubmatch(line); len(matches) == 3 {
+			config.Ports = append(config.Ports, LLDPPort{
+				Interface: matches[1],
+				Mode:      matches[2],
+			})
+		}
+	}
+
+	return config
+}
+
+// BuildLLDPCommands builds the commands to configure LLDP
+// Command format: lldp use <on|off>, lldp agent <interface> mode <mode>
+func BuildLLDPCommands(config LLDPConfig) []string {
+	enabled := "off"
+	if config.Enabled {
+		enabled = "on"
+	}
+
+	commands := []string{fmt.Sprintf("lldp use %s", enabled)}
+	for _, port := range config.Ports {
+		commands = append(commands, fmt.Sprintf("lldp agent %s mode %s", port.Interface, port.Mode))
+	}
+	return commands
+}
+
+// BuildDeleteLLDPPortCommand builds the command to restore the default mode of an interface
+// Command format: no lldp agent <interface> mode
+func BuildDeleteLLDPPortCommand(iface string) string {
+	return fmt.Sprintf("no lldp agent %s mode", iface)
+}
+
+// BuildDeleteLLDPCommands builds the commands to restore the default LLDP settings
+func BuildDeleteLLDPCommands(config LLDPConfig) []string {
+	var commands []string
+	for _, port := range config.Ports {
+		commands = append(commands, BuildDeleteLLDPPortCommand(port.Interface))
+	}
+	return append(commands, "no lldp use")
+}
+
+// BuildShowLLDPConfigCommand builds the command to show the LLDP settings
+func BuildShowLLDPConfigCommand() string {
+	return `show config | grep "lldp "`
+}
+
+// ValidateLLDPConfig validates the LLDP settings
+func ValidateLLDPConfig(config LLDPConfig) error {
+	seen := make(map[string]bool)
+	for _, port := range config.Ports {
+		if !lldpInterfacePattern.MatchString(port.Interface) {
+			return fmt.Errorf("interface must be a LAN interface (e.g., lan1), got %q", port.Interface)
+		}
+		if seen[port.Interface] {
+			return fmt.Errorf("duplicate interface %s", port.Interface)
+		}
+		seen[port.Interface] = true
+		if !slices.Contains(ValidLLDPModes, port.Mode) {
+			return fmt.Errorf("mode of %s must be one of %v, got %q", port.Interface, ValidLLDPModes, port.Mode)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLLDPConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want *LLDPConfig
+	}{
+		{
+			name: "defaults",
+			raw:  "ip lan1 address 192.168.1.1/24",
+			want: &LLDPConfig{},
+		},
+		{
+			name: "enabled with ports",
+			raw: `lldp use on
+lldp agent lan1 mode txrx
+lldp agent lan2 mode tx`,
+			want: &LLDPConfig{
+				Enabled: true,
+				Ports: []LLDPPort{
+					{Interface: "lan1", Mode: "txrx"},
+					{Interface: "lan2", Mode: "tx"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseLLDPConfig(tt.raw); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLLDPConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildLLDPCommands(t *testing.T) {
+	config := LLDPConfig{
+		Enabled: true,
+		Ports:   []LLDPPort{{Interface: "lan1", Mode: "txrx"}},
+	}
+
+	want := []string{"lldp use on", "lldp agent lan1 mode txrx"}
+	if got := BuildLLDPCommands(config); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildLLDPCommands() = %v, want %v", got, want)
+	}
+
+	want = []string{"no lldp agent lan1 mode", "no lldp use"}
+	if got := BuildDeleteLLDPCommands(config); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildDeleteLLDPCommands() = %v, want %v", got, want)
+	}
+}
+
+func TestValidateLLDPConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  LLDPConfig
+		wantErr bool
+	}{
+		{name: "no ports", config: LLDPConfig{Enabled: true}},
+		{name: "valid", config: LLDPConfig{Ports: []LLDPPort{{Interface: "lan1", Mode: "rx"}, {Interface: "lan2", Mode: "disable"}}}},
+		{name: "not lan", config: LLDPConfig{Ports: []LLDPPort{{Interface: "pp1", Mode: "txrx"}}}, wantErr: true},
+		{name: "duplicate", config: LLDPConfig{Ports: []LLDPPort{{Interface: "lan1", Mode: "tx"}, {Interface: "lan1", Mode: "rx"}}}, wantErr: true},
+		{name: "invalid mode", config: LLDPConfig{Ports: []LLDPPort{{Interface: "lan1", Mode: "both"}}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLLDPConfig(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLLDPConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
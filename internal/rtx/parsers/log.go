@@ -0,0 +1,57 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// LogEntry represents a line of the router's internal log ("show log")
+type LogEntry struct {
+	Timestamp string `json:"timestamp"` // Time as printed by the router (YYYY/MM/DD HH:MM:SS)
+	Message   string `json:"message"`   // Log message
+}
+
+var logEntryPattern = regexp.MustCompile(`^(\d{4}/\d{2}/\d{2}\s+\d{2}:\d{2}:\d{2}):\s?(.*)$`)
+
+// ParseLogEntries parses the output of "show log" in chronological order.
+// Lines without a timestamp continue the message of the previous entry.
+func ParseLogEntries(raw string) []LogEntry {
+	entries := []LogEntry{}
+
+	for _, line := range strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n") {
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			continue
+		}
+		if matches := logEntryPattern.FindStringSubmatch(line); len(matches) == 3 {
+			entries = append(entries, LogEntry{
+				Timestamp: strings.Join(strings.Fields(matches[1]), " "),
+				Message:   matches[2],
+			})
+			continue
+		}
+		if len(entries) > 0 {
+			last := &entries[len(entries)-1]
+			last.Message = strings.TrimSpace(last.Message + " " + strings.TrimSpace(line))
+		}
+	}
+
+	return entries
+}
+
+// TailLogEntries returns the last n entries, or all entries when n is not positive
+func TailLogEntries(entries []LogEntry, n int) []LogEntry {
+	if n <= 0 || n >= len(entries) {
+		return entries
+	}
+	return entries[len(entries)-n:]
+}
+
+// BuildShowLogCommand builds the command to show the log, optionally filtered by a keyword
+func BuildShowLogCommand(keyword string) string {
+	if keyword == "" {
+		return "show log"
+	}
+	return fmt.Sprintf(`show log | grep "%s"`, keyword)
+}
@@ -0,0 +1,41 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLogEntries(t *testing.T) {
+	raw := `2026/10/16 07:49:49: PP[01] PPPoE Connect
+2026/10/16 07:49:50: PP[01] IPCP Opened
+2026/10/16 07:50:01: [IKE] initiate ISAKMP phase to 203.0.113.1
+   (local 192.0.2.1)
+`
+
+	want := []LogEntry{
+		{Timestamp: "2026/10/16 07:49:49", Message: "PP[01] PPPoE Connect"},
+		{Timestamp: "2026/10/16 07:49:50", Message: "PP[01] IPCP Opened"},
+		{Timestamp: "2026/10/16 07:50:01", Message: "[IKE] initiate ISAKMP phase to 203.0.113.1 (local 192.0.2.1)"},
+	}
+
+	got := ParseLogEntries(raw)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseLogEntries() = %+v, want %+v", got, want)
+	}
+
+	if tail := TailLogEntries(got, 1); !reflect.DeepEqual(tail, want[2:]) {
+		t.Errorf("TailLogEntries(1) = %+v, want %+v", tail, want[2:])
+	}
+	if tail := TailLogEntries(got, 0); len(tail) != 3 {
+		t.Errorf("TailLogEntries(0) returned %d entries, want 3", len(tail))
+	}
+}
+
+func TestBuildShowLogCommand(t *testing.T) {
+	if got, want := BuildShowLogCommand(""), "show log"; got != want {
+		t.Errorf("BuildShowLogCommand() = %q, want %q", got, want)
+	}
+	if got, want := BuildShowLogCommand("PPPoE"), `show log | grep "PPPoE"`; got != want {
+		t.Errorf("BuildShowLogCommand() = %q, want %q", got, want)
+	}
+}
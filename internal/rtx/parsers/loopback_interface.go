@@ -0,0 +1,67 @@
+package parsers
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+)
+
+// LoopbackInterface represents the address of a loopback interface, typically
+// used as a stable router ID or management address
+type LoopbackInterface struct {
+	Name    string `json:"name"`    // Loopback interface name (loopback1 - loopback9)
+	Address string `json:"address"` // IPv4 address in CIDR notation
+}
+
+var (
+	loopbackAddressPattern = regexp.MustCompile(`^\s*ip\s+(loopback\d+)\s+address\s+(\S+)\s*$`)
+	loopbackNamePattern    = regexp.MustCompile(`^loopback[1-9]$`)
+)
+
+// ParseLoopbackInterfaces parses "ip loopbackN address" entries from the router configuration
+func ParseLoopbackInterfaces(raw string) []LoopbackInterface {
+	var loopbacks []LoopbackInterface
+
+	for _, line := range strings.Split(raw, "\n") {
+		matches := loopbackAddressPattern.FindStringSubmatch(line)
+		if len(matches) < 3 {
+			continue
+		}
+		loopbacks = append(loopbacks, LoopbackInterface{
+			Name:    matches[1],
+			Address: matches[2],
+		})
+	}
+
+	return loopbacks
+}
+
+// BuildLoopbackAddressCommand builds the command to set the loopback address
+// Command format: ip <loopbackN> address <ip_address>/<prefix>
+func BuildLoopbackAddressCommand(loopback LoopbackInterface) string {
+	return fmt.Sprintf("ip %s address %s", loopback.Name, loopback.Address)
+}
+
+// BuildDeleteLoopbackAddressCommand builds the command to remove the loopback address
+// Command format: no ip <loopbackN> address
+func BuildDeleteLoopbackAddressCommand(name string) string {
+	return fmt.Sprintf("no ip %s address", name)
+}
+
+// BuildShowLoopbackInterfacesCommand builds the command to show loopback addresses
+func BuildShowLoopbackInterfacesCommand() string {
+	return `show config | grep "ip loopback"`
+}
+
+// ValidateLoopbackInterface validates a loopback interface address
+func ValidateLoopbackInterface(loopback LoopbackInterface) error {
+	if !loopbackNamePattern.MatchString(loopback.Name) {
+		return fmt.Errorf("name must be loopback1 - loopback9, got %q", loopback.Name)
+	}
+	ip, _, err := net.ParseCIDR(loopback.Address)
+	if err != nil || ip.To4() == nil {
+		return fmt.Errorf("address must be an IPv4 address in CIDR notation (e.g., 10.255.0.1/32), got %q", loopback.Address)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLoopbackInterfaces(t *testing.T) {
+	raw := `ip lan1 address 192.168.1.1/24
+ip loopback1 address 10.255.0.1/32
+ip loopback2 address 10.255.1.1/32
+ip loopback1 rip send off`
+
+	want := []LoopbackInterface{
+		{Name: "loopback1", Address: "10.255.0.1/32"},
+		{Name: "loopback2", Address: "10.255.1.1/32"},
+	}
+
+	if got := ParseLoopbackInterfaces(raw); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseLoopbackInterfaces() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildLoopbackAddressCommands(t *testing.T) {
+	loopback := LoopbackInterface{Name: "loopback1", Address: "10.255.0.1/32"}
+
+	if got, want := BuildLoopbackAddressCommand(loopback), "ip loopback1 address 10.255.0.1/32"; got != want {
+		t.Errorf("BuildLoopbackAddressCommand() = %q, want %q", got, want)
+	}
+	if got, want := BuildDeleteLoopbackAddressCommand("loopback1"), "no ip loopback1 address"; got != want {
+		t.Errorf("BuildDeleteLoopbackAddressCommand() = %q, want %q", got, want)
+	}
+}
+
+func TestValidateLoopbackInterface(t *testing.T) {
+	tests := []struct {
+		name     string
+		loopback LoopbackInterface
+		wantErr  bool
+	}{
+		{name: "host address", loopback: LoopbackInterface{Name: "loopback1", Address: "10.255.0.1/32"}},
+		{name: "subnet address", loopback: LoopbackInterface{Name: "loopback9", Address: "172.16.0.1/24"}},
+		{name: "out of range", loopback: LoopbackInterface{Name: "loopback10", Address: "10.255.0.1/32"}, wantErr: true},
+		{name: "not loopback", loopback: LoopbackInterface{Name: "lan1", Address: "10.255.0.1/32"}, wantErr: true},
+		{name: "missing prefix", loopback: LoopbackInterface{Name: "loopback1", Address: "10.255.0.1"}, wantErr: true},
+		{name: "IPv6 address", loopback: LoopbackInterface{Name: "loopback1", Address: "2001:db8::1/128"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLoopbackInterface(tt.loopback)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLoopbackInterface() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
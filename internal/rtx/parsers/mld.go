@@ -0,0 +1,270 @@
+package parsers
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// MLD interface modes
+const (
+	MLDModeOff    = "off"
+	MLDModeRouter = "router"
+	MLDModeHost   = "host"
+)
+
+// DefaultMLDVersion is the MLD version used by RTX routers when none is specified
+const DefaultMLDVersion = "2"
+
+// MLDInterface represents the MLD setting of a single interface
+// Command format: ipv6 <interface> mld <off|router|host> [version=<1|2>] [syslog=on]
+type MLDInterface struct {
+	Interface string `json:"interface"`
+	Mode      string `json:"mode"`              // off, router, host
+	Version   string `json:"version,omitempty"` // 1 or 2
+	Syslog    bool   `json:"syslog,omitempty"`
+}
+
+// MLDStaticJoin represents a statically joined IPv6 multicast group
+// Command format: ipv6 <interface> mld static <group> [<source>]
+type MLDStaticJoin struct {
+	Interface string `json:"interface"`
+	Group     string `json:"group"`
+	Source    string `json:"source,omitempty"`
+}
+
+// MLDConfig represents the MLD configuration of an RTX router
+type MLDConfig struct {
+	Interfaces  []MLDInterface  `json:"interfaces,omitempty"`
+	StaticJoins []MLDStaticJoin `json:"static_joins,omitempty"`
+}
+
+// MLDProxy represents an MLD proxy: one upstream (host) interface relaying
+// joins from one or more downstream (router) interfaces
+type MLDProxy struct {
+	UpstreamInterface    string          `json:"upstream_interface"`
+	DownstreamInterfaces []string        `json:"downstream_interfaces"`
+	Version              string          `json:"version,omitempty"`
+	Syslog               bool            `json:"syslog,omitempty"`
+	StaticJoins          []MLDStaticJoin `json:"static_joins,omitempty"`
+}
+
+var (
+	mldInterfacePattern  = regexp.MustCompile(`^\s*ipv6\s+(\S+)\s+mld\s+(off|router|host)((?:\s+\S+=\S+)*)\s*$`)
+	mldStaticJoinPattern = regexp.MustCompile(`^\s*ipv6\s+(\S+)\s+mld\s+static\s+(\S+)(?:\s+(\S+))?\s*$`)
+)
+
+// ParseMLDConfig parses MLD related lines from the router configuration
+func ParseMLDConfig(raw string) (*MLDConfig, error) {
+	config := &MLDConfig{
+		Interfaces:  []MLDInterface{},
+		StaticJoins: []MLDStaticJoin{},
+	}
+
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if matches := mldStaticJoinPattern.FindStringSubmatch(line); len(matches) >= 3 {
+			config.StaticJoins = append(config.StaticJoins, MLDStaticJoin{
+				Interface: matches[1],
+				Group:     matches[2],
+				Source:    matches[3],
+			})
+			continue
+		}
+
+		if matches := mldInterfacePattern.FindStringSubmatch(line); len(matches) >= 3 {
+			iface := MLDInterface{
+				Interface: matches[1],
+				Mode:      matches[2],
+				Version:   DefaultMLDVersion,
+			}
+			for _, opt := range strings.Fields(matches[3]) {
+				key, value, _ := strings.Cut(opt, "=")
+				switch key {
+				case "version":
+					iface.Version = value
+				case "syslog":
+					iface.Syslog = value == "on"
+				}
+			}
+			config.Interfaces = append(config.Interfaces, iface)
+		}
+	}
+
+	return config, nil
+}
+
+// MLDProxyFromConfig assembles the MLD proxy rooted at the given upstream
+// interface. Every interface in router mode is treated as a downstream.
+func MLDProxyFromConfig(config *MLDConfig, upstream string) (*MLDProxy, error) {
+	var upstreamIface *MLDInterface
+	for i := range config.Interfaces {
+		if config.Interfaces[i].Interface == upstream && config.Interfaces[i].Mode == MLDModeHost {
+			upstreamIface = &config.Interfaces[i]
+			break
+		}
+	}
+	if upstreamIface == nil {
+		return nil, fmt.Errorf("MLD proxy with upstream %s not found", upstream)
+	}
+
+	proxy := &MLDProxy{
+		UpstreamInterface:    upstream,
+		DownstreamInterfaces: []string{},
+		Version:              upstreamIface.Version,
+		Syslog:               upstreamIface.Syslog,
+		StaticJoins:          []MLDStaticJoin{},
+	}
+
+	for _, iface := range config.Interfaces {
+		if iface.Mode == MLDModeRouter {
+			proxy.DownstreamInterfaces = append(proxy.DownstreamInterfaces, iface.Interface)
+		}
+	}
+	sort.Strings(proxy.DownstreamInterfaces)
+
+	for _, join := range config.StaticJoins {
+		if join.Interface == upstream || slices.Contains(proxy.DownstreamInterfaces, join.Interface) {
+			proxy.StaticJoins = append(proxy.StaticJoins, join)
+		}
+	}
+
+	return proxy, nil
+}
+
+// BuildMLDInterfaceCommand builds the command to set the MLD mode of an interface
+// Command format: ipv6 <interface> mld <mode> [version=<version>] [syslog=on]
+func BuildMLDInterfaceCommand(iface MLDInterface) string {
+	cmd := fmt.Sprintf("ipv6 %s mld %s", iface.Interface, iface.Mode)
+	if iface.Mode == MLDModeOff {
+		return cmd
+	}
+	if iface.Version != "" {
+		cmd += fmt.Sprintf(" version=%s", iface.Version)
+	}
+	if iface.Syslog {
+		cmd += " syslog=on"
+	}
+	return cmd
+}
+
+// BuildDeleteMLDInterfaceCommand builds the command to remove the MLD setting of an interface
+// Command format: no ipv6 <interface> mld
+func BuildDeleteMLDInterfaceCommand(iface string) string {
+	return fmt.Sprintf("no ipv6 %s mld", iface)
+}
+
+// BuildMLDStaticJoinCommand builds the command to statically join a multicast group
+// Command format: ipv6 <interface> mld static <group> [<source>]
+func BuildMLDStaticJoinCommand(join MLDStaticJoin) string {
+	if join.Source != "" {
+		return fmt.Sprintf("ipv6 %s mld static %s %s", join.Interface, join.Group, join.Source)
+	}
+	return fmt.Sprintf("ipv6 %s mld static %s", join.Interface, join.Group)
+}
+
+// BuildDeleteMLDStaticJoinCommand builds the command to remove a static join
+// Command format: no ipv6 <interface> mld static <group> [<source>]
+func BuildDeleteMLDStaticJoinCommand(join MLDStaticJoin) string {
+	return "no " + BuildMLDStaticJoinCommand(join)
+}
+
+// BuildMLDProxyCommands builds all commands needed to configure an MLD proxy
+func BuildMLDProxyCommands(proxy MLDProxy) []string {
+	commands := []string{
+		BuildMLDInterfaceCommand(MLDInterface{
+			Interface: proxy.UpstreamInterface,
+			Mode:      MLDModeHost,
+			Version:   proxy.Version,
+			Syslog:    proxy.Syslog,
+		}),
+	}
+	for _, iface := range proxy.DownstreamInterfaces {
+		commands = append(commands, BuildMLDInterfaceCommand(MLDInterface{
+			Interface: iface,
+			Mode:      MLDModeRouter,
+			Version:   proxy.Version,
+			Syslog:    proxy.Syslog,
+		}))
+	}
+	for _, join := range proxy.StaticJoins {
+		commands = append(commands, BuildMLDStaticJoinCommand(join))
+	}
+	return commands
+}
+
+// BuildDeleteMLDProxyCommands builds the commands needed to remove an MLD proxy
+func BuildDeleteMLDProxyCommands(proxy MLDProxy) []string {
+	var commands []string
+	for _, join := range proxy.StaticJoins {
+		commands = append(commands, BuildDeleteMLDStaticJoinCommand(join))
+	}
+	for _, iface := range proxy.DownstreamInterfaces {
+		commands = append(commands, BuildDeleteMLDInterfaceCommand(iface))
+	}
+	commands = append(commands, BuildDeleteMLDInterfaceCommand(proxy.UpstreamInterface))
+	return commands
+}
+
+// BuildShowMLDConfigCommand builds the command to show MLD configuration
+func BuildShowMLDConfigCommand() string {
+	return "show config | grep \" mld \""
+}
+
+// ValidateMLDProxy validates an MLD proxy configuration
+func ValidateMLDProxy(proxy MLDProxy) error {
+	if err := ValidateIPv6InterfaceName(proxy.UpstreamInterface); err != nil {
+		return fmt.Errorf("upstream interface: %w", err)
+	}
+
+	if len(proxy.DownstreamInterfaces) == 0 {
+		return fmt.Errorf("at least one downstream interface is required")
+	}
+	seen := map[string]bool{proxy.UpstreamInterface: true}
+	for _, iface := range proxy.DownstreamInterfaces {
+		if err := ValidateIPv6InterfaceName(iface); err != nil {
+			return fmt.Errorf("downstream interface: %w", err)
+		}
+		if seen[iface] {
+			return fmt.Errorf("interface %s is listed more than once", iface)
+		}
+		seen[iface] = true
+	}
+
+	if proxy.Version != "" && proxy.Version != "1" && proxy.Version != "2" {
+		return fmt.Errorf("MLD version must be 1 or 2, got %s", proxy.Version)
+	}
+
+	for _, join := range proxy.StaticJoins {
+		if !seen[join.Interface] {
+			return fmt.Errorf("static join interface %s is not part of the MLD proxy", join.Interface)
+		}
+		if err := ValidateMLDGroup(join.Group); err != nil {
+			return err
+		}
+		if join.Source != "" {
+			ip := net.ParseIP(join.Source)
+			if ip == nil || ip.To4() != nil {
+				return fmt.Errorf("invalid IPv6 source address: %s", join.Source)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateMLDGroup validates that the group is an IPv6 multicast address
+func ValidateMLDGroup(group string) error {
+	ip := net.ParseIP(group)
+	if ip == nil || ip.To4() != nil || !ip.IsMulticast() {
+		return fmt.Errorf("invalid IPv6 multicast group: %s", group)
+	}
+	return nil
+}
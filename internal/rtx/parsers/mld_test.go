@@ -0,0 +1,162 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMLDConfig(t *testing.T) {
+	raw := `ipv6 lan1 mld router version=2
+ipv6 lan2 mld host version=2 syslog=on
+ipv6 lan3 mld router
+ipv6 lan1 mld static ff3e::1234
+ipv6 lan1 mld static ff3e::5678 2001:db8::1
+ipv6 lan1 address 2001:db8::1/64`
+
+	config, err := ParseMLDConfig(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantInterfaces := []MLDInterface{
+		{Interface: "lan1", Mode: "router", Version: "2"},
+		{Interface: "lan2", Mode: "host", Version: "2", Syslog: true},
+		{Interface: "lan3", Mode: "router", Version: "2"},
+	}
+	if !reflect.DeepEqual(config.Interfaces, wantInterfaces) {
+		t.Errorf("Interfaces = %+v, want %+v", config.Interfaces, wantInterfaces)
+	}
+
+	wantJoins := []MLDStaticJoin{
+		{Interface: "lan1", Group: "ff3e::1234"},
+		{Interface: "lan1", Group: "ff3e::5678", Source: "2001:db8::1"},
+	}
+	if !reflect.DeepEqual(config.StaticJoins, wantJoins) {
+		t.Errorf("StaticJoins = %+v, want %+v", config.StaticJoins, wantJoins)
+	}
+}
+
+func TestMLDProxyFromConfig(t *testing.T) {
+	config := &MLDConfig{
+		Interfaces: []MLDInterface{
+			{Interface: "lan3", Mode: "router", Version: "1"},
+			{Interface: "lan2", Mode: "host", Version: "1"},
+			{Interface: "lan1", Mode: "router", Version: "1"},
+		},
+		StaticJoins: []MLDStaticJoin{
+			{Interface: "lan1", Group: "ff3e::1"},
+			{Interface: "lan4", Group: "ff3e::2"},
+		},
+	}
+
+	proxy, err := MLDProxyFromConfig(config, "lan2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := &MLDProxy{
+		UpstreamInterface:    "lan2",
+		DownstreamInterfaces: []string{"lan1", "lan3"},
+		Version:              "1",
+		StaticJoins:          []MLDStaticJoin{{Interface: "lan1", Group: "ff3e::1"}},
+	}
+	if !reflect.DeepEqual(proxy, want) {
+		t.Errorf("MLDProxyFromConfig() = %+v, want %+v", proxy, want)
+	}
+
+	if _, err := MLDProxyFromConfig(config, "lan1"); err == nil {
+		t.Error("expected not found error for non-host interface")
+	}
+}
+
+func TestBuildMLDProxyCommands(t *testing.T) {
+	proxy := MLDProxy{
+		UpstreamInterface:    "lan2",
+		DownstreamInterfaces: []string{"lan1"},
+		Version:              "2",
+		Syslog:               true,
+		StaticJoins:          []MLDStaticJoin{{Interface: "lan1", Group: "ff3e::1234"}},
+	}
+
+	got := BuildMLDProxyCommands(proxy)
+	want := []string{
+		"ipv6 lan2 mld host version=2 syslog=on",
+		"ipv6 lan1 mld router version=2 syslog=on",
+		"ipv6 lan1 mld static ff3e::1234",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildMLDProxyCommands() = %v, want %v", got, want)
+	}
+
+	gotDelete := BuildDeleteMLDProxyCommands(proxy)
+	wantDelete := []string{
+		"no ipv6 lan1 mld static ff3e::1234",
+		"no ipv6 lan1 mld",
+		"no ipv6 lan2 mld",
+	}
+	if !reflect.DeepEqual(gotDelete, wantDelete) {
+		t.Errorf("BuildDeleteMLDProxyCommands() = %v, want %v", gotDelete, wantDelete)
+	}
+}
+
+func TestValidateMLDProxy(t *testing.T) {
+	valid := MLDProxy{
+		UpstreamInterface:    "lan2",
+		DownstreamInterfaces: []string{"lan1"},
+		Version:              "2",
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(p *MLDProxy)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(p *MLDProxy) {}, wantErr: false},
+		{name: "invalid upstream", modify: func(p *MLDProxy) { p.UpstreamInterface = "eth0" }, wantErr: true},
+		{name: "no downstream", modify: func(p *MLDProxy) { p.DownstreamInterfaces = nil }, wantErr: true},
+		{name: "upstream also downstream", modify: func(p *MLDProxy) { p.DownstreamInterfaces = []string{"lan2"} }, wantErr: true},
+		{name: "invalid version", modify: func(p *MLDProxy) { p.Version = "3" }, wantErr: true},
+		{
+			name: "valid static join",
+			modify: func(p *MLDProxy) {
+				p.StaticJoins = []MLDStaticJoin{{Interface: "lan1", Group: "ff3e::1", Source: "2001:db8::1"}}
+			},
+			wantErr: false,
+		},
+		{
+			name: "static join on foreign interface",
+			modify: func(p *MLDProxy) {
+				p.StaticJoins = []MLDStaticJoin{{Interface: "lan3", Group: "ff3e::1"}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "static join with unicast group",
+			modify: func(p *MLDProxy) {
+				p.StaticJoins = []MLDStaticJoin{{Interface: "lan1", Group: "2001:db8::1"}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "static join with ipv4 source",
+			modify: func(p *MLDProxy) {
+				p.StaticJoins = []MLDStaticJoin{{Interface: "lan1", Group: "ff3e::1", Source: "192.0.2.1"}}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proxy := valid
+			tt.modify(&proxy)
+			err := ValidateMLDProxy(proxy)
+			if tt.wantErr && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MobileWAN represents an LTE (mobile) WAN connection bound to a PP interface
+type MobileWAN struct {
+	PPNumber       int    `json:"pp_number"`                 // PP number (pp select <num>)
+	Interface      string `json:"interface"`                 // Mobile interface (usb1, wan1)
+	Enabled        bool   `json:"enabled"`                   // mobile use <interface> on|off
+	APN            string `json:"apn"`                       // mobile access-point name <apn>
+	CID            int    `json:"cid"`                       // PDP context ID
+	PDPType        string `json:"pdp_type"`                  // ip, ipv6 or ipv4v6
+	AuthMethod     string `json:"auth_method,omitempty"`     // pp auth accept <pap|chap>
+	Username       string `json:"username,omitempty"`        // pp auth myname <username> <password>
+	Password       string `json:"password,omitempty"`        // pp auth myname <username> <password>
+	PIN            string `json:"pin,omitempty"`             // mobile pin code <interface> <pin>
+	AlwaysOn       bool   `json:"always_on"`                 // pp always-on on|off
+	AutoConnect    bool   `json:"auto_connect"`              // mobile auto connect on|off
+	DisconnectTime int    `json:"disconnect_time,omitempty"` // mobile disconnect time <seconds> (0 = off)
+}
+
+// ValidMobileWANPDPTypes lists the supported PDP types
+var ValidMobileWANPDPTypes = []string{"ip", "ipv6", "ipv4v6"}
+
+// ValidMobileWANAuthMethods lists the supported authentication methods
+var ValidMobileWANAuthMethods = []string{"pap", "chap"}
+
+var (
+	mobileWANInterfacePattern   = regexp.MustCompile(`^(usb|wan)\d+$`)
+	mobileWANPINPattern         = regexp.MustCompile(`^\d{4,8}$`)
+	mobileUsePattern            = regexp.MustCompile(`^\s*mobile\s+use\s+(\S+)\s+(on|off)\s*$`)
+	mobilePINPattern            = regexp.MustCompile(`^\s*mobile\s+pin\s+code\s+(\S+)\s+(\S+)\s*$`)
+	mobileAccessPointPattern    = regexp.MustCompile(`^\s*mobile\s+access-point\s+name\s+(\S+)(?:\s+cid\s+(\d+))?(?:\s+pdp-type\s+(\S+))?\s*$`)
+	mobileAutoConnectPattern    = regexp.MustCompile(`^\s*mobile\s+auto\s+connect\s+(on|off)\s*$`)
+	mobileDisconnectTimePattern = regexp.MustCompile(`^\s*mobile\s+disconnect\s+time\s+(off|\d+)\s*$`)
+	mobilePPBindPattern         = regexp.MustCompile(`^\s*pp\s+bind\s+(\S+)\s*$`)
+	mobilePPAuthAcceptPattern   = regexp.MustCompile(`^\s*pp\s+auth\s+accept\s+(\S+)\s*$`)
+	mobilePPAuthMynamePattern   = regexp.MustCompile(`^\s*pp\s+auth\s+myname\s+(\S+)\s+(\S+)\s*$`)
+	mobilePPAlwaysOnPattern     = regexp.MustCompile(`^\s*pp\s+always-on\s+(on|off)\s*$`)
+)
+
+// ParseMobileWANs parses mobile WAN connections from the router configuration.
+// A PP interface is treated as a mobile WAN when it is bound to a mobile
+// interface (usbN or wanN).
+func ParseMobileWANs(raw string) []MobileWAN {
+	wans := make(map[int]*MobileWAN)
+	enabled := make(map[string]bool)
+	pins := make(map[string]string)
+	currentPP := 0
+
+	wan := func(pp int) *MobileWAN {
+		if wans[pp] == nil {
+			wans[pp] = &MobileWAN{PPNumber: pp, CID: 1, PDPType: "ip"}
+		}
+		return wans[pp]
+	}
+
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		// Global mobile settings are keyed by interface and valid in any context
+		if matches := mobileUsePattern.FindStringSubmatch(line); len(matches) == 3 {
+			enabled[matches[1]] = matches[2] == "on"
+			continue
+		}
+		if matches := mobilePINPattern.FindStringSubmatch(line); len(matches) == 3 {
+			pins[matches[1]] = matches[2]
+			continue
+		}
+
+		if matches := ppContextSelectPattern.FindStringSubmatch(line); len(matches) >= 2 {
+			currentPP, _ = strconv.Atoi(matches[1])
+			continue
+		}
+
+		// Any other top-level select leaves the PP context
+		if strings.HasPrefix(line, "tunnel select ") {
+			currentPP = 0
+			continue
+		}
+
+		if currentPP == 0 {
+			continue
+		}
+
+		if matches := mobilePPBindPattern.FindStringSubmatch(line); len(matches) == 2 {
+			if mobileWANInterfacePattern.MatchString(matches[1]) {
+				wan(currentPP).Interface = matches[1]
+			}
+			continue
+		}
+		if matches := mobileAccessPointPattern.FindStringSubmatch(line); len(matches) == 4 {
+			w := wan(currentPP)
+			w.APN = matches[1]
+			if matches[2] != "" {
+				w.CID, _ = strconv.Atoi(matches[2])
+			}
+			if matches[3] != "" {
+				w.PDPType = matches[3]
+			}
+			continue
+		}
+		if matches := mobileAutoConnectPattern.FindStringSubmatch(line); len(matches) == 2 {
+			wan(currentPP).AutoConnect = matches[1] == "on"
+			continue
+		}
+		if matches := mobileDisconnectTimePattern.FindStringSubmatch(line); len(matches) == 2 {
+			if matches[1] != "off" {
+				wan(currentPP).DisconnectTime, _ = strconv.Atoi(matches[1])
+			}
+			continue
+		}
+		if matches := mobilePPAuthAcceptPattern.FindStringSubmatch(line); len(matches) == 2 {
+			wan(currentPP).AuthMethod = matches[1]
+			continue
+		}
+		if matches := mobilePPAuthMynamePattern.FindStringSubmatch(line); len(matches) == 3 {
+			wan(currentPP).Username = matches[1]
+			wan(currentPP).Password = matches[2]
+			continue
+		}
+		if matches := mobilePPAlwaysOnPattern.FindStringSubmatch(line); len(matches) == 2 {
+			wan(currentPP).AlwaysOn = matches[1] == "on"
+		}
+	}
+
+	// Only PP interfaces bound to a mobile interface are mobile WANs
+	result := make([]MobileWAN, 0, len(wans))
+	for _, w := range wans {
+		if w.Interface == "" {
+			continue
+		}
+		w.Enabled = enabled[w.Interface]
+		w.PIN = pins[w.Interface]
+		result = append(result, *w)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].PPNumber < result[j].PPNumber })
+
+	return result
+}
+
+// BuildMobileWANCommands builds the commands to configure a mobile WAN connection
+func BuildMobileWANCommands(w MobileWAN) []string {
+	var commands []string
+
+	if w.PIN != "" {
+		commands = append(commands, fmt.Sprintf("mobile pin code %s %s", w.Interface, w.PIN))
+	}
+
+	commands = append(commands,
+		BuildPPSelectCommand(w.PPNumber),
+		BuildPPBindCommand(w.Interface),
+		fmt.Sprintf("mobile access-point name %s cid %d pdp-type %s", w.APN, w.CID, w.PDPType),
+	)
+
+	if w.AuthMethod != "" {
+		commands = append(commands, BuildPPPAuthAcceptCommand(w.AuthMethod))
+	}
+	if w.Username != "" {
+		commands = append(commands, BuildPPPAuthMynameCommand(w.Username, w.Password))
+	}
+
+	commands = append(commands, BuildPPAlwaysOnCommand(w.AlwaysOn))
+
+	if w.AutoConnect {
+		commands = append(commands, "mobile auto connect on")
+	} else {
+		commands = append(commands, "mobile auto connect off")
+	}
+
+	if w.DisconnectTime > 0 {
+		commands = append(commands, fmt.Sprintf("mobile disconnect time %d", w.DisconnectTime))
+	} else {
+		commands = append(commands, "mobile disconnect time off")
+	}
+
+	commands = append(commands, BuildPPEnableCommand(w.PPNumber), "pp select none")
+
+	if w.Enabled {
+		return append(commands, fmt.Sprintf("mobile use %s on", w.Interface))
+	}
+	return append(commands, fmt.Sprintf("mobile use %s off", w.Interface))
+}
+
+// BuildDeleteMobileWANAuthCommands builds the commands to remove the authentication
+// settings of a mobile WAN connection
+func BuildDeleteMobileWANAuthCommands(ppNum int) []string {
+	return []string{
+		BuildPPSelectCommand(ppNum),
+		"no pp auth myname",
+		"no pp auth accept",
+		"pp select none",
+	}
+}
+
+// BuildDeleteMobileWANPINCommand builds the command to remove the SIM PIN of a mobile interface
+func BuildDeleteMobileWANPINCommand(iface string) string {
+	return fmt.Sprintf("no mobile pin code %s", iface)
+}
+
+// BuildDeleteMobileWANCommands builds the commands to remove a mobile WAN connection
+func BuildDeleteMobileWANCommands(w MobileWAN) []string {
+	return []string{
+		fmt.Sprintf("no mobile use %s", w.Interface),
+		BuildPPDisableCommand(w.PPNumber),
+		BuildPPSelectCommand(w.PPNumber),
+		"no mobile disconnect time",
+		"no mobile auto connect",
+		"no mobile access-point name",
+		"no pp auth myname",
+		"no pp auth accept",
+		"no pp always-on",
+		"no pp bind",
+		"pp select none",
+		BuildDeleteMobileWANPINCommand(w.Interface),
+	}
+}
+
+// BuildShowMobileWANCommand builds the command to show mobile WAN configuration
+func BuildShowMobileWANCommand() string {
+	return "show config"
+}
+
+// ValidateMobileWAN validates a mobile WAN configuration
+func ValidateMobileWAN(w MobileWAN) error {
+	if w.PPNumber < 1 {
+		return fmt.Errorf("PP number must be >= 1")
+	}
+	if !mobileWANInterfacePattern.MatchString(w.Interface) {
+		return fmt.Errorf("interface must be a mobile interface such as usb1 or wan1, got %q", w.Interface)
+	}
+	if w.APN == "" || strings.ContainsAny(w.APN, " \t\"") {
+		return fmt.Errorf("APN must be non-empty and must not contain spaces or quotes")
+	}
+	if w.CID < 1 {
+		return fmt.Errorf("CID must be >= 1, got %d", w.CID)
+	}
+	if !slices.Contains(ValidMobileWANPDPTypes, w.PDPType) {
+		return fmt.Errorf("PDP type must be one of %v, got %q", ValidMobileWANPDPTypes, w.PDPType)
+	}
+	if w.AuthMethod != "" && !slices.Contains(ValidMobileWANAuthMethods, w.AuthMethod) {
+		return fmt.Errorf("authentication method must be one of %v, got %q", ValidMobileWANAuthMethods, w.AuthMethod)
+	}
+	if w.Username != "" && w.Password == "" {
+		return fmt.Errorf("password is required when username is specified")
+	}
+	if w.PIN != "" && !mobileWANPINPattern.MatchString(w.PIN) {
+		return fmt.Errorf("PIN must be 4-8 digits")
+	}
+	if w.DisconnectTime < 0 {
+		return fmt.Errorf("disconnect time must be >= 0")
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package parsers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testMobileWANConfig = `ip route default gateway pp 1 weight 1 gateway pp 2 weight 0
+pp select 1
+ pp always-on on
+ pppoe use lan2
+ pp auth accept chap
+ pp auth myname isp-user isp-pass
+ pp enable 1
+pp select 2
+ pp always-on on
+ pp bind usb1
+ pp auth accept chap
+ pp auth myname lte lte-pass
+ mobile auto connect on
+ mobile disconnect time 300
+ mobile access-point name internet.example cid 1 pdp-type ipv4v6
+ pp enable 2
+pp select none
+tunnel select 1
+ pp bind usb2
+mobile use usb1 on
+mobile pin code usb1 1234
+`
+
+func TestParseMobileWANs(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []MobileWAN
+	}{
+		{
+			name:  "mobile WAN alongside PPPoE",
+			input: testMobileWANConfig,
+			expected: []MobileWAN{
+				{
+					PPNumber:       2,
+					Interface:      "usb1",
+					Enabled:        true,
+					APN:            "internet.example",
+					CID:            1,
+					PDPType:        "ipv4v6",
+					AuthMethod:     "chap",
+					Username:       "lte",
+					Password:       "lte-pass",
+					PIN:            "1234",
+					AlwaysOn:       true,
+					AutoConnect:    true,
+					DisconnectTime: 300,
+				},
+			},
+		},
+		{
+			name:  "minimal configuration with defaults",
+			input: "pp select 3\n pp bind wan1\n mobile access-point name apn.example\n",
+			expected: []MobileWAN{
+				{PPNumber: 3, Interface: "wan1", APN: "apn.example", CID: 1, PDPType: "ip"},
+			},
+		},
+		{
+			name:     "no mobile WAN",
+			input:    "pp select 1\n pppoe use lan2\n pp bind lan2\n",
+			expected: []MobileWAN{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ParseMobileWANs(tt.input)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ParseMobileWANs() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuildMobileWANCommands(t *testing.T) {
+	w := MobileWAN{
+		PPNumber:       2,
+		Interface:      "usb1",
+		Enabled:        true,
+		APN:            "internet.example",
+		CID:            1,
+		PDPType:        "ip",
+		AuthMethod:     "chap",
+		Username:       "lte",
+		Password:       "lte-pass",
+		PIN:            "1234",
+		AutoConnect:    true,
+		DisconnectTime: 300,
+	}
+
+	expected := []string{
+		"mobile pin code usb1 1234",
+		"pp select 2",
+		"pp bind usb1",
+		"mobile access-point name internet.example cid 1 pdp-type ip",
+		"pp auth accept chap",
+		"pp auth myname lte lte-pass",
+		"pp always-on off",
+		"mobile auto connect on",
+		"mobile disconnect time 300",
+		"pp enable 2",
+		"pp select none",
+		"mobile use usb1 on",
+	}
+
+	if commands := BuildMobileWANCommands(w); !reflect.DeepEqual(commands, expected) {
+		t.Errorf("BuildMobileWANCommands() = %v, want %v", commands, expected)
+	}
+
+	// Round trip through the parser
+	parsed := ParseMobileWANs(strings.Join(expected, "\n"))
+	if len(parsed) != 1 || !reflect.DeepEqual(parsed[0], w) {
+		t.Errorf("round trip = %+v, want %+v", parsed, w)
+	}
+}
+
+func TestBuildDeleteMobileWANCommands(t *testing.T) {
+	commands := BuildDeleteMobileWANCommands(MobileWAN{PPNumber: 2, Interface: "usb1"})
+	if commands[0] != "no mobile use usb1" || commands[1] != "pp disable 2" || commands[2] != "pp select 2" {
+		t.Errorf("unexpected delete command order: %v", commands)
+	}
+	if commands[len(commands)-1] != "no mobile pin code usb1" {
+		t.Errorf("expected PIN removal last, got %v", commands)
+	}
+}
+
+func TestValidateMobileWAN(t *testing.T) {
+	valid := MobileWAN{PPNumber: 2, Interface: "usb1", APN: "internet.example", CID: 1, PDPType: "ip"}
+
+	tests := []struct {
+		name    string
+		modify  func(w *MobileWAN)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(w *MobileWAN) {}},
+		{name: "invalid PP number", modify: func(w *MobileWAN) { w.PPNumber = 0 }, wantErr: true},
+		{name: "non-mobile interface", modify: func(w *MobileWAN) { w.Interface = "lan2" }, wantErr: true},
+		{name: "empty APN", modify: func(w *MobileWAN) { w.APN = "" }, wantErr: true},
+		{name: "APN with space", modify: func(w *MobileWAN) { w.APN = "a b" }, wantErr: true},
+		{name: "invalid PDP type", modify: func(w *MobileWAN) { w.PDPType = "ppp" }, wantErr: true},
+		{name: "invalid auth method", modify: func(w *MobileWAN) { w.AuthMethod = "mschap" }, wantErr: true},
+		{name: "username without password", modify: func(w *MobileWAN) { w.Username = "user" }, wantErr: true},
+		{name: "invalid PIN", modify: func(w *MobileWAN) { w.PIN = "12ab" }, wantErr: true},
+		{name: "valid PIN", modify: func(w *MobileWAN) { w.PIN = "0000" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := valid
+			tt.modify(&w)
+			if err := ValidateMobileWAN(w); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateMobileWAN() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
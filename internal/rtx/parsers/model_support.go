@@ -1,6 +1,12 @@
 // Package parsers provides RTX command parsing and building utilities.
 package parsers
 
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
 // SupportedModels defines the standard set of router models supported by this provider.
 // Based on Yamaha RTX router command references.
 var SupportedModels = []string{
@@ -63,6 +69,9 @@ var modelSupportMap = map[string][]string{
 	// L2TP configuration
 	"l2tp_config": SupportedModels,
 
+	// LAN link aggregation (models with multiple independent LAN ports)
+	"link_aggregation": {"vRX", "RTX5000", "RTX3510", "RTX3500", "RTX1300"},
+
 	// NAT configuration
 	"nat_masquerade": SupportedModels,
 	"nat_static":     SupportedModels,
@@ -101,6 +110,93 @@ var modelSupportMap = map[string][]string{
 	"vlan_config": SupportedModels,
 }
 
+// lanInterfaceCount defines the number of physical LAN interfaces (lan1..lanN)
+// of the models that support link aggregation.
+var lanInterfaceCount = map[string]int{
+	"vRX":     8,
+	"RTX5000": 14,
+	"RTX3510": 9,
+	"RTX3500": 10,
+	"RTX1300": 3,
+}
+
+// LANInterfaceCount returns the number of physical LAN interfaces of a model,
+// or 0 if the model is unknown.
+func LANInterfaceCount(model string) int {
+	return lanInterfaceCount[model]
+}
+
+// ModelLimits holds the capacity of a router model, from the Yamaha product
+// specifications. A zero limit is not published for the model and is not
+// checked.
+type ModelLimits struct {
+	Tunnels        int      // Tunnel interfaces, numbered from 1 to Tunnels
+	NATDescriptors int      // NAT descriptors configured at once
+	IPFilters      int      // Static IP filters ("ip filter") configured at once
+	Protocols      []string // VPN protocols: "ipsec", "l2tpv3", "l2tp" and "pptp"
+}
+
+// allVPNProtocols are the VPN protocols managed by the provider
+var allVPNProtocols = []string{"ipsec", "l2tpv3", "l2tp", "pptp"}
+
+// modelLimits defines the capacity of the supported models
+var modelLimits = map[string]ModelLimits{
+	"vRX":     {Protocols: []string{"ipsec", "l2tpv3", "l2tp"}},
+	"RTX5000": {Tunnels: 3000, Protocols: allVPNProtocols},
+	"RTX3510": {Tunnels: 1000, Protocols: allVPNProtocols},
+	"RTX3500": {Tunnels: 1000, Protocols: allVPNProtocols},
+	"RTX1300": {Tunnels: 100, Protocols: allVPNProtocols},
+	"RTX1220": {Tunnels: 100, Protocols: allVPNProtocols},
+	"RTX1210": {Tunnels: 100, Protocols: allVPNProtocols},
+	"RTX840":  {Tunnels: 20, Protocols: allVPNProtocols},
+	"RTX830":  {Tunnels: 20, Protocols: allVPNProtocols},
+}
+
+// GetModelLimits returns the capacity of a model. Unknown models have no
+// limits.
+func GetModelLimits(model string) ModelLimits {
+	return modelLimits[model]
+}
+
+// CheckTunnelNumber returns an error when a model has no tunnel interface
+// with number id
+func CheckTunnelNumber(model string, id int) error {
+	limit := GetModelLimits(model).Tunnels
+	if limit > 0 && id > limit {
+		return fmt.Errorf("%s supports at most %d tunnels, so tunnel %d does not exist", model, limit, id)
+	}
+	return nil
+}
+
+// CheckVPNProtocol returns an error when a model does not support a VPN
+// protocol
+func CheckVPNProtocol(model, protocol string) error {
+	protocols := GetModelLimits(model).Protocols
+	if len(protocols) == 0 || slices.Contains(protocols, protocol) {
+		return nil
+	}
+	return fmt.Errorf("%s does not support %s (supported protocols: %s)", model, protocol, strings.Join(protocols, ", "))
+}
+
+// CheckNATDescriptorCount returns an error when count NAT descriptors exceed
+// the capacity of a model
+func CheckNATDescriptorCount(model string, count int) error {
+	return checkCount(model, "NAT descriptors", count, GetModelLimits(model).NATDescriptors)
+}
+
+// CheckIPFilterCount returns an error when count static IP filters exceed the
+// capacity of a model
+func CheckIPFilterCount(model string, count int) error {
+	return checkCount(model, "IP filters", count, GetModelLimits(model).IPFilters)
+}
+
+func checkCount(model, what string, count, limit int) error {
+	if limit > 0 && count > limit {
+		return fmt.Errorf("%s supports at most %d %s, the plan needs %d", model, limit, what, count)
+	}
+	return nil
+}
+
 // AllKnownModels returns all known RTX router models including older/unsupported ones
 func AllKnownModels() []string {
 	return []string{
@@ -168,3 +264,14 @@ func GetUnsupportedModels(command string) []string {
 	}
 	return unsupported
 }
+
+// CheckModelSupport returns an error naming the models that support feature
+// when model is not one of them. Models outside SupportedModels, including
+// undetected ones, are never rejected: there is no command reference for them.
+func CheckModelSupport(feature, model string) error {
+	if !slices.Contains(SupportedModels, model) || IsModelSupported(feature, model) {
+		return nil
+	}
+	return fmt.Errorf("%s is not supported on %s (supported models: %s)",
+		strings.ReplaceAll(feature, "_", " "), model, strings.Join(GetSupportedModels(feature), ", "))
+}
@@ -0,0 +1,81 @@
+package parsers
+
+import (
+	"testing"
+)
+
+func TestCheckModelSupport(t *testing.T) {
+	tests := []struct {
+		name    string
+		feature string
+		model   string
+		wantErr string
+	}{
+		{name: "supported", feature: "link_aggregation", model: "RTX1300"},
+		{name: "unsupported", feature: "link_aggregation", model: "RTX830",
+			wantErr: "link aggregation is not supported on RTX830 (supported models: vRX, RTX5000, RTX3510, RTX3500, RTX1300)"},
+		{name: "unrestricted feature", feature: "ip_route", model: "RTX830"},
+		{name: "model without command reference", feature: "link_aggregation", model: "NVR510"},
+		{name: "undetected model", feature: "link_aggregation", model: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckModelSupport(tt.feature, tt.model)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("CheckModelSupport(%q, %q) = %v, want nil", tt.feature, tt.model, err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("CheckModelSupport(%q, %q) = %v, want %q", tt.feature, tt.model, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestModelLimitChecks(t *testing.T) {
+	tests := []struct {
+		name    string
+		check   func() error
+		wantErr string
+	}{
+		{name: "tunnel within limit", check: func() error { return CheckTunnelNumber("RTX830", 20) }},
+		{name: "tunnel over limit", check: func() error { return CheckTunnelNumber("RTX830", 21) },
+			wantErr: "RTX830 supports at most 20 tunnels, so tunnel 21 does not exist"},
+		{name: "tunnel on unknown model", check: func() error { return CheckTunnelNumber("NVR510", 500) }},
+		{name: "supported protocol", check: func() error { return CheckVPNProtocol("RTX1210", "l2tpv3") }},
+		{name: "unsupported protocol", check: func() error { return CheckVPNProtocol("vRX", "pptp") },
+			wantErr: "vRX does not support pptp (supported protocols: ipsec, l2tpv3, l2tp)"},
+		{name: "protocol on unknown model", check: func() error { return CheckVPNProtocol("", "pptp") }},
+		{name: "unpublished NAT descriptor limit", check: func() error { return CheckNATDescriptorCount("RTX830", 100000) }},
+		{name: "unpublished IP filter limit", check: func() error { return CheckIPFilterCount("RTX830", 100000) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.check()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("got %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("got %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckCount(t *testing.T) {
+	if err := checkCount("RTX830", "NAT descriptors", 10, 10); err != nil {
+		t.Errorf("checkCount at limit = %v, want nil", err)
+	}
+	err := checkCount("RTX830", "NAT descriptors", 11, 10)
+	want := "RTX830 supports at most 10 NAT descriptors, the plan needs 11"
+	if err == nil || err.Error() != want {
+		t.Errorf("checkCount over limit = %v, want %q", err, want)
+	}
+}
@@ -0,0 +1,119 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// NATDescriptor summarizes a NAT descriptor and the interfaces it is bound to
+type NATDescriptor struct {
+	ID             int      `json:"id"`              // Descriptor number
+	Type           string   `json:"type"`            // masquerade, static, nat, nat-masquerade, or none
+	OuterAddresses []string `json:"outer_addresses"` // "nat descriptor address outer" values (ipcp, primary, addresses, ranges)
+	InnerAddresses []string `json:"inner_addresses"` // "nat descriptor address inner" values (auto, ranges)
+	Interfaces     []string `json:"interfaces"`      // Interfaces the descriptor is applied to (lan2, pp1, tunnel1, ...)
+}
+
+var (
+	natDescriptorTypePattern    = regexp.MustCompile(`^nat\s+descriptor\s+type\s+(\d+)\s+(\S+)$`)
+	natDescriptorAddressPattern = regexp.MustCompile(`^nat\s+descriptor\s+address\s+(outer|inner)\s+(\d+)\s+(.+)$`)
+	natDescriptorBindPattern    = regexp.MustCompile(`^ip\s+(\S+)\s+nat\s+descriptor\s+(.+)$`)
+)
+
+// ParseNATDescriptors parses all NAT descriptors and their interface bindings from "show config"
+func ParseNATDescriptors(raw string) ([]NATDescriptor, error) {
+	parsed, err := NewConfigFileParser().Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	return extractNATDescriptors(parsed.Commands), nil
+}
+
+// extractNATDescriptors builds the descriptor list from context-aware commands.
+// "ip pp nat descriptor" and "ip tunnel nat descriptor" are attributed to the
+// enclosing "pp select" or "tunnel select" context.
+func extractNATDescriptors(commands []ParsedCommand) []NATDescriptor {
+	descriptors := make(map[int]*NATDescriptor)
+
+	getDescriptor := func(id int) *NATDescriptor {
+		desc, exists := descriptors[id]
+		if !exists {
+			desc = &NATDescriptor{
+				ID:             id,
+				OuterAddresses: []string{},
+				InnerAddresses: []string{},
+				Interfaces:     []string{},
+			}
+			descriptors[id] = desc
+		}
+		return desc
+	}
+
+	for _, cmd := range commands {
+		if matches := natDescriptorTypePattern.FindStringSubmatch(cmd.Line); len(matches) == 3 {
+			id, _ := strconv.Atoi(matches[1])
+			getDescriptor(id).Type = matches[2]
+			continue
+		}
+		if matches := natDescriptorAddressPattern.FindStringSubmatch(cmd.Line); len(matches) == 4 {
+			id, _ := strconv.Atoi(matches[2])
+			desc := getDescriptor(id)
+			if matches[1] == "outer" {
+				desc.OuterAddresses = append(desc.OuterAddresses, strings.Fields(matches[3])...)
+			} else {
+				desc.InnerAddresses = append(desc.InnerAddresses, strings.Fields(matches[3])...)
+			}
+			continue
+		}
+		if matches := natDescriptorBindPattern.FindStringSubmatch(cmd.Line); len(matches) == 3 {
+			iface := natDescriptorInterfaceName(matches[1], cmd.Context)
+			if iface == "" {
+				continue
+			}
+			for _, field := range strings.Fields(matches[2]) {
+				id, err := strconv.Atoi(field)
+				if err != nil {
+					continue // "reverse" keyword
+				}
+				desc := getDescriptor(id)
+				if !slices.Contains(desc.Interfaces, iface) {
+					desc.Interfaces = append(desc.Interfaces, iface)
+				}
+			}
+		}
+	}
+
+	result := make([]NATDescriptor, 0, len(descriptors))
+	for _, desc := range descriptors {
+		result = append(result, *desc)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
+
+// natDescriptorInterfaceName resolves the interface of an "ip <if> nat descriptor" line
+func natDescriptorInterfaceName(iface string, ctx *ParseContext) string {
+	switch iface {
+	case "pp":
+		if ctx == nil || ctx.Type != ContextPP {
+			return ""
+		}
+		if ctx.Name != "" {
+			return "pp " + ctx.Name
+		}
+		return fmt.Sprintf("pp%d", ctx.ID)
+	case "tunnel":
+		if ctx == nil || ctx.Type != ContextTunnel {
+			return ""
+		}
+		return fmt.Sprintf("tunnel%d", ctx.ID)
+	default:
+		return iface
+	}
+}
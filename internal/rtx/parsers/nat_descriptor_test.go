@@ -0,0 +1,67 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseNATDescriptors(t *testing.T) {
+	raw := `ip lan2 nat descriptor 1 2
+nat descriptor type 1 masquerade
+nat descriptor address outer 1 primary
+nat descriptor address inner 1 192.168.1.1-192.168.1.254
+nat descriptor type 2 static
+nat descriptor address outer 2 203.0.113.10 203.0.113.11
+pp select 1
+ ip pp nat descriptor 1000
+ pp enable 1
+tunnel select 1
+ ip tunnel nat descriptor 2
+ tunnel enable 1
+nat descriptor type 1000 masquerade
+nat descriptor address outer 1000 ipcp
+nat descriptor address inner 1000 auto
+`
+
+	want := []NATDescriptor{
+		{
+			ID:             1,
+			Type:           "masquerade",
+			OuterAddresses: []string{"primary"},
+			InnerAddresses: []string{"192.168.1.1-192.168.1.254"},
+			Interfaces:     []string{"lan2"},
+		},
+		{
+			ID:             2,
+			Type:           "static",
+			OuterAddresses: []string{"203.0.113.10", "203.0.113.11"},
+			InnerAddresses: []string{},
+			Interfaces:     []string{"lan2", "tunnel1"},
+		},
+		{
+			ID:             1000,
+			Type:           "masquerade",
+			OuterAddresses: []string{"ipcp"},
+			InnerAddresses: []string{"auto"},
+			Interfaces:     []string{"pp1"},
+		},
+	}
+
+	got, err := ParseNATDescriptors(raw)
+	if err != nil {
+		t.Fatalf("ParseNATDescriptors() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseNATDescriptors() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseNATDescriptors_Empty(t *testing.T) {
+	got, err := ParseNATDescriptors("ip lan1 address 192.168.1.1/24\n")
+	if err != nil {
+		t.Fatalf("ParseNATDescriptors() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("ParseNATDescriptors() = %+v, want empty", got)
+	}
+}
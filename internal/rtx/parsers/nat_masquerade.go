@@ -14,6 +14,19 @@ type NATMasquerade struct {
 	OuterAddress  string                  `json:"outer_address"`            // "ipcp", interface name, or specific IP
 	InnerNetwork  string                  `json:"inner_network"`            // IP range: "192.168.1.0-192.168.1.255"
 	StaticEntries []MasqueradeStaticEntry `json:"static_entries,omitempty"` // Static port mappings
+
+	// Descriptor options
+	SIP                     string                        `json:"sip,omitempty"`                       // "on", "off", "auto" (empty = router default)
+	FTPPorts                []int                         `json:"ftp_ports,omitempty"`                 // FTP control ports (empty = router default 21)
+	UnconvertiblePorts      []MasqueradeUnconvertiblePort `json:"unconvertible_ports,omitempty"`       // Ports excluded from conversion
+	UnconvertibleIfPossible bool                          `json:"unconvertible_if_possible,omitempty"` // Keep source port when not in use
+	Rlogin                  bool                          `json:"rlogin,omitempty"`                    // Allow rlogin/rcp/ssh through masquerade
+}
+
+// MasqueradeUnconvertiblePort represents a port range that IP masquerade must not convert
+type MasqueradeUnconvertiblePort struct {
+	Protocol string `json:"protocol"` // "tcp" or "udp"
+	Port     string `json:"port"`     // Single port or range: "1024" or "1024-65535"
 }
 
 // MasqueradeStaticEntry represents a static port mapping entry
@@ -50,6 +63,29 @@ func ParseNATMasqueradeConfig(raw string) ([]NATMasquerade, error) {
 	// Protocol-only static pattern (no ports): nat descriptor masquerade static <id> <entry> <inner_ip> <protocol>
 	// Format: nat descriptor masquerade static 1000 1 192.168.1.253 esp
 	staticProtocolOnlyPattern := regexp.MustCompile(`^\s*nat\s+descriptor\s+masquerade\s+static\s+(\d+)\s+(\d+)\s+(\d+\.\d+\.\d+\.\d+)\s+(esp|ah|gre|icmp)\s*$`)
+	// nat descriptor sip <id> <on|off|auto>
+	sipPattern := regexp.MustCompile(`^\s*nat\s+descriptor\s+sip\s+(\d+)\s+(on|off|auto)\s*$`)
+	// nat descriptor ftp port <id> <port> [<port>...]
+	ftpPortPattern := regexp.MustCompile(`^\s*nat\s+descriptor\s+ftp\s+port\s+(\d+)\s+(\d+(?:\s+\d+)*)\s*$`)
+	// nat descriptor masquerade unconvertible port <id> if-possible
+	unconvertibleIfPossiblePattern := regexp.MustCompile(`^\s*nat\s+descriptor\s+masquerade\s+unconvertible\s+port\s+(\d+)\s+if-possible\s*$`)
+	// nat descriptor masquerade unconvertible port <id> <tcp|udp> <port[-port]>
+	unconvertiblePortPattern := regexp.MustCompile(`^\s*nat\s+descriptor\s+masquerade\s+unconvertible\s+port\s+(\d+)\s+(tcp|udp)\s+(\d+(?:-\d+)?)\s*$`)
+	// nat descriptor masquerade rlogin <id> <on|off>
+	rloginPattern := regexp.MustCompile(`^\s*nat\s+descriptor\s+masquerade\s+rlogin\s+(\d+)\s+(on|off)\s*$`)
+
+	// getDescriptor returns the descriptor for id, creating it on first reference
+	getDescriptor := func(id int) *NATMasquerade {
+		desc, exists := descriptors[id]
+		if !exists {
+			desc = &NATMasquerade{
+				DescriptorID:  id,
+				StaticEntries: []MasqueradeStaticEntry{},
+			}
+			descriptors[id] = desc
+		}
+		return desc
+	}
 
 	for _, line := range lines {
 		line = strings.TrimSpace(line)
@@ -262,6 +298,68 @@ func ParseNATMasqueradeConfig(raw string) ([]NATMasquerade, error) {
 			desc.StaticEntries = append(desc.StaticEntries, entry)
 			continue
 		}
+
+		// Try SIP option pattern
+		if matches := sipPattern.FindStringSubmatch(line); len(matches) >= 3 {
+			id, err := strconv.Atoi(matches[1])
+			if err != nil {
+				continue
+			}
+			getDescriptor(id).SIP = matches[2]
+			continue
+		}
+
+		// Try FTP port pattern
+		if matches := ftpPortPattern.FindStringSubmatch(line); len(matches) >= 3 {
+			id, err := strconv.Atoi(matches[1])
+			if err != nil {
+				continue
+			}
+			var ports []int
+			for _, field := range strings.Fields(matches[2]) {
+				port, err := strconv.Atoi(field)
+				if err != nil {
+					continue
+				}
+				ports = append(ports, port)
+			}
+			getDescriptor(id).FTPPorts = ports
+			continue
+		}
+
+		// Try unconvertible if-possible pattern
+		if matches := unconvertibleIfPossiblePattern.FindStringSubmatch(line); len(matches) >= 2 {
+			id, err := strconv.Atoi(matches[1])
+			if err != nil {
+				continue
+			}
+			getDescriptor(id).UnconvertibleIfPossible = true
+			continue
+		}
+
+		// Try unconvertible port pattern
+		if matches := unconvertiblePortPattern.FindStringSubmatch(line); len(matches) >= 4 {
+			id, err := strconv.Atoi(matches[1])
+			if err != nil {
+				continue
+			}
+			desc := getDescriptor(id)
+			desc.UnconvertiblePorts = append(desc.UnconvertiblePorts, MasqueradeUnconvertiblePort{
+				Protocol: matches[2],
+				Port:     matches[3],
+			})
+			continue
+		}
+
+		// Try rlogin pattern
+		if matches := rloginPattern.FindStringSubmatch(line); len(matches) >= 3 {
+			id, err := strconv.Atoi(matches[1])
+			if err != nil {
+				continue
+			}
+			getDescriptor(id).Rlogin = matches[2] == "on"
+			continue
+		}
 	}
 
 	// Convert map to slice
@@ -350,6 +448,67 @@ func BuildDeleteNATMasqueradeStaticCommand(id int, entryNum int) string {
 	return fmt.Sprintf("no nat descriptor masquerade static %d %d", id, entryNum)
 }
 
+// BuildNATDescriptorSIPCommand generates "nat descriptor sip N on|off|auto" command
+func BuildNATDescriptorSIPCommand(id int, mode string) string {
+	return fmt.Sprintf("nat descriptor sip %d %s", id, mode)
+}
+
+// BuildDeleteNATDescriptorSIPCommand generates "no nat descriptor sip N" command
+func BuildDeleteNATDescriptorSIPCommand(id int) string {
+	return fmt.Sprintf("no nat descriptor sip %d", id)
+}
+
+// BuildNATDescriptorFTPPortCommand generates "nat descriptor ftp port N port [port...]" command
+func BuildNATDescriptorFTPPortCommand(id int, ports []int) string {
+	parts := make([]string, len(ports))
+	for i, port := range ports {
+		parts[i] = strconv.Itoa(port)
+	}
+	return fmt.Sprintf("nat descriptor ftp port %d %s", id, strings.Join(parts, " "))
+}
+
+// BuildDeleteNATDescriptorFTPPortCommand generates "no nat descriptor ftp port N" command
+func BuildDeleteNATDescriptorFTPPortCommand(id int) string {
+	return fmt.Sprintf("no nat descriptor ftp port %d", id)
+}
+
+// BuildNATMasqueradeUnconvertiblePortCommand generates
+// "nat descriptor masquerade unconvertible port N protocol port" command
+func BuildNATMasqueradeUnconvertiblePortCommand(id int, port MasqueradeUnconvertiblePort) string {
+	return fmt.Sprintf("nat descriptor masquerade unconvertible port %d %s %s", id, strings.ToLower(port.Protocol), port.Port)
+}
+
+// BuildDeleteNATMasqueradeUnconvertiblePortCommand generates
+// "no nat descriptor masquerade unconvertible port N protocol port" command
+func BuildDeleteNATMasqueradeUnconvertiblePortCommand(id int, port MasqueradeUnconvertiblePort) string {
+	return fmt.Sprintf("no nat descriptor masquerade unconvertible port %d %s %s", id, strings.ToLower(port.Protocol), port.Port)
+}
+
+// BuildNATMasqueradeUnconvertibleIfPossibleCommand generates
+// "nat descriptor masquerade unconvertible port N if-possible" command
+func BuildNATMasqueradeUnconvertibleIfPossibleCommand(id int) string {
+	return fmt.Sprintf("nat descriptor masquerade unconvertible port %d if-possible", id)
+}
+
+// BuildDeleteNATMasqueradeUnconvertibleIfPossibleCommand generates
+// "no nat descriptor masquerade unconvertible port N if-possible" command
+func BuildDeleteNATMasqueradeUnconvertibleIfPossibleCommand(id int) string {
+	return fmt.Sprintf("no nat descriptor masquerade unconvertible port %d if-possible", id)
+}
+
+// BuildNATMasqueradeRloginCommand generates "nat descriptor masquerade rlogin N on|off" command
+func BuildNATMasqueradeRloginCommand(id int, enabled bool) string {
+	if enabled {
+		return fmt.Sprintf("nat descriptor masquerade rlogin %d on", id)
+	}
+	return fmt.Sprintf("nat descriptor masquerade rlogin %d off", id)
+}
+
+// BuildDeleteNATMasqueradeRloginCommand generates "no nat descriptor masquerade rlogin N" command
+func BuildDeleteNATMasqueradeRloginCommand(id int) string {
+	return fmt.Sprintf("no nat descriptor masquerade rlogin %d", id)
+}
+
 // BuildShowNATDescriptorCommand builds command to show NAT descriptor configuration
 func BuildShowNATDescriptorCommand(id int) string {
 	// Use simple grep pattern with the descriptor ID
@@ -419,6 +578,45 @@ func ConvertRangeToRTXFormat(cidr string) (string, error) {
 	return fmt.Sprintf("%s-%s", start, end), nil
 }
 
+// AddressRangesEqual reports whether two address specifications cover the
+// same IPv4 addresses, whether written in CIDR notation, as an RTX range or
+// as a single address: "192.168.1.0/24" equals "192.168.1.0-192.168.1.255"
+// and "10.0.0.1" equals "10.0.0.1/32". Anything else, such as "*" or an
+// interface name, is only equal to the same string.
+func AddressRangesEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	aStart, aEnd, ok := addressRangeBounds(a)
+	if !ok {
+		return false
+	}
+	bStart, bEnd, ok := addressRangeBounds(b)
+	if !ok {
+		return false
+	}
+	return aStart == bStart && aEnd == bEnd
+}
+
+// addressRangeBounds returns the first and last IPv4 address covered by a
+// CIDR, an "start-end" range or a single address
+func addressRangeBounds(s string) (string, string, bool) {
+	if strings.Contains(s, "/") {
+		start, end, err := ConvertCIDRToRange(s)
+		return start, end, err == nil
+	}
+	startText, endText, isRange := strings.Cut(s, "-")
+	if !isRange {
+		endText = startText
+	}
+	start := net.ParseIP(strings.TrimSpace(startText)).To4()
+	end := net.ParseIP(strings.TrimSpace(endText)).To4()
+	if start == nil || end == nil {
+		return "", "", false
+	}
+	return start.String(), end.String(), true
+}
+
 // ValidateNATPort validates that a port number is within valid range (1-65535)
 func ValidateNATPort(port int) error {
 	if port < 1 || port > 65535 {
@@ -494,12 +692,20 @@ func ValidateNATMasquerade(nat NATMasquerade) error {
 		return fmt.Errorf("inner network cannot be empty")
 	}
 
-	// Validate static entries
+	// Validate static entries; entry number 0 is assigned when applied
+	entryNumbers := make(map[int]bool, len(nat.StaticEntries))
 	for i, entry := range nat.StaticEntries {
 		if err := ValidateNATProtocol(entry.Protocol); err != nil {
 			return fmt.Errorf("static entry %d: %w", i+1, err)
 		}
 
+		if entry.EntryNumber != 0 {
+			if entryNumbers[entry.EntryNumber] {
+				return fmt.Errorf("static entry %d: duplicate entry number %d", i+1, entry.EntryNumber)
+			}
+			entryNumbers[entry.EntryNumber] = true
+		}
+
 		// Protocol-only entries (ESP, AH, GRE, ICMP) don't have ports
 		if IsProtocolOnly(entry.Protocol) {
 			// Ports should be nil for protocol-only entries
@@ -528,5 +734,47 @@ func ValidateNATMasquerade(nat NATMasquerade) error {
 		}
 	}
 
+	if nat.SIP != "" && nat.SIP != "on" && nat.SIP != "off" && nat.SIP != "auto" {
+		return fmt.Errorf("sip must be 'on', 'off', or 'auto', got '%s'", nat.SIP)
+	}
+
+	for _, port := range nat.FTPPorts {
+		if err := ValidateNATPort(port); err != nil {
+			return fmt.Errorf("ftp port: %w", err)
+		}
+	}
+
+	for i, port := range nat.UnconvertiblePorts {
+		if err := ValidateUnconvertiblePort(port); err != nil {
+			return fmt.Errorf("unconvertible port %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateUnconvertiblePort validates an unconvertible port entry
+func ValidateUnconvertiblePort(port MasqueradeUnconvertiblePort) error {
+	protocol := strings.ToLower(port.Protocol)
+	if protocol != "tcp" && protocol != "udp" {
+		return fmt.Errorf("protocol must be 'tcp' or 'udp', got '%s'", port.Protocol)
+	}
+
+	bounds := strings.SplitN(port.Port, "-", 2)
+	values := make([]int, len(bounds))
+	for i, bound := range bounds {
+		v, err := strconv.Atoi(bound)
+		if err != nil {
+			return fmt.Errorf("invalid port '%s'", port.Port)
+		}
+		if err := ValidateNATPort(v); err != nil {
+			return err
+		}
+		values[i] = v
+	}
+	if len(values) == 2 && values[0] > values[1] {
+		return fmt.Errorf("port range start must not exceed end: %s", port.Port)
+	}
+
 	return nil
 }
@@ -760,6 +760,36 @@ func TestConvertRangeToRTXFormat(t *testing.T) {
 	}
 }
 
+func TestAddressRangesEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{"CIDR and range", "192.168.1.0/24", "192.168.1.0-192.168.1.255", true},
+		{"range and CIDR", "10.0.0.0-10.255.255.255", "10.0.0.0/8", true},
+		{"host bits in CIDR", "192.168.1.10/24", "192.168.1.0-192.168.1.255", true},
+		{"single address and /32", "10.0.0.1", "10.0.0.1/32", true},
+		{"single address and range", "10.0.0.1", "10.0.0.1-10.0.0.1", true},
+		{"different prefix length", "192.168.1.0/25", "192.168.1.0-192.168.1.255", false},
+		{"different network", "192.168.2.0/24", "192.168.1.0-192.168.1.255", false},
+		{"any", "*", "*", true},
+		{"any and CIDR", "*", "0.0.0.0/0", false},
+		{"interface name", "pp1", "pp1", true},
+		{"invalid range", "192.168.1.0-x", "192.168.1.0/24", false},
+		{"empty", "", "192.168.1.0/24", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AddressRangesEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("AddressRangesEqual(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestValidateNATPort(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -1169,6 +1199,32 @@ func TestValidateNATMasquerade(t *testing.T) {
 		nat     NATMasquerade
 		wantErr bool
 	}{
+		{
+			name: "duplicate entry numbers",
+			nat: NATMasquerade{
+				DescriptorID: 1,
+				OuterAddress: "ipcp",
+				InnerNetwork: "192.168.1.0-192.168.1.255",
+				StaticEntries: []MasqueradeStaticEntry{
+					{EntryNumber: 1, InsideLocal: "192.168.1.100", InsideLocalPort: intPtr(80), OutsideGlobalPort: intPtr(80), Protocol: "tcp"},
+					{EntryNumber: 1, InsideLocal: "192.168.1.101", InsideLocalPort: intPtr(443), OutsideGlobalPort: intPtr(443), Protocol: "tcp"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "entries without entry numbers",
+			nat: NATMasquerade{
+				DescriptorID: 1,
+				OuterAddress: "ipcp",
+				InnerNetwork: "192.168.1.0-192.168.1.255",
+				StaticEntries: []MasqueradeStaticEntry{
+					{InsideLocal: "192.168.1.100", InsideLocalPort: intPtr(80), OutsideGlobalPort: intPtr(80), Protocol: "tcp"},
+					{InsideLocal: "192.168.1.101", InsideLocalPort: intPtr(443), OutsideGlobalPort: intPtr(443), Protocol: "tcp"},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "valid basic config",
 			nat: NATMasquerade{
@@ -3098,3 +3154,118 @@ nat descriptor masquerade static 1 3 192.168.1.200 udp 53`,
 		})
 	}
 }
+
+func TestParseNATMasqueradeConfig_DescriptorOptions(t *testing.T) {
+	input := `nat descriptor type 1 masquerade
+nat descriptor address outer 1 ipcp
+nat descriptor address inner 1 auto
+nat descriptor sip 1 on
+nat descriptor ftp port 1 21 2121
+nat descriptor masquerade unconvertible port 1 if-possible
+nat descriptor masquerade unconvertible port 1 tcp 1024-65535
+nat descriptor masquerade unconvertible port 1 udp 500
+nat descriptor masquerade rlogin 1 on
+nat descriptor type 2 masquerade
+nat descriptor masquerade rlogin 2 off`
+
+	result, err := ParseNATMasqueradeConfig(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byID := make(map[int]NATMasquerade)
+	for _, nat := range result {
+		byID[nat.DescriptorID] = nat
+	}
+
+	nat, ok := byID[1]
+	if !ok {
+		t.Fatalf("descriptor 1 not found in result")
+	}
+	if nat.SIP != "on" {
+		t.Errorf("sip = %q, want %q", nat.SIP, "on")
+	}
+	if fmt.Sprint(nat.FTPPorts) != "[21 2121]" {
+		t.Errorf("ftp ports = %v, want [21 2121]", nat.FTPPorts)
+	}
+	if !nat.UnconvertibleIfPossible {
+		t.Errorf("unconvertible if-possible = false, want true")
+	}
+	wantPorts := []MasqueradeUnconvertiblePort{
+		{Protocol: "tcp", Port: "1024-65535"},
+		{Protocol: "udp", Port: "500"},
+	}
+	if fmt.Sprint(nat.UnconvertiblePorts) != fmt.Sprint(wantPorts) {
+		t.Errorf("unconvertible ports = %v, want %v", nat.UnconvertiblePorts, wantPorts)
+	}
+	if !nat.Rlogin {
+		t.Errorf("rlogin = false, want true")
+	}
+	if len(nat.StaticEntries) != 0 {
+		t.Errorf("option lines must not produce static entries, got %d", len(nat.StaticEntries))
+	}
+
+	if byID[2].Rlogin {
+		t.Errorf("descriptor 2: rlogin = true, want false")
+	}
+}
+
+func TestBuildNATMasqueradeOptionCommands(t *testing.T) {
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"sip on", BuildNATDescriptorSIPCommand(1, "on"), "nat descriptor sip 1 on"},
+		{"delete sip", BuildDeleteNATDescriptorSIPCommand(1), "no nat descriptor sip 1"},
+		{"ftp ports", BuildNATDescriptorFTPPortCommand(1, []int{21, 2121}), "nat descriptor ftp port 1 21 2121"},
+		{"delete ftp ports", BuildDeleteNATDescriptorFTPPortCommand(1), "no nat descriptor ftp port 1"},
+		{
+			"unconvertible port",
+			BuildNATMasqueradeUnconvertiblePortCommand(1, MasqueradeUnconvertiblePort{Protocol: "TCP", Port: "1024-65535"}),
+			"nat descriptor masquerade unconvertible port 1 tcp 1024-65535",
+		},
+		{
+			"delete unconvertible port",
+			BuildDeleteNATMasqueradeUnconvertiblePortCommand(1, MasqueradeUnconvertiblePort{Protocol: "udp", Port: "500"}),
+			"no nat descriptor masquerade unconvertible port 1 udp 500",
+		},
+		{"unconvertible if-possible", BuildNATMasqueradeUnconvertibleIfPossibleCommand(1), "nat descriptor masquerade unconvertible port 1 if-possible"},
+		{"delete unconvertible if-possible", BuildDeleteNATMasqueradeUnconvertibleIfPossibleCommand(1), "no nat descriptor masquerade unconvertible port 1 if-possible"},
+		{"rlogin on", BuildNATMasqueradeRloginCommand(1, true), "nat descriptor masquerade rlogin 1 on"},
+		{"rlogin off", BuildNATMasqueradeRloginCommand(1, false), "nat descriptor masquerade rlogin 1 off"},
+		{"delete rlogin", BuildDeleteNATMasqueradeRloginCommand(1), "no nat descriptor masquerade rlogin 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("got %q, want %q", tt.got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateUnconvertiblePort(t *testing.T) {
+	tests := []struct {
+		name    string
+		port    MasqueradeUnconvertiblePort
+		wantErr bool
+	}{
+		{"single tcp port", MasqueradeUnconvertiblePort{Protocol: "tcp", Port: "1024"}, false},
+		{"udp range", MasqueradeUnconvertiblePort{Protocol: "udp", Port: "1024-65535"}, false},
+		{"invalid protocol", MasqueradeUnconvertiblePort{Protocol: "esp", Port: "500"}, true},
+		{"port out of range", MasqueradeUnconvertiblePort{Protocol: "tcp", Port: "70000"}, true},
+		{"reversed range", MasqueradeUnconvertiblePort{Protocol: "tcp", Port: "2000-1000"}, true},
+		{"not a number", MasqueradeUnconvertiblePort{Protocol: "tcp", Port: "http"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUnconvertiblePort(tt.port)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUnconvertiblePort() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package parsers
+
+import (
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// OSPFNeighborStatus represents an OSPF adjacency as reported by "show status ospf neighbor"
+type OSPFNeighborStatus struct {
+	NeighborID string `json:"neighbor_id"`    // Router ID of the neighbor
+	Priority   int    `json:"priority"`       // Router priority of the neighbor
+	State      string `json:"state"`          // Adjacency state (full, 2way, init, ...)
+	Role       string `json:"role,omitempty"` // Role of the neighbor on the segment (dr, bdr, drother)
+	DeadTime   string `json:"dead_time"`      // Time until the neighbor is declared down
+	Address    string `json:"address"`        // Interface address of the neighbor
+	Interface  string `json:"interface"`      // Local interface the neighbor was learned on
+}
+
+var ospfNeighborRowPattern = regexp.MustCompile(`^\s*(\d+\.\d+\.\d+\.\d+)\s+(\d+)\s+(\S+)\s+(\d+:\d{2}:\d{2}|\d+)\s+(\d+\.\d+\.\d+\.\d+)\s+(\S+)`)
+
+// ParseOSPFNeighborStatus parses the neighbor table of "show status ospf neighbor"
+func ParseOSPFNeighborStatus(raw string) []OSPFNeighborStatus {
+	neighbors := []OSPFNeighborStatus{}
+
+	for _, line := range strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n") {
+		matches := ospfNeighborRowPattern.FindStringSubmatch(line)
+		if len(matches) != 7 || net.ParseIP(matches[1]) == nil {
+			continue
+		}
+
+		neighbor := OSPFNeighborStatus{
+			NeighborID: matches[1],
+			DeadTime:   matches[4],
+			Address:    matches[5],
+			Interface:  strings.ToLower(matches[6]),
+		}
+		neighbor.Priority, _ = strconv.Atoi(matches[2])
+
+		state, role, _ := strings.Cut(strings.ToLower(matches[3]), "/")
+		neighbor.State = state
+		if role != "" && role != "-" {
+			neighbor.Role = role
+		}
+		neighbors = append(neighbors, neighbor)
+	}
+
+	return neighbors
+}
+
+// BuildShowOSPFNeighborStatusCommand builds the command to show OSPF neighbors
+func BuildShowOSPFNeighborStatusCommand() string {
+	return "show status ospf neighbor"
+}
@@ -0,0 +1,42 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseOSPFNeighborStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want []OSPFNeighborStatus
+	}{
+		{
+			name: "neighbor table",
+			raw: `Neighbor ID      Pri   State           Dead Time   Address          Interface
+192.168.1.2        1   FULL/BDR        00:00:35    192.168.1.2      LAN1
+10.0.0.2           0   FULL/-          00:00:38    10.255.0.2       TUNNEL1
+192.168.1.3        1   2WAY/DROTHER    00:00:31    192.168.1.3      LAN1
+`,
+			want: []OSPFNeighborStatus{
+				{NeighborID: "192.168.1.2", Priority: 1, State: "full", Role: "bdr", DeadTime: "00:00:35", Address: "192.168.1.2", Interface: "lan1"},
+				{NeighborID: "10.0.0.2", Priority: 0, State: "full", DeadTime: "00:00:38", Address: "10.255.0.2", Interface: "tunnel1"},
+				{NeighborID: "192.168.1.3", Priority: 1, State: "2way", Role: "drother", DeadTime: "00:00:31", Address: "192.168.1.3", Interface: "lan1"},
+			},
+		},
+		{
+			name: "no neighbors",
+			raw:  "Neighbor ID      Pri   State           Dead Time   Address          Interface\n",
+			want: []OSPFNeighborStatus{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseOSPFNeighborStatus(tt.raw)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseOSPFNeighborStatus() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package parsers
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PingOptions holds the parameters of a ping run from the router
+type PingOptions struct {
+	Host           string `json:"host"`                     // Destination host name or address
+	Count          int    `json:"count"`                    // Number of echo requests to send
+	TimeoutSeconds int    `json:"timeout_seconds"`          // Seconds to wait for each reply
+	SourceAddress  string `json:"source_address,omitempty"` // Source address of the echo requests
+	// SourceInterface is an interface whose static IPv4 address is used as the
+	// source address; see PingSourceAddress
+	SourceInterface string `json:"source_interface,omitempty"`
+}
+
+// PingResult represents the summary printed at the end of a ping run
+type PingResult struct {
+	Host              string   `json:"host"`                 // Destination host name or address
+	PacketsSent       int      `json:"packets_sent"`         // Number of echo requests sent
+	PacketsReceived   int      `json:"packets_received"`     // Number of echo replies received
+	PacketLossPercent float64  `json:"packet_loss_percent"`  // Packet loss in percent
+	RTTMinMs          *float64 `json:"rtt_min_ms,omitempty"` // Minimum round-trip time (nil if no reply)
+	RTTAvgMs          *float64 `json:"rtt_avg_ms,omitempty"` // Average round-trip time (nil if no reply)
+	RTTMaxMs          *float64 `json:"rtt_max_ms,omitempty"` // Maximum round-trip time (nil if no reply)
+}
+
+var (
+	pingHostPattern       = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9.:-]*$`)
+	pingStatisticsPattern = regexp.MustCompile(`(\d+)\s+packets? transmitted,\s*(\d+)\s+(?:packets? )?received(?:,.*?([\d.]+)%\s+packet loss)?`)
+	pingRoundTripPattern  = regexp.MustCompile(`(?:round-trip|rtt)\s+min/avg/max(?:/\w+)?\s*=\s*([\d.]+)/([\d.]+)/([\d.]+)`)
+)
+
+// ValidatePingOptions validates the parameters of a ping run
+func ValidatePingOptions(opts PingOptions) error {
+	if !pingHostPattern.MatchString(opts.Host) {
+		return fmt.Errorf("host must be a host name or IP address, got %q", opts.Host)
+	}
+	if opts.Count < 1 || opts.Count > 100 {
+		return fmt.Errorf("count must be between 1 and 100, got %d", opts.Count)
+	}
+	if opts.TimeoutSeconds < 1 || opts.TimeoutSeconds > 60 {
+		return fmt.Errorf("timeout must be between 1 and 60 seconds, got %d", opts.TimeoutSeconds)
+	}
+	if opts.SourceAddress != "" && net.ParseIP(opts.SourceAddress) == nil {
+		return fmt.Errorf("source address must be an IP address, got %q", opts.SourceAddress)
+	}
+	if opts.SourceInterface != "" {
+		if opts.SourceAddress != "" {
+			return fmt.Errorf("source address and source interface cannot both be set")
+		}
+		if strings.Contains(opts.Host, ":") {
+			return fmt.Errorf("source interface is only supported for IPv4 hosts")
+		}
+		if err := ValidateInterfaceName(opts.SourceInterface); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PingSourceAddress returns the address the router sends echo requests from
+// when a source interface is given: the static IPv4 address of the interface,
+// read from its configuration, without the prefix length. The router selects
+// the source by address, so interfaces with a DHCP address are rejected.
+func PingSourceAddress(config *InterfaceConfig) (string, error) {
+	if config.IPAddress == nil || config.IPAddress.Address == "" {
+		return "", fmt.Errorf("interface %s has no static IPv4 address", config.Name)
+	}
+	address, _, _ := strings.Cut(config.IPAddress.Address, "/")
+	return address, nil
+}
+
+// BuildPingCommand builds the ping command. IPv6 destinations use ping6.
+// A source interface must have been resolved to SourceAddress with
+// PingSourceAddress, as the router only takes a source address.
+// Format: ping [-c count] [-w wait] [-sa source] host
+func BuildPingCommand(opts PingOptions) string {
+	parts := []string{"ping"}
+	if strings.Contains(opts.Host, ":") {
+		parts[0] = "ping6"
+	}
+	parts = append(parts, "-c", strconv.Itoa(opts.Count), "-w", strconv.Itoa(opts.TimeoutSeconds))
+	if opts.SourceAddress != "" {
+		parts = append(parts, "-sa", opts.SourceAddress)
+	}
+	parts = append(parts, opts.Host)
+	return strings.Join(parts, " ")
+}
+
+// ParsePingResult parses the statistics printed at the end of a ping run
+func ParsePingResult(host, raw string) (*PingResult, error) {
+	m := pingStatisticsPattern.FindStringSubmatch(raw)
+	if m == nil {
+		return nil, fmt.Errorf("ping statistics not found in output")
+	}
+
+	result := &PingResult{Host: host}
+	result.PacketsSent, _ = strconv.Atoi(m[1])
+	result.PacketsReceived, _ = strconv.Atoi(m[2])
+	if m[3] != "" {
+		result.PacketLossPercent, _ = strconv.ParseFloat(m[3], 64)
+	} else if result.PacketsSent > 0 {
+		result.PacketLossPercent = float64(result.PacketsSent-result.PacketsReceived) * 100 / float64(result.PacketsSent)
+	}
+
+	if rtt := pingRoundTripPattern.FindStringSubmatch(raw); rtt != nil {
+		values := make([]*float64, 3)
+		for i := range values {
+			v, err := strconv.ParseFloat(rtt[i+1], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid round-trip time %q: %w", rtt[i+1], err)
+			}
+			values[i] = &v
+		}
+		result.RTTMinMs, result.RTTAvgMs, result.RTTMaxMs = values[0], values[1], values[2]
+	}
+
+	return result, nil
+}
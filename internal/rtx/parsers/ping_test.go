@@ -0,0 +1,160 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+func TestBuildPingCommand(t *testing.T) {
+	tests := []struct {
+		name string
+		opts PingOptions
+		want string
+	}{
+		{
+			name: "ipv4",
+			opts: PingOptions{Host: "8.8.8.8", Count: 3, TimeoutSeconds: 2},
+			want: "ping -c 3 -w 2 8.8.8.8",
+		},
+		{
+			name: "source address",
+			opts: PingOptions{Host: "www.example.com", Count: 5, TimeoutSeconds: 1, SourceAddress: "192.168.1.1"},
+			want: "ping -c 5 -w 1 -sa 192.168.1.1 www.example.com",
+		},
+		{
+			name: "ipv6",
+			opts: PingOptions{Host: "2001:db8::1", Count: 1, TimeoutSeconds: 1},
+			want: "ping6 -c 1 -w 1 2001:db8::1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BuildPingCommand(tt.opts); got != tt.want {
+				t.Errorf("BuildPingCommand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatePingOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    PingOptions
+		wantErr bool
+	}{
+		{name: "valid", opts: PingOptions{Host: "8.8.8.8", Count: 5, TimeoutSeconds: 1}},
+		{name: "valid source", opts: PingOptions{Host: "example.com", Count: 1, TimeoutSeconds: 1, SourceAddress: "10.0.0.1"}},
+		{name: "empty host", opts: PingOptions{Host: "", Count: 5, TimeoutSeconds: 1}, wantErr: true},
+		{name: "host with option", opts: PingOptions{Host: "-t 8.8.8.8", Count: 5, TimeoutSeconds: 1}, wantErr: true},
+		{name: "host with separator", opts: PingOptions{Host: "8.8.8.8;save", Count: 5, TimeoutSeconds: 1}, wantErr: true},
+		{name: "count too large", opts: PingOptions{Host: "8.8.8.8", Count: 101, TimeoutSeconds: 1}, wantErr: true},
+		{name: "zero timeout", opts: PingOptions{Host: "8.8.8.8", Count: 5, TimeoutSeconds: 0}, wantErr: true},
+		{name: "invalid source", opts: PingOptions{Host: "8.8.8.8", Count: 5, TimeoutSeconds: 1, SourceAddress: "lan1"}, wantErr: true},
+		{name: "valid source interface", opts: PingOptions{Host: "8.8.8.8", Count: 5, TimeoutSeconds: 1, SourceInterface: "lan1"}},
+		{name: "source address and interface", opts: PingOptions{Host: "8.8.8.8", Count: 5, TimeoutSeconds: 1, SourceAddress: "10.0.0.1", SourceInterface: "lan1"}, wantErr: true},
+		{name: "source interface for ipv6", opts: PingOptions{Host: "2001:db8::1", Count: 5, TimeoutSeconds: 1, SourceInterface: "lan1"}, wantErr: true},
+		{name: "invalid source interface", opts: PingOptions{Host: "8.8.8.8", Count: 5, TimeoutSeconds: 1, SourceInterface: "lan1;save"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePingOptions(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePingOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPingSourceAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *InterfaceConfig
+		want    string
+		wantErr bool
+	}{
+		{name: "static address", config: &InterfaceConfig{Name: "lan1", IPAddress: &InterfaceIP{Address: "192.168.1.1/24"}}, want: "192.168.1.1"},
+		{name: "dhcp address", config: &InterfaceConfig{Name: "lan2", IPAddress: &InterfaceIP{DHCP: true}}, wantErr: true},
+		{name: "no address", config: &InterfaceConfig{Name: "lan3"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PingSourceAddress(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PingSourceAddress() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PingSourceAddress() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePingResult(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    *PingResult
+		wantErr bool
+	}{
+		{
+			name: "all replies",
+			raw: `PING 8.8.8.8 (8.8.8.8): 56 data bytes
+64 bytes from 8.8.8.8: icmp_seq=0 ttl=117 time=5.123 ms
+64 bytes from 8.8.8.8: icmp_seq=1 ttl=117 time=4.862 ms
+64 bytes from 8.8.8.8: icmp_seq=2 ttl=117 time=5.051 ms
+
+--- 8.8.8.8 ping statistics ---
+3 packets transmitted, 3 packets received, 0.0% packet loss
+round-trip min/avg/max = 4.862/5.012/5.123 ms
+`,
+			want: &PingResult{
+				Host: "192.0.2.1", PacketsSent: 3, PacketsReceived: 3, PacketLossPercent: 0,
+				RTTMinMs: floatPtr(4.862), RTTAvgMs: floatPtr(5.012), RTTMaxMs: floatPtr(5.123),
+			},
+		},
+		{
+			name: "no replies",
+			raw: `PING 192.0.2.1 (192.0.2.1): 56 data bytes
+
+--- 192.0.2.1 ping statistics ---
+3 packets transmitted, 0 packets received, 100.0% packet loss
+`,
+			want: &PingResult{Host: "192.0.2.1", PacketsSent: 3, PacketsReceived: 0, PacketLossPercent: 100},
+		},
+		{
+			name: "loss not printed",
+			raw:  "4 packets transmitted, 3 received\r\nrtt min/avg/max/mdev = 1.0/2.0/3.0/0.5 ms\r\n",
+			want: &PingResult{
+				Host: "192.0.2.1", PacketsSent: 4, PacketsReceived: 3, PacketLossPercent: 25,
+				RTTMinMs: floatPtr(1), RTTAvgMs: floatPtr(2), RTTMaxMs: floatPtr(3),
+			},
+		},
+		{
+			name:    "unknown host",
+			raw:     "ping: unknown host www.invalid\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePingResult("192.0.2.1", tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePingResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParsePingResult() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
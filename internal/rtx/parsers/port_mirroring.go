@@ -0,0 +1,152 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PortMirroring represents a switching hub port mirroring session on a LAN interface
+type PortMirroring struct {
+	Interface       string `json:"interface"`        // LAN interface (lan1, lan2, ...)
+	DestinationPort int    `json:"destination_port"` // Observer port that receives the mirrored frames
+	SourcePorts     []int  `json:"source_ports"`     // Ports whose traffic is mirrored
+	Direction       string `json:"direction"`        // in, out, or both
+}
+
+// ValidPortMirroringDirections lists the accepted mirroring directions
+var ValidPortMirroringDirections = []string{"in", "out", "both"}
+
+var (
+	portMirroringPattern          = regexp.MustCompile(`^\s*lan\s+port-mirroring\s+(lan\d+)\s+(\d+)\s+(.+?)\s*$`)
+	portMirroringInterfacePattern = regexp.MustCompile(`^lan\d+$`)
+)
+
+// ParsePortMirrorings parses "lan port-mirroring" entries from the router configuration.
+// A session mirroring the same ports in both directions is reported as "both".
+func ParsePortMirrorings(raw string) []PortMirroring {
+	var sessions []PortMirroring
+
+	for _, line := range strings.Split(raw, "\n") {
+		matches := portMirroringPattern.FindStringSubmatch(line)
+		if len(matches) < 4 {
+			continue
+		}
+		dest, err := strconv.Atoi(matches[2])
+		if err != nil {
+			continue
+		}
+
+		ports := make(map[string][]int)
+		fields := strings.Fields(matches[3])
+		for i := 0; i+1 < len(fields); i += 2 {
+			if fields[i] != "in" && fields[i] != "out" {
+				continue
+			}
+			ports[fields[i]] = append(ports[fields[i]], parsePortMirroringPorts(fields[i+1])...)
+		}
+
+		session := PortMirroring{Interface: matches[1], DestinationPort: dest}
+		in, out := ports["in"], ports["out"]
+		switch {
+		case len(in) > 0 && len(out) > 0:
+			session.Direction = "both"
+			session.SourcePorts = mergePortMirroringPorts(in, out)
+		case len(in) > 0:
+			session.Direction = "in"
+			session.SourcePorts = mergePortMirroringPorts(in, nil)
+		case len(out) > 0:
+			session.Direction = "out"
+			session.SourcePorts = mergePortMirroringPorts(out, nil)
+		default:
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions
+}
+
+// parsePortMirroringPorts parses a comma-separated port list (e.g., "2,3")
+func parsePortMirroringPorts(s string) []int {
+	var ports []int
+	for _, p := range strings.Split(s, ",") {
+		if n, err := strconv.Atoi(p); err == nil {
+			ports = append(ports, n)
+		}
+	}
+	return ports
+}
+
+// mergePortMirroringPorts returns the sorted, de-duplicated union of two port lists
+func mergePortMirroringPorts(a, b []int) []int {
+	seen := make(map[int]bool)
+	var ports []int
+	for _, p := range append(append([]int{}, a...), b...) {
+		if !seen[p] {
+			seen[p] = true
+			ports = append(ports, p)
+		}
+	}
+	sort.Ints(ports)
+	return ports
+}
+
+// BuildPortMirroringCommand builds the command to start a mirroring session
+// Command format: lan port-mirroring <interface> <observer> <direction> <ports> [<direction> <ports>]
+func BuildPortMirroringCommand(session PortMirroring) string {
+	ports := make([]string, len(session.SourcePorts))
+	for i, p := range session.SourcePorts {
+		ports[i] = strconv.Itoa(p)
+	}
+	list := strings.Join(ports, ",")
+
+	if session.Direction == "both" {
+		return fmt.Sprintf("lan port-mirroring %s %d in %s out %s", session.Interface, session.DestinationPort, list, list)
+	}
+	return fmt.Sprintf("lan port-mirroring %s %d %s %s", session.Interface, session.DestinationPort, session.Direction, list)
+}
+
+// BuildDeletePortMirroringCommand builds the command to stop a mirroring session
+// Command format: no lan port-mirroring <interface>
+func BuildDeletePortMirroringCommand(iface string) string {
+	return fmt.Sprintf("no lan port-mirroring %s", iface)
+}
+
+// BuildShowPortMirroringCommand builds the command to show mirroring sessions
+func BuildShowPortMirroringCommand() string {
+	return `show config | grep "lan port-mirroring"`
+}
+
+// ValidatePortMirroring validates a port mirroring session
+func ValidatePortMirroring(session PortMirroring) error {
+	if !portMirroringInterfacePattern.MatchString(session.Interface) {
+		return fmt.Errorf("interface must be a LAN interface (e.g., lan1), got %q", session.Interface)
+	}
+	if session.DestinationPort < 1 {
+		return fmt.Errorf("destination_port must be a positive port number, got %d", session.DestinationPort)
+	}
+	if len(session.SourcePorts) == 0 {
+		return fmt.Errorf("at least one source port is required")
+	}
+	seen := make(map[int]bool)
+	for _, p := range session.SourcePorts {
+		if p < 1 {
+			return fmt.Errorf("source port must be a positive port number, got %d", p)
+		}
+		if p == session.DestinationPort {
+			return fmt.Errorf("port %d cannot be both a source and the destination port", p)
+		}
+		if seen[p] {
+			return fmt.Errorf("duplicate source port %d", p)
+		}
+		seen[p] = true
+	}
+	if !slices.Contains(ValidPortMirroringDirections, session.Direction) {
+		return fmt.Errorf("direction must be one of %v, got %q", ValidPortMirroringDirections, session.Direction)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePortMirrorings(t *testing.T) {
+	raw := `lan port-mirroring lan1 1 in 2,3 out 2,3
+lan port-mirroring lan2 8 out 4
+lan port-mirroring lan3 1 in 2 out 3
+lan type lan1 port-based-option=divide-network`
+
+	want := []PortMirroring{
+		{Interface: "lan1", DestinationPort: 1, SourcePorts: []int{2, 3}, Direction: "both"},
+		{Interface: "lan2", DestinationPort: 8, SourcePorts: []int{4}, Direction: "out"},
+		{Interface: "lan3", DestinationPort: 1, SourcePorts: []int{2, 3}, Direction: "both"},
+	}
+
+	if got := ParsePortMirrorings(raw); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParsePortMirrorings() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildPortMirroringCommands(t *testing.T) {
+	tests := []struct {
+		name    string
+		session PortMirroring
+		want    string
+	}{
+		{
+			name:    "both directions",
+			session: PortMirroring{Interface: "lan1", DestinationPort: 1, SourcePorts: []int{2, 3}, Direction: "both"},
+			want:    "lan port-mirroring lan1 1 in 2,3 out 2,3",
+		},
+		{
+			name:    "ingress only",
+			session: PortMirroring{Interface: "lan1", DestinationPort: 4, SourcePorts: []int{1}, Direction: "in"},
+			want:    "lan port-mirroring lan1 4 in 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BuildPortMirroringCommand(tt.session); got != tt.want {
+				t.Errorf("BuildPortMirroringCommand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got, want := BuildDeletePortMirroringCommand("lan1"), "no lan port-mirroring lan1"; got != want {
+		t.Errorf("BuildDeletePortMirroringCommand() = %q, want %q", got, want)
+	}
+}
+
+func TestValidatePortMirroring(t *testing.T) {
+	tests := []struct {
+		name    string
+		session PortMirroring
+		wantErr bool
+	}{
+		{name: "valid", session: PortMirroring{Interface: "lan1", DestinationPort: 1, SourcePorts: []int{2, 3}, Direction: "both"}},
+		{name: "not lan", session: PortMirroring{Interface: "pp1", DestinationPort: 1, SourcePorts: []int{2}, Direction: "in"}, wantErr: true},
+		{name: "no source ports", session: PortMirroring{Interface: "lan1", DestinationPort: 1, Direction: "in"}, wantErr: true},
+		{name: "destination is source", session: PortMirroring{Interface: "lan1", DestinationPort: 1, SourcePorts: []int{1, 2}, Direction: "in"}, wantErr: true},
+		{name: "duplicate source", session: PortMirroring{Interface: "lan1", DestinationPort: 1, SourcePorts: []int{2, 2}, Direction: "in"}, wantErr: true},
+		{name: "invalid direction", session: PortMirroring{Interface: "lan1", DestinationPort: 1, SourcePorts: []int{2}, Direction: "rx"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePortMirroring(tt.session)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePortMirroring() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
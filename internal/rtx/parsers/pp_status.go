@@ -0,0 +1,121 @@
+package parsers
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PPStatus represents the session state of a PP interface as reported by "show status pp N"
+type PPStatus struct {
+	PPNumber           int      `json:"pp_number"`                     // PP interface number
+	Connected          bool     `json:"connected"`                     // Session is established
+	LocalAddress       string   `json:"local_address,omitempty"`       // Address assigned by IPCP
+	RemoteAddress      string   `json:"remote_address,omitempty"`      // Peer address from IPCP
+	DNSServers         []string `json:"dns_servers"`                   // DNS servers notified by IPCP
+	UptimeSeconds      int      `json:"uptime_seconds"`                // Time connected in seconds
+	AccessConcentrator string   `json:"access_concentrator,omitempty"` // PPPoE access concentrator name
+}
+
+var (
+	ppStatusHeaderPattern       = regexp.MustCompile(`^\s*PP\[(\d+)\]`)
+	ppStatusDisconnectedPattern = regexp.MustCompile(`(?i)not\s+connected|disconnected|接続されていません|切断`)
+	ppStatusConnectedPattern    = regexp.MustCompile(`(?i)\bconnected\b|接続されています`)
+	ppStatusUptimePattern       = regexp.MustCompile(`(?i)^\s*(?:time\s+connected|通信時間)\s*:\s*(.+?)\s*$`)
+	ppStatusClockPattern        = regexp.MustCompile(`(\d+):(\d{2}):(\d{2})`)
+	ppStatusDurationPattern     = regexp.MustCompile(`(?i)(\d+)\s*(days?|日|hours?|時間|minutes?|mins?|分|seconds?|secs?|秒)`)
+	ppStatusLocalPattern        = regexp.MustCompile(`(?i)(?:local\s+ip\s+address|pp\s+ip\s+address\s+local)\s*:\s*([\d.]+)`)
+	ppStatusRemotePattern       = regexp.MustCompile(`(?i)remote(?:\s+ip\s+address)?\s*:\s*([\d.]+)`)
+	ppStatusDNSPattern          = regexp.MustCompile(`(?i)dns`)
+	ppStatusACPattern           = regexp.MustCompile(`(?i)^\s*(?:access\s+concentrator|接続先)\s*:\s*(\S+)`)
+	ppStatusIPv4Pattern         = regexp.MustCompile(`\b\d{1,3}(?:\.\d{1,3}){3}\b`)
+)
+
+// ParsePPStatus parses the output of "show status pp N"
+func ParsePPStatus(ppNum int, raw string) (*PPStatus, error) {
+	status := &PPStatus{PPNumber: ppNum, DNSServers: []string{}}
+	found := false
+
+	for _, line := range strings.Split(raw, "\n") {
+		if matches := ppStatusHeaderPattern.FindStringSubmatch(line); len(matches) == 2 {
+			n, _ := strconv.Atoi(matches[1])
+			found = n == ppNum
+			continue
+		}
+		if !found {
+			continue
+		}
+
+		if matches := ppStatusUptimePattern.FindStringSubmatch(line); len(matches) == 2 {
+			status.UptimeSeconds = parsePPStatusDuration(matches[1])
+			continue
+		}
+		if matches := ppStatusACPattern.FindStringSubmatch(line); len(matches) == 2 {
+			status.AccessConcentrator = matches[1]
+			continue
+		}
+		if ppStatusDNSPattern.MatchString(line) {
+			for _, addr := range ppStatusIPv4Pattern.FindAllString(line, -1) {
+				if net.ParseIP(addr) != nil {
+					status.DNSServers = append(status.DNSServers, addr)
+				}
+			}
+			continue
+		}
+		if matches := ppStatusLocalPattern.FindStringSubmatch(line); len(matches) == 2 {
+			status.LocalAddress = matches[1]
+		}
+		if matches := ppStatusRemotePattern.FindStringSubmatch(line); len(matches) == 2 {
+			status.RemoteAddress = matches[1]
+		}
+		if strings.Contains(strings.ToLower(line), "session") || strings.Contains(line, "セッション") {
+			switch {
+			case ppStatusDisconnectedPattern.MatchString(line):
+				status.Connected = false
+			case ppStatusConnectedPattern.MatchString(line):
+				status.Connected = true
+			}
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("status of pp %d not found", ppNum)
+	}
+	return status, nil
+}
+
+// parsePPStatusDuration converts a connection time such as "1day 02:13:02" or
+// "3日 2時間 13分 2秒" to seconds
+func parsePPStatusDuration(s string) int {
+	seconds := 0
+	if matches := ppStatusClockPattern.FindStringSubmatch(s); len(matches) == 4 {
+		h, _ := strconv.Atoi(matches[1])
+		m, _ := strconv.Atoi(matches[2])
+		sec, _ := strconv.Atoi(matches[3])
+		seconds += h*3600 + m*60 + sec
+		s = strings.Replace(s, matches[0], "", 1)
+	}
+
+	for _, matches := range ppStatusDurationPattern.FindAllStringSubmatch(s, -1) {
+		n, _ := strconv.Atoi(matches[1])
+		unit := strings.ToLower(matches[2])
+		switch {
+		case strings.HasPrefix(unit, "day") || unit == "日":
+			seconds += n * 86400
+		case strings.HasPrefix(unit, "hour") || unit == "時間":
+			seconds += n * 3600
+		case strings.HasPrefix(unit, "min") || unit == "分":
+			seconds += n * 60
+		default:
+			seconds += n
+		}
+	}
+	return seconds
+}
+
+// BuildShowPPStatusCommand builds the command to show the session state of a PP interface
+func BuildShowPPStatusCommand(ppNum int) string {
+	return fmt.Sprintf("show status pp %d", ppNum)
+}
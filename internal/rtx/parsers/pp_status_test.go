@@ -0,0 +1,86 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePPStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		ppNum   int
+		raw     string
+		want    *PPStatus
+		wantErr bool
+	}{
+		{
+			name:  "connected",
+			ppNum: 1,
+			raw: `PP[01]:
+Description: ISP
+PPPoE session is connected.
+Access Concentrator: BAS01
+Time Connected: 1day 02:13:02
+Received: 12345 packets [1234567 octets]
+Transmitted: 2345 packets [234567 octets]
+LCP Status: Opened
+IPCP Status: Opened
+ PP IP Address Local: 203.0.113.10, Remote: 203.0.113.1
+ PP DNS Server: primary 198.51.100.1, secondary 198.51.100.2`,
+			want: &PPStatus{
+				PPNumber:           1,
+				Connected:          true,
+				LocalAddress:       "203.0.113.10",
+				RemoteAddress:      "203.0.113.1",
+				DNSServers:         []string{"198.51.100.1", "198.51.100.2"},
+				UptimeSeconds:      94382,
+				AccessConcentrator: "BAS01",
+			},
+		},
+		{
+			name:  "connected japanese",
+			ppNum: 2,
+			raw: `PP[02]:
+説明:
+PPPoEセッションは接続されています
+接続先: BAS02
+通信時間: 3日 2時間 13分 2秒
+ PP IP Address Local: 203.0.113.20, Remote: 203.0.113.1`,
+			want: &PPStatus{
+				PPNumber:           2,
+				Connected:          true,
+				LocalAddress:       "203.0.113.20",
+				RemoteAddress:      "203.0.113.1",
+				DNSServers:         []string{},
+				UptimeSeconds:      267182,
+				AccessConcentrator: "BAS02",
+			},
+		},
+		{
+			name:  "disconnected",
+			ppNum: 1,
+			raw: `PP[01]:
+Description:
+PPPoE session is not connected.`,
+			want: &PPStatus{PPNumber: 1, DNSServers: []string{}},
+		},
+		{
+			name:    "other pp",
+			ppNum:   3,
+			raw:     "PP[01]:\nPPPoE session is connected.",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePPStatus(tt.ppNum, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePPStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParsePPStatus() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
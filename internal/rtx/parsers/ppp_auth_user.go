@@ -0,0 +1,141 @@
+package parsers
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PPAuthUser represents a "pp auth username" entry used to authenticate
+// dial-in, PPTP and L2TP users
+type PPAuthUser struct {
+	PP        string `json:"pp"`                   // PP selector: "anonymous" or a PP number
+	Username  string `json:"username"`             // Login username
+	Password  string `json:"password"`             // Login password
+	IPAddress string `json:"ip_address,omitempty"` // Fixed address assigned to the user
+}
+
+var (
+	ppContextSelectPattern    = regexp.MustCompile(`^\s*pp\s+select\s+(anonymous|\d+|none)\s*$`)
+	ppAuthUserUsernamePattern = regexp.MustCompile(`^\s*pp\s+auth\s+username\s+(\S+)\s+(\S+)(?:\s+(\S+))?`)
+	ppSelectorPattern         = regexp.MustCompile(`^(anonymous|\d+)$`)
+)
+
+// ParsePPAuthUsers parses "pp auth username" entries from the router configuration.
+// Each entry is attributed to the most recent "pp select" context.
+func ParsePPAuthUsers(raw string) []PPAuthUser {
+	var users []PPAuthUser
+	currentPP := ""
+
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if matches := ppContextSelectPattern.FindStringSubmatch(line); len(matches) >= 2 {
+			currentPP = matches[1]
+			if currentPP == "none" {
+				currentPP = ""
+			}
+			continue
+		}
+
+		// Any other top-level select leaves the PP context
+		if strings.HasPrefix(line, "tunnel select ") {
+			currentPP = ""
+			continue
+		}
+
+		if currentPP == "" {
+			continue
+		}
+
+		if matches := ppAuthUserUsernamePattern.FindStringSubmatch(line); len(matches) >= 3 {
+			user := PPAuthUser{
+				PP:       currentPP,
+				Username: matches[1],
+				Password: matches[2],
+			}
+			if len(matches) >= 4 && net.ParseIP(matches[3]) != nil {
+				user.IPAddress = matches[3]
+			}
+			users = append(users, user)
+		}
+	}
+
+	return users
+}
+
+// BuildPPSelectByNameCommand builds the command to enter a PP context by selector
+// Command format: pp select <anonymous|num>
+func BuildPPSelectByNameCommand(pp string) string {
+	return fmt.Sprintf("pp select %s", pp)
+}
+
+// BuildPPAuthUsernameCommand builds the command to add or replace a PP auth user
+// Command format: pp auth username <username> <password> [<ip_address>]
+func BuildPPAuthUsernameCommand(user PPAuthUser) string {
+	cmd := fmt.Sprintf("pp auth username %s %s", user.Username, user.Password)
+	if user.IPAddress != "" {
+		cmd += " " + user.IPAddress
+	}
+	return cmd
+}
+
+// BuildDeletePPAuthUsernameCommand builds the command to remove a PP auth user
+// Command format: no pp auth username <username>
+func BuildDeletePPAuthUsernameCommand(username string) string {
+	return fmt.Sprintf("no pp auth username %s", username)
+}
+
+// BuildPPAuthUserCommands builds the commands to configure a PP auth user,
+// entering the PP context and returning to the global context afterwards
+func BuildPPAuthUserCommands(user PPAuthUser) []string {
+	return []string{
+		BuildPPSelectByNameCommand(user.PP),
+		BuildPPAuthUsernameCommand(user),
+		"pp select none",
+	}
+}
+
+// BuildDeletePPAuthUserCommands builds the commands to remove a PP auth user
+func BuildDeletePPAuthUserCommands(pp, username string) []string {
+	return []string{
+		BuildPPSelectByNameCommand(pp),
+		BuildDeletePPAuthUsernameCommand(username),
+		"pp select none",
+	}
+}
+
+// BuildShowPPAuthUsersCommand builds the command to show PP auth users.
+// The full configuration is needed to attribute entries to their PP context.
+func BuildShowPPAuthUsersCommand() string {
+	return "show config"
+}
+
+// ValidatePPAuthUser validates a PP auth user
+func ValidatePPAuthUser(user PPAuthUser) error {
+	if !ppSelectorPattern.MatchString(user.PP) {
+		return fmt.Errorf("pp must be 'anonymous' or a PP number, got %q", user.PP)
+	}
+	if user.PP != "anonymous" {
+		if num, _ := strconv.Atoi(user.PP); num < 1 {
+			return fmt.Errorf("PP number must be positive, got %s", user.PP)
+		}
+	}
+	if user.Username == "" || strings.ContainsAny(user.Username, " \t\"") {
+		return fmt.Errorf("username must be non-empty and must not contain whitespace or quotes")
+	}
+	if user.Password == "" || strings.ContainsAny(user.Password, " \t\"") {
+		return fmt.Errorf("password must be non-empty and must not contain whitespace or quotes")
+	}
+	if user.IPAddress != "" {
+		if ip := net.ParseIP(user.IPAddress); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("invalid IPv4 address: %s", user.IPAddress)
+		}
+	}
+	return nil
+}
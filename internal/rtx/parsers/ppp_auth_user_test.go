@@ -0,0 +1,72 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePPAuthUsers(t *testing.T) {
+	raw := `pp auth username ignored pass
+pp select anonymous
+ pp bind tunnel1
+ pp auth accept chap
+ pp auth username alice secret1
+ pp auth username bob secret2 192.168.100.10
+pp select 2
+ pp auth username carol secret3
+tunnel select 1
+ pp auth username ignored2 pass`
+
+	want := []PPAuthUser{
+		{PP: "anonymous", Username: "alice", Password: "secret1"},
+		{PP: "anonymous", Username: "bob", Password: "secret2", IPAddress: "192.168.100.10"},
+		{PP: "2", Username: "carol", Password: "secret3"},
+	}
+
+	if got := ParsePPAuthUsers(raw); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParsePPAuthUsers() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildPPAuthUserCommands(t *testing.T) {
+	user := PPAuthUser{PP: "anonymous", Username: "bob", Password: "secret2", IPAddress: "192.168.100.10"}
+
+	want := []string{
+		"pp select anonymous",
+		"pp auth username bob secret2 192.168.100.10",
+		"pp select none",
+	}
+	if got := BuildPPAuthUserCommands(user); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildPPAuthUserCommands() = %v, want %v", got, want)
+	}
+
+	wantDelete := []string{"pp select 2", "no pp auth username carol", "pp select none"}
+	if got := BuildDeletePPAuthUserCommands("2", "carol"); !reflect.DeepEqual(got, wantDelete) {
+		t.Errorf("BuildDeletePPAuthUserCommands() = %v, want %v", got, wantDelete)
+	}
+}
+
+func TestValidatePPAuthUser(t *testing.T) {
+	tests := []struct {
+		name    string
+		user    PPAuthUser
+		wantErr bool
+	}{
+		{name: "anonymous", user: PPAuthUser{PP: "anonymous", Username: "alice", Password: "secret"}},
+		{name: "numbered pp with address", user: PPAuthUser{PP: "1", Username: "alice", Password: "secret", IPAddress: "192.168.100.10"}},
+		{name: "invalid pp", user: PPAuthUser{PP: "lan1", Username: "alice", Password: "secret"}, wantErr: true},
+		{name: "pp zero", user: PPAuthUser{PP: "0", Username: "alice", Password: "secret"}, wantErr: true},
+		{name: "empty username", user: PPAuthUser{PP: "anonymous", Password: "secret"}, wantErr: true},
+		{name: "password with space", user: PPAuthUser{PP: "anonymous", Username: "alice", Password: "a b"}, wantErr: true},
+		{name: "invalid address", user: PPAuthUser{PP: "anonymous", Username: "alice", Password: "secret", IPAddress: "300.1.1.1"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePPAuthUser(tt.user)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePPAuthUser() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
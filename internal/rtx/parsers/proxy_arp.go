@@ -0,0 +1,65 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ProxyARPConfig represents the interfaces answering ARP requests on behalf of other hosts
+type ProxyARPConfig struct {
+	Interfaces []string `json:"interfaces"` // Interfaces with "ip <interface> proxyarp on"
+}
+
+var (
+	proxyARPPattern          = regexp.MustCompile(`^\s*ip\s+(\S+)\s+proxyarp\s+(on|off)\s*$`)
+	proxyARPInterfacePattern = regexp.MustCompile(`^(lan\d+(/\d+)?|bridge\d+)$`)
+)
+
+// ParseProxyARPConfig parses "ip <interface> proxyarp" settings from the router configuration
+func ParseProxyARPConfig(raw string) *ProxyARPConfig {
+	enabled := make(map[string]bool)
+
+	for _, line := range strings.Split(raw, "\n") {
+		if matches := proxyARPPattern.FindStringSubmatch(line); len(matches) == 3 {
+			enabled[matches[1]] = matches[2] == "on"
+		}
+	}
+
+	config := &ProxyARPConfig{Interfaces: []string{}}
+	for iface, on := range enabled {
+		if on {
+			config.Interfaces = append(config.Interfaces, iface)
+		}
+	}
+	sort.Strings(config.Interfaces)
+
+	return config
+}
+
+// BuildDeleteProxyARPCommand builds the command to restore the default (off) for an interface
+// Command format: no ip <interface> proxyarp
+func BuildDeleteProxyARPCommand(iface string) string {
+	return fmt.Sprintf("no ip %s proxyarp", iface)
+}
+
+// BuildShowProxyARPCommand builds the command to show proxy ARP settings
+func BuildShowProxyARPCommand() string {
+	return `show config | grep proxyarp`
+}
+
+// ValidateProxyARPConfig validates the proxy ARP configuration
+func ValidateProxyARPConfig(config ProxyARPConfig) error {
+	seen := make(map[string]bool, len(config.Interfaces))
+	for _, iface := range config.Interfaces {
+		if !proxyARPInterfacePattern.MatchString(iface) {
+			return fmt.Errorf("proxy ARP interface must be a LAN or bridge interface (e.g., lan1, lan1/2, bridge1), got %q", iface)
+		}
+		if seen[iface] {
+			return fmt.Errorf("duplicate proxy ARP interface %q", iface)
+		}
+		seen[iface] = true
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseProxyARPConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "none",
+			input:    "ip lan1 address 192.168.1.1/24\n",
+			expected: []string{},
+		},
+		{
+			name:     "multiple interfaces",
+			input:    "ip lan2 proxyarp on\nip lan1 address 192.168.1.1/24\nip lan1 proxyarp on\nip bridge1 proxyarp off\n",
+			expected: []string{"lan1", "lan2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ParseProxyARPConfig(tt.input)
+			if !reflect.DeepEqual(result.Interfaces, tt.expected) {
+				t.Errorf("ParseProxyARPConfig() = %v, want %v", result.Interfaces, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateProxyARPConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		interfaces []string
+		wantErr    bool
+	}{
+		{name: "lan and bridge", interfaces: []string{"lan1", "lan1/2", "bridge1"}},
+		{name: "pp interface", interfaces: []string{"pp1"}, wantErr: true},
+		{name: "duplicate", interfaces: []string{"lan1", "lan1"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateProxyARPConfig(ProxyARPConfig{Interfaces: tt.interfaces}); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateProxyARPConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// QoSStatus represents the queue statistics of an interface as reported by "show status qos"
+type QoSStatus struct {
+	Interface string           `json:"interface"`            // Interface name (lan1, lan2, ...)
+	QueueType string           `json:"queue_type,omitempty"` // Queuing type as reported by the router
+	Classes   []QoSClassStatus `json:"classes"`              // Statistics per class, ordered by class number
+}
+
+// QoSClassStatus represents the statistics of a queue class
+type QoSClassStatus struct {
+	Class          int   `json:"class"`                 // Class number
+	SentPackets    int64 `json:"sent_packets"`          // Packets transmitted from the class
+	SentOctets     int64 `json:"sent_octets"`           // Octets transmitted from the class
+	DroppedPackets int64 `json:"dropped_packets"`       // Packets discarded because the queue was full
+	QueueLength    int   `json:"queue_length"`          // Packets currently queued
+	QueueLimit     int   `json:"queue_limit,omitempty"` // Maximum queue length
+}
+
+var (
+	qosStatusTypePattern    = regexp.MustCompile(`(?i)^\s*(?:queu(?:e|ing)\s+type|キューイング(?:タイプ|方式))\s*:\s*(.+?)\s*$`)
+	qosStatusRowPattern     = regexp.MustCompile(`^\s*(\d+)\s+(\d+)\s+(\d+)\s+(\d+)\s+(\d+)(?:\s*/\s*(\d+))?\s*$`)
+	qosStatusClassPattern   = regexp.MustCompile(`(?i)^\s*(?:class|クラス)\s*\[?(\d+)\]?\s*:?\s*$`)
+	qosStatusSentPattern    = regexp.MustCompile(`(?i)^\s*(?:sent|transmitted|送信)[^:]*:\s*(\d+)\s*(?:packets?|パケット)\s*\(\s*(\d+)\s*(?:octets?|bytes?|オクテット|バイト)\s*\)`)
+	qosStatusDroppedPattern = regexp.MustCompile(`(?i)^\s*(?:dropped|discarded|破棄)[^:]*:\s*(\d+)`)
+	qosStatusLengthPattern  = regexp.MustCompile(`(?i)^\s*(?:queue\s+length|キュー長)\s*:\s*(\d+)(?:\s*/\s*(\d+))?`)
+)
+
+// ParseQoSStatus parses the output of "show status qos <interface>". Both the
+// tabular layout (class, sent packets, sent octets, dropped, length[/limit])
+// and the labeled per-class layout are accepted.
+func ParseQoSStatus(iface, raw string) *QoSStatus {
+	status := &QoSStatus{Interface: iface}
+	classes := make(map[int]*QoSClassStatus)
+	current := 0
+
+	getClass := func(n int) *QoSClassStatus {
+		c, exists := classes[n]
+		if !exists {
+			c = &QoSClassStatus{Class: n}
+			classes[n] = c
+		}
+		return c
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n") {
+		if matches := qosStatusTypePattern.FindStringSubmatch(line); len(matches) == 2 {
+			status.QueueType = strings.ToLower(matches[1])
+			continue
+		}
+		if matches := qosStatusRowPattern.FindStringSubmatch(line); len(matches) == 7 {
+			n, _ := strconv.Atoi(matches[1])
+			c := getClass(n)
+			c.SentPackets, _ = strconv.ParseInt(matches[2], 10, 64)
+			c.SentOctets, _ = strconv.ParseInt(matches[3], 10, 64)
+			c.DroppedPackets, _ = strconv.ParseInt(matches[4], 10, 64)
+			c.QueueLength, _ = strconv.Atoi(matches[5])
+			c.QueueLimit, _ = strconv.Atoi(matches[6])
+			current = 0
+			continue
+		}
+		if matches := qosStatusClassPattern.FindStringSubmatch(line); len(matches) == 2 {
+			current, _ = strconv.Atoi(matches[1])
+			getClass(current)
+			continue
+		}
+		if current == 0 {
+			continue
+		}
+		if matches := qosStatusSentPattern.FindStringSubmatch(line); len(matches) == 3 {
+			c := getClass(current)
+			c.SentPackets, _ = strconv.ParseInt(matches[1], 10, 64)
+			c.SentOctets, _ = strconv.ParseInt(matches[2], 10, 64)
+			continue
+		}
+		if matches := qosStatusDroppedPattern.FindStringSubmatch(line); len(matches) == 2 {
+			getClass(current).DroppedPackets, _ = strconv.ParseInt(matches[1], 10, 64)
+			continue
+		}
+		if matches := qosStatusLengthPattern.FindStringSubmatch(line); len(matches) == 3 {
+			c := getClass(current)
+			c.QueueLength, _ = strconv.Atoi(matches[1])
+			c.QueueLimit, _ = strconv.Atoi(matches[2])
+		}
+	}
+
+	status.Classes = make([]QoSClassStatus, 0, len(classes))
+	for _, c := range classes {
+		status.Classes = append(status.Classes, *c)
+	}
+	sort.Slice(status.Classes, func(i, j int) bool {
+		return status.Classes[i].Class < status.Classes[j].Class
+	})
+	return status
+}
+
+// BuildShowQoSStatusCommand builds the command to show the queue statistics of an interface
+func BuildShowQoSStatusCommand(iface string) string {
+	return fmt.Sprintf("show status qos %s", iface)
+}
@@ -0,0 +1,67 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseQoSStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want *QoSStatus
+	}{
+		{
+			name: "tabular output",
+			raw: `Interface:      LAN2
+Queuing type:   Priority
+Class  Sent(packets)  Sent(octets)  Dropped  Queue length
+    1          1200        153600        0       0/200
+    2         34000      40800000       12     15/200
+`,
+			want: &QoSStatus{
+				Interface: "lan2",
+				QueueType: "priority",
+				Classes: []QoSClassStatus{
+					{Class: 1, SentPackets: 1200, SentOctets: 153600, QueueLimit: 200},
+					{Class: 2, SentPackets: 34000, SentOctets: 40800000, DroppedPackets: 12, QueueLength: 15, QueueLimit: 200},
+				},
+			},
+		},
+		{
+			name: "labeled Japanese output",
+			raw: `キューイングタイプ: CBQ
+クラス 2
+  送信: 500 パケット (64000 オクテット)
+  破棄: 3 パケット
+  キュー長: 1/32
+クラス 1
+  送信: 10 パケット (1280 オクテット)
+  破棄: 0 パケット
+  キュー長: 0/32
+`,
+			want: &QoSStatus{
+				Interface: "lan2",
+				QueueType: "cbq",
+				Classes: []QoSClassStatus{
+					{Class: 1, SentPackets: 10, SentOctets: 1280, QueueLimit: 32},
+					{Class: 2, SentPackets: 500, SentOctets: 64000, DroppedPackets: 3, QueueLength: 1, QueueLimit: 32},
+				},
+			},
+		},
+		{
+			name: "queuing not configured",
+			raw:  "\n",
+			want: &QoSStatus{Interface: "lan2", Classes: []QoSClassStatus{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseQoSStatus("lan2", tt.raw)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseQoSStatus() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
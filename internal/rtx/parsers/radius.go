@@ -0,0 +1,183 @@
+package parsers
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// RADIUS client defaults applied by the router when not configured
+const (
+	DefaultRADIUSAuthPort = 1812
+	DefaultRADIUSRetry    = 4
+	DefaultRADIUSWait     = 9
+)
+
+// RADIUSConfig represents the RADIUS client configuration used for PPP and login authentication
+type RADIUSConfig struct {
+	Auth       bool     `json:"auth"`       // radius auth on|off
+	Accounting bool     `json:"accounting"` // radius account on|off
+	Servers    []string `json:"servers"`    // RADIUS server addresses (primary, secondary)
+	Secret     string   `json:"secret"`     // Shared secret
+	AuthPort   int      `json:"auth_port"`  // Authentication UDP port
+	Retry      int      `json:"retry"`      // Retransmissions before giving up on a server
+	Wait       int      `json:"wait"`       // Seconds to wait for a reply
+}
+
+var (
+	radiusAuthPattern     = regexp.MustCompile(`^\s*radius\s+auth\s+(on|off)\s*$`)
+	radiusAccountPattern  = regexp.MustCompile(`^\s*radius\s+account\s+(on|off)\s*$`)
+	radiusServerPattern   = regexp.MustCompile(`^\s*radius\s+server\s+(.+?)\s*$`)
+	radiusSecretPattern   = regexp.MustCompile(`^\s*radius\s+secret\s+(\S+)\s*$`)
+	radiusAuthPortPattern = regexp.MustCompile(`^\s*radius\s+auth\s+port\s+(\d+)\s*$`)
+	radiusRetryPattern    = regexp.MustCompile(`^\s*radius\s+retry\s+(\d+)\s*$`)
+	radiusWaitPattern     = regexp.MustCompile(`^\s*radius\s+wait\s+(\d+)\s*$`)
+)
+
+// ParseRADIUSConfig parses "radius" lines from the router configuration.
+// Returns nil when no RADIUS server is configured.
+func ParseRADIUSConfig(raw string) *RADIUSConfig {
+	config := &RADIUSConfig{
+		AuthPort: DefaultRADIUSAuthPort,
+		Retry:    DefaultRADIUSRetry,
+		Wait:     DefaultRADIUSWait,
+	}
+
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		switch {
+		case radiusAuthPattern.MatchString(line):
+			config.Auth = radiusAuthPattern.FindStringSubmatch(line)[1] == "on"
+		case radiusAccountPattern.MatchString(line):
+			config.Accounting = radiusAccountPattern.FindStringSubmatch(line)[1] == "on"
+		case radiusAuthPortPattern.MatchString(line):
+			config.AuthPort, _ = strconv.Atoi(radiusAuthPortPattern.FindStringSubmatch(line)[1])
+		case radiusServerPattern.MatchString(line):
+			config.Servers = strings.Fields(radiusServerPattern.FindStringSubmatch(line)[1])
+		case radiusSecretPattern.MatchString(line):
+			config.Secret = radiusSecretPattern.FindStringSubmatch(line)[1]
+		case radiusRetryPattern.MatchString(line):
+			config.Retry, _ = strconv.Atoi(radiusRetryPattern.FindStringSubmatch(line)[1])
+		case radiusWaitPattern.MatchString(line):
+			config.Wait, _ = strconv.Atoi(radiusWaitPattern.FindStringSubmatch(line)[1])
+		}
+	}
+
+	if len(config.Servers) == 0 {
+		return nil
+	}
+	return config
+}
+
+// BuildRADIUSAuthCommand builds the command to enable or disable RADIUS authentication
+// Command format: radius auth on|off
+func BuildRADIUSAuthCommand(enabled bool) string {
+	if enabled {
+		return "radius auth on"
+	}
+	return "radius auth off"
+}
+
+// BuildRADIUSAccountCommand builds the command to enable or disable RADIUS accounting
+// Command format: radius account on|off
+func BuildRADIUSAccountCommand(enabled bool) string {
+	if enabled {
+		return "radius account on"
+	}
+	return "radius account off"
+}
+
+// BuildRADIUSServerCommand builds the command to set the RADIUS servers
+// Command format: radius server <ip> [<ip>]
+func BuildRADIUSServerCommand(servers []string) string {
+	return fmt.Sprintf("radius server %s", strings.Join(servers, " "))
+}
+
+// BuildRADIUSSecretCommand builds the command to set the shared secret
+// Command format: radius secret <secret>
+func BuildRADIUSSecretCommand(secret string) string {
+	return fmt.Sprintf("radius secret %s", secret)
+}
+
+// BuildRADIUSAuthPortCommand builds the command to set the authentication port
+// Command format: radius auth port <port>
+func BuildRADIUSAuthPortCommand(port int) string {
+	return fmt.Sprintf("radius auth port %d", port)
+}
+
+// BuildRADIUSRetryCommand builds the command to set the retransmission count
+// Command format: radius retry <count>
+func BuildRADIUSRetryCommand(retry int) string {
+	return fmt.Sprintf("radius retry %d", retry)
+}
+
+// BuildRADIUSWaitCommand builds the command to set the reply timeout
+// Command format: radius wait <seconds>
+func BuildRADIUSWaitCommand(wait int) string {
+	return fmt.Sprintf("radius wait %d", wait)
+}
+
+// BuildRADIUSCommands builds all commands needed to apply a RADIUS configuration
+func BuildRADIUSCommands(config RADIUSConfig) []string {
+	return []string{
+		BuildRADIUSServerCommand(config.Servers),
+		BuildRADIUSSecretCommand(config.Secret),
+		BuildRADIUSAuthPortCommand(config.AuthPort),
+		BuildRADIUSRetryCommand(config.Retry),
+		BuildRADIUSWaitCommand(config.Wait),
+		BuildRADIUSAccountCommand(config.Accounting),
+		BuildRADIUSAuthCommand(config.Auth),
+	}
+}
+
+// BuildDeleteRADIUSCommands builds the commands needed to remove the RADIUS configuration
+func BuildDeleteRADIUSCommands() []string {
+	return []string{
+		"no radius auth",
+		"no radius account",
+		"no radius wait",
+		"no radius retry",
+		"no radius auth port",
+		"no radius secret",
+		"no radius server",
+	}
+}
+
+// BuildShowRADIUSConfigCommand builds the command to show RADIUS configuration
+func BuildShowRADIUSConfigCommand() string {
+	return "show config | grep radius"
+}
+
+// ValidateRADIUSConfig validates a RADIUS configuration
+func ValidateRADIUSConfig(config RADIUSConfig) error {
+	if len(config.Servers) == 0 || len(config.Servers) > 2 {
+		return fmt.Errorf("one or two RADIUS servers are required, got %d", len(config.Servers))
+	}
+	for _, server := range config.Servers {
+		if ip := net.ParseIP(server); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("invalid RADIUS server IPv4 address: %s", server)
+		}
+	}
+	if config.Secret == "" {
+		return fmt.Errorf("RADIUS shared secret is required")
+	}
+	if strings.ContainsAny(config.Secret, " \t\"") {
+		return fmt.Errorf("RADIUS shared secret must not contain whitespace or quotes")
+	}
+	if config.AuthPort < 1 || config.AuthPort > 65535 {
+		return fmt.Errorf("auth port must be between 1 and 65535, got %d", config.AuthPort)
+	}
+	if config.Retry < 1 || config.Retry > 10 {
+		return fmt.Errorf("retry must be between 1 and 10, got %d", config.Retry)
+	}
+	if config.Wait < 1 || config.Wait > 30 {
+		return fmt.Errorf("wait must be between 1 and 30 seconds, got %d", config.Wait)
+	}
+	return nil
+}
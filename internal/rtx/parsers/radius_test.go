@@ -0,0 +1,121 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseRADIUSConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want *RADIUSConfig
+	}{
+		{
+			name: "no server",
+			raw:  "radius auth on",
+			want: nil,
+		},
+		{
+			name: "server with defaults",
+			raw: `radius server 192.168.1.10
+radius secret s3cr3t`,
+			want: &RADIUSConfig{
+				Servers:  []string{"192.168.1.10"},
+				Secret:   "s3cr3t",
+				AuthPort: 1812,
+				Retry:    4,
+				Wait:     9,
+			},
+		},
+		{
+			name: "full configuration",
+			raw: `radius auth on
+radius account on
+radius server 192.168.1.10 192.168.1.11
+radius secret s3cr3t
+radius auth port 1645
+radius retry 2
+radius wait 5`,
+			want: &RADIUSConfig{
+				Auth:       true,
+				Accounting: true,
+				Servers:    []string{"192.168.1.10", "192.168.1.11"},
+				Secret:     "s3cr3t",
+				AuthPort:   1645,
+				Retry:      2,
+				Wait:       5,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseRADIUSConfig(tt.raw); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseRADIUSConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildRADIUSCommands(t *testing.T) {
+	config := RADIUSConfig{
+		Auth:     true,
+		Servers:  []string{"192.168.1.10", "192.168.1.11"},
+		Secret:   "s3cr3t",
+		AuthPort: 1812,
+		Retry:    4,
+		Wait:     9,
+	}
+
+	want := []string{
+		"radius server 192.168.1.10 192.168.1.11",
+		"radius secret s3cr3t",
+		"radius auth port 1812",
+		"radius retry 4",
+		"radius wait 9",
+		"radius account off",
+		"radius auth on",
+	}
+	if got := BuildRADIUSCommands(config); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildRADIUSCommands() = %v, want %v", got, want)
+	}
+}
+
+func TestValidateRADIUSConfig(t *testing.T) {
+	valid := RADIUSConfig{
+		Servers:  []string{"192.168.1.10"},
+		Secret:   "s3cr3t",
+		AuthPort: 1812,
+		Retry:    4,
+		Wait:     9,
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(c *RADIUSConfig)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(c *RADIUSConfig) {}},
+		{name: "two servers", modify: func(c *RADIUSConfig) { c.Servers = []string{"192.168.1.10", "192.168.1.11"} }},
+		{name: "no servers", modify: func(c *RADIUSConfig) { c.Servers = nil }, wantErr: true},
+		{name: "three servers", modify: func(c *RADIUSConfig) { c.Servers = []string{"192.168.1.1", "192.168.1.2", "192.168.1.3"} }, wantErr: true},
+		{name: "invalid server", modify: func(c *RADIUSConfig) { c.Servers = []string{"radius.example.com"} }, wantErr: true},
+		{name: "empty secret", modify: func(c *RADIUSConfig) { c.Secret = "" }, wantErr: true},
+		{name: "secret with space", modify: func(c *RADIUSConfig) { c.Secret = "a b" }, wantErr: true},
+		{name: "port out of range", modify: func(c *RADIUSConfig) { c.AuthPort = 70000 }, wantErr: true},
+		{name: "retry out of range", modify: func(c *RADIUSConfig) { c.Retry = 0 }, wantErr: true},
+		{name: "wait out of range", modify: func(c *RADIUSConfig) { c.Wait = 31 }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+			err := ValidateRADIUSConfig(config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRADIUSConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package parsers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BuildShowRunningConfigCommand builds the command to show the router
+// configuration, optionally filtered by a grep pattern
+func BuildShowRunningConfigCommand(pattern string) string {
+	if pattern == "" {
+		return "show config"
+	}
+	return fmt.Sprintf(`show config | grep "%s"`, pattern)
+}
+
+// NormalizeRunningConfig converts line endings to LF and removes trailing
+// whitespace and blank lines at the end of the output
+func NormalizeRunningConfig(raw string) string {
+	raw = strings.ReplaceAll(raw, "\r\n", "\n")
+
+	lines := strings.Split(raw, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+// ValidateConfigGrepPattern validates a pattern passed to "show config | grep".
+// The pattern is sent inside double quotes, so quotes, pipes and control
+// characters are rejected.
+func ValidateConfigGrepPattern(pattern string) error {
+	if strings.ContainsAny(pattern, "\"|") {
+		return fmt.Errorf("grep pattern must not contain double quotes or pipes, got %q", pattern)
+	}
+	for _, r := range pattern {
+		if r < 0x20 || r == 0x7f {
+			return fmt.Errorf("grep pattern must not contain control characters, got %q", pattern)
+		}
+	}
+	return nil
+}
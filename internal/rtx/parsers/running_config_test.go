@@ -0,0 +1,41 @@
+package parsers
+
+import "testing"
+
+func TestBuildShowRunningConfigCommand(t *testing.T) {
+	if got, want := BuildShowRunningConfigCommand(""), "show config"; got != want {
+		t.Errorf("BuildShowRunningConfigCommand() = %q, want %q", got, want)
+	}
+	if got, want := BuildShowRunningConfigCommand("nat descriptor"), `show config | grep "nat descriptor"`; got != want {
+		t.Errorf("BuildShowRunningConfigCommand() = %q, want %q", got, want)
+	}
+}
+
+func TestNormalizeRunningConfig(t *testing.T) {
+	raw := "ip route default gateway pp 1  \r\nip lan1 address 192.168.1.1/24\r\n\r\n"
+	want := "ip route default gateway pp 1\nip lan1 address 192.168.1.1/24"
+
+	if got := NormalizeRunningConfig(raw); got != want {
+		t.Errorf("NormalizeRunningConfig() = %q, want %q", got, want)
+	}
+}
+
+func TestValidateConfigGrepPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		wantErr bool
+	}{
+		{pattern: "ip filter"},
+		{pattern: "^pp "},
+		{pattern: `ip "lan1"`, wantErr: true},
+		{pattern: "ip | save", wantErr: true},
+		{pattern: "ip\nsave", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := ValidateConfigGrepPattern(tt.pattern)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateConfigGrepPattern(%q) error = %v, wantErr %v", tt.pattern, err, tt.wantErr)
+		}
+	}
+}
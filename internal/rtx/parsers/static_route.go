@@ -3,6 +3,7 @@ package parsers
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -18,7 +19,7 @@ type StaticRoute struct {
 type NextHop struct {
 	NextHop     string `json:"next_hop,omitempty"`     // Gateway IP address
 	Interface   string `json:"interface,omitempty"`    // Interface (pp 1, tunnel 1, etc.)
-	Distance    int    `json:"distance"`               // Administrative distance (weight)
+	Distance    int    `json:"distance"`               // Gateway weight (0 = backup, used only when other gateways are down)
 	Name        string `json:"name,omitempty"`         // Route description
 	Permanent   bool   `json:"permanent"`              // Keep route when interface down
 	Filter      int    `json:"filter,omitempty"`       // IP filter number (RTX-specific)
@@ -97,11 +98,17 @@ func (p *StaticRouteParser) ParseRouteConfig(raw string) ([]StaticRoute, error)
 		}
 	}
 
-	// Convert map to slice
+	// Convert map to slice, sorted by destination for a deterministic order
 	result := make([]StaticRoute, 0, len(routes))
 	for _, route := range routes {
 		result = append(result, *route)
 	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Prefix != result[j].Prefix {
+			return result[i].Prefix < result[j].Prefix
+		}
+		return result[i].Mask < result[j].Mask
+	})
 
 	return result, nil
 }
@@ -348,7 +355,7 @@ func splitOnGateway(gatewayPart string) []string {
 }
 
 // BuildIPRouteCommand builds the command to create a static route with a single next hop
-// Command format: ip route <network> gateway <gateway> [weight <n>] [filter <n>] [hide] [keepalive]
+// Command format: ip route <network> gateway <gateway> [weight <n>] [filter <n>] [keepalive [<id>]] [hide]
 func BuildIPRouteCommand(route StaticRoute, hop NextHop) string {
 	// Determine network string
 	network := formatNetworkNotation(route.Prefix, route.Mask)
@@ -367,7 +374,8 @@ func BuildIPRouteCommand(route StaticRoute, hop NextHop) string {
 	}
 
 	// Add optional parameters
-	if hop.Distance > 1 {
+	// Weight 1 is the router default; weight 0 marks a backup gateway
+	if hop.Distance != 1 {
 		cmd.WriteString(fmt.Sprintf(" weight %d", hop.Distance))
 	}
 
@@ -426,7 +434,8 @@ func BuildIPRouteCommandMultiHop(route StaticRoute) string {
 		}
 
 		// Add optional parameters for this hop
-		if hop.Distance > 1 {
+		// Weight 1 is the router default; weight 0 marks a backup gateway
+		if hop.Distance != 1 {
 			cmd.WriteString(fmt.Sprintf(" weight %d", hop.Distance))
 		}
 
@@ -531,10 +540,21 @@ func ValidateStaticRoute(route StaticRoute) error {
 		return fmt.Errorf("at least one next_hop is required")
 	}
 
+	seen := make(map[string]bool, len(route.NextHops))
 	for i, hop := range route.NextHops {
 		if err := validateNextHop(hop); err != nil {
 			return fmt.Errorf("next_hop[%d]: %w", i, err)
 		}
+
+		// Each gateway may appear only once in a multi-gateway route
+		gateway := hop.NextHop
+		if hop.Interface != "" {
+			gateway = hop.Interface
+		}
+		if seen[gateway] {
+			return fmt.Errorf("next_hop[%d]: duplicate gateway %s", i, gateway)
+		}
+		seen[gateway] = true
 	}
 
 	return nil
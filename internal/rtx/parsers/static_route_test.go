@@ -1,6 +1,7 @@
 package parsers
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -494,6 +495,31 @@ func TestBuildIPRouteCommand(t *testing.T) {
 			},
 			expected: "ip route 10.0.0.0/8 gateway 192.168.1.1 weight 20 filter 50 keepalive",
 		},
+		{
+			name: "backup gateway with weight 0",
+			route: StaticRoute{
+				Prefix: "0.0.0.0",
+				Mask:   "0.0.0.0",
+			},
+			hop: NextHop{
+				Interface: "pp 2",
+				Distance:  0,
+			},
+			expected: "ip route default gateway pp 2 weight 0",
+		},
+		{
+			name: "gateway monitored by keepalive",
+			route: StaticRoute{
+				Prefix: "0.0.0.0",
+				Mask:   "0.0.0.0",
+			},
+			hop: NextHop{
+				Interface:   "pp 1",
+				Distance:    1,
+				KeepaliveID: 1,
+			},
+			expected: "ip route default gateway pp 1 keepalive 1",
+		},
 	}
 
 	for _, tt := range tests {
@@ -597,6 +623,41 @@ func TestBuildShowSingleRouteConfigCommand(t *testing.T) {
 	}
 }
 
+func TestParseRouteConfigWeightedDefaultGateway(t *testing.T) {
+	parser := NewStaticRouteParser()
+
+	input := `ip route 10.0.0.0/8 gateway 192.168.1.1
+ip route default gateway 192.168.0.1 weight 3 gateway 192.168.0.2 weight 1 gateway pp 1 weight 0`
+
+	routes, err := parser.ParseRouteConfig(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Routes are sorted by destination regardless of config order
+	if len(routes) != 2 {
+		t.Fatalf("expected 2 routes, got %d", len(routes))
+	}
+	if routes[0].Prefix != "0.0.0.0" || routes[1].Prefix != "10.0.0.0" {
+		t.Fatalf("routes not sorted: %q, %q", routes[0].Prefix, routes[1].Prefix)
+	}
+
+	expected := []NextHop{
+		{NextHop: "192.168.0.1", Distance: 3},
+		{NextHop: "192.168.0.2", Distance: 1},
+		{Interface: "pp 1", Distance: 0},
+	}
+	if !reflect.DeepEqual(routes[0].NextHops, expected) {
+		t.Errorf("next_hops = %+v, want %+v", routes[0].NextHops, expected)
+	}
+
+	cmd := BuildIPRouteCommandMultiHop(routes[0])
+	want := "ip route default gateway 192.168.0.1 weight 3 gateway 192.168.0.2 gateway pp 1 weight 0"
+	if cmd != want {
+		t.Errorf("BuildIPRouteCommandMultiHop() = %q, want %q", cmd, want)
+	}
+}
+
 func TestValidateStaticRoute(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -767,6 +828,19 @@ func TestValidateStaticRoute(t *testing.T) {
 			wantErr: true,
 			errMsg:  "distance must be between 0 and 100",
 		},
+		{
+			name: "duplicate gateway",
+			route: StaticRoute{
+				Prefix: "0.0.0.0",
+				Mask:   "0.0.0.0",
+				NextHops: []NextHop{
+					{NextHop: "192.168.0.1", Distance: 2},
+					{NextHop: "192.168.0.1", Distance: 1},
+				},
+			},
+			wantErr: true,
+			errMsg:  "duplicate gateway 192.168.0.1",
+		},
 	}
 
 	for _, tt := range tests {
@@ -858,3 +932,28 @@ func TestIsValidInterface(t *testing.T) {
 		}
 	}
 }
+
+func TestWANFailoverRouteRoundTrip(t *testing.T) {
+	route := StaticRoute{
+		Prefix: "0.0.0.0",
+		Mask:   "0.0.0.0",
+		NextHops: []NextHop{
+			{Interface: "pp 1", Distance: 1, KeepaliveID: 1},
+			{Interface: "pp 2", Distance: 0},
+		},
+	}
+
+	cmd := BuildIPRouteCommandMultiHop(route)
+	expected := "ip route default gateway pp 1 keepalive 1 gateway pp 2 weight 0"
+	if cmd != expected {
+		t.Fatalf("BuildIPRouteCommandMultiHop() = %q, want %q", cmd, expected)
+	}
+
+	parsed, err := NewStaticRouteParser().ParseSingleRoute(cmd, "0.0.0.0", "0.0.0.0")
+	if err != nil {
+		t.Fatalf("ParseSingleRoute() error = %v", err)
+	}
+	if !reflect.DeepEqual(parsed.NextHops, route.NextHops) {
+		t.Errorf("round trip next hops = %+v, want %+v", parsed.NextHops, route.NextHops)
+	}
+}
@@ -0,0 +1,114 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// SystemSettings represents global forwarding toggles of the router
+type SystemSettings struct {
+	IPRouting           bool   `json:"ip_routing"`             // ip routing on|off
+	IPv6Routing         bool   `json:"ipv6_routing"`           // ipv6 routing on|off
+	IPRoutingProcess    string `json:"ip_routing_process"`     // ip routing process fast|normal
+	IPv6RoutingProcess  string `json:"ipv6_routing_process"`   // ipv6 routing process fast|normal
+	IPFilterSourceRoute bool   `json:"ip_filter_source_route"` // ip filter source-route on|off
+}
+
+// ValidRoutingProcesses lists the supported packet forwarding modes
+var ValidRoutingProcesses = []string{"fast", "normal"}
+
+var (
+	ipRoutingPattern           = regexp.MustCompile(`^\s*(ip|ipv6)\s+routing\s+(on|off)\s*$`)
+	ipRoutingProcessPattern    = regexp.MustCompile(`^\s*(ip|ipv6)\s+routing\s+process\s+(fast|normal)\s*$`)
+	ipFilterSourceRoutePattern = regexp.MustCompile(`^\s*ip\s+filter\s+source-route\s+(on|off)\s*$`)
+)
+
+// DefaultSystemSettings returns the router defaults for the system settings
+func DefaultSystemSettings() SystemSettings {
+	return SystemSettings{
+		IPRouting:           true,
+		IPv6Routing:         true,
+		IPRoutingProcess:    "fast",
+		IPv6RoutingProcess:  "fast",
+		IPFilterSourceRoute: true,
+	}
+}
+
+// ParseSystemSettings parses the global forwarding toggles from the router
+// configuration. Settings that are not present keep the router default.
+func ParseSystemSettings(raw string) *SystemSettings {
+	settings := DefaultSystemSettings()
+
+	for _, line := range strings.Split(raw, "\n") {
+		if matches := ipRoutingPattern.FindStringSubmatch(line); len(matches) == 3 {
+			if matches[1] == "ipv6" {
+				settings.IPv6Routing = matches[2] == "on"
+			} else {
+				settings.IPRouting = matches[2] == "on"
+			}
+			continue
+		}
+		if matches := ipRoutingProcessPattern.FindStringSubmatch(line); len(matches) == 3 {
+			if matches[1] == "ipv6" {
+				settings.IPv6RoutingProcess = matches[2]
+			} else {
+				settings.IPRoutingProcess = matches[2]
+			}
+			continue
+		}
+		if matches := ipFilterSourceRoutePattern.FindStringSubmatch(line); len(matches) == 2 {
+			settings.IPFilterSourceRoute = matches[1] == "on"
+		}
+	}
+
+	return &settings
+}
+
+// BuildSystemSettingsCommands builds the commands to apply the system settings
+func BuildSystemSettingsCommands(settings SystemSettings) []string {
+	return []string{
+		fmt.Sprintf("ip routing %s", systemSettingSwitch(settings.IPRouting)),
+		fmt.Sprintf("ipv6 routing %s", systemSettingSwitch(settings.IPv6Routing)),
+		fmt.Sprintf("ip routing process %s", settings.IPRoutingProcess),
+		fmt.Sprintf("ipv6 routing process %s", settings.IPv6RoutingProcess),
+		fmt.Sprintf("ip filter source-route %s", systemSettingSwitch(settings.IPFilterSourceRoute)),
+	}
+}
+
+// BuildDeleteSystemSettingsCommands builds the commands to restore the default system settings
+func BuildDeleteSystemSettingsCommands() []string {
+	return []string{
+		"no ip routing",
+		"no ipv6 routing",
+		"no ip routing process",
+		"no ipv6 routing process",
+		"no ip filter source-route",
+	}
+}
+
+// BuildShowSystemSettingsCommand builds the command to show the system settings.
+// The settings share no common prefix, so the whole configuration is read.
+func BuildShowSystemSettingsCommand() string {
+	return "show config"
+}
+
+// ValidateSystemSettings validates the system settings
+func ValidateSystemSettings(settings SystemSettings) error {
+	if !slices.Contains(ValidRoutingProcesses, settings.IPRoutingProcess) {
+		return fmt.Errorf("ip routing process must be one of %v, got %q", ValidRoutingProcesses, settings.IPRoutingProcess)
+	}
+	if !slices.Contains(ValidRoutingProcesses, settings.IPv6RoutingProcess) {
+		return fmt.Errorf("ipv6 routing process must be one of %v, got %q", ValidRoutingProcesses, settings.IPv6RoutingProcess)
+	}
+	return nil
+}
+
+// systemSettingSwitch returns the on/off keyword for a system setting
+func systemSettingSwitch(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+	return "off"
+}
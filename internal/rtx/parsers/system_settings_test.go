@@ -0,0 +1,88 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSystemSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected *SystemSettings
+	}{
+		{
+			name:  "defaults",
+			input: "ip lan1 address 192.168.1.1/24\n",
+			expected: &SystemSettings{
+				IPRouting:           true,
+				IPv6Routing:         true,
+				IPRoutingProcess:    "fast",
+				IPv6RoutingProcess:  "fast",
+				IPFilterSourceRoute: true,
+			},
+		},
+		{
+			name: "all settings changed",
+			input: `ip routing off
+ipv6 routing off
+ip routing process normal
+ipv6 routing process normal
+ip filter source-route off
+`,
+			expected: &SystemSettings{
+				IPRoutingProcess:   "normal",
+				IPv6RoutingProcess: "normal",
+			},
+		},
+		{
+			name:  "ipv6 only",
+			input: "ipv6 routing process normal\n",
+			expected: &SystemSettings{
+				IPRouting:           true,
+				IPv6Routing:         true,
+				IPRoutingProcess:    "fast",
+				IPv6RoutingProcess:  "normal",
+				IPFilterSourceRoute: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ParseSystemSettings(tt.input)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ParseSystemSettings() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuildSystemSettingsCommands(t *testing.T) {
+	settings := DefaultSystemSettings()
+	settings.IPv6Routing = false
+	settings.IPRoutingProcess = "normal"
+
+	expected := []string{
+		"ip routing on",
+		"ipv6 routing off",
+		"ip routing process normal",
+		"ipv6 routing process fast",
+		"ip filter source-route on",
+	}
+	if commands := BuildSystemSettingsCommands(settings); !reflect.DeepEqual(commands, expected) {
+		t.Errorf("BuildSystemSettingsCommands() = %v, want %v", commands, expected)
+	}
+}
+
+func TestValidateSystemSettings(t *testing.T) {
+	if err := ValidateSystemSettings(DefaultSystemSettings()); err != nil {
+		t.Errorf("ValidateSystemSettings() unexpected error = %v", err)
+	}
+
+	settings := DefaultSystemSettings()
+	settings.IPv6RoutingProcess = "turbo"
+	if err := ValidateSystemSettings(settings); err == nil {
+		t.Error("ValidateSystemSettings() expected error for invalid routing process")
+	}
+}
@@ -0,0 +1,141 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Traffic threshold directions
+const (
+	TrafficThresholdDirectionIn   = "in"
+	TrafficThresholdDirectionOut  = "out"
+	TrafficThresholdDirectionBoth = "both"
+)
+
+// Traffic threshold actions
+const (
+	TrafficThresholdActionSyslog     = "syslog"
+	TrafficThresholdActionDisconnect = "disconnect"
+)
+
+// ValidTrafficThresholdDirections lists the supported counting directions
+var ValidTrafficThresholdDirections = []string{
+	TrafficThresholdDirectionIn,
+	TrafficThresholdDirectionOut,
+	TrafficThresholdDirectionBoth,
+}
+
+// ValidTrafficThresholdActions lists the supported notification actions
+var ValidTrafficThresholdActions = []string{
+	TrafficThresholdActionSyslog,
+	TrafficThresholdActionDisconnect,
+}
+
+// TrafficThreshold represents a traffic volume alarm on an interface
+// Command format: account threshold <interface> <direction> <megabytes> [action=<syslog|disconnect>]
+type TrafficThreshold struct {
+	Interface string `json:"interface"` // Interface whose traffic is counted (lan2, pp1, ...)
+	Direction string `json:"direction"` // in, out or both
+	Megabytes int    `json:"megabytes"` // Volume in megabytes that triggers the action
+	Action    string `json:"action"`    // syslog or disconnect
+}
+
+var trafficThresholdPattern = regexp.MustCompile(`^\s*account\s+threshold\s+(\S+)\s+(in|out|both)\s+(\d+)((?:\s+\S+=\S+)*)\s*$`)
+
+// ParseTrafficThresholdConfig parses "account threshold" lines from the router configuration
+func ParseTrafficThresholdConfig(raw string) ([]TrafficThreshold, error) {
+	var thresholds []TrafficThreshold
+
+	for _, line := range strings.Split(raw, "\n") {
+		matches := trafficThresholdPattern.FindStringSubmatch(strings.TrimSpace(line))
+		if len(matches) < 4 {
+			continue
+		}
+
+		megabytes, err := strconv.Atoi(matches[3])
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold value %q: %w", matches[3], err)
+		}
+
+		threshold := TrafficThreshold{
+			Interface: matches[1],
+			Direction: matches[2],
+			Megabytes: megabytes,
+			Action:    TrafficThresholdActionSyslog,
+		}
+		for _, opt := range strings.Fields(matches[4]) {
+			if key, value, _ := strings.Cut(opt, "="); key == "action" {
+				threshold.Action = value
+			}
+		}
+
+		thresholds = append(thresholds, threshold)
+	}
+
+	sort.Slice(thresholds, func(i, j int) bool {
+		return thresholds[i].Interface < thresholds[j].Interface
+	})
+
+	return thresholds, nil
+}
+
+// ParseSingleTrafficThreshold parses the configuration and returns the threshold for the given interface
+func ParseSingleTrafficThreshold(raw string, iface string) (*TrafficThreshold, error) {
+	thresholds, err := ParseTrafficThresholdConfig(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range thresholds {
+		if thresholds[i].Interface == iface {
+			return &thresholds[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("traffic threshold for %s not found", iface)
+}
+
+// BuildTrafficThresholdCommand builds the command to set a traffic threshold
+// Command format: account threshold <interface> <direction> <megabytes> [action=<action>]
+func BuildTrafficThresholdCommand(threshold TrafficThreshold) string {
+	cmd := fmt.Sprintf("account threshold %s %s %d", threshold.Interface, threshold.Direction, threshold.Megabytes)
+	if threshold.Action != "" && threshold.Action != TrafficThresholdActionSyslog {
+		cmd += fmt.Sprintf(" action=%s", threshold.Action)
+	}
+	return cmd
+}
+
+// BuildDeleteTrafficThresholdCommand builds the command to remove a traffic threshold
+// Command format: no account threshold <interface>
+func BuildDeleteTrafficThresholdCommand(iface string) string {
+	return fmt.Sprintf("no account threshold %s", iface)
+}
+
+// BuildShowTrafficThresholdCommand builds the command to show traffic threshold configuration
+func BuildShowTrafficThresholdCommand() string {
+	return "show config | grep \"account threshold\""
+}
+
+// ValidateTrafficThreshold validates a traffic threshold definition
+func ValidateTrafficThreshold(threshold TrafficThreshold) error {
+	if err := ValidateInterfaceName(threshold.Interface); err != nil {
+		return err
+	}
+	if !slices.Contains(ValidTrafficThresholdDirections, threshold.Direction) {
+		return fmt.Errorf("invalid direction %q, must be one of: %s", threshold.Direction, strings.Join(ValidTrafficThresholdDirections, ", "))
+	}
+	if threshold.Megabytes < 1 {
+		return fmt.Errorf("threshold must be at least 1 megabyte, got %d", threshold.Megabytes)
+	}
+	if threshold.Action != "" && !slices.Contains(ValidTrafficThresholdActions, threshold.Action) {
+		return fmt.Errorf("invalid action %q, must be one of: %s", threshold.Action, strings.Join(ValidTrafficThresholdActions, ", "))
+	}
+	if threshold.Action == TrafficThresholdActionDisconnect && !strings.HasPrefix(threshold.Interface, "pp") {
+		return fmt.Errorf("action %q is only supported on pp interfaces", TrafficThresholdActionDisconnect)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTrafficThresholdConfig(t *testing.T) {
+	raw := `account threshold pp1 both 30720 action=disconnect
+account threshold lan2 in 10240
+account threshold lan3 sideways 10`
+
+	got, err := ParseTrafficThresholdConfig(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []TrafficThreshold{
+		{Interface: "lan2", Direction: "in", Megabytes: 10240, Action: "syslog"},
+		{Interface: "pp1", Direction: "both", Megabytes: 30720, Action: "disconnect"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseTrafficThresholdConfig() = %+v, want %+v", got, want)
+	}
+
+	if _, err := ParseSingleTrafficThreshold(raw, "lan1"); err == nil {
+		t.Error("expected not found error for missing threshold")
+	}
+}
+
+func TestBuildTrafficThresholdCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold TrafficThreshold
+		want      string
+	}{
+		{
+			name:      "syslog action is implicit",
+			threshold: TrafficThreshold{Interface: "lan2", Direction: "both", Megabytes: 10240, Action: "syslog"},
+			want:      "account threshold lan2 both 10240",
+		},
+		{
+			name:      "disconnect",
+			threshold: TrafficThreshold{Interface: "pp1", Direction: "out", Megabytes: 500, Action: "disconnect"},
+			want:      "account threshold pp1 out 500 action=disconnect",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BuildTrafficThresholdCommand(tt.threshold); got != tt.want {
+				t.Errorf("BuildTrafficThresholdCommand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got := BuildDeleteTrafficThresholdCommand("pp1"); got != "no account threshold pp1" {
+		t.Errorf("BuildDeleteTrafficThresholdCommand() = %q", got)
+	}
+}
+
+func TestValidateTrafficThreshold(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold TrafficThreshold
+		wantErr   bool
+	}{
+		{name: "valid", threshold: TrafficThreshold{Interface: "lan2", Direction: "both", Megabytes: 1, Action: "syslog"}},
+		{name: "disconnect on pp", threshold: TrafficThreshold{Interface: "pp1", Direction: "in", Megabytes: 1, Action: "disconnect"}},
+		{name: "disconnect on lan", threshold: TrafficThreshold{Interface: "lan2", Direction: "in", Megabytes: 1, Action: "disconnect"}, wantErr: true},
+		{name: "invalid direction", threshold: TrafficThreshold{Interface: "lan2", Direction: "up", Megabytes: 1}, wantErr: true},
+		{name: "zero megabytes", threshold: TrafficThreshold{Interface: "lan2", Direction: "both"}, wantErr: true},
+		{name: "invalid interface", threshold: TrafficThreshold{Interface: "wwan", Direction: "both", Megabytes: 1}, wantErr: true},
+		{name: "invalid action", threshold: TrafficThreshold{Interface: "lan2", Direction: "both", Megabytes: 1, Action: "mail"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTrafficThreshold(tt.threshold)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTrafficThreshold() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// USBHostConfig represents the USB host settings of the router
+type USBHostConfig struct {
+	Enabled        bool `json:"enabled"`         // usbhost use on/off
+	ModemEnabled   bool `json:"modem_enabled"`   // usbhost modem use on/off
+	StorageEnabled bool `json:"storage_enabled"` // usbhost storage use on/off
+}
+
+var usbHostPattern = regexp.MustCompile(`^\s*usbhost\s+(?:(modem|storage)\s+)?use\s+(on|off)\s*$`)
+
+// ParseUSBHostConfig parses "usbhost" settings from the router configuration.
+// Settings that are not present keep the router default (on).
+func ParseUSBHostConfig(raw string) *USBHostConfig {
+	config := &USBHostConfig{
+		Enabled:        true,
+		ModemEnabled:   true,
+		StorageEnabled: true,
+	}
+
+	for _, line := range strings.Split(raw, "\n") {
+		matches := usbHostPattern.FindStringSubmatch(line)
+		if len(matches) != 3 {
+			continue
+		}
+
+		on := matches[2] == "on"
+		switch matches[1] {
+		case "modem":
+			config.ModemEnabled = on
+		case "storage":
+			config.StorageEnabled = on
+		default:
+			config.Enabled = on
+		}
+	}
+
+	return config
+}
+
+// BuildUSBHostCommands builds the commands to configure the USB host settings
+func BuildUSBHostCommands(config USBHostConfig) []string {
+	return []string{
+		fmt.Sprintf("usbhost use %s", usbHostSwitch(config.Enabled)),
+		fmt.Sprintf("usbhost modem use %s", usbHostSwitch(config.ModemEnabled)),
+		fmt.Sprintf("usbhost storage use %s", usbHostSwitch(config.StorageEnabled)),
+	}
+}
+
+// BuildDeleteUSBHostCommands builds the commands to restore the default USB host settings
+func BuildDeleteUSBHostCommands() []string {
+	return []string{
+		"no usbhost storage use",
+		"no usbhost modem use",
+		"no usbhost use",
+	}
+}
+
+// BuildShowUSBHostConfigCommand builds the command to show the USB host settings
+func BuildShowUSBHostConfigCommand() string {
+	return `show config | grep "usbhost "`
+}
+
+// usbHostSwitch returns the on/off keyword for a USB host setting
+func usbHostSwitch(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+	return "off"
+}
@@ -0,0 +1,63 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseUSBHostConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected *USBHostConfig
+	}{
+		{
+			name:     "defaults",
+			input:    "",
+			expected: &USBHostConfig{Enabled: true, ModemEnabled: true, StorageEnabled: true},
+		},
+		{
+			name:     "storage disabled",
+			input:    "usbhost storage use off\n",
+			expected: &USBHostConfig{Enabled: true, ModemEnabled: true, StorageEnabled: false},
+		},
+		{
+			name:     "all disabled",
+			input:    "usbhost use off\nusbhost modem use off\nusbhost storage use off\n",
+			expected: &USBHostConfig{},
+		},
+		{
+			name:     "ignores unrelated usbhost commands",
+			input:    "usbhost overcurrent notice on\nusbhost modem use off\n",
+			expected: &USBHostConfig{Enabled: true, ModemEnabled: false, StorageEnabled: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ParseUSBHostConfig(tt.input)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ParseUSBHostConfig() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuildUSBHostCommands(t *testing.T) {
+	commands := BuildUSBHostCommands(USBHostConfig{Enabled: true, ModemEnabled: true, StorageEnabled: false})
+	expected := []string{
+		"usbhost use on",
+		"usbhost modem use on",
+		"usbhost storage use off",
+	}
+	if !reflect.DeepEqual(commands, expected) {
+		t.Errorf("BuildUSBHostCommands() = %v, want %v", commands, expected)
+	}
+}
+
+func TestBuildDeleteUSBHostCommands(t *testing.T) {
+	expected := []string{"no usbhost storage use", "no usbhost modem use", "no usbhost use"}
+	if commands := BuildDeleteUSBHostCommands(); !reflect.DeepEqual(commands, expected) {
+		t.Errorf("BuildDeleteUSBHostCommands() = %v, want %v", commands, expected)
+	}
+}
@@ -0,0 +1,133 @@
+package parsers
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+)
+
+// VPNAddressPool represents the address pool handed to remote access VPN clients
+// of a PP context
+type VPNAddressPool struct {
+	PP           string `json:"pp"`             // PP selector: "anonymous" or a PP number
+	Start        string `json:"start"`          // First address of the pool
+	End          string `json:"end"`            // Last address of the pool
+	DNSToClients bool   `json:"dns_to_clients"` // ppp ipcp msext on: hand DNS servers to clients
+}
+
+var (
+	vpnPoolRangePattern = regexp.MustCompile(`^\s*ip\s+pp\s+remote\s+address\s+pool\s+([0-9.]+)-([0-9.]+)\s*$`)
+	vpnPoolMsextPattern = regexp.MustCompile(`^\s*ppp\s+ipcp\s+msext\s+(on|off)\s*$`)
+)
+
+// ParseVPNAddressPools parses remote address pools from the router configuration.
+// Only static pools (start-end) are returned; "pool dhcp" is not a managed pool.
+func ParseVPNAddressPools(raw string) []VPNAddressPool {
+	pools := make(map[string]*VPNAddressPool)
+	msext := make(map[string]bool)
+	var order []string
+	currentPP := ""
+
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if matches := ppContextSelectPattern.FindStringSubmatch(line); len(matches) >= 2 {
+			currentPP = matches[1]
+			if currentPP == "none" {
+				currentPP = ""
+			}
+			continue
+		}
+
+		// Any other top-level select leaves the PP context
+		if strings.HasPrefix(line, "tunnel select ") {
+			currentPP = ""
+			continue
+		}
+
+		if currentPP == "" {
+			continue
+		}
+
+		if matches := vpnPoolRangePattern.FindStringSubmatch(line); len(matches) >= 3 {
+			if _, exists := pools[currentPP]; !exists {
+				order = append(order, currentPP)
+			}
+			pools[currentPP] = &VPNAddressPool{PP: currentPP, Start: matches[1], End: matches[2]}
+			continue
+		}
+
+		if matches := vpnPoolMsextPattern.FindStringSubmatch(line); len(matches) >= 2 {
+			msext[currentPP] = matches[1] == "on"
+		}
+	}
+
+	result := make([]VPNAddressPool, 0, len(order))
+	for _, pp := range order {
+		pool := pools[pp]
+		pool.DNSToClients = msext[pp]
+		result = append(result, *pool)
+	}
+	return result
+}
+
+// BuildPPPIPCPMsextCommand builds the command to hand DNS servers to PPP clients
+// Command format: ppp ipcp msext on|off
+func BuildPPPIPCPMsextCommand(enabled bool) string {
+	if enabled {
+		return "ppp ipcp msext on"
+	}
+	return "ppp ipcp msext off"
+}
+
+// BuildVPNAddressPoolCommands builds the commands to configure an address pool,
+// entering the PP context and returning to the global context afterwards
+func BuildVPNAddressPoolCommands(pool VPNAddressPool) []string {
+	return []string{
+		BuildPPSelectByNameCommand(pool.PP),
+		BuildIPPPRemotePoolCommand(pool.Start, pool.End),
+		BuildPPPIPCPMsextCommand(pool.DNSToClients),
+		"pp select none",
+	}
+}
+
+// BuildDeleteVPNAddressPoolCommands builds the commands to remove an address pool
+func BuildDeleteVPNAddressPoolCommands(pp string) []string {
+	return []string{
+		BuildPPSelectByNameCommand(pp),
+		"no ip pp remote address pool",
+		"no ppp ipcp msext",
+		"pp select none",
+	}
+}
+
+// BuildShowVPNAddressPoolsCommand builds the command to show address pools.
+// The full configuration is needed to attribute pools to their PP context.
+func BuildShowVPNAddressPoolsCommand() string {
+	return "show config"
+}
+
+// ValidateVPNAddressPool validates an address pool
+func ValidateVPNAddressPool(pool VPNAddressPool) error {
+	if !ppSelectorPattern.MatchString(pool.PP) || pool.PP == "0" {
+		return fmt.Errorf("pp must be 'anonymous' or a PP number, got %q", pool.PP)
+	}
+
+	start := net.ParseIP(pool.Start).To4()
+	if start == nil {
+		return fmt.Errorf("invalid pool start address: %s", pool.Start)
+	}
+	end := net.ParseIP(pool.End).To4()
+	if end == nil {
+		return fmt.Errorf("invalid pool end address: %s", pool.End)
+	}
+	if bytes.Compare(start, end) > 0 {
+		return fmt.Errorf("pool start %s must not be greater than end %s", pool.Start, pool.End)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseVPNAddressPools(t *testing.T) {
+	raw := `ip pp remote address pool 10.0.0.1-10.0.0.9
+pp select anonymous
+ pp bind tunnel1
+ ppp ipcp msext on
+ ip pp remote address pool 192.168.100.10-192.168.100.50
+pp select 2
+ ip pp remote address pool dhcp
+pp select 3
+ ip pp remote address pool 192.168.110.10-192.168.110.20
+pp select none`
+
+	want := []VPNAddressPool{
+		{PP: "anonymous", Start: "192.168.100.10", End: "192.168.100.50", DNSToClients: true},
+		{PP: "3", Start: "192.168.110.10", End: "192.168.110.20"},
+	}
+
+	if got := ParseVPNAddressPools(raw); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseVPNAddressPools() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildVPNAddressPoolCommands(t *testing.T) {
+	pool := VPNAddressPool{PP: "anonymous", Start: "192.168.100.10", End: "192.168.100.50", DNSToClients: true}
+
+	want := []string{
+		"pp select anonymous",
+		"ip pp remote address pool 192.168.100.10-192.168.100.50",
+		"ppp ipcp msext on",
+		"pp select none",
+	}
+	if got := BuildVPNAddressPoolCommands(pool); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildVPNAddressPoolCommands() = %v, want %v", got, want)
+	}
+
+	wantDelete := []string{"pp select anonymous", "no ip pp remote address pool", "no ppp ipcp msext", "pp select none"}
+	if got := BuildDeleteVPNAddressPoolCommands("anonymous"); !reflect.DeepEqual(got, wantDelete) {
+		t.Errorf("BuildDeleteVPNAddressPoolCommands() = %v, want %v", got, wantDelete)
+	}
+}
+
+func TestValidateVPNAddressPool(t *testing.T) {
+	tests := []struct {
+		name    string
+		pool    VPNAddressPool
+		wantErr bool
+	}{
+		{name: "valid", pool: VPNAddressPool{PP: "anonymous", Start: "192.168.100.10", End: "192.168.100.50"}},
+		{name: "single address", pool: VPNAddressPool{PP: "1", Start: "192.168.100.10", End: "192.168.100.10"}},
+		{name: "invalid pp", pool: VPNAddressPool{PP: "pp1", Start: "192.168.100.10", End: "192.168.100.50"}, wantErr: true},
+		{name: "invalid start", pool: VPNAddressPool{PP: "anonymous", Start: "192.168.100", End: "192.168.100.50"}, wantErr: true},
+		{name: "start after end", pool: VPNAddressPool{PP: "anonymous", Start: "192.168.100.50", End: "192.168.100.10"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateVPNAddressPool(tt.pool)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateVPNAddressPool() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package parsers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WLANConfig represents the built-in wireless LAN of models with Wi-Fi
+type WLANConfig struct {
+	Enabled bool       `json:"enabled"`         // wlan use on/off
+	Channel int        `json:"channel"`         // Radio channel (0 = auto)
+	SSIDs   []WLANSSID `json:"ssids,omitempty"` // Configured SSIDs
+}
+
+// WLANSSID represents one SSID of the wireless LAN
+type WLANSSID struct {
+	ID       int    `json:"id"`            // SSID number (1-4)
+	Name     string `json:"name"`          // Network name
+	Security string `json:"security"`      // Security mode
+	PSK      string `json:"psk,omitempty"` // Pre-shared key (not read back from the router)
+}
+
+// ValidWLANSecurityModes lists the supported wireless security modes
+var ValidWLANSecurityModes = []string{"none", "wpa2-psk", "wpa3-sae", "wpa2-wpa3-psk"}
+
+// validWLAN5GHzChannels lists the 5 GHz channels available in Japan
+var validWLAN5GHzChannels = []int{36, 40, 44, 48, 52, 56, 60, 64, 100, 104, 108, 112, 116, 120, 124, 128, 132, 136, 140}
+
+var (
+	wlanUsePattern      = regexp.MustCompile(`^\s*wlan\s+use\s+(on|off)\s*$`)
+	wlanChannelPattern  = regexp.MustCompile(`^\s*wlan\s+channel\s+(auto|\d+)\s*$`)
+	wlanSSIDPattern     = regexp.MustCompile(`^\s*wlan\s+ssid\s+(\d+)\s+(.+?)\s*$`)
+	wlanSecurityPattern = regexp.MustCompile(`^\s*wlan\s+security\s+(\d+)\s+(\S+)\s*$`)
+)
+
+// ParseWLANConfig parses "wlan" settings from the router configuration
+func ParseWLANConfig(raw string) *WLANConfig {
+	config := &WLANConfig{}
+	ssids := make(map[int]*WLANSSID)
+
+	ssid := func(id int) *WLANSSID {
+		if ssids[id] == nil {
+			ssids[id] = &WLANSSID{ID: id, Security: "none"}
+		}
+		return ssids[id]
+	}
+
+	for _, line := range strings.Split(raw, "\n") {
+		if matches := wlanUsePattern.FindStringSubmatch(line); len(matches) == 2 {
+			config.Enabled = matches[1] == "on"
+			continue
+		}
+		if matches := wlanChannelPattern.FindStringSubmatch(line); len(matches) == 2 {
+			if matches[1] != "auto" {
+				config.Channel, _ = strconv.Atoi(matches[1])
+			}
+			continue
+		}
+		if matches := wlanSSIDPattern.FindStringSubmatch(line); len(matches) == 3 {
+			id, _ := strconv.Atoi(matches[1])
+			ssid(id).Name = strings.Trim(matches[2], `"`)
+			continue
+		}
+		if matches := wlanSecurityPattern.FindStringSubmatch(line); len(matches) == 3 {
+			id, _ := strconv.Atoi(matches[1])
+			ssid(id).Security = matches[2]
+		}
+	}
+
+	for _, s := range ssids {
+		if s.Name != "" {
+			config.SSIDs = append(config.SSIDs, *s)
+		}
+	}
+	sort.Slice(config.SSIDs, func(i, j int) bool { return config.SSIDs[i].ID < config.SSIDs[j].ID })
+
+	return config
+}
+
+// BuildWLANCommands builds the commands to configure the wireless LAN
+func BuildWLANCommands(config WLANConfig) []string {
+	var commands []string
+
+	channel := "auto"
+	if config.Channel > 0 {
+		channel = strconv.Itoa(config.Channel)
+	}
+	commands = append(commands, fmt.Sprintf("wlan channel %s", channel))
+
+	for _, s := range config.SSIDs {
+		commands = append(commands, fmt.Sprintf("wlan ssid %d %q", s.ID, s.Name))
+		commands = append(commands, fmt.Sprintf("wlan security %d %s", s.ID, s.Security))
+		if s.Security != "none" && s.PSK != "" {
+			commands = append(commands, fmt.Sprintf("wlan pre-shared-key %d text %s", s.ID, s.PSK))
+		}
+	}
+
+	if config.Enabled {
+		return append(commands, "wlan use on")
+	}
+	return append(commands, "wlan use off")
+}
+
+// BuildDeleteWLANSSIDCommands builds the commands to remove an SSID
+func BuildDeleteWLANSSIDCommands(id int) []string {
+	return []string{
+		fmt.Sprintf("no wlan pre-shared-key %d", id),
+		fmt.Sprintf("no wlan security %d", id),
+		fmt.Sprintf("no wlan ssid %d", id),
+	}
+}
+
+// BuildDeleteWLANCommands builds the commands to disable the wireless LAN and remove its SSIDs
+func BuildDeleteWLANCommands(config WLANConfig) []string {
+	commands := []string{"wlan use off"}
+	for _, s := range config.SSIDs {
+		commands = append(commands, BuildDeleteWLANSSIDCommands(s.ID)...)
+	}
+	return append(commands, "no wlan channel")
+}
+
+// BuildShowWLANConfigCommand builds the command to show the wireless LAN configuration
+func BuildShowWLANConfigCommand() string {
+	return `show config | grep "wlan "`
+}
+
+// BuildShowWLANStatusCommand builds the command used to detect wireless LAN support
+func BuildShowWLANStatusCommand() string {
+	return "show status wlan"
+}
+
+// ValidateWLANConfig validates the wireless LAN configuration
+func ValidateWLANConfig(config WLANConfig) error {
+	if config.Channel != 0 && (config.Channel < 1 || config.Channel > 13) && !slices.Contains(validWLAN5GHzChannels, config.Channel) {
+		return fmt.Errorf("channel must be 0 (auto), 1-13 or a 5 GHz channel (36-140), got %d", config.Channel)
+	}
+
+	seen := make(map[int]bool, len(config.SSIDs))
+	for _, s := range config.SSIDs {
+		if s.ID < 1 || s.ID > 4 {
+			return fmt.Errorf("SSID number must be between 1 and 4, got %d", s.ID)
+		}
+		if seen[s.ID] {
+			return fmt.Errorf("duplicate SSID number %d", s.ID)
+		}
+		seen[s.ID] = true
+
+		if len(s.Name) < 1 || len(s.Name) > 32 || strings.Contains(s.Name, `"`) {
+			return fmt.Errorf("SSID %d: name must be 1-32 characters without double quotes", s.ID)
+		}
+		if !slices.Contains(ValidWLANSecurityModes, s.Security) {
+			return fmt.Errorf("SSID %d: security must be one of %v, got %q", s.ID, ValidWLANSecurityModes, s.Security)
+		}
+		if s.Security != "none" && (len(s.PSK) < 8 || len(s.PSK) > 63) {
+			return fmt.Errorf("SSID %d: pre-shared key must be 8-63 characters for %s", s.ID, s.Security)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseWLANConfig(t *testing.T) {
+	raw := `wlan channel 36
+wlan ssid 1 "office"
+wlan security 1 wpa3-sae
+wlan pre-shared-key 1 text secretpass
+wlan ssid 2 guest
+wlan use on`
+
+	want := &WLANConfig{
+		Enabled: true,
+		Channel: 36,
+		SSIDs: []WLANSSID{
+			{ID: 1, Name: "office", Security: "wpa3-sae"},
+			{ID: 2, Name: "guest", Security: "none"},
+		},
+	}
+
+	if got := ParseWLANConfig(raw); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseWLANConfig() = %+v, want %+v", got, want)
+	}
+
+	if got := ParseWLANConfig("wlan channel auto\nwlan use off"); got.Enabled || got.Channel != 0 || got.SSIDs != nil {
+		t.Errorf("ParseWLANConfig() = %+v, want disabled with auto channel", got)
+	}
+}
+
+func TestBuildWLANCommands(t *testing.T) {
+	config := WLANConfig{
+		Enabled: true,
+		SSIDs: []WLANSSID{
+			{ID: 1, Name: "office", Security: "wpa2-psk", PSK: "secretpass"},
+			{ID: 2, Name: "guest", Security: "none"},
+		},
+	}
+
+	want := []string{
+		"wlan channel auto",
+		`wlan ssid 1 "office"`,
+		"wlan security 1 wpa2-psk",
+		"wlan pre-shared-key 1 text secretpass",
+		`wlan ssid 2 "guest"`,
+		"wlan security 2 none",
+		"wlan use on",
+	}
+	if got := BuildWLANCommands(config); !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildWLANCommands() = %v, want %v", got, want)
+	}
+
+	wantDelete := []string{
+		"wlan use off",
+		"no wlan pre-shared-key 1",
+		"no wlan security 1",
+		"no wlan ssid 1",
+		"no wlan pre-shared-key 2",
+		"no wlan security 2",
+		"no wlan ssid 2",
+		"no wlan channel",
+	}
+	if got := BuildDeleteWLANCommands(config); !reflect.DeepEqual(got, wantDelete) {
+		t.Errorf("BuildDeleteWLANCommands() = %v, want %v", got, wantDelete)
+	}
+}
+
+func TestValidateWLANConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  WLANConfig
+		wantErr bool
+	}{
+		{name: "auto channel", config: WLANConfig{SSIDs: []WLANSSID{{ID: 1, Name: "office", Security: "wpa2-psk", PSK: "secretpass"}}}},
+		{name: "5 GHz channel", config: WLANConfig{Channel: 100}},
+		{name: "open network", config: WLANConfig{Channel: 6, SSIDs: []WLANSSID{{ID: 4, Name: "guest", Security: "none"}}}},
+		{name: "invalid channel", config: WLANConfig{Channel: 14}, wantErr: true},
+		{name: "SSID number out of range", config: WLANConfig{SSIDs: []WLANSSID{{ID: 5, Name: "office", Security: "none"}}}, wantErr: true},
+		{name: "duplicate SSID number", config: WLANConfig{SSIDs: []WLANSSID{{ID: 1, Name: "a", Security: "none"}, {ID: 1, Name: "b", Security: "none"}}}, wantErr: true},
+		{name: "name too long", config: WLANConfig{SSIDs: []WLANSSID{{ID: 1, Name: "abcdefghijklmnopqrstuvwxyz0123456", Security: "none"}}}, wantErr: true},
+		{name: "unknown security", config: WLANConfig{SSIDs: []WLANSSID{{ID: 1, Name: "office", Security: "wep"}}}, wantErr: true},
+		{name: "short key", config: WLANConfig{SSIDs: []WLANSSID{{ID: 1, Name: "office", Security: "wpa3-sae", PSK: "short"}}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateWLANConfig(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWLANConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
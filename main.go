@@ -4,9 +4,11 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
+	"github.com/sh1/terraform-provider-rtx/internal/generate"
 	"github.com/sh1/terraform-provider-rtx/internal/provider"
 )
 
@@ -30,6 +32,11 @@ var (
 )
 
 func main() {
+	// "terraform-provider-rtx generate" writes configuration for an existing router
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		os.Exit(generate.Main(context.Background(), provider.NewFramework(version), os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	var debugMode bool
 
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")